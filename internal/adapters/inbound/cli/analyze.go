@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/gitinfo"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/i18n"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/workspace"
+	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/spf13/cobra"
+)
+
+func newAnalyzeCmd() *cobra.Command {
+	var (
+		f   scoreFlags
+		src analyzeSource
+		nf  notifyFlags
+	)
+
+	cmd := &cobra.Command{
+		Use:   "analyze [<repository>[@ref] | --archive file | --files-from file]",
+		Short: "Score a remote repository, an archive or a streamed file list",
+		Long: `Fetch a remote git repository at a ref into a temporary directory, score
+it, and remove the checkout afterwards. The repository may be a URL
+(https://, ssh://, file://), an scp-style remote (git@github.com:org/repo)
+or a bare host path (github.com/org/repo). The ref defaults to the remote's
+default branch; --ref overrides an @ref suffix.
+
+Authentication uses your git setup (credential helpers, SSH agent); git
+never prompts for a password.
+
+For pipelines without a checked-out workspace, --archive scores a .tar,
+.tar.gz or .zip archive and --files-from scores only the listed files (one
+path per line, relative to --root); pass "-" to read either from stdin.
+
+For nightly jobs, --notify posts a summary of the run (score, delta against
+the --baseline score JSON of an earlier run, new errors) to a Slack, Teams
+or generic JSON webhook.`,
+		Example: `  openkraft analyze github.com/org/repo@v1.2.0
+  openkraft analyze git@github.com:org/private.git --ref main --json
+  openkraft analyze --archive repo.tar.gz
+  git ls-files | openkraft analyze --files-from -
+  openkraft analyze github.com/org/repo --baseline last.json --notify "$SLACK_WEBHOOK"`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if f.format == formatList {
+				return f.renderers().WriteList(cmd.OutOrStdout())
+			}
+			if err := f.validate(); err != nil {
+				return err
+			}
+
+			dir, cleanup, err := src.open(cmd, args)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+			f.source = src.name(args)
+
+			svc := application.NewScoreService(
+				scanner.New(),
+				detector.New(),
+				parser.New(),
+				config.New(),
+				parser.LanguageAnalyzers()...,
+			)
+			opts, err := f.scoreOptions(dir)
+			if err != nil {
+				return err
+			}
+			score, err := svc.ScoreProject(dir, opts...)
+			if err != nil {
+				return fmt.Errorf("scoring failed: %w", err)
+			}
+			if hash, err := gitinfo.New().CommitHash(dir); err == nil {
+				setCommit(score, hash)
+			}
+
+			if err := renderScore(cmd, score, &f); err != nil {
+				return err
+			}
+			if err := nf.send(score); err != nil {
+				return err
+			}
+			if f.ciMode && score.Overall < f.minScore {
+				return fmt.Errorf("score %d is below minimum %d", score.Overall, f.minScore)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&src.ref, "ref", "", "Branch, tag or commit to analyze (default: the remote's default branch)")
+	cmd.Flags().StringVar(&src.archive, "archive", "", "Score a .tar, .tar.gz or .zip archive instead of a repository (- reads stdin)")
+	cmd.Flags().StringVar(&src.filesFrom, "files-from", "", "Score only the files listed in this file, one per line (- reads stdin)")
+	cmd.Flags().StringVar(&src.root, "root", ".", "Directory the --files-from paths are relative to")
+	cmd.Flags().BoolVar(&f.jsonOutput, "json", false, "Output score as JSON (shorthand for --format json)")
+	cmd.Flags().StringVar(&f.format, "format", "text", "Output format: text, json, junit; list shows every format")
+	cmd.Flags().StringVar(&f.profile, "profile", "", "Calibration preset: strict, default, legacy-friendly (overrides the config's calibration)")
+	cmd.Flags().StringVar(&f.penalty, "penalty-model", "", "Penalty model preset: sonar, lenient, strict (overrides the config's penalty_model)")
+	cmd.Flags().StringVar(&f.lang, "lang", "en", "Language of issue messages and report labels: en, es, de (message IDs stay stable)")
+	cmd.Flags().StringVar(&f.minSeverity, "min-severity", "", "Only report issues at or above this severity: error, warning, info")
+	cmd.Flags().IntVar(&f.maxIssues, "max-issues", 0, "Report at most N issues overall, most severe first (0 = unlimited)")
+	cmd.Flags().BoolVar(&f.ciMode, "ci", false, "CI mode: exit 1 if below --min")
+	cmd.Flags().IntVar(&f.minScore, "min", 0, "Minimum score for CI mode")
+	cmd.Flags().BoolVar(&f.strictParse, "strict-parse", false, "Fail if any Go file cannot be parsed instead of scoring the files that can")
+	nf.register(cmd)
+
+	flagValues(cmd, "format", formatNames()...)
+	flagValues(cmd, "profile", domain.ValidCalibrations...)
+	flagValues(cmd, "penalty-model", domain.ValidPenaltyModels...)
+	flagValues(cmd, "lang", i18n.Languages...)
+	flagValues(cmd, "min-severity", domain.SeverityError, domain.SeverityWarning, domain.SeverityInfo)
+
+	_ = cmd.MarkFlagFilename("archive", "tar", "tgz", "gz", "zip")
+
+	return cmd
+}
+
+// analyzeSource is where the analyze command gets the project from: a
+// remote repository argument, an archive or a file list.
+type analyzeSource struct {
+	ref       string
+	archive   string
+	filesFrom string
+	root      string
+}
+
+// name describes the source for report provenance.
+func (s *analyzeSource) name(args []string) string {
+	switch {
+	case s.archive == "-":
+		return "archive:stdin"
+	case s.archive != "":
+		return "archive:" + s.archive
+	case s.filesFrom != "":
+		return "files-from:" + s.filesFrom
+	}
+	return args[0]
+}
+
+// open materializes the project in a temporary directory and returns it
+// with a cleanup function.
+func (s *analyzeSource) open(cmd *cobra.Command, args []string) (string, func() error, error) {
+	sources := 0
+	for _, set := range []bool{len(args) > 0, s.archive != "", s.filesFrom != ""} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return "", nil, fmt.Errorf("specify exactly one of a repository, --archive or --files-from")
+	}
+
+	switch {
+	case s.archive != "":
+		return workspace.ExtractFile(s.archive, cmd.InOrStdin())
+	case s.filesFrom != "":
+		list := cmd.InOrStdin()
+		if s.filesFrom != "-" {
+			f, err := os.Open(s.filesFrom)
+			if err != nil {
+				return "", nil, fmt.Errorf("opening file list: %w", err)
+			}
+			defer f.Close()
+			list = f
+		}
+		return workspace.Stage(s.root, list)
+	}
+
+	url, ref, ok := gitinfo.ParseRemote(args[0])
+	if !ok {
+		return "", nil, fmt.Errorf("%q is not a remote repository (use score for local paths)", args[0])
+	}
+	if s.ref != "" {
+		ref = s.ref
+	}
+	return gitinfo.New().Clone(url, ref)
+}
@@ -0,0 +1,136 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestAnalyzeCommand_RemoteRepository(t *testing.T) {
+	remote := writeGateProject(t, "")
+	for _, args := range [][]string{
+		{"init"},
+		{"-c", "user.email=test@test.com", "-c", "user.name=Test", "add", "."},
+		{"-c", "user.email=test@test.com", "-c", "user.name=Test", "commit", "-m", "init"},
+		{"tag", "v1"},
+	} {
+		c := exec.Command("git", args...)
+		c.Dir = remote
+		out, err := c.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"analyze", "file://" + remote + "@v1", "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var score domain.Score
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &score))
+	assert.NotEmpty(t, score.Categories)
+	assert.NotEmpty(t, score.CommitHash)
+}
+
+func TestAnalyzeCommand_RejectsLocalPath(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetArgs([]string{"analyze", "./internal"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a remote repository")
+}
+
+func TestAnalyzeCommand_ArchiveFromStdin(t *testing.T) {
+	project := writeGateProject(t, "")
+	archive := exec.Command("tar", "-czf", "-", "-C", project, ".")
+	data, err := archive.Output()
+	require.NoError(t, err)
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetIn(bytes.NewReader(data))
+	cmd.SetArgs([]string{"analyze", "--archive", "-", "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var score domain.Score
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &score))
+	assert.NotEmpty(t, score.Categories)
+}
+
+func TestAnalyzeCommand_FilesFromStdin(t *testing.T) {
+	project := writeGateProject(t, "")
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetIn(strings.NewReader("go.mod\nmain.go\n"))
+	cmd.SetArgs([]string{"analyze", "--files-from", "-", "--root", project, "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var score domain.Score
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &score))
+	assert.NotEmpty(t, score.Categories)
+}
+
+func TestAnalyzeCommand_RequiresOneSource(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetArgs([]string{"analyze", "github.com/org/repo", "--archive", "repo.tar.gz"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exactly one of")
+}
+
+func TestAnalyzeCommand_NotifiesWebhookAgainstBaseline(t *testing.T) {
+	var got domain.RunSummary
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+	baseline := filepath.Join(t.TempDir(), "last.json")
+	require.NoError(t, os.WriteFile(baseline, []byte(`{"overall": 100}`), 0644))
+
+	project := writeGateProject(t, "")
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetIn(strings.NewReader("go.mod\nmain.go\n"))
+	cmd.SetArgs([]string{"analyze", "--files-from", "-", "--root", project, "--json",
+		"--notify", srv.URL + "/hook", "--baseline", baseline})
+	require.NoError(t, cmd.Execute())
+
+	var score domain.Score
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &score))
+	assert.True(t, got.HasBaseline)
+	assert.Equal(t, score.Overall, got.Overall)
+	assert.Equal(t, score.Overall-100, got.Delta)
+}
+
+func TestAnalyzeCommand_BaselineNeedsWebhook(t *testing.T) {
+	t.Setenv("OPENKRAFT_NOTIFY_WEBHOOK", "")
+	project := writeGateProject(t, "")
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetIn(strings.NewReader("go.mod\nmain.go\n"))
+	cmd.SetArgs([]string{"analyze", "--files-from", "-", "--root", project, "--baseline", "last.json"})
+	assert.ErrorContains(t, cmd.Execute(), "need a webhook")
+}
+
+func TestAnalyzeCommand_InvalidMinSeverity(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs([]string{"analyze", "--files-from", "-", "--min-severity", "critical"})
+	assert.ErrorContains(t, cmd.Execute(), "unknown severity")
+}
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/application"
+)
+
+func newAPIDiffCmd() *cobra.Command {
+	var (
+		format         string
+		projectPath    string
+		failOnBreaking bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "api-diff <baseline-ref|dir> [target-ref|dir]",
+		Short: "Report exported API changes between two versions",
+		Long: `Compare the exported functions, methods, structs and interfaces of the
+importable packages of two versions and report breaking changes (removed
+symbols, changed signatures, changed interface method sets) and additions.
+Test files, main packages and internal/ packages are not part of the API.
+
+The target defaults to the working tree at --path. Arguments are resolved
+like compare's: an existing directory is used in place, anything else is
+checked out as a git ref. With --fail-on-breaking the command exits 1 when
+there are breaking changes, for gating releases.`,
+		Example: `  openkraft api-diff v1.4.0
+  openkraft api-diff v1.4.0 HEAD --fail-on-breaking
+  openkraft api-diff main feature --format json`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != "text" && format != "json" {
+				return fmt.Errorf("unknown format %q (supported: text, json)", format)
+			}
+
+			absProject, err := filepath.Abs(projectPath)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+			targetArg := absProject
+			if len(args) == 2 {
+				targetArg = args[1]
+			}
+
+			baseline, cleanupBase, err := resolveCompareSide(absProject, args[0])
+			if err != nil {
+				return err
+			}
+			defer cleanupBase()
+			target, cleanupTarget, err := resolveCompareSide(absProject, targetArg)
+			if err != nil {
+				return err
+			}
+			defer cleanupTarget()
+
+			svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New(), parser.LanguageAnalyzers()...)
+			diff, err := svc.APIDiff(baseline, target)
+			if err != nil {
+				return fmt.Errorf("api diff failed: %w", err)
+			}
+			diff.Baseline = args[0]
+			diff.Target = "working tree"
+			if len(args) == 2 {
+				diff.Target = args[1]
+			}
+
+			if format == "json" {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(diff); err != nil {
+					return err
+				}
+			} else {
+				fmt.Fprint(cmd.OutOrStdout(), tui.RenderAPIDiff(diff))
+			}
+
+			if n := len(diff.Breaking()); failOnBreaking && n > 0 {
+				return fmt.Errorf("%d breaking API changes", n)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "text", "Output format: text, json")
+	cmd.Flags().StringVar(&projectPath, "path", ".", "Project path inside the git repository, used when comparing refs")
+	cmd.Flags().BoolVar(&failOnBreaking, "fail-on-breaking", false, "Exit 1 when the target breaks the baseline API")
+
+	flagValues(cmd, "format", "text", "json")
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func writeLibrary(t *testing.T, src string) string {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/lib\n\ngo 1.24\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "lib.go"), []byte(src), 0644))
+	return dir
+}
+
+func TestAPIDiffCommand_JSON(t *testing.T) {
+	base := writeLibrary(t, "package lib\n\nfunc Parse(s string) int { return 0 }\n\nfunc Format(n int) string { return \"\" }\n")
+	target := writeLibrary(t, "package lib\n\nfunc Parse(s string, strict bool) int { return 0 }\n\nfunc Render(n int) string { return \"\" }\n")
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"api-diff", base, target, "--format", "json"})
+	require.NoError(t, cmd.Execute())
+
+	var d domain.APIDiff
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &d))
+	assert.Equal(t, base, d.Baseline)
+	require.Len(t, d.Breaking(), 2)
+	assert.Equal(t, "Format", d.Breaking()[0].Symbol().Key())
+	assert.Equal(t, domain.APIRemoved, d.Breaking()[0].Kind)
+	assert.Equal(t, "func Parse(string, bool) int", d.Breaking()[1].After.Signature)
+	require.Len(t, d.Changes, 3)
+	assert.Equal(t, domain.APIAdded, d.Changes[2].Kind)
+}
+
+func TestAPIDiffCommand_FailOnBreaking(t *testing.T) {
+	base := writeLibrary(t, "package lib\n\nfunc Parse(s string) int { return 0 }\n")
+	target := writeLibrary(t, "package lib\n")
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"api-diff", base, target, "--fail-on-breaking"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 breaking API changes")
+	assert.Contains(t, buf.String(), "func Parse(string) int")
+}
+
+func TestAPIDiffCommand_CompatibleChangePasses(t *testing.T) {
+	base := writeLibrary(t, "package lib\n\nfunc Parse(s string) int { return 0 }\n")
+	target := writeLibrary(t, "package lib\n\nfunc Parse(s string) int { return 0 }\n\nfunc Must(s string) int { return 0 }\n")
+
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"api-diff", base, target, "--fail-on-breaking"})
+	require.NoError(t, cmd.Execute())
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func newBadgeCmd() *cobra.Command {
+	var (
+		format      string
+		output      string
+		profile     string
+		resultCache string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "badge [path]",
+		Short: "Generate an SVG badge or shields.io endpoint file with the score",
+		Long: `Score the project and emit a badge showing the overall score and grade.
+
+  --format svg       self-contained SVG image (default)
+  --format endpoint  shields.io endpoint JSON; publish it and use
+                     https://img.shields.io/endpoint?url=<raw-url-of-file>
+
+Regenerate the file in CI to keep the README badge live. With --result-cache
+the badge reuses the score an earlier "score --result-cache" stage stored
+for the same commit.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != "svg" && format != "endpoint" {
+				return fmt.Errorf("unknown format %q (supported: svg, endpoint)", format)
+			}
+			opts := []application.ScoreOption{provenanceOption("")}
+			if profile != "" {
+				if err := domain.ValidateCalibration(profile); err != nil {
+					return err
+				}
+				opts = append(opts, application.WithCalibration(profile))
+			}
+
+			path := "."
+			if len(args) > 0 {
+				path = args[0]
+			}
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New(), parser.LanguageAnalyzers()...)
+			score, err := scoreThroughCache(cmd, svc, absPath, resultCache, opts)
+			if err != nil {
+				return fmt.Errorf("scoring failed: %w", err)
+			}
+
+			out := report.RenderBadgeSVG(score)
+			if format == "endpoint" {
+				if out, err = report.RenderBadgeEndpoint(score); err != nil {
+					return err
+				}
+			}
+
+			if output == "" {
+				_, err := cmd.OutOrStdout().Write(out)
+				return err
+			}
+			if err := os.WriteFile(output, out, 0644); err != nil {
+				return fmt.Errorf("writing badge: %w", err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Wrote %s (%d/100 %s)\n", output, score.Overall, score.Grade())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "svg", "Badge format: svg, endpoint")
+	cmd.Flags().StringVar(&profile, "profile", "", "Calibration preset: strict, default, legacy-friendly")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Write the badge to this file instead of stdout")
+	cmd.Flags().StringVar(&resultCache, "result-cache", "", resultCacheUsage)
+
+	flagValues(cmd, "format", "svg", "endpoint")
+	flagValues(cmd, "profile", domain.ValidCalibrations...)
+
+	return cmd
+}
@@ -0,0 +1,42 @@
+package cli_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+)
+
+func TestBadgeCommand_SVGToStdout(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"badge", fixtureDir})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), "<svg")
+}
+
+func TestBadgeCommand_EndpointToFile(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "openkraft.json")
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"badge", fixtureDir, "--format", "endpoint", "-o", out})
+	require.NoError(t, cmd.Execute())
+
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"schemaVersion": 1`)
+	assert.Contains(t, buf.String(), "Wrote")
+}
+
+func TestBadgeCommand_UnknownFormat(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetArgs([]string{"badge", fixtureDir, "--format", "png"})
+	require.Error(t, cmd.Execute())
+}
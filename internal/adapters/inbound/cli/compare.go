@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/gitinfo"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/application"
+)
+
+func newCompareCmd() *cobra.Command {
+	var (
+		format      string
+		projectPath string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "compare <baseline-ref|dir> <target-ref|dir>",
+		Short: "Compare scores between two git refs or directories",
+		Long: `Score a baseline and a target and report the score deltas, new and resolved
+issues, and the sub-metrics whose score or detail changed. Arguments naming an
+existing directory are scored in place; anything else is treated as a git ref
+of the repository at --path and checked out into a temporary git worktree.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != "text" && format != "markdown" && format != "json" {
+				return fmt.Errorf("unknown format %q (supported: text, markdown, json)", format)
+			}
+
+			absProject, err := filepath.Abs(projectPath)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			baseline, cleanupBase, err := resolveCompareSide(absProject, args[0])
+			if err != nil {
+				return err
+			}
+			defer cleanupBase()
+			target, cleanupTarget, err := resolveCompareSide(absProject, args[1])
+			if err != nil {
+				return err
+			}
+			defer cleanupTarget()
+
+			svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New(), parser.LanguageAnalyzers()...)
+			comparison, err := svc.Compare(baseline, target)
+			if err != nil {
+				return fmt.Errorf("compare failed: %w", err)
+			}
+			comparison.Baseline = args[0]
+			comparison.Target = args[1]
+
+			switch format {
+			case "json":
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(comparison)
+			case "markdown":
+				_, err := cmd.OutOrStdout().Write(report.RenderComparisonMarkdown(comparison))
+				return err
+			default:
+				fmt.Fprint(cmd.OutOrStdout(), tui.RenderComparison(comparison))
+				return nil
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "text", "Output format: text, markdown, json")
+	cmd.Flags().StringVar(&projectPath, "path", ".", "Project path inside the git repository, used when comparing refs")
+
+	flagValues(cmd, "format", "text", "markdown", "json")
+
+	return cmd
+}
+
+// resolveCompareSide maps a compare argument to a directory to score. An
+// existing directory is used as-is; otherwise arg is checked out as a git
+// ref. The returned cleanup is always non-nil.
+func resolveCompareSide(projectPath, arg string) (string, func(), error) {
+	if info, err := os.Stat(arg); err == nil && info.IsDir() {
+		abs, err := filepath.Abs(arg)
+		if err != nil {
+			return "", func() {}, fmt.Errorf("resolving path: %w", err)
+		}
+		return abs, func() {}, nil
+	}
+
+	dir, cleanup, err := gitinfo.New().Worktree(projectPath, arg)
+	if err != nil {
+		return "", func() {}, fmt.Errorf("%q is neither a directory nor a usable git ref: %w", arg, err)
+	}
+	return dir, func() { _ = cleanup() }, nil
+}
@@ -0,0 +1,52 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestCompareCommand_Directories(t *testing.T) {
+	base := writeGateProject(t, "")
+	target := writeGateProject(t, "")
+	long := "package main\n\nfunc sprawling(a, b, c, d, e, f int) {}\n"
+	require.NoError(t, os.WriteFile(filepath.Join(target, "extra.go"), []byte(long), 0644))
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"compare", base, target, "--format", "json"})
+	require.NoError(t, cmd.Execute())
+
+	var c domain.Comparison
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &c))
+	assert.Equal(t, base, c.Baseline)
+	assert.NotEmpty(t, c.Categories)
+	assert.NotEmpty(t, c.NewIssues)
+}
+
+func TestCompareCommand_Markdown(t *testing.T) {
+	dir := writeGateProject(t, "")
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"compare", dir, dir, "--format", "markdown"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), "| Category | Before | After |")
+}
+
+func TestCompareCommand_UnknownRef(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetArgs([]string{"compare", "definitely-not-a-ref", fixtureDir, "--path", t.TempDir()})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "neither a directory nor a usable git ref")
+}
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// configReport is the --json output of config validate.
+type configReport struct {
+	Valid    bool                   `json:"valid"`
+	Problems []domain.ConfigProblem `json:"problems"`
+	Profile  resolvedProfile        `json:"profile"`
+}
+
+func newConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Configuration commands",
+		Long:  "Commands for checking .openkraft.yaml and the configuration scoring resolves it to.",
+	}
+	cmd.AddCommand(newConfigValidateCmd())
+	return cmd
+}
+
+// configValidateFlags holds the flags of config validate.
+type configValidateFlags struct {
+	jsonOutput  bool
+	calibration string
+	penalty     string
+}
+
+func newConfigValidateCmd() *cobra.Command {
+	var f configValidateFlags
+
+	cmd := &cobra.Command{
+		Use:   "validate [path]",
+		Short: "Check .openkraft.yaml and print the resolved profile",
+		Long: `Check .openkraft.yaml for unknown keys, values of the wrong type, invalid
+values and conflicting settings such as weights that do not sum to 1, then
+print the fully resolved profile as YAML. Problems go to stderr, the profile
+to stdout. --profile and --penalty-model apply as they do for score.
+Exits non-zero when any problem is an error.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: f.run,
+	}
+
+	cmd.Flags().BoolVar(&f.jsonOutput, "json", false, "Output problems and the resolved profile as JSON")
+	cmd.Flags().StringVar(&f.calibration, "profile", "", "Calibration preset: strict, default, legacy-friendly (overrides the config's calibration)")
+	cmd.Flags().StringVar(&f.penalty, "penalty-model", "", "Penalty model preset: sonar, lenient, strict (overrides the config's penalty_model)")
+	flagValues(cmd, "profile", domain.ValidCalibrations...)
+	flagValues(cmd, "penalty-model", domain.ValidPenaltyModels...)
+
+	return cmd
+}
+
+// run checks the config, applies the preset flags and reports the result.
+func (f *configValidateFlags) run(cmd *cobra.Command, args []string) error {
+	absPath, err := pathArg(args)
+	if err != nil {
+		return err
+	}
+	if err := validatePresetFlags(f.calibration, f.penalty); err != nil {
+		return err
+	}
+
+	cfg, problems, err := config.New().Check(absPath)
+	if err != nil {
+		return fmt.Errorf("reading config: %w", err)
+	}
+	if f.calibration != "" {
+		cfg.Calibration = f.calibration
+	}
+	if f.penalty != "" {
+		cfg.PenaltyModel = f.penalty
+	}
+
+	errorCount := 0
+	for _, p := range problems {
+		if p.Severity == domain.SeverityError {
+			errorCount++
+		}
+	}
+	report := configReport{Valid: errorCount == 0, Problems: problems, Profile: resolveProfile(cfg)}
+
+	if f.jsonOutput {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		err = enc.Encode(report)
+	} else {
+		printConfigProblems(cmd.ErrOrStderr(), problems)
+		err = writeYAML(cmd.OutOrStdout(), report.Profile)
+	}
+	if err != nil {
+		return err
+	}
+	if errorCount > 0 {
+		return fmt.Errorf(".openkraft.yaml has %d error(s)", errorCount)
+	}
+	return nil
+}
+
+// validatePresetFlags rejects --profile and --penalty-model values that
+// are not presets.
+func validatePresetFlags(calibration, penalty string) error {
+	if calibration != "" {
+		if err := domain.ValidateCalibration(calibration); err != nil {
+			return err
+		}
+	}
+	if penalty != "" {
+		if err := domain.ValidatePenaltyModel(penalty); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printConfigProblems writes one line per problem, or a confirmation that
+// there are none.
+func printConfigProblems(w io.Writer, problems []domain.ConfigProblem) {
+	if len(problems) == 0 {
+		fmt.Fprintln(w, ".openkraft.yaml is valid")
+		return
+	}
+	for _, p := range problems {
+		if p.Line > 0 {
+			fmt.Fprintf(w, "%s: line %d: %s\n", p.Severity, p.Line, p.Message)
+			continue
+		}
+		fmt.Fprintf(w, "%s: %s\n", p.Severity, p.Message)
+	}
+}
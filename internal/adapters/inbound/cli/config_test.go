@@ -0,0 +1,59 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+)
+
+func runConfigValidate(t *testing.T, yaml string, args ...string) (stdout, stderr string, err error) {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".openkraft.yaml"), []byte(yaml), 0o644))
+
+	cmd := cli.NewRootCmdForTest()
+	out, errOut := new(bytes.Buffer), new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(errOut)
+	cmd.SetArgs(append([]string{"config", "validate", dir}, args...))
+	err = cmd.Execute()
+	return out.String(), errOut.String(), err
+}
+
+func TestConfigValidateCommand_Valid(t *testing.T) {
+	stdout, stderr, err := runConfigValidate(t, "extends: legacy\n", "--penalty-model", "sonar")
+	require.NoError(t, err)
+	assert.Contains(t, stderr, ".openkraft.yaml is valid")
+	assert.Contains(t, stdout, "calibration: legacy-friendly")
+	assert.Contains(t, stdout, "penalty_model: sonar", "flags override the config")
+}
+
+func TestConfigValidateCommand_Errors(t *testing.T) {
+	_, stderr, err := runConfigValidate(t, "gates:\n  min_overal: 80\n")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 error(s)")
+	assert.Contains(t, stderr, `error: line 2: unknown key "min_overal" (did you mean "min_overall"?)`)
+}
+
+func TestConfigValidateCommand_JSON(t *testing.T) {
+	stdout, _, err := runConfigValidate(t, "weights:\n  verifiability: 0.3\n", "--json")
+	require.NoError(t, err)
+
+	var report struct {
+		Valid    bool `json:"valid"`
+		Problems []struct {
+			Severity string `json:"severity"`
+		} `json:"problems"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &report))
+	assert.True(t, report.Valid)
+	require.Len(t, report.Problems, 1)
+	assert.Equal(t, "warning", report.Problems[0].Severity)
+}
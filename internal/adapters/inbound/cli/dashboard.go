@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func newTUICmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "tui [path]",
+		Short: "Explore scores, issues and the package graph interactively",
+		Long: `Open an interactive dashboard with the category scores, a navigable issue
+list with a source preview, and the package graph. Press enter on an issue to
+open its file at the reported line in $VISUAL or $EDITOR.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := "."
+			if len(args) > 0 {
+				path = args[0]
+			}
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New(), parser.LanguageAnalyzers()...)
+			data, err := svc.AnalyzeProject(absPath)
+			if err != nil {
+				return fmt.Errorf("analysis failed: %w", err)
+			}
+			score := svc.ScoreWithData(data.Config, data.Profile, data.Scan, data.Modules, data.Analyzed)
+
+			var graph string
+			if g := scoring.BuildImportGraph(data.Scan.ModulePath, data.Analyzed); g != nil {
+				graph = tui.RenderGraph(g, score.Display, &data.Profile)
+			}
+
+			return tui.RunDashboard(tui.DashboardData{Root: absPath, Score: score.Display.Apply(score), Graph: graph})
+		},
+	}
+}
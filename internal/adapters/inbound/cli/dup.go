@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func newDupCmd() *cobra.Command {
+	var (
+		q          scoring.CloneQuery
+		limit      int
+		show       bool
+		jsonOutput bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "dup [path]",
+		Short: "List duplicated code as clone pairs and clusters",
+		Long: `List the cross-file clones behind the code_duplication sub-metric: code
+with the same token structure once identifiers and literals are ignored.
+Each clone shows the file:line range of every copy, its length in tokens
+and how similar the copies are; copies differing in a few tokens are
+reported as one clone below 100%. Overlapping pairs form clusters.
+
+--min-tokens defaults to the profile's min_clone_tokens. --only keeps
+clones with a copy under a path glob, where dir/** matches a subtree; it
+can be repeated. --show prints the first two copies side by side.`,
+		Example: `  openkraft dup
+  openkraft dup --min-tokens 80 --only 'internal/adapters/**'
+  openkraft dup --show --limit 5
+  openkraft dup ./service --json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := "."
+			if len(args) > 0 {
+				path = args[0]
+			}
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+			clones, err := svc.Clones(absPath, q)
+			if err != nil {
+				return fmt.Errorf("finding clones failed: %w", err)
+			}
+			if limit > 0 {
+				clones = clones[:min(limit, len(clones))]
+			}
+
+			if jsonOutput {
+				if clones == nil {
+					clones = []scoring.Clone{}
+				}
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(clones)
+			}
+			var excerpt func(scoring.CloneFragment) []string
+			if show {
+				excerpt = fragmentExcerpt(absPath)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), tui.RenderClones(clones, excerpt))
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&q.MinTokens, "min-tokens", 0, "Shortest clone to report, in tokens (0 = profile's min_clone_tokens)")
+	cmd.Flags().StringArrayVar(&q.Paths, "only", nil, "Only clones with a copy matching this path glob (repeatable)")
+	cmd.Flags().IntVar(&limit, "limit", 20, "Show at most N clones (0 = unlimited)")
+	cmd.Flags().BoolVar(&show, "show", false, "Print the first two copies of each clone side by side")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output clones as JSON")
+
+	return cmd
+}
+
+// fragmentExcerpt returns a reader of the source lines of a fragment of a
+// project rooted at root; unreadable files yield no lines.
+func fragmentExcerpt(root string) func(scoring.CloneFragment) []string {
+	files := make(map[string][]string)
+	return func(f scoring.CloneFragment) []string {
+		lines, ok := files[f.File]
+		if !ok {
+			src, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(f.File)))
+			if err == nil {
+				lines = strings.Split(string(src), "\n")
+			}
+			files[f.File] = lines
+		}
+		if f.StartLine < 1 || f.EndLine > len(lines) {
+			return nil
+		}
+		return lines[f.StartLine-1 : f.EndLine]
+	}
+}
@@ -0,0 +1,84 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+const dupBody = `
+func Sum(values []int) int {
+	total := 0
+	for _, v := range values {
+		if v > 0 {
+			total += v
+		} else {
+			total -= v
+		}
+	}
+	return total
+}
+`
+
+func writeDupProject(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":      "module example.com/dup\n\ngo 1.24\n",
+		"a/a.go":      "package a\n" + dupBody,
+		"b/b.go":      "package b\n\nvar _ = 1\n" + strings.ReplaceAll(dupBody, "values", "items"),
+		"c/unique.go": "package c\n\nfunc One() int { return 1 }\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	return dir
+}
+
+func TestDupCommand_JSON(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"dup", writeDupProject(t), "--min-tokens", "30", "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var clones []scoring.Clone
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &clones))
+	require.Len(t, clones, 1)
+	assert.Equal(t, []scoring.CloneFragment{
+		{File: "a/a.go", StartLine: 3, EndLine: 13},
+		{File: "b/b.go", StartLine: 5, EndLine: 15},
+	}, clones[0].Fragments)
+}
+
+func TestDupCommand_OnlyFiltersPaths(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"dup", writeDupProject(t), "--min-tokens", "30", "--only", "c/**", "--json"})
+	require.NoError(t, cmd.Execute())
+	assert.JSONEq(t, "[]", buf.String())
+}
+
+func TestDupCommand_ShowPrintsExcerpts(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"dup", writeDupProject(t), "--min-tokens", "30", "--show"})
+	require.NoError(t, cmd.Execute())
+
+	out := buf.String()
+	assert.Contains(t, out, "a/a.go:3-13")
+	assert.Contains(t, out, "func Sum(values []int) int {")
+	assert.Contains(t, out, "func Sum(items []int) int {")
+}
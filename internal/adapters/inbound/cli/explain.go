@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func newExplainCmd() *cobra.Command {
+	var (
+		jsonOutput bool
+		limit      int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "explain <category>[.<sub_metric>] [path]",
+		Short: "Explain how a category or sub-metric score was computed",
+		Long: `Print the exact formula inputs behind a score on the current project:
+per-function decay credits, counted issues and their severity weights, and
+the penalty computation. Example: openkraft explain code_health.function_size`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			category, subMetric, _ := strings.Cut(args[0], ".")
+
+			path := "."
+			if len(args) > 1 {
+				path = args[1]
+			}
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New(), parser.LanguageAnalyzers()...)
+			exp, err := svc.Explain(absPath, category)
+			if err != nil {
+				return fmt.Errorf("explain failed: %w", err)
+			}
+
+			if subMetric != "" {
+				if err := narrowToSubMetric(exp, subMetric); err != nil {
+					return err
+				}
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(exp)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), tui.RenderExplanation(exp, limit))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output explanation as JSON")
+	cmd.Flags().IntVar(&limit, "limit", 20, "Maximum items listed per sub-metric (0 for all)")
+
+	return cmd
+}
+
+// narrowToSubMetric keeps only the requested sub-metric. The penalty is
+// category-wide, so it is kept for context.
+func narrowToSubMetric(exp *domain.Explanation, name string) error {
+	for _, sm := range exp.SubMetrics {
+		if sm.Name == name {
+			exp.SubMetrics = []domain.SubMetricExplanation{sm}
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown sub-metric %q in category %q", name, exp.Category)
+}
@@ -0,0 +1,41 @@
+package cli_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+)
+
+func TestExplainCommand_Category(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"explain", "code_health", fixtureDir})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), "function_size")
+	assert.Contains(t, buf.String(), "penalty")
+	assert.Contains(t, buf.String(), "debt ratio")
+}
+
+func TestExplainCommand_SubMetricJSON(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"explain", "code_health.file_size", fixtureDir, "--json"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), `"name": "file_size"`)
+	assert.NotContains(t, buf.String(), `"name": "function_size"`)
+}
+
+func TestExplainCommand_UnknownSubMetric(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"explain", "code_health.nope", fixtureDir})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown sub-metric")
+}
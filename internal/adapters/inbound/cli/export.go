@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tracker"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func newExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Issue tracker export commands",
+		Long: `Commands that file the issues with the largest estimated score impact as
+tickets in an issue tracker. Each ticket carries the issue's remediation,
+its estimated score impact and its fingerprint; tickets are labeled
+openkraft, and an issue whose fingerprint an open or closed ticket already
+carries is not filed again.`,
+	}
+	cmd.AddCommand(newExportGitHubCmd())
+	cmd.AddCommand(newExportJiraCmd())
+	return cmd
+}
+
+// exportFlags holds the flags shared by the export subcommands.
+type exportFlags struct {
+	top        int
+	category   string
+	dryRun     bool
+	jsonOutput bool
+}
+
+func (f *exportFlags) register(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&f.top, "top", 10, "Export the N issues with the largest estimated score impact (0 for all)")
+	cmd.Flags().StringVar(&f.category, "category", "", "Export only the issues of this category")
+	cmd.Flags().BoolVar(&f.dryRun, "dry-run", false, "List the tickets that would be filed without filing them")
+	cmd.Flags().BoolVar(&f.jsonOutput, "json", false, "Output the exported tickets as JSON")
+	flagValues(cmd, "category", domain.ValidCategories...)
+}
+
+func newExportGitHubCmd() *cobra.Command {
+	var (
+		f    exportFlags
+		repo string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "github [path]",
+		Short: "File the top issues as GitHub issues",
+		Long: `File the top issues as GitHub issues in --repo. The token comes from
+GITHUB_TOKEN or GH_TOKEN and needs permission to read and create issues;
+GITHUB_API_URL points at a GitHub Enterprise Server API.`,
+		Example: `  openkraft export github --repo acme/shop --top 5
+  openkraft export github --repo acme/shop --category code_health --dry-run`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t, err := tracker.NewGitHubTracker(repo, os.Getenv)
+			if err != nil {
+				return err
+			}
+			return f.export(cmd, args, t, "GitHub "+repo)
+		},
+	}
+
+	f.register(cmd)
+	cmd.Flags().StringVar(&repo, "repo", "", "Repository to file issues in, as owner/name")
+	_ = cmd.MarkFlagRequired("repo")
+
+	return cmd
+}
+
+func newExportJiraCmd() *cobra.Command {
+	var (
+		f       exportFlags
+		project tracker.JiraProject
+	)
+
+	cmd := &cobra.Command{
+		Use:   "jira [path]",
+		Short: "File the top issues as Jira issues",
+		Long: `File the top issues as Jira issues in --project. The site comes from
+--jira-url or JIRA_URL. Jira Cloud authenticates with JIRA_EMAIL and
+JIRA_API_TOKEN, Jira Data Center with a personal access token in JIRA_TOKEN.`,
+		Example: `  openkraft export jira --project SHOP --jira-url https://acme.atlassian.net
+  openkraft export jira --project SHOP --issue-type Bug --top 3`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t, err := tracker.NewJiraTracker(project, os.Getenv)
+			if err != nil {
+				return err
+			}
+			return f.export(cmd, args, t, "Jira "+project.Key)
+		},
+	}
+
+	f.register(cmd)
+	cmd.Flags().StringVar(&project.URL, "jira-url", "", "Jira site URL (default $JIRA_URL)")
+	cmd.Flags().StringVar(&project.Key, "project", "", "Key of the Jira project to file issues in")
+	cmd.Flags().StringVar(&project.IssueType, "issue-type", "Task", "Jira issue type of the tickets")
+	_ = cmd.MarkFlagRequired("project")
+
+	return cmd
+}
+
+// export scores the project at the path argument and files its top issues
+// in t, named name in the output.
+func (f *exportFlags) export(cmd *cobra.Command, args []string, t domain.IssueTracker, name string) error {
+	path := "."
+	if len(args) > 0 {
+		path = args[0]
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("resolving path: %w", err)
+	}
+
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New(), parser.LanguageAnalyzers()...)
+	score, err := svc.ScoreProject(absPath)
+	if err != nil {
+		return fmt.Errorf("scoring failed: %w", err)
+	}
+	tickets, err := application.ExportTickets(score, t, domain.TicketOptions{Top: f.top, Category: f.category}, f.dryRun)
+	if err != nil {
+		return fmt.Errorf("export failed: %w", err)
+	}
+
+	if f.jsonOutput {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(tickets)
+	}
+	fmt.Fprint(cmd.OutOrStdout(), tui.RenderTicketExport(tickets, name))
+	return nil
+}
@@ -0,0 +1,58 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestExportGitHubCommand_DryRunFilesNothing(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.CopyFS(dir, os.DirFS("../../../../testdata/go-hexagonal/inconsistent")))
+	posts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			posts++
+		}
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer srv.Close()
+	t.Setenv("GITHUB_API_URL", srv.URL)
+	t.Setenv("GITHUB_TOKEN", "secret")
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"export", "github", dir, "--repo", "acme/shop", "--top", "3", "--dry-run", "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var tickets []domain.ExportedTicket
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &tickets))
+	require.NotEmpty(t, tickets)
+	assert.LessOrEqual(t, len(tickets), 3)
+	for _, tk := range tickets {
+		assert.Equal(t, "planned", tk.Status)
+		assert.Equal(t, tk.Fingerprint, domain.TicketFingerprint(tk.Body))
+	}
+	assert.Zero(t, posts)
+}
+
+func TestExportGitHubCommand_NeedsToken(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv("GH_TOKEN", "")
+
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs([]string{"export", "github", "--repo", "acme/shop"})
+	assert.ErrorContains(t, cmd.Execute(), "GITHUB_TOKEN")
+}
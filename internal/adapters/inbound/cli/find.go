@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func newFindCmd() *cobra.Command {
+	var (
+		projectPath string
+		q           scoring.SymbolQuery
+		jsonOutput  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "find <symbol|pattern>",
+		Short: "Search functions, methods, structs and interfaces",
+		Long: `Look up declarations in the project's symbol index, the way an agent
+navigating the code would. The pattern is matched fuzzily against symbol
+names: exact names rank first, then prefixes, substrings and subsequences
+("scoreproj" or the initials "SP" find ScoreProject). Methods are indexed as
+Type.Method and also match by their bare name; * and ? make the pattern a
+glob. Results can be filtered by kind, exported-ness and the architectural
+role or layer of their package.`,
+		Example: `  openkraft find ScoreProject
+  openkraft find 'New*' --kind func --layer application
+  openkraft find repo --role adapter --exported --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if q.Kind != "" && !slices.Contains(symbolKinds, q.Kind) {
+				return fmt.Errorf("unknown kind %q (valid: func, method, struct, interface)", q.Kind)
+			}
+			absPath, err := filepath.Abs(projectPath)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+			data, err := svc.AnalyzeProject(absPath)
+			if err != nil {
+				return fmt.Errorf("analysis failed: %w", err)
+			}
+
+			q.Pattern = args[0]
+			index := scoring.BuildSymbolIndex(data.Scan.ModulePath, &data.Profile, data.Analyzed)
+			symbols := scoring.FindSymbols(index, q)
+
+			if jsonOutput {
+				if symbols == nil {
+					symbols = []scoring.Symbol{}
+				}
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(symbols)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), tui.RenderSymbols(q.Pattern, symbols))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&projectPath, "path", ".", "Project to search")
+	cmd.Flags().StringVar(&q.Kind, "kind", "", "Only symbols of this kind: func, method, struct, interface")
+	cmd.Flags().StringVar(&q.Role, "role", "", "Only symbols in packages with this role: core, ports, adapter, orchestrator, entry point")
+	cmd.Flags().StringVar(&q.Layer, "layer", "", "Only symbols in this layer: domain, application, adapters")
+	cmd.Flags().BoolVar(&q.ExportedOnly, "exported", false, "Only exported symbols")
+	cmd.Flags().IntVar(&q.Limit, "limit", 20, "Show at most N results (0 = unlimited)")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output results as JSON")
+
+	flagValues(cmd, "kind", symbolKinds...)
+	flagValues(cmd, "role", "core", "ports", "adapter", "orchestrator", "entry point")
+	flagValues(cmd, "layer", "domain", "application", "adapters")
+
+	return cmd
+}
+
+var symbolKinds = []string{domain.SymbolFunc, domain.SymbolMethod, domain.SymbolStruct, domain.SymbolInterface}
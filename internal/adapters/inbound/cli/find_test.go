@@ -0,0 +1,44 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func TestFindCommand_JSON(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"find", "main", "--path", writeGateProject(t, ""), "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var symbols []scoring.Symbol
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &symbols))
+	require.NotEmpty(t, symbols)
+	assert.Equal(t, "main", symbols[0].Name)
+	assert.Equal(t, "main.go", symbols[0].File)
+}
+
+func TestFindCommand_TextNoMatches(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"find", "DoesNotExist", "--path", writeGateProject(t, "")})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), "no symbols found")
+}
+
+func TestFindCommand_UnknownKind(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetArgs([]string{"find", "x", "--kind", "variable"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown kind")
+}
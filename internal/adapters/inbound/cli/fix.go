@@ -43,7 +43,7 @@ func newFixCmd() *cobra.Command {
 			par := parser.New()
 			cfg := config.New()
 
-			scoreSvc := application.NewScoreService(sc, det, par, cfg)
+			scoreSvc := application.NewScoreService(sc, det, par, cfg, parser.LanguageAnalyzers()...)
 			onboardSvc := application.NewOnboardService(sc, det, par, cfg)
 			fixSvc := application.NewFixService(scoreSvc, onboardSvc)
 
@@ -69,5 +69,7 @@ func newFixCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&autoOnly, "auto-only", false, "Only apply safe auto-fixes")
 	cmd.Flags().StringVar(&category, "category", "", "Fix only a specific category")
 
+	flagValues(cmd, "category", domain.ValidCategories...)
+
 	return cmd
 }
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/render"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// builtinRenderers returns a registry holding the formats openkraft ships.
+// The renderers live in the report and tui adapters, so they are wired
+// here rather than in the render adapter. Text labels are translated by
+// label, the --lang catalog's Label.
+func builtinRenderers(label func(string) string) *render.Registry {
+	r := render.NewRegistry()
+	for _, f := range builtinFormats(label) {
+		f.Source = render.SourceBuiltin
+		_ = r.Register(f) // built-in names are distinct
+	}
+	return r
+}
+
+func builtinFormats(label func(string) string) []render.Format {
+	return []render.Format{
+		{
+			Name:        "text",
+			Description: "Terminal scorecard",
+			Score: render.ScoreFunc(func(w io.Writer, score *domain.Score) error {
+				_, err := io.WriteString(w, tui.RenderScoreIn(score.Display.Apply(score), label))
+				return err
+			}),
+			Monorepo: render.MonorepoFunc(func(w io.Writer, m *domain.MonorepoScore) error {
+				_, err := io.WriteString(w, tui.RenderMonorepo(m))
+				return err
+			}),
+		},
+		{
+			Name:        "json",
+			Description: "Full result as JSON (see score --print-schema)",
+			Score:       render.ScoreFunc(func(w io.Writer, score *domain.Score) error { return writeJSON(w, score) }),
+			Monorepo:    render.MonorepoFunc(func(w io.Writer, m *domain.MonorepoScore) error { return writeJSON(w, m) }),
+		},
+		{
+			Name:        "junit",
+			Description: "JUnit XML, one test suite per category, for CI test reports",
+			Score: render.ScoreFunc(func(w io.Writer, score *domain.Score) error {
+				return writeBytes(w, "junit", func() ([]byte, error) { return report.RenderJUnit(score) })
+			}),
+		},
+		{
+			Name:        "html",
+			Description: "Standalone HTML comparison table of the projects of a recursive run",
+			Monorepo: render.MonorepoFunc(func(w io.Writer, m *domain.MonorepoScore) error {
+				return writeBytes(w, "html", func() ([]byte, error) { return report.RenderMonorepoHTML(m) })
+			}),
+		},
+		{
+			Name:        "csv",
+			Description: "One row per issue as CSV, for spreadsheets (see score --file-metrics)",
+			Score:       issuesTable("csv", ','),
+		},
+		{
+			Name:        "tsv",
+			Description: "One row per issue as tab-separated values",
+			Score:       issuesTable("tsv", '\t'),
+		},
+	}
+}
+
+// issuesTable renders the issues of a score with fields separated by comma.
+func issuesTable(format string, comma rune) render.ScoreFunc {
+	return func(w io.Writer, score *domain.Score) error {
+		return writeBytes(w, format, func() ([]byte, error) { return report.RenderIssuesCSV(score, comma) })
+	}
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// writeBytes writes the output of a report renderer, naming the format in
+// its error.
+func writeBytes(w io.Writer, format string, render func() ([]byte, error)) error {
+	out, err := render()
+	if err != nil {
+		return fmt.Errorf("rendering %s: %w", format, err)
+	}
+	_, err = w.Write(out)
+	return err
+}
@@ -9,21 +9,26 @@ import (
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
 	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain"
 	"github.com/abdidvp/openkraft/internal/domain/scoring"
 	"github.com/spf13/cobra"
 )
 
 func newGraphCmd() *cobra.Command {
-	var jsonOutput bool
+	var jsonOutput, calls bool
 
 	cmd := &cobra.Command{
 		Use:   "graph [path]",
 		Short: "Visualize the import dependency graph",
-		Long:  "Analyze a Go project's internal import structure and display package metrics, cycles, and coupling outliers.",
-		Args:  cobra.MaximumNArgs(1),
+		Long: `Analyze a Go project's internal import structure and display package metrics, cycles, and coupling outliers.
+
+With --calls, print the function call graph instead: Graphviz DOT by
+default, or per-function fan-in and fan-out with --json.`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			path := "."
 			if len(args) > 0 {
@@ -47,18 +52,29 @@ func newGraphCmd() *cobra.Command {
 				return fmt.Errorf("analysis failed: %w", err)
 			}
 
+			display := domain.NewPathDisplay(data.Scan.ModulePath, data.Config.Display)
+			if calls {
+				callGraph := scoring.BuildCallGraph(data.Scan.ModulePath, data.Analyzed)
+				if jsonOutput {
+					return renderCallGraphJSON(cmd, callGraph, data.Scan.ModulePath)
+				}
+				_, err := cmd.OutOrStdout().Write(report.RenderCallGraphDOT(callGraph, display))
+				return err
+			}
+
 			graph := scoring.BuildImportGraph(data.Scan.ModulePath, data.Analyzed)
 
 			if jsonOutput {
 				return renderGraphJSON(cmd, graph, data)
 			}
 
-			fmt.Fprint(cmd.OutOrStdout(), tui.RenderGraph(graph, data.Scan.ModulePath, &data.Profile))
+			fmt.Fprint(cmd.OutOrStdout(), tui.RenderGraph(graph, display, &data.Profile))
 			return nil
 		},
 	}
 
 	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output graph metrics as JSON")
+	cmd.Flags().BoolVar(&calls, "calls", false, "Output the function call graph (DOT, or JSON with --json)")
 	return cmd
 }
 
@@ -153,3 +169,49 @@ func renderGraphJSON(cmd *cobra.Command, graph *scoring.ImportGraph, data *appli
 	enc.SetIndent("", "  ")
 	return enc.Encode(out)
 }
+
+type callGraphJSONOutput struct {
+	ModulePath      string             `json:"module_path"`
+	Functions       int                `json:"functions"`
+	Edges           int                `json:"edges"`
+	UnresolvedCalls int                `json:"unresolved_calls"`
+	Nodes           []functionNodeJSON `json:"nodes"`
+}
+
+type functionNodeJSON struct {
+	ID       string   `json:"id"`
+	File     string   `json:"file"`
+	Line     int      `json:"line"`
+	Exported bool     `json:"exported"`
+	FanIn    int      `json:"fan_in"`
+	FanOut   int      `json:"fan_out"`
+	Calls    []string `json:"calls"`
+}
+
+func renderCallGraphJSON(cmd *cobra.Command, graph *scoring.CallGraph, modulePath string) error {
+	out := callGraphJSONOutput{ModulePath: modulePath, Nodes: []functionNodeJSON{}}
+	if graph != nil {
+		out.Functions = len(graph.Functions)
+		out.Edges = graph.EdgeCount()
+		out.UnresolvedCalls = graph.Unresolved
+		for _, node := range graph.SortedFunctions() {
+			callees := node.Calls
+			if callees == nil {
+				callees = []string{}
+			}
+			out.Nodes = append(out.Nodes, functionNodeJSON{
+				ID:       node.ID,
+				File:     node.File,
+				Line:     node.Line,
+				Exported: node.Exported,
+				FanIn:    len(node.CalledBy),
+				FanOut:   len(node.Calls),
+				Calls:    callees,
+			})
+		}
+	}
+
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
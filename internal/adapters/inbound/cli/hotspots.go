@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/gitinfo"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/application"
+)
+
+func newHotspotsCmd() *cobra.Command {
+	var (
+		jsonOutput  bool
+		limit       int
+		churnWindow string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "hotspots [path]",
+		Short: "Rank files and functions by how much score they cost",
+		Long: `Rank files and functions by their total negative contribution to the score:
+lost decay credit plus the severity weight of their issues. With --churn-window,
+cost is scaled by git change frequency so frequently edited code ranks first.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := "."
+			if len(args) > 0 {
+				path = args[0]
+			}
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			var churn map[string]int
+			if churnWindow != "" {
+				window, err := parseWindow(churnWindow)
+				if err != nil {
+					return err
+				}
+				churn, err = gitinfo.New().FileChurn(absPath, time.Now().Add(-window))
+				if err != nil {
+					return fmt.Errorf("reading churn: %w", err)
+				}
+			}
+
+			svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New(), parser.LanguageAnalyzers()...)
+			report, err := svc.Hotspots(absPath, churn)
+			if err != nil {
+				return fmt.Errorf("hotspots failed: %w", err)
+			}
+
+			if limit > 0 {
+				report.Files = report.Files[:min(limit, len(report.Files))]
+				report.Functions = report.Functions[:min(limit, len(report.Functions))]
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(report)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), tui.RenderHotspots(report))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output hotspots as JSON")
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum entries per list (0 for all)")
+	cmd.Flags().StringVar(&churnWindow, "churn-window", "", "Weight by git churn over this window (e.g. 90d)")
+
+	return cmd
+}
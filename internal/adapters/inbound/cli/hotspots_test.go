@@ -0,0 +1,32 @@
+package cli_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+)
+
+func TestHotspotsCommand_JSON(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"hotspots", fixtureDir, "--json"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), `"files"`)
+	assert.Contains(t, buf.String(), `"functions"`)
+}
+
+func TestHotspotsCommand_InvalidChurnWindow(t *testing.T) {
+	for _, window := range []string{"soon", "9dw", "7ddd", "d"} {
+		cmd := cli.NewRootCmdForTest()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetArgs([]string{"hotspots", fixtureDir, "--churn-window", window})
+		err := cmd.Execute()
+		require.Error(t, err, window)
+		assert.Contains(t, err.Error(), "invalid window", window)
+	}
+}
@@ -70,6 +70,8 @@ func newInitCmd() *cobra.Command {
 	cmd.Flags().StringVar(&projectType, "type", "api", "Project type (api, cli-tool, library, microservice)")
 	cmd.Flags().BoolVar(&force, "force", false, "Overwrite existing .openkraft.yaml")
 
+	flagValues(cmd, "type", "api", "cli-tool", "library", "microservice")
+
 	return cmd
 }
 
@@ -130,6 +132,30 @@ func generateConfig(pt domain.ProjectType) string {
 #   verifiability: 60
 #   code_health: 50
 
+# calibration: default   # strict | default | legacy-friendly (or: score --profile)
+
+# penalty_model: sonar    # sonar | lenient | strict (or: score --penalty-model)
+
+# grades:           # custom grade bands, best first
+#   - {grade: A, min: 85}
+#   - {grade: B, min: 70}
+#   - {grade: C, min: 55}
+#   - {grade: F, min: 0}
+
+# severity:         # remap issue severities before penalties; first match wins
+#   - {pattern: import-cycle, severity: warning}
+#   - {sub_metric: parameter_count, path: "internal/cgo/**", from: error, severity: info}
+
+# naming:           # regex naming rules for file, struct and interface names
+#   - {name: er-interfaces, kind: interface, pattern: "(er|Port)$"}
+
+# gates:            # enforced by: openkraft score --gate
+#   min_overall: 70
+#   min_category:
+#     code_health: 60
+#   max_issues:
+#     error: 0
+
 ` + profileSection
 
 	return result
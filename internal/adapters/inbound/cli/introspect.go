@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// flagValuesAnnotation stores a flag's accepted values so both shell
+// completion and --print-commands-json can report them.
+const flagValuesAnnotation = "openkraft_values"
+
+// commandSpec describes a command for --print-commands-json.
+type commandSpec struct {
+	Name     string        `json:"name"`
+	Path     string        `json:"path"`
+	Use      string        `json:"use"`
+	Short    string        `json:"short"`
+	Aliases  []string      `json:"aliases,omitempty"`
+	Flags    []flagSpec    `json:"flags,omitempty"`
+	Commands []commandSpec `json:"commands,omitempty"`
+}
+
+// flagSpec describes a flag for --print-commands-json.
+type flagSpec struct {
+	Name       string   `json:"name"`
+	Shorthand  string   `json:"shorthand,omitempty"`
+	Type       string   `json:"type"`
+	Default    string   `json:"default"`
+	Usage      string   `json:"usage"`
+	Persistent bool     `json:"persistent,omitempty"`
+	Values     []string `json:"values,omitempty"`
+}
+
+// flagValues declares the values a string flag accepts. Shells complete
+// them and --print-commands-json lists them.
+func flagValues(cmd *cobra.Command, name string, values ...string) {
+	_ = cmd.Flags().SetAnnotation(name, flagValuesAnnotation, values)
+	_ = cmd.RegisterFlagCompletionFunc(name, cobra.FixedCompletions(values, cobra.ShellCompDirectiveNoFileComp))
+}
+
+// printCommandsJSON writes the command tree rooted at root as JSON.
+func printCommandsJSON(w io.Writer, root *cobra.Command) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(describeCommand(root))
+}
+
+func describeCommand(cmd *cobra.Command) commandSpec {
+	spec := commandSpec{
+		Name:    cmd.Name(),
+		Path:    cmd.CommandPath(),
+		Use:     cmd.Use,
+		Short:   cmd.Short,
+		Aliases: cmd.Aliases,
+	}
+	persistent := cmd.PersistentFlags()
+	cmd.NonInheritedFlags().VisitAll(func(f *pflag.Flag) {
+		if f.Hidden {
+			return
+		}
+		spec.Flags = append(spec.Flags, flagSpec{
+			Name:       f.Name,
+			Shorthand:  f.Shorthand,
+			Type:       f.Value.Type(),
+			Default:    f.DefValue,
+			Usage:      f.Usage,
+			Persistent: persistent.Lookup(f.Name) != nil,
+			Values:     f.Annotations[flagValuesAnnotation],
+		})
+	})
+	for _, sub := range cmd.Commands() {
+		if !sub.IsAvailableCommand() {
+			continue
+		}
+		spec.Commands = append(spec.Commands, describeCommand(sub))
+	}
+	return spec
+}
@@ -0,0 +1,73 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+)
+
+type commandJSON struct {
+	Name  string `json:"name"`
+	Flags []struct {
+		Name   string   `json:"name"`
+		Values []string `json:"values"`
+	} `json:"flags"`
+	Commands []commandJSON `json:"commands"`
+}
+
+func TestPrintCommandsJSON(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"--print-commands-json"})
+	require.NoError(t, cmd.Execute())
+
+	var root commandJSON
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &root))
+	assert.Equal(t, "openkraft", root.Name)
+
+	names := make(map[string]commandJSON)
+	for _, sub := range root.Commands {
+		names[sub.Name] = sub
+	}
+	assert.Contains(t, names, "completion")
+	assert.NotContains(t, names, "__complete", "hidden commands are omitted")
+	require.Contains(t, names, "score")
+
+	var formatValues []string
+	for _, f := range names["score"].Flags {
+		if f.Name == "format" {
+			formatValues = f.Values
+		}
+	}
+	assert.Equal(t, []string{"text", "json", "junit", "html", "csv", "tsv"}, formatValues)
+}
+
+func TestCompletion(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "bash script", args: []string{"completion", "bash"}, want: "__openkraft_"},
+		{name: "zsh script", args: []string{"completion", "zsh"}, want: "#compdef openkraft"},
+		{name: "flag values", args: []string{"__complete", "score", "--format", ""}, want: "junit"},
+		{name: "subcommands", args: []string{"__complete", "sim"}, want: "simulate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := cli.NewRootCmdForTest()
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(new(bytes.Buffer))
+			cmd.SetArgs(tt.args)
+			require.NoError(t, cmd.Execute())
+			assert.Contains(t, buf.String(), tt.want)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"io"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+)
+
+// logFlags holds the logging flags every command inherits from the root.
+type logFlags struct {
+	verbose bool
+	debug   bool
+	json    bool
+}
+
+func (f *logFlags) register(cmd *cobra.Command) {
+	flags := cmd.PersistentFlags()
+	flags.BoolVarP(&f.verbose, "verbose", "v", false, "Log pipeline progress (files scanned, parse failures) to stderr")
+	flags.BoolVar(&f.debug, "debug", false, "Trace every phase, skipped path and scan decision to stderr (implies --verbose)")
+	flags.BoolVar(&f.json, "log-json", false, "Write log records to stderr as JSON lines for machines")
+}
+
+// level maps the flags to the lowest level logged. Without either flag
+// only warnings and errors are logged.
+func (f *logFlags) level() slog.Level {
+	switch {
+	case f.debug:
+		return slog.LevelDebug
+	case f.verbose:
+		return slog.LevelInfo
+	}
+	return slog.LevelWarn
+}
+
+// install makes the logger the flags describe, writing to w, the default
+// logger the scanner and the scoring pipeline log to.
+func (f *logFlags) install(w io.Writer) {
+	opts := &slog.HandlerOptions{Level: f.level()}
+	var handler slog.Handler = slog.NewTextHandler(w, opts)
+	if f.json {
+		handler = slog.NewJSONHandler(w, opts)
+	}
+	slog.SetDefault(slog.New(handler))
+}
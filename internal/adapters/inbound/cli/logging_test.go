@@ -0,0 +1,96 @@
+package cli_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeLoggedProject writes a module with a vendored package and a file
+// that does not parse, both of which a debug log must explain.
+func writeLoggedProject(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":            "module example.com/logged\n\ngo 1.22\n",
+		"main.go":           "package main\n\nfunc main() {}\n",
+		"broken.go":         "package main\n\nfunc broken( {\n",
+		"vendor/dep/dep.go": "package dep\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return dir
+}
+
+func TestLogging_DebugJSONTracesPhasesAndSkips(t *testing.T) {
+	dir := writeLoggedProject(t)
+	cmd := cli.NewRootCmdForTest()
+	stderr := new(bytes.Buffer)
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{"score", dir, "--json", "--debug", "--log-json"})
+	require.NoError(t, cmd.Execute())
+
+	events := make(map[string][]map[string]any)
+	scan := bufio.NewScanner(stderr)
+	for scan.Scan() {
+		var event map[string]any
+		require.NoError(t, json.Unmarshal(scan.Bytes(), &event), "every line is a JSON record: %s", scan.Text())
+		events[event["msg"].(string)] = append(events[event["msg"].(string)], event)
+	}
+
+	require.Len(t, events["skipped path"], 1)
+	assert.Equal(t, "vendor", events["skipped path"][0]["path"])
+	assert.Equal(t, "non-source directory", events["skipped path"][0]["reason"])
+	require.Len(t, events["skipped unparsable file"], 1)
+	assert.Equal(t, "broken.go", events["skipped unparsable file"][0]["file"])
+	assert.NotEmpty(t, events["skipped unparsable file"][0]["err"])
+
+	var phases []any
+	for _, e := range events["phase done"] {
+		phases = append(phases, e["phase"])
+	}
+	assert.Equal(t, []any{"scan", "parse", "score"}, phases)
+	assert.Len(t, events["scored project"], 1)
+}
+
+func TestLogging_Levels(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   []string
+		want    []string
+		notWant []string
+	}{
+		{name: "quiet by default", notWant: []string{"level=INFO", "level=DEBUG"}},
+		{name: "verbose", flags: []string{"--verbose"}, want: []string{`msg="scored project"`}, notWant: []string{"level=DEBUG"}},
+		{name: "debug", flags: []string{"--debug"}, want: []string{`msg="phase done"`, `msg="scored project"`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeLoggedProject(t)
+			cmd := cli.NewRootCmdForTest()
+			stderr := new(bytes.Buffer)
+			cmd.SetOut(new(bytes.Buffer))
+			cmd.SetErr(stderr)
+			cmd.SetArgs(append([]string{"score", dir, "--json"}, tt.flags...))
+			require.NoError(t, cmd.Execute())
+
+			for _, want := range tt.want {
+				assert.Contains(t, stderr.String(), want)
+			}
+			for _, notWant := range tt.notWant {
+				assert.NotContains(t, stderr.String(), notWant)
+			}
+		})
+	}
+}
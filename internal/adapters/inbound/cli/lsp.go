@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	lspadapter "github.com/abdidvp/openkraft/internal/adapters/inbound/lsp"
+	cacheAdapter "github.com/abdidvp/openkraft/internal/adapters/outbound/cache"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/application"
+)
+
+func newLSPCmd() *cobra.Command {
+	var projectPath string
+
+	cmd := &cobra.Command{
+		Use:   "lsp",
+		Short: "Start a Language Server Protocol server (stdio)",
+		Long:  "Serve OpenKraft issues as LSP diagnostics over stdio so editors can show function size, complexity and dependency violations inline. Changed files are re-analyzed incrementally using the validate cache.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			absPath, err := filepath.Abs(projectPath)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			sc := scanner.New()
+			det := detector.New()
+			par := parser.New()
+			cfg := config.New()
+			scoreSvc := application.NewScoreService(sc, det, par, cfg, parser.LanguageAnalyzers()...)
+			validateSvc := application.NewValidateService(sc, det, par, scoreSvc, cacheAdapter.New(), cfg)
+
+			srv := lspadapter.NewServer(validateSvc, absPath)
+			return srv.Serve(cmd.InOrStdin(), cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().StringVar(&projectPath, "path", ".", "Project path (overridden by the client's rootUri)")
+
+	return cmd
+}
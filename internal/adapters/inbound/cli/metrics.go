@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/application"
+)
+
+func newMetricsCmd() *cobra.Command {
+	var (
+		functions bool
+		format    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "metrics [path]",
+		Short: "Dump the raw per-file or per-function metrics",
+		Long: `Dump the raw metrics openkraft measures, one record per Go file: lines,
+functions, highest cognitive complexity, duplicated lines and issue count.
+
+With --functions, dump one record per function instead: lines, parameters,
+results, nesting, conditional operators, cognitive complexity, string-literal
+ratio and switch case arms. Only the parser runs, so nothing is scored.
+
+Records are JSON, CSV or TSV, for building dashboards outside openkraft.`,
+		Example: `  openkraft metrics --format csv > files.csv
+  openkraft metrics --functions --format csv > functions.csv
+  openkraft metrics ./service --functions | jq '.[] | select(.cognitive_complexity > 25)'`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			comma, err := metricsComma(format)
+			if err != nil {
+				return err
+			}
+			path := "."
+			if len(args) > 0 {
+				path = args[0]
+			}
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New(), parser.LanguageAnalyzers()...)
+			records, table, err := measureMetrics(svc, absPath, functions, comma)
+			if err != nil {
+				return err
+			}
+
+			if format == "json" {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(records)
+			}
+			out, err := table()
+			if err != nil {
+				return fmt.Errorf("rendering %s: %w", format, err)
+			}
+			_, err = cmd.OutOrStdout().Write(out)
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&functions, "functions", false, "Dump one record per function instead of per file")
+	cmd.Flags().StringVar(&format, "format", "json", "Output format: json, csv, tsv")
+	flagValues(cmd, "format", "json", "csv", "tsv")
+
+	return cmd
+}
+
+// measureMetrics returns the file or function metrics of the project and
+// a renderer of them as a table separated by comma.
+func measureMetrics(svc *application.ScoreService, absPath string, functions bool, comma rune) (any, func() ([]byte, error), error) {
+	if functions {
+		fns, err := svc.FunctionMetrics(absPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("measuring functions: %w", err)
+		}
+		return fns, func() ([]byte, error) { return report.RenderFunctionMetricsCSV(fns, comma) }, nil
+	}
+	files, err := svc.FileMetrics(absPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("measuring files: %w", err)
+	}
+	return files, func() ([]byte, error) { return report.RenderFileMetricsCSV(files, comma) }, nil
+}
+
+// metricsComma returns the field separator of a table format; json has
+// none.
+func metricsComma(format string) (rune, error) {
+	switch format {
+	case "json", "csv":
+		return ',', nil
+	case "tsv":
+		return '\t', nil
+	}
+	return 0, fmt.Errorf("unknown format %q (supported: json, csv, tsv)", format)
+}
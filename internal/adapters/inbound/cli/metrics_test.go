@@ -0,0 +1,50 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsCommand_FunctionsJSON(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"metrics", fixtureDir, "--functions"})
+	require.NoError(t, cmd.Execute())
+
+	var fns []domain.FunctionMetrics
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &fns))
+	require.NotEmpty(t, fns)
+	assert.NotEmpty(t, fns[0].File)
+	assert.NotEmpty(t, fns[0].Name)
+}
+
+func TestMetricsCommand_FilesCSV(t *testing.T) {
+	cleanupHistory(t, fixtureDir)
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"metrics", fixtureDir, "--format", "csv"})
+	require.NoError(t, cmd.Execute())
+
+	rows, err := csv.NewReader(buf).ReadAll()
+	require.NoError(t, err)
+	require.Greater(t, len(rows), 1)
+	assert.Equal(t, "file", rows[0][0])
+	assert.Equal(t, "max_cognitive_complexity", rows[0][3])
+}
+
+func TestMetricsCommand_UnknownFormat(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetArgs([]string{"metrics", fixtureDir, "--format", "xml"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown format")
+}
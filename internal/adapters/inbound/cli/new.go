@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func newNewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "new",
+		Short: "Generate code that follows the project's conventions",
+		Long:  "Commands that scaffold new code laid out and named like the existing code, so it starts out consistent.",
+	}
+	cmd.AddCommand(newNewModuleCmd())
+	return cmd
+}
+
+func newNewModuleCmd() *cobra.Command {
+	var (
+		projectPath string
+		entity      string
+		dryRun      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "module <name>",
+		Short: "Scaffold a module with domain, application and adapter layers",
+		Long: `Generate the skeleton of a new module: a domain entity with its errors and
+test, application ports and service, and an in-memory repository adapter.
+Files follow the conventions detected in the project: per-feature
+(internal/<name>/domain) or cross-cutting (internal/domain/<name>) layout,
+bare or suffixed file names, the project's layer directory names and, when
+there is a golden module, its file names and HTTP handler and routes.
+Existing files are never overwritten.`,
+		Example: `  openkraft new module billing
+  openkraft new module billing --entity Invoice --dry-run`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			absPath, err := filepath.Abs(projectPath)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+			sc, err := svc.ScaffoldModule(absPath, args[0], entity)
+			if err != nil {
+				return fmt.Errorf("scaffolding failed: %w", err)
+			}
+
+			printScaffoldConventions(cmd, sc)
+			if dryRun {
+				for _, f := range sc.Files {
+					fmt.Fprintf(cmd.OutOrStdout(), "  would create %s\n", f.Path)
+				}
+				return nil
+			}
+			return writeScaffold(cmd, absPath, sc.Files)
+		},
+	}
+
+	cmd.Flags().StringVar(&projectPath, "path", ".", "Project directory")
+	cmd.Flags().StringVar(&entity, "entity", "", "Name of the module's entity type (default: the module name in PascalCase)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "List the files without writing them")
+
+	return cmd
+}
+
+func printScaffoldConventions(cmd *cobra.Command, sc *domain.ModuleScaffold) {
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Module %s (%s layout, %s file names", sc.Module, sc.Layout, sc.Naming)
+	if sc.Golden != "" {
+		fmt.Fprintf(out, ", following %s", sc.Golden)
+	}
+	fmt.Fprintln(out, ")")
+}
+
+// writeScaffold writes the files under root, refusing before writing any
+// if one of them exists.
+func writeScaffold(cmd *cobra.Command, root string, files []domain.ScaffoldFile) error {
+	for _, f := range files {
+		if _, err := os.Stat(filepath.Join(root, filepath.FromSlash(f.Path))); err == nil {
+			return fmt.Errorf("%s already exists", f.Path)
+		}
+	}
+	for _, f := range files {
+		dest := filepath.Join(root, filepath.FromSlash(f.Path))
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			return fmt.Errorf("creating %s: %w", filepath.Dir(f.Path), err)
+		}
+		if err := os.WriteFile(dest, []byte(f.Content), 0644); err != nil {
+			return fmt.Errorf("writing %s: %w", f.Path, err)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "  created %s\n", f.Path)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package cli_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+)
+
+func TestNewModuleCommand_WritesFollowingConventions(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.CopyFS(dir, os.DirFS("../../../../testdata/go-hexagonal/perfect")))
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"new", "module", "billing", "--entity", "Invoice", "--path", dir})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, buf.String(), "per-feature layout, suffixed file names, following internal/tax")
+	assert.FileExists(t, filepath.Join(dir, "internal/billing/application/billing_service.go"))
+	assert.FileExists(t, filepath.Join(dir, "internal/billing/domain/invoice.go"))
+
+	cmd = cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"new", "module", "billing", "--path", dir})
+	assert.ErrorContains(t, cmd.Execute(), "module billing already exists")
+}
+
+func TestNewModuleCommand_DryRunWritesNothing(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/shop\n\ngo 1.24\n"), 0644))
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"new", "module", "orders", "--path", dir, "--dry-run"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, buf.String(), "would create internal/orders/domain/orders.go")
+	assert.NoDirExists(t, filepath.Join(dir, "internal"))
+}
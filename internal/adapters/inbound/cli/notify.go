@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/notify"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// notifyEnv holds the default webhook URL, so the secret stays out of
+// command lines and CI logs.
+const notifyEnv = "OPENKRAFT_NOTIFY_WEBHOOK"
+
+// notifyFlags holds the run notification flags.
+type notifyFlags struct {
+	webhook  string
+	format   string
+	baseline string // score JSON of an earlier run
+}
+
+func (f *notifyFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.webhook, "notify", "", "Post a run summary to this webhook URL (default $"+notifyEnv+")")
+	cmd.Flags().StringVar(&f.format, "notify-format", "", "Webhook payload: slack, teams, json (default: from the webhook host)")
+	cmd.Flags().StringVar(&f.baseline, "baseline", "", "Score JSON of an earlier run to report the score delta and new errors against")
+	flagValues(cmd, "notify-format", notify.Formats...)
+	_ = cmd.MarkFlagFilename("baseline", "json")
+}
+
+// send posts the summary of score to the webhook, when one is configured.
+func (f *notifyFlags) send(score *domain.Score) error {
+	url := f.webhook
+	if url == "" {
+		url = os.Getenv(notifyEnv)
+	}
+	if url == "" {
+		if f.baseline != "" || f.format != "" {
+			return fmt.Errorf("--baseline and --notify-format need a webhook in --notify or $%s", notifyEnv)
+		}
+		return nil
+	}
+	hook, err := notify.NewWebhook(url, f.format)
+	if err != nil {
+		return err
+	}
+	var baseline *domain.Score
+	if f.baseline != "" {
+		data, err := os.ReadFile(f.baseline)
+		if err != nil {
+			return fmt.Errorf("reading baseline: %w", err)
+		}
+		if err := json.Unmarshal(data, &baseline); err != nil {
+			return fmt.Errorf("decoding baseline %s: %w", f.baseline, err)
+		}
+	}
+	if err := hook.Notify(domain.SummarizeRun(score, baseline)); err != nil {
+		return fmt.Errorf("notifying: %w", err)
+	}
+	return nil
+}
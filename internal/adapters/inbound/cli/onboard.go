@@ -78,5 +78,7 @@ func newOnboardCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&force, "force", false, "Overwrite existing CLAUDE.md")
 	cmd.Flags().StringVar(&format, "format", "md", "Output format: md or json")
 
+	flagValues(cmd, "format", "md", "json")
+
 	return cmd
 }
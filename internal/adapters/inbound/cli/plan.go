@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/application"
+)
+
+func newPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Migration planning commands",
+		Long:  "Commands that propose how to move a project to a target architecture, with the score the move is projected to reach.",
+	}
+	cmd.AddCommand(newPlanHexagonalCmd())
+	return cmd
+}
+
+func newPlanHexagonalCmd() *cobra.Command {
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "hexagonal [path]",
+		Short: "Plan the package moves that give a flat project a hexagonal layout",
+		Long: `Classify every package by its role (core, ports, orchestrator, adapter or
+entry point) and propose where it moves: internal/domain, internal/application,
+internal/adapters/inbound or internal/adapters/outbound, or cmd. Packages keep
+their names. The moves are applied to the analyzed project in memory and the
+result scored, so the plan shows the score the migration is expected to
+reach. Packages already in a layer directory and packages without a
+confident role stay where they are.`,
+		Example: `  openkraft plan hexagonal
+  openkraft plan hexagonal ./service --json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := "."
+			if len(args) > 0 {
+				path = args[0]
+			}
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New(), parser.LanguageAnalyzers()...)
+			plan, err := svc.PlanHexagonal(absPath)
+			if err != nil {
+				return fmt.Errorf("planning failed: %w", err)
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(plan)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), tui.RenderHexagonalPlan(plan))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output the plan as JSON")
+
+	return cmd
+}
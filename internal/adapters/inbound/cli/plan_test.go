@@ -0,0 +1,43 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func TestPlanHexagonalCommand_JSON(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":           "module example.com/flat\n\ngo 1.24\n",
+		"model/invoice.go": "package model\n\ntype Invoice struct{ Total int }\n",
+		"handlers/invoice.go": "package handlers\n\nimport (\n\t\"net/http\"\n\n\t\"example.com/flat/model\"\n)\n\n" +
+			"func Serve(w http.ResponseWriter, r *http.Request) { _ = model.Invoice{} }\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"plan", "hexagonal", dir, "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var plan scoring.HexagonalPlan
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &plan))
+	require.Len(t, plan.Moves, 2)
+	assert.Equal(t, "internal/adapters/inbound/handlers", plan.Moves[0].To)
+	assert.Equal(t, "internal/domain/model", plan.Moves[1].To)
+	assert.Greater(t, plan.Projected, plan.Baseline)
+	assert.Equal(t, plan.Projected-plan.Baseline, plan.Delta)
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// resolvedProfile is the configuration scoring runs with once presets,
+// project type defaults, calibration and overrides are applied.
+type resolvedProfile struct {
+	Extends      string             `yaml:"extends,omitempty"       json:"extends,omitempty"`
+	ProjectType  domain.ProjectType `yaml:"project_type,omitempty"  json:"project_type,omitempty"`
+	Calibration  string             `yaml:"calibration,omitempty"   json:"calibration,omitempty"`
+	PenaltyModel string             `yaml:"penalty_model,omitempty" json:"penalty_model,omitempty"`
+	Weights      map[string]float64 `yaml:"weights"                 json:"weights"`
+	Skip         domain.SkipConfig  `yaml:"skip,omitempty"          json:"skip"`
+	Profile      profileSettings    `yaml:"profile"                 json:"profile"`
+}
+
+// profileSettings keeps settings in declaration order in YAML output.
+type profileSettings []domain.ProfileSetting
+
+func (s profileSettings) MarshalYAML() (any, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, st := range s {
+		var value yaml.Node
+		if err := value.Encode(st.Value); err != nil {
+			return nil, fmt.Errorf("encoding %s: %w", st.Key, err)
+		}
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: st.Key}, &value)
+	}
+	return node, nil
+}
+
+func (s profileSettings) MarshalJSON() ([]byte, error) {
+	m := make(map[string]any, len(s))
+	for _, st := range s {
+		m[st.Key] = st.Value
+	}
+	return json.Marshal(m)
+}
+
+func newProfileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Inspect scoring profiles",
+		Long:  "Commands for inspecting the scoring profile a project resolves to from its .openkraft.yaml and the preset it extends.",
+	}
+	cmd.AddCommand(newProfileShowCmd())
+	return cmd
+}
+
+func newProfileShowCmd() *cobra.Command {
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "show [path]",
+		Short: "Print the effective scoring profile",
+		Long: `Print the profile scoring runs with: the preset named by extends, overlaid
+by project_type defaults and the project's own weights, skips and profile
+overrides, with calibration applied to the limits. The output is YAML in the
+shape of .openkraft.yaml.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			absPath, err := pathArg(args)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := config.New().Load(absPath)
+			if err != nil {
+				return fmt.Errorf("loading config: %w", err)
+			}
+			view := resolveProfile(cfg)
+
+			if jsonOutput {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(view)
+			}
+			return writeYAML(cmd.OutOrStdout(), view)
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output the profile as JSON")
+
+	return cmd
+}
+
+// pathArg returns the absolute project path from an optional [path]
+// argument, defaulting to the current directory.
+func pathArg(args []string) (string, error) {
+	path := "."
+	if len(args) > 0 {
+		path = args[0]
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("resolving path: %w", err)
+	}
+	return absPath, nil
+}
+
+// writeYAML writes the resolved profile to w as YAML.
+func writeYAML(w io.Writer, view resolvedProfile) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(view); err != nil {
+		return fmt.Errorf("encoding profile: %w", err)
+	}
+	return enc.Close()
+}
+
+// resolveProfile builds the view of cfg's effective profile.
+func resolveProfile(cfg domain.ProjectConfig) resolvedProfile {
+	return resolvedProfile{
+		Extends:      cfg.Extends,
+		ProjectType:  cfg.ProjectType,
+		Calibration:  cfg.Calibration,
+		PenaltyModel: cfg.PenaltyModel,
+		Weights:      cfg.ResolvedWeights(),
+		Skip:         cfg.Skip,
+		Profile:      domain.ProfileSettings(application.BuildProfile(cfg)),
+	}
+}
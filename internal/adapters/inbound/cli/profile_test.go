@@ -0,0 +1,49 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+)
+
+func TestProfileShowCommand_ResolvesPreset(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".openkraft.yaml"), []byte("extends: strict\nprofile:\n  max_parameters: 6\n"), 0o644))
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"profile", "show", dir})
+	require.NoError(t, cmd.Execute())
+
+	out := buf.String()
+	assert.Contains(t, out, "extends: strict")
+	assert.Contains(t, out, "security_hints: 0.05")
+	assert.Contains(t, out, "max_parameters: 6")
+	assert.Contains(t, out, "max_function_lines: 38", "strict calibration scales the default limit")
+	assert.Less(t, bytes.Index(buf.Bytes(), []byte("expected_layers")), bytes.Index(buf.Bytes(), []byte("safe_sql_builders")))
+}
+
+func TestProfileShowCommand_JSON(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"profile", "show", t.TempDir(), "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var view struct {
+		Weights map[string]float64 `json:"weights"`
+		Profile map[string]any     `json:"profile"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &view))
+	assert.InDelta(t, 0.25, view.Weights["code_health"], 0.001)
+	assert.InDelta(t, 0.0, view.Weights["security_hints"], 0.001)
+	assert.EqualValues(t, 50, view.Profile["max_function_lines"])
+}
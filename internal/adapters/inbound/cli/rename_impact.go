@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/application"
+)
+
+func newRenameImpactCmd() *cobra.Command {
+	var (
+		projectPath string
+		jsonOutput  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "rename-impact <pkg.Symbol>",
+		Short: "List everything a symbol rename would touch",
+		Long: `Estimate the blast radius of renaming a function, method, struct or
+interface before making the change. The symbol is named pkg.Symbol or
+pkg.Type.Method, where pkg is a package name, a module-relative directory or
+an import path; methods of interfaces are accepted too.
+
+The report lists call sites, receivers and type uses, the interface
+bindings the symbol takes part in, and string references the compiler
+cannot check: Go string literals and template, config and SQL files.
+Method calls on values of unknown type are listed as possible calls.
+Public API, broken bindings or many files rate the rename high.`,
+		Example: `  openkraft rename-impact scoring.BuildSymbolIndex
+  openkraft rename-impact application.ScoreService.ScoreProject
+  openkraft rename-impact internal/domain.CodeAnalyzer.AnalyzeFile --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			absPath, err := filepath.Abs(projectPath)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+			impact, err := svc.RenameImpact(absPath, args[0])
+			if err != nil {
+				return fmt.Errorf("rename impact failed: %w", err)
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(impact)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), tui.RenderRenameImpact(impact))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&projectPath, "path", ".", "Project to analyze")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output the impact as JSON")
+
+	return cmd
+}
@@ -0,0 +1,73 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func writeRenameProject(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":            "module example.com/shop\n\ngo 1.24\n",
+		"main.go":           "package main\n\nimport \"example.com/shop/cart\"\n\nfunc main() {\n\tc := cart.New()\n\tc.Total()\n}\n",
+		"cart/cart.go":      "package cart\n\ntype Cart struct{}\n\nfunc New() *Cart { return &Cart{} }\n\nfunc (c *Cart) Total() int { return 0 }\n",
+		"web/cart.tmpl":     "<p>{{ .Total }}</p>\n",
+		"web/unrelated.txt": "Totally unrelated.\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	return dir
+}
+
+func TestRenameImpactCommand_JSON(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"rename-impact", "cart.Cart.Total", "--path", writeRenameProject(t), "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var impact scoring.RenameImpact
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &impact))
+	assert.Equal(t, "Cart.Total", impact.Symbol.Name)
+	require.Len(t, impact.CallSites, 1)
+	assert.Equal(t, scoring.RefPossibleCall, impact.CallSites[0].Kind)
+	assert.Equal(t, "main.go", impact.CallSites[0].File)
+	require.Len(t, impact.Strings, 1)
+	assert.Equal(t, "web/cart.tmpl", impact.Strings[0].File)
+	assert.True(t, impact.Blast.PublicAPI, "cart is importable from outside the module")
+	assert.Equal(t, scoring.BlastHigh, impact.Blast.Level)
+}
+
+func TestRenameImpactCommand_Text(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"rename-impact", "cart.New", "--path", writeRenameProject(t)})
+	require.NoError(t, cmd.Execute())
+
+	out := buf.String()
+	assert.Contains(t, out, "Rename impact of New")
+	assert.Contains(t, out, "Blast radius")
+	assert.Contains(t, out, "main.go:6")
+}
+
+func TestRenameImpactCommand_UnknownSymbol(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"rename-impact", "cart.Missing", "--path", writeRenameProject(t)})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no function, method, struct or interface cart.Missing")
+}
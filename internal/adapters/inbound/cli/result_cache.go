@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/gitinfo"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/resultcache"
+	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// resultCacheUsage is the help text of the --result-cache flag.
+const resultCacheUsage = "Reuse the analysis of this commit stored here by an earlier CI stage, or store it: a directory or s3://bucket/prefix URL"
+
+// scoreThroughCache scores absPath, through the result cache at location
+// when one is given. A checkout with local changes is analyzed without the
+// cache, since its content is not the commit's.
+func scoreThroughCache(cmd *cobra.Command, svc *application.ScoreService, absPath, location string, opts []application.ScoreOption) (*domain.Score, error) {
+	if location == "" {
+		return svc.ScoreProject(absPath, opts...)
+	}
+	gi := gitinfo.New()
+	commit, err := gi.CommitHash(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("--result-cache needs a git checkout: %w", err)
+	}
+	if dirty, err := gi.HasLocalChanges(absPath); err != nil || dirty {
+		fmt.Fprintln(cmd.ErrOrStderr(), "result cache: working tree has local changes, analyzing without the cache")
+		return svc.ScoreProject(absPath, opts...)
+	}
+
+	store, err := resultcache.New(location)
+	if err != nil {
+		return nil, err
+	}
+	score, hit, err := svc.ScoreProjectCached(absPath, commit, store, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if hit {
+		fmt.Fprintf(cmd.ErrOrStderr(), "result cache: reusing the analysis of %.12s\n", commit)
+	}
+	return score, nil
+}
@@ -0,0 +1,62 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestScoreCommand_ResultCacheReusedByLaterStages(t *testing.T) {
+	project := writeGateProject(t, "")
+	for _, args := range [][]string{
+		{"init"},
+		{"-c", "user.email=test@test.com", "-c", "user.name=Test", "add", "."},
+		{"-c", "user.email=test@test.com", "-c", "user.name=Test", "commit", "-m", "init"},
+	} {
+		c := exec.Command("git", args...)
+		c.Dir = project
+		out, err := c.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	store := filepath.Join(t.TempDir(), "results")
+
+	cmd := cli.NewRootCmdForTest()
+	out, errOut := new(bytes.Buffer), new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(errOut)
+	cmd.SetArgs([]string{"score", project, "--json", "--result-cache", store})
+	require.NoError(t, cmd.Execute())
+	assert.NotContains(t, errOut.String(), "reusing")
+	var first domain.Score
+	require.NoError(t, json.Unmarshal(out.Bytes(), &first))
+	entries, err := os.ReadDir(store)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	cmd = cli.NewRootCmdForTest()
+	out, errOut = new(bytes.Buffer), new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(errOut)
+	cmd.SetArgs([]string{"badge", project, "--format", "endpoint", "--result-cache", store})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, errOut.String(), "result cache: reusing the analysis of")
+
+	// Local changes make the checkout differ from the cached commit.
+	require.NoError(t, os.WriteFile(filepath.Join(project, "extra.go"), []byte("package main\n"), 0o644))
+	cmd = cli.NewRootCmdForTest()
+	errOut = new(bytes.Buffer)
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(errOut)
+	cmd.SetArgs([]string{"score", project, "--result-cache", store})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, errOut.String(), "local changes")
+}
@@ -7,7 +7,7 @@ var (
 	commit  = "none"
 )
 
-func newRootCmd() *cobra.Command {
+func newRootCmd(tel *telemetrySession) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "openkraft",
 		Short: "Stop shipping 80% code",
@@ -15,8 +15,25 @@ func newRootCmd() *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 	}
+	var printCommands bool
+	var logs logFlags
+	logs.register(cmd)
+	cmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		logs.install(cmd.ErrOrStderr())
+		tel.start(cmd)
+	}
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if printCommands {
+			return printCommandsJSON(cmd.OutOrStdout(), cmd)
+		}
+		return cmd.Help()
+	}
+	cmd.Flags().BoolVar(&printCommands, "print-commands-json", false, "Print every command and flag as JSON for wrappers and editor integrations")
+	_ = cmd.Flags().MarkHidden("print-commands-json")
 	cmd.AddCommand(newVersionCmd())
 	cmd.AddCommand(newScoreCmd())
+	cmd.AddCommand(newScoreFileCmd())
+	cmd.AddCommand(newAnalyzeCmd())
 	cmd.AddCommand(newCheckCmd())
 	cmd.AddCommand(newMCPCmd())
 	cmd.AddCommand(newInitCmd())
@@ -24,14 +41,33 @@ func newRootCmd() *cobra.Command {
 	cmd.AddCommand(newFixCmd())
 	cmd.AddCommand(newValidateCmd())
 	cmd.AddCommand(newGraphCmd())
+	cmd.AddCommand(newFindCmd())
+	cmd.AddCommand(newRenameImpactCmd())
+	cmd.AddCommand(newSplitCmd())
+	cmd.AddCommand(newLSPCmd())
+	cmd.AddCommand(newExplainCmd())
+	cmd.AddCommand(newHotspotsCmd())
+	cmd.AddCommand(newMetricsCmd())
+	cmd.AddCommand(newDupCmd())
+	cmd.AddCommand(newCompareCmd())
+	cmd.AddCommand(newAPIDiffCmd())
+	cmd.AddCommand(newBadgeCmd())
+	cmd.AddCommand(newSimulateCmd())
+	cmd.AddCommand(newPlanCmd())
+	cmd.AddCommand(newNewCmd())
+	cmd.AddCommand(newExportCmd())
+	cmd.AddCommand(newTUICmd())
+	cmd.AddCommand(newProfileCmd())
+	cmd.AddCommand(newConfigCmd())
 	return cmd
 }
 
 // NewRootCmdForTest returns the root command for testing.
 func NewRootCmdForTest() *cobra.Command {
-	return newRootCmd()
+	return newRootCmd(&telemetrySession{})
 }
 
 func Execute() error {
-	return newRootCmd().Execute()
+	var tel telemetrySession
+	return tel.finish(newRootCmd(&tel).Execute())
 }
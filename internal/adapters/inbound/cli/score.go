@@ -3,28 +3,73 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
 	"time"
 
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/buildsize"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/cloneindex"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/codeowners"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/deprecations"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/gitinfo"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/history"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/i18n"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/pprof"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/render"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/typecheck"
 	"github.com/abdidvp/openkraft/internal/application"
 	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
 	"github.com/spf13/cobra"
 )
 
+// scoreFlags holds the flag values of the score command.
+type scoreFlags struct {
+	jsonOutput  bool
+	ciMode      bool
+	minScore    int
+	badge       bool
+	showHistory bool
+	printSchema bool
+	format      string
+	gate        bool
+	churnWindow string
+	pprof       string
+	binarySize  bool
+	deprecated  bool
+	typed       bool
+	groupBy     string
+	recursive   bool
+	profile     string
+	penalty     string
+	lang        string
+	profileSelf bool
+	lowMemory   bool
+	determinism bool
+	minSeverity string
+	maxIssues   int
+	maxPerSub   int
+	source      string // remote repository or archive being scored, for provenance
+	nested      bool   // scan nested Go modules as part of the project
+	submodules  bool   // scan git submodules as part of the project
+	symlinks    bool   // follow symlinked directories leading outside the project
+	resultCache string // result cache location, see resultcache.New
+	strictParse bool
+	fileMetrics string // per-file metrics table output path
+
+	registry *render.Registry // see renderers
+}
+
 func newScoreCmd() *cobra.Command {
 	var (
-		jsonOutput  bool
-		ciMode      bool
-		minScore    int
-		badge       bool
-		showHistory bool
+		f  scoreFlags
+		nf notifyFlags
 	)
 
 	cmd := &cobra.Command{
@@ -33,6 +78,17 @@ func newScoreCmd() *cobra.Command {
 		Long:  "Analyze a Go project and produce a Lighthouse-style AI-readiness score.",
 		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if f.printSchema {
+				_, err := cmd.OutOrStdout().Write(report.ScoreSchema())
+				return err
+			}
+			if f.format == formatList {
+				return f.renderers().WriteList(cmd.OutOrStdout())
+			}
+			if err := f.validate(); err != nil {
+				return err
+			}
+
 			path := "."
 			if len(args) > 0 {
 				path = args[0]
@@ -44,13 +100,33 @@ func newScoreCmd() *cobra.Command {
 			}
 
 			svc := application.NewScoreService(
-				scanner.New(),
+				f.scanner(),
 				detector.New(),
 				parser.New(),
 				config.New(),
+				parser.LanguageAnalyzers()...,
 			)
 
-			score, err := svc.ScoreProject(absPath)
+			if f.recursive {
+				if nf.webhook != "" || nf.baseline != "" {
+					return fmt.Errorf("--notify and --baseline cannot be combined with --recursive")
+				}
+				return scoreRecursive(cmd, svc, absPath, &f)
+			}
+
+			opts, err := f.scoreOptions(absPath)
+			if err != nil {
+				return err
+			}
+
+			if f.determinism {
+				if err := svc.VerifyDeterminism(absPath, opts...); err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.ErrOrStderr(), "determinism check passed: two runs produced identical scores")
+			}
+
+			score, err := scoreThroughCache(cmd, svc, absPath, f.resultCache, opts)
 			if err != nil {
 				return fmt.Errorf("scoring failed: %w", err)
 			}
@@ -58,21 +134,25 @@ func newScoreCmd() *cobra.Command {
 			// Attach git commit hash if available
 			gi := gitinfo.New()
 			if hash, err := gi.CommitHash(absPath); err == nil {
-				score.CommitHash = hash
+				setCommit(score, hash)
 			}
 
 			// Save to history
 			hist := history.New()
 			entry := domain.ScoreEntry{
-				Timestamp:  time.Now().Format(time.RFC3339),
-				CommitHash: score.CommitHash,
-				Overall:    score.Overall,
-				Grade:      score.Grade(),
+				Timestamp:    time.Now().Format(time.RFC3339),
+				CommitHash:   score.CommitHash,
+				Overall:      score.Overall,
+				Grade:        score.Grade(),
+				Fingerprints: domain.IssueFingerprints(score),
+			}
+			if previous, err := hist.Load(absPath); err == nil {
+				entry.TrackIssues(previous)
 			}
 			_ = hist.Save(absPath, entry) // best-effort
 
 			// Show history if requested
-			if showHistory {
+			if f.showHistory {
 				entries, err := hist.Load(absPath)
 				if err != nil {
 					return fmt.Errorf("loading history: %w", err)
@@ -81,36 +161,375 @@ func newScoreCmd() *cobra.Command {
 				return nil
 			}
 
-			switch {
-			case jsonOutput:
-				return renderJSON(cmd, score)
-			case badge:
+			if f.badge && f.format == "text" && f.groupBy == "" {
 				return renderBadge(cmd, score)
-			default:
-				fmt.Fprint(cmd.OutOrStdout(), tui.RenderScore(score))
+			}
+			if err := renderScoreProfiled(cmd, score, &f); err != nil {
+				return err
+			}
+			if f.fileMetrics != "" {
+				if err := writeFileMetrics(f.fileMetrics, score, f.format); err != nil {
+					return err
+				}
+			}
+			if err := nf.send(score); err != nil {
+				return err
+			}
+
+			if f.ciMode && score.Overall < f.minScore {
+				return fmt.Errorf("score %d is below minimum %d", score.Overall, f.minScore)
 			}
 
-			if ciMode && score.Overall < minScore {
-				return fmt.Errorf("score %d is below minimum %d", score.Overall, minScore)
+			if f.gate {
+				return checkGates(cmd, absPath, score)
 			}
 
 			return nil
 		},
 	}
 
-	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output score as JSON")
-	cmd.Flags().BoolVar(&ciMode, "ci", false, "CI mode: exit 1 if below --min")
-	cmd.Flags().IntVar(&minScore, "min", 0, "Minimum score for CI mode")
-	cmd.Flags().BoolVar(&badge, "badge", false, "Output shields.io badge URL")
-	cmd.Flags().BoolVar(&showHistory, "history", false, "Show score history")
+	cmd.Flags().BoolVar(&f.jsonOutput, "json", false, "Output score as JSON (shorthand for --format json)")
+	cmd.Flags().StringVar(&f.format, "format", "text", "Output format: text, json, junit, html (html requires --recursive); list shows every format")
+	cmd.Flags().BoolVar(&f.ciMode, "ci", false, "CI mode: exit 1 if below --min")
+	cmd.Flags().IntVar(&f.minScore, "min", 0, "Minimum score for CI mode")
+	cmd.Flags().BoolVar(&f.badge, "badge", false, "Output shields.io badge URL")
+	cmd.Flags().BoolVar(&f.showHistory, "history", false, "Show score history")
+	cmd.Flags().BoolVar(&f.gate, "gate", false, "Exit non-zero if any quality gate from the config's gates section fails")
+	cmd.Flags().StringVar(&f.churnWindow, "churn-window", "", "Weight code_health penalties by git churn over this window (e.g. 90d)")
+	cmd.Flags().StringVar(&f.pprof, "pprof", "", "Raise the severity of code_health issues in functions hot in this pprof CPU profile (e.g. cpu.pb.gz)")
+	cmd.Flags().BoolVar(&f.binarySize, "binary-size", false, "Build every main package and report stripped binary sizes and their largest packages")
+	cmd.Flags().BoolVar(&f.deprecated, "deprecated-deps", false, "Also warn about calls to deprecated functions of direct dependencies (resolved with go list)")
+	cmd.Flags().BoolVar(&f.typed, "typed", false, "Type-check the project with go/packages for exact call and interface resolution (slower)")
+	cmd.Flags().StringVar(&f.groupBy, "group-by", "", "Summarize issue debt per group instead of per category: owner (uses CODEOWNERS)")
+	cmd.Flags().BoolVar(&f.recursive, "recursive", false, "Score every Go module under path and aggregate the results")
+	cmd.Flags().StringVar(&f.profile, "profile", "", "Calibration preset: strict, default, legacy-friendly (overrides the config's calibration)")
+	cmd.Flags().StringVar(&f.penalty, "penalty-model", "", "Penalty model preset: sonar, lenient, strict (overrides the config's penalty_model)")
+	cmd.Flags().StringVar(&f.lang, "lang", "en", "Language of issue messages and report labels: en, es, de (message IDs stay stable)")
+	cmd.Flags().BoolVar(&f.profileSelf, "profile-self", false, "Record openkraft's own phase timings, allocations and file counts in the output")
+	cmd.Flags().BoolVar(&f.lowMemory, "low-memory", false, "Spill duplication token indexes to disk and score file-local sub-metrics as files are parsed (for very large repos)")
+	cmd.Flags().StringVar(&f.minSeverity, "min-severity", "", "Only report issues at or above this severity: error, warning, info")
+	cmd.Flags().IntVar(&f.maxIssues, "max-issues", 0, "Report at most N issues overall, most severe first (0 = unlimited)")
+	cmd.Flags().IntVar(&f.maxPerSub, "max-issues-per-sub-metric", 0, "Report at most N issues per sub-metric, most severe first (0 = unlimited)")
+	cmd.Flags().BoolVar(&f.determinism, "verify-determinism", false, "Score twice and fail if the results differ (ignoring timestamp and self-profile)")
+	cmd.Flags().BoolVar(&f.printSchema, "schema", false, "Print the JSON Schema for --json output and exit")
+	cmd.Flags().BoolVar(&f.nested, "include-nested-modules", false, "Scan directories holding their own go.mod as part of the project (skipped by default)")
+	cmd.Flags().BoolVar(&f.submodules, "include-submodules", false, "Scan git submodule checkouts as part of the project (skipped by default)")
+	cmd.Flags().BoolVar(&f.symlinks, "follow-external-symlinks", false, "Follow symlinked directories that lead outside the project (skipped by default)")
+	cmd.Flags().StringVar(&f.resultCache, "result-cache", "", resultCacheUsage)
+	cmd.Flags().BoolVar(&f.strictParse, "strict-parse", false, "Fail if any Go file cannot be parsed instead of scoring the files that can")
+	cmd.Flags().StringVar(&f.fileMetrics, "file-metrics", "", "Also write per-file lines, functions, max cognitive complexity and duplication to this file (CSV; TSV with --format tsv)")
+	nf.register(cmd)
+
+	flagValues(cmd, "format", formatNames()...)
+	flagValues(cmd, "group-by", "owner")
+	flagValues(cmd, "profile", domain.ValidCalibrations...)
+	flagValues(cmd, "penalty-model", domain.ValidPenaltyModels...)
+	flagValues(cmd, "lang", i18n.Languages...)
+	flagValues(cmd, "min-severity", domain.SeverityError, domain.SeverityWarning, domain.SeverityInfo)
 
 	return cmd
 }
 
-func renderJSON(cmd *cobra.Command, score *domain.Score) error {
-	enc := json.NewEncoder(cmd.OutOrStdout())
-	enc.SetIndent("", "  ")
-	return enc.Encode(score)
+// scanner returns the file scanner configured by the nested-module,
+// submodule and symlink flags.
+func (f *scoreFlags) scanner() *scanner.FileScanner {
+	var opts []scanner.Option
+	if f.nested {
+		opts = append(opts, scanner.WithNestedModules())
+	}
+	if f.submodules {
+		opts = append(opts, scanner.WithSubmodules())
+	}
+	if f.symlinks {
+		opts = append(opts, scanner.WithExternalSymlinks())
+	}
+	return scanner.New(opts...)
+}
+
+// validate normalizes --json into --format and rejects unsupported flag
+// values and combinations before any scanning happens.
+func (f *scoreFlags) validate() error {
+	if f.jsonOutput {
+		f.format = "json"
+	}
+	format, err := f.renderers().Lookup(f.format)
+	if err != nil {
+		return err
+	}
+	if f.groupBy != "" && f.groupBy != "owner" {
+		return fmt.Errorf("unknown --group-by %q (supported: owner)", f.groupBy)
+	}
+	if f.profile != "" {
+		if err := domain.ValidateCalibration(f.profile); err != nil {
+			return err
+		}
+	}
+	if f.penalty != "" {
+		if err := domain.ValidatePenaltyModel(f.penalty); err != nil {
+			return err
+		}
+	}
+	if _, err := f.catalog(); err != nil {
+		return fmt.Errorf("--lang: %w", err)
+	}
+	if err := f.issueFilter().Validate(); err != nil {
+		return fmt.Errorf("issue filter: %w", err)
+	}
+	if f.recursive {
+		if f.gate || f.badge || f.showHistory || f.groupBy != "" || f.churnWindow != "" || f.pprof != "" || f.determinism || f.resultCache != "" || f.fileMetrics != "" {
+			return fmt.Errorf("--recursive cannot be combined with --gate, --badge, --history, --group-by, --churn-window, --pprof, --verify-determinism, --result-cache or --file-metrics")
+		}
+		if !format.Recursive {
+			return fmt.Errorf("%s output is not supported with --recursive", f.format)
+		}
+	} else if !format.Single {
+		return fmt.Errorf("%s output requires --recursive", f.format)
+	}
+	return nil
+}
+
+// scoreOptions translates flags into application score options, reading
+// git churn and CODEOWNERS as needed.
+func (f *scoreFlags) scoreOptions(absPath string) ([]application.ScoreOption, error) {
+	opts := []application.ScoreOption{provenanceOption(f.source)}
+	if f.profile != "" {
+		opts = append(opts, application.WithCalibration(f.profile))
+	}
+	if f.penalty != "" {
+		opts = append(opts, application.WithPenaltyModel(f.penalty))
+	}
+	if f.profileSelf {
+		opts = append(opts, application.WithSelfProfile())
+	}
+	if f.lowMemory {
+		opts = append(opts, application.WithLowMemory(newCloneIndex))
+	}
+	if f.strictParse {
+		opts = append(opts, application.WithStrictParse())
+	}
+	if f.fileMetrics != "" {
+		opts = append(opts, application.WithFileMetrics())
+	}
+	if f.binarySize {
+		opts = append(opts, application.WithBinarySizes(buildsize.New()))
+	}
+	if f.deprecated {
+		opts = append(opts, application.WithDependencyDeprecations(deprecations.New()))
+	}
+	if f.typed {
+		opts = append(opts, application.WithTypeResolver(typecheck.New()))
+	}
+
+	if f.churnWindow != "" {
+		window, err := parseWindow(f.churnWindow)
+		if err != nil {
+			return nil, err
+		}
+		churn, err := gitinfo.New().FileChurn(absPath, time.Now().Add(-window))
+		if err != nil {
+			return nil, fmt.Errorf("reading churn: %w", err)
+		}
+		opts = append(opts, application.WithChurn(f.churnWindow, churn))
+	}
+	if f.pprof != "" {
+		profile, err := pprof.New().Load(f.pprof)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, application.WithCPUProfile(profile))
+	}
+
+	owners, err := codeowners.New().Load(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading CODEOWNERS: %w", err)
+	}
+	if owners != nil {
+		opts = append(opts, application.WithOwners(owners))
+	} else if f.groupBy == "owner" {
+		return nil, fmt.Errorf("--group-by owner requires a CODEOWNERS file")
+	}
+	return opts, nil
+}
+
+func newCloneIndex() (domain.CloneIndex, error) { return cloneindex.New() }
+
+// issueFilter returns the output filter selected by --min-severity and the
+// --max-issues caps.
+func (f *scoreFlags) issueFilter() domain.IssueFilter {
+	return domain.IssueFilter{MinSeverity: f.minSeverity, MaxPerSubMetric: f.maxPerSub, MaxTotal: f.maxIssues}
+}
+
+// catalog returns the message catalog selected by --lang.
+func (f *scoreFlags) catalog() (*i18n.Catalog, error) {
+	if f.lang == "" {
+		return i18n.New("en")
+	}
+	return i18n.New(f.lang)
+}
+
+// renderScore writes the score in the format selected by the flags. Issue
+// filters and translation apply to the rendered copy only; gates see every
+// issue.
+func renderScore(cmd *cobra.Command, score *domain.Score, f *scoreFlags) error {
+	defer application.TraceSpan("render", time.Now(), "format", f.format)
+	catalog, err := f.catalog()
+	if err != nil {
+		return err
+	}
+	score = catalog.TranslateScore(domain.FilterIssues(score, f.issueFilter()))
+	if f.groupBy == "owner" {
+		return renderOwnerDebt(cmd, score, f.format)
+	}
+	format, err := f.renderers().Lookup(f.format)
+	if err != nil {
+		return err
+	}
+	return format.Score.RenderScore(cmd.OutOrStdout(), score)
+}
+
+// renderScoreProfiled renders the score and, with --profile-self, records
+// the render phase. JSON cannot time its own encoding, so it is encoded once
+// to measure the phase before the final write; other formats print the
+// profile to stderr afterwards.
+func renderScoreProfiled(cmd *cobra.Command, score *domain.Score, f *scoreFlags) error {
+	if score.SelfProfile == nil {
+		return renderScore(cmd, score, f)
+	}
+	start := time.Now()
+	if f.format == "json" && f.groupBy == "" {
+		if _, err := json.Marshal(score); err != nil {
+			return fmt.Errorf("encoding score: %w", err)
+		}
+		score.SelfProfile.AddPhase("render", time.Since(start))
+		return renderScore(cmd, score, f)
+	}
+	if err := renderScore(cmd, score, f); err != nil {
+		return err
+	}
+	score.SelfProfile.AddPhase("render", time.Since(start))
+	fmt.Fprint(cmd.ErrOrStderr(), tui.RenderSelfProfile(score.SelfProfile))
+	return nil
+}
+
+// checkGates evaluates the gates section of the project config. The summary
+// goes to stderr so machine-readable stdout stays parseable.
+func checkGates(cmd *cobra.Command, projectPath string, score *domain.Score) error {
+	cfg, err := config.New().Load(projectPath)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+	if cfg.Gates == nil {
+		return fmt.Errorf("--gate requires a gates section in .openkraft.yaml")
+	}
+
+	failures := domain.EvaluateGates(score, cfg.Gates)
+	fmt.Fprint(cmd.ErrOrStderr(), tui.RenderGateResults(failures))
+	if len(failures) > 0 {
+		return fmt.Errorf("%d quality gate(s) failed", len(failures))
+	}
+	return nil
+}
+
+// scoreRecursive scores every Go module under root and prints the aggregate
+// scorecard with a per-project comparison table.
+func scoreRecursive(cmd *cobra.Command, svc *application.ScoreService, root string, f *scoreFlags) error {
+	opts := []application.ScoreOption{provenanceOption(f.source)}
+	if f.profile != "" {
+		opts = append(opts, application.WithCalibration(f.profile))
+	}
+	if f.penalty != "" {
+		opts = append(opts, application.WithPenaltyModel(f.penalty))
+	}
+	if f.profileSelf {
+		opts = append(opts, application.WithSelfProfile())
+	}
+	if f.lowMemory {
+		opts = append(opts, application.WithLowMemory(newCloneIndex))
+	}
+	if f.strictParse {
+		opts = append(opts, application.WithStrictParse())
+	}
+
+	result, err := svc.ScoreProjects(root, opts...)
+	if err != nil {
+		return fmt.Errorf("scoring failed: %w", err)
+	}
+	for i, p := range result.Projects {
+		result.Projects[i].Score = domain.FilterIssues(p.Score, f.issueFilter())
+	}
+
+	format, err := f.renderers().Lookup(f.format)
+	if err != nil {
+		return err
+	}
+	if err := format.Monorepo.RenderMonorepo(cmd.OutOrStdout(), result); err != nil {
+		return err
+	}
+
+	if f.ciMode && result.Overall < f.minScore {
+		return fmt.Errorf("aggregate score %d is below minimum %d", result.Overall, f.minScore)
+	}
+	return nil
+}
+
+// renderOwnerDebt prints the severity-weighted issue debt of each
+// CODEOWNERS owner, as JSON or text.
+func renderOwnerDebt(cmd *cobra.Command, score *domain.Score, format string) error {
+	var issues []domain.Issue
+	for _, cat := range score.Categories {
+		issues = append(issues, cat.Issues...)
+	}
+	owners := scoring.SummarizeByOwner(issues)
+
+	if format == "json" {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(owners)
+	}
+	fmt.Fprint(cmd.OutOrStdout(), tui.RenderOwnerDebt(owners))
+	fmt.Fprint(cmd.OutOrStdout(), tui.RenderSuppressed(score.Suppressed))
+	fmt.Fprint(cmd.OutOrStdout(), tui.RenderMetadata(score.Metadata))
+	return nil
+}
+
+// formatList is the --format value that lists the output formats.
+const formatList = "list"
+
+// renderers returns the output formats: the built-in ones, with text in the
+// --lang language, and the external renderers on PATH. The registry is
+// built once per command.
+func (f *scoreFlags) renderers() *render.Registry {
+	if f.registry == nil {
+		var label func(string) string
+		if catalog, err := f.catalog(); err == nil { // validate reports a bad --lang
+			label = catalog.Label
+		}
+		f.registry = builtinRenderers(label)
+		f.registry.AddExternal(os.Getenv("PATH"))
+	}
+	return f.registry
+}
+
+// formatNames lists the built-in formats, for completion.
+func formatNames() []string {
+	return builtinRenderers(nil).Names()
+}
+
+// writeFileMetrics writes the per-file metrics of score to path as CSV, or
+// as TSV alongside --format tsv.
+func writeFileMetrics(path string, score *domain.Score, format string) error {
+	comma := ','
+	if format == "tsv" {
+		comma = '\t'
+	}
+	out, err := report.RenderFileMetricsCSV(score.FileMetrics, comma)
+	if err != nil {
+		return fmt.Errorf("rendering file metrics: %w", err)
+	}
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		return fmt.Errorf("writing file metrics: %w", err)
+	}
+	return nil
 }
 
 func renderBadge(cmd *cobra.Command, score *domain.Score) error {
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	cacheAdapter "github.com/abdidvp/openkraft/internal/adapters/outbound/cache"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// scoreFileFlags holds the flags of score-file.
+type scoreFileFlags struct {
+	jsonOutput  bool
+	stdin       bool
+	projectPath string
+	failOn      string
+}
+
+func newScoreFileCmd() *cobra.Command {
+	var f scoreFileFlags
+
+	cmd := &cobra.Command{
+		Use:   "score-file <file>",
+		Short: "Score one file's impact on its project",
+		Long: `Score one Go file against its project's profile: the project is scored with
+the file and without it, and every sub-metric the file moves or has issues in
+is listed with its impact. With --stdin the source is read from standard input
+and scored as the content of <file>, which need not exist, so editors can
+score unsaved buffers and hooks can score staged snippets. The project is the
+nearest directory above the file holding a go.mod, unless --path names it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: f.run,
+	}
+
+	cmd.Flags().BoolVar(&f.jsonOutput, "json", false, "Output the file score as JSON")
+	cmd.Flags().BoolVar(&f.stdin, "stdin", false, "Read the file's source from standard input")
+	cmd.Flags().StringVar(&f.projectPath, "path", "", "Project root (defaults to the nearest directory above the file with a go.mod)")
+	cmd.Flags().StringVar(&f.failOn, "fail-on", "", "Exit non-zero if the file has an issue at or above this severity: error, warning, info")
+	flagValues(cmd, "fail-on", domain.SeverityError, domain.SeverityWarning, domain.SeverityInfo)
+
+	return cmd
+}
+
+// run scores the file named by args[0] and reports the result.
+func (f *scoreFileFlags) run(cmd *cobra.Command, args []string) error {
+	if f.failOn != "" {
+		if err := domain.ValidateSeverity(f.failOn); err != nil {
+			return fmt.Errorf("--fail-on: %w", err)
+		}
+	}
+	root, rel, err := f.locate(args[0])
+	if err != nil {
+		return err
+	}
+	var src []byte
+	if f.stdin {
+		if src, err = io.ReadAll(cmd.InOrStdin()); err != nil {
+			return fmt.Errorf("reading stdin: %w", err)
+		}
+	}
+
+	sc, det, par, cfg := scanner.New(), detector.New(), parser.New(), config.New()
+	scoreSvc := application.NewScoreService(sc, det, par, cfg, parser.LanguageAnalyzers()...)
+	svc := application.NewValidateService(sc, det, par, scoreSvc, cacheAdapter.New(), cfg)
+	fs, err := svc.ScoreFile(root, rel, src)
+	if err != nil {
+		return fmt.Errorf("score-file failed: %w", err)
+	}
+
+	if f.jsonOutput {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		err = enc.Encode(fs)
+	} else {
+		_, err = fmt.Fprint(cmd.OutOrStdout(), tui.RenderFileScore(fs))
+	}
+	if err != nil {
+		return err
+	}
+	if n := fs.IssuesAtLeast(f.failOn); f.failOn != "" && n > 0 {
+		return fmt.Errorf("%s has %d issue(s) at or above %s", fs.Path, n, f.failOn)
+	}
+	return nil
+}
+
+// locate returns the project root and the slash-separated path of file
+// relative to it, rejecting files outside the project.
+func (f *scoreFileFlags) locate(file string) (root, rel string, err error) {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return "", "", fmt.Errorf("resolving path: %w", err)
+	}
+	if root, err = f.root(abs); err != nil {
+		return "", "", err
+	}
+	rel, err = filepath.Rel(root, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", "", fmt.Errorf("%s is outside the project %s", file, root)
+	}
+	return root, filepath.ToSlash(rel), nil
+}
+
+// root returns the project root: --path, or the nearest directory above
+// file holding a go.mod, or the working directory.
+func (f *scoreFileFlags) root(file string) (string, error) {
+	if f.projectPath != "" {
+		return filepath.Abs(f.projectPath)
+	}
+	for dir := filepath.Dir(file); ; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		if filepath.Dir(dir) == dir {
+			break
+		}
+	}
+	return filepath.Abs(".")
+}
@@ -0,0 +1,84 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// writeScoreFileProject writes a two-file module and returns its root.
+func writeScoreFileProject(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":           "module example.com/app\n\ngo 1.24\n",
+		"store/store.go":   "package store\n\n// Get returns the value stored under key.\nfunc Get(key string) string { return key }\n",
+		"store/convert.go": "package store\n\n// Convert adds its arguments.\nfunc Convert(a, b, c, d, e, f, g int) int { return a + b + c + d + e + f + g }\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	return dir
+}
+
+func TestScoreFileCommand_JSON(t *testing.T) {
+	dir := writeScoreFileProject(t)
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score-file", filepath.Join(dir, "store", "convert.go"), "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var fs domain.FileScore
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &fs))
+	assert.Equal(t, "store/convert.go", fs.Path)
+	assert.NotEmpty(t, fs.Issues)
+	found := false
+	for _, sm := range fs.SubMetrics {
+		if sm.SubMetric == "parameter_count" {
+			found = true
+			assert.Positive(t, sm.Issues)
+		}
+	}
+	assert.True(t, found, "expected parameter_count among the file's sub-metrics")
+}
+
+func TestScoreFileCommand_StdinAndFailOn(t *testing.T) {
+	dir := writeScoreFileProject(t)
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetIn(strings.NewReader("package store\n\nfunc Wide(a, b, c, d, e, f, g int) int { return a }\n"))
+	cmd.SetArgs([]string{"score-file", filepath.Join(dir, "store", "new.go"), "--stdin", "--fail-on", "warning"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at or above warning")
+	assert.Contains(t, buf.String(), "store/new.go")
+}
+
+func TestScoreFileCommand_RejectsFileOutsideProject(t *testing.T) {
+	dir := writeScoreFileProject(t)
+
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs([]string{"score-file", filepath.Join(t.TempDir(), "x.go"), "--path", dir})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "outside the project")
+}
@@ -2,11 +2,17 @@ package cli_test
 
 import (
 	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/abdidvp/openkraft/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -83,3 +89,458 @@ func TestScoreCommand_History(t *testing.T) {
 	assert.Contains(t, buf.String(), "Score History")
 	assert.Contains(t, buf.String(), "/100")
 }
+
+func TestScoreCommand_JSONIncludesSchemaVersion(t *testing.T) {
+	cleanupHistory(t, fixtureDir)
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", fixtureDir, "--json"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), `"schema_version": "1.1"`)
+}
+
+func TestScoreCommand_JSONIncludesMetadata(t *testing.T) {
+	cleanupHistory(t, fixtureDir)
+	t.Setenv("GITHUB_ACTIONS", "true")
+	t.Setenv("GITHUB_RUN_ID", "42")
+	t.Setenv("GITHUB_SERVER_URL", "https://github.com")
+	t.Setenv("GITHUB_REPOSITORY", "org/repo")
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", fixtureDir, "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var score domain.Score
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &score))
+	meta := score.Metadata
+	require.NotNil(t, meta)
+	assert.Equal(t, "dev", meta.ToolVersion)
+	assert.Equal(t, "example.com/perfect", meta.ModulePath)
+	assert.NotEmpty(t, meta.ProfileHash)
+	assert.Positive(t, meta.Files.Analyzed)
+	require.NotNil(t, meta.Provenance)
+	assert.NotEmpty(t, meta.Provenance.GoVersion)
+	require.NotNil(t, meta.Provenance.CI)
+	assert.Equal(t, "github-actions", meta.Provenance.CI.Provider)
+	assert.Equal(t, "https://github.com/org/repo/actions/runs/42", meta.Provenance.CI.RunURL)
+}
+
+func TestScoreCommand_ProfileSelfJSON(t *testing.T) {
+	cleanupHistory(t, fixtureDir)
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", fixtureDir, "--json", "--profile-self"})
+	require.NoError(t, cmd.Execute())
+
+	var score domain.Score
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &score))
+	require.NotNil(t, score.SelfProfile)
+
+	var phases []string
+	for _, ph := range score.SelfProfile.Phases {
+		phases = append(phases, ph.Name)
+	}
+	assert.Equal(t, []string{"scan", "parse", "score", "render"}, phases)
+	assert.Positive(t, score.SelfProfile.GoFiles)
+	assert.Positive(t, score.SelfProfile.AllocBytes)
+}
+
+func TestScoreCommand_ProfileSelfTextGoesToStderr(t *testing.T) {
+	cleanupHistory(t, fixtureDir)
+	cmd := cli.NewRootCmdForTest()
+	out, errOut := new(bytes.Buffer), new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(errOut)
+	cmd.SetArgs([]string{"score", fixtureDir, "--profile-self"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, errOut.String(), "Self profile")
+	assert.NotContains(t, out.String(), "Self profile")
+}
+
+func TestScoreCommand_VerifyDeterminism(t *testing.T) {
+	cleanupHistory(t, fixtureDir)
+	cmd := cli.NewRootCmdForTest()
+	out, errOut := new(bytes.Buffer), new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(errOut)
+	cmd.SetArgs([]string{"score", fixtureDir, "--json", "--verify-determinism"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, errOut.String(), "determinism check passed")
+	var score domain.Score
+	require.NoError(t, json.Unmarshal(out.Bytes(), &score))
+}
+
+func TestScoreCommand_MinSeverityAndMaxIssues(t *testing.T) {
+	cleanupHistory(t, fixtureDir)
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", fixtureDir, "--json", "--min-severity", "warning", "--max-issues", "1"})
+	require.NoError(t, cmd.Execute())
+
+	var score domain.Score
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &score))
+	var shown int
+	for _, cat := range score.Categories {
+		for _, iss := range cat.Issues {
+			assert.NotEqual(t, domain.SeverityInfo, iss.Severity)
+			shown++
+		}
+	}
+	assert.LessOrEqual(t, shown, 1)
+	require.NotNil(t, score.Suppressed)
+	assert.Positive(t, score.Suppressed.Total)
+}
+
+func TestScoreCommand_InvalidMinSeverity(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs([]string{"score", fixtureDir, "--min-severity", "critical"})
+	assert.ErrorContains(t, cmd.Execute(), "unknown severity")
+}
+
+func TestScoreCommand_NegativeIssueCap(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs([]string{"score", fixtureDir, "--max-issues-per-sub-metric", "-1"})
+	assert.ErrorContains(t, cmd.Execute(), "must not be negative")
+}
+
+func TestScoreCommand_NotifiesWebhookAgainstBaseline(t *testing.T) {
+	var got domain.RunSummary
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+	baseline := filepath.Join(t.TempDir(), "last.json")
+	require.NoError(t, os.WriteFile(baseline, []byte(`{"overall": 100}`), 0644))
+
+	project := writeGateProject(t, "")
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", project, "--json", "--notify", srv.URL + "/hook", "--baseline", baseline})
+	require.NoError(t, cmd.Execute())
+
+	var score domain.Score
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &score))
+	assert.True(t, got.HasBaseline)
+	assert.Equal(t, score.Overall, got.Overall)
+	assert.Equal(t, score.Overall-100, got.Delta)
+}
+
+func TestScoreCommand_PrintSchema(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", "--schema"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), `"$schema"`)
+	assert.Contains(t, buf.String(), `"schema_version"`)
+}
+
+func TestScoreCommand_FormatJUnit(t *testing.T) {
+	cleanupHistory(t, fixtureDir)
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", fixtureDir, "--format", "junit"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), "<?xml")
+	assert.Contains(t, buf.String(), `<testsuites name="openkraft"`)
+	assert.Contains(t, buf.String(), `<testsuite name="code_health"`)
+}
+
+func TestScoreCommand_FormatCSVWithFileMetrics(t *testing.T) {
+	cleanupHistory(t, fixtureDir)
+	metrics := filepath.Join(t.TempDir(), "files.tsv")
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", fixtureDir, "--format", "tsv", "--file-metrics", metrics})
+	require.NoError(t, cmd.Execute())
+	assert.True(t, strings.HasPrefix(buf.String(), "category\tsub_metric\tseverity\tfile\t"))
+
+	data, err := os.ReadFile(metrics)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Equal(t, "file\tlines\tfunctions\tmax_cognitive_complexity\tduplicated_lines\tduplication_percent\tissues\tgenerated", lines[0])
+	assert.Greater(t, len(lines), 1)
+}
+
+func TestScoreCommand_UnknownFormat(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetArgs([]string{"score", fixtureDir, "--format", "yaml"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown format")
+	assert.Contains(t, err.Error(), "text, json, junit, html, csv, tsv")
+}
+
+func TestScoreCommand_FormatList(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", "--format", "list"})
+	require.NoError(t, cmd.Execute())
+	for _, name := range []string{"text", "json", "junit", "html"} {
+		assert.Contains(t, buf.String(), name)
+	}
+	assert.Regexp(t, `html\s+recursive\s+builtin`, buf.String())
+	assert.Regexp(t, `junit\s+score\s+builtin`, buf.String())
+}
+
+func TestScoreCommand_ExternalRenderer(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("external renderer fixture is a shell script")
+	}
+	cleanupHistory(t, fixtureDir)
+	bin := t.TempDir()
+	script := "#!/bin/sh\necho rendered by plugin\ncat >/dev/null\n"
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "openkraft-render-plain"), []byte(script), 0o755))
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", fixtureDir, "--format", "plain"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "rendered by plugin\n", buf.String())
+}
+
+func TestScoreCommand_InvalidChurnWindow(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetArgs([]string{"score", fixtureDir, "--churn-window", "soon"})
+	require.Error(t, cmd.Execute())
+}
+
+func writeGateProject(t *testing.T, gates string) string {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/gated\n\ngo 1.24\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".openkraft.yaml"), []byte(gates), 0644))
+	return dir
+}
+
+func TestScoreCommand_GateFails(t *testing.T) {
+	dir := writeGateProject(t, "gates:\n  min_overall: 100\n")
+	cmd := cli.NewRootCmdForTest()
+	stderr := new(bytes.Buffer)
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{"score", dir, "--json", "--gate"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "quality gate(s) failed")
+	assert.Contains(t, stderr.String(), "min_overall")
+}
+
+func TestScoreCommand_GatePasses(t *testing.T) {
+	dir := writeGateProject(t, "gates:\n  min_overall: 0\n")
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs([]string{"score", dir, "--gate"})
+	assert.NoError(t, cmd.Execute())
+}
+
+func TestScoreCommand_GateWithoutConfig(t *testing.T) {
+	cleanupHistory(t, fixtureDir)
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"score", fixtureDir, "--gate"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gates section")
+}
+
+func TestScoreCommand_GroupByOwner(t *testing.T) {
+	dir := writeGateProject(t, "")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "CODEOWNERS"), []byte("* @org/team\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "big.go"), []byte("package main\n\nfunc big(a, b, c, d, e, f, g int) {}\n"), 0644))
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", dir, "--group-by", "owner", "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var owners []domain.OwnerDebt
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &owners))
+	var names []string
+	for _, od := range owners {
+		names = append(names, od.Owner)
+	}
+	assert.Contains(t, names, "@org/team")
+}
+
+func TestScoreCommand_GroupByOwnerRequiresCodeOwners(t *testing.T) {
+	dir := writeGateProject(t, "")
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"score", dir, "--group-by", "owner"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "CODEOWNERS")
+}
+
+func TestScoreCommand_Recursive(t *testing.T) {
+	dir := writeGateProject(t, "")
+	nested := filepath.Join(dir, "tools")
+	require.NoError(t, os.MkdirAll(nested, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(nested, "go.mod"), []byte("module example.com/tools\n\ngo 1.24\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(nested, "gen.go"), []byte("package tools\n\nfunc Generate() {}\n"), 0644))
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", dir, "--recursive", "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var result domain.MonorepoScore
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+	require.Len(t, result.Projects, 2)
+	assert.Equal(t, "tools", result.Projects[1].Path)
+}
+
+func TestScoreCommand_HTMLRequiresRecursive(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetArgs([]string{"score", fixtureDir, "--format", "html"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--recursive")
+}
+
+func TestScoreCommand_ProfilePreset(t *testing.T) {
+	cleanupHistory(t, fixtureDir)
+	var overall [2]int
+	for i, preset := range []string{"strict", "legacy-friendly"} {
+		cmd := cli.NewRootCmdForTest()
+		buf := new(bytes.Buffer)
+		cmd.SetOut(buf)
+		cmd.SetArgs([]string{"score", fixtureDir, "--json", "--profile", preset})
+		require.NoError(t, cmd.Execute())
+
+		var score domain.Score
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &score))
+		overall[i] = score.Overall
+		assert.Equal(t, preset, score.AppliedConfig.Calibration)
+	}
+	assert.LessOrEqual(t, overall[0], overall[1], "strict never scores above legacy-friendly")
+}
+
+func TestScoreCommand_PenaltyModel(t *testing.T) {
+	cleanupHistory(t, fixtureDir)
+	var overall [2]int
+	for i, preset := range []string{"strict", "lenient"} {
+		cmd := cli.NewRootCmdForTest()
+		buf := new(bytes.Buffer)
+		cmd.SetOut(buf)
+		cmd.SetArgs([]string{"score", fixtureDir, "--json", "--penalty-model", preset})
+		require.NoError(t, cmd.Execute())
+
+		var score domain.Score
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &score))
+		overall[i] = score.Overall
+		assert.Equal(t, preset, score.AppliedConfig.PenaltyModel)
+	}
+	assert.LessOrEqual(t, overall[0], overall[1], "strict never scores above lenient")
+
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetArgs([]string{"score", fixtureDir, "--penalty-model", "harsh"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown penalty model")
+}
+
+func TestScoreCommand_Lang(t *testing.T) {
+	cleanupHistory(t, fixtureDir)
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", fixtureDir, "--lang", "de"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), "KI-Bereitschaftswert")
+
+	cmd = cli.NewRootCmdForTest()
+	cmd.SetArgs([]string{"score", fixtureDir, "--lang", "fr"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported language")
+}
+
+func TestScoreCommand_UnknownProfile(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetArgs([]string{"score", fixtureDir, "--profile", "lenient"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown calibration")
+}
+
+func TestScoreCommand_DisplayStripsModulePrefixInTextOnly(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":          "module example.com/deep/vanity/app\n\ngo 1.24\n",
+		".openkraft.yaml": "display:\n  strip_module_prefix: true\n",
+		"a/a.go":          "package a\n\n// A is a.\nfunc A() int { return 1 }\n",
+		"b/b.go":          "package b\n\n// B is b.\nfunc B() int { return 2 }\n",
+		"main.go":         "package main\n\nimport (\n\t\"example.com/deep/vanity/app/b\"\n\t\"example.com/deep/vanity/app/a\"\n)\n\nfunc main() { _ = a.A() + b.B() }\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", dir})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), "(b before a)")
+
+	cmd = cli.NewRootCmdForTest()
+	buf = new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", dir, "--json"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), "(example.com/deep/vanity/app/b before example.com/deep/vanity/app/a)", "JSON keeps full import paths")
+}
+
+func TestScoreCommand_ParseFailures(t *testing.T) {
+	dir := writeLoggedProject(t)
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", dir})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), "Parse failures")
+	assert.Contains(t, buf.String(), "broken.go:3:")
+	assert.Contains(t, buf.String(), "1 unparsed")
+
+	cmd = cli.NewRootCmdForTest()
+	buf = new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"score", dir, "--json"})
+	require.NoError(t, cmd.Execute())
+	var score domain.Score
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &score))
+	require.Len(t, score.ParseFailures, 1)
+	assert.Equal(t, "broken.go", score.ParseFailures[0].File)
+
+	cmd = cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"score", dir, "--strict-parse"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 Go file could not be parsed")
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/application"
+)
+
+func newSimulateCmd() *cobra.Command {
+	var (
+		planFile   string
+		jsonOutput bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "simulate [path] --plan edits.yaml",
+		Short: "Project the score gain of refactorings before making them",
+		Long: `Apply hypothetical edits from a YAML plan to the analyzed project and
+recompute the score without touching any file. Each edit is scored alone, to
+rank refactors by projected gain, and cumulatively in plan order.
+
+  edits:
+    - split_function: {file: internal/app/run.go, function: Run, parts: 3}
+    - remove_dependency: {from: internal/domain, to: internal/adapters/outbound/scanner}
+    - delete_file: internal/legacy/old.go`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if planFile == "" {
+				return fmt.Errorf("--plan is required")
+			}
+			path := "."
+			if len(args) > 0 {
+				path = args[0]
+			}
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			plan, err := config.LoadSimulationPlan(planFile)
+			if err != nil {
+				return err
+			}
+
+			svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New(), parser.LanguageAnalyzers()...)
+			result, err := svc.Simulate(absPath, plan)
+			if err != nil {
+				return fmt.Errorf("simulation failed: %w", err)
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(result)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), tui.RenderSimulation(result))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&planFile, "plan", "", "YAML file listing the edits to simulate")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output the simulation as JSON")
+	_ = cmd.MarkFlagFilename("plan", "yaml", "yml")
+
+	return cmd
+}
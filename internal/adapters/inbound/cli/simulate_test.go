@@ -0,0 +1,39 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestSimulateCommand_JSON(t *testing.T) {
+	plan := filepath.Join(t.TempDir(), "plan.yaml")
+	require.NoError(t, os.WriteFile(plan, []byte("edits:\n  - delete_file: internal/tax/domain/tax_rule_test.go\n"), 0644))
+
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"simulate", fixtureDir, "--plan", plan, "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var result domain.SimulationResult
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+	require.Len(t, result.Edits, 1)
+	assert.Equal(t, "delete internal/tax/domain/tax_rule_test.go", result.Edits[0].Edit)
+}
+
+func TestSimulateCommand_RequiresPlan(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs([]string{"simulate", fixtureDir})
+	assert.ErrorContains(t, cmd.Execute(), "--plan is required")
+}
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func newSplitCmd() *cobra.Command {
+	var (
+		format  string
+		pkgPath string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "split [path]",
+		Short: "Propose how to split packages that mix responsibilities",
+		Long: `Find packages whose files mix responsibilities and propose a concrete
+split: which files stay and which move into which new package. A package is
+flagged when its files play different roles by name, imports and
+declarations (core types next to HTTP handlers or SQL stores), or when its
+cohesion is low and its files form separate groups. Packages imported by and
+importing many others are called out as hubs. The plan is printed as
+Markdown, ready for an issue or pull request description.`,
+		Example: `  openkraft split
+  openkraft split --package internal/billing
+  openkraft split ./service --format json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != "markdown" && format != "json" {
+				return fmt.Errorf("unknown format %q (supported: markdown, json)", format)
+			}
+			path := "."
+			if len(args) > 0 {
+				path = args[0]
+			}
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+			data, err := svc.AnalyzeProject(absPath)
+			if err != nil {
+				return fmt.Errorf("analysis failed: %w", err)
+			}
+			plans := scoring.AdviseSplits(data.Scan.ModulePath, &data.Profile, data.Analyzed)
+			if pkgPath != "" {
+				plans = filterSplitPlans(plans, strings.Trim(filepath.ToSlash(pkgPath), "/"))
+			}
+
+			if format == "json" {
+				if plans == nil {
+					plans = []scoring.SplitPlan{}
+				}
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(plans)
+			}
+			_, err = cmd.OutOrStdout().Write(report.RenderSplitPlansMarkdown(plans))
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "markdown", "Output format: markdown, json")
+	cmd.Flags().StringVar(&pkgPath, "package", "", "Only plan the package in this module-relative directory")
+
+	flagValues(cmd, "format", "markdown", "json")
+
+	return cmd
+}
+
+func filterSplitPlans(plans []scoring.SplitPlan, pkg string) []scoring.SplitPlan {
+	var out []scoring.SplitPlan
+	for _, p := range plans {
+		if p.Package == pkg {
+			out = append(out, p)
+		}
+	}
+	return out
+}
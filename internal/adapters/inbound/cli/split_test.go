@@ -0,0 +1,57 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/cli"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func writeSplitProject(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":                  "module example.com/shop\n\ngo 1.24\n",
+		"billing/invoice.go":      "package billing\n\ntype Invoice struct{ Total int }\n",
+		"billing/payment.go":      "package billing\n\ntype Payment struct{ Invoice *Invoice }\n",
+		"billing/invoice_http.go": "package billing\n\nimport \"net/http\"\n\nfunc Serve(w http.ResponseWriter, r *http.Request) {}\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	return dir
+}
+
+func TestSplitCommand_Markdown(t *testing.T) {
+	cmd := cli.NewRootCmdForTest()
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"split", writeSplitProject(t)})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, buf.String(), "## `billing`")
+	assert.Contains(t, buf.String(), "| `invoice_http.go` | adapter | `billing/http` |")
+}
+
+func TestSplitCommand_JSONFilteredByPackage(t *testing.T) {
+	dir := writeSplitProject(t)
+	for pkg, want := range map[string]int{"billing": 1, "other": 0} {
+		cmd := cli.NewRootCmdForTest()
+		buf := new(bytes.Buffer)
+		cmd.SetOut(buf)
+		cmd.SetArgs([]string{"split", dir, "--format", "json", "--package", pkg})
+		require.NoError(t, cmd.Execute())
+
+		var plans []scoring.SplitPlan
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &plans))
+		assert.Len(t, plans, want, pkg)
+	}
+}
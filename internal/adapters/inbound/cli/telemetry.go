@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/telemetry"
+	"github.com/abdidvp/openkraft/internal/application"
+)
+
+// telemetrySession traces one command as OpenTelemetry spans when the
+// environment configures an OTLP endpoint. Telemetry never fails a
+// command: problems are logged as warnings.
+type telemetrySession struct {
+	tracer *telemetry.Tracer
+}
+
+// start opens the command's root span and routes the pipeline phases to
+// it.
+func (s *telemetrySession) start(cmd *cobra.Command) {
+	if !telemetry.TracingEnabled(os.Getenv) {
+		return
+	}
+	t, err := telemetry.NewTracer(cmd.CommandPath(), version)
+	if err != nil {
+		slog.Warn("tracing disabled", "error", err)
+		return
+	}
+	s.tracer = t
+	application.SetTracer(t)
+}
+
+// finish ends the root span with the command's outcome, exports the spans
+// and returns err unchanged.
+func (s *telemetrySession) finish(err error) error {
+	if s.tracer == nil {
+		return err
+	}
+	application.SetTracer(nil)
+	if ferr := s.tracer.Shutdown(err); ferr != nil {
+		slog.Warn("exporting traces failed", "error", ferr)
+	}
+	return err
+}
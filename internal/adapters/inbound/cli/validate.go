@@ -46,7 +46,7 @@ func newValidateCmd() *cobra.Command {
 				_ = cacheSt.Invalidate(absPath)
 			}
 
-			scoreSvc := application.NewScoreService(sc, det, par, cfg)
+			scoreSvc := application.NewScoreService(sc, det, par, cfg, parser.LanguageAnalyzers()...)
 			validateSvc := application.NewValidateService(sc, det, par, scoreSvc, cacheSt, cfg)
 
 			var deletedFiles []string
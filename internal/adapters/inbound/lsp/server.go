@@ -0,0 +1,170 @@
+// Package lsp exposes OpenKraft issues as Language Server Protocol diagnostics
+// over stdio, so editors can surface violations inline while files are edited.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/application"
+)
+
+// Server is a minimal LSP server that publishes diagnostics for Go files.
+// Every open, change or save re-analyzes and rescores only that file,
+// against an edit session opened on the validate service's cache.
+type Server struct {
+	validate    *application.ValidateService
+	projectPath string
+	session     *application.EditSession
+	out         io.Writer
+}
+
+// NewServer creates an LSP server rooted at projectPath. The root may be
+// replaced by the rootUri sent by the client during initialize.
+func NewServer(validate *application.ValidateService, projectPath string) *Server {
+	return &Server{validate: validate, projectPath: projectPath}
+}
+
+// Serve reads requests from in and writes responses and notifications to out
+// until the client sends exit or the input stream closes.
+func (s *Server) Serve(in io.Reader, out io.Writer) error {
+	s.out = out
+	r := bufio.NewReader(in)
+	for {
+		msg, err := readMessage(r)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if msg.Method == "exit" {
+			return nil
+		}
+		if err := s.handle(msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(msg *message) error {
+	switch msg.Method {
+	case "initialize":
+		var params initializeParams
+		if err := json.Unmarshal(msg.Params, &params); err == nil && params.RootURI != "" {
+			if root, err := uriToPath(params.RootURI); err == nil {
+				s.projectPath, s.session = root, nil
+			}
+		}
+		return s.reply(msg, map[string]any{
+			"capabilities": map[string]any{
+				"textDocumentSync": map[string]any{
+					"openClose": true,
+					"change":    1, // full document sync
+					"save":      true,
+				},
+			},
+			"serverInfo": map[string]string{"name": "openkraft"},
+		})
+	case "shutdown":
+		return s.reply(msg, nil)
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil
+		}
+		return s.publish(params.TextDocument.URI, []byte(params.TextDocument.Text))
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil || len(params.ContentChanges) == 0 {
+			return nil
+		}
+		text := params.ContentChanges[len(params.ContentChanges)-1].Text
+		return s.publish(params.TextDocument.URI, []byte(text))
+	case "textDocument/didSave":
+		var params didSaveParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil
+		}
+		return s.publish(params.TextDocument.URI, nil)
+	case "textDocument/didClose":
+		var params didCloseParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil
+		}
+		return s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+			URI: params.TextDocument.URI, Diagnostics: []Diagnostic{},
+		})
+	default:
+		if msg.ID != nil {
+			return writeMessage(s.out, &message{
+				ID:    msg.ID,
+				Error: &responseError{Code: codeMethodNotFound, Message: "method not found: " + msg.Method},
+			})
+		}
+		return nil // unknown notifications are ignored per the spec
+	}
+}
+
+// publish re-analyzes the document and sends its diagnostics. A nil src reads
+// the saved file from disk. Files with syntax errors mid-edit get the
+// diagnostics of their partial analysis; files outside the project or that
+// cannot be analyzed at all are skipped silently so the editor keeps its
+// last diagnostics.
+func (s *Server) publish(uri string, src []byte) error {
+	rel, ok := s.relativePath(uri)
+	if !ok {
+		return nil
+	}
+	if s.session == nil {
+		session, err := s.validate.OpenSession(s.projectPath)
+		if err != nil {
+			return nil
+		}
+		s.session = session
+	}
+	issues, err := s.session.FileIssues(rel, src)
+	if err != nil {
+		return nil
+	}
+	diags := make([]Diagnostic, 0, len(issues))
+	for _, issue := range issues {
+		diags = append(diags, toDiagnostic(issue))
+	}
+	return s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{URI: uri, Diagnostics: diags})
+}
+
+func (s *Server) relativePath(uri string) (string, bool) {
+	path, err := uriToPath(uri)
+	if err != nil || !strings.HasSuffix(path, ".go") {
+		return "", false
+	}
+	root, err := filepath.Abs(s.projectPath)
+	if err != nil {
+		return "", false
+	}
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+func (s *Server) reply(req *message, result any) error {
+	if result == nil {
+		result = json.RawMessage("null")
+	}
+	return writeMessage(s.out, &message{ID: req.ID, Result: result})
+}
+
+func (s *Server) notify(method string, params any) error {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return writeMessage(s.out, &message{Method: method, Params: raw})
+}
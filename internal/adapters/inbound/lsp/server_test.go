@@ -0,0 +1,156 @@
+package lsp_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/inbound/lsp"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/cache"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/application"
+)
+
+const fixtureDir = "../../../../testdata/go-hexagonal/perfect"
+
+func frame(t *testing.T, buf *bytes.Buffer, msg map[string]any) {
+	t.Helper()
+	msg["jsonrpc"] = "2.0"
+	body, err := json.Marshal(msg)
+	require.NoError(t, err)
+	fmt.Fprintf(buf, "Content-Length: %d\r\n\r\n%s", len(body), body)
+}
+
+func readAll(t *testing.T, r io.Reader) []map[string]any {
+	t.Helper()
+	br := bufio.NewReader(r)
+	var msgs []map[string]any
+	for {
+		header, err := br.ReadString('\n')
+		if err == io.EOF {
+			return msgs
+		}
+		require.NoError(t, err)
+		n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(header, "Content-Length:")))
+		require.NoError(t, err)
+		_, err = br.ReadString('\n') // blank separator line
+		require.NoError(t, err)
+		body := make([]byte, n)
+		_, err = io.ReadFull(br, body)
+		require.NoError(t, err)
+		var msg map[string]any
+		require.NoError(t, json.Unmarshal(body, &msg))
+		msgs = append(msgs, msg)
+	}
+}
+
+func newServer(t *testing.T, root string) *lsp.Server {
+	t.Helper()
+	sc := scanner.New()
+	det := detector.New()
+	par := parser.New()
+	cfg := config.New()
+	scoreSvc := application.NewScoreService(sc, det, par, cfg)
+	validateSvc := application.NewValidateService(sc, det, par, scoreSvc, cache.New(), cfg)
+	return lsp.NewServer(validateSvc, root)
+}
+
+func TestServer_PublishesDiagnosticsOnChange(t *testing.T) {
+	root, err := filepath.Abs(fixtureDir)
+	require.NoError(t, err)
+	_ = cache.New().Invalidate(root)
+	defer func() { _ = cache.New().Invalidate(root) }()
+
+	uri := (&url.URL{Scheme: "file", Path: filepath.ToSlash(filepath.Join(root, "internal/tax/domain/tax_rule.go"))}).String()
+
+	var src strings.Builder
+	src.WriteString("package domain\n\nfunc Huge() int {\n\tx := 0\n")
+	for i := 0; i < 400; i++ {
+		src.WriteString("\tx++\n")
+	}
+	src.WriteString("\treturn x\n}\n")
+
+	var in bytes.Buffer
+	frame(t, &in, map[string]any{"id": 1, "method": "initialize", "params": map[string]any{}})
+	frame(t, &in, map[string]any{"method": "textDocument/didChange", "params": map[string]any{
+		"textDocument":   map[string]any{"uri": uri},
+		"contentChanges": []map[string]any{{"text": src.String()}},
+	}})
+	frame(t, &in, map[string]any{"id": 2, "method": "shutdown"})
+	frame(t, &in, map[string]any{"method": "exit"})
+
+	var out bytes.Buffer
+	require.NoError(t, newServer(t, root).Serve(&in, &out))
+
+	msgs := readAll(t, &out)
+	require.Len(t, msgs, 3)
+	assert.Contains(t, msgs[0], "result")
+	assert.Equal(t, "textDocument/publishDiagnostics", msgs[1]["method"])
+
+	params := msgs[1]["params"].(map[string]any)
+	assert.Equal(t, uri, params["uri"])
+	codes := []string{}
+	for _, d := range params["diagnostics"].([]any) {
+		diag := d.(map[string]any)
+		assert.Equal(t, "openkraft", diag["source"])
+		codes = append(codes, fmt.Sprint(diag["code"]))
+	}
+	assert.Contains(t, codes, "function_size")
+}
+
+func TestServer_SkipsFilesOutsideTheProject(t *testing.T) {
+	root, err := filepath.Abs(fixtureDir)
+	require.NoError(t, err)
+	_ = cache.New().Invalidate(root)
+	defer func() { _ = cache.New().Invalidate(root) }()
+
+	fileURI := func(path string) string {
+		return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+	}
+	outside := fileURI(filepath.Join(filepath.Dir(root), "outside.go"))
+	// A directory whose name merely starts with ".." is still inside.
+	dotted := fileURI(filepath.Join(root, "..gen", "gen.go"))
+
+	var in bytes.Buffer
+	frame(t, &in, map[string]any{"id": 1, "method": "initialize", "params": map[string]any{}})
+	for _, uri := range []string{outside, dotted} {
+		frame(t, &in, map[string]any{"method": "textDocument/didChange", "params": map[string]any{
+			"textDocument":   map[string]any{"uri": uri},
+			"contentChanges": []map[string]any{{"text": "package gen\n"}},
+		}})
+	}
+	frame(t, &in, map[string]any{"method": "exit"})
+
+	var out bytes.Buffer
+	require.NoError(t, newServer(t, root).Serve(&in, &out))
+
+	msgs := readAll(t, &out)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, "textDocument/publishDiagnostics", msgs[1]["method"])
+	assert.Equal(t, dotted, msgs[1]["params"].(map[string]any)["uri"])
+}
+
+func TestServer_UnknownRequestReturnsError(t *testing.T) {
+	var in bytes.Buffer
+	frame(t, &in, map[string]any{"id": 7, "method": "textDocument/hover"})
+
+	var out bytes.Buffer
+	require.NoError(t, newServer(t, fixtureDir).Serve(&in, &out))
+
+	msgs := readAll(t, &out)
+	require.Len(t, msgs, 1)
+	assert.Contains(t, msgs[0], "error")
+}
@@ -28,6 +28,8 @@ func registerTools(s *server.MCPServer, projectPath string) {
 	s.AddTool(
 		mcplib.NewTool("openkraft_score",
 			mcplib.WithDescription("Returns the full AI-readiness score for the project as JSON"),
+			mcplib.WithString("min_severity", mcplib.Description("Only include issues at or above this severity: error, warning, info")),
+			mcplib.WithNumber("max_issues", mcplib.Description("Include at most this many issues, most severe first")),
 		),
 		handleScore(projectPath),
 	)
@@ -122,18 +124,26 @@ func newServices() (*application.ScoreService, *application.CheckService) {
 	det := detector.New()
 	par := parser.New()
 	cfg := config.New()
-	return application.NewScoreService(sc, det, par, cfg),
+	return application.NewScoreService(sc, det, par, cfg, parser.LanguageAnalyzers()...),
 		application.NewCheckService(sc, det, par, cfg)
 }
 
 func handleScore(projectPath string) server.ToolHandlerFunc {
-	return func(_ context.Context, _ mcplib.CallToolRequest) (*mcplib.CallToolResult, error) {
+	return func(_ context.Context, request mcplib.CallToolRequest) (*mcplib.CallToolResult, error) {
+		args := request.GetArguments()
+		minSeverity, _ := args["min_severity"].(string)
+		maxIssues, _ := args["max_issues"].(float64)
+		filter := domain.IssueFilter{MinSeverity: minSeverity, MaxTotal: int(maxIssues)}
+		if err := filter.Validate(); err != nil {
+			return errorResult(err.Error()), nil
+		}
+
 		scoreSvc, _ := newServices()
 		score, err := scoreSvc.ScoreProject(projectPath)
 		if err != nil {
 			return errorResult(fmt.Sprintf("scoring failed: %v", err)), nil
 		}
-		return jsonResult(score)
+		return jsonResult(domain.FilterIssues(score, filter))
 	}
 }
 
@@ -316,7 +326,7 @@ func handleFix(projectPath string) server.ToolHandlerFunc {
 		par := parser.New()
 		cfg := config.New()
 
-		scoreSvc := application.NewScoreService(sc, det, par, cfg)
+		scoreSvc := application.NewScoreService(sc, det, par, cfg, parser.LanguageAnalyzers()...)
 		onboardSvc := application.NewOnboardService(sc, det, par, cfg)
 		fixSvc := application.NewFixService(scoreSvc, onboardSvc)
 
@@ -350,7 +360,7 @@ func handleValidate(projectPath string) server.ToolHandlerFunc {
 		cfg := config.New()
 
 		cacheSt := cacheAdapter.New()
-		scoreSvc := application.NewScoreService(sc, det, par, cfg)
+		scoreSvc := application.NewScoreService(sc, det, par, cfg, parser.LanguageAnalyzers()...)
 		validateSvc := application.NewValidateService(sc, det, par, scoreSvc, cacheSt, cfg)
 
 		changed := splitAndTrim(changedStr)
@@ -0,0 +1,122 @@
+package buildsize
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// topPackages is the number of largest contributing packages reported per
+// binary.
+const topPackages = 10
+
+// Sizer implements domain.BinarySizer with the go toolchain: go build for
+// the stripped size and go tool nm on an unstripped build for the symbol
+// sizes per package.
+type Sizer struct{}
+
+func New() *Sizer {
+	return &Sizer{}
+}
+
+func (s *Sizer) Measure(projectPath string, mainDirs []string) ([]domain.BinarySize, error) {
+	tmp, err := os.MkdirTemp("", "openkraft-binsize-")
+	if err != nil {
+		return nil, fmt.Errorf("creating build directory: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	var sizes []domain.BinarySize
+	for i, dir := range mainDirs {
+		stripped := filepath.Join(tmp, fmt.Sprintf("stripped%d", i))
+		full := filepath.Join(tmp, fmt.Sprintf("full%d", i))
+		pkg := "./" + filepath.ToSlash(dir)
+		if err := goCommand(projectPath, "build", "-trimpath", "-ldflags=-s -w", "-o", stripped, pkg); err != nil {
+			return nil, fmt.Errorf("building %s: %w", dir, err)
+		}
+		if err := goCommand(projectPath, "build", "-trimpath", "-o", full, pkg); err != nil {
+			return nil, fmt.Errorf("building %s: %w", dir, err)
+		}
+		info, err := os.Stat(stripped)
+		if err != nil {
+			return nil, fmt.Errorf("measuring %s: %w", dir, err)
+		}
+		nm, err := exec.Command("go", "tool", "nm", "-size", full).Output()
+		if err != nil {
+			return nil, fmt.Errorf("listing symbols of %s: %w", dir, err)
+		}
+		sizes = append(sizes, domain.BinarySize{
+			Dir:         filepath.ToSlash(dir),
+			Bytes:       info.Size(),
+			TopPackages: largestPackages(nm, topPackages),
+		})
+	}
+	return sizes, nil
+}
+
+func goCommand(dir string, args ...string) error {
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("go %s: %w: %s", args[0], err, bytes.TrimSpace(out))
+	}
+	return nil
+}
+
+// largestPackages sums the symbol sizes in go tool nm -size output per
+// package and returns the n largest.
+func largestPackages(nm []byte, n int) []domain.PackageSize {
+	totals := make(map[string]int64)
+	sc := bufio.NewScanner(bytes.NewReader(nm))
+	for sc.Scan() {
+		// address size type name
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 4 {
+			continue
+		}
+		size, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil || size == 0 {
+			continue
+		}
+		if pkg := symbolPackage(strings.Join(fields[3:], " ")); pkg != "" {
+			totals[pkg] += size
+		}
+	}
+
+	sizes := make([]domain.PackageSize, 0, len(totals))
+	for pkg, total := range totals {
+		sizes = append(sizes, domain.PackageSize{Path: pkg, Bytes: total})
+	}
+	slices.SortFunc(sizes, func(a, b domain.PackageSize) int {
+		return cmp.Or(cmp.Compare(b.Bytes, a.Bytes), cmp.Compare(a.Path, b.Path))
+	})
+	return sizes[:min(n, len(sizes))]
+}
+
+// symbolPackage returns the import path of a Go symbol name such as
+// github.com/a/b.(*T).M or runtime.mallocgc. Linker-generated symbols
+// (type:*T, go:buildid) have no package.
+func symbolPackage(name string) string {
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		name = name[:i]
+	}
+	slash := strings.LastIndexByte(name, '/')
+	dot := strings.IndexByte(name[slash+1:], '.')
+	if dot < 0 {
+		return ""
+	}
+	pkg := name[:slash+1+dot]
+	if pkg == "" || strings.ContainsAny(pkg, ": ") {
+		return ""
+	}
+	return pkg
+}
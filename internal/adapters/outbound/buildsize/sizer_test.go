@@ -0,0 +1,55 @@
+package buildsize
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSymbolPackage(t *testing.T) {
+	tests := map[string]string{
+		"runtime.mallocgc":                                 "runtime",
+		"github.com/spf13/cobra.(*Command).Execute":        "github.com/spf13/cobra",
+		"example.com/app/internal/store.New[go.shape.int]": "example.com/app/internal/store",
+		"type:*example.com/app.T":                          "",
+		"go:buildid":                                       "",
+		"main.main":                                        "main",
+	}
+	for name, want := range tests {
+		assert.Equal(t, want, symbolPackage(name), name)
+	}
+}
+
+func TestLargestPackages(t *testing.T) {
+	nm := []byte(`  401000      120 T runtime.mallocgc
+  402000       80 T runtime.gcStart
+  403000      150 T example.com/app/store.(*DB).Query
+  404000       10 T main.main
+  405000        0 U _cgo_topofstack
+  406000       64 R type:*example.com/app/store.DB
+`)
+	sizes := largestPackages(nm, 2)
+	require.Len(t, sizes, 2)
+	assert.Equal(t, "runtime", sizes[0].Path)
+	assert.Equal(t, int64(200), sizes[0].Bytes)
+	assert.Equal(t, "example.com/app/store", sizes[1].Path)
+}
+
+func TestSizer_Measure(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/hello\n\ngo 1.21\n"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "cmd", "hello"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cmd", "hello", "main.go"),
+		[]byte("package main\n\nimport \"fmt\"\n\nfunc main() { fmt.Println(\"hello\") }\n"), 0644))
+
+	sizes, err := New().Measure(dir, []string{"cmd/hello"})
+	require.NoError(t, err)
+	require.Len(t, sizes, 1)
+	assert.Equal(t, "cmd/hello", sizes[0].Dir)
+	assert.Positive(t, sizes[0].Bytes)
+	require.NotEmpty(t, sizes[0].TopPackages)
+	assert.Equal(t, "runtime", sizes[0].TopPackages[0].Path)
+}
@@ -0,0 +1,148 @@
+package cloneindex
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// bucketCount is the number of spill files; only one bucket is loaded into
+// memory at a time when duplicates are resolved.
+const bucketCount = 64
+
+// recordSize is the encoded size of one window: hash, file id, position.
+const recordSize = 16
+
+// DiskIndex implements domain.CloneIndex by spilling window hashes into
+// hash-partitioned files under a temporary directory.
+type DiskIndex struct {
+	dir     string
+	files   []string
+	buckets [bucketCount]*bucket
+}
+
+type bucket struct {
+	f *os.File
+	w *bufio.Writer
+}
+
+// New creates an index backed by a fresh temporary directory. Close removes it.
+func New() (*DiskIndex, error) {
+	dir, err := os.MkdirTemp("", "openkraft-clones-")
+	if err != nil {
+		return nil, fmt.Errorf("creating clone index: %w", err)
+	}
+	idx := &DiskIndex{dir: dir}
+	for i := range idx.buckets {
+		f, err := os.Create(filepath.Join(dir, fmt.Sprintf("bucket-%02d", i)))
+		if err != nil {
+			idx.Close()
+			return nil, fmt.Errorf("creating clone index: %w", err)
+		}
+		idx.buckets[i] = &bucket{f: f, w: bufio.NewWriter(f)}
+	}
+	return idx, nil
+}
+
+func (idx *DiskIndex) Add(file string, hashes []uint64) error {
+	id := uint32(len(idx.files))
+	idx.files = append(idx.files, file)
+
+	var rec [recordSize]byte
+	for pos, h := range hashes {
+		binary.LittleEndian.PutUint64(rec[0:8], h)
+		binary.LittleEndian.PutUint32(rec[8:12], id)
+		binary.LittleEndian.PutUint32(rec[12:16], uint32(pos))
+		if _, err := idx.buckets[h%bucketCount].w.Write(rec[:]); err != nil {
+			return fmt.Errorf("writing clone index: %w", err)
+		}
+	}
+	return nil
+}
+
+type location struct {
+	file uint32
+	pos  uint32
+}
+
+func (idx *DiskIndex) DuplicatePositions(canPair func(a, b string) bool) (map[string][]int, error) {
+	positions := make(map[string][]int)
+	for _, b := range idx.buckets {
+		locs, err := b.load()
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range locs {
+			idx.collect(group, canPair, positions)
+		}
+	}
+	return positions, nil
+}
+
+// collect appends the positions of every window in group whose file shares
+// the hash with a file it can pair with.
+func (idx *DiskIndex) collect(group []location, canPair func(a, b string) bool, positions map[string][]int) {
+	fileSet := make(map[uint32]bool)
+	for _, l := range group {
+		fileSet[l.file] = true
+	}
+	if len(fileSet) < 2 {
+		return // intra-file only
+	}
+	for _, l := range group {
+		for other := range fileSet {
+			a, b := idx.files[l.file], idx.files[other]
+			if other != l.file && canPair(a, b) {
+				positions[a] = append(positions[a], int(l.pos))
+				break
+			}
+		}
+	}
+}
+
+// load flushes the bucket and reads it back grouped by hash.
+func (b *bucket) load() (map[uint64][]location, error) {
+	if err := b.w.Flush(); err != nil {
+		return nil, fmt.Errorf("flushing clone index: %w", err)
+	}
+	if _, err := b.f.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("reading clone index: %w", err)
+	}
+
+	groups := make(map[uint64][]location)
+	r := bufio.NewReader(b.f)
+	var rec [recordSize]byte
+	for {
+		if _, err := io.ReadFull(r, rec[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("reading clone index: %w", err)
+		}
+		h := binary.LittleEndian.Uint64(rec[0:8])
+		groups[h] = append(groups[h], location{
+			file: binary.LittleEndian.Uint32(rec[8:12]),
+			pos:  binary.LittleEndian.Uint32(rec[12:16]),
+		})
+	}
+
+	// Later Adds append to the end of the bucket.
+	if _, err := b.f.Seek(0, io.SeekEnd); err != nil {
+		return nil, fmt.Errorf("reading clone index: %w", err)
+	}
+	return groups, nil
+}
+
+// Close releases the spill files and removes the index directory.
+func (idx *DiskIndex) Close() error {
+	for _, b := range idx.buckets {
+		if b != nil {
+			b.f.Close()
+		}
+	}
+	return os.RemoveAll(idx.dir)
+}
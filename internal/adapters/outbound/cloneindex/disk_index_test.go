@@ -0,0 +1,57 @@
+package cloneindex_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/cloneindex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func allPairs(_, _ string) bool { return true }
+
+func TestDiskIndex_FindsWindowsSharedAcrossFiles(t *testing.T) {
+	idx, err := cloneindex.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { idx.Close() })
+
+	require.NoError(t, idx.Add("a.go", []uint64{1, 2, 3, 2}))
+	require.NoError(t, idx.Add("b.go", []uint64{9, 2, 3}))
+	require.NoError(t, idx.Add("c.go", []uint64{7, 7, 7}))
+
+	positions, err := idx.DuplicatePositions(allPairs)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, positions["a.go"])
+	assert.ElementsMatch(t, []int{1, 2}, positions["b.go"])
+	assert.NotContains(t, positions, "c.go", "windows repeated within one file are not clones")
+}
+
+func TestDiskIndex_HonorsPairPredicate(t *testing.T) {
+	idx, err := cloneindex.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { idx.Close() })
+
+	require.NoError(t, idx.Add("poll_linux.go", []uint64{5, 6}))
+	require.NoError(t, idx.Add("poll_windows.go", []uint64{5, 6}))
+
+	positions, err := idx.DuplicatePositions(func(a, b string) bool { return false })
+	require.NoError(t, err)
+	assert.Empty(t, positions)
+}
+
+func TestDiskIndex_AddAfterResolve(t *testing.T) {
+	idx, err := cloneindex.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { idx.Close() })
+
+	require.NoError(t, idx.Add("a.go", []uint64{42}))
+	_, err = idx.DuplicatePositions(allPairs)
+	require.NoError(t, err)
+
+	require.NoError(t, idx.Add("b.go", []uint64{42}))
+	positions, err := idx.DuplicatePositions(allPairs)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0}, positions["a.go"])
+	assert.Equal(t, []int{0}, positions["b.go"])
+}
@@ -0,0 +1,68 @@
+package codeowners
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// searchPaths lists CODEOWNERS locations in the order GitHub consults them.
+var searchPaths = []string{
+	filepath.Join(".github", "CODEOWNERS"),
+	"CODEOWNERS",
+	filepath.Join("docs", "CODEOWNERS"),
+}
+
+// Loader implements domain.CodeOwnersLoader by reading the project's
+// CODEOWNERS file from disk.
+type Loader struct{}
+
+func New() *Loader {
+	return &Loader{}
+}
+
+// Load returns the parsed CODEOWNERS rules, or nil when the project has no
+// CODEOWNERS file. The file is looked up at the top level of the git
+// repository holding projectPath, where GitHub reads it, so a project in a
+// subdirectory of a monorepo is attributed by the repository's rules.
+func (l *Loader) Load(projectPath string) (*domain.CodeOwners, error) {
+	projectPath, err := filepath.Abs(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	top := topLevel(projectPath)
+	dir, err := filepath.Rel(top, projectPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, rel := range searchPaths {
+		data, err := os.ReadFile(filepath.Join(top, rel))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", rel, err)
+		}
+		return domain.ParseCodeOwners(string(data)).Under(filepath.ToSlash(dir)), nil
+	}
+	return nil, nil
+}
+
+// topLevel returns the nearest directory at or above dir holding a .git
+// directory or file, or dir itself outside a git repository.
+func topLevel(dir string) string {
+	for d := dir; ; {
+		if _, err := os.Stat(filepath.Join(d, ".git")); err == nil {
+			return d
+		}
+		parent := filepath.Dir(d)
+		if parent == d {
+			return dir
+		}
+		d = parent
+	}
+}
@@ -0,0 +1,54 @@
+package codeowners_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/codeowners"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoader_NoFile(t *testing.T) {
+	co, err := codeowners.New().Load(t.TempDir())
+	require.NoError(t, err)
+	assert.Nil(t, co)
+}
+
+func TestLoader_PrefersGitHubDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".github"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".github", "CODEOWNERS"), []byte("* @org/github\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "CODEOWNERS"), []byte("* @org/root\n"), 0644))
+
+	co, err := codeowners.New().Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "@org/github", co.Owner("main.go"))
+}
+
+func TestLoader_RootFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "CODEOWNERS"), []byte("/internal/ @org/core\n"), 0644))
+
+	co, err := codeowners.New().Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "@org/core", co.Owner("internal/app.go"))
+	assert.Empty(t, co.Owner("main.go"))
+}
+
+func TestLoader_ResolvesAgainstGitTopLevel(t *testing.T) {
+	repo := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repo, ".git"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(repo, ".github"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(repo, ".github", "CODEOWNERS"),
+		[]byte("* @org/all\n/services/billing/internal/ @org/billing\n"), 0644))
+	project := filepath.Join(repo, "services", "billing")
+	require.NoError(t, os.MkdirAll(project, 0755))
+
+	co, err := codeowners.New().Load(project)
+	require.NoError(t, err)
+	require.NotNil(t, co)
+	assert.Equal(t, "@org/billing", co.Owner("internal/app.go"))
+	assert.Equal(t, "@org/all", co.Owner("main.go"))
+}
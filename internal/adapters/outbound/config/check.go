@@ -0,0 +1,148 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// decodeLine splits a yaml.v3 type error into its line and message.
+	decodeLine = regexp.MustCompile(`^line (\d+): (.*)$`)
+	// unknownField matches the message yaml.v3 reports for unknown keys.
+	unknownField = regexp.MustCompile(`^field (\S+) not found in type (\S+)$`)
+)
+
+// Check reads .openkraft.yaml from projectPath like Load, but reports every
+// problem instead of stopping at the first: keys the schema does not know,
+// values of the wrong type, an invalid value, a remote config that cannot
+// be fetched or fails its checksum, and conflicting settings. The returned
+// config is resolved as Load resolves it once it decodes and validates.
+// Only reading the file returns an error.
+func (l *YAMLLoader) Check(projectPath string) (domain.ProjectConfig, []domain.ConfigProblem, error) {
+	data, err := os.ReadFile(filepath.Join(projectPath, fileName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			cfg := domain.DefaultConfig()
+			return cfg, cfg.Conflicts(), nil
+		}
+		return domain.ProjectConfig{}, nil, err
+	}
+
+	var cfg domain.ProjectConfig
+	problems := decodeStrict(data, &cfg)
+	if len(problems) > 0 {
+		// Values that failed to decode are left zero, so validating them
+		// would only repeat the decoding errors.
+		return cfg, problems, nil
+	}
+	if err := cfg.Validate(); err != nil {
+		return cfg, []domain.ConfigProblem{{Severity: domain.SeverityError, Message: err.Error()}}, nil
+	}
+	resolved, err := l.resolve(projectPath, cfg)
+	if err != nil {
+		return cfg, []domain.ConfigProblem{{Severity: domain.SeverityError, Message: err.Error()}}, nil
+	}
+	return resolved, resolved.Conflicts(), nil
+}
+
+// decodeStrict decodes data into cfg, rejecting unknown keys, and turns
+// every decoding error into a problem. Values that decode are kept.
+func decodeStrict(data []byte, cfg *domain.ProjectConfig) []domain.ConfigProblem {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	err := dec.Decode(cfg)
+	if err == nil || errors.Is(err, io.EOF) {
+		return nil
+	}
+	var typeErr *yaml.TypeError
+	if !errors.As(err, &typeErr) {
+		return []domain.ConfigProblem{{Severity: domain.SeverityError, Message: fmt.Sprintf("parsing %s: %v", fileName, err)}}
+	}
+
+	keys := schemaKeys()
+	problems := make([]domain.ConfigProblem, 0, len(typeErr.Errors))
+	for _, msg := range typeErr.Errors {
+		p := domain.ConfigProblem{Severity: domain.SeverityError, Message: msg}
+		if m := decodeLine.FindStringSubmatch(msg); m != nil {
+			p.Line, _ = strconv.Atoi(m[1])
+			p.Message = m[2]
+		}
+		if m := unknownField.FindStringSubmatch(p.Message); m != nil {
+			p.Message = fmt.Sprintf("unknown key %q", m[1])
+			if s := closestKey(m[1], keys[m[2]]); s != "" {
+				p.Message += fmt.Sprintf(" (did you mean %q?)", s)
+			}
+		}
+		problems = append(problems, p)
+	}
+	return problems
+}
+
+// schemaKeys returns the YAML keys of every struct type in the config
+// schema, by Go type name as yaml.v3 reports it (domain.ProfileOverrides).
+func schemaKeys() map[string][]string {
+	keys := make(map[string][]string)
+	var walk func(t reflect.Type)
+	walk = func(t reflect.Type) {
+		for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct || keys[t.String()] != nil {
+			return
+		}
+		keys[t.String()] = []string{}
+		for i := range t.NumField() {
+			f := t.Field(i)
+			if name, _, _ := strings.Cut(f.Tag.Get("yaml"), ","); name != "" && name != "-" {
+				keys[t.String()] = append(keys[t.String()], name)
+			}
+			walk(f.Type)
+		}
+	}
+	walk(reflect.TypeFor[domain.ProjectConfig]())
+	return keys
+}
+
+// closestKey returns the known key nearest to name by edit distance, or ""
+// when none is close enough to be a likely typo.
+func closestKey(name string, known []string) string {
+	best, bestDist := "", max(2, len(name)/4)+1
+	for _, k := range known {
+		if d := editDistance(name, k); d < bestDist {
+			best, bestDist = k, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
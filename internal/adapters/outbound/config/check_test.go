@@ -0,0 +1,72 @@
+package config_test
+
+import (
+	"testing"
+
+	appconfig "github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck_MissingFile(t *testing.T) {
+	cfg, problems, err := appconfig.New().Check(t.TempDir())
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultConfig(), cfg)
+	assert.Empty(t, problems)
+}
+
+func TestCheck_UnknownKeysAndTypeErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, `
+project_typ: cli-tool
+profile:
+  max_fuction_lines: 40
+  max_parameters: many
+  frobnicate: true
+`)
+
+	_, problems, err := appconfig.New().Check(dir)
+	require.NoError(t, err)
+	require.Len(t, problems, 4)
+	assert.Equal(t, domain.ConfigProblem{Severity: "error", Line: 2, Message: `unknown key "project_typ" (did you mean "project_type"?)`}, problems[0])
+	assert.Equal(t, `unknown key "max_fuction_lines" (did you mean "max_function_lines"?)`, problems[1].Message)
+	assert.Equal(t, 5, problems[2].Line)
+	assert.Contains(t, problems[2].Message, "cannot unmarshal")
+	assert.Equal(t, `unknown key "frobnicate"`, problems[3].Message)
+}
+
+func TestCheck_InvalidValue(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, `penalty_model: brutal`)
+
+	_, problems, err := appconfig.New().Check(dir)
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Message, `unknown penalty model "brutal"`)
+}
+
+func TestCheck_SyntaxError(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, `{{{invalid yaml`)
+
+	_, problems, err := appconfig.New().Check(dir)
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Message, "parsing .openkraft.yaml")
+}
+
+func TestCheck_ResolvesAndReportsConflicts(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, `
+extends: library
+weights:
+  verifiability: 0.5
+`)
+
+	cfg, problems, err := appconfig.New().Check(dir)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProjectTypeLibrary, cfg.ProjectType)
+	require.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Message, "weights sum to 1.25")
+}
@@ -0,0 +1,232 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"gopkg.in/yaml.v3"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// maxExtendsDepth bounds chains of remote configs extending each other.
+const maxExtendsDepth = 5
+
+// maxRemoteConfigBytes caps the size of a fetched config; larger ones are
+// an error rather than being cut short.
+const maxRemoteConfigBytes = 1 << 20
+
+// remoteCacheDir holds pinned remote configs under the project root.
+const remoteCacheDir = ".openkraft/extends"
+
+// extend layers cfg over what it extends: a built-in preset, or a remote
+// config fetched from its URL, itself resolved first. seen holds the
+// remote configs already on the chain, to stop cycles. A pinned config may
+// only extend pinned remotes, so the pin covers everything it brings in.
+func (l *YAMLLoader) extend(projectPath string, cfg domain.ProjectConfig, seen map[string]bool) (domain.ProjectConfig, error) {
+	if !domain.IsRemoteExtends(cfg.Extends) {
+		return domain.ApplyPreset(cfg), nil
+	}
+	if seen[cfg.Extends] {
+		return domain.ProjectConfig{}, fmt.Errorf("extends cycle through %s", cfg.Extends)
+	}
+	if len(seen) >= maxExtendsDepth {
+		return domain.ProjectConfig{}, fmt.Errorf("extends chain deeper than %d configs at %s", maxExtendsDepth, cfg.Extends)
+	}
+	seen[cfg.Extends] = true
+
+	base, err := l.remoteConfig(projectPath, cfg.Extends, cfg.ExtendsChecksum)
+	if err != nil {
+		return domain.ProjectConfig{}, err
+	}
+	if cfg.ExtendsChecksum != "" && domain.IsRemoteExtends(base.Extends) && base.ExtendsChecksum == "" {
+		return domain.ProjectConfig{}, fmt.Errorf("pinned config %s extends %s, which must be pinned with extends_checksum too", cfg.Extends, base.Extends)
+	}
+	base, err = l.extend(projectPath, base, seen)
+	if err != nil {
+		return domain.ProjectConfig{}, err
+	}
+	return domain.OverlayConfig(base, cfg), nil
+}
+
+// remoteConfig fetches, verifies and validates the config at location.
+func (l *YAMLLoader) remoteConfig(projectPath, location, checksum string) (domain.ProjectConfig, error) {
+	data, err := remoteContent(projectPath, location, checksum)
+	if err != nil {
+		return domain.ProjectConfig{}, err
+	}
+
+	var cfg domain.ProjectConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return domain.ProjectConfig{}, fmt.Errorf("parsing %s: %w", location, err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return domain.ProjectConfig{}, fmt.Errorf("invalid %s: %w", location, err)
+	}
+	return cfg, nil
+}
+
+// remoteContent returns the content at location, verified against
+// checksum when one is given. A pinned config is cached under
+// .openkraft/extends, keyed by location and checksum, and read from there
+// on later runs; an unpinned one is fetched every time, since nothing says
+// a cached copy is current.
+func remoteContent(projectPath, location, checksum string) ([]byte, error) {
+	cached := remoteCachePath(projectPath, location, checksum)
+	if checksum != "" {
+		if data, err := os.ReadFile(cached); err == nil && contentChecksum(data) == checksum {
+			return data, nil
+		}
+	}
+	data, err := fetchRemote(location)
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s: %w", location, err)
+	}
+	if checksum == "" {
+		return data, nil
+	}
+	if got := contentChecksum(data); got != checksum {
+		return nil, fmt.Errorf("extends_checksum mismatch: %s has %s, want %s", location, got, checksum)
+	}
+	// The cache only saves a fetch; a read-only checkout fetches again.
+	if os.MkdirAll(filepath.Dir(cached), 0o755) == nil {
+		_ = os.WriteFile(cached, data, 0o644)
+	}
+	return data, nil
+}
+
+// remoteCachePath returns where the config at location pinned by checksum
+// is cached.
+func remoteCachePath(projectPath, location, checksum string) string {
+	key := sha256.Sum256([]byte(location + "\n" + checksum))
+	return filepath.Join(projectPath, remoteCacheDir, hex.EncodeToString(key[:16])+".yaml")
+}
+
+// contentChecksum returns the extends_checksum form of data's digest.
+func contentChecksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// fetchRemote returns the content at location: an http(s) URL, or a git
+// URL of the form git+<repository>//<path>[?ref=<branch or tag>].
+func fetchRemote(location string) ([]byte, error) {
+	if rest, ok := strings.CutPrefix(location, "git+"); ok {
+		return fetchGit(rest)
+	}
+	return fetchHTTP(location)
+}
+
+// fetchHTTP downloads a config over http(s).
+func fetchHTTP(location string) ([]byte, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteConfigBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxRemoteConfigBytes {
+		return nil, fmt.Errorf("config is larger than %d bytes", maxRemoteConfigBytes)
+	}
+	return data, nil
+}
+
+// fetchGit reads one file from a shallow in-memory clone of a repository,
+// addressed as <repository>//<path>[?ref=<branch or tag>]. Without a ref
+// the default branch is read.
+func fetchGit(location string) ([]byte, error) {
+	repoURL, path, ref, err := splitGitLocation(location)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for _, name := range gitRefCandidates(ref) {
+		repo, err := git.Clone(memory.NewStorage(), nil, &git.CloneOptions{
+			URL: repoURL, ReferenceName: name, SingleBranch: true, Depth: 1,
+		})
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return readGitFile(repo, path)
+	}
+	return nil, fmt.Errorf("cloning %s: %w", repoURL, lastErr)
+}
+
+// splitGitLocation splits <repository>//<path>[?ref=<ref>] into its parts.
+// The // separating the path is the first one after the URL's scheme.
+func splitGitLocation(location string) (repoURL, path, ref string, err error) {
+	location, query, _ := strings.Cut(location, "?")
+	if query != "" {
+		values, err := url.ParseQuery(query)
+		if err != nil {
+			return "", "", "", fmt.Errorf("parsing query of %s: %w", location, err)
+		}
+		ref = values.Get("ref")
+	}
+	schemeEnd := 0
+	if i := strings.Index(location, "://"); i >= 0 {
+		schemeEnd = i + len("://")
+	}
+	i := strings.Index(location[schemeEnd:], "//")
+	if i < 0 {
+		return "", "", "", fmt.Errorf("git location %s names no file: use <repository>//<path>", location)
+	}
+	repoURL, path = location[:schemeEnd+i], location[schemeEnd+i+2:]
+	if path == "" {
+		return "", "", "", fmt.Errorf("git location %s names no file: use <repository>//<path>", location)
+	}
+	return repoURL, path, ref, nil
+}
+
+// gitRefCandidates returns the references ref may name, a branch first and
+// then a tag, or the remote's default branch for an empty ref.
+func gitRefCandidates(ref string) []plumbing.ReferenceName {
+	if ref == "" {
+		return []plumbing.ReferenceName{""}
+	}
+	return []plumbing.ReferenceName{plumbing.NewBranchReferenceName(ref), plumbing.NewTagReferenceName(ref)}
+}
+
+// readGitFile returns the content of path in the commit HEAD of repo.
+func readGitFile(repo *git.Repository, path string) ([]byte, error) {
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("resolving HEAD: %w", err)
+	}
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("reading commit: %w", err)
+	}
+	file, err := commit.File(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	if file.Size > maxRemoteConfigBytes {
+		return nil, fmt.Errorf("%s is larger than %d bytes", path, maxRemoteConfigBytes)
+	}
+	content, err := file.Contents()
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return []byte(content), nil
+}
@@ -0,0 +1,198 @@
+package config_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appconfig "github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+const orgPolicy = `
+extends: strict
+exclude_paths: [vendor]
+weights:
+  structure: 0.10
+gates:
+  min_overall: 80
+  max_issues: {error: 0}
+severity:
+  - {pattern: fmt-print, severity: error}
+`
+
+func checksum(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func serveConfig(t *testing.T, content string) string {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(content))
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL + "/openkraft-org.yaml"
+}
+
+func TestYAMLLoader_RemoteExtendsOverlaysLocalConfig(t *testing.T) {
+	url := serveConfig(t, orgPolicy)
+	dir := t.TempDir()
+	writeConfig(t, dir, `
+extends: `+url+`
+extends_checksum: `+checksum(orgPolicy)+`
+exclude_paths: [testdata]
+weights:
+  verifiability: 0.25
+gates:
+  max_issues: {error: 3}
+severity:
+  - {pattern: fmt-print, severity: info}
+`)
+
+	cfg, err := appconfig.New().Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, url, cfg.Extends)
+	assert.Equal(t, domain.CalibrationStrict, cfg.Calibration, "inherited through the remote's preset")
+	assert.InDelta(t, 0.10, cfg.Weights["structure"], 0.001, "remote weight")
+	assert.InDelta(t, 0.25, cfg.Weights["verifiability"], 0.001, "local weight wins")
+	assert.InDelta(t, 0.05, cfg.Weights["security_hints"], 0.001, "preset weight")
+	assert.Equal(t, []string{"vendor", "testdata"}, cfg.ExcludePaths)
+	require.NotNil(t, cfg.Gates)
+	assert.Equal(t, 80, *cfg.Gates.MinOverall)
+	assert.Equal(t, 3, cfg.Gates.MaxIssues["error"])
+	require.Len(t, cfg.Severity, 2)
+	assert.Equal(t, domain.SeverityInfo, cfg.Severity[0].Severity, "local rules match first")
+}
+
+func TestYAMLLoader_RemoteChecksumMismatch(t *testing.T) {
+	url := serveConfig(t, orgPolicy)
+	dir := t.TempDir()
+	writeConfig(t, dir, "extends: "+url+"\nextends_checksum: "+checksum("something else")+"\n")
+
+	_, err := appconfig.New().Load(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "extends_checksum mismatch")
+}
+
+func TestYAMLLoader_PlainHTTPRequiresChecksum(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, "extends: "+serveConfig(t, orgPolicy)+"\n")
+
+	_, err := appconfig.New().Load(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be pinned with extends_checksum")
+}
+
+func TestYAMLLoader_TwoHopPinnedExtends(t *testing.T) {
+	leafURL := serveConfig(t, orgPolicy)
+	middle := "extends: " + leafURL + "\nextends_checksum: " + checksum(orgPolicy) + "\nweights:\n  verifiability: 0.25\n"
+	dir := t.TempDir()
+	writeConfig(t, dir, "extends: "+serveConfig(t, middle)+"\nextends_checksum: "+checksum(middle)+"\n")
+
+	cfg, err := appconfig.New().Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, domain.CalibrationStrict, cfg.Calibration, "inherited through both hops")
+	assert.InDelta(t, 0.10, cfg.Weights["structure"], 0.001, "second hop weight")
+	assert.InDelta(t, 0.25, cfg.Weights["verifiability"], 0.001, "first hop weight")
+}
+
+func TestYAMLLoader_PinnedRemoteCannotExtendUnpinned(t *testing.T) {
+	// The second hop is https, which alone needs no pin, and is never
+	// fetched: the pinned first hop cannot vouch for it.
+	middle := "extends: https://example.invalid/org.yaml\n"
+	dir := t.TempDir()
+	writeConfig(t, dir, "extends: "+serveConfig(t, middle)+"\nextends_checksum: "+checksum(middle)+"\n")
+
+	_, err := appconfig.New().Load(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be pinned with extends_checksum too")
+}
+
+func TestYAMLLoader_PinnedRemoteIsCached(t *testing.T) {
+	fetches := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		_, _ = w.Write([]byte(orgPolicy))
+	}))
+	defer srv.Close()
+	dir := t.TempDir()
+	writeConfig(t, dir, "extends: "+srv.URL+"/org.yaml\nextends_checksum: "+checksum(orgPolicy)+"\n")
+
+	for range 2 {
+		cfg, err := appconfig.New().Load(dir)
+		require.NoError(t, err)
+		assert.Equal(t, domain.CalibrationStrict, cfg.Calibration)
+	}
+	assert.Equal(t, 1, fetches, "the second load reads the cache")
+	cached, err := filepath.Glob(filepath.Join(dir, ".openkraft", "extends", "*.yaml"))
+	require.NoError(t, err)
+	assert.Len(t, cached, 1)
+
+	// A different pin is a different cache entry, fetched and verified.
+	writeConfig(t, dir, "extends: "+srv.URL+"/org.yaml\nextends_checksum: "+checksum("something else")+"\n")
+	_, err = appconfig.New().Load(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "extends_checksum mismatch")
+	assert.Equal(t, 2, fetches)
+}
+
+func TestYAMLLoader_RemoteTooLarge(t *testing.T) {
+	large := "# " + strings.Repeat("x", 1<<20) + "\n"
+	dir := t.TempDir()
+	writeConfig(t, dir, "extends: "+serveConfig(t, large)+"\nextends_checksum: "+checksum(large)+"\n")
+
+	_, err := appconfig.New().Load(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "larger than")
+}
+
+func TestYAMLLoader_RemoteFetchFailure(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+	dir := t.TempDir()
+	writeConfig(t, dir, "extends: "+srv.URL+"/missing.yaml\nextends_checksum: "+checksum("")+"\n")
+
+	_, problems, err := appconfig.New().Check(dir)
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Message, "404")
+}
+
+func TestYAMLLoader_GitExtends(t *testing.T) {
+	repoDir := t.TempDir()
+	repo, err := git.PlainInit(repoDir, false)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, "policy"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "policy", "openkraft.yaml"), []byte("extends: cli-app\npenalty_model: lenient\n"), 0o644))
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = wt.Add("policy/openkraft.yaml")
+	require.NoError(t, err)
+	_, err = wt.Commit("policy", &git.CommitOptions{Author: &object.Signature{Name: "ci", Email: "ci@example.com", When: time.Now()}})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	writeConfig(t, dir, "extends: git+"+repoDir+"//policy/openkraft.yaml?ref=master\n")
+
+	cfg, err := appconfig.New().Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PenaltyModelLenient, cfg.PenaltyModel)
+	assert.Equal(t, domain.ProjectTypeCLI, cfg.ProjectType)
+
+	writeConfig(t, dir, "extends: git+"+repoDir+"//policy/missing.yaml\n")
+	_, err = appconfig.New().Load(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing.yaml")
+}
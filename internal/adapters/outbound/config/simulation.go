@@ -0,0 +1,25 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadSimulationPlan reads a simulation plan from a YAML file.
+func LoadSimulationPlan(file string) (domain.SimulationPlan, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return domain.SimulationPlan{}, fmt.Errorf("reading simulation plan: %w", err)
+	}
+	var plan domain.SimulationPlan
+	if err := yaml.Unmarshal(data, &plan); err != nil {
+		return domain.SimulationPlan{}, fmt.Errorf("parsing %s: %w", file, err)
+	}
+	if err := plan.Validate(); err != nil {
+		return domain.SimulationPlan{}, fmt.Errorf("invalid %s: %w", file, err)
+	}
+	return plan, nil
+}
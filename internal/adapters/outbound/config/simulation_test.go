@@ -0,0 +1,38 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	appconfig "github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSimulationPlan(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "plan.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`edits:
+  - split_function: {file: internal/app/run.go, function: Run, parts: 3}
+  - remove_dependency: {from: internal/domain, to: internal/adapters/db}
+  - delete_file: internal/legacy.go
+`), 0644))
+
+	plan, err := appconfig.LoadSimulationPlan(file)
+	require.NoError(t, err)
+	require.Len(t, plan.Edits, 3)
+	assert.Equal(t, 3, plan.Edits[0].SplitFunction.Parts)
+	assert.Equal(t, "internal/adapters/db", plan.Edits[1].RemoveDependency.To)
+	assert.Equal(t, "internal/legacy.go", plan.Edits[2].DeleteFile)
+}
+
+func TestLoadSimulationPlan_Invalid(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "plan.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("edits:\n  - split_function: {file: a.go, function: Run, parts: 1}\n"), 0644))
+
+	_, err := appconfig.LoadSimulationPlan(file)
+	assert.ErrorContains(t, err, "edit 1")
+
+	_, err = appconfig.LoadSimulationPlan(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
@@ -39,12 +39,21 @@ func (l *YAMLLoader) Load(projectPath string) (domain.ProjectConfig, error) {
 		return domain.ProjectConfig{}, fmt.Errorf("invalid %s: %w", fileName, err)
 	}
 
-	// If project_type is set, merge type defaults under explicit values.
+	return l.resolve(projectPath, cfg)
+}
+
+// resolve layers cfg over the preset or remote config it extends, then
+// merges project_type defaults under the result. Remote configs are cached
+// under projectPath.
+func (l *YAMLLoader) resolve(projectPath string, cfg domain.ProjectConfig) (domain.ProjectConfig, error) {
+	cfg, err := l.extend(projectPath, cfg, make(map[string]bool))
+	if err != nil {
+		return domain.ProjectConfig{}, err
+	}
 	if cfg.ProjectType != "" {
 		defaults := domain.DefaultConfigForType(cfg.ProjectType)
 		cfg = mergeConfig(defaults, cfg)
 	}
-
 	return cfg, nil
 }
 
@@ -73,8 +82,18 @@ func mergeConfig(base, override domain.ProjectConfig) domain.ProjectConfig {
 		result.MinThresholds = override.MinThresholds
 	}
 
-	// Profile overrides are always preserved from user config.
+	// Everything else is always preserved from user config.
+	result.Extends = override.Extends
+	result.ExtendsChecksum = override.ExtendsChecksum
 	result.Profile = override.Profile
+	result.Gates = override.Gates
+	result.Calibration = override.Calibration
+	result.Grades = override.Grades
+	result.Severity = override.Severity
+	result.PenaltyModel = override.PenaltyModel
+	result.Naming = override.Naming
+	result.Headers = override.Headers
+	result.Display = override.Display
 
 	return result
 }
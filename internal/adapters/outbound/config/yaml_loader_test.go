@@ -141,3 +141,76 @@ func TestYAMLLoader_EmptyFileReturnsDefaults(t *testing.T) {
 	require.NoError(t, err)
 	assert.Empty(t, cfg.ProjectType)
 }
+
+func TestYAMLLoader_GatesPreservedWithProjectType(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, `
+project_type: api
+gates:
+  min_overall: 80
+  min_category:
+    code_health: 75
+  max_issues:
+    error: 0
+`)
+	loader := appconfig.New()
+
+	cfg, err := loader.Load(dir)
+	require.NoError(t, err)
+	require.NotNil(t, cfg.Gates)
+	require.NotNil(t, cfg.Gates.MinOverall)
+	assert.Equal(t, 80, *cfg.Gates.MinOverall)
+	assert.Equal(t, 75, cfg.Gates.MinCategory["code_health"])
+	assert.Equal(t, 0, cfg.Gates.MaxIssues["error"])
+}
+
+func TestYAMLLoader_CalibrationAndGradesPreservedWithProjectType(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, `
+project_type: library
+calibration: strict
+grades:
+  - {grade: Gold, min: 85}
+  - {grade: Silver, min: 60}
+  - {grade: Bronze, min: 0}
+`)
+	cfg, err := appconfig.New().Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "strict", cfg.Calibration)
+	require.Len(t, cfg.Grades, 3)
+	assert.Equal(t, domain.GradeBand{Grade: "Silver", Min: 60}, cfg.Grades[1])
+}
+
+func TestYAMLLoader_ExtendsPreset(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, `
+extends: cli-app
+weights:
+  structure: 0.05
+severity:
+  - pattern: fmt-print
+    severity: error
+profile:
+  max_parameters: 6
+`)
+
+	cfg, err := appconfig.New().Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "cli-app", cfg.Extends)
+	assert.Equal(t, domain.ProjectTypeCLI, cfg.ProjectType)
+	assert.InDelta(t, 0.05, cfg.Weights["structure"], 0.001)
+	assert.InDelta(t, 0.20, cfg.Weights["verifiability"], 0.001)
+	require.NotNil(t, cfg.Profile)
+	assert.Equal(t, 6, *cfg.Profile.MaxParameters)
+	assert.Equal(t, 60, *cfg.Profile.MaxFunctionLines)
+	assert.Len(t, cfg.Severity, 1, "severity rules survive the project type merge")
+}
+
+func TestYAMLLoader_UnknownPreset(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, `extends: paranoid`)
+
+	_, err := appconfig.New().Load(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown preset")
+}
@@ -0,0 +1,118 @@
+package deprecations
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	goparser "go/parser"
+	"go/token"
+	"io"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// Finder implements domain.DeprecationFinder with go list, which resolves
+// each import path to its source directory through the project's go.mod,
+// and go/parser, which reads the doc comments there.
+type Finder struct{}
+
+func New() *Finder {
+	return &Finder{}
+}
+
+// listedPackage is the subset of go list -json output the finder reads.
+type listedPackage struct {
+	ImportPath string
+	Dir        string
+	GoFiles    []string
+}
+
+func (f *Finder) Deprecated(projectPath string, importPaths []string) (map[string]string, error) {
+	deprecated := make(map[string]string)
+	if len(importPaths) == 0 {
+		return deprecated, nil
+	}
+	pkgs, err := listPackages(projectPath, importPaths)
+	if err != nil {
+		return nil, err
+	}
+	for _, pkg := range pkgs {
+		for _, name := range pkg.GoFiles {
+			if err := collectDeprecated(pkg.ImportPath, filepath.Join(pkg.Dir, name), deprecated); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return deprecated, nil
+}
+
+// listPackages runs go list in projectPath. With -e, packages that fail to
+// load are still listed, without files, rather than failing the run.
+func listPackages(projectPath string, importPaths []string) ([]listedPackage, error) {
+	args := append([]string{"list", "-e", "-json=ImportPath,Dir,GoFiles"}, importPaths...)
+	cmd := exec.Command("go", args...)
+	cmd.Dir = projectPath
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list: %w: %s", err, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	var pkgs []listedPackage
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for {
+		var pkg listedPackage
+		if err := dec.Decode(&pkg); errors.Is(err, io.EOF) {
+			return pkgs, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("decoding go list output: %w", err)
+		}
+		pkgs = append(pkgs, pkg)
+	}
+}
+
+// collectDeprecated adds the deprecated exported functions and methods of
+// one file to deprecated, keyed as the call graph keys them.
+func collectDeprecated(importPath, file string, deprecated map[string]string) error {
+	f, err := goparser.ParseFile(token.NewFileSet(), file, nil, goparser.ParseComments|goparser.SkipObjectResolution)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", file, err)
+	}
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || !fn.Name.IsExported() {
+			continue
+		}
+		note := domain.DeprecationNote(fn.Doc.Text())
+		if note == "" {
+			continue
+		}
+		id := importPath + "." + fn.Name.Name
+		if fn.Recv != nil && len(fn.Recv.List) > 0 {
+			id = importPath + "." + receiverName(fn.Recv.List[0].Type) + "." + fn.Name.Name
+		}
+		deprecated[id] = note
+	}
+	return nil
+}
+
+// receiverName returns the type name of a receiver, without pointer or
+// type parameters.
+func receiverName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(t.X)
+	case *ast.IndexExpr:
+		return receiverName(t.X)
+	case *ast.IndexListExpr:
+		return receiverName(t.X)
+	case *ast.Ident:
+		return t.Name
+	}
+	return ""
+}
@@ -0,0 +1,44 @@
+package deprecations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinder_Deprecated(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/lib\n\ngo 1.21\n"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "store"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "store", "store.go"), []byte(`package store
+
+type Store struct{}
+
+// Get returns the value for key.
+//
+// Deprecated: use Lookup, which reports
+// whether the key exists.
+func (s *Store) Get(key string) string { return "" }
+
+// Lookup returns the value for key and whether it exists.
+func (s *Store) Lookup(key string) (string, bool) { return "", false }
+
+// Open opens a store.
+//
+// Deprecated: use New.
+func Open() *Store { return &Store{} }
+
+// Deprecated: unexported functions cannot be called from outside.
+func open() {}
+`), 0644))
+
+	deprecated, err := New().Deprecated(dir, []string{"example.com/lib/store", "example.com/lib/missing"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"example.com/lib/store.Store.Get": "Deprecated: use Lookup, which reports whether the key exists.",
+		"example.com/lib/store.Open":      "Deprecated: use New.",
+	}, deprecated)
+}
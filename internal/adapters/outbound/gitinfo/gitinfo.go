@@ -2,8 +2,16 @@ package gitinfo
 
 import (
 	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 )
 
 // GitInfoAdapter implements domain.GitInfo using go-git.
@@ -31,3 +39,191 @@ func (g *GitInfoAdapter) CommitHash(projectPath string) (string, error) {
 
 	return head.Hash().String(), nil
 }
+
+// FileChurn walks the commit log since the given time and counts, per file,
+// how many commits touched it. The repository may live above projectPath;
+// paths are returned relative to projectPath and files outside it are ignored.
+// Commits whose parents are unavailable (shallow clones) are skipped.
+func (g *GitInfoAdapter) FileChurn(projectPath string, since time.Time) (map[string]int, error) {
+	repo, err := git.PlainOpenWithOptions(projectPath, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return nil, fmt.Errorf("opening git repo: %w", err)
+	}
+
+	prefix, err := repoPrefix(repo, projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := repo.Log(&git.LogOptions{Since: &since})
+	if err != nil {
+		return nil, fmt.Errorf("reading git log: %w", err)
+	}
+
+	churn := make(map[string]int)
+	err = iter.ForEach(func(c *object.Commit) error {
+		stats, err := c.Stats()
+		if err != nil {
+			return nil
+		}
+		for _, st := range stats {
+			name := st.Name
+			if prefix != "" {
+				if !strings.HasPrefix(name, prefix+"/") {
+					continue
+				}
+				name = strings.TrimPrefix(name, prefix+"/")
+			}
+			churn[name]++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking git log: %w", err)
+	}
+	return churn, nil
+}
+
+// Worktree checks ref out into a temporary detached git worktree and returns
+// the directory inside it that corresponds to projectPath. The caller must
+// invoke cleanup to remove the worktree. Requires the git binary.
+func (g *GitInfoAdapter) Worktree(projectPath, ref string) (string, func() error, error) {
+	repo, err := git.PlainOpenWithOptions(projectPath, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return "", nil, fmt.Errorf("opening git repo: %w", err)
+	}
+	hash, err := repo.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		return "", nil, fmt.Errorf("resolving %q: %w", ref, err)
+	}
+	prefix, err := repoPrefix(repo, projectPath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "openkraft-worktree-*")
+	if err != nil {
+		return "", nil, fmt.Errorf("creating worktree dir: %w", err)
+	}
+	if out, err := runGit(projectPath, "worktree", "add", "--detach", dir, hash.String()); err != nil {
+		os.RemoveAll(dir)
+		return "", nil, fmt.Errorf("adding worktree for %q: %w: %s", ref, err, out)
+	}
+
+	cleanup := func() error {
+		_, err := runGit(projectPath, "worktree", "remove", "--force", dir)
+		os.RemoveAll(dir)
+		return err
+	}
+	return filepath.Join(dir, filepath.FromSlash(prefix)), cleanup, nil
+}
+
+// HasLocalChanges reports whether projectPath holds modified, staged or
+// untracked files, so its content may differ from the HEAD commit. The
+// .openkraft directory openkraft writes itself is ignored. Requires the
+// git binary.
+func (g *GitInfoAdapter) HasLocalChanges(projectPath string) (bool, error) {
+	out, err := runGit(projectPath, "status", "--porcelain", "--", ".", ":(exclude).openkraft")
+	if err != nil {
+		return false, fmt.Errorf("reading git status: %w: %s", err, out)
+	}
+	return out != "", nil
+}
+
+// Clone fetches ref (the default branch when empty) of the remote repository
+// at url into a temporary directory with a depth-1 fetch and returns the
+// directory. The caller must invoke cleanup to remove it. Requires the git
+// binary, which authenticates with the user's credential helpers and SSH
+// agent; it never prompts for a password. A url or ref starting with "-" is
+// rejected so that it cannot be taken for a git option.
+func (g *GitInfoAdapter) Clone(url, ref string) (string, func() error, error) {
+	if ref == "" {
+		ref = "HEAD"
+	}
+	if strings.HasPrefix(url, "-") {
+		return "", nil, fmt.Errorf("invalid repository URL %q", url)
+	}
+	if strings.HasPrefix(ref, "-") {
+		return "", nil, fmt.Errorf("invalid ref %q", ref)
+	}
+	dir, err := os.MkdirTemp("", "openkraft-clone-*")
+	if err != nil {
+		return "", nil, fmt.Errorf("creating clone dir: %w", err)
+	}
+	cleanup := func() error { return os.RemoveAll(dir) }
+
+	steps := [][]string{
+		{"init", "--quiet"},
+		{"fetch", "--quiet", "--depth", "1", "--", url, ref},
+		{"checkout", "--quiet", "--detach", "FETCH_HEAD"},
+	}
+	for _, args := range steps {
+		if out, err := runGit(dir, args...); err != nil {
+			cleanup()
+			return "", nil, fmt.Errorf("cloning %s at %s: %w: %s", url, ref, err, out)
+		}
+	}
+	return dir, cleanup, nil
+}
+
+// ParseRemote splits a remote repository argument such as
+// "github.com/org/repo@v1.2.0" into a clone URL and ref. Arguments with a
+// URL scheme or in scp form ("git@host:org/repo") are used as is; bare
+// "host/path" arguments whose host contains a dot get an https:// scheme.
+// The ref follows the last "@" after the final path separator. ok is false
+// for anything that looks like a local path.
+func ParseRemote(arg string) (url, ref string, ok bool) {
+	if at := strings.LastIndex(arg, "@"); at > strings.LastIndexAny(arg, "/:") {
+		arg, ref = arg[:at], arg[at+1:]
+	}
+	switch {
+	case strings.Contains(arg, "://"):
+		return arg, ref, true
+	case scpLike.MatchString(arg):
+		return arg, ref, true
+	}
+	host, _, found := strings.Cut(arg, "/")
+	if !found || !strings.Contains(host, ".") || strings.HasPrefix(host, ".") {
+		return "", "", false
+	}
+	return "https://" + arg, ref, true
+}
+
+// scpLike matches scp-style remotes such as git@github.com:org/repo.git.
+var scpLike = regexp.MustCompile(`^[\w.-]+@[\w.-]+:[^/]`)
+
+func runGit(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	out, err := cmd.CombinedOutput()
+	return strings.TrimSpace(string(out)), err
+}
+
+// repoPrefix returns projectPath relative to the repository worktree root,
+// using forward slashes, or "" when they are the same directory.
+func repoPrefix(repo *git.Repository, projectPath string) (string, error) {
+	wt, err := repo.Worktree()
+	if err != nil {
+		return "", fmt.Errorf("opening worktree: %w", err)
+	}
+	absProject, err := filepath.Abs(projectPath)
+	if err != nil {
+		return "", err
+	}
+	root, err := filepath.EvalSymlinks(wt.Filesystem.Root())
+	if err != nil {
+		return "", err
+	}
+	if resolved, err := filepath.EvalSymlinks(absProject); err == nil {
+		absProject = resolved
+	}
+	rel, err := filepath.Rel(root, absProject)
+	if err != nil {
+		return "", err
+	}
+	if rel == "." {
+		return "", nil
+	}
+	return filepath.ToSlash(rel), nil
+}
@@ -5,6 +5,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/gitinfo"
 	"github.com/stretchr/testify/assert"
@@ -58,3 +59,154 @@ func runGit(t *testing.T, dir string, args ...string) {
 	out, err := cmd.CombinedOutput()
 	require.NoError(t, err, "git %v: %s", args, string(out))
 }
+
+func TestGitInfo_FileChurn_CountsCommitsPerFile(t *testing.T) {
+	dir := t.TempDir()
+	runGit(t, dir, "init")
+	runGit(t, dir, "config", "user.email", "test@test.com")
+	runGit(t, dir, "config", "user.name", "Test")
+
+	sub := filepath.Join(dir, "svc")
+	require.NoError(t, os.MkdirAll(sub, 0755))
+	for i, content := range []string{"a", "b", "c"} {
+		require.NoError(t, os.WriteFile(filepath.Join(sub, "hot.go"), []byte(content), 0644))
+		if i == 0 {
+			require.NoError(t, os.WriteFile(filepath.Join(sub, "cold.go"), []byte("x"), 0644))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "root.txt"), []byte("x"), 0644))
+		}
+		runGit(t, dir, "add", ".")
+		runGit(t, dir, "commit", "-m", "change "+content)
+	}
+
+	gi := gitinfo.New()
+	churn, err := gi.FileChurn(sub, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 3, churn["hot.go"])
+	assert.Equal(t, 1, churn["cold.go"])
+	assert.NotContains(t, churn, "root.txt", "files outside the project path are ignored")
+}
+
+func TestGitInfo_FileChurn_NotGitRepo(t *testing.T) {
+	_, err := gitinfo.New().FileChurn(t.TempDir(), time.Now())
+	assert.Error(t, err)
+}
+
+func TestGitInfo_Worktree_ChecksOutRef(t *testing.T) {
+	dir := t.TempDir()
+	runGit(t, dir, "init")
+	runGit(t, dir, "config", "user.email", "test@test.com")
+	runGit(t, dir, "config", "user.name", "Test")
+
+	sub := filepath.Join(dir, "svc")
+	require.NoError(t, os.MkdirAll(sub, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(sub, "main.go"), []byte("v1"), 0644))
+	runGit(t, dir, "add", ".")
+	runGit(t, dir, "commit", "-m", "v1")
+	runGit(t, dir, "tag", "v1")
+	require.NoError(t, os.WriteFile(filepath.Join(sub, "main.go"), []byte("v2"), 0644))
+	runGit(t, dir, "commit", "-am", "v2")
+
+	wt, cleanup, err := gitinfo.New().Worktree(sub, "v1")
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(wt, "main.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(data), "worktree maps the project subdirectory at the ref")
+
+	require.NoError(t, cleanup())
+	assert.NoDirExists(t, wt)
+}
+
+func TestGitInfo_Worktree_UnknownRef(t *testing.T) {
+	dir := t.TempDir()
+	runGit(t, dir, "init")
+	_, _, err := gitinfo.New().Worktree(dir, "no-such-ref")
+	assert.Error(t, err)
+}
+
+func TestGitInfo_Clone_FetchesRef(t *testing.T) {
+	remote := t.TempDir()
+	runGit(t, remote, "init")
+	runGit(t, remote, "config", "user.email", "test@test.com")
+	runGit(t, remote, "config", "user.name", "Test")
+	require.NoError(t, os.WriteFile(filepath.Join(remote, "main.go"), []byte("v1"), 0644))
+	runGit(t, remote, "add", ".")
+	runGit(t, remote, "commit", "-m", "v1")
+	runGit(t, remote, "tag", "v1")
+	require.NoError(t, os.WriteFile(filepath.Join(remote, "main.go"), []byte("v2"), 0644))
+	runGit(t, remote, "commit", "-am", "v2")
+
+	for ref, want := range map[string]string{"v1": "v1", "": "v2"} {
+		dir, cleanup, err := gitinfo.New().Clone("file://"+remote, ref)
+		require.NoError(t, err)
+
+		data, err := os.ReadFile(filepath.Join(dir, "main.go"))
+		require.NoError(t, err)
+		assert.Equal(t, want, string(data), "ref %q", ref)
+
+		require.NoError(t, cleanup())
+		assert.NoDirExists(t, dir)
+	}
+}
+
+func TestGitInfo_Clone_UnknownRemote(t *testing.T) {
+	_, _, err := gitinfo.New().Clone("file://"+filepath.Join(t.TempDir(), "missing"), "")
+	assert.Error(t, err)
+}
+
+func TestGitInfo_Clone_RejectsOptionLikeArguments(t *testing.T) {
+	_, _, err := gitinfo.New().Clone("--upload-pack=touch /tmp/pwned", "")
+	assert.ErrorContains(t, err, "invalid repository URL")
+
+	_, _, err = gitinfo.New().Clone("https://github.com/org/repo", "--upload-pack=touch /tmp/pwned")
+	assert.ErrorContains(t, err, "invalid ref")
+}
+
+func TestParseRemote(t *testing.T) {
+	tests := []struct {
+		arg     string
+		wantURL string
+		wantRef string
+		wantOK  bool
+	}{
+		{"github.com/org/repo", "https://github.com/org/repo", "", true},
+		{"github.com/org/repo@v1.2.0", "https://github.com/org/repo", "v1.2.0", true},
+		{"https://gitlab.com/org/repo.git@main", "https://gitlab.com/org/repo.git", "main", true},
+		{"git@github.com:org/repo.git", "git@github.com:org/repo.git", "", true},
+		{"git@github.com:org/repo.git@abc123", "git@github.com:org/repo.git", "abc123", true},
+		{"file:///tmp/repo", "file:///tmp/repo", "", true},
+		{"./internal/app", "", "", false},
+		{"internal/app", "", "", false},
+		{".", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			url, ref, ok := gitinfo.ParseRemote(tt.arg)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantURL, url)
+			assert.Equal(t, tt.wantRef, ref)
+		})
+	}
+}
+
+func TestGitInfo_HasLocalChanges(t *testing.T) {
+	dir := t.TempDir()
+	runGit(t, dir, "init")
+	runGit(t, dir, "config", "user.email", "test@test.com")
+	runGit(t, dir, "config", "user.name", "Test")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0644))
+	runGit(t, dir, "add", ".")
+	runGit(t, dir, "commit", "-m", "init")
+
+	gi := gitinfo.New()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".openkraft", "history"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".openkraft", "history", "scores.json"), []byte("[]"), 0644))
+	dirty, err := gi.HasLocalChanges(dir)
+	require.NoError(t, err)
+	assert.False(t, dirty, ".openkraft is openkraft's own output")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0644))
+	dirty, err = gi.HasLocalChanges(dir)
+	require.NoError(t, err)
+	assert.True(t, dirty)
+}
@@ -23,6 +23,11 @@ func (h *FileHistory) Save(projectPath string, entry domain.ScoreEntry) error {
 		return err
 	}
 
+	// Only the latest entry keeps its fingerprints; they exist to diff the
+	// next run against.
+	for i := range entries {
+		entries[i].Fingerprints = nil
+	}
 	entries = append(entries, entry)
 
 	fp := filepath.Join(projectPath, historyFile)
@@ -67,3 +67,17 @@ func TestHistory_CreatesDirectory(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, entries, 1)
 }
+
+func TestHistory_KeepsFingerprintsOnLatestEntryOnly(t *testing.T) {
+	dir := t.TempDir()
+	h := history.New()
+
+	require.NoError(t, h.Save(dir, domain.ScoreEntry{Timestamp: "t1", Fingerprints: []string{"a", "b"}}))
+	require.NoError(t, h.Save(dir, domain.ScoreEntry{Timestamp: "t2", Fingerprints: []string{"a"}}))
+
+	entries, err := h.Load(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Nil(t, entries[0].Fingerprints)
+	assert.Equal(t, []string{"a"}, entries[1].Fingerprints)
+}
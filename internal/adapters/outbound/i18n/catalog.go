@@ -0,0 +1,105 @@
+package i18n
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// Languages lists the supported output languages; English is the source
+// language of every message and label.
+var Languages = []string{"en", "es", "de"}
+
+// translations holds, per language, issue message templates keyed by
+// domain message ID ({0}, {1}... stand for Issue.MessageArgs) and report
+// labels keyed by their English text.
+var translations = map[string]struct {
+	messages map[string]string
+	labels   map[string]string
+}{
+	"es": {messages: esMessages, labels: esLabels},
+	"de": {messages: deMessages, labels: deLabels},
+}
+
+// Catalog translates issue messages and report labels into one language.
+// Message IDs never change; only the human-readable text does. Messages
+// and labels without a translation are kept in English.
+type Catalog struct {
+	lang     string
+	messages map[string]string
+	labels   map[string]string
+}
+
+// New returns the catalog for lang, one of Languages.
+func New(lang string) (*Catalog, error) {
+	if lang == "en" {
+		return &Catalog{lang: lang}, nil
+	}
+	t, ok := translations[lang]
+	if !ok {
+		return nil, fmt.Errorf("unsupported language %q (supported: %s)", lang, strings.Join(Languages, ", "))
+	}
+	return &Catalog{lang: lang, messages: t.messages, labels: t.labels}, nil
+}
+
+// Lang returns the catalog's language code.
+func (c *Catalog) Lang() string { return c.lang }
+
+// Label translates an English report label.
+func (c *Catalog) Label(label string) string {
+	if t, ok := c.labels[label]; ok {
+		return t
+	}
+	return label
+}
+
+// Message returns the issue's message in the catalog language.
+func (c *Catalog) Message(iss domain.Issue) string {
+	tmpl, ok := c.messages[iss.MessageID]
+	if !ok {
+		return iss.Message
+	}
+	pairs := make([]string, 0, 2*len(iss.MessageArgs))
+	for i, arg := range iss.MessageArgs {
+		pairs = append(pairs, "{"+strconv.Itoa(i)+"}", arg)
+	}
+	return strings.NewReplacer(pairs...).Replace(tmpl)
+}
+
+// TranslateScore returns a copy of score whose issue messages are in the
+// catalog language. Fingerprints, message IDs and arguments are kept, so
+// tooling matches issues across languages.
+func (c *Catalog) TranslateScore(score *domain.Score) *domain.Score {
+	if score == nil || c.messages == nil {
+		return score
+	}
+	out := *score
+	out.Categories = make([]domain.CategoryScore, len(score.Categories))
+	for i, cat := range score.Categories {
+		cat.Issues = c.translateIssues(cat.Issues)
+		out.Categories[i] = cat
+	}
+	out.Findings = make([]domain.Finding, len(score.Findings))
+	for i, f := range score.Findings {
+		f.Issues = c.translateIssues(f.Issues)
+		out.Findings[i] = f
+	}
+	if score.Findings == nil {
+		out.Findings = nil
+	}
+	return &out
+}
+
+func (c *Catalog) translateIssues(issues []domain.Issue) []domain.Issue {
+	if issues == nil {
+		return nil
+	}
+	out := make([]domain.Issue, len(issues))
+	for i, iss := range issues {
+		iss.Message = c.Message(iss)
+		out[i] = iss
+	}
+	return out
+}
@@ -0,0 +1,76 @@
+package i18n_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/i18n"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+var verb = regexp.MustCompile(`%[-+# 0]*[0-9]*(?:\.[0-9]+)?[a-zA-Z]`)
+
+func TestCatalogs_TranslateEveryMessageWithItsArguments(t *testing.T) {
+	for _, lang := range []string{"es", "de"} {
+		c, err := i18n.New(lang)
+		require.NoError(t, err)
+		for _, id := range domain.MessageIDs() {
+			format, _ := domain.MessageFormat(id)
+			n := len(verb.FindAllString(strings.ReplaceAll(format, "%%", ""), -1))
+			args := make([]string, n)
+			for i := range args {
+				args[i] = "ARG" + string(rune('A'+i))
+			}
+
+			got := c.Message(domain.Issue{MessageID: id, MessageArgs: args, Message: "english"})
+
+			assert.NotEqual(t, "english", got, "%s: %s has no translation", lang, id)
+			for _, a := range args {
+				assert.Contains(t, got, a, "%s: %s drops an argument", lang, id)
+			}
+			assert.NotContains(t, got, "{", "%s: %s has an unfilled placeholder", lang, id)
+		}
+	}
+}
+
+func TestCatalog_KeepsUntranslatedMessagesAndLabels(t *testing.T) {
+	c, err := i18n.New("de")
+	require.NoError(t, err)
+
+	assert.Equal(t, "missing go.sum entry", c.Message(domain.Issue{Message: "missing go.sum entry"}))
+	assert.Equal(t, "Befunde", c.Label("Issues"))
+	assert.Equal(t, "unknown label", c.Label("unknown label"))
+}
+
+func TestCatalog_TranslateScoreKeepsIdentity(t *testing.T) {
+	iss := domain.Issue{Severity: domain.SeverityWarning, File: "a.go"}.WithMessage("function_size.lines", "Run", 80, 50)
+	iss.Fingerprint = domain.IssueFingerprint(iss)
+	score := &domain.Score{Categories: []domain.CategoryScore{{Name: "code_health", Issues: []domain.Issue{iss}}}}
+	score.Findings = domain.GroupFindings(score.Categories)
+
+	c, err := i18n.New("es")
+	require.NoError(t, err)
+	out := c.TranslateScore(score)
+
+	got := out.Categories[0].Issues[0]
+	assert.Equal(t, "la función Run tiene 80 líneas (>50)", got.Message)
+	assert.Equal(t, iss.Fingerprint, got.Fingerprint)
+	assert.Equal(t, "function_size.lines", got.MessageID)
+	assert.Equal(t, got.Message, out.Findings[0].Issues[0].Message)
+	assert.Equal(t, "function Run is 80 lines (>50)", score.Categories[0].Issues[0].Message, "input is untouched")
+}
+
+func TestNew_RejectsUnknownLanguage(t *testing.T) {
+	_, err := i18n.New("fr")
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "en, es, de"))
+
+	c, err := i18n.New("en")
+	require.NoError(t, err)
+	score := &domain.Score{}
+	assert.Same(t, score, c.TranslateScore(score))
+}
@@ -0,0 +1,104 @@
+package i18n
+
+var deMessages = map[string]string{
+	"function_size.lines":           "Funktion {0} hat {1} Zeilen (>{2})",
+	"cognitive_complexity.exceeded": "Funktion {0} hat kognitive Komplexität {1} (>{2})",
+	"parameter_count.exceeded":      "Funktion {0} hat {1} Parameter (>{2})",
+	"file_size.lines":               "Datei hat {0} Zeilen (>{1})",
+	"file_size.foreign_lines":       "{0}-Datei hat {1} Zeilen (>{2})",
+	"code_duplication.string":       "die Zeichenkette {0} kommt {1}-mal in {2} Dateien vor ({3}); verschiebe sie in eine Konstante",
+	"code_duplication.percent":      "Datei hat {0}% doppelte Zeilen ({1} Zeilen, >{2}%)",
+
+	"naming_uniqueness.single_word":            "exportierte Funktion {0} hat einen Namen aus einem Wort; erwägen Sie das Muster Verb+Substantiv",
+	"naming_uniqueness.too_many_words":         "exportierte Funktion {0} hat {1} Wörter; erwägen Sie ein kürzeres Verb+Substantiv-Muster",
+	"naming_uniqueness.duplicate_name":         "exportierte Funktion {0} kommt in {1} Paketen vor",
+	"naming_uniqueness.vague_package":          "Paketname {0} ist unspezifisch; erwägen Sie einen aussagekräftigeren Namen",
+	"naming_uniqueness.single_letter_params":   "exportierte Funktion {0} hat {1} Parameter mit nur einem Buchstaben",
+	"naming_uniqueness.over_exported":          "das interne Paket {0} wird nur von {1} importiert, das {2} seiner {3} exportierten Symbole nie verwendet: {4}",
+	"naming_uniqueness.stutter":                "{0}.{1} wiederholt den Paketnamen; erwäge {2}.{3}",
+	"naming_uniqueness.rule":                   "{0} {1} entspricht nicht der Namensregel {2} ({3})",
+	"naming_uniqueness.interface_er":           "Interface {0} mit einer Methode sollte nach ihrer Methode {1} mit der Endung -er benannt sein (z. B. {2})",
+	"naming_uniqueness.interface_prefix":       "Interface {0} verwendet ein I-Präfix; benenne es nach seinem Verhalten (z. B. {1})",
+	"naming_uniqueness.interface_suffix":       "Interface {0} wiederholt Interface im Namen; entferne die Endung (z. B. {1})",
+	"file_naming_conventions.bare":             "Datei {0} verwendet einfache Namen, das Projekt aber Suffixe",
+	"file_naming_conventions.suffixed":         "Datei {0} verwendet Suffixe, das Projekt aber einfache Namen",
+	"file_naming_conventions.foreign":          "{0}-Datei {1} folgt nicht der {2}-Namenskonvention",
+	"file_naming_conventions.embed_var":        "embed-Variable {0} benennt den eingebetteten Inhalt ({1}) nicht",
+	"predictable_structure.missing_layer":      "Modul {0} fehlt die Schicht {1}, die {2}/{3} Nachbarmodule haben",
+	"predictable_structure.file_type_missing":  "Datei {0} deklariert {1} nicht; sie deklariert {2}",
+	"predictable_structure.type_file_mismatch": "Typ {0} hat {1} Zeilen Methoden, ist aber in {2} deklariert; verschieben Sie ihn nach {3}",
+	"predictable_structure.package_depth":      "Paket {0} ist {1} Verzeichnisse tief verschachtelt (max. {2}); flachen Sie den Baum ab",
+	"predictable_structure.package_sprawl":     "{0} von {1} Paketen enthalten nur eine Datei mit höchstens {2} Zeilen",
+	"predictable_structure.embed_location":     "eingebettete Ressourcen {0} liegen in {1}; verwenden Sie ein übliches Verzeichnis wie templates/, static/ oder migrations/",
+	"predictable_structure.docs_missing":       "keine README.md, ARCHITECTURE.md oder docs/ beschreibt die {0} Module des Projekts",
+	"predictable_structure.docs_unmentioned":   "Modul {0} wird weder in README.md, ARCHITECTURE.md noch in docs/ erwähnt",
+	"predictable_structure.docs_stale":         "Dokumentation verweist auf {0}, das nicht existiert",
+	"dependency_direction.violation":           "Schicht {0} importiert {1} (Verstoß gegen die Abhängigkeitsrichtung)",
+	"dependency_direction.import_cycle":        "Importzyklus: {0}",
+	"dependency_direction.coupling_outlier":    "Paket {0} importiert {1} interne Pakete (Median ist {2})",
+	"function_coupling.fan_out":                "Funktion {0} ruft {1} verschiedene Funktionen auf (>{2})",
+	"function_coupling.hub":                    "Funktion {0} ist ein Knotenpunkt: von {1} Funktionen aufgerufen, ruft {2} auf",
+	"predictable_structure.embedding_depth":    "{0} bettet Typen {1} Ebenen tief ein ({2}), mehr als {3}",
+	"predictable_structure.embedding_diamond":  "{0} bettet {1} sowohl über {2} als auch über {3} ein, daher ist unklar, über welchen Pfad seine Member kommen",
+	"package_cohesion.split":                   "Paket {0} zerfällt in {1} unabhängige Dateigruppen über {2} Dateien (Kohäsion {3} < {4})",
+
+	"structure.no_modules":                "keine Module erkannt; Struktur kann nicht bewertet werden",
+	"interface_contracts.no_ports":        "Modul {0} hat eine Domain-/Application-Schicht, aber keine Port-Interfaces",
+	"interface_granularity.fat_interface": "Interface {0} hat {1} Methoden (>{2}); teile es in kleinere, rollenspezifische Interfaces auf",
+	"verifiability.missing":               "{0} fehlt: {1}",
+	"context_quality.no_claude_md":        "CLAUDE.md nicht gefunden; fügen Sie sie hinzu, um KI-Agenten Projektkontext zu geben",
+	"context_quality.no_cursorrules":      ".cursorrules nicht gefunden; fügen Sie sie für die Cursor-IDE-Integration hinzu",
+	"context_quality.no_agents_md":        "AGENTS.md nicht gefunden; fügen Sie sie hinzu, um Agenten-Workflows zu beschreiben",
+	"context_quality.package_doc_missing": "Paket {0} hat keinen Paketkommentar (importiert von {1} Paketen); fügen Sie einen in einer doc.go hinzu",
+	"context_quality.package_doc_empty":   "Paket {0} hat eine doc.go ohne Paketkommentar (importiert von {1} Paketen)",
+
+	"predictability.no_error_handling":            "in keiner Quelldatei wurde Fehlerbehandlung gefunden",
+	"predictability.global_vars":                  "Datei hat {0} Variablen auf Paketebene (bevorzugen Sie explizite Injektion)",
+	"predictability.init_functions":               "Datei hat {0} init()-Funktion(en) (bevorzugen Sie explizite Initialisierung)",
+	"consistent_patterns.mock_in_production":      "Mock {0} ist im Produktionscode definiert; verschieben Sie ihn in ein mocks/-Paket oder eine *_mock.go-Datei",
+	"consistent_patterns.getter_prefix":           "Getter {0}.{1} sollte ohne Get-Präfix auskommen ({2})",
+	"consistent_patterns.getter_field":            "Getter {0}.{1} gibt das Feld {2} zurück; benenne ihn nach dem zurückgegebenen Feld",
+	"consistent_patterns.setter_error":            "Setter {0}.{1} kann fehlschlagen (ruft {2} auf), gibt aber keinen Fehler zurück",
+	"consistent_patterns.missing_constructor":     "exportiertes Struct {0} hat nicht exportierte Felder, aber keinen Konstruktor (z. B. New{1})",
+	"consistent_patterns.constructor_error":       "Konstruktor {0} prüft {1}, gibt aber keinen Fehler zurück; gib ({2}, error) zurück",
+	"consistent_patterns.constructor_nil_check":   "Konstruktor {0} prüft das Pflichtargument {1} nicht auf nil",
+	"consistent_patterns.imports_unsorted":        "Imports sind innerhalb ihrer Gruppe nicht sortiert ({0} vor {1}); führe goimports aus",
+	"consistent_patterns.imports_mixed":           "Importgruppe mischt {0}- und {1}-Imports ({2}, {3}); trenne sie durch eine Leerzeile",
+	"consistent_patterns.imports_stdlib_last":     "Standardbibliotheks-Import {0} steht nach {1}; setze die Gruppe der Standardbibliothek an den Anfang",
+	"consistent_patterns.alias_inconsistent":      "Import {0} heißt hier {1}, aber in {3} anderen Dateien {2}",
+	"consistent_patterns.receiver_inconsistent":   "eine Methode von {0} nutzt den Receiver {1}; die anderen Methoden nutzen {2} ({3}-mal)",
+	"consistent_patterns.receiver_self":           "eine Methode von {0} nutzt den Receiver {1}; benenne ihn nach dem Typ, z. B. {2}",
+	"consistent_patterns.naked_return":            "Funktion {0} hat {1} nackte Returns in {2} Zeilen; gib die Werte explizit zurück",
+	"hardcoded_secrets.secret":                    "möglicherweise fest kodierter {0} in {1}",
+	"sql_injection.concat":                        "Aufruf von {0} fügt {1} in sein SQL ein; übergib die Werte als Abfrageargumente",
+	"file_access.permissive_mode":                 "{0} mit Modus {1} macht die {2} für alle Benutzer des Hosts beschreibbar",
+	"file_access.path_traversal":                  "aus der Anfrageeingabe {0} gebildeter Pfad kann über .. übergeordnete Verzeichnisse erreichen",
+	"logging_consistency.mixed_loggers":           "Datei loggt mit {0}, während das Projekt {1} nutzt",
+	"logging_consistency.fmt_print":               "Funktion {0} gibt außerhalb von package main mit fmt.{1} aus, obwohl die Ausgabe dem Aufrufer oder dem Logger gehört",
+	"logging_consistency.error_log_without_error": "Log-Aufruf auf Fehlerebene übergibt den Fehler nicht, daher geht die Ursache verloren",
+	"consistent_patterns.process_exit":            "Funktion {0} ruft {1} außerhalb von package main auf und beendet den Prozess, ohne dass Aufrufer oder Tests reagieren können",
+	"consistent_patterns.tag_casing":              "Struct {0} hat {1}-Tags {2}, die vom im Projekt üblichen {3}-Stil abweichen",
+	"consistent_patterns.tag_missing":             "Struct {0} hat json-Tags, aber die exportierten Felder {1} haben keine und werden unter ihrem Go-Namen kodiert",
+	"consistent_patterns.tag_duplicate":           "Struct {0} gibt den Feldern {1} und {2} denselben {3}-Tag {4}, daher geht einer beim Kodieren verloren",
+	"consistent_patterns.same_type_params":        "Funktion {0} nimmt {1} aufeinanderfolgende {2}-Parameter ({3}), die Aufrufer unbemerkt vertauschen können",
+	"consistent_patterns.magic_number":            "die Zahl {0} kommt {1}-mal in Paket {2} vor ({3}); benenne sie mit einer Konstante",
+	"consistent_patterns.alias_shadows":           "Import-Alias {0} für {1} verdeckt einen vordeklarierten Bezeichner",
+	"consistent_patterns.header_missing":          "der Dateikopf entspricht nicht dem geforderten Muster {0}",
+	"consistent_patterns.deprecated_call":         "{0} ruft {1} auf. {2}",
+	"consistent_patterns.mock_import":             "Produktionscode importiert das Mock-Paket {0}",
+	"consistent_patterns.test_helper_exported":    "Test-Helfer {0} wird aus Produktionscode exportiert; verschieben Sie ihn in ein testutil-Paket",
+	"consistent_patterns.test_helper_duplicated":  "Test-Helfer {0} ist in {1} Paketen definiert; stellen Sie eine Kopie aus einem testutil-Paket bereit",
+	"consistent_patterns.test_helper_import":      "Produktionscode importiert das Test-Helfer-Paket {0}",
+}
+
+var deLabels = map[string]string{
+	"AI-Readiness Score":   "KI-Bereitschaftswert",
+	"Issues":               "Befunde",
+	"%d errors":            "%d Fehler",
+	"%d warnings":          "%d Warnungen",
+	"%d info":              "%d Hinweise",
+	"No issues found.":     "Keine Befunde.",
+	"fix: ":                "Behebung: ",
+	"%d related issues":    "%d zusammenhängende Befunde",
+	"build-tagged files: ": "Dateien mit Build-Tags: ",
+}
@@ -0,0 +1,104 @@
+package i18n
+
+var esMessages = map[string]string{
+	"function_size.lines":           "la función {0} tiene {1} líneas (>{2})",
+	"cognitive_complexity.exceeded": "la función {0} tiene complejidad cognitiva {1} (>{2})",
+	"parameter_count.exceeded":      "la función {0} tiene {1} parámetros (>{2})",
+	"file_size.lines":               "el archivo tiene {0} líneas (>{1})",
+	"file_size.foreign_lines":       "el archivo {0} tiene {1} líneas (>{2})",
+	"code_duplication.string":       "la cadena {0} aparece {1} veces en {2} archivos ({3}); muévela a una constante",
+	"code_duplication.percent":      "el archivo tiene {0}% de líneas duplicadas ({1} líneas, >{2}%)",
+
+	"naming_uniqueness.single_word":            "la función exportada {0} tiene un nombre de una sola palabra; considere el patrón verbo+sustantivo",
+	"naming_uniqueness.too_many_words":         "la función exportada {0} tiene {1} palabras; considere un patrón verbo+sustantivo más corto",
+	"naming_uniqueness.duplicate_name":         "la función exportada {0} aparece en {1} paquetes",
+	"naming_uniqueness.vague_package":          "el paquete {0} tiene un nombre vago; considere un nombre más descriptivo",
+	"naming_uniqueness.single_letter_params":   "la función exportada {0} tiene {1} parámetros de una sola letra",
+	"naming_uniqueness.over_exported":          "el paquete interno {0} solo lo importa {1}, que no usa {2} de sus {3} símbolos exportados: {4}",
+	"naming_uniqueness.stutter":                "{0}.{1} repite el nombre del paquete; considera {2}.{3}",
+	"naming_uniqueness.rule":                   "{0} {1} no cumple la regla de nombres {2} ({3})",
+	"naming_uniqueness.interface_er":           "la interfaz de un solo método {0} debería llamarse como su método {1} con el sufijo -er (p. ej. {2})",
+	"naming_uniqueness.interface_prefix":       "la interfaz {0} usa el prefijo I; nómbrala por su comportamiento (p. ej. {1})",
+	"naming_uniqueness.interface_suffix":       "la interfaz {0} repite Interface en su nombre; quita el sufijo (p. ej. {1})",
+	"file_naming_conventions.bare":             "el archivo {0} usa nombres simples pero el proyecto usa sufijos",
+	"file_naming_conventions.suffixed":         "el archivo {0} usa sufijos pero el proyecto usa nombres simples",
+	"file_naming_conventions.foreign":          "el archivo {0} {1} no sigue la convención de nombres {2}",
+	"file_naming_conventions.embed_var":        "la variable embed {0} no nombra lo embebido ({1})",
+	"predictable_structure.missing_layer":      "al módulo {0} le falta la capa {1} que tienen {2}/{3} de sus pares",
+	"predictable_structure.file_type_missing":  "el archivo {0} no declara {1}; declara {2}",
+	"predictable_structure.type_file_mismatch": "el tipo {0} tiene {1} líneas de métodos pero se declara en {2}; muévalo a {3}",
+	"predictable_structure.package_depth":      "el paquete {0} está anidado a {1} directorios de profundidad (máx. {2}); aplane el árbol",
+	"predictable_structure.package_sprawl":     "{0} de {1} paquetes contienen un solo archivo de como mucho {2} líneas",
+	"predictable_structure.embed_location":     "los recursos embebidos {0} están en {1}; use un directorio convencional como templates/, static/ o migrations/",
+	"predictable_structure.docs_missing":       "ningún README.md, ARCHITECTURE.md ni docs/ describe los {0} módulos del proyecto",
+	"predictable_structure.docs_unmentioned":   "el módulo {0} no se menciona en README.md, ARCHITECTURE.md ni docs/",
+	"predictable_structure.docs_stale":         "la documentación hace referencia a {0}, que no existe",
+	"dependency_direction.violation":           "la capa {0} importa {1} (violación de la dirección de dependencias)",
+	"dependency_direction.import_cycle":        "ciclo de importación: {0}",
+	"dependency_direction.coupling_outlier":    "el paquete {0} importa {1} paquetes internos (la mediana es {2})",
+	"function_coupling.fan_out":                "la función {0} llama a {1} funciones distintas (>{2})",
+	"function_coupling.hub":                    "la función {0} es un nodo central: la llaman {1} funciones y llama a {2}",
+	"predictable_structure.embedding_depth":    "{0} incrusta tipos con {1} niveles de profundidad ({2}), más de {3}",
+	"predictable_structure.embedding_diamond":  "{0} incrusta {1} a través de {2} y de {3}, así que no se sabe por qué camino llegan sus miembros",
+	"package_cohesion.split":                   "el paquete {0} se divide en {1} grupos de archivos no relacionados en {2} archivos (cohesión {3} < {4})",
+
+	"structure.no_modules":                "no se detectaron módulos; no se puede evaluar la estructura",
+	"interface_contracts.no_ports":        "el módulo {0} tiene capa de dominio/aplicación pero ninguna interfaz de puerto",
+	"interface_granularity.fat_interface": "la interfaz {0} tiene {1} métodos (>{2}); divídela en interfaces más pequeñas, una por rol",
+	"verifiability.missing":               "falta {0}: {1}",
+	"context_quality.no_claude_md":        "no se encontró CLAUDE.md; agréguelo para dar contexto del proyecto a los agentes de IA",
+	"context_quality.no_cursorrules":      "no se encontró .cursorrules; agréguelo para integrar el IDE Cursor",
+	"context_quality.no_agents_md":        "no se encontró AGENTS.md; agréguelo para describir los flujos de trabajo de los agentes",
+	"context_quality.package_doc_missing": "el paquete {0} no tiene comentario de paquete (importado por {1} paquetes); agregue uno en un doc.go",
+	"context_quality.package_doc_empty":   "el paquete {0} tiene un doc.go sin comentario de paquete (importado por {1} paquetes)",
+
+	"predictability.no_error_handling":            "no se encontró manejo de errores en ningún archivo fuente",
+	"predictability.global_vars":                  "el archivo tiene {0} variables a nivel de paquete (prefiera la inyección explícita)",
+	"predictability.init_functions":               "el archivo tiene {0} funciones init() (prefiera la inicialización explícita)",
+	"consistent_patterns.mock_in_production":      "el mock {0} está definido en código de producción; muévalo a un paquete mocks/ o a un archivo *_mock.go",
+	"consistent_patterns.getter_prefix":           "el getter {0}.{1} debería omitir el prefijo Get ({2})",
+	"consistent_patterns.getter_field":            "el getter {0}.{1} devuelve el campo {2}; nómbralo como el campo que devuelve",
+	"consistent_patterns.setter_error":            "el setter {0}.{1} puede fallar (llama a {2}) pero no devuelve un error",
+	"consistent_patterns.missing_constructor":     "el struct exportado {0} tiene campos no exportados pero ningún constructor (p. ej. New{1})",
+	"consistent_patterns.constructor_error":       "el constructor {0} comprueba {1} pero no devuelve un error; devuelve ({2}, error)",
+	"consistent_patterns.constructor_nil_check":   "el constructor {0} no comprueba si el argumento obligatorio {1} es nil",
+	"consistent_patterns.imports_unsorted":        "los imports no están ordenados dentro de su grupo ({0} antes de {1}); ejecuta goimports",
+	"consistent_patterns.imports_mixed":           "el grupo de imports mezcla imports de {0} y de {1} ({2}, {3}); sepáralos con una línea en blanco",
+	"consistent_patterns.imports_stdlib_last":     "el import de la biblioteca estándar {0} aparece después de {1}; pon primero el grupo de la biblioteca estándar",
+	"consistent_patterns.alias_inconsistent":      "el import {0} usa el alias {1} aquí pero {2} en otros {3} archivos",
+	"consistent_patterns.receiver_inconsistent":   "un método de {0} usa el receptor {1}; los demás métodos usan {2} ({3} veces)",
+	"consistent_patterns.receiver_self":           "un método de {0} usa el receptor {1}; nómbralo según el tipo, p. ej. {2}",
+	"consistent_patterns.naked_return":            "la función {0} tiene {1} returns sin valores en {2} líneas; devuelve los valores explícitamente",
+	"hardcoded_secrets.secret":                    "posible {0} escrito en el código en {1}",
+	"sql_injection.concat":                        "la llamada a {0} inserta {1} en su SQL; pasa los valores como argumentos de la consulta",
+	"file_access.permissive_mode":                 "{0} con modo {1} deja el {2} escribible para todos los usuarios del host",
+	"file_access.path_traversal":                  "la ruta construida con la entrada de la petición {0} puede llegar a directorios superiores con ..",
+	"logging_consistency.mixed_loggers":           "el archivo registra con {0} mientras el proyecto usa {1}",
+	"logging_consistency.fmt_print":               "la función {0} imprime con fmt.{1} fuera del paquete main, donde la salida corresponde a quien llama o al logger",
+	"logging_consistency.error_log_without_error": "la llamada de log de nivel error no pasa el error, así que se pierde la causa",
+	"consistent_patterns.process_exit":            "la función {0} llama a {1} fuera del paquete main y termina el proceso donde ni quien llama ni los tests pueden recuperarse",
+	"consistent_patterns.tag_casing":              "el struct {0} tiene etiquetas {1} {2} que rompen el estilo {3} que usa el proyecto",
+	"consistent_patterns.tag_missing":             "el struct {0} tiene etiquetas json, pero los campos exportados {1} no tienen ninguna y se codifican con su nombre en Go",
+	"consistent_patterns.tag_duplicate":           "el struct {0} da a los campos {1} y {2} la misma etiqueta {3} {4}, así que uno se pierde al codificar",
+	"consistent_patterns.same_type_params":        "la función {0} recibe {1} parámetros {2} consecutivos ({3}) que quien la llama puede intercambiar sin notarlo",
+	"consistent_patterns.magic_number":            "el número {0} aparece {1} veces en el paquete {2} ({3}); dale nombre con una constante",
+	"consistent_patterns.alias_shadows":           "el alias de import {0} para {1} oculta un identificador predeclarado",
+	"consistent_patterns.header_missing":          "la cabecera del archivo no coincide con el patrón requerido {0}",
+	"consistent_patterns.deprecated_call":         "{0} llama a {1}. {2}",
+	"consistent_patterns.mock_import":             "el código de producción importa el paquete de mocks {0}",
+	"consistent_patterns.test_helper_exported":    "el helper de test {0} se exporta desde código de producción; muévalo a un paquete testutil",
+	"consistent_patterns.test_helper_duplicated":  "el helper de test {0} está definido en {1} paquetes; comparta una sola copia desde un paquete testutil",
+	"consistent_patterns.test_helper_import":      "el código de producción importa el paquete de helpers de test {0}",
+}
+
+var esLabels = map[string]string{
+	"AI-Readiness Score":   "Puntuación de preparación para IA",
+	"Issues":               "Problemas",
+	"%d errors":            "%d errores",
+	"%d warnings":          "%d advertencias",
+	"%d info":              "%d informativos",
+	"No issues found.":     "No se encontraron problemas.",
+	"fix: ":                "solución: ",
+	"%d related issues":    "%d problemas relacionados",
+	"build-tagged files: ": "archivos con etiquetas de compilación: ",
+}
@@ -0,0 +1,147 @@
+// Package notify posts scoring run summaries to webhooks: Slack incoming
+// webhooks, Microsoft Teams workflows, or any endpoint taking JSON.
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// Payload formats a Webhook can post.
+const (
+	FormatSlack = "slack"
+	FormatTeams = "teams"
+	FormatJSON  = "json"
+)
+
+// Formats lists the payload formats, for flag validation and completion.
+var Formats = []string{FormatSlack, FormatTeams, FormatJSON}
+
+// Webhook is a domain.Notifier posting to one webhook URL.
+type Webhook struct {
+	url    string
+	format string
+	client *http.Client
+}
+
+// NewWebhook returns a notifier posting to rawURL in format, or in the
+// format its host implies when format is empty: slack for
+// hooks.slack.com, teams for Microsoft workflow and connector hosts, json
+// otherwise.
+func NewWebhook(rawURL, format string) (*Webhook, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return nil, fmt.Errorf("webhook %q is not an http(s) URL", redact(rawURL))
+	}
+	if format == "" {
+		format = formatForHost(u.Hostname())
+	}
+	switch format {
+	case FormatSlack, FormatTeams, FormatJSON:
+	default:
+		return nil, fmt.Errorf("unknown webhook format %q (supported: %s)", format, strings.Join(Formats, ", "))
+	}
+	return &Webhook{url: rawURL, format: format, client: &http.Client{Timeout: 30 * time.Second}}, nil
+}
+
+// Notify posts s and fails on any status other than 2xx.
+func (w *Webhook) Notify(s domain.RunSummary) error {
+	data, err := json.Marshal(w.payload(s))
+	if err != nil {
+		return err
+	}
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		// The URL is the webhook's secret; keep it out of the error.
+		return fmt.Errorf("posting to webhook %s: %w", redact(w.url), unwrapURLError(err))
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("posting to webhook %s: unexpected status %s", redact(w.url), resp.Status)
+	}
+	return nil
+}
+
+func (w *Webhook) payload(s domain.RunSummary) any {
+	switch w.format {
+	case FormatSlack:
+		return slackPayload(s)
+	case FormatTeams:
+		return teamsPayload(s)
+	}
+	return s
+}
+
+// slackPayload is a message in Slack mrkdwn.
+func slackPayload(s domain.RunSummary) map[string]any {
+	text := "*openkraft* " + s.Headline()
+	for _, line := range s.ErrorLines() {
+		text += "\n• " + line
+	}
+	return map[string]any{"text": text}
+}
+
+// teamsPayload is a message carrying an Adaptive Card, as Teams workflow
+// webhooks expect.
+func teamsPayload(s domain.RunSummary) map[string]any {
+	body := []map[string]any{
+		{"type": "TextBlock", "text": "openkraft", "weight": "Bolder"},
+		{"type": "TextBlock", "text": s.Headline(), "wrap": true},
+	}
+	for _, line := range s.ErrorLines() {
+		body = append(body, map[string]any{"type": "TextBlock", "text": "- " + line, "wrap": true, "spacing": "None"})
+	}
+	return map[string]any{
+		"type": "message",
+		"attachments": []map[string]any{{
+			"contentType": "application/vnd.microsoft.card.adaptive",
+			"content": map[string]any{
+				"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+				"type":    "AdaptiveCard",
+				"version": "1.4",
+				"body":    body,
+			},
+		}},
+	}
+}
+
+func formatForHost(host string) string {
+	switch {
+	case host == "hooks.slack.com":
+		return FormatSlack
+	case strings.HasSuffix(host, ".webhook.office.com"), strings.HasSuffix(host, ".logic.azure.com"),
+		strings.HasSuffix(host, ".powerplatform.com"):
+		return FormatTeams
+	}
+	return FormatJSON
+}
+
+// redact keeps the scheme and host of a webhook URL; its path is the
+// secret that lets anyone post.
+func redact(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "(invalid URL)"
+	}
+	return u.Scheme + "://" + u.Host + "/..."
+}
+
+// unwrapURLError drops the *url.Error wrapper, whose message repeats the
+// full URL.
+func unwrapURLError(err error) error {
+	var ue *url.Error
+	if errors.As(err, &ue) {
+		return ue.Err
+	}
+	return err
+}
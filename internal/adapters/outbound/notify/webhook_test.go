@@ -0,0 +1,73 @@
+package notify_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/notify"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func summary() domain.RunSummary {
+	return domain.RunSummary{
+		Project: "github.com/org/repo", Overall: 78, Grade: "B", HasBaseline: true, Baseline: 81, Delta: -3,
+		NewErrors: []domain.Issue{{Severity: domain.SeverityError, File: "a.go", Line: 3, Message: "hardcoded secret"}},
+	}
+}
+
+func capture(t *testing.T, status int) (*httptest.Server, *map[string]any) {
+	got := map[string]any{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &got
+}
+
+func TestWebhook_Slack(t *testing.T) {
+	srv, got := capture(t, http.StatusOK)
+	hook, err := notify.NewWebhook(srv.URL, notify.FormatSlack)
+	require.NoError(t, err)
+
+	require.NoError(t, hook.Notify(summary()))
+	assert.Equal(t, "*openkraft* github.com/org/repo scored 78 (B), down 3 from 81, 1 new error\n• a.go:3: hardcoded secret", (*got)["text"])
+}
+
+func TestWebhook_TeamsAdaptiveCard(t *testing.T) {
+	srv, got := capture(t, http.StatusAccepted)
+	hook, err := notify.NewWebhook(srv.URL, notify.FormatTeams)
+	require.NoError(t, err)
+
+	require.NoError(t, hook.Notify(summary()))
+	attachments := (*got)["attachments"].([]any)
+	card := attachments[0].(map[string]any)["content"].(map[string]any)
+	assert.Equal(t, "AdaptiveCard", card["type"])
+	assert.Len(t, card["body"], 3)
+}
+
+func TestWebhook_GenericJSONAndStatus(t *testing.T) {
+	srv, got := capture(t, http.StatusForbidden)
+	hook, err := notify.NewWebhook(srv.URL+"/secret-path", "")
+	require.NoError(t, err)
+
+	err = hook.Notify(summary())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+	assert.NotContains(t, err.Error(), "secret-path")
+	assert.Equal(t, float64(-3), (*got)["delta"])
+}
+
+func TestNewWebhook_Validates(t *testing.T) {
+	_, err := notify.NewWebhook("hooks.slack.com/services/x", "")
+	assert.ErrorContains(t, err, "not an http(s) URL")
+
+	_, err = notify.NewWebhook("https://example.com/hook", "discord")
+	assert.ErrorContains(t, err, "unknown webhook format")
+}
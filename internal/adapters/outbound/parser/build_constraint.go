@@ -0,0 +1,124 @@
+package parser
+
+import (
+	"go/ast"
+	"go/build/constraint"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// knownOS and knownArch are the GOOS and GOARCH values the go tool matches
+// in file names (see go/build).
+var knownOS = map[string]bool{
+	"aix": true, "android": true, "darwin": true, "dragonfly": true, "freebsd": true,
+	"hurd": true, "illumos": true, "ios": true, "js": true, "linux": true, "nacl": true,
+	"netbsd": true, "openbsd": true, "plan9": true, "solaris": true, "wasip1": true,
+	"windows": true, "zos": true,
+}
+
+var knownArch = map[string]bool{
+	"386": true, "amd64": true, "amd64p32": true, "arm": true, "armbe": true, "arm64": true,
+	"arm64be": true, "loong64": true, "mips": true, "mipsle": true, "mips64": true,
+	"mips64le": true, "mips64p32": true, "mips64p32le": true, "ppc": true, "ppc64": true,
+	"ppc64le": true, "riscv": true, "riscv64": true, "s390": true, "s390x": true,
+	"sparc": true, "sparc64": true, "wasm": true,
+}
+
+// buildConstraint returns the effective build constraint of a file, joining
+// its //go:build line (or legacy // +build lines) with the GOOS/GOARCH
+// implied by its name, plus the positive tags the constraint mentions.
+func buildConstraint(file *ast.File, filePath string) (string, []string) {
+	var exprs []constraint.Expr
+	if x := headerConstraint(file); x != nil {
+		exprs = append(exprs, x)
+	}
+	exprs = append(exprs, filenameConstraint(filePath)...)
+	if len(exprs) == 0 {
+		return "", nil
+	}
+
+	x := exprs[0]
+	for _, next := range exprs[1:] {
+		x = &constraint.AndExpr{X: x, Y: next}
+	}
+
+	tagSet := make(map[string]bool)
+	collectTags(x, false, tagSet)
+	tags := make([]string, 0, len(tagSet))
+	for tag := range tagSet {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return x.String(), tags
+}
+
+// headerConstraint parses the build constraint comments above the package
+// clause. A //go:build line takes precedence over // +build lines.
+func headerConstraint(file *ast.File) constraint.Expr {
+	var plusBuild constraint.Expr
+	for _, cg := range file.Comments {
+		if cg.Pos() > file.Package {
+			break
+		}
+		for _, c := range cg.List {
+			switch {
+			case constraint.IsGoBuild(c.Text):
+				if x, err := constraint.Parse(c.Text); err == nil {
+					return x
+				}
+			case constraint.IsPlusBuild(c.Text):
+				x, err := constraint.Parse(c.Text)
+				if err != nil {
+					continue
+				}
+				if plusBuild == nil {
+					plusBuild = x
+				} else {
+					plusBuild = &constraint.AndExpr{X: plusBuild, Y: x}
+				}
+			}
+		}
+	}
+	return plusBuild
+}
+
+// filenameConstraint returns the constraints implied by *_GOOS, *_GOARCH
+// and *_GOOS_GOARCH file name suffixes.
+func filenameConstraint(filePath string) []constraint.Expr {
+	name := strings.TrimSuffix(filepath.Base(filePath), ".go")
+	name = strings.TrimSuffix(name, "_test")
+	parts := strings.Split(name, "_")
+	// The first element is never a constraint: "linux.go" has none.
+	if len(parts) < 2 {
+		return nil
+	}
+	parts = parts[1:]
+
+	n := len(parts)
+	if n >= 2 && knownOS[parts[n-2]] && knownArch[parts[n-1]] {
+		return []constraint.Expr{&constraint.TagExpr{Tag: parts[n-2]}, &constraint.TagExpr{Tag: parts[n-1]}}
+	}
+	if knownOS[parts[n-1]] || knownArch[parts[n-1]] {
+		return []constraint.Expr{&constraint.TagExpr{Tag: parts[n-1]}}
+	}
+	return nil
+}
+
+// collectTags records the tags of x that are not negated.
+func collectTags(x constraint.Expr, negated bool, tags map[string]bool) {
+	switch e := x.(type) {
+	case *constraint.TagExpr:
+		if !negated {
+			tags[e.Tag] = true
+		}
+	case *constraint.NotExpr:
+		collectTags(e.X, !negated, tags)
+	case *constraint.AndExpr:
+		collectTags(e.X, negated, tags)
+		collectTags(e.Y, negated, tags)
+	case *constraint.OrExpr:
+		collectTags(e.X, negated, tags)
+		collectTags(e.Y, negated, tags)
+	}
+}
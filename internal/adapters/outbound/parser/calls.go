@@ -0,0 +1,151 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"path"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// importNames maps the name each import is referred to by in the file to
+// its import path. Blank and dot imports are skipped: their identifiers
+// cannot qualify a call.
+func importNames(file *ast.File) map[string]string {
+	names := make(map[string]string, len(file.Imports))
+	for _, imp := range file.Imports {
+		p := strings.Trim(imp.Path.Value, `"`)
+		if imp.Name != nil {
+			if imp.Name.Name != "_" && imp.Name.Name != "." {
+				names[imp.Name.Name] = p
+			}
+			continue
+		}
+		names[defaultImportName(p)] = p
+	}
+	return names
+}
+
+// defaultImportName guesses the package name of an unaliased import from
+// its path: the last element without a major-version suffix ("/v2",
+// "yaml.v3") or a "go-" prefix.
+func defaultImportName(importPath string) string {
+	name := path.Base(importPath)
+	if isMajorVersion(name) && path.Dir(importPath) != "." {
+		name = path.Base(path.Dir(importPath))
+	}
+	if i := strings.Index(name, ".v"); i > 0 && isMajorVersion(name[i+1:]) {
+		name = name[:i]
+	}
+	name = strings.TrimPrefix(name, "go-")
+	return strings.ReplaceAll(name, "-", "")
+}
+
+func isMajorVersion(s string) bool {
+	if len(s) < 2 || s[0] != 'v' {
+		return false
+	}
+	for _, c := range s[1:] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// extractCalls lists the distinct calls in a function body, including
+// calls inside function literals. Calls through an imported package carry
+// its import path; calls on the function's own receiver carry the
+// receiver type. Other selector calls are recorded as bare methods for
+// the call graph to resolve.
+func extractCalls(decl *ast.FuncDecl, imports map[string]string, fset *token.FileSet) []domain.Call {
+	if decl.Body == nil {
+		return nil
+	}
+	var recvName, recvType string
+	if decl.Recv != nil && len(decl.Recv.List) > 0 {
+		recvType = strings.TrimPrefix(receiverType(decl.Recv.List[0].Type), "*")
+		if names := decl.Recv.List[0].Names; len(names) > 0 {
+			recvName = names[0].Name
+		}
+	}
+
+	var calls []domain.Call
+	seen := map[domain.Call]bool{}
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		ce, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		var c domain.Call
+		switch fn := unparen(ce.Fun).(type) {
+		case *ast.Ident:
+			c.Name = fn.Name
+		case *ast.SelectorExpr:
+			c.Name = fn.Sel.Name
+			if x, ok := fn.X.(*ast.Ident); ok {
+				switch {
+				case x.Name == recvName && recvName != "" && recvName != "_":
+					c.Receiver = recvType
+				case imports[x.Name] != "" && x.Obj == nil:
+					c.Package = imports[x.Name]
+				default:
+					c.Method = true
+				}
+			} else {
+				c.Method = true
+			}
+		case *ast.IndexExpr: // explicit instantiation: F[T](x)
+			if id, ok := fn.X.(*ast.Ident); ok {
+				c.Name = id.Name
+			}
+		}
+		if c.Name == "" || seen[c] {
+			return true
+		}
+		seen[c] = true
+		c.Line = fset.Position(ce.Pos()).Line
+		calls = append(calls, c)
+		return true
+	})
+	return calls
+}
+
+func unparen(e ast.Expr) ast.Expr {
+	for {
+		p, ok := e.(*ast.ParenExpr)
+		if !ok {
+			return e
+		}
+		e = p.X
+	}
+}
+
+// qualifiedRefs maps each import path to the distinct names the file
+// selects from it (pkg.Name), in order of first use. Selectors on a local
+// identifier that shadows the import name are not references.
+func qualifiedRefs(file *ast.File, imports map[string]string) map[string][]string {
+	var refs map[string][]string
+	seen := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		x, ok := sel.X.(*ast.Ident)
+		if !ok || x.Obj != nil || imports[x.Name] == "" {
+			return true
+		}
+		p := imports[x.Name]
+		if key := p + "." + sel.Sel.Name; !seen[key] {
+			seen[key] = true
+			if refs == nil {
+				refs = make(map[string][]string)
+			}
+			refs[p] = append(refs[p], sel.Sel.Name)
+		}
+		return true
+	})
+	return refs
+}
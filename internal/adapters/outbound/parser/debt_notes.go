@@ -0,0 +1,62 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// debtMarker matches a comment line starting with TODO, FIXME or HACK,
+// optionally followed by a parenthesized attribution such as (alice) or
+// (bob, 2024-03-01), then a colon, a space or the end of the line.
+var debtMarker = regexp.MustCompile(`^(TODO|FIXME|HACK)(?:\(([^)]*)\))?(?::|\s|$)\s*(.*)$`)
+
+var isoDate = regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}\b`)
+
+// debtNotes collects the TODO, FIXME and HACK notes in the comments of
+// file. Only lines that start with a marker count, so prose that mentions
+// a TODO in passing is not a note. The author is what the parentheses hold
+// besides a date; the date is the first YYYY-MM-DD in the parentheses or
+// the text.
+func debtNotes(fset *token.FileSet, file *ast.File) []domain.DebtNote {
+	var notes []domain.DebtNote
+	for _, cg := range file.Comments {
+		for _, c := range cg.List {
+			line := fset.Position(c.Slash).Line
+			for i, text := range commentLines(c.Text) {
+				m := debtMarker.FindStringSubmatch(text)
+				if m == nil {
+					continue
+				}
+				note := domain.DebtNote{Kind: m[1], Line: line + i, Text: strings.TrimSpace(m[3])}
+				attribution := m[2]
+				if date := isoDate.FindString(attribution); date != "" {
+					note.Date = date
+					attribution = strings.Replace(attribution, date, "", 1)
+				} else {
+					note.Date = isoDate.FindString(note.Text)
+				}
+				note.Author = strings.Trim(attribution, " ,;")
+				notes = append(notes, note)
+			}
+		}
+	}
+	return notes
+}
+
+// commentLines splits a // or /* */ comment into its lines with the
+// comment markers and leading decoration removed.
+func commentLines(text string) []string {
+	if rest, ok := strings.CutPrefix(text, "//"); ok {
+		return []string{strings.TrimSpace(rest)}
+	}
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+	lines := strings.Split(text, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(l), "*"))
+	}
+	return lines
+}
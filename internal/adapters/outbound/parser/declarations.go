@@ -0,0 +1,103 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// declarations returns the package-level consts, vars and types of file
+// other than structs and interfaces. A const without type or value repeats
+// the type of the spec above it, as in an iota sequence.
+func declarations(file *ast.File, fset *token.FileSet) []domain.Declaration {
+	var out []domain.Declaration
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		var constType string
+		for _, spec := range gd.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				switch s.Type.(type) {
+				case *ast.StructType, *ast.InterfaceType:
+					continue
+				}
+				d := domain.Declaration{Kind: "type", Name: s.Name.Name, Type: types.ExprString(s.Type), Line: fset.Position(s.Pos()).Line}
+				if s.Assign.IsValid() {
+					d.Type = "= " + d.Type
+				}
+				if s.TypeParams != nil {
+					d.TypeParams = "[" + strings.Join(fieldList(s.TypeParams), ", ") + "]"
+				}
+				out = append(out, d)
+			case *ast.ValueSpec:
+				typ := ""
+				if s.Type != nil {
+					typ = types.ExprString(s.Type)
+				}
+				if gd.Tok == token.CONST {
+					if s.Type != nil || len(s.Values) > 0 {
+						constType = typ
+					}
+					typ = constType
+				}
+				for _, name := range s.Names {
+					if name.Name != "_" {
+						out = append(out, domain.Declaration{Kind: gd.Tok.String(), Name: name.Name, Type: typ, Line: fset.Position(name.Pos()).Line})
+					}
+				}
+			}
+		}
+	}
+	return out
+}
+
+// methodSignature renders the parameter and result types of a method
+// without their names, e.g. "(string, int) (bool, error)".
+func methodSignature(ft *ast.FuncType) string {
+	sig := "(" + strings.Join(fieldTypes(ft.Params), ", ") + ")"
+	results := fieldTypes(ft.Results)
+	switch len(results) {
+	case 0:
+	case 1:
+		sig += " " + results[0]
+	default:
+		sig += " (" + strings.Join(results, ", ") + ")"
+	}
+	return sig
+}
+
+// fieldTypes returns the type of each entry of list, repeated for fields
+// that declare several names.
+func fieldTypes(list *ast.FieldList) []string {
+	if list == nil {
+		return nil
+	}
+	var out []string
+	for _, f := range list.List {
+		typ := types.ExprString(f.Type)
+		for range max(1, len(f.Names)) {
+			out = append(out, typ)
+		}
+	}
+	return out
+}
+
+// fieldList renders each entry of list as written, names included, e.g.
+// "K comparable" and "V any" for [K comparable, V any].
+func fieldList(list *ast.FieldList) []string {
+	var out []string
+	for _, f := range list.List {
+		names := make([]string, len(f.Names))
+		for i, n := range f.Names {
+			names[i] = n.Name
+		}
+		out = append(out, strings.TrimSpace(strings.Join(names, ", ")+" "+types.ExprString(f.Type)))
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"hash/fnv"
+	"sort"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// tokenStream is a file's token sequence as duplication detection reads
+// it: normalized tokens with the line, byte offset and text hash of each.
+type tokenStream struct {
+	tokens, lines, offsets, values []int
+}
+
+// apply stores the stream's tokens, lines and values in af.
+func (ts tokenStream) apply(af *domain.AnalyzedFile) {
+	af.NormalizedTokens, af.TokenLines, af.TokenValues = ts.tokens, ts.lines, ts.values
+}
+
+// index returns the number of tokens starting before byte offset off.
+func (ts tokenStream) index(off int) int {
+	return sort.SearchInts(ts.offsets, off)
+}
+
+// ranges converts [start, end) source spans to token index ranges.
+func (ts tokenStream) ranges(spans [][2]token.Pos, fset *token.FileSet) [][2]int {
+	var out [][2]int
+	for _, sp := range spans {
+		start, end := ts.index(fset.Position(sp[0]).Offset), ts.index(fset.Position(sp[1]).Offset)
+		if end > start {
+			out = append(out, [2]int{start, end})
+		}
+	}
+	return out
+}
+
+// tokenValue hashes the text of an identifier or literal into
+// [0, 1<<30), leaving room for the placeholders in a token stream.
+func tokenValue(lit string) int {
+	h := fnv.New32a()
+	h.Write([]byte(lit))
+	return int(h.Sum32() & (1<<30 - 1))
+}
+
+// importsEnd returns the byte offset where the package clause and import
+// declarations of file end, past the semicolon inserted at their newline.
+func importsEnd(file *ast.File, fset *token.FileSet) int {
+	end := file.Name.End()
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			break
+		}
+		end = gd.End()
+	}
+	return fset.Position(end).Offset + 1
+}
+
+// testTables returns the case lists of table-driven tests: the braces of
+// slice or map literals whose two or more elements are all composite
+// literals, e.g. tests := []struct{...}{{...}, {...}}.
+func testTables(file *ast.File) [][2]token.Pos {
+	var tables [][2]token.Pos
+	ast.Inspect(file, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok || len(lit.Elts) < 2 {
+			return true
+		}
+		switch lit.Type.(type) {
+		case *ast.ArrayType, *ast.MapType:
+		default:
+			return true
+		}
+		for _, elt := range lit.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				elt = kv.Value
+			}
+			if _, ok := elt.(*ast.CompositeLit); !ok {
+				return true
+			}
+		}
+		tables = append(tables, [2]token.Pos{lit.Lbrace, lit.Rbrace + 1})
+		return false
+	})
+	return tables
+}
@@ -0,0 +1,42 @@
+package parser
+
+import (
+	"go/ast"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// embeddedType describes the embedded field or interface element expr.
+// Qualified types carry the import path of their package; type arguments
+// are dropped. Elements that are not a named type, such as the unions and
+// ~T terms of constraint interfaces, report false.
+func embeddedType(expr ast.Expr, imports map[string]string) (domain.EmbeddedType, bool) {
+	var et domain.EmbeddedType
+	if star, ok := expr.(*ast.StarExpr); ok {
+		et.Pointer = true
+		expr = star.X
+	}
+	switch x := expr.(type) {
+	case *ast.IndexExpr:
+		expr = x.X
+	case *ast.IndexListExpr:
+		expr = x.X
+	}
+	switch x := expr.(type) {
+	case *ast.Ident:
+		et.Name = x.Name
+		return et, true
+	case *ast.SelectorExpr:
+		pkg, ok := x.X.(*ast.Ident)
+		if !ok {
+			return et, false
+		}
+		et.Package = imports[pkg.Name]
+		if et.Package == "" {
+			et.Package = pkg.Name
+		}
+		et.Name = x.Sel.Name
+		return et, true
+	}
+	return et, false
+}
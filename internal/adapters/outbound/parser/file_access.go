@@ -0,0 +1,176 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// modeArgs maps the file functions that take a permission mode to the
+// index of the mode argument.
+var modeArgs = map[string]int{
+	"os.OpenFile": 2, "os.WriteFile": 2, "ioutil.WriteFile": 2,
+	"os.Mkdir": 1, "os.MkdirAll": 1, "os.Chmod": 1,
+}
+
+// requestInput matches expressions that read data a client controls: the
+// URL, form, headers or body of an *http.Request, router parameters, and
+// command-line arguments.
+var requestInput = regexp.MustCompile(`\b(r|req|request)\.(URL|Form|PostForm|Header|Body|MultipartForm)\b|` +
+	`\.(FormValue|PostFormValue|PathValue|Param|Params|QueryParam|URLParam|Query)\(|\bmux\.Vars\(|\bos\.Args\b`)
+
+// fileModes lists the calls that create or chmod files with a constant
+// permission mode, such as os.WriteFile(name, data, 0666).
+func fileModes(file *ast.File, imports map[string]string, fset *token.FileSet) []domain.FileMode {
+	var modes []domain.FileMode
+	ast.Inspect(file, func(n ast.Node) bool {
+		ce, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		name := qualifiedCall(ce, imports)
+		idx, ok := modeArgs[name]
+		if !ok || idx >= len(ce.Args) {
+			return true
+		}
+		if mode, ok := constantMode(ce.Args[idx]); ok {
+			modes = append(modes, domain.FileMode{Call: name, Mode: mode, Line: fset.Position(ce.Pos()).Line})
+		}
+		return true
+	})
+	return modes
+}
+
+// qualifiedCall returns "pkg.Func" for a call to an imported package's
+// function, with the package's default name, or "".
+func qualifiedCall(ce *ast.CallExpr, imports map[string]string) string {
+	sel, ok := ce.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	x, ok := sel.X.(*ast.Ident)
+	if !ok || x.Obj != nil || imports[x.Name] == "" {
+		return ""
+	}
+	return defaultImportName(imports[x.Name]) + "." + sel.Sel.Name
+}
+
+// constantMode evaluates a mode written as an integer literal, a
+// conversion such as os.FileMode(0644), or os.ModePerm.
+func constantMode(expr ast.Expr) (uint32, bool) {
+	switch x := unparen(expr).(type) {
+	case *ast.BasicLit:
+		if x.Kind != token.INT {
+			return 0, false
+		}
+		v, err := strconv.ParseUint(x.Value, 0, 32)
+		return uint32(v), err == nil
+	case *ast.CallExpr:
+		if len(x.Args) == 1 && strings.HasSuffix(types.ExprString(x.Fun), "FileMode") {
+			return constantMode(x.Args[0])
+		}
+	case *ast.SelectorExpr:
+		if x.Sel.Name == "ModePerm" {
+			return 0o777, true
+		}
+	}
+	return 0, false
+}
+
+// pathJoins lists the filepath.Join and path.Join calls with an argument
+// derived from request input, directly or through local variables, that
+// was not reduced with filepath.Base or checked with filepath.IsLocal or
+// strings.Contains(x, "..") in the same function.
+func pathJoins(file *ast.File, imports map[string]string, fset *token.FileSet) []domain.PathJoin {
+	var joins []domain.PathJoin
+	for _, decl := range file.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Body == nil {
+			continue
+		}
+		tainted, checked := requestTaint(fd.Body, imports)
+		ast.Inspect(fd.Body, func(n ast.Node) bool {
+			ce, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			if name := qualifiedCall(ce, imports); name != "filepath.Join" && name != "path.Join" {
+				return true
+			}
+			var inputs []string
+			for _, arg := range ce.Args {
+				if isTainted(arg, tainted) && !checked[types.ExprString(arg)] {
+					inputs = append(inputs, types.ExprString(arg))
+				}
+			}
+			if len(inputs) > 0 {
+				joins = append(joins, domain.PathJoin{Inputs: inputs, Line: fset.Position(ce.Pos()).Line})
+			}
+			return true
+		})
+	}
+	return joins
+}
+
+// requestTaint returns the local variables of body assigned from request
+// input, and the expressions body checks for parent directory references.
+func requestTaint(body *ast.BlockStmt, imports map[string]string) (tainted, checked map[string]bool) {
+	tainted, checked = make(map[string]bool), make(map[string]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range x.Lhs {
+				id, ok := lhs.(*ast.Ident)
+				rhs := x.Rhs[min(i, len(x.Rhs)-1)]
+				if ok && isTainted(rhs, tainted) && !isBaseCall(rhs, imports) {
+					tainted[id.Name] = true
+				}
+			}
+		case *ast.CallExpr:
+			if isTraversalCheck(x, imports) {
+				checked[types.ExprString(x.Args[0])] = true
+			}
+		}
+		return true
+	})
+	return tainted, checked
+}
+
+// isTainted reports whether expr reads request input or a tainted
+// variable.
+func isTainted(expr ast.Expr, tainted map[string]bool) bool {
+	if requestInput.MatchString(types.ExprString(expr)) {
+		return true
+	}
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && tainted[id.Name] {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// isTraversalCheck reports whether ce is filepath.IsLocal(x) or
+// strings.Contains(x, "..").
+func isTraversalCheck(ce *ast.CallExpr, imports map[string]string) bool {
+	switch qualifiedCall(ce, imports) {
+	case "filepath.IsLocal":
+		return len(ce.Args) == 1
+	case "strings.Contains":
+		lit, ok := ce.Args[len(ce.Args)-1].(*ast.BasicLit)
+		return len(ce.Args) == 2 && ok && lit.Value == `".."`
+	}
+	return false
+}
+
+func isBaseCall(expr ast.Expr, imports map[string]string) bool {
+	ce, ok := unparen(expr).(*ast.CallExpr)
+	return ok && qualifiedCall(ce, imports) == "filepath.Base"
+}
@@ -0,0 +1,67 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// typeParams lists the type parameters declared by list, each with its
+// constraint as written and the number of terms in it.
+func typeParams(list *ast.FieldList) []domain.TypeParam {
+	if list == nil {
+		return nil
+	}
+	var params []domain.TypeParam
+	for _, field := range list.List {
+		constraint := types.ExprString(field.Type)
+		terms := constraintTerms(field.Type)
+		for _, name := range field.Names {
+			params = append(params, domain.TypeParam{Name: name.Name, Constraint: constraint, Terms: terms})
+		}
+	}
+	return params
+}
+
+// constraintTerms counts what a constraint asks of a type argument: one
+// per union term, method and named constraint, and none for any. An
+// inline interface counts the terms of its elements.
+func constraintTerms(expr ast.Expr) int {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if t.Name == "any" {
+			return 0
+		}
+		return 1
+	case *ast.BinaryExpr:
+		if t.Op == token.OR {
+			return constraintTerms(t.X) + constraintTerms(t.Y)
+		}
+	case *ast.ParenExpr:
+		return constraintTerms(t.X)
+	case *ast.InterfaceType:
+		n := 0
+		for _, elem := range t.Methods.List {
+			if len(elem.Names) > 0 {
+				n += len(elem.Names)
+				continue
+			}
+			n += constraintTerms(elem.Type)
+		}
+		return n
+	}
+	return 1
+}
+
+// genericTypes returns the names of the generic types declared in decl.
+func genericTypes(decl *ast.GenDecl) []string {
+	var names []string
+	for _, spec := range decl.Specs {
+		if ts, ok := spec.(*ast.TypeSpec); ok && ts.TypeParams != nil {
+			names = append(names, ts.Name.Name)
+		}
+	}
+	return names
+}
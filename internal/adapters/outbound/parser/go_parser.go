@@ -8,6 +8,7 @@ import (
 	"go/token"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/abdidvp/openkraft/internal/domain"
@@ -18,22 +19,38 @@ type GoParser struct{}
 
 func New() *GoParser { return &GoParser{} }
 
+// parseError converts the syntax errors of go/parser into a
+// *domain.ParseError positioned at the first of them.
+func parseError(err error) error {
+	list, ok := err.(scanner.ErrorList)
+	if !ok || len(list) == 0 {
+		return err
+	}
+	return &domain.ParseError{Line: list[0].Pos.Line, Column: list[0].Pos.Column, Message: list[0].Msg, Count: len(list)}
+}
+
 func (p *GoParser) AnalyzeFile(filePath string) (*domain.AnalyzedFile, error) {
 	src, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("reading %s: %w", filePath, err)
 	}
+	return p.AnalyzeSource(filePath, src)
+}
 
+// AnalyzeSource parses in-memory Go source as if it were read from filePath.
+// Used by editor integrations to analyze unsaved buffers.
+func (p *GoParser) AnalyzeSource(filePath string, src []byte) (*domain.AnalyzedFile, error) {
 	fset := token.NewFileSet()
 	file, err := goparser.ParseFile(fset, filePath, src, goparser.ParseComments)
 	if err != nil {
-		return nil, fmt.Errorf("parsing %s: %w", filePath, err)
+		return nil, fmt.Errorf("parsing %s: %w", filePath, parseError(err))
 	}
 
 	result := &domain.AnalyzedFile{
 		Path:       filePath,
 		Package:    file.Name.Name,
 		PackageDoc: file.Doc != nil && len(file.Doc.List) > 0,
+		Header:     fileHeader(src, fset.Position(file.Package).Offset),
 	}
 
 	// Total lines in the file.
@@ -51,15 +68,26 @@ func (p *GoParser) AnalyzeFile(filePath string) (*domain.AnalyzedFile, error) {
 		if path == "C" {
 			result.HasCGoImport = true
 		}
+		if imp.Name != nil && imp.Name.Name != "_" && imp.Name.Name != "." {
+			if result.ImportAliases == nil {
+				result.ImportAliases = make(map[string]string)
+			}
+			result.ImportAliases[path] = imp.Name.Name
+		}
 	}
+	result.ImportGroups = importGroups(file, fset)
 
 	// Walk top-level declarations.
+	imports := importNames(file)
 	for _, decl := range file.Decls {
 		switch d := decl.(type) {
 		case *ast.GenDecl:
-			p.processGenDecl(d, result)
+			p.processGenDecl(d, imports, result)
+			result.GenericTypes = append(result.GenericTypes, genericTypes(d)...)
 		case *ast.FuncDecl:
 			fn := p.processFunc(d, fset)
+			fn.Calls = extractCalls(d, imports, fset)
+			fn.Deprecated = domain.DeprecationNote(d.Doc.Text())
 			result.Functions = append(result.Functions, fn)
 			if d.Name.Name == "init" {
 				result.InitFunctions++
@@ -67,24 +95,66 @@ func (p *GoParser) AnalyzeFile(filePath string) (*domain.AnalyzedFile, error) {
 		}
 	}
 
+	result.QualifiedRefs = qualifiedRefs(file, imports)
+	result.NumericLiterals = numericLiterals(file, fset)
+	result.StringLiterals = stringLiterals(file, fset)
+	result.ErrorLogs = errorLogs(file, imports, fset)
+	result.SecretCandidates = secretCandidates(file, fset)
+	result.Declarations = declarations(file, fset)
+	result.SQLCalls = sqlCalls(file, fset)
+	result.FileModes = fileModes(file, imports, fset)
+	result.PathJoins = pathJoins(file, imports, fset)
+
+	if result.HasCGoImport {
+		extractCGo(file, fset, result)
+	}
+	result.Embeds = extractEmbeds(file, fset)
+	result.BuildConstraint, result.BuildTags = buildConstraint(file, filePath)
+	result.DebtNotes = debtNotes(fset, file)
+
 	// Error calls and type assertions require a deep walk.
 	result.ErrorCalls = extractErrorCalls(file)
 	result.TypeAssertions = extractTypeAssertions(file)
 
 	// Normalized tokens for duplication detection.
-	result.NormalizedTokens = normalizeTokens(src)
+	ts := normalizeTokens(src)
+	ts.apply(result)
+	result.ImportTokens = ts.index(importsEnd(file, fset))
+	if strings.HasSuffix(filePath, "_test.go") {
+		result.TestTableTokens = ts.ranges(testTables(file), fset)
+	}
 
 	return result, nil
 }
 
 // processGenDecl extracts struct/interface declarations and package-level variables.
-func (p *GoParser) processGenDecl(decl *ast.GenDecl, result *domain.AnalyzedFile) {
+func (p *GoParser) processGenDecl(decl *ast.GenDecl, imports map[string]string, result *domain.AnalyzedFile) {
 	for _, spec := range decl.Specs {
 		switch s := spec.(type) {
 		case *ast.TypeSpec:
 			switch itype := s.Type.(type) {
 			case *ast.StructType:
 				result.Structs = append(result.Structs, s.Name.Name)
+				sdef := domain.StructDef{Name: s.Name.Name}
+				for _, field := range itype.Fields.List {
+					typeName := exprToString(field.Type)
+					var tag string
+					if field.Tag != nil {
+						tag, _ = strconv.Unquote(field.Tag.Value)
+					}
+					if len(field.Names) == 0 {
+						embedded := strings.TrimPrefix(typeName, "*")
+						embedded = embedded[strings.LastIndex(embedded, ".")+1:]
+						sdef.Fields = append(sdef.Fields, domain.Param{Name: embedded, Type: typeName, Tag: tag})
+						if et, ok := embeddedType(field.Type, imports); ok {
+							sdef.Embedded = append(sdef.Embedded, et)
+						}
+					}
+					for _, name := range field.Names {
+						sdef.Fields = append(sdef.Fields, domain.Param{Name: name.Name, Type: typeName, Tag: tag})
+					}
+				}
+				result.StructDefs = append(result.StructDefs, sdef)
 			case *ast.InterfaceType:
 				result.Interfaces = append(result.Interfaces, s.Name.Name)
 				idef := domain.InterfaceDef{Name: s.Name.Name}
@@ -92,6 +162,11 @@ func (p *GoParser) processGenDecl(decl *ast.GenDecl, result *domain.AnalyzedFile
 					for _, method := range itype.Methods.List {
 						if len(method.Names) > 0 {
 							idef.Methods = append(idef.Methods, method.Names[0].Name)
+							if ft, ok := method.Type.(*ast.FuncType); ok {
+								idef.Signatures = append(idef.Signatures, methodSignature(ft))
+							}
+						} else if et, ok := embeddedType(method.Type, imports); ok {
+							idef.Embedded = append(idef.Embedded, et)
 						}
 					}
 				}
@@ -109,6 +184,142 @@ func (p *GoParser) processGenDecl(decl *ast.GenDecl, result *domain.AnalyzedFile
 	}
 }
 
+// extractCGo records the C preamble length, the //export directives and
+// which functions call into C. Functions are matched to FuncDecls by order.
+func extractCGo(file *ast.File, fset *token.FileSet, result *domain.AnalyzedFile) {
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			is := spec.(*ast.ImportSpec)
+			if is.Path.Value != `"C"` {
+				continue
+			}
+			doc := is.Doc
+			if doc == nil && len(gd.Specs) == 1 {
+				doc = gd.Doc
+			}
+			if doc != nil {
+				result.CGoPreambleLines += fset.Position(doc.End()).Line - fset.Position(doc.Pos()).Line + 1
+			}
+		}
+	}
+
+	i := 0
+	for _, decl := range file.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		if fd.Doc != nil {
+			for _, c := range fd.Doc.List {
+				if name, ok := strings.CutPrefix(c.Text, "//export "); ok {
+					result.CGoExports = append(result.CGoExports, strings.TrimSpace(name))
+				}
+			}
+		}
+		if fd.Body != nil && i < len(result.Functions) {
+			result.Functions[i].CallsC = callsC(fd.Body)
+		}
+		i++
+	}
+}
+
+// callsC reports whether body references a C.name selector.
+func callsC(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Name == "C" {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// importGroups splits the file's imports into groups: each import
+// declaration starts a group, and so does a blank line between specs.
+func importGroups(file *ast.File, fset *token.FileSet) [][]string {
+	var groups [][]string
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		lastLine := 0
+		for _, spec := range gd.Specs {
+			is := spec.(*ast.ImportSpec)
+			line := fset.Position(is.Pos()).Line
+			if is.Doc != nil {
+				line = fset.Position(is.Doc.Pos()).Line
+			}
+			if lastLine == 0 || line > lastLine+1 {
+				groups = append(groups, nil)
+			}
+			groups[len(groups)-1] = append(groups[len(groups)-1], strings.Trim(is.Path.Value, `"`))
+			lastLine = fset.Position(is.End()).Line
+		}
+	}
+	return groups
+}
+
+// extractEmbeds collects the //go:embed directives attached to package-level
+// variables. Quoted patterns are unquoted; the "all:" prefix is kept.
+func extractEmbeds(file *ast.File, fset *token.FileSet) []domain.EmbedDirective {
+	var embeds []domain.EmbedDirective
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok || len(vs.Names) != 1 {
+				continue
+			}
+			doc := vs.Doc
+			if doc == nil && len(gd.Specs) == 1 {
+				doc = gd.Doc
+			}
+			patterns := embedPatterns(doc)
+			if len(patterns) == 0 {
+				continue
+			}
+			embeds = append(embeds, domain.EmbedDirective{
+				Var:      vs.Names[0].Name,
+				Patterns: patterns,
+				Line:     fset.Position(vs.Pos()).Line,
+			})
+		}
+	}
+	return embeds
+}
+
+// embedPatterns returns the patterns of every //go:embed line in doc.
+func embedPatterns(doc *ast.CommentGroup) []string {
+	if doc == nil {
+		return nil
+	}
+	var patterns []string
+	for _, c := range doc.List {
+		args, ok := strings.CutPrefix(c.Text, "//go:embed")
+		if !ok || (args != "" && args[0] != ' ' && args[0] != '\t') {
+			continue
+		}
+		for _, field := range strings.Fields(args) {
+			if unquoted, err := strconv.Unquote(field); err == nil {
+				field = unquoted
+			}
+			patterns = append(patterns, field)
+		}
+	}
+	return patterns
+}
+
 // processFunc extracts a rich Function representation from a function declaration.
 func (p *GoParser) processFunc(decl *ast.FuncDecl, fset *token.FileSet) domain.Function {
 	f := domain.Function{
@@ -123,7 +334,11 @@ func (p *GoParser) processFunc(decl *ast.FuncDecl, fset *token.FileSet) domain.F
 	// Receiver.
 	if decl.Recv != nil && len(decl.Recv.List) > 0 {
 		f.Receiver = receiverType(decl.Recv.List[0].Type)
+		if names := decl.Recv.List[0].Names; len(names) > 0 {
+			f.ReceiverName = names[0].Name
+		}
 	}
+	f.TypeParams = typeParams(decl.Type.TypeParams)
 
 	// Parameters.
 	if decl.Type.Params != nil {
@@ -143,6 +358,7 @@ func (p *GoParser) processFunc(decl *ast.FuncDecl, fset *token.FileSet) domain.F
 	}
 
 	// Return types.
+	f.NamedResults = namedResults(decl.Type)
 	if decl.Type.Results != nil {
 		for _, field := range decl.Type.Results.List {
 			f.Returns = append(f.Returns, exprToString(field.Type))
@@ -157,11 +373,93 @@ func (p *GoParser) processFunc(decl *ast.FuncDecl, fset *token.FileSet) domain.F
 		lines := f.LineEnd - f.LineStart + 1
 		f.StringLiteralRatio = stringLiteralRatio(fset, decl.Body, lines)
 		f.MaxCaseArms, f.AvgCaseLines = switchDispatchMetrics(fset, decl.Body)
+		f.ReturnsField = returnedField(decl)
+		f.ZeroChecks = zeroCheckedParams(decl.Body, f.Params)
+		if f.NamedResults {
+			f.NakedReturns = nakedReturns(decl.Body)
+		}
 	}
 
 	return f
 }
 
+// zeroCheckedParams returns the params compared against a zero value in
+// body: p == nil, p.Name == "", p.Port == 0 or len(p) == 0.
+func zeroCheckedParams(body *ast.BlockStmt, params []domain.Param) []string {
+	checked := map[string]bool{}
+	ast.Inspect(body, func(n ast.Node) bool {
+		be, ok := n.(*ast.BinaryExpr)
+		if !ok || (be.Op != token.EQL && be.Op != token.NEQ) {
+			return true
+		}
+		for _, pair := range [][2]ast.Expr{{be.X, be.Y}, {be.Y, be.X}} {
+			if isZeroValue(pair[1]) {
+				if name := checkedParam(pair[0]); name != "" {
+					checked[name] = true
+				}
+			}
+		}
+		return true
+	})
+	var names []string
+	for _, p := range params {
+		if checked[p.Name] {
+			names = append(names, p.Name)
+		}
+	}
+	return names
+}
+
+// checkedParam returns the identifier at the root of p, p.Field or len(p).
+func checkedParam(expr ast.Expr) string {
+	if call, ok := expr.(*ast.CallExpr); ok && len(call.Args) == 1 {
+		if fn, ok := call.Fun.(*ast.Ident); ok && fn.Name == "len" {
+			expr = call.Args[0]
+		}
+	}
+	for {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			return e.Name
+		case *ast.SelectorExpr:
+			expr = e.X
+		default:
+			return ""
+		}
+	}
+}
+
+func isZeroValue(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name == "nil"
+	case *ast.BasicLit:
+		return e.Value == `""` || e.Value == "``" || e.Value == "0"
+	}
+	return false
+}
+
+// returnedField returns the field a method body consists of returning
+// through its receiver (func (u *User) Name() string { return u.name }),
+// or "" when the body does anything else.
+func returnedField(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 || len(decl.Recv.List[0].Names) == 0 || len(decl.Body.List) != 1 {
+		return ""
+	}
+	ret, ok := decl.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return ""
+	}
+	sel, ok := ret.Results[0].(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	if x, ok := sel.X.(*ast.Ident); ok && x.Name == decl.Recv.List[0].Names[0].Name {
+		return sel.Sel.Name
+	}
+	return ""
+}
+
 // --- Nesting depth ---
 
 // maxNestingDepth returns the deepest nesting level within a block.
@@ -688,36 +986,47 @@ func switchDispatchMetrics(fset *token.FileSet, body *ast.BlockStmt) (int, float
 //   - STRING → -2, INT → -3, FLOAT → -4, IMAG → -5, CHAR → -6
 //   - Comments → skipped
 //   - Structural tokens (keywords, operators, delimiters) → int(tok)
-func normalizeTokens(src []byte) []int {
+//
+// The stream also records each token's line, for locating clones, and a
+// hash of each identifier's and literal's text, for profiles that compare
+// names or values.
+func normalizeTokens(src []byte) tokenStream {
 	var s scanner.Scanner
 	fset := token.NewFileSet()
 	file := fset.AddFile("", fset.Base(), len(src))
 	s.Init(file, src, nil, 0) // mode 0: skip comments
 
-	var tokens []int
+	var ts tokenStream
 	for {
-		_, tok, _ := s.Scan()
+		pos, tok, lit := s.Scan()
 		if tok == token.EOF {
 			break
 		}
+		ts.lines = append(ts.lines, file.Line(pos))
+		ts.offsets = append(ts.offsets, file.Offset(pos))
+		value := 0
 		switch {
 		case tok == token.IDENT:
-			tokens = append(tokens, -1)
+			ts.tokens = append(ts.tokens, -1)
 		case tok == token.STRING:
-			tokens = append(tokens, -2)
+			ts.tokens = append(ts.tokens, -2)
 		case tok == token.INT:
-			tokens = append(tokens, -3)
+			ts.tokens = append(ts.tokens, -3)
 		case tok == token.FLOAT:
-			tokens = append(tokens, -4)
+			ts.tokens = append(ts.tokens, -4)
 		case tok == token.IMAG:
-			tokens = append(tokens, -5)
+			ts.tokens = append(ts.tokens, -5)
 		case tok == token.CHAR:
-			tokens = append(tokens, -6)
+			ts.tokens = append(ts.tokens, -6)
 		default:
-			tokens = append(tokens, int(tok))
+			ts.tokens = append(ts.tokens, int(tok))
+		}
+		if ts.tokens[len(ts.tokens)-1] < 0 {
+			value = tokenValue(lit)
 		}
+		ts.values = append(ts.values, value)
 	}
-	return tokens
+	return ts
 }
 
 // --- Helpers ---
@@ -728,6 +1037,10 @@ func receiverType(expr ast.Expr) string {
 		return "*" + receiverType(t.X)
 	case *ast.Ident:
 		return t.Name
+	case *ast.IndexExpr: // generic receiver: T[K]
+		return receiverType(t.X)
+	case *ast.IndexListExpr: // generic receiver: T[K, V]
+		return receiverType(t.X)
 	default:
 		return ""
 	}
@@ -757,3 +1070,12 @@ func exprToString(expr ast.Expr) string {
 		return "unknown"
 	}
 }
+
+// maxHeaderBytes bounds the header kept per file; license blocks are far
+// shorter, and a long package doc after them is irrelevant to header rules.
+const maxHeaderBytes = 4096
+
+// fileHeader returns the source before the package clause at offset.
+func fileHeader(src []byte, offset int) string {
+	return string(src[:min(offset, maxHeaderBytes, len(src))])
+}
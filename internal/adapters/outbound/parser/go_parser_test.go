@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -342,6 +343,40 @@ func Alloc(size int) unsafe.Pointer {
 	assert.True(t, result.HasCGoImport, "file with import \"C\" should set HasCGoImport")
 }
 
+func TestGoParser_ExtractsCGoPreambleAndExports(t *testing.T) {
+	source := `package gpu
+
+/*
+#include <stdlib.h>
+
+static int twice(int x) { return 2 * x; }
+*/
+import "C"
+
+//export go_on_event
+func go_on_event(code C.int) {}
+
+func Twice(x int) int {
+	return int(C.twice(C.int(x)))
+}
+
+func Version() string { return "1" }
+`
+	p := parser.New()
+	path := writeGoFile(t, t.TempDir(), "gpu.go", source)
+
+	result, err := p.AnalyzeFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 5, result.CGoPreambleLines)
+	assert.Equal(t, []string{"go_on_event"}, result.CGoExports)
+
+	calls := map[string]bool{}
+	for _, fn := range result.Functions {
+		calls[fn.Name] = fn.CallsC
+	}
+	assert.Equal(t, map[string]bool{"go_on_event": false, "Twice": true, "Version": false}, calls)
+}
+
 // ---------------------------------------------------------------------------
 // Cognitive complexity
 // ---------------------------------------------------------------------------
@@ -640,6 +675,50 @@ func Foo(x int) int { return x + 1 }
 		"comments should be excluded from normalized tokens")
 }
 
+func TestGoParser_TokenLines(t *testing.T) {
+	src := `package a
+
+func Foo(x int) int {
+	return x + 1
+}
+`
+	p := parser.New()
+	r, err := p.AnalyzeFile(writeGoFile(t, t.TempDir(), "a.go", src))
+	require.NoError(t, err)
+
+	require.Len(t, r.TokenLines, len(r.NormalizedTokens))
+	assert.Equal(t, 1, r.TokenLines[0], "package")
+	assert.Equal(t, 5, r.TokenLines[len(r.TokenLines)-2], "closing brace")
+}
+
+func TestGoParser_TokenValuesImportsAndTestTables(t *testing.T) {
+	src := `package a
+
+import "testing"
+
+func TestSum(t *testing.T) {
+	tests := []struct{ in, want int }{
+		{1, 1},
+		{2, 2},
+	}
+	_ = tests
+}
+`
+	p := parser.New()
+	r, err := p.AnalyzeFile(writeGoFile(t, t.TempDir(), "a_test.go", src))
+	require.NoError(t, err)
+
+	require.Len(t, r.TokenValues, len(r.NormalizedTokens))
+	assert.NotZero(t, r.TokenValues[1], "package name")
+	assert.Zero(t, r.TokenValues[0], "package keyword")
+	assert.Equal(t, 6, r.ImportTokens, "package a ; import \"testing\" ;")
+
+	require.Len(t, r.TestTableTokens, 1)
+	table := r.TestTableTokens[0]
+	assert.Equal(t, 6, r.TokenLines[table[0]])
+	assert.Equal(t, 9, r.TokenLines[table[1]-1])
+}
+
 func TestGoParser_NoCGoImport(t *testing.T) {
 	source := `package logic
 
@@ -655,3 +734,670 @@ func Hello() { fmt.Println("hello") }
 	require.NoError(t, err)
 	assert.False(t, result.HasCGoImport, "file without import \"C\" should not set HasCGoImport")
 }
+
+func TestGoParser_ExtractsEmbedDirectives(t *testing.T) {
+	src := `package web
+
+import "embed"
+
+//go:embed templates/*.html "static/app.css"
+var templateFS embed.FS
+
+var (
+	//go:embed schema.sql
+	schemaSQL string
+
+	notEmbedded string
+)
+
+// go:embed ignored.txt
+var spaced string
+`
+	result, err := parser.New().AnalyzeSource("web/assets.go", []byte(src))
+	require.NoError(t, err)
+
+	require.Len(t, result.Embeds, 2)
+	assert.Equal(t, "templateFS", result.Embeds[0].Var)
+	assert.Equal(t, []string{"templates/*.html", "static/app.css"}, result.Embeds[0].Patterns)
+	assert.Equal(t, 6, result.Embeds[0].Line)
+	assert.Equal(t, "schemaSQL", result.Embeds[1].Var)
+	assert.Equal(t, []string{"schema.sql"}, result.Embeds[1].Patterns)
+}
+
+func TestGoParser_BuildConstraints(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		header     string
+		constraint string
+		tags       []string
+	}{
+		{"unconstrained", "poll.go", "", "", nil},
+		{"go:build line", "poll.go", "//go:build linux && !cgo\n\n", "linux && !cgo", []string{"linux"}},
+		{"legacy +build", "poll.go", "// +build integration\n\n", "integration", []string{"integration"}},
+		{"GOOS suffix", "poll_windows.go", "", "windows", []string{"windows"}},
+		{"GOOS_GOARCH suffix on test", "poll_linux_amd64_test.go", "", "linux && amd64", []string{"amd64", "linux"}},
+		{"bare GOOS name is not a constraint", "linux.go", "", "", nil},
+		{"header and suffix combine", "poll_darwin.go", "//go:build cgo\n\n", "cgo && darwin", []string{"cgo", "darwin"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := tt.header + "package sys\n"
+			result, err := parser.New().AnalyzeSource(tt.path, []byte(src))
+			require.NoError(t, err)
+			assert.Equal(t, tt.constraint, result.BuildConstraint)
+			assert.Equal(t, tt.tags, result.BuildTags)
+		})
+	}
+}
+
+func TestGoParser_RecordsCalls(t *testing.T) {
+	src := `package store
+
+import (
+	"fmt"
+	yaml "gopkg.in/yaml.v3"
+	"example.com/app/internal/codec"
+)
+
+func (s *Store) Save(v any) error {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encoding: %w", err)
+	}
+	s.write(codec.Encode(data))
+	s.log.Print("saved")
+	go func() { helper() }()
+	return validate(v)
+}
+`
+	result, err := parser.New().AnalyzeSource("internal/store/store.go", []byte(src))
+	require.NoError(t, err)
+	require.Len(t, result.Functions, 1)
+
+	assert.Equal(t, []domain.Call{
+		{Name: "Marshal", Package: "gopkg.in/yaml.v3", Line: 10},
+		{Name: "Errorf", Package: "fmt", Line: 12},
+		{Name: "write", Receiver: "Store", Line: 14},
+		{Name: "Encode", Package: "example.com/app/internal/codec", Line: 14},
+		{Name: "Print", Method: true, Line: 15},
+		{Name: "helper", Line: 16},
+		{Name: "validate", Line: 17},
+	}, result.Functions[0].Calls)
+}
+
+func TestGoParser_RecordsReturnedField(t *testing.T) {
+	src := `package user
+
+func (u *User) Name() string { return u.name }
+
+func (u *User) Title() string { return u.profile.title }
+
+func (u *User) Age() int {
+	age := u.age
+	return age
+}
+
+func (User) Kind() string { return "user" }
+
+func name(u *User) string { return u.name }
+`
+	result, err := parser.New().AnalyzeSource("user.go", []byte(src))
+	require.NoError(t, err)
+	require.Len(t, result.Functions, 5)
+
+	fields := map[string]string{}
+	for _, fn := range result.Functions {
+		fields[fn.Name] = fn.ReturnsField
+	}
+	assert.Equal(t, map[string]string{
+		"Name":  "name",
+		"Title": "",
+		"Age":   "",
+		"Kind":  "",
+		"name":  "",
+	}, fields)
+}
+
+func TestGoParser_RecordsStructFieldsAndZeroChecks(t *testing.T) {
+	src := `package store
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+type Store struct {
+	*time.Location
+	mu      sync.Mutex
+	db      *DB
+	Timeout time.Duration
+}
+
+func New(db *DB, cfg Config, name string, opts []Option) (*Store, error) {
+	if db == nil || cfg.Path == "" {
+		return nil, errors.New("store: db and path are required")
+	}
+	if 0 == len(opts) {
+		opts = defaults
+	}
+	return &Store{db: db}, nil
+}
+`
+	result, err := parser.New().AnalyzeSource("internal/store/store.go", []byte(src))
+	require.NoError(t, err)
+
+	require.Len(t, result.StructDefs, 1)
+	assert.Equal(t, domain.StructDef{Name: "Store", Fields: []domain.Param{
+		{Name: "Location", Type: "*time.Location"},
+		{Name: "mu", Type: "sync.Mutex"},
+		{Name: "db", Type: "*DB"},
+		{Name: "Timeout", Type: "time.Duration"},
+	}, Embedded: []domain.EmbeddedType{
+		{Package: "time", Name: "Location", Pointer: true},
+	}}, result.StructDefs[0])
+
+	require.Len(t, result.Functions, 1)
+	assert.Equal(t, []string{"db", "cfg", "opts"}, result.Functions[0].ZeroChecks)
+}
+
+func TestGoParser_RecordsImportGroups(t *testing.T) {
+	src := `package store
+
+import (
+	"fmt"
+	"os"
+
+	// yaml is the config codec.
+	"gopkg.in/yaml.v3"
+	"example.com/app/internal/codec"
+)
+
+import "strings"
+`
+	result, err := parser.New().AnalyzeSource("internal/store/store.go", []byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]string{
+		{"fmt", "os"},
+		{"gopkg.in/yaml.v3", "example.com/app/internal/codec"},
+		{"strings"},
+	}, result.ImportGroups)
+}
+
+func TestGoParser_RecordsImportAliases(t *testing.T) {
+	src := `package store
+
+import (
+	_ "embed"
+	. "strings"
+	"fmt"
+	pb "example.com/app/gen/api/v1"
+	yaml "gopkg.in/yaml.v3"
+)
+`
+	result, err := parser.New().AnalyzeSource("internal/store/store.go", []byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"example.com/app/gen/api/v1": "pb",
+		"gopkg.in/yaml.v3":           "yaml",
+	}, result.ImportAliases)
+}
+
+func TestGoParser_RecordsHeader(t *testing.T) {
+	src := "// Copyright 2025 Acme Inc.\n\n// Package store persists orders.\npackage store\n"
+	result, err := parser.New().AnalyzeSource("internal/store/store.go", []byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, "// Copyright 2025 Acme Inc.\n\n// Package store persists orders.\n", result.Header)
+}
+
+func TestGoParser_RecordsDebtNotes(t *testing.T) {
+	src := `package store
+
+// TODO(alice, 2023-05-02): batch the writes
+// FIXME: retry on deadlock, seen 2021-11-30
+func Save() {
+	/*
+	 * HACK(bob) sleep until the replica catches up
+	 */
+	// the TODO list lives elsewhere
+	// TODO, FIXME and HACK are the markers
+}
+`
+	result, err := parser.New().AnalyzeSource("internal/store/store.go", []byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, []domain.DebtNote{
+		{Kind: "TODO", Line: 3, Author: "alice", Date: "2023-05-02", Text: "batch the writes"},
+		{Kind: "FIXME", Line: 4, Date: "2021-11-30", Text: "retry on deadlock, seen 2021-11-30"},
+		{Kind: "HACK", Line: 7, Author: "bob", Text: "sleep until the replica catches up"},
+	}, result.DebtNotes)
+}
+
+func TestGoParser_RecordsDeprecation(t *testing.T) {
+	src := `package store
+
+// Open opens a store.
+//
+// Deprecated: use New.
+func Open() {}
+
+// New opens a store.
+func New() {}
+`
+	result, err := parser.New().AnalyzeSource("internal/store/store.go", []byte(src))
+	require.NoError(t, err)
+
+	require.Len(t, result.Functions, 2)
+	assert.Equal(t, "Deprecated: use New.", result.Functions[0].Deprecated)
+	assert.Empty(t, result.Functions[1].Deprecated)
+}
+
+func TestGoParser_RecordsTypeParams(t *testing.T) {
+	src := `package set
+
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+type Set[T comparable] map[T]struct{}
+
+type Pair[K comparable, V any] struct {
+	Key K
+	Val V
+}
+
+func Map[T, U any](s []T, f func(T) U) []U { return nil }
+
+func Sum[N Number](ns ...N) N { var n N; return n }
+
+func Clamp[T interface{ ~int | ~float64; String() string }](v, lo, hi T) T { return v }
+
+func Plain() {}
+`
+	result, err := parser.New().AnalyzeSource("internal/set/set.go", []byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Set", "Pair"}, result.GenericTypes)
+	require.Len(t, result.Functions, 4)
+	assert.Equal(t, []domain.TypeParam{
+		{Name: "T", Constraint: "any"},
+		{Name: "U", Constraint: "any"},
+	}, result.Functions[0].TypeParams)
+	assert.Equal(t, []domain.TypeParam{{Name: "N", Constraint: "Number", Terms: 1}}, result.Functions[1].TypeParams)
+	assert.Equal(t, 3, result.Functions[2].ConstraintTerms())
+	assert.Empty(t, result.Functions[3].TypeParams)
+}
+
+func TestGoParser_RecordsEmbeddedTypes(t *testing.T) {
+	src := `package store
+
+import (
+	"io"
+
+	kv "example.com/app/internal/kv"
+)
+
+type Cache struct {
+	*kv.Store
+	Base
+	List[string]
+	name string
+}
+
+type ReadCloser interface {
+	io.Reader
+	Closer
+	Name() string
+}
+
+type Number interface {
+	~int | ~float64
+}
+`
+	result, err := parser.New().AnalyzeSource("internal/store/store.go", []byte(src))
+	require.NoError(t, err)
+
+	require.Len(t, result.StructDefs, 1)
+	assert.Equal(t, []domain.EmbeddedType{
+		{Package: "example.com/app/internal/kv", Name: "Store", Pointer: true},
+		{Name: "Base"},
+		{Name: "List"},
+	}, result.StructDefs[0].Embedded)
+
+	require.Len(t, result.InterfaceDefs, 2)
+	assert.Equal(t, []domain.EmbeddedType{
+		{Package: "io", Name: "Reader"},
+		{Name: "Closer"},
+	}, result.InterfaceDefs[0].Embedded)
+	assert.Equal(t, []string{"Name"}, result.InterfaceDefs[0].Methods)
+	assert.Empty(t, result.InterfaceDefs[1].Embedded)
+}
+
+func TestGoParser_RecordsStructTags(t *testing.T) {
+	src := "package api\n\ntype User struct {\n\tBase `json:\",inline\"`\n\tID, Ref string `json:\"id\" db:\"user_id\"`\n\tName string\n}\n"
+	result, err := parser.New().AnalyzeSource("api/user.go", []byte(src))
+	require.NoError(t, err)
+
+	require.Len(t, result.StructDefs, 1)
+	assert.Equal(t, []domain.Param{
+		{Name: "Base", Type: "Base", Tag: `json:",inline"`},
+		{Name: "ID", Type: "string", Tag: `json:"id" db:"user_id"`},
+		{Name: "Ref", Type: "string", Tag: `json:"id" db:"user_id"`},
+		{Name: "Name", Type: "string"},
+	}, result.StructDefs[0].Fields)
+}
+
+func TestGoParser_RecordsErrorLogs(t *testing.T) {
+	src := `package store
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/rs/zerolog/log"
+)
+
+func (s *Store) Save(t Tester) error {
+	if err := s.db.Exec(); err != nil {
+		slog.Error("save failed", "err", err)
+		s.logger.Error("save failed")
+		log.Error().Err(err).Msg("save failed")
+		log.Error().Msg("save failed")
+		t.Errorf("not a logger")
+		return fmt.Errorf("saving: %w", err)
+	}
+	return nil
+}
+`
+	result, err := parser.New().AnalyzeSource("store/store.go", []byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, []domain.ErrorLog{
+		{Line: 12, HasError: true},
+		{Line: 13},
+		{Line: 14, HasError: true},
+		{Line: 15},
+	}, result.ErrorLogs)
+}
+
+func TestGoParser_RecordsSecretCandidates(t *testing.T) {
+	src := `package config
+
+import "net/http"
+
+const defaultPassword = "hunter2!"
+
+var headers = map[string]string{"X-Api-Key": "k-29f8a7c3"}
+
+type Config struct {
+	Token string ` + "`json:\"token_value\"`" + `
+}
+
+func load(c *Config) {
+	c.Token = "t0ken-123"
+	_ = "short"
+	println("a message with spaces")
+	_ = http.MethodGet
+}
+`
+	result, err := parser.New().AnalyzeSource("config/config.go", []byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, []domain.SecretCandidate{
+		{Name: "defaultPassword", Kind: "credential", Line: 5},
+		{Name: "Token", Kind: "credential", Line: 14},
+	}, result.SecretCandidates)
+}
+
+func TestGoParser_RecordsDeclarationsAndMethodSignatures(t *testing.T) {
+	src := `package store
+
+type Mode int
+
+const (
+	ReadOnly Mode = iota
+	ReadWrite
+	Limit = 10
+)
+
+var ErrNotFound, errClosed error
+
+type Set[K comparable, V any] map[K]V
+
+type Name = string
+
+type Store interface {
+	Get(key string) (string, error)
+	Put(key, value string, opts ...func(*Mode))
+	Close() error
+}
+
+type Options struct{ Path string }
+`
+	result, err := parser.New().AnalyzeSource("store/store.go", []byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, []domain.Declaration{
+		{Kind: "type", Name: "Mode", Type: "int", Line: 3},
+		{Kind: "const", Name: "ReadOnly", Type: "Mode", Line: 6},
+		{Kind: "const", Name: "ReadWrite", Type: "Mode", Line: 7},
+		{Kind: "const", Name: "Limit", Line: 8},
+		{Kind: "var", Name: "ErrNotFound", Type: "error", Line: 11},
+		{Kind: "var", Name: "errClosed", Type: "error", Line: 11},
+		{Kind: "type", Name: "Set", TypeParams: "[K comparable, V any]", Type: "map[K]V", Line: 13},
+		{Kind: "type", Name: "Name", Type: "= string", Line: 15},
+	}, result.Declarations)
+
+	require.Len(t, result.InterfaceDefs, 1)
+	assert.Equal(t, []string{
+		"(string) (string, error)",
+		"(string, string, ...func(*Mode))",
+		"() error",
+	}, result.InterfaceDefs[0].Signatures)
+}
+
+func TestGoParser_RecordsSQLCalls(t *testing.T) {
+	src := `package store
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func (s *Store) Find(ctx context.Context, name string, limit int, sort string) {
+	s.db.QueryContext(ctx, "SELECT * FROM users WHERE name = '" + name + "'")
+	q := "SELECT * FROM users"
+	q += " ORDER BY " + sort
+	s.db.Query(q)
+	s.db.Query(fmt.Sprintf("SELECT * FROM users LIMIT %d", limit))
+	s.db.Query(fmt.Sprintf("SELECT * FROM %s LIMIT %d", s.table, limit))
+	s.db.Exec("DELETE FROM users WHERE id = ?", name)
+	s.db.Exec("DELETE FROM users LIMIT " + strconv.Itoa(limit))
+	s.cmd.Exec("ls " + name)
+}
+`
+	result, err := parser.New().AnalyzeSource("store/store.go", []byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, []domain.SQLCall{
+		{Method: "QueryContext", Dynamic: []string{"name"}, Line: 9},
+		{Method: "Query", Dynamic: []string{"sort"}, Line: 12},
+		{Method: "Query", Dynamic: []string{"s.table"}, Line: 14},
+		{Method: "Exec", Dynamic: []string{"strconv.Itoa(limit)"}, Line: 16},
+	}, result.SQLCalls)
+}
+
+func TestGoParser_RecordsFileAccess(t *testing.T) {
+	src := `package files
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func serve(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	os.WriteFile(filepath.Join("/srv", name), nil, 0o666)
+	os.MkdirAll("/srv/cache", os.FileMode(0755))
+	os.Chmod("/srv/cache", os.ModePerm)
+	base := filepath.Base(r.FormValue("file"))
+	_ = filepath.Join("/srv", base)
+	dir := r.PathValue("dir")
+	if strings.Contains(dir, "..") {
+		return
+	}
+	_ = filepath.Join("/srv", dir)
+}
+`
+	result, err := parser.New().AnalyzeSource("files/files.go", []byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, []domain.FileMode{
+		{Call: "os.WriteFile", Mode: 0o666, Line: 12},
+		{Call: "os.MkdirAll", Mode: 0o755, Line: 13},
+		{Call: "os.Chmod", Mode: 0o777, Line: 14},
+	}, result.FileModes)
+	assert.Equal(t, []domain.PathJoin{{Inputs: []string{"name"}, Line: 12}}, result.PathJoins)
+}
+
+func TestGoParser_RecordsQualifiedRefs(t *testing.T) {
+	src := `package app
+
+import (
+	"fmt"
+
+	st "example.com/app/internal/store"
+)
+
+func Run(fmt string) error {
+	s, err := st.New(st.Options{})
+	if err != nil {
+		return err
+	}
+	_ = fmt.Sprintf
+	return s.Close(st.ErrClosed, st.New)
+}
+`
+	result, err := parser.New().AnalyzeSource("internal/app/app.go", []byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]string{
+		"example.com/app/internal/store": {"New", "Options", "ErrClosed"},
+	}, result.QualifiedRefs, "the fmt parameter shadows the import")
+}
+
+func TestGoParser_RecordsReceiverName(t *testing.T) {
+	src := `package server
+
+type Server struct{}
+
+func (s *Server) Start() {}
+func (Server) Stop()     {}
+`
+	result, err := parser.New().AnalyzeSource("server/server.go", []byte(src))
+	require.NoError(t, err)
+
+	require.Len(t, result.Functions, 2)
+	assert.Equal(t, "s", result.Functions[0].ReceiverName)
+	assert.Empty(t, result.Functions[1].ReceiverName)
+}
+
+func TestGoParser_CountsNakedReturns(t *testing.T) {
+	src := `package calc
+
+func Split(sum int) (x, y int) {
+	if sum < 0 {
+		return
+	}
+	f := func() (err error) { return }
+	_ = f
+	x = sum * 4 / 9
+	y = sum - x
+	return
+}
+
+func Sum(a, b int) int { return a + b }
+`
+	result, err := parser.New().AnalyzeSource("calc/calc.go", []byte(src))
+	require.NoError(t, err)
+
+	require.Len(t, result.Functions, 2)
+	assert.True(t, result.Functions[0].NamedResults)
+	assert.Equal(t, 2, result.Functions[0].NakedReturns, "the literal's return is its own")
+	assert.False(t, result.Functions[1].NamedResults)
+	assert.Zero(t, result.Functions[1].NakedReturns)
+}
+
+func TestGoParser_RecordsNumericLiterals(t *testing.T) {
+	src := `package retry
+
+const maxAttempts = 5
+
+var base = 250
+
+func Delay(n int) int {
+	const cap = 30000
+	var buf [64]byte
+	_ = buf
+	if n <= 0 || n == -1 {
+		return 1
+	}
+	return min(base*n*3, cap) + -7 + int(0.5*2.5)
+}
+`
+	result, err := parser.New().AnalyzeSource("retry/retry.go", []byte(src))
+	require.NoError(t, err)
+
+	var values []string
+	for _, lit := range result.NumericLiterals {
+		values = append(values, lit.Value)
+	}
+	assert.Equal(t, []string{"3", "-7", "0.5", "2.5"}, values)
+	assert.Equal(t, 14, result.NumericLiterals[0].Line)
+}
+
+func TestGoParser_RecordsLongStringLiterals(t *testing.T) {
+	src := `package store
+
+import "example.com/app/internal/some/long/import/path"
+
+const query = "SELECT id FROM users WHERE id = $1"
+
+type User struct {
+	Name string ` + "`json:\"name,omitempty\" db:\"user_name_column\"`" + `
+}
+
+var errMissing = "the user could not be found in the store"
+
+func Load() string {
+	_ = map[string]string{"consistent_patterns.message_id": "x"}
+	return "SELECT name FROM users WHERE id = $1" + "short"
+}
+`
+	result, err := parser.New().AnalyzeSource("store/store.go", []byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, []domain.StringLiteral{
+		{Value: "the user could not be found in the store", Line: 11},
+		{Value: "SELECT name FROM users WHERE id = $1", Line: 15},
+	}, result.StringLiterals)
+}
+
+func TestGoParser_SyntaxErrorIsPositioned(t *testing.T) {
+	src := "package broken\n\nfunc ok() {}\n\nfunc bad( {\n}\n"
+	_, err := parser.New().AnalyzeSource("broken.go", []byte(src))
+	require.Error(t, err)
+
+	var perr *domain.ParseError
+	require.ErrorAs(t, err, &perr)
+	assert.Equal(t, 5, perr.Line)
+	assert.Positive(t, perr.Column)
+	assert.NotEmpty(t, perr.Message)
+	assert.GreaterOrEqual(t, perr.Count, 1)
+}
@@ -0,0 +1,135 @@
+package parser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+var (
+	snakeCaseName = regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`)
+	// camelOrKebabName accepts camelCase, PascalCase (components) and
+	// kebab-case segments, rejecting underscores and spaces.
+	camelOrKebabName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*(-[a-z0-9]+)*$`)
+)
+
+// LineAnalyzer implements domain.LanguageAnalyzer for languages that only
+// need line counts, comment stripping and a file naming check.
+type LineAnalyzer struct {
+	language    string
+	extensions  []string
+	lineComment string
+	blockStart  string
+	blockEnd    string
+	convention  string
+	nameRe      *regexp.Regexp
+}
+
+// LanguageAnalyzers returns the built-in analyzers for non-Go files:
+// Protocol Buffers, SQL and TypeScript.
+func LanguageAnalyzers() []domain.LanguageAnalyzer {
+	return []domain.LanguageAnalyzer{
+		&LineAnalyzer{
+			language: "protobuf", extensions: []string{".proto"},
+			lineComment: "//", blockStart: "/*", blockEnd: "*/",
+			convention: "snake_case", nameRe: snakeCaseName,
+		},
+		&LineAnalyzer{
+			language: "sql", extensions: []string{".sql"},
+			lineComment: "--", blockStart: "/*", blockEnd: "*/",
+			convention: "snake_case", nameRe: snakeCaseName,
+		},
+		&LineAnalyzer{
+			language: "typescript", extensions: []string{".ts", ".tsx"},
+			lineComment: "//", blockStart: "/*", blockEnd: "*/",
+			convention: "camelCase or kebab-case", nameRe: camelOrKebabName,
+		},
+	}
+}
+
+func (a *LineAnalyzer) Language() string { return a.language }
+
+func (a *LineAnalyzer) Extensions() []string { return a.extensions }
+
+func (a *LineAnalyzer) AnalyzeFile(filePath string) (*domain.ForeignFile, error) {
+	src, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", filePath, err)
+	}
+	return a.AnalyzeSource(filePath, src), nil
+}
+
+// AnalyzeSource measures in-memory source as if it were read from filePath.
+func (a *LineAnalyzer) AnalyzeSource(filePath string, src []byte) *domain.ForeignFile {
+	content := string(src)
+	ff := &domain.ForeignFile{
+		Path:             filePath,
+		Language:         a.language,
+		NamingConvention: a.convention,
+		NamingOK:         a.nameRe.MatchString(baseName(filePath)),
+		IsGenerated:      strings.Contains(content, "Code generated") && strings.Contains(content, "DO NOT EDIT"),
+	}
+
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	if content == "" {
+		lines = nil
+	}
+	ff.TotalLines = len(lines)
+
+	inBlock := false
+	for _, line := range lines {
+		var code bool
+		code, inBlock = a.scanLine(strings.TrimSpace(line), inBlock)
+		if code {
+			ff.CodeLines++
+		}
+	}
+	return ff
+}
+
+// scanLine reports whether a trimmed line holds code outside comments and
+// whether a block comment is still open at its end.
+func (a *LineAnalyzer) scanLine(line string, inBlock bool) (code, open bool) {
+	for line != "" {
+		if inBlock {
+			end := strings.Index(line, a.blockEnd)
+			if end < 0 {
+				return code, true
+			}
+			line = strings.TrimSpace(line[end+len(a.blockEnd):])
+			inBlock = false
+			continue
+		}
+		if strings.HasPrefix(line, a.lineComment) {
+			return code, false
+		}
+		if strings.HasPrefix(line, a.blockStart) {
+			line = line[len(a.blockStart):]
+			inBlock = true
+			continue
+		}
+		// Anything else is code; a trailing block comment may still open.
+		code = true
+		start := strings.Index(line, a.blockStart)
+		if start < 0 {
+			return code, false
+		}
+		line = line[start+len(a.blockStart):]
+		inBlock = true
+	}
+	return code, inBlock
+}
+
+// baseName strips the directory and every extension, so "user.service.ts"
+// and "users.d.ts" are checked on "user" and "users".
+func baseName(path string) string {
+	name := filepath.Base(path)
+	if i := strings.Index(name, "."); i > 0 {
+		name = name[:i]
+	}
+	return name
+}
@@ -0,0 +1,88 @@
+package parser_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func languageAnalyzer(t *testing.T, ext string) domain.LanguageAnalyzer {
+	t.Helper()
+	for _, la := range parser.LanguageAnalyzers() {
+		for _, e := range la.Extensions() {
+			if e == ext {
+				return la
+			}
+		}
+	}
+	t.Fatalf("no analyzer for %s", ext)
+	return nil
+}
+
+func TestLineAnalyzer_CountsCodeLinesSkippingComments(t *testing.T) {
+	src := `-- users table
+/* multi-line
+   comment */
+CREATE TABLE users (
+  id INT /* inline */
+);
+
+`
+	path := filepath.Join(t.TempDir(), "create_users.sql")
+	require.NoError(t, os.WriteFile(path, []byte(src), 0644))
+
+	ff, err := languageAnalyzer(t, ".sql").AnalyzeFile(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, "sql", ff.Language)
+	assert.Equal(t, 7, ff.TotalLines)
+	assert.Equal(t, 3, ff.CodeLines)
+	assert.True(t, ff.NamingOK)
+	assert.False(t, ff.IsGenerated)
+}
+
+func TestLineAnalyzer_NamingConventions(t *testing.T) {
+	tests := []struct {
+		file string
+		ok   bool
+	}{
+		{"user_service.proto", true},
+		{"UserService.proto", false},
+		{"001_init.sql", true},
+		{"AddUsers.sql", false},
+		{"user-service.ts", true},
+		{"userService.ts", true},
+		{"user.service.ts", true},
+		{"Button.tsx", true},
+		{"user_service.ts", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			require.NoError(t, os.WriteFile(path, []byte("x\n"), 0644))
+
+			ff, err := languageAnalyzer(t, filepath.Ext(tt.file)).AnalyzeFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.ok, ff.NamingOK)
+		})
+	}
+}
+
+func TestLineAnalyzer_DetectsGeneratedFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api.ts")
+	require.NoError(t, os.WriteFile(path, []byte("// Code generated by protoc-gen-ts. DO NOT EDIT.\nexport {}\n"), 0644))
+
+	ff, err := languageAnalyzer(t, ".ts").AnalyzeFile(path)
+	require.NoError(t, err)
+	assert.True(t, ff.IsGenerated)
+}
+
+func TestLineAnalyzer_MissingFile(t *testing.T) {
+	_, err := languageAnalyzer(t, ".proto").AnalyzeFile(filepath.Join(t.TempDir(), "missing.proto"))
+	assert.Error(t, err)
+}
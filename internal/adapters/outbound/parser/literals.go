@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// numericLiterals lists the integer and floating-point literals in
+// function bodies, negated ones as "-N". Literals in const declarations
+// and array lengths name or size something already and are skipped, as are
+// 0, 1 and -1, and every package-level declaration.
+func numericLiterals(file *ast.File, fset *token.FileSet) []domain.NumericLiteral {
+	var lits []domain.NumericLiteral
+	add := func(value string, pos token.Pos) {
+		if value == "0" || value == "1" || value == "-1" {
+			return
+		}
+		lits = append(lits, domain.NumericLiteral{Value: value, Line: fset.Position(pos).Line})
+	}
+	for _, decl := range file.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Body == nil {
+			continue
+		}
+		ast.Inspect(fd.Body, func(n ast.Node) bool {
+			switch x := n.(type) {
+			case *ast.GenDecl:
+				return x.Tok != token.CONST
+			case *ast.ArrayType:
+				return false
+			case *ast.UnaryExpr:
+				if lit, ok := x.X.(*ast.BasicLit); ok && x.Op == token.SUB && isNumber(lit) {
+					add("-"+lit.Value, x.Pos())
+					return false
+				}
+			case *ast.BasicLit:
+				if isNumber(x) {
+					add(x.Value, x.Pos())
+				}
+			}
+			return true
+		})
+	}
+	return lits
+}
+
+func isNumber(lit *ast.BasicLit) bool {
+	return lit.Kind == token.INT || lit.Kind == token.FLOAT
+}
+
+// minStringLiteralLen is the unquoted length from which a string literal
+// is long enough to be worth a single source of truth.
+const minStringLiteralLen = 20
+
+// stringLiterals lists the string literals of at least minStringLiteralLen
+// characters, unquoted. Import paths, struct tags and literals in const
+// declarations are skipped: they are either not values or already named.
+// So are key-like strings such as message IDs and map keys, which repeat
+// by design.
+func stringLiterals(file *ast.File, fset *token.FileSet) []domain.StringLiteral {
+	var lits []domain.StringLiteral
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.GenDecl:
+			return x.Tok != token.CONST && x.Tok != token.IMPORT
+		case *ast.Field: // names, type and tag: no values
+			return false
+		case *ast.BasicLit:
+			if x.Kind != token.STRING {
+				return true
+			}
+			v, err := strconv.Unquote(x.Value)
+			if err == nil && len(v) >= minStringLiteralLen && !isKeyLike(v) {
+				lits = append(lits, domain.StringLiteral{Value: v, Line: fset.Position(x.Pos()).Line})
+			}
+		}
+		return true
+	})
+	return lits
+}
+
+// isKeyLike reports whether s consists of letters, digits and the
+// separators of identifiers and dotted keys only.
+func isKeyLike(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("_.-", r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// loggingPackages are the import paths of the logging libraries whose
+// package-level functions log, e.g. slog.Error or zap.L().Error.
+var loggingPackages = map[string]bool{
+	"log":                        true,
+	"log/slog":                   true,
+	"github.com/sirupsen/logrus": true,
+	"go.uber.org/zap":            true,
+	"github.com/rs/zerolog":      true,
+	"github.com/rs/zerolog/log":  true,
+}
+
+// errorLevelMethods are the methods that log at error level across log,
+// slog, logrus, zap and zerolog.
+var errorLevelMethods = map[string]bool{
+	"Error": true, "Errorf": true, "Errorw": true, "Errorln": true, "ErrorContext": true,
+}
+
+// errorLogs lists the error-level logging calls in the file. A call counts
+// when its selector chain, such as s.logger.Error(...) or
+// log.Error().Err(err).Msg(...), has an error-level method and starts at
+// an imported logging package or at a name containing "log". Without type
+// information that is the closest a parser gets to "this is a logger".
+func errorLogs(file *ast.File, imports map[string]string, fset *token.FileSet) []domain.ErrorLog {
+	var logs []domain.ErrorLog
+	inner := make(map[*ast.CallExpr]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		ce, ok := n.(*ast.CallExpr)
+		if !ok || inner[ce] {
+			return true
+		}
+		chain, root := callChain(ce)
+		for _, c := range chain[1:] {
+			inner[c] = true
+		}
+		if !isLoggerRoot(root, imports) {
+			return true
+		}
+		errorLevel, hasError := false, false
+		for _, c := range chain {
+			if sel, ok := c.Fun.(*ast.SelectorExpr); ok && errorLevelMethods[sel.Sel.Name] {
+				errorLevel = true
+			}
+			hasError = hasError || refersToError(c.Args)
+		}
+		if errorLevel {
+			logs = append(logs, domain.ErrorLog{Line: fset.Position(ce.Pos()).Line, HasError: hasError})
+		}
+		return true
+	})
+	return logs
+}
+
+// callChain returns the method calls chained into ce, outermost first, and
+// the expression the chain starts at: for a.B().C() the calls C and B and
+// the root a.
+func callChain(ce *ast.CallExpr) ([]*ast.CallExpr, ast.Expr) {
+	chain := []*ast.CallExpr{ce}
+	for {
+		sel, ok := unparen(ce.Fun).(*ast.SelectorExpr)
+		if !ok {
+			return chain, ce.Fun
+		}
+		next, ok := unparen(sel.X).(*ast.CallExpr)
+		if !ok {
+			return chain, sel.X
+		}
+		chain = append(chain, next)
+		ce = next
+	}
+}
+
+// isLoggerRoot reports whether a call chain starting at root is made on a
+// logger: an imported logging package, or a variable or field whose name
+// mentions log.
+func isLoggerRoot(root ast.Expr, imports map[string]string) bool {
+	switch x := root.(type) {
+	case *ast.Ident:
+		if p, ok := imports[x.Name]; ok && x.Obj == nil {
+			return loggingPackages[p]
+		}
+		return strings.Contains(strings.ToLower(x.Name), "log")
+	case *ast.SelectorExpr:
+		return strings.Contains(strings.ToLower(x.Sel.Name), "log")
+	}
+	return false
+}
+
+// refersToError reports whether any of args mentions an error value by
+// name: err, a name ending in Err or err, or a sentinel starting with Err.
+func refersToError(args []ast.Expr) bool {
+	found := false
+	for _, arg := range args {
+		ast.Inspect(arg, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok && isErrorName(id.Name) {
+				found = true
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+func isErrorName(name string) bool {
+	return strings.HasSuffix(name, "err") || strings.HasSuffix(name, "Err") || strings.HasPrefix(name, "Err")
+}
@@ -0,0 +1,244 @@
+package parser
+
+import (
+	"go/scanner"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// AnalyzePartial measures Go source that does not parse, as editors see it
+// mid-edit, with a tolerant pass over its tokens: the package clause and
+// its doc, imports, struct and interface names, and every function with its
+// line span. A declaration keyword in the first column starts a new
+// top-level declaration even when braces above it are unbalanced, so one
+// broken function does not swallow the rest of the file. Everything that
+// needs a syntax tree is left empty and Partial is set.
+func (p *GoParser) AnalyzePartial(filePath string, src []byte) *domain.AnalyzedFile {
+	fset := token.NewFileSet()
+	file := fset.AddFile(filePath, -1, len(src))
+	var s scanner.Scanner
+	s.Init(file, src, func(token.Position, string) {}, scanner.ScanComments)
+
+	var toks []partialToken
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		position := file.Position(pos)
+		toks = append(toks, partialToken{tok: tok, lit: lit, line: position.Line, col: position.Column, offset: position.Offset})
+	}
+
+	ps := &partialScan{toks: toks, result: &domain.AnalyzedFile{
+		Path:        filePath,
+		Partial:     true,
+		TotalLines:  file.LineCount(),
+		IsGenerated: isGeneratedFilename(filePath),
+	}}
+	normalizeTokens(src).apply(ps.result)
+	ps.scan(src)
+	return ps.result
+}
+
+type partialToken struct {
+	tok    token.Token
+	lit    string
+	line   int
+	col    int
+	offset int
+}
+
+// partialScan is the state of one AnalyzePartial pass.
+type partialScan struct {
+	toks   []partialToken
+	result *domain.AnalyzedFile
+	depth  int              // brace depth
+	parens int              // paren depth, to find a function body's brace
+	fn     *domain.Function // function being scanned, added to result once it ends
+	body   bool             // fn's body brace has been seen
+	docEnd int              // end line of the last comment above the package clause
+}
+
+func (ps *partialScan) scan(src []byte) {
+	for i := 0; i < len(ps.toks); i++ {
+		t := ps.toks[i]
+		if t.col == 1 && (t.tok == token.FUNC || t.tok == token.TYPE || t.tok == token.VAR ||
+			t.tok == token.CONST || t.tok == token.IMPORT) {
+			ps.endFunc(t.line - 1)
+			ps.depth, ps.parens = 0, 0
+		}
+		switch t.tok {
+		case token.COMMENT:
+			ps.comment(t)
+		case token.PACKAGE:
+			ps.packageClause(i, src)
+		case token.IMPORT:
+			if ps.depth == 0 {
+				i = ps.imports(i + 1)
+			}
+		case token.TYPE:
+			if ps.depth == 0 {
+				ps.typeDecl(i + 1)
+			}
+		case token.FUNC:
+			if ps.depth == 0 && ps.fn == nil {
+				i = ps.funcDecl(i)
+			}
+		default:
+			ps.delimiter(t)
+		}
+	}
+	if len(ps.toks) > 0 {
+		ps.endFunc(ps.toks[len(ps.toks)-1].line)
+	}
+}
+
+// comment notes generated-code markers and, above the package clause,
+// where the comment ends, to tell whether it documents the package.
+func (ps *partialScan) comment(t partialToken) {
+	if strings.Contains(t.lit, "Code generated") && strings.Contains(t.lit, "DO NOT EDIT") {
+		ps.result.IsGenerated = true
+	}
+	if ps.result.Package == "" {
+		ps.docEnd = t.line + strings.Count(t.lit, "\n")
+	}
+}
+
+// packageClause records the package named after the package keyword at
+// token i, its doc and the header above it.
+func (ps *partialScan) packageClause(i int, src []byte) {
+	if ps.result.Package != "" || i+1 >= len(ps.toks) || ps.toks[i+1].tok != token.IDENT {
+		return
+	}
+	t := ps.toks[i]
+	ps.result.Package = ps.toks[i+1].lit
+	ps.result.PackageDoc = ps.docEnd == t.line-1
+	ps.result.Header = fileHeader(src, t.offset)
+}
+
+// delimiter tracks brace and paren depth, opening and closing the body of
+// the function being scanned.
+func (ps *partialScan) delimiter(t partialToken) {
+	switch t.tok {
+	case token.LPAREN:
+		ps.parens++
+	case token.RPAREN:
+		ps.parens = max(ps.parens-1, 0)
+	case token.LBRACE:
+		if ps.fn != nil && !ps.body && ps.depth == 0 && ps.parens == 0 {
+			ps.body = true
+		}
+		ps.depth++
+	case token.RBRACE:
+		ps.depth = max(ps.depth-1, 0)
+		if ps.depth == 0 && ps.body {
+			ps.endFunc(t.line)
+		}
+	}
+}
+
+// endFunc records the function being scanned as ending at line.
+func (ps *partialScan) endFunc(line int) {
+	if ps.fn == nil {
+		return
+	}
+	ps.fn.LineEnd = max(line, ps.fn.LineStart)
+	ps.result.Functions = append(ps.result.Functions, *ps.fn)
+	if ps.fn.Name == "init" && ps.fn.Receiver == "" {
+		ps.result.InitFunctions++
+	}
+	ps.fn, ps.body = nil, false
+}
+
+// imports records the import spec or parenthesized import specs starting
+// at token i and returns the index of their last token.
+func (ps *partialScan) imports(i int) int {
+	grouped := i < len(ps.toks) && ps.toks[i].tok == token.LPAREN
+	if grouped {
+		i++
+	}
+	for ; i < len(ps.toks); i++ {
+		t := ps.toks[i]
+		switch {
+		case t.tok == token.RPAREN:
+			return i
+		case t.col == 1 && t.tok.IsKeyword():
+			return i - 1 // the next declaration
+		case t.tok == token.STRING:
+			ps.addImport(i)
+			if !grouped {
+				return i
+			}
+		}
+	}
+	return i
+}
+
+func (ps *partialScan) addImport(i int) {
+	path, err := strconv.Unquote(ps.toks[i].lit)
+	if err != nil {
+		return
+	}
+	ps.result.Imports = append(ps.result.Imports, path)
+	if path == "C" {
+		ps.result.HasCGoImport = true
+	}
+	if i > 0 && ps.toks[i-1].tok == token.IDENT && ps.toks[i-1].line == ps.toks[i].line && ps.toks[i-1].lit != "_" {
+		if ps.result.ImportAliases == nil {
+			ps.result.ImportAliases = make(map[string]string)
+		}
+		ps.result.ImportAliases[path] = ps.toks[i-1].lit
+	}
+}
+
+// typeDecl records a struct or interface type declared at token i.
+func (ps *partialScan) typeDecl(i int) {
+	if i+1 >= len(ps.toks) || ps.toks[i].tok != token.IDENT {
+		return
+	}
+	switch ps.toks[i+1].tok {
+	case token.STRUCT:
+		ps.result.Structs = append(ps.result.Structs, ps.toks[i].lit)
+	case token.INTERFACE:
+		ps.result.Interfaces = append(ps.result.Interfaces, ps.toks[i].lit)
+	}
+}
+
+// funcDecl starts the function declared by the func keyword at token i and
+// returns the index of its name, or i for a function literal.
+func (ps *partialScan) funcDecl(i int) int {
+	fn := &domain.Function{LineStart: ps.toks[i].line}
+	j := i + 1
+	if j < len(ps.toks) && ps.toks[j].tok == token.LPAREN {
+		var idents []string
+		pointer := ""
+		for j++; j < len(ps.toks) && ps.toks[j].tok != token.RPAREN && ps.toks[j].tok != token.LBRACK; j++ {
+			switch ps.toks[j].tok {
+			case token.IDENT:
+				idents = append(idents, ps.toks[j].lit)
+			case token.MUL:
+				pointer = "*"
+			}
+		}
+		for j < len(ps.toks) && ps.toks[j].tok != token.RPAREN {
+			j++
+		}
+		j++
+		if len(idents) > 0 {
+			fn.Receiver = pointer + idents[len(idents)-1]
+			if len(idents) > 1 {
+				fn.ReceiverName = idents[0]
+			}
+		}
+	}
+	if j >= len(ps.toks) || ps.toks[j].tok != token.IDENT {
+		return i
+	}
+	fn.Name = ps.toks[j].lit
+	fn.Exported = token.IsExported(fn.Name)
+	ps.fn = fn
+	return j
+}
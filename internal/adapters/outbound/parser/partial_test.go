@@ -0,0 +1,80 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoParser_AnalyzePartial(t *testing.T) {
+	src := `// Package orders handles orders.
+package orders
+
+import (
+	"fmt"
+	str "strings"
+)
+
+import "errors"
+
+type Order struct {
+	ID string
+}
+
+type Store interface {
+	Get(id string) (*Order, error)
+}
+
+func (s *Service) Place(o *Order) error {
+	if o.ID == "" {
+		return errors.New("missing id"
+	}
+	fmt.Println(str.ToUpper(o.ID))
+	return nil
+}
+
+func validate(o *Order) bool {
+	for {
+		if o == nil {
+}
+
+func Cancel[T any](v T) error {
+	return nil
+}
+`
+	_, err := parser.New().AnalyzeSource("orders.go", []byte(src))
+	require.Error(t, err, "the source must not parse")
+
+	af := parser.New().AnalyzePartial("orders.go", []byte(src))
+	assert.True(t, af.Partial)
+	assert.Equal(t, "orders", af.Package)
+	assert.True(t, af.PackageDoc)
+	assert.Equal(t, 34, af.TotalLines)
+	assert.Equal(t, []string{"fmt", "strings", "errors"}, af.Imports)
+	assert.Equal(t, map[string]string{"strings": "str"}, af.ImportAliases)
+	assert.Equal(t, []string{"Order"}, af.Structs)
+	assert.Equal(t, []string{"Store"}, af.Interfaces)
+
+	require.Len(t, af.Functions, 3)
+	assert.Equal(t, domain.Function{Name: "Place", Receiver: "*Service", ReceiverName: "s", Exported: true, LineStart: 19, LineEnd: 25},
+		stripCalls(af.Functions[0]))
+	assert.Equal(t, domain.Function{Name: "validate", LineStart: 27, LineEnd: 31}, stripCalls(af.Functions[1]),
+		"an unbalanced function ends before the next declaration in column one")
+	assert.Equal(t, domain.Function{Name: "Cancel", Exported: true, LineStart: 32, LineEnd: 34}, stripCalls(af.Functions[2]))
+}
+
+func TestGoParser_AnalyzePartialDetectsGeneratedCode(t *testing.T) {
+	src := "// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage pb\n\nfunc broken( {\n"
+	af := parser.New().AnalyzePartial("api.go", []byte(src))
+	assert.True(t, af.IsGenerated)
+	assert.False(t, af.PackageDoc)
+	assert.Equal(t, "// Code generated by protoc-gen-go. DO NOT EDIT.\n\n", af.Header)
+}
+
+func stripCalls(fn domain.Function) domain.Function {
+	fn.Calls = nil
+	return fn
+}
@@ -0,0 +1,26 @@
+package parser
+
+import "go/ast"
+
+// namedResults reports whether a function type names its results.
+func namedResults(ft *ast.FuncType) bool {
+	return ft.Results != nil && len(ft.Results.List) > 0 && len(ft.Results.List[0].Names) > 0
+}
+
+// nakedReturns counts the bare return statements in body. Returns inside
+// function literals belong to the literal and are not counted.
+func nakedReturns(body *ast.BlockStmt) int {
+	n := 0
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch s := node.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			if len(s.Results) == 0 {
+				n++
+			}
+		}
+		return true
+	})
+	return n
+}
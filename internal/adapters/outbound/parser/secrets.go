@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// minSecretLen is the shortest literal worth checking for a credential.
+const minSecretLen = 6
+
+// secretCandidates lists the string literals that look like a credential
+// to domain.SecretKind. Only literals at least minSecretLen characters
+// long, and either bound to a name or free of whitespace, are checked,
+// since prose is not a key. Import paths and struct tags are skipped. The
+// name a literal is bound to comes from a constant or variable declaration,
+// an assignment, or a key in a composite literal.
+func secretCandidates(file *ast.File, fset *token.FileSet) []domain.SecretCandidate {
+	names := literalNames(file)
+	var out []domain.SecretCandidate
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.GenDecl:
+			return x.Tok != token.IMPORT
+		case *ast.Field:
+			return false
+		case *ast.BasicLit:
+			if x.Kind != token.STRING {
+				return true
+			}
+			v, err := strconv.Unquote(x.Value)
+			name := names[x]
+			if err != nil || len(v) < minSecretLen || (name == "" && strings.ContainsFunc(v, unicode.IsSpace)) {
+				return true
+			}
+			if kind := domain.SecretKind(name, v); kind != "" {
+				out = append(out, domain.SecretCandidate{Name: name, Kind: kind, Line: fset.Position(x.Pos()).Line})
+			}
+		}
+		return true
+	})
+	return out
+}
+
+// literalNames maps the string literals directly assigned to a name to
+// that name.
+func literalNames(file *ast.File) map[*ast.BasicLit]string {
+	names := make(map[*ast.BasicLit]string)
+	bind := func(name string, value ast.Expr) {
+		if lit, ok := unparen(value).(*ast.BasicLit); ok && lit.Kind == token.STRING && name != "" {
+			names[lit] = name
+		}
+	}
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.ValueSpec:
+			for i, v := range x.Values {
+				if i < len(x.Names) {
+					bind(x.Names[i].Name, v)
+				}
+			}
+		case *ast.AssignStmt:
+			for i, v := range x.Rhs {
+				if i < len(x.Lhs) {
+					bind(boundName(x.Lhs[i]), v)
+				}
+			}
+		case *ast.KeyValueExpr:
+			bind(boundName(x.Key), x.Value)
+		}
+		return true
+	})
+	return names
+}
+
+// boundName returns the name an assignment target or composite literal key
+// gives its value: an identifier, the field of a selector, or a string key.
+func boundName(e ast.Expr) string {
+	switch x := unparen(e).(type) {
+	case *ast.Ident:
+		return x.Name
+	case *ast.SelectorExpr:
+		return x.Sel.Name
+	case *ast.IndexExpr:
+		return boundName(x.Index)
+	case *ast.BasicLit:
+		if s, err := strconv.Unquote(x.Value); err == nil && x.Kind == token.STRING {
+			return s
+		}
+	}
+	return ""
+}
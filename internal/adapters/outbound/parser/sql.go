@@ -0,0 +1,192 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// sqlMethods maps the database/sql and sqlx methods that take a query to
+// the index of the query argument.
+var sqlMethods = map[string]int{
+	"Query": 0, "QueryRow": 0, "Exec": 0, "Prepare": 0,
+	"Queryx": 0, "QueryRowx": 0, "MustExec": 0,
+	"QueryContext": 1, "QueryRowContext": 1, "ExecContext": 1, "PrepareContext": 1,
+	"QueryxContext": 1, "QueryRowxContext": 1, "MustExecContext": 1,
+}
+
+// sqlKeyword tells a query apart from other strings passed to a method
+// named Exec or Query.
+var sqlKeyword = regexp.MustCompile(`(?i)\b(select|insert|update|delete|from|where|order by|values)\b`)
+
+// queryParts are the pieces a query string is built from: the literal
+// text, and the source of every other operand.
+type queryParts struct {
+	literals []string
+	dynamic  []string
+}
+
+// sqlCalls lists the query calls whose SQL is built at run time by string
+// concatenation or fmt.Sprintf, directly in the argument or in a local
+// variable assigned earlier in the function. Queries built only from
+// literals, and strings without SQL keywords, are skipped.
+func sqlCalls(file *ast.File, fset *token.FileSet) []domain.SQLCall {
+	var calls []domain.SQLCall
+	for _, decl := range file.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Body == nil {
+			continue
+		}
+		built := make(map[string]queryParts)
+		ast.Inspect(fd.Body, func(n ast.Node) bool {
+			switch x := n.(type) {
+			case *ast.AssignStmt:
+				trackQueryAssign(x, built)
+			case *ast.CallExpr:
+				if c, ok := sqlCall(x, built); ok {
+					c.Line = fset.Position(x.Pos()).Line
+					calls = append(calls, c)
+				}
+			}
+			return true
+		})
+	}
+	return calls
+}
+
+// trackQueryAssign records the parts of strings assigned to local
+// variables, so q := "SELECT ..." + name; db.Query(q) is recognized.
+func trackQueryAssign(as *ast.AssignStmt, built map[string]queryParts) {
+	if len(as.Lhs) != len(as.Rhs) {
+		return
+	}
+	for i, lhs := range as.Lhs {
+		id, ok := lhs.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		parts, ok := stringParts(as.Rhs[i], built)
+		switch {
+		case !ok:
+			delete(built, id.Name)
+		case as.Tok == token.ADD_ASSIGN:
+			prev := built[id.Name]
+			built[id.Name] = queryParts{
+				literals: append(append([]string(nil), prev.literals...), parts.literals...),
+				dynamic:  append(append([]string(nil), prev.dynamic...), parts.dynamic...),
+			}
+		default:
+			built[id.Name] = parts
+		}
+	}
+}
+
+// sqlCall reports a call to a query method whose query argument mixes SQL
+// text with run-time values.
+func sqlCall(ce *ast.CallExpr, built map[string]queryParts) (domain.SQLCall, bool) {
+	sel, ok := ce.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return domain.SQLCall{}, false
+	}
+	idx, ok := sqlMethods[sel.Sel.Name]
+	if !ok || idx >= len(ce.Args) {
+		return domain.SQLCall{}, false
+	}
+	parts, ok := stringParts(ce.Args[idx], built)
+	if !ok || len(parts.dynamic) == 0 || !sqlKeyword.MatchString(strings.Join(parts.literals, " ")) {
+		return domain.SQLCall{}, false
+	}
+	return domain.SQLCall{Method: sel.Sel.Name, Dynamic: parts.dynamic}, true
+}
+
+// stringParts splits a string expression into literal text and dynamic
+// operands. It understands literals, + concatenation, fmt.Sprintf and
+// variables tracked in built; ok is false for anything else, whose
+// content is unknown.
+func stringParts(expr ast.Expr, built map[string]queryParts) (queryParts, bool) {
+	switch x := unparen(expr).(type) {
+	case *ast.BasicLit:
+		if v, err := strconv.Unquote(x.Value); err == nil && x.Kind == token.STRING {
+			return queryParts{literals: []string{v}}, true
+		}
+	case *ast.Ident:
+		parts, ok := built[x.Name]
+		return parts, ok
+	case *ast.BinaryExpr:
+		if x.Op != token.ADD {
+			break
+		}
+		var parts queryParts
+		for _, operand := range []ast.Expr{x.X, x.Y} {
+			p, ok := stringParts(operand, built)
+			if !ok {
+				p = queryParts{dynamic: []string{types.ExprString(operand)}}
+			}
+			parts.literals = append(parts.literals, p.literals...)
+			parts.dynamic = append(parts.dynamic, p.dynamic...)
+		}
+		return parts, true
+	case *ast.CallExpr:
+		return sprintfParts(x)
+	}
+	return queryParts{}, false
+}
+
+// sprintfParts splits a fmt.Sprintf call into its format and the
+// arguments formatted as strings. Arguments for %d, %f and the other
+// numeric and boolean verbs cannot inject SQL and are left out.
+func sprintfParts(ce *ast.CallExpr) (queryParts, bool) {
+	sel, ok := ce.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Sprintf" || len(ce.Args) == 0 {
+		return queryParts{}, false
+	}
+	if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "fmt" {
+		return queryParts{}, false
+	}
+	lit, ok := ce.Args[0].(*ast.BasicLit)
+	if !ok {
+		return queryParts{}, false
+	}
+	format, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return queryParts{}, false
+	}
+	parts := queryParts{literals: []string{format}}
+	verbs := formatVerbs(format)
+	for i, arg := range ce.Args[1:] {
+		if i < len(verbs) && strings.ContainsRune("dbcoOxXUeEfFgGt", verbs[i]) {
+			continue
+		}
+		parts.dynamic = append(parts.dynamic, types.ExprString(arg))
+	}
+	return parts, true
+}
+
+// formatVerbs returns the verb letters of a format string in order. An
+// explicit argument index such as %[1]s ends the scan, since verbs no
+// longer map to arguments in order.
+func formatVerbs(format string) []rune {
+	var verbs []rune
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		j := i + 1
+		for j < len(format) && strings.IndexByte("+-# 0123456789.*", format[j]) >= 0 {
+			j++
+		}
+		if j >= len(format) || format[j] == '[' {
+			return verbs
+		}
+		if format[j] != '%' {
+			verbs = append(verbs, rune(format[j]))
+		}
+		i = j
+	}
+	return verbs
+}
@@ -0,0 +1,211 @@
+package pprof
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// profile holds the parts of a profile.proto message needed to attribute
+// samples to source lines. Unknown fields are skipped.
+type profile struct {
+	sampleTypes []int64 // string table index of each sample type
+	samples     []sample
+	locations   map[uint64][]line // location ID -> inlined lines, innermost first
+	functions   map[uint64]int64  // function ID -> string index of its file name
+	strings     []string
+}
+
+type sample struct {
+	locations []uint64
+	values    []int64
+}
+
+type line struct {
+	function uint64
+	line     int64
+}
+
+func (p *profile) str(i int64) string {
+	if i < 0 || int(i) >= len(p.strings) {
+		return ""
+	}
+	return p.strings[i]
+}
+
+// Field numbers from github.com/google/pprof/proto/profile.proto.
+const (
+	profileSampleType  = 1
+	profileSample      = 2
+	profileLocation    = 4
+	profileFunction    = 5
+	profileStringTable = 6
+
+	valueTypeType = 1
+
+	sampleLocationID = 1
+	sampleValue      = 2
+
+	locationID   = 1
+	locationLine = 4
+
+	lineFunctionID = 1
+	lineLine       = 2
+
+	functionID       = 1
+	functionFilename = 4
+)
+
+var errTruncated = errors.New("truncated protobuf message")
+
+func decodeProfile(data []byte) (*profile, error) {
+	p := &profile{locations: make(map[uint64][]line), functions: make(map[uint64]int64)}
+	err := eachField(data, func(num int, wire int, v uint64, b []byte) error {
+		switch num {
+		case profileSampleType:
+			var t int64
+			err := eachField(b, func(num, _ int, v uint64, _ []byte) error {
+				if num == valueTypeType {
+					t = int64(v)
+				}
+				return nil
+			})
+			p.sampleTypes = append(p.sampleTypes, t)
+			return err
+		case profileSample:
+			var s sample
+			err := eachField(b, func(num, wire int, v uint64, b []byte) error {
+				switch num {
+				case sampleLocationID:
+					return repeated(wire, v, b, func(v uint64) { s.locations = append(s.locations, v) })
+				case sampleValue:
+					return repeated(wire, v, b, func(v uint64) { s.values = append(s.values, int64(v)) })
+				}
+				return nil
+			})
+			p.samples = append(p.samples, s)
+			return err
+		case profileLocation:
+			var id uint64
+			var lines []line
+			err := eachField(b, func(num, _ int, v uint64, b []byte) error {
+				switch num {
+				case locationID:
+					id = v
+				case locationLine:
+					var ln line
+					err := eachField(b, func(num, _ int, v uint64, _ []byte) error {
+						switch num {
+						case lineFunctionID:
+							ln.function = v
+						case lineLine:
+							ln.line = int64(v)
+						}
+						return nil
+					})
+					lines = append(lines, ln)
+					return err
+				}
+				return nil
+			})
+			p.locations[id] = lines
+			return err
+		case profileFunction:
+			var id uint64
+			var file int64
+			err := eachField(b, func(num, _ int, v uint64, _ []byte) error {
+				switch num {
+				case functionID:
+					id = v
+				case functionFilename:
+					file = int64(v)
+				}
+				return nil
+			})
+			p.functions[id] = file
+			return err
+		case profileStringTable:
+			if wire != wireBytes {
+				return fmt.Errorf("string table entry has wire type %d", wire)
+			}
+			p.strings = append(p.strings, string(b))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Protobuf wire types.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// eachField calls fn for every field of the message in data, passing the
+// value of varint and fixed fields as v and the payload of length-delimited
+// fields as b.
+func eachField(data []byte, fn func(num, wire int, v uint64, b []byte) error) error {
+	for len(data) > 0 {
+		tag, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errTruncated
+		}
+		data = data[n:]
+		num, wire := int(tag>>3), int(tag&7)
+
+		var v uint64
+		var b []byte
+		switch wire {
+		case wireVarint:
+			v, n = binary.Uvarint(data)
+			if n <= 0 {
+				return errTruncated
+			}
+			data = data[n:]
+		case wireFixed64:
+			if len(data) < 8 {
+				return errTruncated
+			}
+			v, data = binary.LittleEndian.Uint64(data), data[8:]
+		case wireFixed32:
+			if len(data) < 4 {
+				return errTruncated
+			}
+			v, data = uint64(binary.LittleEndian.Uint32(data)), data[4:]
+		case wireBytes:
+			size, n := binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < size {
+				return errTruncated
+			}
+			b, data = data[n:n+int(size)], data[n+int(size):]
+		default:
+			return fmt.Errorf("unsupported wire type %d", wire)
+		}
+		if err := fn(num, wire, v, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// repeated decodes a repeated varint field, packed or not.
+func repeated(wire int, v uint64, b []byte, add func(uint64)) error {
+	if wire != wireBytes {
+		add(v)
+		return nil
+	}
+	for len(b) > 0 {
+		x, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errTruncated
+		}
+		add(x)
+		b = b[n:]
+	}
+	return nil
+}
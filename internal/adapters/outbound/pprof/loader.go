@@ -0,0 +1,86 @@
+package pprof
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// Loader reads pprof CPU profiles (profile.proto, optionally gzipped) from
+// disk.
+type Loader struct{}
+
+func New() *Loader {
+	return &Loader{}
+}
+
+// Load reads the profile at path and attributes its samples to source
+// lines. The sample value used is the "cpu" one when the profile has it,
+// otherwise the last sample type.
+func (l *Loader) Load(path string) (*domain.CPUProfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading profile: %w", err)
+	}
+	if len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("decompressing profile: %w", err)
+		}
+		if data, err = io.ReadAll(zr); err != nil {
+			return nil, fmt.Errorf("decompressing profile: %w", err)
+		}
+	}
+	p, err := decodeProfile(data)
+	if err != nil {
+		return nil, fmt.Errorf("decoding profile %s: %w", path, err)
+	}
+	return p.cpuProfile(), nil
+}
+
+// cpuProfile sums the samples of p per source line.
+func (p *profile) cpuProfile() *domain.CPUProfile {
+	valueIndex := len(p.sampleTypes) - 1
+	for i, t := range p.sampleTypes {
+		if p.str(t) == "cpu" {
+			valueIndex = i
+		}
+	}
+
+	type key struct {
+		file string
+		line int
+	}
+	totals := make(map[key]int64)
+	var order []key
+	out := &domain.CPUProfile{}
+	for _, s := range p.samples {
+		if valueIndex < 0 || valueIndex >= len(s.values) {
+			continue
+		}
+		v := s.values[valueIndex]
+		out.Total += v
+		seen := make(map[key]bool)
+		for _, locID := range s.locations {
+			for _, ln := range p.locations[locID] {
+				k := key{p.str(p.functions[ln.function]), int(ln.line)}
+				if k.file == "" || seen[k] {
+					continue
+				}
+				seen[k] = true
+				if _, ok := totals[k]; !ok {
+					order = append(order, k)
+				}
+				totals[k] += v
+			}
+		}
+	}
+	for _, k := range order {
+		out.Lines = append(out.Lines, domain.ProfileLine{File: k.file, Line: k.line, Value: totals[k]})
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+package pprof_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"strings"
+	"testing"
+	"time"
+
+	openkraftpprof "github.com/abdidvp/openkraft/internal/adapters/outbound/pprof"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//go:noinline
+func spin(d time.Duration) int {
+	n := 0
+	for start := time.Now(); time.Since(start) < d; {
+		n++
+	}
+	return n
+}
+
+func TestLoader_Load(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cpu.pb.gz")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	require.NoError(t, pprof.StartCPUProfile(f))
+	spin(300 * time.Millisecond)
+	pprof.StopCPUProfile()
+	require.NoError(t, f.Close())
+
+	profile, err := openkraftpprof.New().Load(path)
+	require.NoError(t, err)
+	require.Positive(t, profile.Total)
+
+	var inTest int64
+	for _, l := range profile.Lines {
+		if strings.HasSuffix(l.File, "pprof/loader_test.go") {
+			inTest = max(inTest, l.Value)
+		}
+	}
+	assert.Greater(t, float64(inTest)/float64(profile.Total), 0.5, "spin should dominate the profile")
+}
+
+func TestLoader_LoadRejectsGarbage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cpu.pb")
+	require.NoError(t, os.WriteFile(path, []byte{0x0a, 0xff}, 0644))
+
+	_, err := openkraftpprof.New().Load(path)
+	assert.ErrorContains(t, err, "decoding profile")
+}
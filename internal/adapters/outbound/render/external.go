@@ -0,0 +1,89 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// ExternalPrefix names external renderers: an executable
+// openkraft-render-csv on PATH provides --format csv. It reads the score
+// JSON on stdin and writes the rendered report to stdout.
+const ExternalPrefix = "openkraft-render-"
+
+// AddExternal registers the external renderers found in the directories
+// of pathList, a PATH-style list. The first executable found for a format
+// wins, and formats already registered are kept, so an executable cannot
+// replace a built-in format. External renderers render single scores only.
+func (r *Registry) AddExternal(pathList string) {
+	for _, dir := range filepath.SplitList(pathList) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			name, ok := externalFormat(e)
+			if !ok {
+				continue
+			}
+			if _, taken := r.byName[name]; taken {
+				continue
+			}
+			path := filepath.Join(dir, e.Name())
+			_ = r.Register(Format{
+				Name:        name,
+				Description: "External renderer " + e.Name(),
+				Source:      path,
+				Score:       external{path: path},
+			}) // invalid names are skipped
+		}
+	}
+}
+
+// externalFormat returns the format an executable directory entry named
+// openkraft-render-<format> provides.
+func externalFormat(e os.DirEntry) (string, bool) {
+	name, ok := strings.CutPrefix(e.Name(), ExternalPrefix)
+	if !ok || e.IsDir() {
+		return "", false
+	}
+	if runtime.GOOS == "windows" {
+		name, ok = strings.CutSuffix(name, ".exe")
+		return name, ok && name != ""
+	}
+	info, err := e.Info()
+	if err != nil || info.Mode()&0o111 == 0 {
+		return "", false
+	}
+	return name, name != ""
+}
+
+// external renders through an executable.
+type external struct {
+	path string
+}
+
+// RenderScore pipes the score JSON through the executable, which reports
+// its own problems on stderr.
+func (e external) RenderScore(w io.Writer, score *domain.Score) error {
+	data, err := json.Marshal(score)
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(e.path)
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stdout = w
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("running %s: %w", filepath.Base(e.path), err)
+	}
+	return nil
+}
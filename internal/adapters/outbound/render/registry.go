@@ -0,0 +1,115 @@
+// Package render maps output format names to the renderers of scores and
+// monorepo scorecards, so a format is added by registering it rather than
+// by editing the commands that print.
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// SourceBuiltin is the Source of the formats openkraft ships.
+const SourceBuiltin = "builtin"
+
+// Format is one registered output format. A format renders single scores,
+// monorepo scorecards or both.
+type Format struct {
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	Source      string                  `json:"source"` // SourceBuiltin or the path of an external renderer
+	Score       domain.ScoreRenderer    `json:"-"`
+	Monorepo    domain.MonorepoRenderer `json:"-"`
+	Recursive   bool                    `json:"recursive"` // set by Register when Monorepo is
+	Single      bool                    `json:"single"`    // set by Register when Score is
+}
+
+// Registry holds the output formats by name, in registration order.
+type Registry struct {
+	formats []Format
+	byName  map[string]int
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{byName: map[string]int{}}
+}
+
+// Register adds f. A format name is registered once; built-in formats are
+// registered first and so cannot be replaced.
+func (r *Registry) Register(f Format) error {
+	if f.Name == "" || strings.ContainsAny(f.Name, " /\\") {
+		return fmt.Errorf("invalid format name %q", f.Name)
+	}
+	if f.Score == nil && f.Monorepo == nil {
+		return fmt.Errorf("format %s renders nothing", f.Name)
+	}
+	if _, dup := r.byName[f.Name]; dup {
+		return fmt.Errorf("format %s is already registered", f.Name)
+	}
+	f.Single, f.Recursive = f.Score != nil, f.Monorepo != nil
+	r.byName[f.Name] = len(r.formats)
+	r.formats = append(r.formats, f)
+	return nil
+}
+
+// Lookup returns the format registered under name.
+func (r *Registry) Lookup(name string) (Format, error) {
+	i, ok := r.byName[name]
+	if !ok {
+		return Format{}, fmt.Errorf("unknown format %q (supported: %s; see --format list)", name, strings.Join(r.Names(), ", "))
+	}
+	return r.formats[i], nil
+}
+
+// Formats returns every registered format in registration order.
+func (r *Registry) Formats() []Format {
+	return append([]Format(nil), r.formats...)
+}
+
+// Names returns the names of every registered format.
+func (r *Registry) Names() []string {
+	names := make([]string, len(r.formats))
+	for i, f := range r.formats {
+		names[i] = f.Name
+	}
+	return names
+}
+
+// WriteList writes a table of the registered formats: name, what each
+// renders, where it comes from and its description.
+func (r *Registry) WriteList(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "FORMAT\tRENDERS\tSOURCE\tDESCRIPTION")
+	for _, f := range r.formats {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", f.Name, f.renders(), f.Source, f.Description)
+	}
+	return tw.Flush()
+}
+
+// renders describes the runs f renders.
+func (f Format) renders() string {
+	switch {
+	case f.Single && f.Recursive:
+		return "score, recursive"
+	case f.Recursive:
+		return "recursive"
+	default:
+		return "score"
+	}
+}
+
+// ScoreFunc adapts a function to a domain.ScoreRenderer.
+type ScoreFunc func(w io.Writer, score *domain.Score) error
+
+// RenderScore calls fn.
+func (fn ScoreFunc) RenderScore(w io.Writer, score *domain.Score) error { return fn(w, score) }
+
+// MonorepoFunc adapts a function to a domain.MonorepoRenderer.
+type MonorepoFunc func(w io.Writer, m *domain.MonorepoScore) error
+
+// RenderMonorepo calls fn.
+func (fn MonorepoFunc) RenderMonorepo(w io.Writer, m *domain.MonorepoScore) error { return fn(w, m) }
@@ -0,0 +1,95 @@
+package render_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/render"
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRegistry returns a registry with two formats registered the way the
+// built-in ones are.
+func newRegistry(t *testing.T) *render.Registry {
+	t.Helper()
+	r := render.NewRegistry()
+	require.NoError(t, r.Register(render.Format{
+		Name:   "json",
+		Source: render.SourceBuiltin,
+		Score: render.ScoreFunc(func(w io.Writer, score *domain.Score) error {
+			return json.NewEncoder(w).Encode(score)
+		}),
+		Monorepo: render.MonorepoFunc(func(w io.Writer, m *domain.MonorepoScore) error {
+			return json.NewEncoder(w).Encode(m)
+		}),
+	}))
+	require.NoError(t, r.Register(render.Format{
+		Name:     "html",
+		Source:   render.SourceBuiltin,
+		Monorepo: render.MonorepoFunc(func(_ io.Writer, _ *domain.MonorepoScore) error { return nil }),
+	}))
+	return r
+}
+
+func TestRegistry_RegisterRejectsDuplicatesAndEmptyFormats(t *testing.T) {
+	r := newRegistry(t)
+	noop := render.ScoreFunc(func(_ io.Writer, _ *domain.Score) error { return nil })
+
+	assert.Error(t, r.Register(render.Format{Name: "json", Score: noop}))
+	assert.Error(t, r.Register(render.Format{Name: "empty"}))
+	assert.Error(t, r.Register(render.Format{Name: "a/b", Score: noop}))
+	require.NoError(t, r.Register(render.Format{Name: "xlsx", Score: noop}))
+
+	f, err := r.Lookup("xlsx")
+	require.NoError(t, err)
+	assert.True(t, f.Single)
+	assert.False(t, f.Recursive)
+}
+
+func TestRegistry_LookupUnknownListsFormats(t *testing.T) {
+	_, err := newRegistry(t).Lookup("yaml")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown format "yaml"`)
+	assert.Contains(t, err.Error(), "json, html")
+}
+
+func TestRegistry_AddExternal(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("external renderer fixtures are shell scripts")
+	}
+	dir := t.TempDir()
+	write := func(name string, mode os.FileMode) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\ncat\n"), mode))
+	}
+	write("openkraft-render-sarif", 0o755)
+	write("openkraft-render-json", 0o755)    // cannot replace a built-in format
+	write("openkraft-render-notexec", 0o644) // not executable
+	write("other-tool", 0o755)
+
+	r := newRegistry(t)
+	r.AddExternal(dir)
+	assert.Equal(t, []string{"json", "html", "sarif"}, r.Names())
+
+	f, err := r.Lookup("sarif")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "openkraft-render-sarif"), f.Source)
+	assert.False(t, f.Recursive)
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Score.RenderScore(&buf, &domain.Score{Overall: 64}))
+	assert.Contains(t, buf.String(), `"overall":64`)
+}
+
+func TestRegistry_WriteList(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, newRegistry(t).WriteList(&buf))
+	assert.Contains(t, buf.String(), "FORMAT")
+	assert.Regexp(t, `html\s+recursive\s+builtin`, buf.String())
+}
@@ -0,0 +1,91 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+const badgeLabel = "openkraft"
+
+// badgeHex maps shields.io named colors, as returned by domain.BadgeColor,
+// to the hex values shields.io renders them with.
+var badgeHex = map[string]string{
+	"brightgreen": "#4c1",
+	"green":       "#97ca00",
+	"yellow":      "#dfb317",
+	"orange":      "#fe7d37",
+	"red":         "#e05d44",
+	"critical":    "#e05d44",
+}
+
+// BadgeEndpoint is the shields.io endpoint badge schema
+// (https://shields.io/badges/endpoint-badge).
+type BadgeEndpoint struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Label         string `json:"label"`
+	Message       string `json:"message"`
+	Color         string `json:"color"`
+}
+
+func badgeMessage(score *domain.Score) string {
+	return fmt.Sprintf("%d/100 %s", score.Overall, score.Grade())
+}
+
+// RenderBadgeEndpoint renders a shields.io endpoint JSON file. Commit or
+// publish it and point https://img.shields.io/endpoint?url=... at it for a
+// live badge.
+func RenderBadgeEndpoint(score *domain.Score) ([]byte, error) {
+	data, err := json.MarshalIndent(BadgeEndpoint{
+		SchemaVersion: 1,
+		Label:         badgeLabel,
+		Message:       badgeMessage(score),
+		Color:         domain.BadgeColor(score.Overall),
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encoding badge endpoint: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// RenderBadgeSVG renders a self-contained flat badge in the shields.io style.
+func RenderBadgeSVG(score *domain.Score) []byte {
+	message := badgeMessage(score)
+	color := badgeHex[domain.BadgeColor(score.Overall)]
+	lw, mw := badgeTextWidth(badgeLabel)+10, badgeTextWidth(message)+10
+	w := lw + mw
+
+	svg := fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="20" role="img" aria-label="%[2]s: %[3]s">
+<title>%[2]s: %[3]s</title>
+<linearGradient id="s" x2="0" y2="100%%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>
+<clipPath id="r"><rect width="%[1]d" height="20" rx="3" fill="#fff"/></clipPath>
+<g clip-path="url(#r)"><rect width="%[4]d" height="20" fill="#555"/><rect x="%[4]d" width="%[5]d" height="20" fill="%[6]s"/><rect width="%[1]d" height="20" fill="url(#s)"/></g>
+<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">
+<text x="%[7]d" y="15" fill="#010101" fill-opacity=".3">%[2]s</text><text x="%[7]d" y="14">%[2]s</text>
+<text x="%[8]d" y="15" fill="#010101" fill-opacity=".3">%[3]s</text><text x="%[8]d" y="14">%[3]s</text>
+</g>
+</svg>
+`, w, html.EscapeString(badgeLabel), html.EscapeString(message), lw, mw, color, lw/2, lw+mw/2)
+	return []byte(svg)
+}
+
+// badgeTextWidth approximates the rendered width in pixels of s in 11px
+// Verdana, which is close enough to size the badge without font metrics.
+func badgeTextWidth(s string) int {
+	w := 0.0
+	for _, r := range s {
+		switch {
+		case r == ' ' || r == '/' || r == 'i' || r == 'l' || r == 'f' || r == 't':
+			w += 4.5
+		case r == '+':
+			w += 9
+		case r >= 'A' && r <= 'Z':
+			w += 8
+		default:
+			w += 7
+		}
+	}
+	return int(w + 0.5)
+}
@@ -0,0 +1,41 @@
+package report_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestRenderBadgeSVG_IsWellFormed(t *testing.T) {
+	svg := report.RenderBadgeSVG(&domain.Score{Overall: 92})
+
+	var doc struct {
+		XMLName xml.Name `xml:"svg"`
+		Title   string   `xml:"title"`
+	}
+	require.NoError(t, xml.Unmarshal(svg, &doc))
+	assert.Equal(t, "openkraft: 92/100 A+", doc.Title)
+	assert.Contains(t, string(svg), `fill="#4c1"`, "brightgreen for scores >= 90")
+}
+
+func TestRenderBadgeSVG_ColorFollowsScore(t *testing.T) {
+	assert.Contains(t, string(report.RenderBadgeSVG(&domain.Score{Overall: 40})), `fill="#e05d44"`)
+}
+
+func TestRenderBadgeEndpoint(t *testing.T) {
+	data, err := report.RenderBadgeEndpoint(&domain.Score{Overall: 75})
+	require.NoError(t, err)
+
+	var ep report.BadgeEndpoint
+	require.NoError(t, json.Unmarshal(data, &ep))
+	assert.Equal(t, 1, ep.SchemaVersion)
+	assert.Equal(t, "openkraft", ep.Label)
+	assert.Equal(t, "75/100 B", ep.Message)
+	assert.Equal(t, "yellow", ep.Color)
+}
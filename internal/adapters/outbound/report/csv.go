@@ -0,0 +1,95 @@
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+var issueColumns = []string{
+	"category", "sub_metric", "severity", "file", "line", "message", "remediation", "owner", "fingerprint",
+}
+
+var fileMetricsColumns = []string{
+	"file", "lines", "functions", "max_cognitive_complexity", "duplicated_lines", "duplication_percent", "issues", "generated",
+}
+
+// RenderIssuesCSV renders one row per issue of the score, under a header
+// row, for triage in a spreadsheet. comma separates the fields: ',' for
+// CSV, '\t' for TSV.
+func RenderIssuesCSV(score *domain.Score, comma rune) ([]byte, error) {
+	rows := [][]string{issueColumns}
+	for _, cat := range score.Categories {
+		for _, iss := range cat.Issues {
+			fp := iss.Fingerprint
+			if fp == "" {
+				fp = domain.IssueFingerprint(iss)
+			}
+			rows = append(rows, []string{
+				cat.Name, iss.SubMetric, iss.Severity, iss.File, lineField(iss.Line),
+				iss.Message, iss.Remediation, iss.Owner, fp,
+			})
+		}
+	}
+	return writeCSV(rows, comma)
+}
+
+// RenderFileMetricsCSV renders one row per file with its size, complexity
+// and duplication figures, under a header row.
+func RenderFileMetricsCSV(files []domain.FileMetrics, comma rune) ([]byte, error) {
+	rows := [][]string{fileMetricsColumns}
+	for _, f := range files {
+		rows = append(rows, []string{
+			f.Path, strconv.Itoa(f.Lines), strconv.Itoa(f.Functions), strconv.Itoa(f.MaxCognitiveComplexity),
+			strconv.Itoa(f.DuplicatedLines), strconv.Itoa(f.DuplicationPercent), strconv.Itoa(f.Issues),
+			strconv.FormatBool(f.Generated),
+		})
+	}
+	return writeCSV(rows, comma)
+}
+
+var functionMetricsColumns = []string{
+	"file", "package", "receiver", "name", "exported", "line", "lines", "params", "returns", "max_nesting",
+	"max_cond_ops", "cognitive_complexity", "string_literal_ratio", "max_case_arms", "avg_case_lines", "generated",
+}
+
+// RenderFunctionMetricsCSV renders one row per function with the metrics
+// the parser measured, under a header row.
+func RenderFunctionMetricsCSV(fns []domain.FunctionMetrics, comma rune) ([]byte, error) {
+	rows := [][]string{functionMetricsColumns}
+	for _, fn := range fns {
+		rows = append(rows, []string{
+			fn.File, fn.Package, fn.Receiver, fn.Name, strconv.FormatBool(fn.Exported),
+			strconv.Itoa(fn.Line), strconv.Itoa(fn.Lines), strconv.Itoa(fn.Params), strconv.Itoa(fn.Returns),
+			strconv.Itoa(fn.MaxNesting), strconv.Itoa(fn.MaxCondOps), strconv.Itoa(fn.CognitiveComplexity),
+			ratioField(fn.StringLiteralRatio), strconv.Itoa(fn.MaxCaseArms), ratioField(fn.AvgCaseLines),
+			strconv.FormatBool(fn.Generated),
+		})
+	}
+	return writeCSV(rows, comma)
+}
+
+// ratioField formats a fractional metric with the digits it needs.
+func ratioField(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// lineField leaves the line empty for issues that are not located on one.
+func lineField(line int) string {
+	if line <= 0 {
+		return ""
+	}
+	return strconv.Itoa(line)
+}
+
+func writeCSV(rows [][]string, comma rune) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Comma = comma
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
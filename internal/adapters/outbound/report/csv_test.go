@@ -0,0 +1,66 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func readCSV(t *testing.T, data []byte, comma rune) [][]string {
+	t.Helper()
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = comma
+	rows, err := r.ReadAll()
+	require.NoError(t, err)
+	return rows
+}
+
+func TestRenderIssuesCSV(t *testing.T) {
+	score := &domain.Score{Categories: []domain.CategoryScore{
+		{Name: "code_health", Issues: []domain.Issue{{
+			Severity: "warning", Category: "code_health", SubMetric: "function_size",
+			File: "svc.go", Line: 12, Message: `function "Run", is 80 lines`, Fingerprint: "abc",
+		}}},
+		{Name: "structure", Issues: []domain.Issue{{Severity: "info", Category: "structure", Message: "no cmd/ directory"}}},
+	}}
+
+	out, err := report.RenderIssuesCSV(score, ',')
+	require.NoError(t, err)
+	rows := readCSV(t, out, ',')
+
+	require.Len(t, rows, 3)
+	assert.Equal(t, "category", rows[0][0])
+	assert.Equal(t, []string{"code_health", "function_size", "warning", "svc.go", "12",
+		`function "Run", is 80 lines`, "", "", "abc"}, rows[1])
+	assert.Empty(t, rows[2][4], "issues without a line leave it empty")
+	assert.NotEmpty(t, rows[2][8], "missing fingerprints are computed")
+}
+
+func TestRenderFileMetricsCSV_TSV(t *testing.T) {
+	out, err := report.RenderFileMetricsCSV([]domain.FileMetrics{
+		{Path: "a.go", Lines: 120, Functions: 4, MaxCognitiveComplexity: 9, DuplicatedLines: 30, DuplicationPercent: 25, Issues: 2},
+	}, '\t')
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "file\tlines\tfunctions")
+	assert.Equal(t, []string{"a.go", "120", "4", "9", "30", "25", "2", "false"}, readCSV(t, out, '\t')[1])
+}
+
+func TestRenderFunctionMetricsCSV(t *testing.T) {
+	out, err := report.RenderFunctionMetricsCSV([]domain.FunctionMetrics{{
+		File: "svc.go", Package: "svc", Receiver: "*Service", Name: "Run", Exported: true, Line: 40, Lines: 30,
+		Params: 2, Returns: 1, MaxNesting: 3, MaxCondOps: 2, CognitiveComplexity: 12, StringLiteralRatio: 0.25,
+		MaxCaseArms: 6, AvgCaseLines: 2.5,
+	}}, ',')
+	require.NoError(t, err)
+	rows := readCSV(t, out, ',')
+	require.Len(t, rows, 2)
+	assert.Len(t, rows[1], len(rows[0]))
+	assert.Equal(t, []string{"svc.go", "svc", "*Service", "Run", "true", "40", "30", "2", "1", "3", "2", "12",
+		"0.25", "6", "2.5", "false"}, rows[1])
+}
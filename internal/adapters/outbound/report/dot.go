@@ -0,0 +1,54 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+// RenderCallGraphDOT renders the call graph in Graphviz DOT, one cluster
+// per package. Cluster labels drop the module path prefix of display and
+// apply its aliases; node IDs keep full import paths.
+func RenderCallGraphDOT(g *scoring.CallGraph, display *domain.PathDisplay) []byte {
+	var b strings.Builder
+	b.WriteString("digraph calls {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, fontname=\"Helvetica\", fontsize=10];\n")
+	if g == nil {
+		b.WriteString("}\n")
+		return []byte(b.String())
+	}
+
+	display = display.Stripped()
+	nodes := g.SortedFunctions()
+	cluster := -1
+	var pkg string
+	for _, node := range nodes {
+		if cluster < 0 || node.Package != pkg {
+			if cluster >= 0 {
+				b.WriteString("  }\n")
+			}
+			cluster++
+			pkg = node.Package
+			fmt.Fprintf(&b, "  subgraph cluster_%d {\n    label=%q;\n", cluster, display.Package(pkg))
+		}
+		label := node.Name
+		if node.Receiver != "" {
+			label = node.Receiver + "." + node.Name
+		}
+		fmt.Fprintf(&b, "    %q [label=%q];\n", node.ID, label)
+	}
+	if cluster >= 0 {
+		b.WriteString("  }\n")
+	}
+
+	for _, node := range nodes {
+		for _, callee := range node.Calls {
+			fmt.Fprintf(&b, "  %q -> %q;\n", node.ID, callee)
+		}
+	}
+	b.WriteString("}\n")
+	return []byte(b.String())
+}
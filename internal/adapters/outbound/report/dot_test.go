@@ -0,0 +1,26 @@
+package report_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderCallGraphDOT(t *testing.T) {
+	mod := "example.com/app"
+	g := scoring.BuildCallGraph(mod, map[string]*domain.AnalyzedFile{
+		"main.go": {Path: "main.go", Functions: []domain.Function{
+			{Name: "main", Calls: []domain.Call{{Name: "Open", Package: mod + "/store"}}},
+		}},
+		"store/store.go": {Path: "store/store.go", Functions: []domain.Function{{Name: "Open", Exported: true}}},
+	})
+
+	out := string(report.RenderCallGraphDOT(g, domain.NewPathDisplay(mod, nil)))
+	assert.Contains(t, out, "digraph calls {")
+	assert.Contains(t, out, `label="store";`)
+	assert.Contains(t, out, `"example.com/app/store.Open" [label="Open"];`)
+	assert.Contains(t, out, `"example.com/app.main" -> "example.com/app/store.Open";`)
+}
@@ -0,0 +1,187 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Timestamp  string           `xml:"timestamp,attr,omitempty"`
+	Properties []junitProperty  `xml:"properties>property,omitempty"`
+	Suites     []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Cases      []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Line      int           `xml:"line,attr,omitempty"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// RenderJUnit converts a score into a JUnit XML report. Each category becomes
+// a test suite; every issue becomes a failing test case classed under its
+// category and sub-metric, and sub-metrics without issues appear as passing
+// cases so CI panels show the full set of checks. Report metadata becomes
+// properties of the root element.
+func RenderJUnit(score *domain.Score) ([]byte, error) {
+	root := junitTestSuites{Name: "openkraft", Properties: metadataProperties(score.Metadata)}
+	if score.Metadata != nil {
+		root.Timestamp = score.Metadata.Timestamp.UTC().Format(time.RFC3339)
+	}
+
+	for _, cat := range score.Categories {
+		suite := junitTestSuite{
+			Name: cat.Name,
+			Properties: []junitProperty{
+				{Name: "score", Value: fmt.Sprintf("%d", cat.Score)},
+				{Name: "weight", Value: fmt.Sprintf("%.2f", cat.Weight)},
+			},
+		}
+		if cat.Suppressed > 0 {
+			suite.Properties = append(suite.Properties, junitProperty{Name: "suppressed_issues", Value: fmt.Sprintf("%d", cat.Suppressed)})
+		}
+
+		bySubMetric := make(map[string][]domain.Issue)
+		for _, issue := range cat.Issues {
+			bySubMetric[issue.SubMetric] = append(bySubMetric[issue.SubMetric], issue)
+		}
+
+		for _, sm := range cat.SubMetrics {
+			if sm.Skipped {
+				continue
+			}
+			if len(bySubMetric[sm.Name]) == 0 {
+				suite.Cases = append(suite.Cases, junitTestCase{
+					Name:      sm.Name,
+					ClassName: cat.Name + "." + sm.Name,
+				})
+				continue
+			}
+			suite.Cases = append(suite.Cases, failureCases(cat.Name, sm.Name, bySubMetric[sm.Name])...)
+			delete(bySubMetric, sm.Name)
+		}
+		// Issues not tied to a scored sub-metric (e.g. missing context files).
+		remaining := make([]string, 0, len(bySubMetric))
+		for name := range bySubMetric {
+			remaining = append(remaining, name)
+		}
+		sort.Strings(remaining)
+		for _, name := range remaining {
+			suite.Cases = append(suite.Cases, failureCases(cat.Name, name, bySubMetric[name])...)
+		}
+
+		for _, tc := range suite.Cases {
+			suite.Tests++
+			if tc.Failure != nil {
+				suite.Failures++
+			}
+		}
+		root.Tests += suite.Tests
+		root.Failures += suite.Failures
+		root.Suites = append(root.Suites, suite)
+	}
+
+	out, err := xml.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(out, '\n')...), nil
+}
+
+// metadataProperties flattens report metadata into JUnit properties,
+// omitting empty values.
+func metadataProperties(m *domain.ReportMetadata) []junitProperty {
+	if m == nil {
+		return nil
+	}
+	pairs := [][2]string{
+		{"openkraft.tool_version", m.ToolVersion},
+		{"openkraft.tool_commit", m.ToolCommit},
+		{"openkraft.module_path", m.ModulePath},
+		{"openkraft.commit_sha", m.CommitSHA},
+		{"openkraft.profile_hash", m.ProfileHash},
+		{"openkraft.files.total", fmt.Sprintf("%d", m.Files.Total)},
+		{"openkraft.files.analyzed", fmt.Sprintf("%d", m.Files.Analyzed)},
+	}
+	if p := m.Provenance; p != nil {
+		pairs = append(pairs,
+			[2]string{"openkraft.go_version", p.GoVersion},
+			[2]string{"openkraft.platform", p.Platform},
+			[2]string{"openkraft.source", p.Source},
+		)
+		if p.CI != nil {
+			pairs = append(pairs,
+				[2]string{"openkraft.ci.provider", p.CI.Provider},
+				[2]string{"openkraft.ci.run_url", p.CI.RunURL},
+			)
+		}
+	}
+	var props []junitProperty
+	for _, kv := range pairs {
+		if kv[1] != "" {
+			props = append(props, junitProperty{Name: kv[0], Value: kv[1]})
+		}
+	}
+	return props
+}
+
+func failureCases(category, subMetric string, issues []domain.Issue) []junitTestCase {
+	className := category
+	if subMetric != "" {
+		className += "." + subMetric
+	}
+	cases := make([]junitTestCase, 0, len(issues))
+	for _, issue := range issues {
+		text := fmt.Sprintf("[%s] %s", issue.Severity, issue.Message)
+		if issue.Remediation != "" {
+			text += "\nFix: " + issue.Remediation
+		}
+		name := issue.Message
+		if issue.File != "" {
+			name = issue.File
+			if issue.Line > 0 {
+				name = fmt.Sprintf("%s:%d", issue.File, issue.Line)
+			}
+		}
+		cases = append(cases, junitTestCase{
+			Name:      name,
+			ClassName: className,
+			File:      issue.File,
+			Line:      issue.Line,
+			Failure: &junitFailure{
+				Message: issue.Message,
+				Type:    issue.Severity,
+				Text:    text,
+			},
+		})
+	}
+	return cases
+}
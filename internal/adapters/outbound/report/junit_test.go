@@ -0,0 +1,118 @@
+package report_test
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestRenderJUnit_IssuesBecomeFailures(t *testing.T) {
+	score := &domain.Score{
+		Overall: 70,
+		Categories: []domain.CategoryScore{
+			{
+				Name:   "code_health",
+				Score:  70,
+				Weight: 0.25,
+				SubMetrics: []domain.SubMetric{
+					{Name: "function_size", Score: 10, Points: 20},
+					{Name: "file_size", Score: 20, Points: 20},
+				},
+				Issues: []domain.Issue{
+					{Severity: domain.SeverityError, Category: "code_health", SubMetric: "function_size",
+						File: "a.go", Line: 12, Message: "function Run is 300 lines", Remediation: "split Run into named helpers"},
+					{Severity: domain.SeverityWarning, Category: "code_health", SubMetric: "function_size",
+						File: "b.go", Line: 4, Message: "function Load is 120 lines"},
+				},
+			},
+			{
+				Name:   "context_quality",
+				Score:  50,
+				Weight: 0.15,
+				Issues: []domain.Issue{
+					{Severity: domain.SeverityWarning, Category: "context_quality", Message: "CLAUDE.md not found"},
+				},
+			},
+		},
+	}
+
+	out, err := report.RenderJUnit(score)
+	require.NoError(t, err)
+
+	var parsed struct {
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+		Suites   []struct {
+			Name  string `xml:"name,attr"`
+			Cases []struct {
+				Name      string `xml:"name,attr"`
+				ClassName string `xml:"classname,attr"`
+				Failure   *struct {
+					Type string `xml:"type,attr"`
+					Text string `xml:",chardata"`
+				} `xml:"failure"`
+			} `xml:"testcase"`
+		} `xml:"testsuite"`
+	}
+	require.NoError(t, xml.Unmarshal(out, &parsed))
+
+	assert.Equal(t, 4, parsed.Tests)
+	assert.Equal(t, 3, parsed.Failures)
+	require.Len(t, parsed.Suites, 2)
+
+	health := parsed.Suites[0]
+	assert.Equal(t, "code_health", health.Name)
+	require.Len(t, health.Cases, 3)
+	assert.Equal(t, "a.go:12", health.Cases[0].Name)
+	assert.Equal(t, "code_health.function_size", health.Cases[0].ClassName)
+	require.NotNil(t, health.Cases[0].Failure)
+	assert.Equal(t, "error", health.Cases[0].Failure.Type)
+	assert.Equal(t, "[error] function Run is 300 lines\nFix: split Run into named helpers", health.Cases[0].Failure.Text)
+	assert.Equal(t, "file_size", health.Cases[2].Name)
+	assert.Nil(t, health.Cases[2].Failure)
+
+	ctx := parsed.Suites[1]
+	require.Len(t, ctx.Cases, 1)
+	assert.Equal(t, "context_quality", ctx.Cases[0].ClassName)
+}
+
+func TestRenderJUnit_RecordsSuppressedIssues(t *testing.T) {
+	score := &domain.Score{
+		Categories: []domain.CategoryScore{{Name: "code_health", Score: 70, Weight: 0.25, Suppressed: 42}},
+	}
+
+	out, err := report.RenderJUnit(score)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `<property name="suppressed_issues" value="42"></property>`)
+}
+
+func TestRenderJUnit_RecordsMetadata(t *testing.T) {
+	score := &domain.Score{
+		Categories: []domain.CategoryScore{{Name: "code_health", Score: 70, Weight: 0.25}},
+		Metadata: &domain.ReportMetadata{
+			Tool:        domain.ToolName,
+			ToolVersion: "v1.2.3",
+			CommitSHA:   "abc123",
+			ProfileHash: "sha256:ff",
+			Timestamp:   time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+			Files:       domain.FileCounts{Total: 20, Analyzed: 17},
+			Provenance:  &domain.Provenance{Platform: "linux/amd64"},
+		},
+	}
+
+	out, err := report.RenderJUnit(score)
+	require.NoError(t, err)
+	xml := string(out)
+	assert.Contains(t, xml, `timestamp="2026-01-02T03:04:05Z"`)
+	assert.Contains(t, xml, `<property name="openkraft.tool_version" value="v1.2.3"></property>`)
+	assert.Contains(t, xml, `<property name="openkraft.commit_sha" value="abc123"></property>`)
+	assert.Contains(t, xml, `<property name="openkraft.files.analyzed" value="17"></property>`)
+	assert.Contains(t, xml, `<property name="openkraft.platform" value="linux/amd64"></property>`)
+	assert.NotContains(t, xml, "openkraft.tool_commit", "empty values are omitted")
+}
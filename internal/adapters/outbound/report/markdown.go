@@ -0,0 +1,74 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// RenderComparisonMarkdown renders a comparison as GitHub-flavored Markdown,
+// suitable for pull request comments.
+func RenderComparisonMarkdown(c *domain.Comparison) []byte {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "## openkraft: `%s` → `%s`\n\n", c.Baseline, c.Target)
+	fmt.Fprintf(&b, "**Overall:** %d → %d (%s)\n\n", c.OverallBefore, c.OverallAfter, signed(c.OverallDelta))
+
+	b.WriteString("| Category | Before | After | Δ |\n")
+	b.WriteString("|---|---:|---:|---:|\n")
+	for _, cat := range c.Categories {
+		fmt.Fprintf(&b, "| %s | %d | %d | %s |\n", cat.Name, cat.Before, cat.After, signed(cat.Delta))
+	}
+
+	var changed []string
+	for _, cat := range c.Categories {
+		for _, sm := range cat.SubMetrics {
+			line := fmt.Sprintf("- `%s.%s`: %d/%d → %d/%d", cat.Name, sm.Name, sm.Before, sm.Points, sm.After, sm.Points)
+			if sm.DetailBefore != sm.DetailAfter {
+				line += fmt.Sprintf(" — %s", mdEscape(sm.DetailAfter))
+			}
+			changed = append(changed, line)
+		}
+	}
+	if len(changed) > 0 {
+		b.WriteString("\n### Changed sub-metrics\n\n")
+		b.WriteString(strings.Join(changed, "\n") + "\n")
+	}
+
+	writeMarkdownIssues(&b, "New issues", c.NewIssues)
+	writeMarkdownIssues(&b, "Resolved issues", c.ResolvedIssues)
+
+	return []byte(b.String())
+}
+
+func writeMarkdownIssues(b *strings.Builder, heading string, issues []domain.Issue) {
+	fmt.Fprintf(b, "\n### %s (%d)\n\n", heading, len(issues))
+	if len(issues) == 0 {
+		b.WriteString("_None._\n")
+		return
+	}
+	for _, issue := range issues {
+		loc := ""
+		if issue.File != "" {
+			loc = fmt.Sprintf("`%s", issue.File)
+			if issue.Line > 0 {
+				loc += fmt.Sprintf(":%d", issue.Line)
+			}
+			loc += "` "
+		}
+		fmt.Fprintf(b, "- **%s** %s%s\n", issue.Severity, loc, mdEscape(issue.Message))
+	}
+}
+
+func signed(delta int) string {
+	if delta > 0 {
+		return fmt.Sprintf("+%d", delta)
+	}
+	return fmt.Sprint(delta)
+}
+
+// mdEscape keeps free-form messages from breaking Markdown layout.
+func mdEscape(s string) string {
+	return strings.NewReplacer("|", `\|`, "*", `\*`, "_", `\_`, "\n", " ").Replace(s)
+}
@@ -0,0 +1,33 @@
+package report_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestRenderComparisonMarkdown(t *testing.T) {
+	c := &domain.Comparison{
+		Baseline:      "main",
+		Target:        "feature",
+		OverallBefore: 70,
+		OverallAfter:  74,
+		OverallDelta:  4,
+		Categories: []domain.CategoryDelta{{
+			Name: "code_health", Before: 70, After: 78, Delta: 8,
+			SubMetrics: []domain.SubMetricDelta{{Name: "function_size", Before: 15, After: 18, Points: 20, DetailBefore: "a", DetailAfter: "b"}},
+		}},
+		NewIssues: []domain.Issue{{Severity: "warning", File: "x.go", Line: 3, Message: "a | b"}},
+	}
+
+	md := string(report.RenderComparisonMarkdown(c))
+	assert.Contains(t, md, "## openkraft: `main` → `feature`")
+	assert.Contains(t, md, "**Overall:** 70 → 74 (+4)")
+	assert.Contains(t, md, "| code_health | 70 | 78 | +8 |")
+	assert.Contains(t, md, "`code_health.function_size`: 15/20 → 18/20")
+	assert.Contains(t, md, "- **warning** `x.go:3` a \\| b")
+	assert.Contains(t, md, "### Resolved issues (0)\n\n_None._")
+}
@@ -0,0 +1,98 @@
+package report
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"html/template"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+//go:embed monorepo.html.tmpl
+var monorepoHTML string
+
+var monorepoTemplate = template.Must(template.New("monorepo").
+	Funcs(template.FuncMap{"band": scoreBand}).
+	Parse(monorepoHTML))
+
+type htmlCell struct {
+	Score   int
+	Present bool
+}
+
+type htmlRow struct {
+	Name       string
+	ModulePath string
+	Overall    int
+	Files      int
+	Cells      []htmlCell
+}
+
+type htmlMonorepo struct {
+	Root      string
+	Overall   int
+	Grade     string
+	Projects  []domain.ProjectScore
+	Columns   []string
+	Rows      []htmlRow
+	Aggregate []htmlCell
+	Metadata  *domain.ReportMetadata
+}
+
+// RenderMonorepoHTML renders a standalone HTML page with the aggregate score
+// and a per-project comparison table. Report metadata is shown in the
+// footer and embedded as JSON for tooling.
+func RenderMonorepoHTML(m *domain.MonorepoScore) ([]byte, error) {
+	page := htmlMonorepo{
+		Root:      m.Root,
+		Overall:   m.Overall,
+		Grade:     m.Grade(),
+		Projects:  m.Projects,
+		Columns:   domain.ValidCategories,
+		Aggregate: cellsFor(m.Categories),
+		Metadata:  m.Metadata,
+	}
+	for _, p := range m.Projects {
+		scores := make(map[string]int, len(p.Score.Categories))
+		for _, c := range p.Score.Categories {
+			scores[c.Name] = c.Score
+		}
+		page.Rows = append(page.Rows, htmlRow{
+			Name:       p.Path,
+			ModulePath: p.Score.Display.Alias(p.ModulePath),
+			Overall:    p.Score.Overall,
+			Files:      p.Files,
+			Cells:      cellsFor(scores),
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := monorepoTemplate.Execute(&buf, page); err != nil {
+		return nil, fmt.Errorf("executing html template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func cellsFor(scores map[string]int) []htmlCell {
+	cells := make([]htmlCell, 0, len(domain.ValidCategories))
+	for _, name := range domain.ValidCategories {
+		score, ok := scores[name]
+		cells = append(cells, htmlCell{Score: score, Present: ok})
+	}
+	return cells
+}
+
+// scoreBand maps a score to the CSS class used for its color.
+func scoreBand(score int) string {
+	switch {
+	case score >= 80:
+		return "good"
+	case score >= 60:
+		return "ok"
+	case score >= 40:
+		return "weak"
+	default:
+		return "bad"
+	}
+}
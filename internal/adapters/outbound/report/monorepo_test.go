@@ -0,0 +1,41 @@
+package report_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestRenderMonorepoHTML(t *testing.T) {
+	m := &domain.MonorepoScore{
+		Root:       "/repo",
+		Overall:    72,
+		Categories: map[string]int{"code_health": 85},
+		Projects: []domain.ProjectScore{{
+			Path:       "services/<api>",
+			ModulePath: "example.com/api",
+			Files:      12,
+			Score: &domain.Score{
+				Overall:    72,
+				Categories: []domain.CategoryScore{{Name: "code_health", Score: 85}},
+			},
+		}},
+		Metadata: &domain.ReportMetadata{Tool: domain.ToolName, ToolVersion: "v1.2.3", Files: domain.FileCounts{Total: 14, Analyzed: 12}},
+	}
+
+	out, err := report.RenderMonorepoHTML(m)
+	require.NoError(t, err)
+	html := string(out)
+
+	assert.Contains(t, html, "<!DOCTYPE html>")
+	assert.Contains(t, html, "72 / 100")
+	assert.Contains(t, html, "services/&lt;api&gt;", "project names are escaped")
+	assert.Contains(t, html, `<td class="good">85</td>`)
+	assert.Contains(t, html, `<td class="na">-</td>`, "missing categories render as a dash")
+	assert.Contains(t, html, "Generated by openkraft v1.2.3")
+	assert.Contains(t, html, `"tool_version":"v1.2.3"`, "metadata is embedded as JSON")
+}
@@ -0,0 +1,14 @@
+// Package report renders scores into machine-readable formats consumed by
+// CI systems and other tooling.
+package report
+
+import _ "embed"
+
+//go:embed score.schema.json
+var scoreSchema []byte
+
+// ScoreSchema returns the JSON Schema document describing the versioned
+// `score --json` output.
+func ScoreSchema() []byte {
+	return scoreSchema
+}
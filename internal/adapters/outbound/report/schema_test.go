@@ -0,0 +1,157 @@
+package report_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+type schemaDoc struct {
+	Required   []string `json:"required"`
+	Properties map[string]struct {
+		Pattern string `json:"pattern"`
+	} `json:"properties"`
+}
+
+func TestScoreSchema_IsValidJSON(t *testing.T) {
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(report.ScoreSchema(), &doc))
+	assert.Equal(t, "https://json-schema.org/draft/2020-12/schema", doc["$schema"])
+}
+
+func TestScoreSchema_MatchesCurrentVersion(t *testing.T) {
+	var doc schemaDoc
+	require.NoError(t, json.Unmarshal(report.ScoreSchema(), &doc))
+
+	pattern := doc.Properties["schema_version"].Pattern
+	require.NotEmpty(t, pattern)
+	assert.Regexp(t, regexp.MustCompile(pattern), domain.ScoreSchemaVersion)
+}
+
+func TestScoreSchema_RequiredFieldsPresentInOutput(t *testing.T) {
+	var doc schemaDoc
+	require.NoError(t, json.Unmarshal(report.ScoreSchema(), &doc))
+
+	score := domain.Score{
+		SchemaVersion: domain.ScoreSchemaVersion,
+		Overall:       80,
+		Categories:    []domain.CategoryScore{{Name: "code_health", Score: 80, Weight: 0.25}},
+		Timestamp:     time.Now(),
+	}
+	data, err := json.Marshal(score)
+	require.NoError(t, err)
+
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(data, &out))
+	for _, field := range doc.Required {
+		assert.Contains(t, out, field)
+	}
+	for field := range out {
+		assert.Contains(t, doc.Properties, field, "output field %q is missing from the schema", field)
+	}
+}
+
+func TestScoreSchema_DescribesFullyPopulatedScore(t *testing.T) {
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(report.ScoreSchema(), &schema))
+
+	var score domain.Score
+	populate(reflect.ValueOf(&score).Elem())
+	data, err := json.Marshal(score)
+	require.NoError(t, err)
+
+	var out any
+	require.NoError(t, json.Unmarshal(data, &out))
+	checkAgainstSchema(t, schema, schema, out, "score")
+}
+
+// populate sets every exported field reachable from v to a non-zero value,
+// so that no omitempty field is left out of the JSON output.
+func populate(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString("x")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(0.5)
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		populate(v.Elem())
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		populate(v.Index(0))
+	case reflect.Map:
+		key, elem := reflect.New(v.Type().Key()).Elem(), reflect.New(v.Type().Elem()).Elem()
+		populate(key)
+		populate(elem)
+		v.Set(reflect.MakeMap(v.Type()))
+		v.SetMapIndex(key, elem)
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			v.Set(reflect.ValueOf(time.Now()))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				populate(v.Field(i))
+			}
+		}
+	}
+}
+
+// checkAgainstSchema reports the properties of value that node, a JSON
+// Schema object resolved against root, does not describe, and the required
+// properties value lacks. Objects without listed properties are free-form.
+func checkAgainstSchema(t *testing.T, root, node map[string]any, value any, path string) {
+	t.Helper()
+	if ref, ok := node["$ref"].(string); ok {
+		node = root
+		for _, part := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+			node = node[part].(map[string]any)
+		}
+	}
+	switch value := value.(type) {
+	case map[string]any:
+		required, _ := node["required"].([]any)
+		for _, req := range required {
+			assert.Contains(t, value, req, "%s lacks required property %q", path, req)
+		}
+		if extra, ok := node["additionalProperties"].(map[string]any); ok {
+			for key, v := range value {
+				checkAgainstSchema(t, root, extra, v, path+"."+key)
+			}
+			return
+		}
+		props, ok := node["properties"].(map[string]any)
+		if !ok {
+			return
+		}
+		for key, v := range value {
+			prop, ok := props[key].(map[string]any)
+			if !assert.True(t, ok, "%s.%s is missing from the schema", path, key) {
+				continue
+			}
+			checkAgainstSchema(t, root, prop, v, path+"."+key)
+		}
+	case []any:
+		if items, ok := node["items"].(map[string]any); ok {
+			for _, v := range value {
+				checkAgainstSchema(t, root, items, v, path+"[]")
+			}
+		}
+	}
+}
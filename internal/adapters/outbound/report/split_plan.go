@@ -0,0 +1,91 @@
+package report
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+// RenderSplitPlansMarkdown renders package split plans as a Markdown
+// document, one section per package with the move of every file.
+func RenderSplitPlansMarkdown(plans []scoring.SplitPlan) []byte {
+	var b strings.Builder
+
+	b.WriteString("# Package split plan\n\n")
+	if len(plans) == 0 {
+		b.WriteString("_No package mixes responsibilities._\n")
+		return []byte(b.String())
+	}
+	fmt.Fprintf(&b, "%d %s mix responsibilities. Each plan keeps the first package and moves the other files;\n",
+		len(plans), pluralize(len(plans), "package", "packages"))
+	b.WriteString("moved packages import the one that stays, never the reverse.\n")
+
+	for _, p := range plans {
+		fmt.Fprintf(&b, "\n## `%s`\n\n", p.Package)
+		for _, r := range p.Reasons {
+			fmt.Fprintf(&b, "- %s\n", mdEscape(r))
+		}
+
+		keep := p.Targets[0]
+		fmt.Fprintf(&b, "\n%s%s %s in `%s`: %s.\n", fileCount(len(keep.Files)), roleSuffix(keep.Role),
+			pluralize(len(keep.Files), "stays", "stay"), keep.Package, fileList(keep.Files, maxListedFiles))
+		b.WriteString("\n| File | Role | Move to |\n")
+		b.WriteString("|---|---|---|\n")
+		for _, t := range p.Targets[1:] {
+			for _, f := range t.Files {
+				fmt.Fprintf(&b, "| `%s` | %s | `%s` |\n", path.Base(f), t.Role, t.Package)
+			}
+		}
+
+		b.WriteString("\n**Steps**\n\n")
+		step := 1
+		for _, t := range p.Targets[1:] {
+			fmt.Fprintf(&b, "%d. Create `%s` and move %s into it.\n", step, t.Package, fileList(t.Files, len(t.Files)))
+			step++
+		}
+		if p.FanIn > 0 {
+			fmt.Fprintf(&b, "%d. Update the %d %s of `%s` that use moved declarations.\n",
+				step, p.FanIn, pluralize(p.FanIn, "importer", "importers"), p.Package)
+		}
+		for _, w := range p.Warnings {
+			fmt.Fprintf(&b, "\n> **Warning:** %s\n", mdEscape(w))
+		}
+	}
+	return []byte(b.String())
+}
+
+// maxListedFiles caps the files of the kept package listed by name.
+const maxListedFiles = 8
+
+// fileList lists the base names of up to n files in backticks.
+func fileList(files []string, n int) string {
+	names := make([]string, 0, min(n, len(files)))
+	for _, f := range files[:min(n, len(files))] {
+		names = append(names, "`"+path.Base(f)+"`")
+	}
+	list := strings.Join(names, ", ")
+	if rest := len(files) - len(names); rest > 0 {
+		list += fmt.Sprintf(" and %d more", rest)
+	}
+	return list
+}
+
+func fileCount(n int) string {
+	return fmt.Sprintf("%d %s", n, pluralize(n, "file", "files"))
+}
+
+func roleSuffix(role string) string {
+	if role == "" {
+		return ""
+	}
+	return " (" + role + ")"
+}
+
+func pluralize(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
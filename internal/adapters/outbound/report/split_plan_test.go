@@ -0,0 +1,37 @@
+package report_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/report"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func TestRenderSplitPlansMarkdown(t *testing.T) {
+	plans := []scoring.SplitPlan{{
+		Package: "internal/billing",
+		Reasons: []string{"mixes core types (2 files) with adapters (1 file)"},
+		FanIn:   3,
+		Targets: []scoring.SplitTarget{
+			{Package: "internal/billing", Role: "core", Files: []string{"internal/billing/invoice.go", "internal/billing/payment.go"}},
+			{Package: "internal/billing/http", Role: "adapter", Files: []string{"internal/billing/http_handler.go"}},
+		},
+		Warnings: []string{"invoice.go uses Serve from http_handler.go"},
+	}}
+
+	md := string(report.RenderSplitPlansMarkdown(plans))
+	assert.Contains(t, md, "# Package split plan")
+	assert.Contains(t, md, "## `internal/billing`")
+	assert.Contains(t, md, "- mixes core types (2 files) with adapters (1 file)")
+	assert.Contains(t, md, "2 files (core) stay in `internal/billing`: `invoice.go`, `payment.go`.")
+	assert.Contains(t, md, "| `http_handler.go` | adapter | `internal/billing/http` |")
+	assert.Contains(t, md, "1. Create `internal/billing/http` and move `http_handler.go` into it.")
+	assert.Contains(t, md, "2. Update the 3 importers of `internal/billing`")
+	assert.Contains(t, md, `> **Warning:** invoice.go uses Serve from http\_handler.go`)
+}
+
+func TestRenderSplitPlansMarkdown_None(t *testing.T) {
+	assert.Contains(t, string(report.RenderSplitPlansMarkdown(nil)), "No package mixes responsibilities")
+}
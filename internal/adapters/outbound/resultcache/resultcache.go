@@ -0,0 +1,92 @@
+// Package resultcache stores full analysis results keyed by commit and
+// profile, on a filesystem path or in an S3-compatible bucket, so later CI
+// stages can reuse the analysis of an earlier one.
+package resultcache
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// New opens the result cache at location: an s3://bucket/prefix URL (see
+// NewS3Store) or a directory, given as a path or a file:// URL.
+func New(location string) (domain.ResultCache, error) {
+	switch {
+	case location == "":
+		return nil, fmt.Errorf("empty result cache location")
+	case strings.HasPrefix(location, "s3://"):
+		u, err := url.Parse(location)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", location, err)
+		}
+		return NewS3Store(u, os.Getenv)
+	case strings.HasPrefix(location, "file://"):
+		return NewDirStore(strings.TrimPrefix(location, "file://")), nil
+	case strings.Contains(location, "://"):
+		return nil, fmt.Errorf("unsupported result cache %s: use a directory or an s3:// URL", location)
+	}
+	return NewDirStore(location), nil
+}
+
+// DirStore is a domain.ResultCache keeping one JSON file per key in a
+// directory, which may be shared between CI stages as an artifact or a
+// mounted volume.
+type DirStore struct {
+	dir string
+}
+
+// NewDirStore returns a store in dir, created on the first Put.
+func NewDirStore(dir string) *DirStore {
+	return &DirStore{dir: dir}
+}
+
+// Get reads the score stored under key. Returns (nil, nil) if there is none.
+func (s *DirStore) Get(key string) (*domain.Score, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, key+".json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeScore(data)
+}
+
+// Put stores score under key. The file is written under a temporary name
+// and renamed, so a concurrent Get never reads half a result.
+func (s *DirStore) Put(key string, score *domain.Score) error {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(score)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(s.dir, key+".json"))
+}
+
+func decodeScore(data []byte) (*domain.Score, error) {
+	var score domain.Score
+	if err := json.Unmarshal(data, &score); err != nil {
+		return nil, fmt.Errorf("decoding cached result: %w", err)
+	}
+	return &score, nil
+}
@@ -0,0 +1,118 @@
+package resultcache_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/resultcache"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestDirStore_PutAndGet(t *testing.T) {
+	store := resultcache.NewDirStore(filepath.Join(t.TempDir(), "results"))
+
+	missing, err := store.Get("c0ffee-01")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	require.NoError(t, store.Put("c0ffee-01", &domain.Score{Overall: 82, CommitHash: "c0ffee"}))
+	got, err := store.Get("c0ffee-01")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, 82, got.Overall)
+	assert.Equal(t, "c0ffee", got.CommitHash)
+}
+
+func TestNew_SelectsStoreByLocation(t *testing.T) {
+	dir := t.TempDir()
+	for _, loc := range []string{dir, "file://" + dir} {
+		store, err := resultcache.New(loc)
+		require.NoError(t, err, loc)
+		assert.IsType(t, &resultcache.DirStore{}, store, loc)
+	}
+
+	store, err := resultcache.New("s3://bucket/ci/results?region=eu-west-1")
+	require.NoError(t, err)
+	assert.IsType(t, &resultcache.S3Store{}, store)
+
+	_, err = resultcache.New("gs://bucket")
+	assert.ErrorContains(t, err, "unsupported result cache")
+	_, err = resultcache.New("s3:///prefix")
+	assert.ErrorContains(t, err, "names no bucket")
+}
+
+// fakeBucket is an httptest handler storing objects by path.
+type fakeBucket struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	auth    []string
+}
+
+func (b *fakeBucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.auth = append(b.auth, r.Header.Get("Authorization"))
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		b.objects[r.URL.Path] = data
+	case http.MethodGet:
+		data, ok := b.objects[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(data)
+	}
+}
+
+func TestS3Store_PutAndGet(t *testing.T) {
+	bucket := &fakeBucket{objects: map[string][]byte{}}
+	srv := httptest.NewServer(bucket)
+	defer srv.Close()
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_SESSION_TOKEN", "")
+	store, err := resultcache.New("s3://ci-cache/openkraft/results?endpoint=" + srv.URL)
+	require.NoError(t, err)
+
+	missing, err := store.Get("c0ffee-01")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	require.NoError(t, store.Put("c0ffee-01", &domain.Score{Overall: 82}))
+	assert.Contains(t, bucket.objects, "/ci-cache/openkraft/results/c0ffee-01.json")
+
+	got, err := store.Get("c0ffee-01")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, 82, got.Overall)
+
+	for _, auth := range bucket.auth {
+		assert.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/"), auth)
+		assert.Contains(t, auth, "/us-east-1/s3/aws4_request")
+		assert.Contains(t, auth, "host;x-amz-content-sha256;x-amz-date, Signature=")
+	}
+}
+
+func TestS3Store_UnsignedWithoutCredentials(t *testing.T) {
+	bucket := &fakeBucket{objects: map[string][]byte{}}
+	srv := httptest.NewServer(bucket)
+	defer srv.Close()
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	store, err := resultcache.New("s3://public?endpoint=" + srv.URL)
+	require.NoError(t, err)
+	_, err = store.Get("c0ffee-01")
+	require.NoError(t, err)
+	assert.Equal(t, []string{""}, bucket.auth)
+}
@@ -0,0 +1,197 @@
+package resultcache
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// maxResultBytes caps the size of a result read back from a bucket.
+const maxResultBytes = 64 << 20
+
+// S3Store is a domain.ResultCache keeping one JSON object per key in an
+// S3-compatible bucket (AWS S3, MinIO, R2, ...), addressed path-style and
+// signed with AWS Signature Version 4.
+type S3Store struct {
+	endpoint string // scheme and host, without a trailing slash
+	bucket   string
+	prefix   string // key prefix without surrounding slashes, may be empty
+	region   string
+	access   string
+	secret   string
+	token    string
+	client   *http.Client
+}
+
+// NewS3Store returns a store for a location of the form
+// s3://bucket[/prefix][?endpoint=<url>&region=<region>]. Credentials come
+// from AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN;
+// without them requests are unsigned, which suits public buckets. The
+// region defaults to AWS_REGION, then us-east-1, and the endpoint to
+// AWS_ENDPOINT_URL_S3, AWS_ENDPOINT_URL, then AWS S3 in that region.
+func NewS3Store(u *url.URL, getenv func(string) string) (*S3Store, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("result cache %s names no bucket", u.Redacted())
+	}
+	q := u.Query()
+	region := firstNonEmpty(q.Get("region"), getenv("AWS_REGION"), getenv("AWS_DEFAULT_REGION"), "us-east-1")
+	endpoint := firstNonEmpty(q.Get("endpoint"), getenv("AWS_ENDPOINT_URL_S3"), getenv("AWS_ENDPOINT_URL"),
+		"https://s3."+region+".amazonaws.com")
+	if _, err := url.Parse(endpoint); err != nil {
+		return nil, fmt.Errorf("parsing endpoint %s: %w", endpoint, err)
+	}
+	return &S3Store{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		bucket:   u.Host,
+		prefix:   strings.Trim(u.Path, "/"),
+		region:   region,
+		access:   getenv("AWS_ACCESS_KEY_ID"),
+		secret:   getenv("AWS_SECRET_ACCESS_KEY"),
+		token:    getenv("AWS_SESSION_TOKEN"),
+		client:   &http.Client{Timeout: 60 * time.Second},
+	}, nil
+}
+
+// Get downloads the score stored under key. Returns (nil, nil) if the
+// object does not exist.
+func (s *S3Store) Get(key string) (*domain.Score, error) {
+	resp, err := s.do(http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("getting %s: unexpected status %s", key, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResultBytes))
+	if err != nil {
+		return nil, fmt.Errorf("getting %s: %w", key, err)
+	}
+	return decodeScore(data)
+}
+
+// Put uploads score under key.
+func (s *S3Store) Put(key string, score *domain.Score) error {
+	data, err := json.Marshal(score)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("putting %s: unexpected status %s", key, resp.Status)
+	}
+	return nil
+}
+
+// do sends a signed request for the object holding key.
+func (s *S3Store) do(method, key string, body []byte) (*http.Response, error) {
+	segments := []string{s.bucket}
+	if s.prefix != "" {
+		segments = append(segments, strings.Split(s.prefix, "/")...)
+	}
+	segments = append(segments, key+".json")
+	for i, seg := range segments {
+		segments[i] = url.PathEscape(seg)
+	}
+
+	req, err := http.NewRequest(method, s.endpoint+"/"+strings.Join(segments, "/"), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if method == http.MethodPut {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if s.access != "" {
+		s.sign(req, body)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", strings.ToLower(method), key, err)
+	}
+	return resp, nil
+}
+
+// sign adds AWS Signature Version 4 headers to req for the s3 service.
+func (s *S3Store) sign(req *http.Request, body []byte) {
+	amzDate := time.Now().UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+	if s.token != "" {
+		req.Header.Set("x-amz-security-token", s.token)
+	}
+	headers := map[string]string{"host": req.URL.Host}
+	for name := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(req.Header.Get(name))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method, req.URL.EscapedPath(), req.URL.RawQuery,
+		canonicalHeaders.String(), signedHeaders, payloadHash,
+	}, "\n")
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+	signature := hex.EncodeToString(hmacSHA256(signingKey(s.secret, date, s.region, "s3"), stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.access, scope, signedHeaders, signature))
+}
+
+// signingKey derives the Signature Version 4 key for one day, region and
+// service.
+func signingKey(secret, date, region, service string) []byte {
+	key := hmacSHA256([]byte("AWS4"+secret), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	return hmacSHA256(key, "aws4_request")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,106 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// readGoMod parses the require and replace directives of root's go.mod and
+// the module checksums recorded in go.sum. It returns nil when go.mod
+// cannot be read.
+func readGoMod(root string) *domain.GoModInfo {
+	data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return nil
+	}
+	info := parseGoMod(string(data))
+	if sum, err := os.ReadFile(filepath.Join(root, "go.sum")); err == nil {
+		info.HasGoSum = true
+		info.Sums = parseGoSum(string(sum))
+	}
+	return info
+}
+
+// parseGoMod reads the directives openkraft scores from go.mod content.
+// Both single-line directives and parenthesized blocks are supported.
+func parseGoMod(content string) *domain.GoModInfo {
+	info := &domain.GoModInfo{}
+	block := ""
+	for i, raw := range strings.Split(content, "\n") {
+		line, comment, _ := strings.Cut(raw, "//")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if block != "" {
+			if fields[0] == ")" {
+				block = ""
+				continue
+			}
+			addDirective(info, block, fields, comment, i+1)
+			continue
+		}
+		if len(fields) == 2 && fields[1] == "(" {
+			block = fields[0]
+			continue
+		}
+		addDirective(info, fields[0], fields[1:], comment, i+1)
+	}
+	return info
+}
+
+func addDirective(info *domain.GoModInfo, verb string, args []string, comment string, line int) {
+	switch verb {
+	case "go":
+		if len(args) == 1 {
+			info.GoVersion = args[0]
+		}
+	case "require":
+		if len(args) == 2 {
+			info.Requires = append(info.Requires, domain.ModuleRequire{
+				Path:     unquote(args[0]),
+				Version:  args[1],
+				Indirect: strings.TrimSpace(comment) == "indirect" || strings.HasPrefix(strings.TrimSpace(comment), "indirect;"),
+				Line:     line,
+			})
+		}
+	case "replace":
+		arrow := -1
+		for j, a := range args {
+			if a == "=>" {
+				arrow = j
+			}
+		}
+		if arrow <= 0 || arrow == len(args)-1 {
+			return
+		}
+		target := unquote(args[arrow+1])
+		info.Replaces = append(info.Replaces, domain.ModuleReplace{
+			Old:   unquote(args[0]),
+			New:   target,
+			Local: strings.HasPrefix(target, "./") || strings.HasPrefix(target, "../") || filepath.IsAbs(target),
+			Line:  line,
+		})
+	}
+}
+
+// parseGoSum returns the "path@version" keys that have a go.mod checksum.
+func parseGoSum(content string) map[string]bool {
+	sums := make(map[string]bool)
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+		version := strings.TrimSuffix(fields[1], "/go.mod")
+		sums[fields[0]+"@"+version] = true
+	}
+	return sums
+}
+
+func unquote(s string) string {
+	return strings.Trim(s, "\"`")
+}
@@ -1,6 +1,8 @@
 package scanner
 
 import (
+	"io/fs"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"strings"
@@ -24,10 +26,44 @@ var skipDirs = map[string]bool{
 }
 
 // FileScanner implements domain.ProjectScanner by walking the filesystem.
-type FileScanner struct{}
+// Directories holding their own go.mod and git submodule checkouts are
+// skipped unless an option includes them. Symlinked directories leading
+// outside the project are skipped unless an option follows them, and then
+// followed once; links leading back into the scanned tree are always
+// skipped, so links cannot cause cycles. Every such decision is recorded in
+// ScanResult.Decisions.
+type FileScanner struct {
+	includeNestedModules bool
+	includeSubmodules    bool
+	followExternalLinks  bool
+}
+
+// Option configures a FileScanner.
+type Option func(*FileScanner)
+
+// WithNestedModules scans directories below the project root that hold
+// their own go.mod as part of the project.
+func WithNestedModules() Option {
+	return func(s *FileScanner) { s.includeNestedModules = true }
+}
+
+// WithSubmodules scans git submodule checkouts as part of the project.
+func WithSubmodules() Option {
+	return func(s *FileScanner) { s.includeSubmodules = true }
+}
+
+// WithExternalSymlinks follows symlinked directories that lead outside the
+// project root and scans their files as part of the project.
+func WithExternalSymlinks() Option {
+	return func(s *FileScanner) { s.followExternalLinks = true }
+}
 
-func New() *FileScanner {
-	return &FileScanner{}
+func New(opts ...Option) *FileScanner {
+	s := &FileScanner{}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *FileScanner) Scan(projectPath string, excludePaths ...string) (*domain.ScanResult, error) {
@@ -35,81 +71,256 @@ func (s *FileScanner) Scan(projectPath string, excludePaths ...string) (*domain.
 	if err != nil {
 		return nil, err
 	}
-
-	// Merge extra excludes with built-in skip dirs.
-	extraSkip := make(map[string]bool, len(excludePaths))
-	for _, p := range excludePaths {
-		extraSkip[strings.TrimSuffix(p, "/")] = true
+	realRoot, err := filepath.EvalSymlinks(absPath)
+	if err != nil {
+		return nil, err
 	}
 
 	result := &domain.ScanResult{
 		RootPath: absPath,
 	}
+	w := &walk{
+		scanner:    s,
+		extraSkip:  excludeSet(excludePaths),
+		isModule:   isFile(filepath.Join(absPath, "go.mod")),
+		walkedDirs: []string{realRoot},
+		result:     result,
+	}
+	err = w.dir(absPath, ".")
 
-	err = filepath.WalkDir(absPath, func(path string, d os.DirEntry, err error) error {
+	if err == nil {
+		populateFileMetadata(absPath, result)
+		if result.HasGoMod {
+			result.GoMod = readGoMod(absPath)
+		}
+	}
+
+	return result, err
+}
+
+// walk is the state of one Scan.
+type walk struct {
+	scanner    *FileScanner
+	extraSkip  map[string]bool
+	isModule   bool     // the root holds a go.mod, so go.mod below it marks a nested module
+	walkedDirs []string // real paths of the root and every followed symlink target
+	result     *domain.ScanResult
+}
+
+// dir walks the directory at path, recording its files under relDir.
+func (w *walk) dir(path, relDir string) error {
+	return filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
+		r, _ := filepath.Rel(path, p)
+		relPath := filepath.Join(relDir, r)
 
 		if d.IsDir() {
-			name := d.Name()
-			// Skip known non-source directories, user-excluded paths, and
-			// underscore-prefixed dirs (Go convention: ignored by toolchain).
-			if skipDirs[name] || extraSkip[name] ||
-				(strings.HasPrefix(name, "_") && name != "_internal") {
+			if w.skip(relPath, d.Name()) || !w.enter(p, relPath) {
 				return filepath.SkipDir
 			}
-			// Skip worktree directories nested under other dirs (e.g. .claude/worktrees)
-			if d.Name() == "worktrees" {
-				relDir, _ := filepath.Rel(absPath, path)
-				if strings.HasPrefix(relDir, ".claude"+string(filepath.Separator)) {
-					return filepath.SkipDir
-				}
-			}
 			return nil
 		}
-
-		relPath, _ := filepath.Rel(absPath, path)
-		result.AllFiles = append(result.AllFiles, relPath)
-
-		// Detect root-level marker files (only in project root, not subdirs)
-		dir := filepath.Dir(relPath)
-		isRoot := dir == "."
-
-		switch {
-		case d.Name() == "go.mod" && isRoot:
-			result.HasGoMod = true
-			result.Language = "go"
-			result.ModulePath = readModulePath(filepath.Join(absPath, "go.mod"))
-		case d.Name() == "CLAUDE.md" && isRoot:
-			result.HasClaudeMD = true
-		case d.Name() == ".cursorrules" && isRoot:
-			result.HasCursorRules = true
-		case d.Name() == "AGENTS.md" && isRoot:
-			result.HasAgentsMD = true
-		case d.Name() == ".github" || strings.HasPrefix(relPath, ".github/"):
-			result.HasCIConfig = true
-		}
-
-		if strings.HasSuffix(d.Name(), ".go") {
-			result.GoFiles = append(result.GoFiles, relPath)
-			if strings.HasSuffix(d.Name(), "_test.go") {
-				result.TestFiles = append(result.TestFiles, relPath)
+		if d.Type()&fs.ModeSymlink != 0 {
+			if target, ok := symlinkedDir(p); ok {
+				return w.symlink(target, relPath, d.Name())
 			}
 		}
+		w.file(relPath, d.Name())
+		return nil
+	})
+}
+
+// enter reports whether the directory at path should be walked, recording
+// a decision when it is a git submodule or a nested Go module.
+func (w *walk) enter(path, relPath string) bool {
+	if relPath == "." {
+		return true
+	}
+	switch {
+	case isFile(filepath.Join(path, ".git")):
+		return w.decide(relPath, domain.ScanSubmodule, w.scanner.includeSubmodules, "git submodule checkout")
+	case w.isModule && isFile(filepath.Join(path, "go.mod")):
+		return w.decide(relPath, domain.ScanNestedModule, w.scanner.includeNestedModules, "holds its own go.mod")
+	}
+	return true
+}
 
-		if d.Name() == ".openkraft" || strings.HasPrefix(relPath, ".openkraft/") {
-			result.HasOpenKraftDir = true
+// symlink follows the symlinked directory at relPath, whose real path is
+// target, unless it leads into a directory already walked or, without
+// WithExternalSymlinks, outside the project.
+func (w *walk) symlink(target, relPath, name string) error {
+	if w.skip(relPath, name) {
+		return nil
+	}
+	for _, dir := range w.walkedDirs {
+		if within(dir, target) {
+			w.decide(relPath, domain.ScanSymlink, false, "links into already scanned "+filepath.ToSlash(target))
+			return nil
 		}
+	}
+	if !w.scanner.followExternalLinks {
+		w.decide(relPath, domain.ScanSymlink, false, "links outside the project to "+filepath.ToSlash(target))
+		return nil
+	}
+	if !w.enter(target, relPath) {
+		return nil
+	}
+	w.decide(relPath, domain.ScanSymlink, true, "links to "+filepath.ToSlash(target))
+	w.walkedDirs = append(w.walkedDirs, target)
+	return w.dir(target, relPath)
+}
+
+// skip reports whether the directory at relPath is left out of the scan,
+// logging why at debug level.
+func (w *walk) skip(relPath, name string) bool {
+	reason := skipReason(relPath, name, w.extraSkip)
+	if reason == "" {
+		return false
+	}
+	slog.Debug("skipped path", "path", filepath.ToSlash(relPath), "reason", reason)
+	return true
+}
+
+func (w *walk) decide(relPath, kind string, included bool, reason string) bool {
+	slog.Debug("scan decision", "path", filepath.ToSlash(relPath), "kind", kind, "included", included, "reason", reason)
+	w.result.Decisions = append(w.result.Decisions, domain.ScanDecision{
+		Path:     filepath.ToSlash(relPath),
+		Kind:     kind,
+		Included: included,
+		Reason:   reason,
+	})
+	return included
+}
+
+// file records the file at relPath.
+func (w *walk) file(relPath, name string) {
+	result := w.result
+	result.AllFiles = append(result.AllFiles, relPath)
+
+	// Detect root-level marker files (only in project root, not subdirs)
+	dir := filepath.Dir(relPath)
+	isRoot := dir == "."
+
+	switch {
+	case name == "go.mod" && isRoot:
+		result.HasGoMod = true
+		result.Language = "go"
+		result.ModulePath = readModulePath(filepath.Join(result.RootPath, "go.mod"))
+	case name == "CLAUDE.md" && isRoot:
+		result.HasClaudeMD = true
+	case name == ".cursorrules" && isRoot:
+		result.HasCursorRules = true
+	case name == "AGENTS.md" && isRoot:
+		result.HasAgentsMD = true
+	case name == ".github" || strings.HasPrefix(relPath, ".github/"):
+		result.HasCIConfig = true
+	}
 
+	if strings.HasSuffix(name, ".go") {
+		result.GoFiles = append(result.GoFiles, relPath)
+		if strings.HasSuffix(name, "_test.go") {
+			result.TestFiles = append(result.TestFiles, relPath)
+		}
+	}
+
+	if name == ".openkraft" || strings.HasPrefix(relPath, ".openkraft/") {
+		result.HasOpenKraftDir = true
+	}
+}
+
+// symlinkedDir returns the real path of the symlink at path when it leads
+// to a directory.
+func symlinkedDir(path string) (string, bool) {
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", false
+	}
+	info, err := os.Stat(target)
+	if err != nil || !info.IsDir() {
+		return "", false
+	}
+	return target, true
+}
+
+// within reports whether path is dir or lies below it.
+func within(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func isFile(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
+
+// DiscoverProjects walks root and returns the directories, relative to root
+// and slash-separated, that contain a go.mod file. The root itself is
+// reported as ".". Directories are skipped by the same rules as Scan.
+func (s *FileScanner) DiscoverProjects(root string, excludePaths ...string) ([]string, error) {
+	absPath, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	extraSkip := excludeSet(excludePaths)
+
+	var projects []string
+	err = filepath.WalkDir(absPath, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			relDir, _ := filepath.Rel(absPath, path)
+			if skipDir(relDir, d.Name(), extraSkip) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.Name() == "go.mod" {
+			relDir, _ := filepath.Rel(absPath, filepath.Dir(path))
+			projects = append(projects, filepath.ToSlash(relDir))
+		}
 		return nil
 	})
+	return projects, err
+}
 
-	if err == nil {
-		populateFileMetadata(absPath, result)
+// excludeSet normalizes user exclude paths. Entries match either a directory
+// name at any depth or a slash-separated path relative to the project root.
+func excludeSet(excludePaths []string) map[string]bool {
+	set := make(map[string]bool, len(excludePaths))
+	for _, p := range excludePaths {
+		set[strings.TrimSuffix(p, "/")] = true
 	}
+	return set
+}
 
-	return result, err
+// skipDir reports whether the directory at relDir, relative to the
+// project root, should not be walked.
+func skipDir(relDir, name string, extraSkip map[string]bool) bool {
+	return skipReason(relDir, name, extraSkip) != ""
+}
+
+// skipReason explains why the directory at relDir is not walked, or
+// returns "" when it is.
+func skipReason(relDir, name string, extraSkip map[string]bool) string {
+	switch {
+	case relDir == ".":
+		return ""
+	// Skip known non-source directories, user-excluded paths, and
+	// underscore-prefixed dirs (Go convention: ignored by toolchain).
+	case skipDirs[name]:
+		return "non-source directory"
+	case extraSkip[name] || extraSkip[filepath.ToSlash(relDir)]:
+		return "matches exclude_paths"
+	case strings.HasPrefix(name, "_") && name != "_internal":
+		return "underscore prefix, ignored by the go tool"
+	// Skip worktree directories nested under other dirs (e.g. .claude/worktrees)
+	case name == "worktrees" && strings.HasPrefix(relDir, ".claude"+string(filepath.Separator)):
+		return "agent worktrees"
+	}
+	return ""
 }
 
 // readModulePath extracts the module path from a go.mod file.
@@ -129,6 +340,8 @@ func readModulePath(goModPath string) string {
 
 const maxReadSize = 16 * 1024 // 16KB cap for file reads.
 
+const maxDocReadSize = 256 * 1024 // 256KB cap for project documentation reads.
+
 // populateFileMetadata reads sizes and content for detected context files.
 func populateFileMetadata(rootPath string, result *domain.ScanResult) {
 	readSize := func(name string) (int, []byte) {
@@ -167,6 +380,24 @@ func populateFileMetadata(rootPath string, result *domain.ScanResult) {
 		}
 	}
 
+	// README.md, ARCHITECTURE.md and docs/, for the module references in them.
+	for _, f := range result.AllFiles {
+		if !isProjectDoc(f) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(rootPath, filepath.FromSlash(f)))
+		if err != nil {
+			continue
+		}
+		if len(data) > maxDocReadSize {
+			data = data[:maxDocReadSize]
+		}
+		if result.Docs == nil {
+			result.Docs = make(map[string]string)
+		}
+		result.Docs[f] = string(data)
+	}
+
 	// copilot-instructions.md
 	for _, f := range result.AllFiles {
 		lower := strings.ToLower(f)
@@ -176,3 +407,14 @@ func populateFileMetadata(rootPath string, result *domain.ScanResult) {
 		}
 	}
 }
+
+// isProjectDoc reports whether f is documentation that describes the
+// project's structure: README.md or ARCHITECTURE.md at the root,
+// or any Markdown file under docs/ or doc/.
+func isProjectDoc(f string) bool {
+	lower := strings.ToLower(f)
+	if lower == "readme.md" || lower == "architecture.md" {
+		return true
+	}
+	return strings.HasSuffix(lower, ".md") && (strings.HasPrefix(lower, "docs/") || strings.HasPrefix(lower, "doc/"))
+}
@@ -1,11 +1,14 @@
 package scanner_test
 
 import (
+	"bytes"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -83,6 +86,109 @@ func TestFileScanner_CustomExcludePaths(t *testing.T) {
 	}
 }
 
+func TestFileScanner_ExcludeRelativePath(t *testing.T) {
+	s := scanner.New()
+	result, err := s.Scan(fixtureDir, "internal/payments")
+	require.NoError(t, err)
+
+	for _, f := range result.GoFiles {
+		assert.NotContains(t, f, "internal/payments/", "should exclude the relative path: %s", f)
+	}
+	assert.NotEmpty(t, result.GoFiles)
+}
+
+func writeModule(t *testing.T, dir, module string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module "+module+"\n"), 0644))
+}
+
+func TestFileScanner_DiscoverProjects(t *testing.T) {
+	root := t.TempDir()
+	writeModule(t, root, "example.com/root")
+	writeModule(t, filepath.Join(root, "services", "api"), "example.com/api")
+	writeModule(t, filepath.Join(root, "tools"), "example.com/tools")
+	writeModule(t, filepath.Join(root, "vendor", "dep"), "example.com/dep")
+	writeModule(t, filepath.Join(root, "testdata", "fixture"), "example.com/fixture")
+
+	projects, err := scanner.New().DiscoverProjects(root)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{".", "services/api", "tools"}, projects)
+
+	projects, err = scanner.New().DiscoverProjects(root, "tools")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{".", "services/api"}, projects)
+}
+
+func writeGoFile(t *testing.T, path string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte("package x\n"), 0644))
+}
+
+func TestFileScanner_NestedModulesAndSubmodules(t *testing.T) {
+	root := t.TempDir()
+	writeModule(t, root, "example.com/root")
+	writeGoFile(t, filepath.Join(root, "main.go"))
+	writeModule(t, filepath.Join(root, "tools"), "example.com/tools")
+	writeGoFile(t, filepath.Join(root, "tools", "gen.go"))
+	writeGoFile(t, filepath.Join(root, "third_party", "lib", "lib.go"))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "third_party", "lib", ".git"), []byte("gitdir: ../../.git/modules/lib\n"), 0644))
+
+	result, err := scanner.New().Scan(root)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main.go"}, result.GoFiles)
+	assert.Equal(t, []domain.ScanDecision{
+		{Path: "third_party/lib", Kind: domain.ScanSubmodule, Reason: "git submodule checkout"},
+		{Path: "tools", Kind: domain.ScanNestedModule, Reason: "holds its own go.mod"},
+	}, result.Decisions)
+
+	result, err = scanner.New(scanner.WithNestedModules(), scanner.WithSubmodules()).Scan(root)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"main.go", "tools/gen.go", "third_party/lib/lib.go"}, result.GoFiles)
+	assert.Equal(t, "example.com/root", result.ModulePath)
+	for _, d := range result.Decisions {
+		assert.True(t, d.Included, d.Path)
+	}
+}
+
+func TestFileScanner_Symlinks(t *testing.T) {
+	root := t.TempDir()
+	shared := t.TempDir()
+	writeModule(t, root, "example.com/root")
+	writeGoFile(t, filepath.Join(root, "pkg", "a.go"))
+	writeGoFile(t, filepath.Join(shared, "b.go"))
+	require.NoError(t, os.Symlink(filepath.Join(root, "pkg"), filepath.Join(root, "pkg", "loop")))
+	require.NoError(t, os.Symlink(shared, filepath.Join(root, "shared")))
+	require.NoError(t, os.Symlink(shared, filepath.Join(shared, "self")))
+
+	result, err := scanner.New().Scan(root)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pkg/a.go"}, result.GoFiles, "links outside the project are not followed by default")
+	assert.Equal(t, []domain.ScanDecision{
+		{Path: "pkg/loop", Kind: domain.ScanSymlink, Reason: "links into already scanned " + filepath.ToSlash(filepath.Join(realPath(t, root), "pkg"))},
+		{Path: "shared", Kind: domain.ScanSymlink, Reason: "links outside the project to " + filepath.ToSlash(realPath(t, shared))},
+	}, result.Decisions)
+
+	result, err = scanner.New(scanner.WithExternalSymlinks()).Scan(root)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"pkg/a.go", "shared/b.go"}, result.GoFiles, "symlinked dirs are followed once, never in a cycle")
+	require.Len(t, result.Decisions, 3)
+	assert.Equal(t, "pkg/loop", result.Decisions[0].Path)
+	assert.False(t, result.Decisions[0].Included)
+	assert.Equal(t, "shared", result.Decisions[1].Path)
+	assert.True(t, result.Decisions[1].Included)
+	assert.Equal(t, "shared/self", result.Decisions[2].Path)
+	assert.False(t, result.Decisions[2].Included)
+}
+
+func realPath(t *testing.T, path string) string {
+	t.Helper()
+	real, err := filepath.EvalSymlinks(path)
+	require.NoError(t, err)
+	return real
+}
+
 func TestFileScanner_PopulatesFileMetadata(t *testing.T) {
 	s := scanner.New()
 	result, err := s.Scan(fixtureDir)
@@ -94,6 +200,20 @@ func TestFileScanner_PopulatesFileMetadata(t *testing.T) {
 	assert.Greater(t, result.CursorRulesSize, 0, "should read .cursorrules size")
 }
 
+func TestFileScanner_ReadsProjectDocs(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "docs", "adr"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# shop\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "docs", "adr", "0001-billing.md"), []byte("billing\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "CHANGELOG.md"), []byte("v1\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0644))
+
+	result, err := scanner.New().Scan(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"README.md": "# shop\n", "docs/adr/0001-billing.md": "billing\n"}, result.Docs)
+}
+
 func TestFileScanner_ReadsModulePath(t *testing.T) {
 	dir := t.TempDir()
 	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module github.com/example/myproject\n\ngo 1.21\n"), 0644))
@@ -135,3 +255,62 @@ func TestFileScanner_AIContextOnlyFromRoot(t *testing.T) {
 	assert.False(t, result.HasClaudeMD, "should not detect CLAUDE.md from subdirectory")
 	assert.False(t, result.HasCursorRules, "should not detect .cursorrules from subdirectory")
 }
+
+func TestFileScanner_ReadsGoModDependencies(t *testing.T) {
+	dir := t.TempDir()
+	gomod := `module example.com/deps
+
+go 1.24
+
+require github.com/spf13/cobra v1.8.0
+
+require (
+	example.com/pinned v0.0.0-20240101120000-abcdef123456
+	github.com/pkg/errors v0.9.1 // indirect
+)
+
+replace example.com/pinned => ../pinned
+`
+	gosum := "github.com/spf13/cobra v1.8.0 h1:abc=\ngithub.com/spf13/cobra v1.8.0/go.mod h1:def=\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(gomod), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.sum"), []byte(gosum), 0644))
+
+	s := scanner.New()
+	result, err := s.Scan(dir)
+	require.NoError(t, err)
+	require.NotNil(t, result.GoMod)
+
+	mod := result.GoMod
+	assert.Equal(t, "1.24", mod.GoVersion)
+	assert.Equal(t, []domain.ModuleRequire{
+		{Path: "github.com/spf13/cobra", Version: "v1.8.0", Line: 5},
+		{Path: "example.com/pinned", Version: "v0.0.0-20240101120000-abcdef123456", Line: 8},
+		{Path: "github.com/pkg/errors", Version: "v0.9.1", Indirect: true, Line: 9},
+	}, mod.Requires)
+	assert.Equal(t, []domain.ModuleReplace{
+		{Old: "example.com/pinned", New: "../pinned", Local: true, Line: 12},
+	}, mod.Replaces)
+	assert.True(t, mod.HasGoSum)
+	assert.True(t, mod.Sums["github.com/spf13/cobra@v1.8.0"])
+	assert.Len(t, mod.DirectRequires(), 2)
+}
+
+func TestFileScanner_LogsSkippedPathsWithReasons(t *testing.T) {
+	dir := t.TempDir()
+	for _, sub := range []string{"vendor/dep", "_scratch", "gen", "internal/app"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, sub), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, sub, "x.go"), []byte("package x\n"), 0644))
+	}
+	var logged bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logged, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	result, err := scanner.New().Scan(dir, "gen")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{filepath.Join("internal", "app", "x.go")}, result.GoFiles)
+	assert.Contains(t, logged.String(), `path=vendor reason="non-source directory"`)
+	assert.Contains(t, logged.String(), `path=_scratch reason="underscore prefix, ignored by the go tool"`)
+	assert.Contains(t, logged.String(), `path=gen reason="matches exclude_paths"`)
+}
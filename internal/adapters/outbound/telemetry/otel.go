@@ -0,0 +1,113 @@
+// Package telemetry exports the phases of a run as OpenTelemetry spans over
+// OTLP/HTTP, configured by the standard OTEL_* environment variables.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// shutdownTimeout bounds how long exiting waits for spans to be flushed.
+const shutdownTimeout = 5 * time.Second
+
+// TracingEnabled reports whether the environment configures an OTLP
+// endpoint for traces and does not disable the SDK or the traces exporter.
+func TracingEnabled(getenv func(string) string) bool {
+	if strings.EqualFold(getenv("OTEL_SDK_DISABLED"), "true") || getenv("OTEL_TRACES_EXPORTER") == "none" {
+		return false
+	}
+	return getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// Tracer is a domain.Tracer recording every phase as a child of one root
+// span for the command, batched and exported when the command ends.
+type Tracer struct {
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer
+	root     trace.Span
+	ctx      context.Context // carries root
+}
+
+// NewTracer opens the root span named command, exporting through the
+// OTLP/HTTP exporter the environment configures. The service is named
+// openkraft unless OTEL_SERVICE_NAME or OTEL_RESOURCE_ATTRIBUTES say
+// otherwise.
+func NewTracer(command, version string) (*Tracer, error) {
+	exporter, err := otlptracehttp.New(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP exporter: %w", err)
+	}
+	return start(sdktrace.NewBatchSpanProcessor(exporter), command, version)
+}
+
+func start(processor sdktrace.SpanProcessor, command, version string) (*Tracer, error) {
+	res, err := resource.Merge(
+		resource.NewSchemaless(attribute.String("service.name", "openkraft"), attribute.String("service.version", version)),
+		resource.Environment(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("building telemetry resource: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(processor), sdktrace.WithResource(res))
+	t := &Tracer{provider: provider, tracer: provider.Tracer("github.com/abdidvp/openkraft")}
+	t.ctx, t.root = t.tracer.Start(context.Background(), command)
+	return t, nil
+}
+
+// RecordSpan records a completed phase under the root span.
+func (t *Tracer) RecordSpan(name string, start, end time.Time, attrs ...any) {
+	_, span := t.tracer.Start(t.ctx, name, trace.WithTimestamp(start), trace.WithAttributes(attributes(attrs)...))
+	span.End(trace.WithTimestamp(end))
+}
+
+// Shutdown ends the root span, marking it failed when the command returned
+// err, and flushes every span to the exporter.
+func (t *Tracer) Shutdown(err error) error {
+	if err != nil {
+		t.root.RecordError(err)
+		t.root.SetStatus(codes.Error, err.Error())
+	}
+	t.root.End()
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := t.provider.Shutdown(ctx); err != nil {
+		return fmt.Errorf("flushing telemetry: %w", err)
+	}
+	return nil
+}
+
+// attributes converts alternating keys and values to span attributes,
+// prefixing keys with openkraft. A trailing key without a value is
+// dropped.
+func attributes(kvs []any) []attribute.KeyValue {
+	var out []attribute.KeyValue
+	for i := 0; i+1 < len(kvs); i += 2 {
+		key := "openkraft." + fmt.Sprint(kvs[i])
+		switch v := kvs[i+1].(type) {
+		case string:
+			out = append(out, attribute.String(key, v))
+		case int:
+			out = append(out, attribute.Int(key, v))
+		case int64:
+			out = append(out, attribute.Int64(key, v))
+		case float64:
+			out = append(out, attribute.Float64(key, v))
+		case bool:
+			out = append(out, attribute.Bool(key, v))
+		case time.Duration:
+			out = append(out, attribute.Int64(key+"_ms", v.Milliseconds()))
+		default:
+			out = append(out, attribute.String(key, fmt.Sprint(v)))
+		}
+	}
+	return out
+}
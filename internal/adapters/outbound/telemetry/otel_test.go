@@ -0,0 +1,52 @@
+package telemetry
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer_RecordsPhasesUnderRootSpan(t *testing.T) {
+	rec := tracetest.NewSpanRecorder()
+	tr, err := start(rec, "openkraft score", "v1.0.0")
+	require.NoError(t, err)
+
+	begin := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tr.RecordSpan("parse", begin, begin.Add(2*time.Second), "parsed", 12, "project", "/src", "duration", time.Second, "dangling")
+	require.NoError(t, tr.Shutdown(errors.New("score 40 is below minimum 60")))
+
+	spans := rec.Ended()
+	require.Len(t, spans, 2)
+	parse, root := spans[0], spans[1]
+	assert.Equal(t, "parse", parse.Name())
+	assert.Equal(t, root.SpanContext().SpanID(), parse.Parent().SpanID())
+	assert.Equal(t, begin, parse.StartTime())
+	assert.Equal(t, 2*time.Second, parse.EndTime().Sub(parse.StartTime()))
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.Int("openkraft.parsed", 12),
+		attribute.String("openkraft.project", "/src"),
+		attribute.Int64("openkraft.duration_ms", 1000),
+	}, parse.Attributes())
+
+	assert.Equal(t, "openkraft score", root.Name())
+	assert.Equal(t, codes.Error, root.Status().Code)
+	name, _ := root.Resource().Set().Value("service.name")
+	assert.Equal(t, "openkraft", name.AsString())
+}
+
+func TestTracingEnabled(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(k string) string { return vars[k] }
+	}
+	assert.False(t, TracingEnabled(env(nil)))
+	assert.True(t, TracingEnabled(env(map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://collector:4318"})))
+	assert.True(t, TracingEnabled(env(map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://collector:4318/v1/traces"})))
+	assert.False(t, TracingEnabled(env(map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://collector:4318", "OTEL_SDK_DISABLED": "true"})))
+	assert.False(t, TracingEnabled(env(map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://collector:4318", "OTEL_TRACES_EXPORTER": "none"})))
+}
@@ -0,0 +1,100 @@
+package tracker
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// GitHubTracker is a domain.IssueTracker filing GitHub issues in one
+// repository.
+type GitHubTracker struct {
+	api    string // API base URL without a trailing slash
+	repo   string // owner/name
+	token  string
+	client *http.Client
+}
+
+// NewGitHubTracker returns a tracker for repo, given as owner/name. The
+// token comes from GITHUB_TOKEN, then GH_TOKEN, and the API from
+// GITHUB_API_URL, which GitHub Actions sets on GitHub Enterprise Server,
+// then api.github.com.
+func NewGitHubTracker(repo string, getenv func(string) string) (*GitHubTracker, error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("GitHub repository %q must be owner/name", repo)
+	}
+	token := firstNonEmpty(getenv("GITHUB_TOKEN"), getenv("GH_TOKEN"))
+	if token == "" {
+		return nil, fmt.Errorf("filing GitHub issues needs a token in GITHUB_TOKEN or GH_TOKEN")
+	}
+	return &GitHubTracker{
+		api:    strings.TrimSuffix(firstNonEmpty(getenv("GITHUB_API_URL"), "https://api.github.com"), "/"),
+		repo:   repo,
+		token:  token,
+		client: newClient(),
+	}, nil
+}
+
+type githubIssue struct {
+	HTMLURL string `json:"html_url"`
+	Body    string `json:"body"`
+}
+
+// Filed lists the repository's issues labeled domain.TicketLabel, open or
+// closed, page by page.
+func (g *GitHubTracker) Filed() (map[string]string, error) {
+	filed := map[string]string{}
+	for page := 1; ; page++ {
+		q := url.Values{
+			"labels":   {domain.TicketLabel},
+			"state":    {"all"},
+			"per_page": {fmt.Sprint(pageSize)},
+			"page":     {fmt.Sprint(page)},
+		}
+		req, err := g.request(http.MethodGet, "/issues?"+q.Encode())
+		if err != nil {
+			return nil, err
+		}
+		var issues []githubIssue
+		if err := doJSON(g.client, req, nil, &issues); err != nil {
+			return nil, err
+		}
+		for _, iss := range issues {
+			if fp := domain.TicketFingerprint(iss.Body); fp != "" {
+				filed[fp] = iss.HTMLURL
+			}
+		}
+		if len(issues) < pageSize {
+			return filed, nil
+		}
+	}
+}
+
+// Create opens an issue for t and returns its URL.
+func (g *GitHubTracker) Create(t domain.Ticket) (string, error) {
+	req, err := g.request(http.MethodPost, "/issues")
+	if err != nil {
+		return "", err
+	}
+	payload := map[string]any{"title": t.Title, "body": t.Body, "labels": t.Labels}
+	var created githubIssue
+	if err := doJSON(g.client, req, payload, &created); err != nil {
+		return "", err
+	}
+	return created.HTMLURL, nil
+}
+
+// request builds an authenticated request for a path under the repository.
+func (g *GitHubTracker) request(method, path string) (*http.Request, error) {
+	req, err := http.NewRequest(method, g.api+"/repos/"+g.repo+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+g.token)
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	return req, nil
+}
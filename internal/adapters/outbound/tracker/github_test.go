@@ -0,0 +1,89 @@
+package tracker_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tracker"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func env(vars map[string]string) func(string) string {
+	return func(k string) string { return vars[k] }
+}
+
+func TestGitHubTracker_FiledPagesThroughLabeledIssues(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/acme/shop/issues", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, domain.TicketLabel, r.URL.Query().Get("labels"))
+		assert.Equal(t, "all", r.URL.Query().Get("state"))
+		var issues []map[string]string
+		if r.URL.Query().Get("page") == "1" {
+			for i := range 100 {
+				issues = append(issues, map[string]string{"html_url": fmt.Sprintf("https://gh/%d", i), "body": "filed by hand"})
+			}
+			issues[42]["body"] = "text\n\nopenkraft-fingerprint: 0123456789abcdef\n"
+		} else {
+			issues = append(issues, map[string]string{"html_url": "https://gh/100", "body": "openkraft-fingerprint: fedcba9876543210"})
+		}
+		_ = json.NewEncoder(w).Encode(issues)
+	}))
+	defer srv.Close()
+
+	g, err := tracker.NewGitHubTracker("acme/shop", env(map[string]string{"GITHUB_TOKEN": "secret", "GITHUB_API_URL": srv.URL}))
+	require.NoError(t, err)
+	filed, err := g.Filed()
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"0123456789abcdef": "https://gh/42", "fedcba9876543210": "https://gh/100"}, filed)
+}
+
+func TestGitHubTracker_CreatePostsTicket(t *testing.T) {
+	var got map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"html_url": "https://gh/7"}`))
+	}))
+	defer srv.Close()
+
+	g, err := tracker.NewGitHubTracker("acme/shop", env(map[string]string{"GH_TOKEN": "secret", "GITHUB_API_URL": srv.URL + "/"}))
+	require.NoError(t, err)
+	url, err := g.Create(domain.Ticket{Title: "a.go: too long", Body: "body", Labels: []string{domain.TicketLabel}})
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://gh/7", url)
+	assert.Equal(t, "a.go: too long", got["title"])
+	assert.Equal(t, []any{domain.TicketLabel}, got["labels"])
+}
+
+func TestGitHubTracker_ReportsRejections(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message": "Bad credentials"}`, http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	g, err := tracker.NewGitHubTracker("acme/shop", env(map[string]string{"GITHUB_TOKEN": "bad", "GITHUB_API_URL": srv.URL}))
+	require.NoError(t, err)
+	_, err = g.Filed()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+	assert.Contains(t, err.Error(), "Bad credentials")
+}
+
+func TestNewGitHubTracker_Validates(t *testing.T) {
+	_, err := tracker.NewGitHubTracker("shop", env(map[string]string{"GITHUB_TOKEN": "x"}))
+	assert.ErrorContains(t, err, "owner/name")
+
+	_, err = tracker.NewGitHubTracker("acme/shop", env(nil))
+	assert.ErrorContains(t, err, "GITHUB_TOKEN")
+}
@@ -0,0 +1,149 @@
+package tracker
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// JiraTracker is a domain.IssueTracker filing Jira issues in one project.
+type JiraTracker struct {
+	base      string // site URL without a trailing slash
+	project   string // project key
+	issueType string
+	auth      func(*http.Request)
+	client    *http.Client
+}
+
+// JiraProject locates the Jira project tickets are filed in.
+type JiraProject struct {
+	URL       string // site URL; JIRA_URL when empty
+	Key       string
+	IssueType string // e.g. Bug; Task when empty
+}
+
+// NewJiraTracker returns a tracker for project. Jira Cloud authenticates
+// with JIRA_EMAIL and JIRA_API_TOKEN, Jira Data Center with a personal
+// access token in JIRA_TOKEN.
+func NewJiraTracker(project JiraProject, getenv func(string) string) (*JiraTracker, error) {
+	base := strings.TrimSuffix(firstNonEmpty(project.URL, getenv("JIRA_URL")), "/")
+	if base == "" {
+		return nil, fmt.Errorf("filing Jira issues needs the site URL in --jira-url or JIRA_URL")
+	}
+	if project.Key == "" {
+		return nil, fmt.Errorf("filing Jira issues needs a project key")
+	}
+	t := &JiraTracker{base: base, project: project.Key, issueType: firstNonEmpty(project.IssueType, "Task"), client: newClient()}
+	email, apiToken, pat := getenv("JIRA_EMAIL"), getenv("JIRA_API_TOKEN"), getenv("JIRA_TOKEN")
+	switch {
+	case email != "" && apiToken != "":
+		t.auth = func(req *http.Request) { req.SetBasicAuth(email, apiToken) }
+	case pat != "":
+		t.auth = func(req *http.Request) { req.Header.Set("Authorization", "Bearer "+pat) }
+	default:
+		return nil, fmt.Errorf("filing Jira issues needs JIRA_EMAIL and JIRA_API_TOKEN, or JIRA_TOKEN")
+	}
+	return t, nil
+}
+
+type jiraSearchResult struct {
+	Issues []struct {
+		Key    string `json:"key"`
+		Fields struct {
+			Description string `json:"description"`
+		} `json:"fields"`
+	} `json:"issues"`
+	// Jira Cloud pages with a token, Jira Data Center with an offset.
+	NextPageToken string `json:"nextPageToken"`
+	IsLast        bool   `json:"isLast"`
+	Total         int    `json:"total"`
+}
+
+// Filed searches the project for issues labeled domain.TicketLabel. It uses
+// the token-paged search of Jira Cloud and falls back to the offset-paged
+// search of Jira Data Center where the former does not exist.
+func (j *JiraTracker) Filed() (map[string]string, error) {
+	jql := fmt.Sprintf("project = %q AND labels = %q", j.project, domain.TicketLabel)
+	filed := map[string]string{}
+	err := j.searchCloud(jql, filed)
+	var se *statusError
+	if errors.As(err, &se) && se.code == http.StatusNotFound {
+		err = j.searchDataCenter(jql, filed)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return filed, nil
+}
+
+func (j *JiraTracker) searchCloud(jql string, filed map[string]string) error {
+	token := ""
+	for {
+		payload := map[string]any{"jql": jql, "fields": []string{"description"}, "maxResults": pageSize}
+		if token != "" {
+			payload["nextPageToken"] = token
+		}
+		var res jiraSearchResult
+		if err := j.do(http.MethodPost, "/rest/api/2/search/jql", payload, &res); err != nil {
+			return err
+		}
+		j.collect(res, filed)
+		if res.IsLast || res.NextPageToken == "" {
+			return nil
+		}
+		token = res.NextPageToken
+	}
+}
+
+func (j *JiraTracker) searchDataCenter(jql string, filed map[string]string) error {
+	for start := 0; ; {
+		payload := map[string]any{"jql": jql, "fields": []string{"description"}, "maxResults": pageSize, "startAt": start}
+		var res jiraSearchResult
+		if err := j.do(http.MethodPost, "/rest/api/2/search", payload, &res); err != nil {
+			return err
+		}
+		j.collect(res, filed)
+		start += len(res.Issues)
+		if len(res.Issues) == 0 || start >= res.Total {
+			return nil
+		}
+	}
+}
+
+func (j *JiraTracker) collect(res jiraSearchResult, filed map[string]string) {
+	for _, iss := range res.Issues {
+		if fp := domain.TicketFingerprint(iss.Fields.Description); fp != "" {
+			filed[fp] = j.base + "/browse/" + iss.Key
+		}
+	}
+}
+
+// Create files an issue for t and returns its URL.
+func (j *JiraTracker) Create(t domain.Ticket) (string, error) {
+	payload := map[string]any{"fields": map[string]any{
+		"project":     map[string]string{"key": j.project},
+		"issuetype":   map[string]string{"name": j.issueType},
+		"summary":     t.Title,
+		"description": t.Body,
+		"labels":      t.Labels,
+	}}
+	var created struct {
+		Key string `json:"key"`
+	}
+	if err := j.do(http.MethodPost, "/rest/api/2/issue", payload, &created); err != nil {
+		return "", err
+	}
+	return j.base + "/browse/" + created.Key, nil
+}
+
+func (j *JiraTracker) do(method, path string, payload, out any) error {
+	req, err := http.NewRequest(method, j.base+path, nil)
+	if err != nil {
+		return err
+	}
+	j.auth(req)
+	return doJSON(j.client, req, payload, out)
+}
@@ -0,0 +1,101 @@
+package tracker_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tracker"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func jiraIssue(key, description string) map[string]any {
+	return map[string]any{"key": key, "fields": map[string]any{"description": description}}
+}
+
+func TestJiraTracker_FiledPagesWithCloudTokens(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/search/jql", r.URL.Path)
+		user, pass, _ := r.BasicAuth()
+		assert.Equal(t, "me@acme.io:token", user+":"+pass)
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, `project = "SHOP" AND labels = "openkraft"`, req["jql"])
+		if req["nextPageToken"] == nil {
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"issues":        []any{jiraIssue("SHOP-1", "openkraft-fingerprint: 0123456789abcdef")},
+				"nextPageToken": "p2",
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issues": []any{jiraIssue("SHOP-2", "openkraft-fingerprint: fedcba9876543210")},
+			"isLast": true,
+		})
+	}))
+	defer srv.Close()
+
+	j, err := tracker.NewJiraTracker(tracker.JiraProject{URL: srv.URL, Key: "SHOP", IssueType: "Task"}, env(map[string]string{"JIRA_EMAIL": "me@acme.io", "JIRA_API_TOKEN": "token"}))
+	require.NoError(t, err)
+	filed, err := j.Filed()
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"0123456789abcdef": srv.URL + "/browse/SHOP-1",
+		"fedcba9876543210": srv.URL + "/browse/SHOP-2",
+	}, filed)
+}
+
+func TestJiraTracker_FallsBackToDataCenterSearch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer pat", r.Header.Get("Authorization"))
+		if r.URL.Path != "/rest/api/2/search" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issues": []any{jiraIssue("SHOP-9", "openkraft-fingerprint: 0123456789abcdef"), jiraIssue("SHOP-10", "by hand")},
+			"total":  2,
+		})
+	}))
+	defer srv.Close()
+
+	j, err := tracker.NewJiraTracker(tracker.JiraProject{Key: "SHOP", IssueType: "Task"}, env(map[string]string{"JIRA_URL": srv.URL, "JIRA_TOKEN": "pat"}))
+	require.NoError(t, err)
+	filed, err := j.Filed()
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"0123456789abcdef": srv.URL + "/browse/SHOP-9"}, filed)
+}
+
+func TestJiraTracker_CreateFilesIssue(t *testing.T) {
+	var got struct {
+		Fields map[string]any `json:"fields"`
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"key": "SHOP-3"}`))
+	}))
+	defer srv.Close()
+
+	j, err := tracker.NewJiraTracker(tracker.JiraProject{URL: srv.URL, Key: "SHOP", IssueType: "Bug"}, env(map[string]string{"JIRA_TOKEN": "pat"}))
+	require.NoError(t, err)
+	url, err := j.Create(domain.Ticket{Title: "a.go: too long", Body: "body", Labels: []string{domain.TicketLabel}})
+
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/browse/SHOP-3", url)
+	assert.Equal(t, "a.go: too long", got.Fields["summary"])
+	assert.Equal(t, map[string]any{"name": "Bug"}, got.Fields["issuetype"])
+	assert.Equal(t, map[string]any{"key": "SHOP"}, got.Fields["project"])
+}
+
+func TestNewJiraTracker_NeedsCredentials(t *testing.T) {
+	_, err := tracker.NewJiraTracker(tracker.JiraProject{URL: "https://acme.atlassian.net", Key: "SHOP"}, env(nil))
+	assert.ErrorContains(t, err, "JIRA_API_TOKEN")
+}
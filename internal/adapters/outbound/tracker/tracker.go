@@ -0,0 +1,92 @@
+// Package tracker files openkraft issues as tickets in GitHub Issues or
+// Jira through their REST APIs, and finds the tickets filed earlier by the
+// fingerprint line of their body.
+package tracker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// maxResponseBytes caps the size of a response body read from a tracker.
+const maxResponseBytes = 16 << 20
+
+// maxSnippet caps the part of a rejected response quoted in errors.
+const maxSnippet = 200
+
+// pageSize is the number of tickets requested per page when listing.
+const pageSize = 100
+
+func newClient() *http.Client {
+	return &http.Client{Timeout: 30 * time.Second}
+}
+
+// doJSON sends req with body encoded as JSON, when not nil, and decodes a
+// 2xx response into out. Other statuses are errors carrying the start of
+// the response body, where trackers explain what they rejected.
+func doJSON(client *http.Client, req *http.Request, body, out any) error {
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(data))
+		req.ContentLength = int64(len(data))
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, err)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return &statusError{method: req.Method, path: req.URL.Path, status: resp.Status, code: resp.StatusCode, body: snippet(data)}
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decoding %s %s: %w", req.Method, req.URL.Path, err)
+	}
+	return nil
+}
+
+// statusError is a response with a non-2xx status.
+type statusError struct {
+	method, path, status, body string
+	code                       int
+}
+
+func (e *statusError) Error() string {
+	msg := fmt.Sprintf("%s %s: unexpected status %s", e.method, e.path, e.status)
+	if e.body != "" {
+		msg += ": " + e.body
+	}
+	return msg
+}
+
+func snippet(data []byte) string {
+	s := string(bytes.TrimSpace(data))
+	if len(s) > maxSnippet {
+		s = s[:maxSnippet] + "..."
+	}
+	return s
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
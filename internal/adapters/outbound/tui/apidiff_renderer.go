@@ -0,0 +1,41 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// RenderAPIDiff renders exported API changes, breaking changes first.
+func RenderAPIDiff(d *domain.APIDiff) string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString("  " + titleStyle.Render(fmt.Sprintf("API %s → %s", d.Baseline, d.Target)) + "\n")
+	b.WriteString("  " + separatorLine + "\n")
+
+	breaking := d.Breaking()
+	fmt.Fprintf(&b, "\n  %s %s\n", titleStyle.Render("Breaking changes"), dimStyle.Render(fmt.Sprintf("(%d)", len(breaking))))
+	for _, c := range breaking {
+		s := c.Symbol()
+		fmt.Fprintf(&b, "    %s %s %s\n", failStyle.Render(padRight(c.Kind, 8)), catNameStyle.Render(s.Key()), fileStyle.Render(shortenPath(s.File)))
+		if c.Before != nil {
+			b.WriteString("      " + faintStyle.Render("- "+c.Before.Signature) + "\n")
+		}
+		if c.After != nil {
+			b.WriteString("      " + dimStyle.Render("+ "+c.After.Signature) + "\n")
+		}
+	}
+
+	added := len(d.Changes) - len(breaking)
+	fmt.Fprintf(&b, "\n  %s %s\n", titleStyle.Render("Additions"), dimStyle.Render(fmt.Sprintf("(%d)", added)))
+	for _, c := range d.Changes {
+		if !c.Breaking {
+			fmt.Fprintf(&b, "    %s %s\n", passStyle.Render("+"), dimStyle.Render(c.After.Package+": "+c.After.Signature))
+		}
+	}
+
+	b.WriteString("\n")
+	return b.String()
+}
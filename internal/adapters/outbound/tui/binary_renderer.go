@@ -0,0 +1,35 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// RenderBinarySizes renders the --binary-size report: the stripped size of
+// each main package and its largest packages. Returns "" without binaries.
+func RenderBinarySizes(binaries []domain.BinarySize) string {
+	if len(binaries) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("\n  " + titleStyle.Render("Binary size") + "\n")
+	for _, bin := range binaries {
+		b.WriteString(fmt.Sprintf("  %s %s\n", padRight(bin.Dir, 24), formatBytes(bin.Bytes)))
+		for _, p := range bin.TopPackages {
+			b.WriteString("    " + dimStyle.Render(fmt.Sprintf("%s %s", padRight(p.Path, 40), formatBytes(p.Bytes))) + "\n")
+		}
+	}
+	return b.String()
+}
+
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%d B", n)
+}
@@ -0,0 +1,76 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+// Side-by-side excerpt layout: column width in characters and the most
+// rows shown per clone.
+const (
+	excerptColumn = 58
+	excerptRows   = 40
+)
+
+// RenderClones lists clone clusters with the line ranges of their copies.
+// When excerpt is non-nil, the source lines it returns for the first two
+// fragments of each clone are printed side by side, rows that differ
+// marked with |.
+func RenderClones(clones []scoring.Clone, excerpt func(scoring.CloneFragment) []string) string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "  %s %s\n", titleStyle.Render("Clones"), dimStyle.Render(fmt.Sprintf("(%d)", len(clones))))
+	b.WriteString("  " + separatorLine + "\n")
+	if len(clones) == 0 {
+		b.WriteString("\n  " + passStyle.Render("No clones found.") + "\n\n")
+		return b.String()
+	}
+
+	for i, c := range clones {
+		stats := fmt.Sprintf("%d tokens · %d copies · %.0f%% similar", c.Tokens, len(c.Fragments), c.Similarity*100)
+		fmt.Fprintf(&b, "\n  %3d. %s\n", i+1, dimStyle.Render(stats))
+		for _, f := range c.Fragments {
+			fmt.Fprintf(&b, "       %s\n", fileStyle.Render(fmt.Sprintf("%s:%d-%d", f.File, f.StartLine, f.EndLine)))
+		}
+		if excerpt != nil && len(c.Fragments) >= 2 {
+			renderSideBySide(&b, excerpt(c.Fragments[0]), excerpt(c.Fragments[1]))
+		}
+	}
+
+	b.WriteString("\n")
+	return b.String()
+}
+
+func renderSideBySide(b *strings.Builder, left, right []string) {
+	rows := max(len(left), len(right))
+	b.WriteString("\n")
+	for i := range min(rows, excerptRows) {
+		l, r := excerptLine(left, i), excerptLine(right, i)
+		marker := " "
+		if strings.TrimSpace(l) != strings.TrimSpace(r) {
+			marker = warnStyle.Render("|")
+		}
+		pad := strings.Repeat(" ", excerptColumn-utf8.RuneCountInString(l))
+		fmt.Fprintf(b, "       %s%s %s %s\n", l, pad, marker, r)
+	}
+	if rows > excerptRows {
+		b.WriteString("       " + dimStyle.Render(fmt.Sprintf("… %d more lines", rows-excerptRows)) + "\n")
+	}
+}
+
+// excerptLine returns row i of lines with tabs expanded, cut to the
+// column width.
+func excerptLine(lines []string, i int) string {
+	if i >= len(lines) {
+		return ""
+	}
+	s := strings.ReplaceAll(lines[i], "\t", "    ")
+	if r := []rune(s); len(r) > excerptColumn {
+		s = string(r[:excerptColumn-1]) + "…"
+	}
+	return s
+}
@@ -0,0 +1,67 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// RenderComparison renders score deltas between a baseline and a target,
+// the sub-metrics that changed, and the issues introduced or resolved.
+func RenderComparison(c *domain.Comparison) string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString("  " + titleStyle.Render(fmt.Sprintf("%s → %s", c.Baseline, c.Target)) + "\n")
+	b.WriteString("  " + separatorLine + "\n\n")
+
+	fmt.Fprintf(&b, "  %s %3d → %3d  %s\n\n",
+		catNameStyle.Render(padRight("overall", 20)),
+		c.OverallBefore, c.OverallAfter, deltaText(c.OverallDelta))
+
+	for _, cat := range c.Categories {
+		fmt.Fprintf(&b, "  %s %3d → %3d  %s\n",
+			catNameStyle.Render(padRight(cat.Name, 20)),
+			cat.Before, cat.After, deltaText(cat.Delta))
+		for _, sm := range cat.SubMetrics {
+			fmt.Fprintf(&b, "    %s %s  %s\n",
+				padRight(sm.Name, 30),
+				dimStyle.Render(fmt.Sprintf("%d/%d → %d/%d", sm.Before, sm.Points, sm.After, sm.Points)),
+				deltaText(sm.After-sm.Before))
+			if sm.DetailBefore != sm.DetailAfter {
+				b.WriteString("      " + faintStyle.Render("- "+sm.DetailBefore) + "\n")
+				b.WriteString("      " + dimStyle.Render("+ "+sm.DetailAfter) + "\n")
+			}
+		}
+	}
+
+	renderIssueChanges(&b, "New issues", c.NewIssues, failStyle.Render("+"))
+	renderIssueChanges(&b, "Resolved issues", c.ResolvedIssues, passStyle.Render("-"))
+
+	b.WriteString("\n")
+	return b.String()
+}
+
+func renderIssueChanges(b *strings.Builder, heading string, issues []domain.Issue, marker string) {
+	fmt.Fprintf(b, "\n  %s %s\n", titleStyle.Render(heading), dimStyle.Render(fmt.Sprintf("(%d)", len(issues))))
+	for _, issue := range issues {
+		loc := ""
+		if issue.File != "" {
+			loc = fileStyle.Render(shortenPath(issue.File)) + "  "
+		}
+		fmt.Fprintf(b, "    %s %s %s%s\n", marker, severityTag(issue.Severity), loc, dimStyle.Render(issue.Message))
+	}
+}
+
+// deltaText renders a signed delta, green when the score improved.
+func deltaText(delta int) string {
+	switch {
+	case delta > 0:
+		return passStyle.Render(fmt.Sprintf("+%d", delta))
+	case delta < 0:
+		return failStyle.Render(fmt.Sprintf("%d", delta))
+	default:
+		return dimStyle.Render("±0")
+	}
+}
@@ -0,0 +1,304 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// Dashboard tabs.
+const (
+	tabScores = iota
+	tabIssues
+	tabGraph
+)
+
+var (
+	tabNames       = []string{"Scores", "Issues", "Graph"}
+	activeTabStyle = lipgloss.NewStyle().Bold(true).Foreground(accent).Underline(true)
+	cursorStyle    = lipgloss.NewStyle().Bold(true).Foreground(accent)
+	previewLine    = lipgloss.NewStyle().Bold(true).Foreground(fg)
+)
+
+// previewContext is the number of lines shown around an issue's line.
+const previewContext = 5
+
+// DashboardData is what the interactive dashboard displays.
+type DashboardData struct {
+	Root  string // project root; issue files are relative to it
+	Score *domain.Score
+	Graph string // rendered package graph (see RenderGraph)
+}
+
+// Dashboard is the bubbletea model behind `openkraft tui`: category scores,
+// a navigable issue list with a source preview, and the package graph.
+type Dashboard struct {
+	data     DashboardData
+	issues   []domain.Issue
+	tab      int
+	cursor   int // selected issue
+	scroll   int // first visible line of the scores and graph tabs
+	width    int
+	height   int
+	status   string
+	files    map[string][]string
+	readFile func(string) ([]byte, error)
+}
+
+// editorClosedMsg reports that the $EDITOR process started from the issue
+// list has exited.
+type editorClosedMsg struct{ err error }
+
+// NewDashboard creates the dashboard model for data.
+func NewDashboard(data DashboardData) *Dashboard {
+	return &Dashboard{
+		data:     data,
+		issues:   collectAndSortIssues(data.Score),
+		width:    100,
+		height:   30,
+		files:    make(map[string][]string),
+		readFile: os.ReadFile,
+	}
+}
+
+// RunDashboard shows the dashboard in the alternate screen until the user
+// quits.
+func RunDashboard(data DashboardData) error {
+	_, err := tea.NewProgram(NewDashboard(data), tea.WithAltScreen()).Run()
+	return err
+}
+
+func (d *Dashboard) Init() tea.Cmd { return nil }
+
+func (d *Dashboard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		d.width, d.height = msg.Width, msg.Height
+	case editorClosedMsg:
+		d.status = ""
+		if msg.err != nil {
+			d.status = "editor: " + msg.err.Error()
+		}
+		// The file may have changed; drop its cached preview.
+		d.files = make(map[string][]string)
+	case tea.KeyMsg:
+		return d, d.handleKey(msg.String())
+	}
+	return d, nil
+}
+
+func (d *Dashboard) handleKey(key string) tea.Cmd {
+	switch key {
+	case "q", "ctrl+c", "esc":
+		return tea.Quit
+	case "tab", "right", "l":
+		d.switchTab((d.tab + 1) % len(tabNames))
+	case "shift+tab", "left", "h":
+		d.switchTab((d.tab + len(tabNames) - 1) % len(tabNames))
+	case "1", "2", "3":
+		d.switchTab(int(key[0] - '1'))
+	case "down", "j":
+		d.move(1)
+	case "up", "k":
+		d.move(-1)
+	case "pgdown", "ctrl+d":
+		d.move(d.bodyHeight() / 2)
+	case "pgup", "ctrl+u":
+		d.move(-d.bodyHeight() / 2)
+	case "g", "home":
+		d.cursor, d.scroll = 0, 0
+	case "enter", "e":
+		if d.tab == tabIssues {
+			return d.openInEditor()
+		}
+	}
+	return nil
+}
+
+func (d *Dashboard) switchTab(tab int) {
+	d.tab = tab
+	d.scroll = 0
+	d.status = ""
+}
+
+// move moves the issue cursor on the issues tab and scrolls elsewhere.
+func (d *Dashboard) move(delta int) {
+	if d.tab == tabIssues {
+		d.cursor = clamp(d.cursor+delta, 0, len(d.issues)-1)
+		return
+	}
+	d.scroll = clamp(d.scroll+delta, 0, max(len(d.tabLines())-d.bodyHeight(), 0))
+}
+
+// openInEditor suspends the dashboard and opens the selected issue's file
+// at its line in $VISUAL or $EDITOR (vi by default).
+func (d *Dashboard) openInEditor() tea.Cmd {
+	if len(d.issues) == 0 || d.issues[d.cursor].File == "" {
+		d.status = "issue has no file to open"
+		return nil
+	}
+	cmd := editorCommand(d.path(d.issues[d.cursor].File), d.issues[d.cursor].Line)
+	return tea.ExecProcess(cmd, func(err error) tea.Msg { return editorClosedMsg{err} })
+}
+
+// editorCommand builds the editor invocation, passing +line, which vi, vim,
+// nano, emacs and most terminal editors understand.
+func editorCommand(file string, line int) *exec.Cmd {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+	args := strings.Fields(editor)
+	if line > 0 {
+		args = append(args, fmt.Sprintf("+%d", line))
+	}
+	args = append(args, file)
+	return exec.Command(args[0], args[1:]...)
+}
+
+func (d *Dashboard) View() string {
+	var b strings.Builder
+
+	overall := lipgloss.NewStyle().Bold(true).Foreground(scoreColor(d.data.Score.Overall)).
+		Render(fmt.Sprintf("%d/100 %s", d.data.Score.Overall, d.data.Score.Grade()))
+	b.WriteString(" " + titleStyle.Render("openkraft") + "  " + overall + "   ")
+	for i, name := range tabNames {
+		label := fmt.Sprintf("%d %s", i+1, name)
+		if i == d.tab {
+			b.WriteString(activeTabStyle.Render(label))
+		} else {
+			b.WriteString(dimStyle.Render(label))
+		}
+		b.WriteString("  ")
+	}
+	b.WriteString("\n" + faintStyle.Render(strings.Repeat("─", max(d.width-1, 10))) + "\n")
+
+	if d.tab == tabIssues {
+		b.WriteString(d.issuesView())
+	} else {
+		lines := d.tabLines()
+		end := min(d.scroll+d.bodyHeight(), len(lines))
+		b.WriteString(strings.Join(lines[min(d.scroll, end):end], "\n"))
+		b.WriteString("\n")
+	}
+
+	help := "tab/1-3 switch · ↑↓/jk move · pgup/pgdn page · q quit"
+	if d.tab == tabIssues {
+		help = "tab/1-3 switch · ↑↓/jk select · enter/e open in $EDITOR · q quit"
+	}
+	if d.status != "" {
+		help = d.status
+	}
+	b.WriteString(faintStyle.Render(help) + "\n")
+	return b.String()
+}
+
+// tabLines returns the content of the scores or graph tab.
+func (d *Dashboard) tabLines() []string {
+	var content string
+	if d.tab == tabGraph {
+		content = d.data.Graph
+		if content == "" {
+			content = "  " + dimStyle.Render("No internal packages to graph.")
+		}
+	} else {
+		var b strings.Builder
+		for _, cat := range d.data.Score.Categories {
+			renderCategoryFull(&b, cat)
+			b.WriteString("\n")
+		}
+		content = b.String()
+	}
+	return strings.Split(strings.TrimRight(content, "\n"), "\n")
+}
+
+// issuesView renders a window of the issue list around the cursor and a
+// preview of the selected issue's source below it.
+func (d *Dashboard) issuesView() string {
+	if len(d.issues) == 0 {
+		return "  " + passStyle.Render("No issues found.") + "\n"
+	}
+	var b strings.Builder
+
+	listHeight := max(d.bodyHeight()-(2*previewContext+4), 3)
+	start := clamp(d.cursor-listHeight/2, 0, max(len(d.issues)-listHeight, 0))
+	end := min(start+listHeight, len(d.issues))
+	for i := start; i < end; i++ {
+		iss := d.issues[i]
+		marker := "  "
+		if i == d.cursor {
+			marker = cursorStyle.Render("▸ ")
+		}
+		loc := ""
+		if iss.File != "" {
+			loc = shortenPath(iss.File)
+			if iss.Line > 0 {
+				loc += fmt.Sprintf(":%d", iss.Line)
+			}
+			loc = fileStyle.Render(loc) + "  "
+		}
+		fmt.Fprintf(&b, "%s%s %s%s\n", marker, severityTag(iss.Severity), loc, iss.Message)
+	}
+	fmt.Fprintf(&b, "%s\n", faintStyle.Render(fmt.Sprintf("  %d/%d", d.cursor+1, len(d.issues))))
+	b.WriteString(faintStyle.Render(strings.Repeat("─", max(d.width-1, 10))) + "\n")
+	b.WriteString(d.preview(d.issues[d.cursor]))
+	return b.String()
+}
+
+// preview returns the lines around the issue's line, or the top of the
+// file when the issue has no line.
+func (d *Dashboard) preview(iss domain.Issue) string {
+	if iss.File == "" {
+		return "  " + dimStyle.Render("project-wide issue; no file to preview") + "\n"
+	}
+	lines, ok := d.files[iss.File]
+	if !ok {
+		src, err := d.readFile(d.path(iss.File))
+		if err != nil {
+			return "  " + dimStyle.Render("cannot preview: "+err.Error()) + "\n"
+		}
+		lines = strings.Split(string(src), "\n")
+		d.files[iss.File] = lines
+	}
+
+	focus := max(iss.Line, 1)
+	from := max(focus-previewContext, 1)
+	to := min(focus+previewContext, len(lines))
+	var b strings.Builder
+	for n := from; n <= to; n++ {
+		text := strings.ReplaceAll(lines[n-1], "\t", "    ")
+		num := fmt.Sprintf("%5d ", n)
+		if n == iss.Line {
+			fmt.Fprintf(&b, "%s%s\n", cursorStyle.Render(num), previewLine.Render(text))
+		} else {
+			fmt.Fprintf(&b, "%s%s\n", faintStyle.Render(num), dimStyle.Render(text))
+		}
+	}
+	return b.String()
+}
+
+func (d *Dashboard) path(file string) string {
+	if filepath.IsAbs(file) {
+		return file
+	}
+	return filepath.Join(d.data.Root, file)
+}
+
+// bodyHeight is the number of lines between the tab bar and the help line.
+func (d *Dashboard) bodyHeight() int {
+	return max(d.height-3, 5)
+}
+
+func clamp(v, lo, hi int) int {
+	return max(lo, min(v, hi))
+}
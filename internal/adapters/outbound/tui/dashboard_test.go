@@ -0,0 +1,85 @@
+package tui_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+)
+
+func press(t *testing.T, m tea.Model, keys ...string) (tea.Model, tea.Cmd) {
+	t.Helper()
+	var cmd tea.Cmd
+	for _, k := range keys {
+		var msg tea.KeyMsg
+		switch k {
+		case "tab":
+			msg = tea.KeyMsg{Type: tea.KeyTab}
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		}
+		m, cmd = m.Update(msg)
+	}
+	return m, cmd
+}
+
+func dashboardFixture(t *testing.T) tui.DashboardData {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "internal"), 0755))
+	src := "package foo\n\nfunc Run() {\n\tdoSomething()\n}\n"
+	require.NoError(t, os.WriteFile(filepath.Join(root, "internal/foo.go"), []byte(src), 0644))
+
+	score := sampleScore()
+	score.Categories[0].Issues[0].File = "internal/foo.go"
+	score.Categories[0].Issues[0].Line = 4
+	return tui.DashboardData{Root: root, Score: score, Graph: "  package graph here"}
+}
+
+func TestDashboard_ScoresTabShowsCategories(t *testing.T) {
+	view := tui.NewDashboard(dashboardFixture(t)).View()
+	assert.Contains(t, view, "67/100")
+	assert.Contains(t, view, "code_health")
+	assert.Contains(t, view, "function_size")
+}
+
+func TestDashboard_IssuesTabPreviewsSelectedIssue(t *testing.T) {
+	m, _ := press(t, tui.NewDashboard(dashboardFixture(t)), "2")
+	view := m.View()
+	assert.Contains(t, view, "function too long")
+	assert.Contains(t, view, "internal/foo.go:4")
+	assert.Contains(t, view, "doSomething()", "preview shows the issue's line")
+	assert.Contains(t, view, "1/2")
+
+	m, _ = press(t, m, "j")
+	view = m.View()
+	assert.Contains(t, view, "2/2")
+	assert.Contains(t, view, "project-wide issue")
+
+	m, _ = press(t, m, "j")
+	assert.Contains(t, m.View(), "2/2", "the cursor stops at the last issue")
+}
+
+func TestDashboard_OpenInEditor(t *testing.T) {
+	m, cmd := press(t, tui.NewDashboard(dashboardFixture(t)), "2", "enter")
+	assert.NotNil(t, cmd, "an issue with a file opens the editor")
+
+	m, cmd = press(t, m, "j", "enter")
+	assert.Nil(t, cmd)
+	assert.Contains(t, m.View(), "issue has no file to open")
+}
+
+func TestDashboard_GraphTabAndQuit(t *testing.T) {
+	m, _ := press(t, tui.NewDashboard(dashboardFixture(t)), "tab", "tab")
+	assert.Contains(t, m.View(), "package graph here")
+
+	_, cmd := press(t, m, "q")
+	require.NotNil(t, cmd)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+}
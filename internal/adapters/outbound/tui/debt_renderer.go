@@ -0,0 +1,37 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// maxDebtPackages bounds the packages listed by RenderDebtNotes.
+const maxDebtPackages = 5
+
+// RenderDebtNotes renders the TODO/FIXME/HACK inventory: totals, the
+// packages with the most notes and the oldest dated notes. Returns ""
+// without notes.
+func RenderDebtNotes(inv *domain.DebtInventory) string {
+	if inv == nil {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("\n  " + titleStyle.Render("Debt notes") + "  " +
+		dimStyle.Render(fmt.Sprintf("%d TODO, %d FIXME, %d HACK", inv.ByKind["TODO"], inv.ByKind["FIXME"], inv.ByKind["HACK"])) + "\n")
+	for _, p := range inv.Packages[:min(len(inv.Packages), maxDebtPackages)] {
+		b.WriteString(fmt.Sprintf("  %s %d\n", padRight(p.Package, 40), p.Notes))
+	}
+	if len(inv.Oldest) > 0 {
+		b.WriteString("  " + dimStyle.Render("Oldest:") + "\n")
+	}
+	for _, n := range inv.Oldest {
+		who := n.Date
+		if n.Author != "" {
+			who += " " + n.Author
+		}
+		b.WriteString(fmt.Sprintf("    %s %s %s\n", dimStyle.Render(who), fileStyle.Render(fmt.Sprintf("%s:%d", shortenPath(n.File), n.Line)), n.Kind+": "+n.Text))
+	}
+	return b.String()
+}
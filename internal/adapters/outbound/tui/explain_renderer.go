@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderExplanation formats a category score breakdown. At most itemLimit
+// credit items are listed per sub-metric (0 lists all).
+func RenderExplanation(exp *domain.Explanation, itemLimit int) string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "  %s  %s\n", titleStyle.Render(exp.Category),
+		dimStyle.Render(fmt.Sprintf("score %d/100 · weight %d%%", exp.Score, int(exp.Weight*100))))
+	b.WriteString("  " + separatorLine + "\n")
+
+	for _, sm := range exp.SubMetrics {
+		renderSubMetricExplanation(&b, sm, itemLimit)
+	}
+
+	if pb := exp.Penalty; pb != nil {
+		b.WriteString("\n  " + catNameStyle.Render("penalty") + "\n")
+		fmt.Fprintf(&b, "    issues     %d error × 3 + %d warning × 1 + %d info × 0.2 = %.1f\n",
+			pb.Errors, pb.Warnings, pb.Infos, pb.Weighted)
+		fmt.Fprintf(&b, "    debt ratio %.1f / %d = %.4f\n", pb.Weighted, pb.Normalizer, pb.DebtRatio)
+		fmt.Fprintf(&b, "    penalty    round(%.4f × %.0f) = %d", pb.DebtRatio, pb.Scale, pb.Penalty)
+		if pb.Errors > 0 && math.Round(pb.DebtRatio*pb.Scale) < 1 {
+			b.WriteString(dimStyle.Render("  (floored to 1: errors present)"))
+		}
+		b.WriteString("\n")
+		fmt.Fprintf(&b, "    score      max(0, %d - %d) = %d\n", exp.BaseScore, pb.Penalty, max(0, exp.BaseScore-pb.Penalty))
+	}
+
+	if exp.Note != "" {
+		b.WriteString("\n  " + warnStyle.Render("note: "+exp.Note) + "\n")
+	}
+
+	b.WriteString("\n")
+	return b.String()
+}
+
+func renderSubMetricExplanation(b *strings.Builder, sm domain.SubMetricExplanation, itemLimit int) {
+	b.WriteString("\n")
+	if sm.Skipped {
+		fmt.Fprintf(b, "  %s %s\n", skipStyle.Render(padRight(sm.Name, 30)), skipStyle.Render("skipped"))
+		return
+	}
+	fmt.Fprintf(b, "  %s %s  %s\n", catNameStyle.Render(padRight(sm.Name, 30)),
+		dimStyle.Render(fmt.Sprintf("%d/%d", sm.Score, sm.Points)),
+		dimStyle.Render(fmt.Sprintf("%d issue(s)", sm.Issues)))
+	if sm.Detail != "" {
+		fmt.Fprintf(b, "    %s\n", faintStyle.Render(sm.Detail))
+	}
+	if sm.Formula != "" {
+		fmt.Fprintf(b, "    formula  %s\n", dimStyle.Render(sm.Formula))
+	}
+	if sm.Evaluated > 0 {
+		fmt.Fprintf(b, "    credit   %.2f earned over %d evaluated\n", sm.Earned, sm.Evaluated)
+	}
+
+	items := sm.Items
+	if itemLimit > 0 && len(items) > itemLimit {
+		items = items[:itemLimit]
+	}
+	for _, it := range items {
+		loc := it.File
+		if it.Line > 0 {
+			loc = fmt.Sprintf("%s:%d", it.File, it.Line)
+		}
+		symbol := ""
+		if it.Symbol != "" {
+			symbol = " " + it.Symbol
+		}
+		fmt.Fprintf(b, "      %s %s%s  %s\n",
+			lossStyle(it.Credit).Render(fmt.Sprintf("%.2f", it.Credit)),
+			fileStyle.Render(shortenPath(loc)), symbol,
+			dimStyle.Render(fmt.Sprintf("%d > %d", it.Value, it.Limit)))
+	}
+	if hidden := len(sm.Items) - len(items); hidden > 0 {
+		fmt.Fprintf(b, "      %s\n", faintStyle.Render(fmt.Sprintf("… %d more", hidden)))
+	}
+}
+
+func lossStyle(credit float64) lipgloss.Style {
+	switch {
+	case credit <= 0.25:
+		return failStyle
+	case credit <= 0.75:
+		return warnStyle
+	default:
+		return dimStyle
+	}
+}
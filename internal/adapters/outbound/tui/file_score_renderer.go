@@ -0,0 +1,53 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// RenderFileScore renders the impact of one file on its project's score:
+// the overall change, the sub-metrics the file moves or has issues in, and
+// the file's issues by line.
+func RenderFileScore(fs *domain.FileScore) string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString("  " + titleStyle.Render(fs.Path) + "\n")
+	b.WriteString("  " + separatorLine + "\n\n")
+	if fs.Partial {
+		b.WriteString("  " + warnStyle.Render("partial analysis: the file has syntax errors, so only its size, names and imports were measured") + "\n\n")
+	}
+
+	fmt.Fprintf(&b, "  %s %3d  %s\n\n",
+		catNameStyle.Render(padRight("overall", 36)), fs.Overall, deltaText(fs.OverallImpact))
+
+	if len(fs.SubMetrics) == 0 {
+		b.WriteString("  " + dimStyle.Render("the file moves no sub-metric") + "\n")
+	}
+	for _, sm := range fs.SubMetrics {
+		issues := ""
+		if sm.Issues > 0 {
+			issues = faintStyle.Render(fmt.Sprintf("%d issue(s)", sm.Issues))
+		}
+		fmt.Fprintf(&b, "  %s %s  %s  %s\n",
+			padRight(sm.Category+"/"+sm.SubMetric, 36),
+			dimStyle.Render(fmt.Sprintf("%3d/%-3d", sm.Score, sm.Points)), deltaText(sm.Impact), issues)
+	}
+
+	if len(fs.Issues) > 0 {
+		b.WriteString("\n")
+	}
+	for _, issue := range fs.Issues {
+		loc := fs.Path
+		if issue.Line > 0 {
+			loc = fmt.Sprintf("%s:%d", loc, issue.Line)
+		}
+		fmt.Fprintf(&b, "  %s %s\n", severityTag(issue.Severity), fileStyle.Render(shortenPath(loc)))
+		fmt.Fprintf(&b, "        %s\n", dimStyle.Render(issue.Message))
+	}
+
+	b.WriteString("\n")
+	return b.String()
+}
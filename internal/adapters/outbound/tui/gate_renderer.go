@@ -0,0 +1,30 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// RenderGateResults renders a summary of quality gate failures. An empty
+// slice renders a single pass line.
+func RenderGateResults(failures []domain.GateFailure) string {
+	var b strings.Builder
+
+	if len(failures) == 0 {
+		b.WriteString(passStyle.Render("✓ all quality gates passed"))
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	b.WriteString(failStyle.Render(fmt.Sprintf("✗ %d quality gate(s) failed", len(failures))))
+	b.WriteString("\n")
+	for _, f := range failures {
+		b.WriteString(fmt.Sprintf("  %s %s  %s\n",
+			failStyle.Render("●"),
+			padRight(f.Gate, 28),
+			dimStyle.Render(f.Message)))
+	}
+	return b.String()
+}
@@ -0,0 +1,26 @@
+package tui_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestRenderGateResults_Pass(t *testing.T) {
+	out := tui.RenderGateResults(nil)
+	assert.Contains(t, out, "all quality gates passed")
+}
+
+func TestRenderGateResults_ListsFailures(t *testing.T) {
+	out := tui.RenderGateResults([]domain.GateFailure{
+		{Gate: "min_overall", Actual: 70, Limit: 80, Message: "overall score 70 is below 80"},
+		{Gate: "max_issues.error", Actual: 2, Limit: 0, Message: "2 error issue(s) found, at most 0 allowed"},
+	})
+	assert.Contains(t, out, "2 quality gate(s) failed")
+	assert.Contains(t, out, "min_overall")
+	assert.Contains(t, out, "overall score 70 is below 80")
+	assert.Contains(t, out, "max_issues.error")
+}
@@ -14,30 +14,34 @@ const graphMaxRows = 15
 
 // RenderGraph produces a terminal-formatted visualization of the import graph
 // including a summary header, metrics table, cycles, and coupling outliers.
-func RenderGraph(graph *scoring.ImportGraph, modulePath string, profile *domain.ScoringProfile) string {
+// Packages are shown relative to the module path of display, with its
+// aliases applied.
+func RenderGraph(graph *scoring.ImportGraph, display *domain.PathDisplay, profile *domain.ScoringProfile) string {
 	if graph == nil || len(graph.Packages) == 0 {
 		return "\n  " + dimStyle.Render("No import graph available (no go.mod found).") + "\n\n"
 	}
 
+	modulePath := display.ModulePath()
+	display = display.Stripped()
 	annotated := graph.ClassifyPackages(modulePath, profile)
 
 	var b strings.Builder
 
 	// ── Header box ──
-	renderGraphHeader(&b, graph, modulePath, scoring.TotalViolations(annotated))
+	renderGraphHeader(&b, graph, display.Alias(modulePath), scoring.TotalViolations(annotated))
 
 	// ── Metrics table ──
-	renderMetricsTable(&b, annotated, modulePath)
+	renderMetricsTable(&b, annotated, display)
 
 	// ── Cycles ──
-	renderCyclesSection(&b, graph)
+	renderCyclesSection(&b, graph, display)
 
 	// ── Coupling outliers ──
 	multiplier := 2.0
 	if profile != nil && profile.CouplingOutlierMultiplier > 0 {
 		multiplier = profile.CouplingOutlierMultiplier
 	}
-	renderOutliersSection(&b, graph, modulePath, multiplier)
+	renderOutliersSection(&b, graph, display, multiplier)
 
 	b.WriteString("\n")
 	return b.String()
@@ -70,11 +74,11 @@ type annotatedRow struct {
 	violations []scoring.PackageViolation
 }
 
-func renderMetricsTable(b *strings.Builder, annotated map[string]*scoring.AnnotatedPackage, modulePath string) {
+func renderMetricsTable(b *strings.Builder, annotated map[string]*scoring.AnnotatedPackage, display *domain.PathDisplay) {
 	var rows []annotatedRow
 	for pkg, ap := range annotated {
 		rows = append(rows, annotatedRow{
-			shortName:  stripModulePrefix(pkg, modulePath),
+			shortName:  display.Package(pkg),
 			ca:         len(ap.Node.ImportedBy),
 			ce:         len(ap.Node.ImportsInternal),
 			role:       ap.Role,
@@ -153,7 +157,7 @@ func renderViolations(violations []scoring.PackageViolation) string {
 	return msg
 }
 
-func renderCyclesSection(b *strings.Builder, graph *scoring.ImportGraph) {
+func renderCyclesSection(b *strings.Builder, graph *scoring.ImportGraph, display *domain.PathDisplay) {
 	b.WriteString("  " + titleStyle.Render("Cycles") + "\n")
 	cycles := graph.DetectCycles()
 	if len(cycles) == 0 {
@@ -161,23 +165,25 @@ func renderCyclesSection(b *strings.Builder, graph *scoring.ImportGraph) {
 	} else {
 		for _, cycle := range cycles {
 			// Show as a → b → c → a
-			parts := make([]string, len(cycle))
-			copy(parts, cycle)
-			parts = append(parts, cycle[0])
+			parts := make([]string, 0, len(cycle)+1)
+			for _, pkg := range cycle {
+				parts = append(parts, display.Package(pkg))
+			}
+			parts = append(parts, parts[0])
 			b.WriteString("    " + failStyle.Render(strings.Join(parts, " → ")) + "\n")
 		}
 	}
 	b.WriteString("\n")
 }
 
-func renderOutliersSection(b *strings.Builder, graph *scoring.ImportGraph, modulePath string, multiplier float64) {
+func renderOutliersSection(b *strings.Builder, graph *scoring.ImportGraph, display *domain.PathDisplay, multiplier float64) {
 	b.WriteString("  " + titleStyle.Render("Coupling Outliers") + "\n")
 	outliers := graph.CouplingOutliers(multiplier)
 	if len(outliers) == 0 {
 		b.WriteString("    " + passStyle.Render("(none)") + "\n")
 	} else {
 		for _, o := range outliers {
-			short := stripModulePrefix(o.Package, modulePath)
+			short := display.Package(o.Package)
 			b.WriteString("    " + warnStyle.Render(fmt.Sprintf(
 				"%s imports %d packages (median: %.0f)", short, o.Ce, o.MedianCe)) + "\n")
 		}
@@ -190,18 +196,3 @@ func truncateOrPad(s string, width int) string {
 	}
 	return padRight(s, width)
 }
-
-func stripModulePrefix(pkg, modulePath string) string {
-	if modulePath == "" {
-		return pkg
-	}
-	trimmed := strings.TrimPrefix(pkg, modulePath+"/")
-	if trimmed == pkg {
-		// Might be the root module itself.
-		if pkg == modulePath {
-			return "./"
-		}
-		return pkg
-	}
-	return trimmed
-}
@@ -12,14 +12,14 @@ import (
 
 func TestRenderGraph_NilGraph(t *testing.T) {
 	profile := domain.DefaultProfile()
-	out := RenderGraph(nil, "example.com/app", &profile)
+	out := RenderGraph(nil, domain.NewPathDisplay("example.com/app", nil), &profile)
 	assert.Contains(t, out, "No import graph available")
 }
 
 func TestRenderGraph_EmptyGraph(t *testing.T) {
 	graph := &scoring.ImportGraph{Packages: map[string]*scoring.PackageNode{}}
 	profile := domain.DefaultProfile()
-	out := RenderGraph(graph, "example.com/app", &profile)
+	out := RenderGraph(graph, domain.NewPathDisplay("example.com/app", nil), &profile)
 	assert.Contains(t, out, "No import graph available")
 }
 
@@ -33,7 +33,7 @@ func TestRenderGraph_SinglePackage(t *testing.T) {
 		},
 	}
 	profile := domain.DefaultProfile()
-	out := RenderGraph(graph, "example.com/app", &profile)
+	out := RenderGraph(graph, domain.NewPathDisplay("example.com/app", nil), &profile)
 
 	assert.Contains(t, out, "Import Graph")
 	assert.Contains(t, out, "1 packages")
@@ -64,7 +64,7 @@ func TestRenderGraph_BasicOutput(t *testing.T) {
 		},
 	}
 	profile := domain.DefaultProfile()
-	out := RenderGraph(graph, "example.com/proj", &profile)
+	out := RenderGraph(graph, domain.NewPathDisplay("example.com/proj", nil), &profile)
 
 	assert.Contains(t, out, "Import Graph")
 	assert.Contains(t, out, "3 packages")
@@ -100,7 +100,7 @@ func TestRenderGraph_CyclesShown(t *testing.T) {
 		},
 	}
 	profile := domain.DefaultProfile()
-	out := RenderGraph(graph, "example.com/proj", &profile)
+	out := RenderGraph(graph, domain.NewPathDisplay("example.com/proj", nil), &profile)
 
 	assert.Contains(t, out, "Cycles")
 	// Should show a → b → a cycle notation
@@ -125,7 +125,7 @@ func TestRenderGraph_OutliersShown(t *testing.T) {
 		},
 	}
 	profile := domain.DefaultProfile()
-	out := RenderGraph(graph, "example.com/proj", &profile)
+	out := RenderGraph(graph, domain.NewPathDisplay("example.com/proj", nil), &profile)
 
 	assert.Contains(t, out, "Coupling Outliers")
 	assert.Contains(t, out, "imports 5 packages")
@@ -142,7 +142,7 @@ func TestRenderGraph_TruncatesLargeProjects(t *testing.T) {
 	}
 	graph := &scoring.ImportGraph{Packages: packages}
 	profile := domain.DefaultProfile()
-	out := RenderGraph(graph, "example.com/proj", &profile)
+	out := RenderGraph(graph, domain.NewPathDisplay("example.com/proj", nil), &profile)
 
 	assert.Contains(t, out, "more packages")
 }
@@ -166,7 +166,7 @@ func TestRenderGraph_RoleLabelsShown(t *testing.T) {
 		},
 	}
 	profile := domain.DefaultProfile()
-	out := RenderGraph(graph, "example.com/proj", &profile)
+	out := RenderGraph(graph, domain.NewPathDisplay("example.com/proj", nil), &profile)
 
 	assert.Contains(t, out, "core")
 	assert.Contains(t, out, "orchestrator")
@@ -187,7 +187,7 @@ func TestRenderGraph_ViolationShown(t *testing.T) {
 		},
 	}
 	profile := domain.DefaultProfile()
-	out := RenderGraph(graph, "example.com/proj", &profile)
+	out := RenderGraph(graph, domain.NewPathDisplay("example.com/proj", nil), &profile)
 
 	assert.Contains(t, out, "imports adapter")
 }
@@ -206,7 +206,32 @@ func TestRenderGraph_ZeroViolationsInHeader(t *testing.T) {
 		},
 	}
 	profile := domain.DefaultProfile()
-	out := RenderGraph(graph, "example.com/proj", &profile)
+	out := RenderGraph(graph, domain.NewPathDisplay("example.com/proj", nil), &profile)
 
 	assert.Contains(t, out, "0 violations")
 }
+
+func TestRenderGraph_DisplayAliases(t *testing.T) {
+	graph := &scoring.ImportGraph{
+		Packages: map[string]*scoring.PackageNode{
+			"github.com/acme/platform/svc/internal/a": {
+				ImportPath:      "github.com/acme/platform/svc/internal/a",
+				ImportsInternal: []string{"github.com/acme/platform/svc/internal/b"},
+				ImportedBy:      []string{"github.com/acme/platform/svc/internal/b"},
+			},
+			"github.com/acme/platform/svc/internal/b": {
+				ImportPath:      "github.com/acme/platform/svc/internal/b",
+				ImportsInternal: []string{"github.com/acme/platform/svc/internal/a"},
+				ImportedBy:      []string{"github.com/acme/platform/svc/internal/a"},
+			},
+		},
+	}
+	profile := domain.DefaultProfile()
+	display := domain.NewPathDisplay("github.com/acme/platform/svc", &domain.DisplayConfig{
+		Aliases: map[string]string{"github.com/acme/platform/svc": "svc"},
+	})
+	out := RenderGraph(graph, display, &profile)
+
+	assert.Contains(t, out, "svc/internal/a → svc/internal/b → svc/internal/a")
+	assert.NotContains(t, out, "github.com/acme/platform/svc")
+}
@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+// RenderHexagonalPlan renders a hexagonal migration plan: the projected
+// score, the package moves, the packages that stay
+// and the category changes the moves bring.
+func RenderHexagonalPlan(p *scoring.HexagonalPlan) string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString("  " + titleStyle.Render("Hexagonal migration plan") + "\n")
+	b.WriteString("  " + separatorLine + "\n\n")
+
+	fmt.Fprintf(&b, "  %s %3d → %3d  %s\n\n",
+		catNameStyle.Render(padRight("overall", 20)),
+		p.Baseline, p.Projected, deltaText(p.Delta))
+
+	renderPackageMoves(&b, p.Moves)
+
+	if len(p.Placed) > 0 {
+		fmt.Fprintf(&b, "\n  %s\n", dimStyle.Render(fmt.Sprintf("%d packages already in a layer stay", len(p.Placed))))
+	}
+	if len(p.Undecided) > 0 {
+		fmt.Fprintf(&b, "\n  %s %s\n", warnTagStyle.Render("Place by hand:"), strings.Join(p.Undecided, ", "))
+		b.WriteString("  " + hintStyle.Render("no role signal is confident enough to choose a layer") + "\n")
+	}
+	b.WriteString("\n")
+
+	for _, cat := range p.Categories {
+		if cat.Delta == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "  %s %3d → %3d  %s\n",
+			catNameStyle.Render(padRight(cat.Name, 20)),
+			cat.Before, cat.After, deltaText(cat.Delta))
+		for _, sm := range cat.SubMetrics {
+			fmt.Fprintf(&b, "    %s %s\n", padRight(sm.Name, 30), dimStyle.Render(sm.DetailAfter))
+		}
+	}
+
+	b.WriteString("\n")
+	return b.String()
+}
+
+// renderPackageMoves lists the moves of a plan, noting the packages that
+// other modules can import.
+func renderPackageMoves(b *strings.Builder, moves []scoring.PackageMove) {
+	if len(moves) == 0 {
+		b.WriteString("  " + passStyle.Render("No package to move.") + "\n")
+	}
+	width := 0
+	for _, m := range moves {
+		width = max(width, len(m.From))
+	}
+	public := 0
+	for i, m := range moves {
+		fmt.Fprintf(b, "  %2d. %s → %s\n", i+1, padRight(m.From, width), m.To)
+		fmt.Fprintf(b, "      %s\n", dimStyle.Render(fmt.Sprintf("%s %.2f · %d files · %d importers",
+			m.Role, m.Confidence, m.Files, m.Importers)))
+		if m.Public {
+			public++
+		}
+	}
+	if public > 0 {
+		fmt.Fprintf(b, "\n  %s\n", warnStyle.Render(fmt.Sprintf(
+			"%d of these packages can be imported by other modules; moving them under internal/ breaks those imports", public)))
+	}
+}
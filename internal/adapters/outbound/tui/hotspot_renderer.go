@@ -0,0 +1,65 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// RenderHotspots formats the ranked file and function hotspots.
+func RenderHotspots(report *domain.HotspotReport) string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	title := "Hotspots"
+	if report.ChurnUsed {
+		title += dimStyle.Render("  (weighted by git churn)")
+	}
+	b.WriteString("  " + titleStyle.Render(title) + "\n")
+	b.WriteString("  " + separatorLine + "\n")
+
+	renderHotspotList(&b, "files", report.Files, report.ChurnUsed)
+	renderHotspotList(&b, "functions", report.Functions, report.ChurnUsed)
+
+	b.WriteString("\n")
+	return b.String()
+}
+
+func renderHotspotList(b *strings.Builder, heading string, hotspots []domain.Hotspot, churn bool) {
+	b.WriteString("\n  " + catNameStyle.Render(heading) + "\n")
+	if len(hotspots) == 0 {
+		b.WriteString("    " + dimStyle.Render("nothing costs points here") + "\n")
+		return
+	}
+
+	for i, h := range hotspots {
+		loc := shortenPath(h.File)
+		if h.Line > 0 {
+			loc = fmt.Sprintf("%s:%d", loc, h.Line)
+		}
+		if h.Symbol != "" {
+			loc += " " + h.Symbol
+		}
+		stats := fmt.Sprintf("cost %.2f = credit %.2f + issues %.1f (%d)", h.Cost, h.LostCredit, h.IssueWeight, h.Issues)
+		if churn {
+			stats += fmt.Sprintf(" · %d commits", h.Churn)
+		}
+		fmt.Fprintf(b, "  %3d. %s %s\n       %s\n", i+1,
+			hotspotStyle(h.Priority).Render(fmt.Sprintf("%6.2f", h.Priority)),
+			fileStyle.Render(loc), dimStyle.Render(stats))
+	}
+}
+
+func hotspotStyle(priority float64) lipgloss.Style {
+	switch {
+	case priority >= 5:
+		return failStyle
+	case priority >= 2:
+		return warnStyle
+	default:
+		return dimStyle
+	}
+}
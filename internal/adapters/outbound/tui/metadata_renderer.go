@@ -0,0 +1,65 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// RenderMetadata renders a one-line provenance footer: tool version, module,
+// commit, file counts, unparsed files, skipped directories and profile hash. Returns ""
+// without metadata.
+func RenderMetadata(m *domain.ReportMetadata) string {
+	if m == nil {
+		return ""
+	}
+	tool := m.Tool
+	if m.ToolVersion != "" {
+		tool += " " + m.ToolVersion
+	}
+	parts := []string{tool}
+	if m.ModulePath != "" {
+		module := m.ModulePath
+		if m.CommitSHA != "" {
+			module += " @ " + shortHash(m.CommitSHA, 7)
+		}
+		parts = append(parts, module)
+	}
+	parts = append(parts, fmt.Sprintf("%d files (%d analyzed)", m.Files.Total, m.Files.Analyzed))
+	if m.Files.Unparsed > 0 {
+		parts = append(parts, fmt.Sprintf("%d unparsed", m.Files.Unparsed))
+	}
+	if skipped := skippedDirs(m.ScanDecisions); skipped > 0 {
+		parts = append(parts, fmt.Sprintf("%d dirs skipped", skipped))
+	}
+	if m.ProfileHash != "" {
+		parts = append(parts, "profile "+shortHash(m.ProfileHash, 12))
+	}
+	return "\n  " + faintStyle.Render(strings.Join(parts, " · ")) + "\n"
+}
+
+func skippedDirs(decisions []domain.ScanDecision) int {
+	n := 0
+	for _, d := range decisions {
+		if !d.Included {
+			n++
+		}
+	}
+	return n
+}
+
+// shortHash abbreviates a hex digest, keeping an algorithm prefix such as
+// "sha256:".
+func shortHash(h string, n int) string {
+	prefix, digest, ok := strings.Cut(h, ":")
+	if !ok {
+		prefix, digest = "", h
+	} else {
+		prefix += ":"
+	}
+	if len(digest) > n {
+		digest = digest[:n]
+	}
+	return prefix + digest
+}
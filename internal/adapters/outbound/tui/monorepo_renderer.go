@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// categoryAbbrev shortens category names for table column headers.
+var categoryAbbrev = map[string]string{
+	"code_health":     "health",
+	"discoverability": "disc",
+	"structure":       "struct",
+	"verifiability":   "verif",
+	"context_quality": "context",
+	"predictability":  "predict",
+	"security_hints":  "secrets",
+}
+
+// RenderMonorepo renders the aggregate score followed by a comparison
+// table with one row per discovered project.
+func RenderMonorepo(m *domain.MonorepoScore) string {
+	var b strings.Builder
+
+	grade := m.Grade()
+	scoreStyled := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(gradeColor(grade)).
+		Render(fmt.Sprintf("%d / 100  %s", m.Overall, grade))
+	subtitle := dimStyle.Render(fmt.Sprintf("Aggregate of %d projects, weighted by file count", len(m.Projects)))
+	b.WriteString(boxStyle.Render(headerStyle.Render("openkraft") + "\n" + subtitle + "\n\n" + scoreStyled))
+	b.WriteString("\n\n")
+
+	header := "  " + padRight("project", 24) + padLeft("score", 6) + padLeft("files", 7)
+	for _, name := range domain.ValidCategories {
+		header += padLeft(categoryAbbrev[name], 8)
+	}
+	b.WriteString(dimStyle.Render(header) + "\n")
+	rule := "  " + faintStyle.Render(strings.Repeat("─", len(header)-2)) + "\n"
+	b.WriteString(rule)
+
+	for _, p := range m.Projects {
+		cats := make(map[string]int, len(p.Score.Categories))
+		for _, c := range p.Score.Categories {
+			cats[c.Name] = c.Score
+		}
+		b.WriteString(monorepoRow(p.Path, p.Score.Overall, fmt.Sprint(p.Files), cats))
+	}
+
+	b.WriteString(rule)
+	b.WriteString(monorepoRow("aggregate", m.Overall, "", m.Categories))
+	b.WriteString(RenderMetadata(m.Metadata))
+	b.WriteString("\n")
+	return b.String()
+}
+
+func monorepoRow(name string, overall int, files string, cats map[string]int) string {
+	row := "  " + catNameStyle.Render(padRight(name, 24)) +
+		scoreCell(overall, 6, true) +
+		dimStyle.Render(padLeft(files, 7))
+	for _, cat := range domain.ValidCategories {
+		row += scoreCell(cats[cat], 8, hasKey(cats, cat))
+	}
+	return row + "\n"
+}
+
+// scoreCell right-aligns a score colored by its band, or a dash when the
+// category was skipped for that project.
+func scoreCell(score, width int, present bool) string {
+	if !present {
+		return skipStyle.Render(padLeft("-", width))
+	}
+	return lipgloss.NewStyle().Foreground(scoreColor(score)).Render(padLeft(fmt.Sprint(score), width))
+}
+
+func hasKey(m map[string]int, key string) bool {
+	_, ok := m[key]
+	return ok
+}
+
+func padLeft(s string, width int) string {
+	if len(s) >= width {
+		return s
+	}
+	return strings.Repeat(" ", width-len(s)) + s
+}
@@ -0,0 +1,33 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// RenderOwnerDebt renders the per-owner issue summary produced by
+// --group-by owner.
+func RenderOwnerDebt(owners []domain.OwnerDebt) string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString("  " + titleStyle.Render("Score debt by owner") + "\n")
+	b.WriteString("  " + separatorLine + "\n\n")
+
+	if len(owners) == 0 {
+		b.WriteString("  " + passStyle.Render("✓ no issues to attribute") + "\n\n")
+		return b.String()
+	}
+
+	for _, od := range owners {
+		b.WriteString(fmt.Sprintf("  %s %s  %s\n",
+			padRight(od.Owner, 30),
+			fmt.Sprintf("%7.1f", od.Debt),
+			dimStyle.Render(fmt.Sprintf("%d issues (%d errors, %d warnings, %d info)",
+				od.Issues, od.Errors, od.Warnings, od.Infos))))
+	}
+	b.WriteString("\n")
+	return b.String()
+}
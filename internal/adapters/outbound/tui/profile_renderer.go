@@ -0,0 +1,23 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// RenderSelfProfile renders the --profile-self timings of a scoring run.
+func RenderSelfProfile(p *domain.SelfProfile) string {
+	var b strings.Builder
+
+	b.WriteString("  " + titleStyle.Render("Self profile") + "\n")
+	b.WriteString("  " + separatorLine + "\n")
+	for _, ph := range p.Phases {
+		b.WriteString(fmt.Sprintf("  %s %10.1f ms\n", padRight(ph.Name, 10), ph.DurationMs))
+	}
+	b.WriteString(fmt.Sprintf("  %s %10.1f ms\n", padRight("total", 10), p.WallTimeMs))
+	b.WriteString("  " + dimStyle.Render(fmt.Sprintf("%d files (%d Go, %d analyzed) · %d allocs · %.1f MB allocated",
+		p.Files, p.GoFiles, p.AnalyzedFiles, p.Allocs, float64(p.AllocBytes)/(1<<20))) + "\n\n")
+	return b.String()
+}
@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+// RenderRenameImpact renders what renaming a symbol touches: its blast
+// radius, call sites, other references, interface bindings and string
+// references.
+func RenderRenameImpact(impact *scoring.RenameImpact) string {
+	var b strings.Builder
+	s := impact.Symbol
+
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "  %s %s\n", titleStyle.Render("Rename impact of "+s.Name), dimStyle.Render("("+s.Kind+")"))
+	b.WriteString("  " + separatorLine + "\n")
+	loc := s.File
+	if s.Line > 0 {
+		loc = fmt.Sprintf("%s:%d", s.File, s.Line)
+	}
+	fmt.Fprintf(&b, "  %s  %s\n", fileStyle.Render(loc), faintStyle.Render(s.Package))
+
+	r := impact.Blast
+	radius := fmt.Sprintf("%d references in %d files across %d packages", r.References, r.Files, r.Packages)
+	fmt.Fprintf(&b, "\n  Blast radius  %s  %s\n", blastStyle(r.Level).Render(r.Level), radius)
+	if r.PublicAPI {
+		b.WriteString("  " + warnStyle.Render("exported from an importable package: code outside the module may break") + "\n")
+	}
+
+	renderRenameRefs(&b, "call sites", impact.CallSites)
+	renderRenameRefs(&b, "references", impact.References)
+	if len(impact.Bindings) > 0 {
+		b.WriteString("\n  " + catNameStyle.Render("interface bindings") + "\n")
+		for _, ib := range impact.Bindings {
+			line := fmt.Sprintf("%s satisfies %s", ib.Type, ib.Interface)
+			if ib.Breaks {
+				line += "  " + warnStyle.Render("breaks unless renamed in step")
+			}
+			fmt.Fprintf(&b, "    %s  %s\n", line, fileStyle.Render(ib.File))
+		}
+	}
+	renderRenameRefs(&b, "string references", impact.Strings)
+
+	b.WriteString("\n")
+	return b.String()
+}
+
+func renderRenameRefs(b *strings.Builder, heading string, refs []scoring.RenameReference) {
+	if len(refs) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n  %s %s\n", catNameStyle.Render(heading), dimStyle.Render(fmt.Sprintf("(%d)", len(refs))))
+	for _, ref := range refs {
+		loc := ref.File
+		if ref.Line > 0 {
+			loc = fmt.Sprintf("%s:%d", ref.File, ref.Line)
+		}
+		detail := ref.Function
+		if ref.Kind == scoring.RefString {
+			detail = ref.Text
+		}
+		fmt.Fprintf(b, "    %s %s  %s\n", dimStyle.Render(padRight(ref.Kind, 13)), fileStyle.Render(loc), faintStyle.Render(detail))
+	}
+}
+
+func blastStyle(level string) lipgloss.Style {
+	switch level {
+	case scoring.BlastHigh:
+		return failStyle
+	case scoring.BlastMedium:
+		return warnStyle
+	}
+	return passStyle
+}
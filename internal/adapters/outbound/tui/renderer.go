@@ -59,13 +59,26 @@ var (
 	separatorLine = faintStyle.Render(strings.Repeat("─", 64))
 )
 
+// Translate maps an English report label to the output language.
+type Translate func(label string) string
+
 func RenderScore(score *domain.Score) string {
+	return RenderScoreIn(score, nil)
+}
+
+// RenderScoreIn renders like RenderScore with report labels translated by
+// tr. A nil tr keeps the English labels; issue messages are rendered as
+// they are in score.
+func RenderScoreIn(score *domain.Score, tr Translate) string {
+	if tr == nil {
+		tr = func(label string) string { return label }
+	}
 	var b strings.Builder
 
 	// ── Header ──
 	grade := score.Grade()
 	title := headerStyle.Render("openkraft")
-	subtitle := dimStyle.Render("AI-Readiness Score")
+	subtitle := dimStyle.Render(tr("AI-Readiness Score"))
 	scoreLine := fmt.Sprintf("%d / 100", score.Overall)
 	scoreStyled := lipgloss.NewStyle().
 		Bold(true).
@@ -79,6 +92,21 @@ func RenderScore(score *domain.Score) string {
 	b.WriteString(boxStyle.Render(title + "\n" + subtitle + "\n\n" + scoreStyled + "  " + gradeStyled))
 	b.WriteString("\n\n")
 
+	if c := score.Churn; c != nil {
+		b.WriteString("  " + dimStyle.Render(fmt.Sprintf("code_health penalties weighted by churn: %s window, %d files changed (max %d commits)",
+			c.Window, c.FilesChanged, c.MaxCommits)))
+		b.WriteString("\n\n")
+	}
+
+	if len(score.BuildTags) > 0 {
+		tags := make([]string, len(score.BuildTags))
+		for i, g := range score.BuildTags {
+			tags[i] = fmt.Sprintf("%s (%d)", g.Tag, len(g.Files))
+		}
+		b.WriteString("  " + dimStyle.Render(tr("build-tagged files: ")+strings.Join(tags, ", ")))
+		b.WriteString("\n\n")
+	}
+
 	// ── Categories ──
 	for i, cat := range score.Categories {
 		renderCategoryFull(&b, cat)
@@ -96,32 +124,92 @@ func RenderScore(score *domain.Score) string {
 	if len(issues) > 0 {
 		errorCount, warnCount, infoCount := countSeverities(issues)
 		b.WriteString("  ")
-		b.WriteString(titleStyle.Render("Issues"))
+		b.WriteString(titleStyle.Render(tr("Issues")))
 		b.WriteString("  ")
 		if errorCount > 0 {
-			b.WriteString(errorTagStyle.Render(fmt.Sprintf("%d errors", errorCount)))
+			b.WriteString(errorTagStyle.Render(fmt.Sprintf(tr("%d errors"), errorCount)))
 			b.WriteString("  ")
 		}
 		if warnCount > 0 {
-			b.WriteString(warnTagStyle.Render(fmt.Sprintf("%d warnings", warnCount)))
+			b.WriteString(warnTagStyle.Render(fmt.Sprintf(tr("%d warnings"), warnCount)))
 			b.WriteString("  ")
 		}
 		if infoCount > 0 {
-			b.WriteString(infoTagStyle.Render(fmt.Sprintf("%d info", infoCount)))
+			b.WriteString(infoTagStyle.Render(fmt.Sprintf(tr("%d info"), infoCount)))
 		}
 		b.WriteString("\n\n")
 
-		for _, issue := range issues {
-			renderIssue(&b, issue)
+		for _, f := range domain.GroupFindings(score.Categories) {
+			renderFinding(&b, f, tr)
 		}
-	} else {
-		b.WriteString("  " + passStyle.Render("No issues found.") + "\n")
+	} else if score.Suppressed == nil {
+		b.WriteString("  " + passStyle.Render(tr("No issues found.")) + "\n")
 	}
+	b.WriteString(RenderParseFailures(score.ParseFailures))
+	b.WriteString(RenderSuppressed(score.Suppressed))
+	b.WriteString(RenderBinarySizes(score.Binaries))
+	b.WriteString(RenderDebtNotes(score.DebtNotes))
+	b.WriteString(RenderSuffixSuggestions(score.SuffixSuggestions))
+	b.WriteString(RenderMetadata(score.Metadata))
 
 	b.WriteString("\n")
 	return b.String()
 }
 
+// RenderSuppressed summarizes the issues hidden by --min-severity and
+// --max-issues, or returns "" when nothing was suppressed.
+func RenderSuppressed(s *domain.SuppressedIssues) string {
+	if s == nil || s.Total == 0 {
+		return ""
+	}
+	var parts []string
+	for _, sev := range []string{domain.SeverityError, domain.SeverityWarning, domain.SeverityInfo} {
+		if n := s.BySeverity[sev]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, sev))
+		}
+	}
+	return fmt.Sprintf("\n  %s\n", dimStyle.Render(fmt.Sprintf(
+		"%d issues suppressed (%s): %d below --min-severity, %d over --max-issues caps",
+		s.Total, strings.Join(parts, ", "), s.BelowSeverity, s.OverCap)))
+}
+
+// RenderSuffixSuggestions lists the file suffixes learned from the project
+// that the profile could add to expected_file_suffixes, or returns "" when
+// there are none.
+func RenderSuffixSuggestions(suggestions []domain.SuffixSuggestion) string {
+	if len(suggestions) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n  %s  %s\n", titleStyle.Render("Learned file suffixes"),
+		dimStyle.Render("add to profile.expected_file_suffixes to keep them without learn_file_suffixes"))
+	for _, s := range suggestions {
+		fmt.Fprintf(&b, "    %s %s  %s\n", padRight(s.Suffix, 16),
+			dimStyle.Render(fmt.Sprintf("%d files in %d packages", s.Files, s.Packages)),
+			fileStyle.Render(strings.Join(s.Examples, ", ")))
+	}
+	return b.String()
+}
+
+// RenderParseFailures lists the Go files left out of the analysis because
+// they do not parse, or returns "" when every file parsed.
+func RenderParseFailures(failures []domain.ParseFailure) string {
+	if len(failures) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n  %s  %s\n", titleStyle.Render("Parse failures"),
+		warnTagStyle.Render(fmt.Sprintf("%d files not analyzed", len(failures))))
+	for _, f := range failures {
+		loc := f.File
+		if f.Line > 0 {
+			loc = fmt.Sprintf("%s:%d:%d", f.File, f.Line, f.Column)
+		}
+		fmt.Fprintf(&b, "    %s  %s\n", fileStyle.Render(loc), f.Error)
+	}
+	return b.String()
+}
+
 func renderCategoryFull(b *strings.Builder, cat domain.CategoryScore) {
 	// Category header
 	color := scoreColor(cat.Score)
@@ -150,6 +238,16 @@ func renderSubMetric(b *strings.Builder, sm domain.SubMetric) {
 		return
 	}
 
+	if sm.Points == 0 {
+		fmt.Fprintf(b, "    %s %s %s  %s\n",
+			skipStyle.Render("○"),
+			name,
+			dimStyle.Render("info"),
+			faintStyle.Render(sm.Detail),
+		)
+		return
+	}
+
 	pct := 0
 	if sm.Points > 0 {
 		pct = sm.Score * 100 / sm.Points
@@ -174,7 +272,7 @@ func renderSubMetric(b *strings.Builder, sm domain.SubMetric) {
 	}
 }
 
-func renderIssue(b *strings.Builder, issue domain.Issue) {
+func renderIssue(b *strings.Builder, issue domain.Issue, tr Translate) {
 	tag := severityTag(issue.Severity)
 	file := ""
 	if issue.File != "" {
@@ -187,6 +285,26 @@ func renderIssue(b *strings.Builder, issue domain.Issue) {
 	} else {
 		fmt.Fprintf(b, "    %s %s\n", tag, dimStyle.Render(issue.Message))
 	}
+	if issue.Remediation != "" {
+		fmt.Fprintf(b, "         %s\n", faintStyle.Render(tr("fix: ")+issue.Remediation))
+	}
+}
+
+// renderFinding prints a single-issue finding as a plain issue, and a
+// grouped finding as one file header followed by its child issues.
+func renderFinding(b *strings.Builder, f domain.Finding, tr Translate) {
+	if len(f.Issues) == 1 {
+		renderIssue(b, f.Issues[0], tr)
+		return
+	}
+	fmt.Fprintf(b, "    %s %s  %s\n", severityTag(f.Severity), fileStyle.Render(shortenPath(f.File)),
+		faintStyle.Render(fmt.Sprintf(tr("%d related issues"), len(f.Issues))))
+	for _, issue := range f.Issues {
+		fmt.Fprintf(b, "         %s %s\n", severityTag(issue.Severity), dimStyle.Render(issue.Message))
+		if issue.Remediation != "" {
+			fmt.Fprintf(b, "               %s\n", faintStyle.Render(tr("fix: ")+issue.Remediation))
+		}
+	}
 }
 
 func severityTag(severity string) string {
@@ -316,6 +434,9 @@ func RenderHistory(entries []domain.ScoreEntry) string {
 				line += "  " + failStyle.Render(fmt.Sprintf("↓%d", -diff))
 			}
 		}
+		if e.NewIssues > 0 || e.ResolvedIssues > 0 {
+			line += "  " + dimStyle.Render(fmt.Sprintf("+%d new, -%d resolved issues", e.NewIssues, e.ResolvedIssues))
+		}
 
 		b.WriteString(line)
 		b.WriteString("\n")
@@ -1,6 +1,7 @@
 package tui_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/tui"
@@ -19,7 +20,8 @@ func sampleScore() *domain.Score {
 					{Name: "cognitive_complexity", Score: 5, Points: 20, Detail: "3 complex functions"},
 				},
 				Issues: []domain.Issue{
-					{Severity: "error", Category: "code_health", File: "internal/domain/foo.go", Message: "function too long"},
+					{Severity: "error", Category: "code_health", File: "internal/domain/foo.go", Message: "function too long",
+						Remediation: "split Foo into named helpers"},
 				},
 			},
 			{
@@ -82,6 +84,22 @@ func TestRenderScore_ShowsIssues(t *testing.T) {
 	assert.Contains(t, output, "Issues")
 }
 
+func TestRenderScore_ShowsIssueRemediation(t *testing.T) {
+	output := tui.RenderScore(sampleScore())
+	assert.Contains(t, output, "fix: split Foo into named helpers")
+}
+
+func TestRenderScore_GroupsIssuesInTheSameFile(t *testing.T) {
+	score := sampleScore()
+	score.Categories[0].Issues = append(score.Categories[0].Issues,
+		domain.Issue{Severity: "warning", Category: "code_health", File: "internal/domain/foo.go", Message: "file too long"})
+
+	output := tui.RenderScore(score)
+	assert.Contains(t, output, "2 related issues")
+	assert.Equal(t, 1, strings.Count(output, "internal/domain/foo.go"))
+	assert.Contains(t, output, "file too long")
+}
+
 func TestRenderScore_ShowsIssueSeverityTags(t *testing.T) {
 	output := tui.RenderScore(sampleScore())
 	assert.Contains(t, output, "error")
@@ -117,6 +135,64 @@ func TestRenderScore_IssueSummaryCount(t *testing.T) {
 	assert.Contains(t, output, "1 warnings")
 }
 
+func TestRenderScore_ShowsSuppressedCounts(t *testing.T) {
+	score := domain.FilterIssues(sampleScore(), domain.IssueFilter{MinSeverity: domain.SeverityError})
+	output := tui.RenderScore(score)
+	assert.Contains(t, output, "function too long")
+	assert.NotContains(t, output, "missing test naming conventions")
+	assert.Contains(t, output, "1 issues suppressed (1 warning): 1 below --min-severity")
+}
+
+func TestRenderSuppressed_EmptyWhenNothingSuppressed(t *testing.T) {
+	assert.Empty(t, tui.RenderSuppressed(nil))
+}
+
+func TestRenderScore_ShowsMetadataFooter(t *testing.T) {
+	score := sampleScore()
+	score.Metadata = &domain.ReportMetadata{
+		Tool:        domain.ToolName,
+		ToolVersion: "v1.2.3",
+		ModulePath:  "example.com/app",
+		CommitSHA:   "0123456789abcdef",
+		ProfileHash: "sha256:00112233445566778899",
+		Files:       domain.FileCounts{Total: 20, Analyzed: 17},
+	}
+	output := tui.RenderScore(score)
+	assert.Contains(t, output, "openkraft v1.2.3 · example.com/app @ 0123456 · 20 files (17 analyzed) · profile sha256:001122334455")
+	assert.Empty(t, tui.RenderMetadata(nil))
+}
+
+func TestRenderScore_ShowsDebtNotes(t *testing.T) {
+	score := sampleScore()
+	score.Categories[0].SubMetrics = append(score.Categories[0].SubMetrics,
+		domain.SubMetric{Name: "technical_debt_notes", Detail: "2 TODO, 1 FIXME, 0 HACK in 2 packages"})
+	score.DebtNotes = &domain.DebtInventory{
+		Total:    3,
+		ByKind:   map[string]int{"TODO": 2, "FIXME": 1},
+		Packages: []domain.PackageDebt{{Package: "internal/store", Notes: 2}, {Package: "internal/api", Notes: 1}},
+		Oldest:   []domain.DebtNote{{Kind: "FIXME", File: "internal/store/db.go", Line: 12, Author: "alice", Date: "2021-04-01", Text: "retry on deadlock"}},
+	}
+	output := tui.RenderScore(score)
+	assert.Contains(t, output, "info")
+	assert.Contains(t, output, "2 TODO, 1 FIXME, 0 HACK")
+	assert.Contains(t, output, "internal/store")
+	assert.Contains(t, output, "2021-04-01 alice")
+	assert.Contains(t, output, "FIXME: retry on deadlock")
+	assert.Empty(t, tui.RenderDebtNotes(nil))
+}
+
+func TestRenderScore_ShowsSuffixSuggestions(t *testing.T) {
+	score := sampleScore()
+	score.SuffixSuggestions = []domain.SuffixSuggestion{
+		{Suffix: "_worker", Files: 5, Packages: 3, Examples: []string{"billing/invoice_worker.go"}},
+	}
+	output := tui.RenderScore(score)
+	assert.Contains(t, output, "Learned file suffixes")
+	assert.Contains(t, output, "_worker")
+	assert.Contains(t, output, "5 files in 3 packages")
+	assert.Empty(t, tui.RenderSuffixSuggestions(nil))
+}
+
 func indexOf(s, substr string) int {
 	for i := 0; i <= len(s)-len(substr); i++ {
 		if s[i:i+len(substr)] == substr {
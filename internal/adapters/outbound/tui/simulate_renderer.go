@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// RenderSimulation renders the projected score of a simulation plan: the
+// gain of each edit alone, the running total, and the categories and
+// sub-metrics the plan changes.
+func RenderSimulation(r *domain.SimulationResult) string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString("  " + titleStyle.Render("Simulated refactoring") + "\n")
+	b.WriteString("  " + separatorLine + "\n\n")
+
+	fmt.Fprintf(&b, "  %s %3d → %3d  %s\n\n",
+		catNameStyle.Render(padRight("overall", 20)),
+		r.Baseline, r.Projected, deltaText(r.Delta))
+
+	for i, e := range r.Edits {
+		fmt.Fprintf(&b, "  %d. %s\n", i+1, e.Edit)
+		fmt.Fprintf(&b, "     %s %s  %s\n",
+			dimStyle.Render(fmt.Sprintf("alone %3d", e.Alone)), deltaText(e.Gain),
+			faintStyle.Render(fmt.Sprintf("cumulative %d", e.Cumulative)))
+	}
+	b.WriteString("\n")
+
+	for _, cat := range r.Categories {
+		if cat.Delta == 0 && len(cat.SubMetrics) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "  %s %3d → %3d  %s\n",
+			catNameStyle.Render(padRight(cat.Name, 20)),
+			cat.Before, cat.After, deltaText(cat.Delta))
+		for _, sm := range cat.SubMetrics {
+			fmt.Fprintf(&b, "    %s %s\n", padRight(sm.Name, 30), dimStyle.Render(sm.DetailAfter))
+		}
+	}
+
+	b.WriteString("\n")
+	return b.String()
+}
@@ -0,0 +1,45 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+// RenderSymbols renders symbol search results, one declaration per entry
+// with its location, signature and package role.
+func RenderSymbols(query string, symbols []scoring.Symbol) string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "  %s %s\n", titleStyle.Render(fmt.Sprintf("Symbols matching %q", query)), dimStyle.Render(fmt.Sprintf("(%d)", len(symbols))))
+	b.WriteString("  " + separatorLine + "\n")
+
+	if len(symbols) == 0 {
+		b.WriteString("\n  " + dimStyle.Render("no symbols found") + "\n\n")
+		return b.String()
+	}
+
+	for _, s := range symbols {
+		loc := s.File
+		if s.Line > 0 {
+			loc = fmt.Sprintf("%s:%d", s.File, s.Line)
+		}
+		fmt.Fprintf(&b, "\n  %s %s  %s\n", dimStyle.Render(padRight(s.Kind, 9)), catNameStyle.Render(s.Name), fileStyle.Render(loc))
+		b.WriteString("    " + faintStyle.Render(s.Signature) + "\n")
+		var tags []string
+		if s.Role != "" {
+			tags = append(tags, "role: "+s.Role)
+		}
+		if s.Layer != "" {
+			tags = append(tags, "layer: "+s.Layer)
+		}
+		if len(tags) > 0 {
+			b.WriteString("    " + dimStyle.Render(strings.Join(tags, "  ")) + "\n")
+		}
+	}
+
+	b.WriteString("\n")
+	return b.String()
+}
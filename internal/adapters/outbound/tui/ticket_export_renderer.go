@@ -0,0 +1,44 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// RenderTicketExport renders the outcome of exporting issues to an issue
+// tracker: one line per ticket with its estimated score impact, and the
+// URL of the tickets created or already filed.
+func RenderTicketExport(tickets []domain.ExportedTicket, tracker string) string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString("  " + titleStyle.Render("Export to "+tracker) + "\n")
+	b.WriteString("  " + separatorLine + "\n\n")
+
+	if len(tickets) == 0 {
+		b.WriteString("  " + passStyle.Render("No issue to export.") + "\n\n")
+		return b.String()
+	}
+	counts := map[string]int{}
+	for _, t := range tickets {
+		counts[t.Status]++
+		var tag string
+		switch t.Status {
+		case "created":
+			tag = passStyle.Render(padRight("created", 12))
+		case "exists":
+			tag = dimStyle.Render(padRight("filed", 12))
+		default:
+			tag = warnStyle.Render(padRight("would file", 12))
+		}
+		fmt.Fprintf(&b, "  %s %s  %s\n", tag, dimStyle.Render(fmt.Sprintf("+%.1f", t.Impact)), t.Title)
+		if t.URL != "" {
+			fmt.Fprintf(&b, "  %s %s\n", strings.Repeat(" ", 12), faintStyle.Render(t.URL))
+		}
+	}
+	fmt.Fprintf(&b, "\n  %s\n\n", dimStyle.Render(fmt.Sprintf("%d created, %d already filed, %d to file",
+		counts["created"], counts["exists"], counts["planned"])))
+	return b.String()
+}
@@ -0,0 +1,100 @@
+package typecheck
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// resolveCalls lists the distinct calls in a function body like the
+// parser does, but with the callee taken from the type information: the
+// package of a qualified call comes from the import it resolves to rather
+// than from the import path, and method calls on any named type carry the
+// type. Calls on interfaces and function values stay unresolved.
+func resolveCalls(decl *ast.FuncDecl, pkg *packages.Package, fset *token.FileSet) []domain.Call {
+	if decl.Body == nil {
+		return nil
+	}
+	var calls []domain.Call
+	seen := map[domain.Call]bool{}
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		ce, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		c := resolveCall(ce.Fun, pkg)
+		if c.Name == "" || seen[c] {
+			return true
+		}
+		seen[c] = true
+		c.Line = fset.Position(ce.Pos()).Line
+		calls = append(calls, c)
+		return true
+	})
+	return calls
+}
+
+func resolveCall(fun ast.Expr, pkg *packages.Package) domain.Call {
+	info := pkg.TypesInfo
+	switch fn := ast.Unparen(fun).(type) {
+	case *ast.Ident:
+		return domain.Call{Name: fn.Name, Package: foreignPackage(info.Uses[fn], pkg)}
+	case *ast.IndexExpr: // explicit instantiation: F[T](x)
+		return resolveCall(fn.X, pkg)
+	case *ast.IndexListExpr:
+		return resolveCall(fn.X, pkg)
+	case *ast.SelectorExpr:
+		c := domain.Call{Name: fn.Sel.Name}
+		if _, ok := info.Selections[fn]; !ok {
+			// A qualified identifier: pkg.Func or a conversion to pkg.Type.
+			c.Package = foreignPackage(info.Uses[fn.Sel], pkg)
+			return c
+		}
+		method, ok := info.Uses[fn.Sel].(*types.Func)
+		if !ok {
+			c.Method = true // a field of function type
+			return c
+		}
+		named := receiverNamed(method)
+		if named == nil {
+			c.Method = true // an interface method
+			return c
+		}
+		c.Receiver = named.Obj().Name()
+		c.Package = foreignPackage(named.Obj(), pkg)
+		return c
+	}
+	return domain.Call{}
+}
+
+// foreignPackage returns the import path of obj's package when it differs
+// from pkg, or "" for local and universe objects. Test variants share
+// their package's path, so they count as local.
+func foreignPackage(obj types.Object, pkg *packages.Package) string {
+	if obj == nil || obj.Pkg() == nil || obj.Pkg().Path() == pkg.Types.Path() {
+		return ""
+	}
+	return obj.Pkg().Path()
+}
+
+// receiverNamed returns the named type declaring method, or nil for an
+// interface method.
+func receiverNamed(method *types.Func) *types.Named {
+	recv := method.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return nil
+	}
+	t := recv.Type()
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || types.IsInterface(named) {
+		return nil
+	}
+	return named.Origin()
+}
@@ -0,0 +1,210 @@
+package typecheck
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// loadMode is what the resolver needs from go/packages: syntax and full
+// type information for the project's packages, tests included.
+const loadMode = packages.NeedName | packages.NeedSyntax |
+	packages.NeedTypes | packages.NeedTypesInfo | packages.NeedImports | packages.NeedDeps
+
+// Resolver implements domain.TypeResolver with go/packages. Loading and
+// type-checking every package and its dependencies is several times
+// slower than parsing alone.
+type Resolver struct{}
+
+func New() *Resolver {
+	return &Resolver{}
+}
+
+func (r *Resolver) Resolve(rootPath string, analyzed map[string]*domain.AnalyzedFile) error {
+	cfg := &packages.Config{Mode: loadMode, Dir: rootPath, Tests: true}
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		return fmt.Errorf("loading packages: %w", err)
+	}
+
+	// Resolve each non-test file from its package's plain variant, so that
+	// the types it declares are the ones other packages import.
+	sort.SliceStable(pkgs, func(i, j int) bool { return pkgs[i].ForTest == "" && pkgs[j].ForTest != "" })
+	u := universe{root: rootPath, analyzed: analyzed, seen: make(map[string]bool)}
+	for _, pkg := range pkgs {
+		// A package with errors is still resolved as far as its type
+		// information goes; the parser's results stand for the rest.
+		for _, file := range pkg.Syntax {
+			u.file(pkg, file, pkg.Fset.File(file.Pos()).Name())
+		}
+	}
+	u.matchInterfaces()
+	return nil
+}
+
+// universe collects what the type-checked packages declare. Test variants
+// of a package repeat its files, so each file is resolved once.
+type universe struct {
+	root     string
+	analyzed map[string]*domain.AnalyzedFile
+	seen     map[string]bool
+	ifaces   []declaredInterface
+	named    []declaredType
+}
+
+type declaredInterface struct {
+	def     *domain.InterfaceDef
+	methods map[string]*types.Signature
+}
+
+type declaredType struct {
+	impl    domain.Implementation
+	value   map[string]*types.Signature // method sets of T and *T
+	pointer map[string]*types.Signature
+}
+
+func (u *universe) file(pkg *packages.Package, file *ast.File, filename string) {
+	rel, err := filepath.Rel(u.root, filename)
+	if err != nil {
+		return
+	}
+	rel = filepath.ToSlash(rel)
+	af := u.analyzed[rel]
+	if af == nil || u.seen[rel] {
+		return
+	}
+	u.seen[rel] = true
+	af.Typed = true
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if fn := functionAt(af, pkg.Fset.Position(d.Pos()).Line); fn != nil {
+				fn.Calls = resolveCalls(d, pkg, pkg.Fset)
+			}
+		case *ast.GenDecl:
+			u.types(pkg, d, af, rel)
+		}
+	}
+}
+
+// types records the interfaces and named non-interface types declared in d.
+func (u *universe) types(pkg *packages.Package, d *ast.GenDecl, af *domain.AnalyzedFile, rel string) {
+	if d.Tok != token.TYPE {
+		return
+	}
+	for _, spec := range d.Specs {
+		ts, ok := spec.(*ast.TypeSpec)
+		if !ok {
+			continue
+		}
+		obj, ok := pkg.TypesInfo.Defs[ts.Name].(*types.TypeName)
+		if !ok || obj.IsAlias() {
+			continue
+		}
+		if iface, ok := obj.Type().Underlying().(*types.Interface); ok {
+			for i := range af.InterfaceDefs {
+				if def := &af.InterfaceDefs[i]; def.Name == ts.Name.Name && iface.NumMethods() > 0 {
+					def.Implementations = nil
+					u.ifaces = append(u.ifaces, declaredInterface{def: def, methods: methods(types.NewMethodSet(obj.Type()))})
+				}
+			}
+			continue
+		}
+		u.named = append(u.named, declaredType{
+			impl: domain.Implementation{
+				Type: domain.Qualify(path.Dir(rel), ts.Name.Name),
+				File: rel,
+				Test: strings.HasSuffix(rel, "_test.go"),
+			},
+			value:   methods(types.NewMethodSet(obj.Type())),
+			pointer: methods(types.NewMethodSet(types.NewPointer(obj.Type()))),
+		})
+	}
+}
+
+// matchInterfaces sets the implementations of every interface.
+func (u *universe) matchInterfaces() {
+	sort.Slice(u.named, func(i, j int) bool { return u.named[i].impl.Type < u.named[j].impl.Type })
+	for _, iface := range u.ifaces {
+		for _, t := range u.named {
+			switch {
+			case satisfies(t.value, iface.methods):
+				iface.def.Implementations = append(iface.def.Implementations, t.impl)
+			case satisfies(t.pointer, iface.methods):
+				impl := t.impl
+				impl.Pointer = true
+				iface.def.Implementations = append(iface.def.Implementations, impl)
+			}
+		}
+	}
+}
+
+// satisfies reports whether methods has every required method with an
+// identical signature. Types declared in test files belong to test
+// variants, whose copies of a package's types are not identical to the
+// originals, so signatures that print the same also match.
+func satisfies(methods, required map[string]*types.Signature) bool {
+	for name, want := range required {
+		got, ok := methods[name]
+		if !ok || !types.Identical(got, want) && signature(got) != signature(want) {
+			return false
+		}
+	}
+	return true
+}
+
+func methods(ms *types.MethodSet) map[string]*types.Signature {
+	sigs := make(map[string]*types.Signature, ms.Len())
+	for i := range ms.Len() {
+		obj := ms.At(i).Obj()
+		sigs[obj.Name()] = obj.Type().(*types.Signature)
+	}
+	return sigs
+}
+
+// signature formats sig without parameter names, which do not take part
+// in interface satisfaction, and with types qualified by package path.
+func signature(sig *types.Signature) string {
+	qualifier := types.RelativeTo(nil)
+	var b strings.Builder
+	b.WriteString("func(")
+	writeTuple(&b, sig.Params(), sig.Variadic(), qualifier)
+	b.WriteString(") (")
+	writeTuple(&b, sig.Results(), false, qualifier)
+	b.WriteString(")")
+	return b.String()
+}
+
+func writeTuple(b *strings.Builder, tuple *types.Tuple, variadic bool, qualifier types.Qualifier) {
+	for i := range tuple.Len() {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		t := tuple.At(i).Type()
+		if variadic && i == tuple.Len()-1 {
+			b.WriteString("...")
+			t = t.(*types.Slice).Elem()
+		}
+		b.WriteString(types.TypeString(t, qualifier))
+	}
+}
+
+// functionAt returns the function of af declared at line.
+func functionAt(af *domain.AnalyzedFile, line int) *domain.Function {
+	for i := range af.Functions {
+		if af.Functions[i].LineStart == line {
+			return &af.Functions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package typecheck
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const storeSrc = `package store
+
+import "context"
+
+type Repository interface {
+	Get(ctx context.Context, key string) (string, error)
+}
+
+type base struct{}
+
+func (base) Get(ctx context.Context, key string) (string, error) { return "", nil }
+
+type Memory struct{ base }
+
+type Wrong struct{}
+
+func (*Wrong) Get(key string) string { return "" }
+
+type Disk struct{}
+
+func (d *Disk) Get(ctx context.Context, k string) (value string, err error) { return d.read(k), nil }
+
+func (d *Disk) read(key string) string { return key }
+`
+
+const apiSrc = `package api
+
+import (
+	"context"
+
+	kv "example.com/tc/store"
+)
+
+type Server struct {
+	repo kv.Repository
+	disk *kv.Disk
+}
+
+func (s *Server) Handle(ctx context.Context) {
+	s.repo.Get(ctx, "a")
+	s.disk.Get(ctx, "b")
+	_ = string("c")
+}
+`
+
+func writeModule(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		"go.mod":         "module example.com/tc\n\ngo 1.21\n",
+		"store/store.go": storeSrc,
+		"api/api.go":     apiSrc,
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(src), 0644))
+	}
+	return dir
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	dir := writeModule(t)
+	analyzed := map[string]*domain.AnalyzedFile{
+		"store/store.go": {
+			Path:          "store/store.go",
+			InterfaceDefs: []domain.InterfaceDef{{Name: "Repository", Methods: []string{"Get"}}},
+			Functions: []domain.Function{
+				{Name: "Get", Receiver: "*Disk", LineStart: 21},
+			},
+		},
+		"api/api.go": {
+			Path:      "api/api.go",
+			Functions: []domain.Function{{Name: "Handle", Receiver: "*Server", LineStart: 14}},
+		},
+	}
+
+	require.NoError(t, New().Resolve(dir, analyzed))
+
+	assert.True(t, analyzed["store/store.go"].Typed)
+	assert.Equal(t, []domain.Implementation{
+		{Type: "store.Disk", File: "store/store.go", Pointer: true},
+		{Type: "store.Memory", File: "store/store.go"},
+		{Type: "store.base", File: "store/store.go"},
+	}, analyzed["store/store.go"].InterfaceDefs[0].Implementations, "Wrong has a Get method with another signature")
+
+	assert.Equal(t, []domain.Call{{Name: "read", Receiver: "Disk", Line: 21}}, analyzed["store/store.go"].Functions[0].Calls)
+	assert.Equal(t, []domain.Call{
+		{Name: "Get", Method: true, Line: 15},
+		{Name: "Get", Package: "example.com/tc/store", Receiver: "Disk", Line: 16},
+		{Name: "string", Line: 17},
+	}, analyzed["api/api.go"].Functions[0].Calls)
+}
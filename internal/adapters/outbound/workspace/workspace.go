@@ -0,0 +1,229 @@
+// Package workspace materializes projects that are not checked out on disk
+// (archives, streamed file lists) into temporary directories for scoring.
+package workspace
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxFileSize bounds a single extracted file so that a hostile archive
+// cannot fill the disk through one entry.
+const maxFileSize = 256 << 20
+
+// ExtractFile extracts the .tar, .tar.gz/.tgz or .zip archive at path, or
+// the archive on stdin when path is "-". See Extract.
+func ExtractFile(path string, stdin io.Reader) (string, func() error, error) {
+	if path == "-" {
+		return Extract(stdin)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return "", nil, fmt.Errorf("opening archive: %w", err)
+	}
+	defer f.Close()
+	return Extract(f)
+}
+
+// Extract unpacks an archive into a temporary directory and returns the
+// project root inside it: the single top-level directory when the archive
+// has one (as GitHub and `git archive --prefix` tarballs do), otherwise the
+// directory itself. The format is detected from the content. Entries that
+// would escape the directory and non-regular files are skipped. The caller
+// must invoke cleanup.
+func Extract(r io.Reader) (string, func() error, error) {
+	dir, err := os.MkdirTemp("", "openkraft-archive-*")
+	if err != nil {
+		return "", nil, fmt.Errorf("creating archive dir: %w", err)
+	}
+	cleanup := func() error { return os.RemoveAll(dir) }
+
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(4)
+	switch {
+	case bytes.HasPrefix(magic, []byte("PK\x03\x04")):
+		err = extractZip(br, dir)
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		var gz *gzip.Reader
+		if gz, err = gzip.NewReader(br); err == nil {
+			err = extractTar(gz, dir)
+		}
+	default:
+		err = extractTar(br, dir)
+	}
+	if err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("extracting archive: %w", err)
+	}
+	return projectRoot(dir), cleanup, nil
+}
+
+func extractTar(r io.Reader, dir string) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := writeEntry(dir, hdr.Name, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// extractZip buffers the archive in a temporary file, since zip needs
+// random access and the input may be a pipe.
+func extractZip(r io.Reader, dir string) error {
+	tmp, err := os.CreateTemp("", "openkraft-archive-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		return err
+	}
+
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return err
+	}
+	for _, zf := range zr.File {
+		if !zf.Mode().IsRegular() {
+			continue
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			return err
+		}
+		err = writeEntry(dir, zf.Name, rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeEntry writes one archive member below dir, skipping names that are
+// absolute or climb out of it.
+func writeEntry(dir, name string, r io.Reader) error {
+	target, ok := within(dir, name)
+	if !ok {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(f, io.LimitReader(r, maxFileSize+1))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil && n > maxFileSize {
+		err = fmt.Errorf("%s exceeds %d bytes", name, maxFileSize)
+	}
+	return err
+}
+
+// within resolves name below dir, rejecting absolute paths and "..".
+func within(dir, name string) (string, bool) {
+	name = filepath.FromSlash(strings.ReplaceAll(name, "\\", "/"))
+	if filepath.IsAbs(name) || filepath.VolumeName(name) != "" {
+		return "", false
+	}
+	clean := filepath.Clean(name)
+	if clean == "." || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.Join(dir, clean), true
+}
+
+// projectRoot returns dir's only entry when it is a directory, else dir.
+func projectRoot(dir string) string {
+	entries, err := os.ReadDir(dir)
+	if err != nil || len(entries) != 1 || !entries[0].IsDir() {
+		return dir
+	}
+	return filepath.Join(dir, entries[0].Name())
+}
+
+// Stage copies the files listed in r (one path per line, relative to root
+// or absolute below it) into a temporary directory, preserving their paths
+// relative to root, and returns it. Blank lines and lines starting with #
+// are ignored. The caller must invoke cleanup.
+func Stage(root string, r io.Reader) (string, func() error, error) {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return "", nil, fmt.Errorf("resolving root: %w", err)
+	}
+	dir, err := os.MkdirTemp("", "openkraft-files-*")
+	if err != nil {
+		return "", nil, fmt.Errorf("creating staging dir: %w", err)
+	}
+	cleanup := func() error { return os.RemoveAll(dir) }
+
+	staged := 0
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := stageFile(absRoot, dir, line); err != nil {
+			cleanup()
+			return "", nil, err
+		}
+		staged++
+	}
+	if err := sc.Err(); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("reading file list: %w", err)
+	}
+	if staged == 0 {
+		cleanup()
+		return "", nil, fmt.Errorf("file list is empty")
+	}
+	return dir, cleanup, nil
+}
+
+func stageFile(root, dir, file string) error {
+	src := file
+	if !filepath.IsAbs(src) {
+		src = filepath.Join(root, src)
+	}
+	rel, err := filepath.Rel(root, src)
+	if err != nil {
+		return fmt.Errorf("staging %s: %w", file, err)
+	}
+	if _, ok := within(dir, rel); !ok {
+		return fmt.Errorf("staging %s: file is outside %s", file, root)
+	}
+	f, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("staging %s: %w", file, err)
+	}
+	defer f.Close()
+	if err := writeEntry(dir, rel, f); err != nil {
+		return fmt.Errorf("staging %s: %w", file, err)
+	}
+	return nil
+}
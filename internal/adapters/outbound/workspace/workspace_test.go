@@ -0,0 +1,118 @@
+package workspace_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/workspace"
+)
+
+func tarGz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, body := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(body)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, body := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name    string
+		archive func(*testing.T, map[string]string) []byte
+		files   map[string]string
+		want    string // path of main.go relative to the returned root
+	}{
+		{name: "tar.gz with top-level dir", archive: tarGz, files: map[string]string{"repo-v1/go.mod": "module x", "repo-v1/main.go": "package main"}, want: "main.go"},
+		{name: "flat zip", archive: zipArchive, files: map[string]string{"go.mod": "module x", "cmd/main.go": "package main"}, want: "cmd/main.go"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, cleanup, err := workspace.Extract(bytes.NewReader(tt.archive(t, tt.files)))
+			require.NoError(t, err)
+
+			data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(tt.want)))
+			require.NoError(t, err)
+			assert.Equal(t, "package main", string(data))
+
+			require.NoError(t, cleanup())
+			assert.NoDirExists(t, dir)
+		})
+	}
+}
+
+func TestExtract_SkipsEntriesEscapingTheDirectory(t *testing.T) {
+	archive := tarGz(t, map[string]string{"../evil.go": "package evil", "/abs.go": "package abs", "ok.go": "package ok"})
+	dir, cleanup, err := workspace.Extract(bytes.NewReader(archive))
+	require.NoError(t, err)
+	defer cleanup()
+
+	assert.FileExists(t, filepath.Join(dir, "ok.go"))
+	assert.NoFileExists(t, filepath.Join(filepath.Dir(dir), "evil.go"))
+	assert.NoFileExists(t, filepath.Join(dir, "abs.go"))
+}
+
+func TestStage(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "pkg"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module x"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "pkg", "a.go"), []byte("package pkg"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "pkg", "b.go"), []byte("package pkg"), 0644))
+
+	dir, cleanup, err := workspace.Stage(root, strings.NewReader("go.mod\n\n# comment\npkg/a.go\n"))
+	require.NoError(t, err)
+	defer cleanup()
+
+	assert.FileExists(t, filepath.Join(dir, "go.mod"))
+	assert.FileExists(t, filepath.Join(dir, "pkg", "a.go"))
+	assert.NoFileExists(t, filepath.Join(dir, "pkg", "b.go"), "unlisted files are not staged")
+}
+
+func TestStage_Errors(t *testing.T) {
+	root := t.TempDir()
+	tests := []struct {
+		name string
+		list string
+		want string
+	}{
+		{name: "empty list", list: "\n# nothing\n", want: "file list is empty"},
+		{name: "missing file", list: "nope.go\n", want: "nope.go"},
+		{name: "outside root", list: "../other.go\n", want: "outside"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := workspace.Stage(root, strings.NewReader(tt.list))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package application
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// VerifyDeterminism scores the project twice and fails if the two results
+// differ in anything but the timestamps and self-profile, so CI can assert
+// that score diffs between runs reflect code changes only.
+func (s *ScoreService) VerifyDeterminism(projectPath string, opts ...ScoreOption) error {
+	var runs [2][]byte
+	for i := range runs {
+		score, err := s.ScoreProject(projectPath, opts...)
+		if err != nil {
+			return fmt.Errorf("scoring run %d: %w", i+1, err)
+		}
+		out, err := canonicalScore(score)
+		if err != nil {
+			return err
+		}
+		runs[i] = out
+	}
+	if line, first, second, ok := firstDifference(runs[0], runs[1]); ok {
+		return fmt.Errorf("score is not deterministic: line %d differs between runs:\n  run 1: %s\n  run 2: %s", line, first, second)
+	}
+	return nil
+}
+
+// canonicalScore encodes the parts of a score that must be reproducible.
+func canonicalScore(score *domain.Score) ([]byte, error) {
+	c := *score
+	c.Timestamp = time.Time{}
+	c.SelfProfile = nil
+	if c.Metadata != nil {
+		meta := *c.Metadata
+		meta.Timestamp = time.Time{}
+		c.Metadata = &meta
+	}
+	out, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encoding score: %w", err)
+	}
+	return out, nil
+}
+
+// firstDifference returns the first line (1-based) at which a and b differ.
+func firstDifference(a, b []byte) (int, string, string, bool) {
+	la, lb := bytes.Split(a, []byte("\n")), bytes.Split(b, []byte("\n"))
+	for i := 0; i < max(len(la), len(lb)); i++ {
+		var x, y []byte
+		if i < len(la) {
+			x = la[i]
+		}
+		if i < len(lb) {
+			y = lb[i]
+		}
+		if !bytes.Equal(x, y) {
+			return i + 1, string(bytes.TrimSpace(x)), string(bytes.TrimSpace(y)), true
+		}
+	}
+	return 0, "", "", false
+}
@@ -0,0 +1,100 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+// EditSession holds a project's cached state in memory for the length of an
+// editor session, so that each edit re-analyzes and rescores only the edited
+// file instead of reloading the cache and rescoring the whole project.
+type EditSession struct {
+	validate    *ValidateService
+	projectPath string
+	cfg         domain.ProjectConfig
+	profile     domain.ScoringProfile
+	cached      *domain.ProjectCache
+	modules     []domain.DetectedModule
+	// project is the score of the project when the session was opened; it
+	// supplies the findings that compare a file with the rest of the project.
+	project *domain.Score
+}
+
+// OpenSession loads the project's cache, building it when it is missing or
+// invalidated, and keeps it for the edits that follow.
+func (s *ValidateService) OpenSession(projectPath string) (*EditSession, error) {
+	cfg, cached, err := s.loadCache(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	profile := BuildProfile(cfg)
+
+	modules := cached.Modules
+	if modules == nil {
+		if modules, err = s.detector.Detect(cached.ScanResult); err != nil {
+			return nil, fmt.Errorf("detecting modules: %w", err)
+		}
+	}
+	project := cached.BaselineScore
+	if project == nil {
+		project = s.scoreService.ScoreWithData(cfg, profile, cached.ScanResult, modules, cached.AnalyzedFiles)
+	}
+	if cached.AnalyzedFiles == nil {
+		cached.AnalyzedFiles = make(map[string]*domain.AnalyzedFile)
+	}
+	return &EditSession{
+		validate: s, projectPath: projectPath, cfg: cfg, profile: profile,
+		cached: cached, modules: modules, project: project,
+	}, nil
+}
+
+// FileIssues re-analyzes relPath and returns the scoring issues located in
+// it. Only the file is scored: issues of file-local sub-metrics, such as
+// function size, come from that score, and the file's other issues, such as
+// duplication, from the project as it was when the session opened. When src
+// is nil the saved file is read from disk and its analysis replaces the
+// session's; otherwise src is an unsaved editor buffer and the session is
+// left untouched. The cache on disk is never written.
+func (e *EditSession) FileIssues(relPath string, src []byte) ([]domain.Issue, error) {
+	af, err := e.validate.analyzeFile(e.projectPath, relPath, src)
+	if err != nil {
+		return nil, err
+	}
+
+	scan, modules := e.cached.ScanResult, e.modules
+	_, known := e.cached.AnalyzedFiles[relPath]
+	if !known {
+		// A new file may change which modules the project has.
+		scan = cloneScan(scan)
+		scan.AddFile(relPath)
+		if modules, err = e.validate.detector.Detect(scan); err != nil {
+			return nil, fmt.Errorf("detecting modules: %w", err)
+		}
+	}
+	if src == nil {
+		e.cached.AnalyzedFiles[relPath] = af
+		e.cached.ScanResult, e.modules = scan, modules
+	}
+
+	analyzed := map[string]*domain.AnalyzedFile{relPath: af}
+	score := e.validate.scoreService.ScoreWithData(e.cfg, e.profile, scan, modules, analyzed)
+
+	var issues []domain.Issue
+	for _, cat := range score.Categories {
+		for _, issue := range cat.Issues {
+			if issue.File == relPath && scoring.FileLocal(issue.SubMetric) {
+				issues = append(issues, issue)
+			}
+		}
+	}
+	for _, cat := range e.project.Categories {
+		for _, issue := range cat.Issues {
+			if issue.File == relPath && !scoring.FileLocal(issue.SubMetric) {
+				issues = append(issues, issue)
+			}
+		}
+	}
+	return issues, nil
+}
@@ -0,0 +1,42 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+// PlanHexagonal proposes the package moves that give the project a
+// hexagonal layout and projects its score after them: the moves are
+// applied to the analyzed project, its modules re-detected from the moved
+// files, and the result scored without touching any file.
+func (s *ScoreService) PlanHexagonal(projectPath string) (*scoring.HexagonalPlan, error) {
+	data, err := s.AnalyzeProject(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	modulePath := data.Scan.ModulePath
+	if modulePath == "" {
+		return nil, fmt.Errorf("planning a hexagonal layout needs a go.mod module path")
+	}
+
+	plan := scoring.PlanHexagonal(modulePath, &data.Profile, data.Analyzed)
+	base := domain.ProjectSnapshot{Scan: data.Scan, Modules: data.Modules, Analyzed: data.Analyzed}
+	moved := base.Clone()
+	for _, m := range plan.Moves {
+		if err := moved.MovePackage(modulePath, domain.MovePackageEdit{From: m.From, To: m.To}); err != nil {
+			return nil, fmt.Errorf("moving %s: %w", m.From, err)
+		}
+	}
+	if moved.Modules, err = s.detector.Detect(moved.Scan); err != nil {
+		return nil, fmt.Errorf("detecting modules: %w", err)
+	}
+
+	baseline := s.ScoreWithData(data.Config, data.Profile, base.Scan, base.Modules, base.Analyzed)
+	projected := s.ScoreWithData(data.Config, data.Profile, moved.Scan, moved.Modules, moved.Analyzed)
+	plan.Baseline, plan.Projected = baseline.Overall, projected.Overall
+	plan.Delta = projected.Overall - baseline.Overall
+	plan.Categories = domain.CompareScores(baseline, projected).Categories
+	return plan, nil
+}
@@ -0,0 +1,62 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+// lowMemoryRun reduces each file as soon as it is parsed, so low-memory
+// runs never hold every file's tokens at once: the duplication tokens move
+// into a CloneIndex, and the file-local sub-metrics are tallied so the
+// data only they read can be released. A nil run leaves files in place.
+type lowMemoryRun struct {
+	index   domain.CloneIndex
+	profile *domain.ScoringProfile
+	window  int
+	tokens  map[string]int // token count per indexed file
+	tallies map[string]domain.FileTally
+}
+
+func newLowMemoryRun(index domain.CloneIndex, profile *domain.ScoringProfile) *lowMemoryRun {
+	return &lowMemoryRun{
+		index: index, profile: profile, window: scoring.CloneWindow(profile),
+		tokens: make(map[string]int), tallies: make(map[string]domain.FileTally),
+	}
+}
+
+// add indexes the tokens of af, tallies its file-local sub-metrics and
+// releases the data behind both.
+func (r *lowMemoryRun) add(af *domain.AnalyzedFile) error {
+	if r == nil {
+		return nil
+	}
+	if tokens, _ := scoring.DuplicationTokens(r.profile, af); scoring.CloneEligible(af, tokens, r.window) {
+		if err := r.index.Add(af.Path, scoring.WindowHashes(tokens, r.window)); err != nil {
+			return fmt.Errorf("indexing %s: %w", af.Path, err)
+		}
+		r.tokens[af.Path] = len(tokens)
+	}
+	af.NormalizedTokens, af.TokenLines, af.TokenValues, af.TestTableTokens = nil, nil, nil, nil
+
+	r.tallies[af.Path] = scoring.TallyFile(r.profile, af)
+	scoring.ReleaseTallied(af)
+	return nil
+}
+
+// duplicatedLines resolves the index into duplicated lines per indexed file,
+// ignoring clones between build variants as the in-memory scorer does.
+func (r *lowMemoryRun) duplicatedLines(analyzed map[string]*domain.AnalyzedFile) (map[string]int, error) {
+	positions, err := r.index.DuplicatePositions(func(a, b string) bool {
+		return !scoring.BuildVariants(analyzed[a], analyzed[b])
+	})
+	if err != nil {
+		return nil, err
+	}
+	lines := make(map[string]int, len(r.tokens))
+	for path, n := range r.tokens {
+		lines[path] = scoring.DuplicatedLines(positions[path], n, analyzed[path].TotalLines, r.window)
+	}
+	return lines, nil
+}
@@ -0,0 +1,23 @@
+package application
+
+import "github.com/abdidvp/openkraft/internal/domain"
+
+// FileMetrics scores the project and returns the size, complexity,
+// duplication and issue count of every Go file.
+func (s *ScoreService) FileMetrics(projectPath string) ([]domain.FileMetrics, error) {
+	score, err := s.ScoreProject(projectPath, WithFileMetrics())
+	if err != nil {
+		return nil, err
+	}
+	return score.FileMetrics, nil
+}
+
+// FunctionMetrics analyzes the project and returns the raw metrics of every
+// function. Nothing is scored.
+func (s *ScoreService) FunctionMetrics(projectPath string) ([]domain.FunctionMetrics, error) {
+	data, err := s.AnalyzeProject(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	return domain.BuildFunctionMetrics(data.Analyzed), nil
+}
@@ -141,3 +141,102 @@ func TestBuildProfile_TypePlusOverride(t *testing.T) {
 	assert.Equal(t, 40, p.MaxFunctionLines)
 	assert.Equal(t, []string{"pkg"}, p.ExpectedDirs)
 }
+
+func TestBuildProfile_WordListOverridesEditDefaults(t *testing.T) {
+	cfg := domain.ProjectConfig{
+		Profile: &domain.ProfileOverrides{
+			VaguePackageNames: &domain.WordListOverride{Add: []string{"stuff"}, Remove: []string{"Types"}},
+			DomainWords:       &domain.WordListOverride{Add: []string{"Handler", "Zahlung"}},
+			Acronyms:          &domain.WordListOverride{Add: []string{"K8S"}, Remove: []string{"VM"}},
+		},
+	}
+	p := application.BuildProfile(cfg)
+
+	assert.Contains(t, p.VaguePackageNames, "stuff")
+	assert.Contains(t, p.VaguePackageNames, "util")
+	assert.NotContains(t, p.VaguePackageNames, "types")
+	assert.Equal(t, []string{"Handler", "Zahlung"}, p.DomainWords)
+	assert.Contains(t, p.Acronyms, "K8S")
+	assert.NotContains(t, p.Acronyms, "VM")
+	// Lists without overrides keep their defaults.
+	assert.Equal(t, domain.DefaultProfile().GenericWords, p.GenericWords)
+}
+
+func TestBuildProfile_PenaltyModelPresetThenOverrides(t *testing.T) {
+	scale := 200.0
+	info := 0.0
+	cfg := domain.ProjectConfig{
+		PenaltyModel: domain.PenaltyModelLenient,
+		Profile:      &domain.ProfileOverrides{PenaltyScale: &scale, InfoWeight: &info},
+	}
+	p := application.BuildProfile(cfg)
+
+	lenient := domain.PenaltyModelPreset(domain.PenaltyModelLenient)
+	assert.Equal(t, 200.0, p.Penalty.Scale)
+	assert.Equal(t, 0.0, p.Penalty.InfoWeight)
+	assert.Equal(t, lenient.DecayK, p.Penalty.DecayK)
+	assert.Equal(t, lenient.ErrorWeight, p.Penalty.ErrorWeight)
+
+	assert.Equal(t, domain.PenaltyModelPreset(domain.PenaltyModelSonar), application.BuildProfile(domain.ProjectConfig{}).Penalty)
+}
+
+func TestBuildProfile_InterfaceNamingOptOut(t *testing.T) {
+	assert.True(t, application.BuildProfile(domain.ProjectConfig{}).InterfaceNaming)
+
+	off := false
+	cfg := domain.ProjectConfig{Profile: &domain.ProfileOverrides{InterfaceNaming: &off}}
+	assert.False(t, application.BuildProfile(cfg).InterfaceNaming)
+}
+
+func TestBuildProfile_DebtNotesSubMetricOptIn(t *testing.T) {
+	assert.False(t, application.BuildProfile(domain.ProjectConfig{}).DebtNotesSubMetric)
+
+	on := true
+	cfg := domain.ProjectConfig{Profile: &domain.ProfileOverrides{DebtNotesSubMetric: &on}}
+	assert.True(t, application.BuildProfile(cfg).DebtNotesSubMetric)
+}
+
+func TestBuildProfile_DuplicationNormalizationOverrides(t *testing.T) {
+	p := application.BuildProfile(domain.ProjectConfig{})
+	assert.False(t, p.DupKeepIdentifiers || p.DupKeepLiterals || p.DupIgnoreImports || p.DupIgnoreTestTables)
+
+	on := true
+	cfg := domain.ProjectConfig{Profile: &domain.ProfileOverrides{
+		DupKeepLiterals: &on, DupIgnoreImports: &on, DupIgnoreTestTables: &on,
+	}}
+	p = application.BuildProfile(cfg)
+	assert.False(t, p.DupKeepIdentifiers)
+	assert.True(t, p.DupKeepLiterals)
+	assert.True(t, p.DupIgnoreImports)
+	assert.True(t, p.DupIgnoreTestTables)
+}
+
+func TestBuildProfile_LearnFileSuffixesOptIn(t *testing.T) {
+	assert.False(t, application.BuildProfile(domain.ProjectConfig{}).LearnFileSuffixes)
+
+	on := true
+	cfg := domain.ProjectConfig{Profile: &domain.ProfileOverrides{LearnFileSuffixes: &on}}
+	assert.True(t, application.BuildProfile(cfg).LearnFileSuffixes)
+}
+
+func TestBuildProfile_StutterExemptPackagesOverride(t *testing.T) {
+	assert.Equal(t, []string{"*pb"}, application.BuildProfile(domain.ProjectConfig{}).StutterExemptPackages)
+
+	cfg := domain.ProjectConfig{Profile: &domain.ProfileOverrides{
+		StutterExemptPackages: &domain.WordListOverride{Add: []string{"*proto"}},
+	}}
+	assert.Equal(t, []string{"*pb", "*proto"}, application.BuildProfile(cfg).StutterExemptPackages)
+}
+
+func TestBuildProfile_PackageLayoutOverrides(t *testing.T) {
+	depth, lines, ratio := 5, 50, 0.3
+	cfg := domain.ProjectConfig{Profile: &domain.ProfileOverrides{
+		MaxPackageDepth:     &depth,
+		TinyPackageLines:    &lines,
+		MaxTinyPackageRatio: &ratio,
+	}}
+	p := application.BuildProfile(cfg)
+	assert.Equal(t, 5, p.MaxPackageDepth)
+	assert.Equal(t, 50, p.TinyPackageLines)
+	assert.Equal(t, 0.3, p.MaxTinyPackageRatio)
+}
@@ -0,0 +1,87 @@
+package application
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// ScoreProjectCached is ScoreProject through a result cache: the score of
+// projectPath at commit is read from cache when an earlier run with the
+// same profile and options stored it, and stored there otherwise. The
+// second result reports whether the cache was hit. Callers must only pass
+// the commit of a working tree without local changes.
+func (s *ScoreService) ScoreProjectCached(projectPath, commit string, cache domain.ResultCache, opts ...ScoreOption) (*domain.Score, bool, error) {
+	var o scoreOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	cfg, err := s.configLoader.Load(projectPath)
+	if err != nil {
+		return nil, false, fmt.Errorf("loading config: %w", err)
+	}
+	if o.calibration != "" {
+		cfg.Calibration = o.calibration
+	}
+	if o.penalty != "" {
+		cfg.PenaltyModel = o.penalty
+	}
+	key := domain.ResultCacheKey(commit, domain.ProfileHash(BuildProfile(cfg), cfg), o.cacheSettings()...)
+
+	cached, err := cache.Get(key)
+	if err != nil {
+		return nil, false, fmt.Errorf("reading result cache: %w", err)
+	}
+	if cached != nil {
+		if o.strictParse && len(cached.ParseFailures) > 0 {
+			return nil, false, &domain.ParseFailuresError{Failures: cached.ParseFailures}
+		}
+		cached.GradeBands = GradeBands(cfg)
+		if cached.Metadata != nil {
+			cached.Display = domain.NewPathDisplay(cached.Metadata.ModulePath, cfg.Display)
+		}
+		return cached, true, nil
+	}
+
+	score, _, err := s.scoreProject(projectPath, o)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := cache.Put(key, score); err != nil {
+		return nil, false, fmt.Errorf("writing result cache: %w", err)
+	}
+	return score, false, nil
+}
+
+// cacheSettings lists the options that change a score beyond its profile,
+// as part of its result-cache key. Options that only change how the score
+// is computed or stamped, such as low-memory mode, are left out.
+func (o scoreOptions) cacheSettings() []string {
+	settings := []string{
+		"excludes=" + digest(o.excludes),
+		"typed=" + strconv.FormatBool(o.resolver != nil),
+		"binary-size=" + strconv.FormatBool(o.sizer != nil),
+		"deprecated-deps=" + strconv.FormatBool(o.deprecations != nil),
+		"owners=" + strconv.FormatBool(o.owners != nil), // CODEOWNERS is part of the commit
+		"cpu-profile=" + digest(o.cpuProfile),
+		"churn=" + o.churnWindow + ":" + digest(o.churn),
+	}
+	if o.fileMetrics {
+		settings = append(settings, "file-metrics=true") // only when set, so existing keys still hit
+	}
+	if o.provenance != nil {
+		settings = append(settings, "version="+o.provenance.version, "tool-commit="+o.provenance.commit)
+	}
+	return settings
+}
+
+// digest returns a short hash of v's JSON encoding.
+func digest(v any) string {
+	data, _ := json.Marshal(v)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
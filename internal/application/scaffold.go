@@ -0,0 +1,105 @@
+package application
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/golden"
+)
+
+// canonicalLayers are the layers a scaffolded module has.
+var canonicalLayers = []string{"domain", "application", "adapters"}
+
+// ScaffoldModule generates the skeleton of a new module named name,
+// following the conventions detected in the project: its layout, file
+// naming, layer directory names and, in per-feature layouts, the file
+// names of its golden module. Nothing is written.
+func (s *ScoreService) ScaffoldModule(projectPath, name, entity string) (*domain.ModuleScaffold, error) {
+	data, err := s.AnalyzeProject(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	if data.Scan.ModulePath == "" {
+		return nil, fmt.Errorf("scaffolding a module needs a go.mod module path")
+	}
+	for _, m := range data.Modules {
+		if m.Name == name {
+			return nil, fmt.Errorf("module %s already exists in %s", name, m.Path)
+		}
+	}
+
+	naming := data.Profile.NamingConvention
+	if naming != "bare" && naming != "suffixed" {
+		naming, _ = detectNamingConvention(data.Scan)
+	}
+	layers, split := detectLayerDirs(data.Scan.GoFiles, data.Profile.LayerAliases)
+	conv := domain.ScaffoldConventions{
+		ModulePath:    data.Scan.ModulePath,
+		Layout:        data.Scan.Layout,
+		Naming:        naming,
+		Layers:        layers,
+		SplitAdapters: split,
+	}
+	if conv.Layout != domain.LayoutCrossCutting {
+		if gm, err := golden.SelectGolden(data.Modules, data.Analyzed); err == nil {
+			conv.Blueprint, _ = golden.ExtractBlueprint(gm.Module, data.Analyzed)
+		}
+	}
+	return golden.ScaffoldModule(name, entity, conv)
+}
+
+// detectLayerDirs returns the directory name used most for each canonical
+// layer under internal/, resolving aliases such as app for application,
+// and whether adapters are split into inbound and outbound.
+func detectLayerDirs(goFiles []string, aliases map[string]string) (map[string]string, bool) {
+	counts := map[string]map[string]int{}
+	split := false
+	for _, f := range goFiles {
+		parts := strings.Split(f, "/")
+		for i, seg := range parts[:len(parts)-1] {
+			layer := aliases[seg]
+			if layer == "" {
+				layer = seg
+			}
+			if !isCanonicalLayer(layer) || !underInternal(parts[:i]) {
+				continue
+			}
+			if counts[layer] == nil {
+				counts[layer] = map[string]int{}
+			}
+			counts[layer][seg]++
+			if layer == "adapters" && i+1 < len(parts)-1 && (parts[i+1] == "inbound" || parts[i+1] == "outbound") {
+				split = true
+			}
+		}
+	}
+
+	layers := map[string]string{}
+	for _, layer := range canonicalLayers {
+		best := layer
+		for seg, n := range counts[layer] {
+			if n > counts[layer][best] || n == counts[layer][best] && seg < best {
+				best = seg
+			}
+		}
+		layers[layer] = best
+	}
+	return layers, split
+}
+
+func isCanonicalLayer(layer string) bool {
+	for _, l := range canonicalLayers {
+		if l == layer {
+			return true
+		}
+	}
+	return false
+}
+
+// underInternal reports whether a path prefix is internal/ or
+// internal/{feature}/, where layer directories sit.
+func underInternal(prefix []string) bool {
+	n := len(prefix)
+	return n >= 1 && prefix[n-1] == "internal" || n >= 2 && prefix[n-2] == "internal"
+}
@@ -2,8 +2,12 @@ package application
 
 import (
 	"fmt"
+	"log/slog"
 	"math"
+	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/abdidvp/openkraft/internal/domain"
@@ -17,19 +21,30 @@ type ScoreService struct {
 	detector     domain.ModuleDetector
 	analyzer     domain.CodeAnalyzer
 	configLoader domain.ConfigLoader
+	languages    map[string]domain.LanguageAnalyzer // by file extension
 }
 
+// NewScoreService wires the scoring pipeline. Optional language analyzers
+// measure non-Go files (e.g. .proto, .sql) alongside the Go code.
 func NewScoreService(
 	scanner domain.ProjectScanner,
 	detector domain.ModuleDetector,
 	analyzer domain.CodeAnalyzer,
 	configLoader domain.ConfigLoader,
+	languages ...domain.LanguageAnalyzer,
 ) *ScoreService {
+	byExt := make(map[string]domain.LanguageAnalyzer)
+	for _, la := range languages {
+		for _, ext := range la.Extensions() {
+			byExt[ext] = la
+		}
+	}
 	return &ScoreService{
 		scanner:      scanner,
 		detector:     detector,
 		analyzer:     analyzer,
 		configLoader: configLoader,
+		languages:    byExt,
 	}
 }
 
@@ -46,12 +61,29 @@ type ProjectData struct {
 
 // AnalyzeProject scans, detects modules, and analyzes files without scoring.
 func (s *ScoreService) AnalyzeProject(projectPath string) (*ProjectData, error) {
+	return s.analyzeProject(projectPath, scoreOptions{})
+}
+
+// analyzeProject is AnalyzeProject honoring run options: extra exclude
+// paths (to keep nested modules out of a parent module) and a calibration
+// preset that overrides the configured one.
+func (s *ScoreService) analyzeProject(projectPath string, o scoreOptions) (*ProjectData, error) {
 	cfg, err := s.configLoader.Load(projectPath)
 	if err != nil {
 		return nil, fmt.Errorf("loading config: %w", err)
 	}
+	if o.calibration != "" {
+		cfg.Calibration = o.calibration
+	}
+	if o.penalty != "" {
+		cfg.PenaltyModel = o.penalty
+	}
 
-	scan, err := s.scanner.Scan(projectPath, cfg.ExcludePaths...)
+	trace := newPhaseTrace()
+	excludes := append(append([]string(nil), cfg.ExcludePaths...), o.excludes...)
+	slog.Debug("loaded config", "project", projectPath, "project_type", cfg.ProjectType,
+		"calibration", cfg.Calibration, "penalty_model", cfg.PenaltyModel, "exclude_paths", excludes)
+	scan, err := s.scanner.Scan(projectPath, excludes...)
 	if err != nil {
 		return nil, fmt.Errorf("scanning project: %w", err)
 	}
@@ -60,19 +92,47 @@ func (s *ScoreService) AnalyzeProject(projectPath string) (*ProjectData, error)
 	if err != nil {
 		return nil, fmt.Errorf("detecting modules: %w", err)
 	}
+	o.timer.mark("scan")
+	trace.done("scan", "project", projectPath, "files", len(scan.AllFiles), "go_files", len(scan.GoFiles), "modules", len(modules))
 
-	analyzed := make(map[string]*domain.AnalyzedFile)
-	for _, f := range scan.GoFiles {
-		absPath := filepath.Join(scan.RootPath, f)
-		af, err := s.analyzer.AnalyzeFile(absPath)
+	profile := BuildProfile(cfg)
+
+	var low *lowMemoryRun
+	if o.newCloneIndex != nil {
+		index, err := o.newCloneIndex()
 		if err != nil {
-			continue
+			return nil, err
 		}
-		af.Path = f
-		analyzed[f] = af
+		defer index.Close()
+		low = newLowMemoryRun(index, &profile)
 	}
 
-	profile := BuildProfile(cfg)
+	analyzed, err := s.analyzeGoFiles(scan, low)
+	if err != nil {
+		return nil, err
+	}
+	if o.strictParse && len(scan.ParseFailures) > 0 {
+		return nil, &domain.ParseFailuresError{Failures: scan.ParseFailures}
+	}
+	if low != nil {
+		if scan.DuplicatedLines, err = low.duplicatedLines(analyzed); err != nil {
+			return nil, fmt.Errorf("resolving clone index: %w", err)
+		}
+		scan.FileTallies = low.tallies
+	}
+	scan.ForeignFiles = s.analyzeForeignFiles(scan)
+	o.timer.mark("parse")
+	trace.done("parse", "parsed", len(analyzed), "failed", len(scan.GoFiles)-len(analyzed),
+		"foreign_files", len(scan.ForeignFiles))
+	if o.resolver != nil {
+		if err := o.resolver.Resolve(scan.RootPath, analyzed); err != nil {
+			return nil, fmt.Errorf("type-checking project: %w", err)
+		}
+		o.timer.mark("typecheck")
+		trace.done("typecheck")
+	}
+	slog.Info("analyzed project", "root", scan.RootPath, "files", len(scan.AllFiles),
+		"go_files", len(scan.GoFiles), "parsed", len(analyzed))
 
 	return &ProjectData{
 		Config:   cfg,
@@ -83,26 +143,443 @@ func (s *ScoreService) AnalyzeProject(projectPath string) (*ProjectData, error)
 	}, nil
 }
 
-func (s *ScoreService) ScoreProject(projectPath string) (*domain.Score, error) {
-	data, err := s.AnalyzeProject(projectPath)
+// analyzeGoFiles parses the scanned Go files, keyed by path relative to
+// the root. Files that do not parse are left out, logged and recorded in
+// scan.ParseFailures. A low-memory run reduces each file once it is parsed.
+func (s *ScoreService) analyzeGoFiles(scan *domain.ScanResult, low *lowMemoryRun) (map[string]*domain.AnalyzedFile, error) {
+	analyzed := make(map[string]*domain.AnalyzedFile)
+	for _, f := range scan.GoFiles {
+		af, err := s.analyzer.AnalyzeFile(filepath.Join(scan.RootPath, f))
+		if err != nil {
+			slog.Info("skipped unparsable file", "file", f, "err", err)
+			scan.ParseFailures = append(scan.ParseFailures, domain.NewParseFailure(f, err))
+			continue
+		}
+		af.Path = f
+		if err := low.add(af); err != nil {
+			return nil, err
+		}
+		analyzed[f] = af
+	}
+	return analyzed, nil
+}
+
+// analyzeForeignFiles runs the language analyzers over the scanned files
+// they handle. Files that cannot be read are skipped, as Go files are.
+func (s *ScoreService) analyzeForeignFiles(scan *domain.ScanResult) []domain.ForeignFile {
+	if len(s.languages) == 0 {
+		return nil
+	}
+	var foreign []domain.ForeignFile
+	for _, f := range scan.AllFiles {
+		la, ok := s.languages[filepath.Ext(f)]
+		if !ok {
+			continue
+		}
+		ff, err := la.AnalyzeFile(filepath.Join(scan.RootPath, f))
+		if err != nil {
+			slog.Debug("skipped unreadable file", "file", f, "language", la.Language(), "err", err)
+			continue
+		}
+		ff.Path = f
+		foreign = append(foreign, *ff)
+	}
+	return foreign
+}
+
+// ScoreOption customizes a single ScoreProject run.
+type ScoreOption func(*scoreOptions)
+
+type scoreOptions struct {
+	churn        map[string]int
+	churnWindow  string
+	cpuProfile   *domain.CPUProfile
+	sizer        domain.BinarySizer
+	deprecations domain.DeprecationFinder
+	resolver     domain.TypeResolver
+	owners       *domain.CodeOwners
+	excludes     []string
+	calibration  string
+	penalty      string
+	selfProfile  bool
+	strictParse  bool
+	fileMetrics  bool
+	timer        *phaseTimer
+	provenance   *provenance
+	// newCloneIndex, when set, selects low-memory mode: tokens are spilled
+	// into a fresh index per project and file-local sub-metrics are tallied
+	// during parsing, instead of kept on every AnalyzedFile.
+	newCloneIndex func() (domain.CloneIndex, error)
+}
+
+// WithChurn weights code_health penalties by per-file git churn (commits per
+// file observed over window) so frequently changed files count more.
+func WithChurn(window string, churn map[string]int) ScoreOption {
+	return func(o *scoreOptions) {
+		o.churnWindow = window
+		o.churn = churn
+	}
+}
+
+// WithCPUProfile raises the severity of code_health issues in functions
+// that are hot in profile.
+func WithCPUProfile(profile *domain.CPUProfile) ScoreOption {
+	return func(o *scoreOptions) {
+		o.cpuProfile = profile
+	}
+}
+
+// WithBinarySizes builds every main package with sizer and reports the
+// binary sizes alongside the score.
+func WithBinarySizes(sizer domain.BinarySizer) ScoreOption {
+	return func(o *scoreOptions) {
+		o.sizer = sizer
+	}
+}
+
+// WithDependencyDeprecations also warns about calls to the deprecated
+// functions of direct dependencies, which finder looks up.
+func WithDependencyDeprecations(finder domain.DeprecationFinder) ScoreOption {
+	return func(o *scoreOptions) {
+		o.deprecations = finder
+	}
+}
+
+// WithTypeResolver type-checks the project with resolver after parsing, so
+// the call graph and interface map use resolved types instead of names.
+func WithTypeResolver(resolver domain.TypeResolver) ScoreOption {
+	return func(o *scoreOptions) {
+		o.resolver = resolver
+	}
+}
+
+// WithOwners attributes every issue to its file's CODEOWNERS owner.
+func WithOwners(owners *domain.CodeOwners) ScoreOption {
+	return func(o *scoreOptions) {
+		o.owners = owners
+	}
+}
+
+// WithCalibration selects a calibration preset (see domain.ValidCalibrations),
+// overriding the calibration set in the project config.
+func WithCalibration(preset string) ScoreOption {
+	return func(o *scoreOptions) {
+		o.calibration = preset
+	}
+}
+
+// WithPenaltyModel selects a penalty model preset (see
+// domain.ValidPenaltyModels), overriding the penalty_model set in the
+// project config.
+func WithPenaltyModel(preset string) ScoreOption {
+	return func(o *scoreOptions) {
+		o.penalty = preset
+	}
+}
+
+// provenance is the tool and run information stamped on report metadata.
+type provenance struct {
+	version string
+	commit  string
+	run     *domain.Provenance
+}
+
+// WithProvenance stamps the openkraft version and commit and the run's
+// provenance on the score's metadata.
+func WithProvenance(version, commit string, run *domain.Provenance) ScoreOption {
+	return func(o *scoreOptions) {
+		o.provenance = &provenance{version: version, commit: commit, run: run}
+	}
+}
+
+// WithSelfProfile records openkraft's own per-phase timings, allocations
+// and file counts on the score.
+func WithSelfProfile() ScoreOption {
+	return func(o *scoreOptions) {
+		o.selfProfile = true
+	}
+}
+
+// WithStrictParse fails the run with a *domain.ParseFailuresError when
+// any Go file cannot be parsed, instead of scoring the files that can.
+func WithStrictParse() ScoreOption {
+	return func(o *scoreOptions) {
+		o.strictParse = true
+	}
+}
+
+// WithFileMetrics reports the lines, functions, highest cognitive
+// complexity and duplication of every Go file alongside the score.
+func WithFileMetrics() ScoreOption {
+	return func(o *scoreOptions) {
+		o.fileMetrics = true
+	}
+}
+
+// WithLowMemory streams duplication tokens into on-disk clone indexes made
+// by newIndex, one per scored project, instead of keeping every file's
+// tokens in memory, and scores the file-local sub-metrics of code_health
+// and security_hints file by file as each is parsed, releasing the
+// security scan data. Scores are identical; intended for very large repos.
+// Functions, imports and literals stay on every AnalyzedFile until scoring
+// ends, since the other categories compare them across files.
+func WithLowMemory(newIndex func() (domain.CloneIndex, error)) ScoreOption {
+	return func(o *scoreOptions) {
+		o.newCloneIndex = newIndex
+	}
+}
+
+func (s *ScoreService) ScoreProject(projectPath string, opts ...ScoreOption) (*domain.Score, error) {
+	var o scoreOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	result, _, err := s.scoreProject(projectPath, o)
+	return result, err
+}
+
+// ScoreProjectData is ScoreProject that also returns the analysis data the
+// score was computed from, for callers that need the import graph as well.
+func (s *ScoreService) ScoreProjectData(projectPath string, opts ...ScoreOption) (*domain.Score, *ProjectData, error) {
+	var o scoreOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return s.scoreProject(projectPath, o)
+}
+
+// scoreProject runs the full pipeline and also returns the analysis data
+// the score was computed from.
+func (s *ScoreService) scoreProject(projectPath string, o scoreOptions) (*domain.Score, *ProjectData, error) {
+	if o.selfProfile {
+		o.timer = newPhaseTimer()
+	}
+	data, err := s.analyzeProject(projectPath, o)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	trace := newPhaseTrace()
+
+	if o.churnWindow != "" {
+		data.Scan.FileChurn = o.churn
+	}
+	data.Scan.CPUProfile = o.cpuProfile
+	if o.deprecations != nil {
+		deprecated, err := o.deprecations.Deprecated(projectPath, dependencyImports(data.Scan, data.Analyzed))
+		if err != nil {
+			return nil, nil, fmt.Errorf("finding deprecated dependency APIs: %w", err)
+		}
+		data.Scan.Deprecations = deprecated
 	}
 
 	result := s.ScoreWithData(data.Config, data.Profile, data.Scan, data.Modules, data.Analyzed)
+	if p := o.provenance; p != nil {
+		result.Metadata.ToolVersion = p.version
+		result.Metadata.ToolCommit = p.commit
+		result.Metadata.Provenance = p.run
+	}
+
+	if o.churnWindow != "" {
+		result.Churn = summarizeChurn(o.churnWindow, o.churn, data.Analyzed)
+	}
+	if o.owners != nil {
+		assignOwners(result.Categories, o.owners)
+	}
+	if o.sizer != nil {
+		binaries, err := o.sizer.Measure(projectPath, mainPackageDirs(data.Analyzed))
+		if err != nil {
+			return nil, nil, fmt.Errorf("measuring binary size: %w", err)
+		}
+		result.Binaries = binaries
+	}
+	if groups := domain.GroupByBuildTag(data.Analyzed); len(groups) > 0 {
+		result.BuildTags = groups
+	}
+	result.DebtNotes = domain.BuildDebtInventory(data.Analyzed)
+	result.Generics = domain.BuildGenericsAdoption(data.Analyzed)
+	result.Exposure = domain.BuildExposureReport(data.Scan.ModulePath, data.Analyzed)
+	if data.Profile.LearnFileSuffixes {
+		result.SuffixSuggestions = scoring.LearnFileSuffixes(&data.Profile, data.Scan.GoFiles, data.Analyzed)
+	}
+	if o.fileMetrics {
+		dupLines := scoring.DuplicatedLinesPerFile(&data.Profile, data.Scan, data.Analyzed)
+		result.FileMetrics = domain.BuildFileMetrics(data.Analyzed, dupLines, result.Categories)
+	}
 
 	// Attach config to output if non-default
 	var appliedCfg *domain.ProjectConfig
 	cfg := data.Config
-	if cfg.ProjectType != "" || len(cfg.Weights) > 0 || len(cfg.Skip.Categories) > 0 || len(cfg.Skip.SubMetrics) > 0 {
+	if cfg.ProjectType != "" || len(cfg.Weights) > 0 || len(cfg.Skip.Categories) > 0 || len(cfg.Skip.SubMetrics) > 0 ||
+		cfg.Calibration != "" || len(cfg.Grades) > 0 || cfg.PenaltyModel != "" {
 		appliedCfg = &cfg
 	}
 	result.AppliedConfig = appliedCfg
 
+	o.timer.mark("score")
+	result.SelfProfile = o.timer.finish(data.Scan, len(data.Analyzed))
+	trace.done("score")
+	slog.Info("scored project", "project", projectPath, "overall", result.Overall)
+
+	return result, data, nil
+}
+
+// ScoreProjects discovers every Go module under root, scores each one on
+// its own config and aggregates the results weighted by file count. Nested
+// modules are excluded from the scan of their parent module, as the go
+// tool does. Directories matching the root config's exclude_paths are not
+// searched for modules, and the aggregate is graded with its grade bands.
+func (s *ScoreService) ScoreProjects(root string, opts ...ScoreOption) (*domain.MonorepoScore, error) {
+	var base scoreOptions
+	for _, opt := range opts {
+		opt(&base)
+	}
+
+	rootCfg, err := s.configLoader.Load(root)
+	if err != nil {
+		return nil, fmt.Errorf("loading config: %w", err)
+	}
+	if base.calibration != "" {
+		rootCfg.Calibration = base.calibration
+	}
+	excludes := append(append([]string(nil), rootCfg.ExcludePaths...), base.excludes...)
+	paths, err := s.scanner.DiscoverProjects(root, excludes...)
+	if err != nil {
+		return nil, fmt.Errorf("discovering projects: %w", err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no Go modules found under %s", root)
+	}
+
+	result := &domain.MonorepoScore{
+		SchemaVersion: domain.ScoreSchemaVersion,
+		Root:          root,
+		Timestamp:     time.Now(),
+		GradeBands:    GradeBands(rootCfg),
+	}
+	for _, rel := range paths {
+		o := base
+		nested := nestedProjects(rel, paths)
+		o.excludes = append(append([]string(nil), base.excludes...), nested...)
+		slog.Info("scoring module", "path", rel, "excluded_nested", nested)
+		score, data, err := s.scoreProject(filepath.Join(root, rel), o)
+		if err != nil {
+			return nil, fmt.Errorf("scoring %s: %w", rel, err)
+		}
+		result.Projects = append(result.Projects, domain.ProjectScore{
+			Path:       rel,
+			ModulePath: data.Scan.ModulePath,
+			Files:      len(data.Analyzed),
+			Score:      score,
+		})
+	}
+
+	result.Overall, result.Categories = domain.AggregateProjects(result.Projects)
+	result.Metadata = monorepoMetadata(result, base.provenance)
 	return result, nil
 }
 
-// ScoreWithData runs all 6 scorers with pre-loaded data. No disk I/O.
+// monorepoMetadata sums the file counts of the projects. Projects may be
+// scored with different configs, so the profile hash is left to each
+// project's own metadata.
+func monorepoMetadata(m *domain.MonorepoScore, p *provenance) *domain.ReportMetadata {
+	meta := &domain.ReportMetadata{Tool: domain.ToolName, Timestamp: m.Timestamp}
+	for _, proj := range m.Projects {
+		if pm := proj.Score.Metadata; pm != nil {
+			meta.Files.Total += pm.Files.Total
+			meta.Files.Go += pm.Files.Go
+			meta.Files.Test += pm.Files.Test
+			meta.Files.Foreign += pm.Files.Foreign
+			meta.Files.Analyzed += pm.Files.Analyzed
+		}
+	}
+	if p != nil {
+		meta.ToolVersion, meta.ToolCommit, meta.Provenance = p.version, p.commit, p.run
+	}
+	return meta
+}
+
+// nestedProjects returns the projects below parent, relative to parent.
+func nestedProjects(parent string, all []string) []string {
+	var nested []string
+	for _, p := range all {
+		switch {
+		case p == parent:
+		case parent == ".":
+			nested = append(nested, p)
+		case strings.HasPrefix(p, parent+"/"):
+			nested = append(nested, strings.TrimPrefix(p, parent+"/"))
+		}
+	}
+	return nested
+}
+
+// mainPackageDirs returns the sorted directories of the non-test main
+// packages in analyzed.
+func mainPackageDirs(analyzed map[string]*domain.AnalyzedFile) []string {
+	var dirs []string
+	for path, af := range analyzed {
+		dir := filepath.Dir(path)
+		if af.Package == "main" && !strings.HasSuffix(path, "_test.go") && !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	slices.Sort(dirs)
+	return dirs
+}
+
+// dependencyImports returns the import paths, in sorted order, of the
+// packages that non-generated files import from direct dependencies.
+func dependencyImports(scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) []string {
+	if scan.GoMod == nil {
+		return nil
+	}
+	direct := scan.GoMod.DirectRequires()
+	var imports []string
+	for _, af := range analyzed {
+		if af.IsGenerated {
+			continue
+		}
+		for _, imp := range af.Imports {
+			if slices.Contains(imports, imp) {
+				continue
+			}
+			for _, r := range direct {
+				if imp == r.Path || strings.HasPrefix(imp, r.Path+"/") {
+					imports = append(imports, imp)
+					break
+				}
+			}
+		}
+	}
+	slices.Sort(imports)
+	return imports
+}
+
+// summarizeChurn reports how much of the analyzed code changed in the window.
+func summarizeChurn(window string, churn map[string]int, analyzed map[string]*domain.AnalyzedFile) *domain.ChurnSummary {
+	summary := &domain.ChurnSummary{Window: window}
+	for path, commits := range churn {
+		if _, ok := analyzed[path]; !ok || commits == 0 {
+			continue
+		}
+		summary.FilesChanged++
+		summary.MaxCommits = max(summary.MaxCommits, commits)
+	}
+	return summary
+}
+
+// assignOwners sets the Owner of every issue that points at a file.
+func assignOwners(categories []domain.CategoryScore, owners *domain.CodeOwners) {
+	for _, cat := range categories {
+		for i := range cat.Issues {
+			if cat.Issues[i].File != "" {
+				cat.Issues[i].Owner = owners.Owner(cat.Issues[i].File)
+			}
+		}
+	}
+}
+
+// ScoreWithData runs all scorers with pre-loaded data. No disk I/O.
 func (s *ScoreService) ScoreWithData(
 	cfg domain.ProjectConfig,
 	profile domain.ScoringProfile,
@@ -117,21 +594,161 @@ func (s *ScoreService) ScoreWithData(
 		scoring.ScoreVerifiability(&profile, scan, analyzed),
 		scoring.ScoreContextQuality(&profile, scan, analyzed),
 		scoring.ScorePredictability(&profile, modules, scan, analyzed),
+		scoring.ScoreSecurityHints(&profile, scan, analyzed),
 	}
 
 	categories = applyConfig(categories, cfg)
+	scoring.AttachRemediations(categories, analyzed)
+	for _, cat := range categories {
+		domain.SortIssues(cat.Issues)
+	}
+	domain.AssignFingerprints(categories)
 	overall := domain.ComputeOverallScore(categories)
+	now := time.Now()
 
 	return &domain.Score{
-		Overall:    overall,
-		Categories: categories,
-		Timestamp:  time.Now(),
+		SchemaVersion: domain.ScoreSchemaVersion,
+		Overall:       overall,
+		Categories:    categories,
+		Timestamp:     now,
+		Metadata:      domain.NewReportMetadata(scan, len(analyzed), profile, cfg, now),
+		Interfaces:    domain.BuildInterfaceMap(analyzed),
+		Findings:      domain.GroupFindings(categories),
+		ParseFailures: scan.ParseFailures,
+		GradeBands:    GradeBands(cfg),
+		Display:       domain.NewPathDisplay(scan.ModulePath, cfg.Display),
+	}
+}
+
+// Compare scores a baseline and a target checkout of a project and diffs
+// the results.
+func (s *ScoreService) Compare(baselinePath, targetPath string) (*domain.Comparison, error) {
+	baseline, err := s.ScoreProject(baselinePath)
+	if err != nil {
+		return nil, fmt.Errorf("scoring baseline: %w", err)
+	}
+	target, err := s.ScoreProject(targetPath)
+	if err != nil {
+		return nil, fmt.Errorf("scoring target: %w", err)
+	}
+	c := domain.CompareScores(baseline, target)
+	return &c, nil
+}
+
+// APIDiff analyzes a baseline and a target checkout of a project and
+// reports how the exported API of its importable packages changed.
+func (s *ScoreService) APIDiff(baselinePath, targetPath string) (*domain.APIDiff, error) {
+	baseline, err := s.AnalyzeProject(baselinePath)
+	if err != nil {
+		return nil, fmt.Errorf("analyzing baseline: %w", err)
+	}
+	target, err := s.AnalyzeProject(targetPath)
+	if err != nil {
+		return nil, fmt.Errorf("analyzing target: %w", err)
+	}
+	return &domain.APIDiff{
+		Changes: domain.DiffAPI(domain.ExtractAPI(baseline.Analyzed), domain.ExtractAPI(target.Analyzed)),
+	}, nil
+}
+
+// Explain scores the project and returns the formula breakdown for one
+// category, reflecting config weights and skipped sub-metrics.
+func (s *ScoreService) Explain(projectPath, category string) (*domain.Explanation, error) {
+	data, err := s.AnalyzeProject(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	if data.Config.IsSkippedCategory(category) {
+		return nil, fmt.Errorf("category %q is skipped by config", category)
+	}
+
+	exp, err := scoring.ExplainCategory(&data.Profile, data.Modules, data.Scan, data.Analyzed, category)
+	if err != nil {
+		return nil, err
+	}
+
+	final := s.ScoreWithData(data.Config, data.Profile, data.Scan, data.Modules, data.Analyzed)
+	for _, cat := range final.Categories {
+		if cat.Name != category {
+			continue
+		}
+		exp.Score = cat.Score
+		exp.Weight = cat.Weight
+		for i, sm := range cat.SubMetrics {
+			if sm.Skipped {
+				exp.SubMetrics[i].Skipped = true
+				exp.Note = "sub-metrics skipped by config; score rescaled to the remaining points without penalty"
+			}
+		}
+	}
+	return exp, nil
+}
+
+// Hotspots scores the project and ranks its files and functions by their
+// negative contribution to the score. churn may be nil.
+func (s *ScoreService) Hotspots(projectPath string, churn map[string]int) (*domain.HotspotReport, error) {
+	data, err := s.AnalyzeProject(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	score := s.ScoreWithData(data.Config, data.Profile, data.Scan, data.Modules, data.Analyzed)
+	var issues []domain.Issue
+	for _, cat := range score.Categories {
+		issues = append(issues, cat.Issues...)
+	}
+
+	report := scoring.RankHotspots(&data.Profile, data.Analyzed, issues, churn)
+	return &report, nil
+}
+
+// Clones analyzes the project and lists its cross-file clones matching q.
+func (s *ScoreService) Clones(projectPath string, q scoring.CloneQuery) ([]scoring.Clone, error) {
+	data, err := s.AnalyzeProject(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	return scoring.FindClones(&data.Profile, data.Analyzed, q), nil
+}
+
+// RenameImpact analyzes the project and lists what renaming the symbol
+// target names would touch, reading Go sources, templates and config for
+// string references.
+func (s *ScoreService) RenameImpact(projectPath, target string) (*scoring.RenameImpact, error) {
+	data, err := s.AnalyzeProject(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	read := func(file string) []byte {
+		src, err := os.ReadFile(filepath.Join(data.Scan.RootPath, file))
+		if err != nil {
+			slog.Debug("skipped unreadable file", "file", file, "err", err)
+		}
+		return src
+	}
+	return scoring.AnalyzeRenameImpact(target, &data.Profile, data.Scan, data.Analyzed, read)
+}
+
+// GradeBands returns the configured grade bands, falling back to the bands
+// of the calibration preset.
+func GradeBands(cfg domain.ProjectConfig) []domain.GradeBand {
+	if len(cfg.Grades) > 0 {
+		return cfg.Grades
 	}
+	return domain.CalibrationGradeBands(cfg.Calibration)
 }
 
-// BuildProfile constructs a ScoringProfile from config defaults and user overrides.
+// BuildProfile constructs a ScoringProfile from config defaults, the
+// calibration preset and user overrides, in that order.
 func BuildProfile(cfg domain.ProjectConfig) domain.ScoringProfile {
 	base := domain.DefaultProfileForType(cfg.ProjectType)
+	domain.CalibrateProfile(&base, cfg.Calibration)
+	base.SeverityRules = cfg.Severity
+	base.NamingRules = cfg.Naming
+	base.Headers = cfg.Headers
+	if cfg.PenaltyModel != "" {
+		base.Penalty = domain.PenaltyModelPreset(cfg.PenaltyModel)
+	}
 	if cfg.Profile == nil {
 		return base
 	}
@@ -149,9 +766,24 @@ func BuildProfile(cfg domain.ProjectConfig) domain.ScoringProfile {
 	if len(p.ExpectedFileSuffixes) > 0 {
 		base.ExpectedFileSuffixes = p.ExpectedFileSuffixes
 	}
+	if p.LearnFileSuffixes != nil {
+		base.LearnFileSuffixes = *p.LearnFileSuffixes
+	}
 	if p.NamingConvention != "" {
 		base.NamingConvention = p.NamingConvention
 	}
+	if p.PreferredLogger != "" {
+		base.PreferredLogger = p.PreferredLogger
+	}
+	if p.InterfaceNaming != nil {
+		base.InterfaceNaming = *p.InterfaceNaming
+	}
+	if p.DebtNotesSubMetric != nil {
+		base.DebtNotesSubMetric = *p.DebtNotesSubMetric
+	}
+	if p.ScanTestSecrets != nil {
+		base.ScanTestSecrets = *p.ScanTestSecrets
+	}
 	if p.MaxFunctionLines != nil {
 		base.MaxFunctionLines = *p.MaxFunctionLines
 	}
@@ -176,21 +808,95 @@ func BuildProfile(cfg domain.ProjectConfig) domain.ScoringProfile {
 	if p.MinCloneTokens != nil {
 		base.MinCloneTokens = *p.MinCloneTokens
 	}
+	if p.DupKeepIdentifiers != nil {
+		base.DupKeepIdentifiers = *p.DupKeepIdentifiers
+	}
+	if p.DupKeepLiterals != nil {
+		base.DupKeepLiterals = *p.DupKeepLiterals
+	}
+	if p.DupIgnoreImports != nil {
+		base.DupIgnoreImports = *p.DupIgnoreImports
+	}
+	if p.DupIgnoreTestTables != nil {
+		base.DupIgnoreTestTables = *p.DupIgnoreTestTables
+	}
+	if p.MinStringLiteralRepeats != nil {
+		base.MinStringLiteralRepeats = *p.MinStringLiteralRepeats
+	}
 	if len(p.ExemptParamPatterns) > 0 {
 		base.ExemptParamPatterns = p.ExemptParamPatterns
 	}
+	if p.MaxFunctionFanOut != nil {
+		base.MaxFunctionFanOut = *p.MaxFunctionFanOut
+	}
+	if p.HubFanIn != nil {
+		base.HubFanIn = *p.HubFanIn
+	}
+	if p.MaxInterfaceMethods != nil {
+		base.MaxInterfaceMethods = *p.MaxInterfaceMethods
+	}
 	if len(p.ContextFiles) > 0 {
 		base.ContextFiles = p.ContextFiles
 	}
 	if p.MinTestRatio != nil {
 		base.MinTestRatio = *p.MinTestRatio
 	}
+	if p.MinPackageCohesion != nil {
+		base.MinPackageCohesion = *p.MinPackageCohesion
+	}
+	if p.MaxPackageDepth != nil {
+		base.MaxPackageDepth = *p.MaxPackageDepth
+	}
+	if p.MaxEmbeddingDepth != nil {
+		base.MaxEmbeddingDepth = *p.MaxEmbeddingDepth
+	}
+	if p.TinyPackageLines != nil {
+		base.TinyPackageLines = *p.TinyPackageLines
+	}
+	if p.MaxTinyPackageRatio != nil {
+		base.MaxTinyPackageRatio = *p.MaxTinyPackageRatio
+	}
+	if p.SmoothSmallSamples != nil {
+		base.SmoothSmallSamples = *p.SmoothSmallSamples
+	}
+	if p.SmoothingWeight != nil {
+		base.SmoothingWeight = *p.SmoothingWeight
+	}
+	if p.SmoothingPrior != nil {
+		base.SmoothingPrior = *p.SmoothingPrior
+	}
+	if p.PenaltyScale != nil {
+		base.Penalty.Scale = *p.PenaltyScale
+	}
+	if p.DecayK != nil {
+		base.Penalty.DecayK = *p.DecayK
+	}
+	if p.ErrorWeight != nil {
+		base.Penalty.ErrorWeight = *p.ErrorWeight
+	}
+	if p.WarningWeight != nil {
+		base.Penalty.WarningWeight = *p.WarningWeight
+	}
+	if p.InfoWeight != nil {
+		base.Penalty.InfoWeight = *p.InfoWeight
+	}
 	if p.MaxGlobalVarPenalty != nil {
 		base.MaxGlobalVarPenalty = *p.MaxGlobalVarPenalty
 	}
+	if p.MaxDirectDependencies != nil {
+		base.MaxDirectDependencies = *p.MaxDirectDependencies
+	}
 	if len(p.CompositionRoots) > 0 {
 		base.CompositionRoots = p.CompositionRoots
 	}
+	base.VaguePackageNames = p.VaguePackageNames.Apply(base.VaguePackageNames)
+	base.StutterExemptPackages = p.StutterExemptPackages.Apply(base.StutterExemptPackages)
+	base.GenericWords = p.GenericWords.Apply(base.GenericWords)
+	base.ActionWords = p.ActionWords.Apply(base.ActionWords)
+	base.DomainWords = p.DomainWords.Apply(base.DomainWords)
+	base.Acronyms = p.Acronyms.Apply(base.Acronyms)
+	base.MagicNumberAllowlist = p.MagicNumberAllowlist.Apply(base.MagicNumberAllowlist)
+	base.SafeSQLBuilders = p.SafeSQLBuilders.Apply(base.SafeSQLBuilders)
 
 	return base
 }
@@ -224,6 +930,9 @@ func filterSubMetrics(cat domain.CategoryScore, cfg domain.ProjectConfig) domain
 	var hasSkipped bool
 
 	for i, sm := range cat.SubMetrics {
+		if sm.Skipped {
+			continue
+		}
 		if cfg.IsSkippedSubMetric(sm.Name) {
 			cat.SubMetrics[i].Skipped = true
 			cat.SubMetrics[i].Score = 0
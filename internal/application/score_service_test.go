@@ -1,15 +1,21 @@
 package application_test
 
 import (
+	"cmp"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/cloneindex"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
 	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
 	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -29,7 +35,304 @@ func TestScoreService_ScoreProject(t *testing.T) {
 
 	assert.True(t, score.Overall > 0, "overall score should be positive")
 	assert.True(t, score.Overall <= 100, "overall score should not exceed 100")
-	assert.Len(t, score.Categories, 6, "should have 6 categories")
+	assert.Len(t, score.Categories, 7, "should have 7 categories")
+}
+
+func TestScoreService_WithChurnSummarizesAnalyzedFiles(t *testing.T) {
+	svc := application.NewScoreService(
+		scanner.New(),
+		detector.New(),
+		parser.New(),
+		config.New(),
+	)
+
+	churn := map[string]int{
+		"cmd/api/main.go":   7,
+		"docs/unrelated.md": 20,
+	}
+	score, err := svc.ScoreProject(fixtureDir, application.WithChurn("30d", churn))
+	require.NoError(t, err)
+
+	require.NotNil(t, score.Churn)
+	assert.Equal(t, "30d", score.Churn.Window)
+	assert.Equal(t, 1, score.Churn.FilesChanged, "only analyzed Go files count")
+	assert.Equal(t, 7, score.Churn.MaxCommits)
+
+	plain, err := svc.ScoreProject(fixtureDir)
+	require.NoError(t, err)
+	assert.Nil(t, plain.Churn)
+}
+
+// recordingSizer is a domain.BinarySizer that records the main package
+// directories it was asked to build.
+type recordingSizer struct{ dirs []string }
+
+func (r *recordingSizer) Measure(_ string, mainDirs []string) ([]domain.BinarySize, error) {
+	r.dirs = mainDirs
+	sizes := make([]domain.BinarySize, len(mainDirs))
+	for i, dir := range mainDirs {
+		sizes[i] = domain.BinarySize{Dir: dir, Bytes: 1 << 20}
+	}
+	return sizes, nil
+}
+
+func TestScoreService_WithBinarySizesBuildsMainPackages(t *testing.T) {
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+	sizer := &recordingSizer{}
+
+	score, err := svc.ScoreProject(fixtureDir, application.WithBinarySizes(sizer))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cmd/api"}, sizer.dirs)
+	require.Len(t, score.Binaries, 1)
+	assert.Equal(t, int64(1<<20), score.Binaries[0].Bytes)
+}
+
+// recordingFinder is a domain.DeprecationFinder that records the import
+// paths it was asked to load and deprecates every function named Old.
+type recordingFinder struct{ imports []string }
+
+func (r *recordingFinder) Deprecated(_ string, importPaths []string) (map[string]string, error) {
+	r.imports = importPaths
+	deprecated := make(map[string]string)
+	for _, p := range importPaths {
+		deprecated[p+".Old"] = "Deprecated: use New."
+	}
+	return deprecated, nil
+}
+
+func TestScoreService_WithDependencyDeprecationsLoadsDirectDependencies(t *testing.T) {
+	root := t.TempDir()
+	write := func(rel, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(root, rel)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, rel), []byte(content), 0644))
+	}
+	write("go.mod", "module example.com/svc\n\ngo 1.24\n\nrequire (\n\tgithub.com/acme/kv v1.0.0\n\tgithub.com/acme/log v1.0.0 // indirect\n)\n")
+	write("main.go", "package main\n\nimport (\n\t\"fmt\"\n\n\t\"github.com/acme/kv/client\"\n\t\"github.com/acme/log\"\n)\n\nfunc main() {\n\tclient.Old()\n\tlog.Old()\n\tfmt.Println()\n}\n")
+
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+	finder := &recordingFinder{}
+	score, err := svc.ScoreProject(root, application.WithDependencyDeprecations(finder))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"github.com/acme/kv/client"}, finder.imports)
+
+	var deprecatedCalls []string
+	for _, cat := range score.Categories {
+		for _, issue := range cat.Issues {
+			if issue.Pattern == "deprecated-call" {
+				deprecatedCalls = append(deprecatedCalls, issue.Message)
+			}
+		}
+	}
+	assert.Equal(t, []string{"main calls client.Old. Deprecated: use New."}, deprecatedCalls)
+}
+
+func TestScoreService_LanguageAnalyzersMeasureForeignFiles(t *testing.T) {
+	root := t.TempDir()
+	write := func(rel, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(root, rel)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, rel), []byte(content), 0644))
+	}
+	write("go.mod", "module example.com/svc\n\ngo 1.24\n")
+	write("main.go", "package main\n\nfunc main() {}\n")
+	write("api/user_service.proto", "syntax = \"proto3\";\n// comment\nmessage User {}\n")
+	write("web/ignored.css", "body {}\n")
+
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New(), parser.LanguageAnalyzers()...)
+	data, err := svc.AnalyzeProject(root)
+	require.NoError(t, err)
+
+	require.Len(t, data.Scan.ForeignFiles, 1)
+	ff := data.Scan.ForeignFiles[0]
+	assert.Equal(t, "api/user_service.proto", ff.Path)
+	assert.Equal(t, "protobuf", ff.Language)
+	assert.Equal(t, 3, ff.TotalLines)
+	assert.Equal(t, 2, ff.CodeLines)
+
+	goOnly := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+	data, err = goOnly.AnalyzeProject(root)
+	require.NoError(t, err)
+	assert.Empty(t, data.Scan.ForeignFiles)
+}
+
+func TestScoreService_WithSelfProfileRecordsPhases(t *testing.T) {
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+
+	score, err := svc.ScoreProject(fixtureDir, application.WithSelfProfile())
+	require.NoError(t, err)
+	require.NotNil(t, score.SelfProfile)
+
+	p := score.SelfProfile
+	require.Len(t, p.Phases, 3)
+	assert.Equal(t, "scan", p.Phases[0].Name)
+	assert.Equal(t, "parse", p.Phases[1].Name)
+	assert.Equal(t, "score", p.Phases[2].Name)
+	assert.Positive(t, p.Files)
+	assert.Equal(t, p.GoFiles, p.AnalyzedFiles)
+	assert.Positive(t, p.Allocs)
+
+	plain, err := svc.ScoreProject(fixtureDir)
+	require.NoError(t, err)
+	assert.Nil(t, plain.SelfProfile)
+}
+
+func TestScoreService_WithLowMemoryMatchesInMemoryScore(t *testing.T) {
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+
+	inMemory, err := svc.ScoreProject(fixtureDir)
+	require.NoError(t, err)
+
+	var indexes int
+	lowMemory, err := svc.ScoreProject(fixtureDir, application.WithLowMemory(func() (domain.CloneIndex, error) {
+		indexes++
+		return cloneindex.New()
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, indexes)
+	assert.Equal(t, inMemory.Overall, lowMemory.Overall)
+	assert.Equal(t, inMemory.Categories[0].SubMetrics, lowMemory.Categories[0].SubMetrics)
+}
+
+func TestScoreService_WithLowMemoryTalliesFileLocalSubMetrics(t *testing.T) {
+	root := t.TempDir()
+	write := func(rel, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(root, rel), []byte(content), 0644))
+	}
+	write("go.mod", "module example.com/store\n\ngo 1.24\n")
+	write("store.go", `package store
+
+import (
+	"database/sql"
+	"os"
+)
+
+var dbPassword = "hunter2-correct-horse"
+
+func Find(db *sql.DB, name string) (*sql.Rows, error) {
+	return db.Query("SELECT * FROM users WHERE name = '" + name + "'")
+}
+
+func Save(data []byte) error {
+	return os.WriteFile("out.txt", data, 0o666)
+}
+`)
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+
+	inMemory, _, err := svc.ScoreProjectData(root)
+	require.NoError(t, err)
+	lowMemory, data, err := svc.ScoreProjectData(root,
+		application.WithLowMemory(func() (domain.CloneIndex, error) { return cloneindex.New() }))
+	require.NoError(t, err)
+
+	security := inMemory.Categories[len(inMemory.Categories)-1]
+	require.Equal(t, "security_hints", security.Name)
+	require.Len(t, security.Issues, 3, "one secret, one query and one file mode")
+	for i := range inMemory.Categories {
+		assert.Equal(t, inMemory.Categories[i].SubMetrics, lowMemory.Categories[i].SubMetrics, inMemory.Categories[i].Name)
+		assert.Equal(t, inMemory.Categories[i].Issues, lowMemory.Categories[i].Issues, inMemory.Categories[i].Name)
+	}
+
+	af := data.Analyzed["store.go"]
+	require.NotNil(t, af)
+	assert.Nil(t, af.SecretCandidates, "released once tallied")
+	assert.Nil(t, af.SQLCalls)
+	assert.Nil(t, af.FileModes)
+	assert.Contains(t, data.Scan.FileTallies, "store.go")
+}
+
+func TestScoreService_WithFileMetricsMatchesLowMemory(t *testing.T) {
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+
+	plain, err := svc.ScoreProject(fixtureDir)
+	require.NoError(t, err)
+	assert.Nil(t, plain.FileMetrics, "file metrics are opt-in")
+
+	inMemory, err := svc.FileMetrics(fixtureDir)
+	require.NoError(t, err)
+	require.NotEmpty(t, inMemory)
+	assert.True(t, slices.IsSortedFunc(inMemory, func(a, b domain.FileMetrics) int { return cmp.Compare(a.Path, b.Path) }))
+
+	lowMemory, err := svc.ScoreProject(fixtureDir, application.WithFileMetrics(),
+		application.WithLowMemory(func() (domain.CloneIndex, error) { return cloneindex.New() }))
+	require.NoError(t, err)
+	assert.Equal(t, inMemory, lowMemory.FileMetrics)
+}
+
+func TestScoreService_FunctionMetrics(t *testing.T) {
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+
+	fns, err := svc.FunctionMetrics(fixtureDir)
+	require.NoError(t, err)
+	require.NotEmpty(t, fns)
+	for _, fn := range fns {
+		assert.Positive(t, fn.Lines, "%s:%d %s", fn.File, fn.Line, fn.Name)
+	}
+}
+
+func TestScoreService_ScoreProjects(t *testing.T) {
+	root := t.TempDir()
+	write := func(rel, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(root, rel)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, rel), []byte(content), 0644))
+	}
+	write("go.mod", "module example.com/root\n\ngo 1.24\n")
+	write("main.go", "package main\n\nfunc main() {}\n")
+	write("services/api/go.mod", "module example.com/api\n\ngo 1.24\n")
+	write("services/api/api.go", "package api\n\nfunc Serve() {}\n")
+	write("services/api/routes.go", "package api\n\nfunc Routes() {}\n")
+
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+	result, err := svc.ScoreProjects(root)
+	require.NoError(t, err)
+
+	require.Len(t, result.Projects, 2)
+	assert.Equal(t, ".", result.Projects[0].Path)
+	assert.Equal(t, 1, result.Projects[0].Files, "nested module files stay out of the root project")
+	assert.Equal(t, "services/api", result.Projects[1].Path)
+	assert.Equal(t, "example.com/api", result.Projects[1].ModulePath)
+	assert.Equal(t, 2, result.Projects[1].Files)
+	assert.Contains(t, result.Categories, "code_health")
+	assert.Equal(t, domain.ScoreSchemaVersion, result.SchemaVersion)
+}
+
+func TestScoreService_ScoreProjectsHonorsRootExcludes(t *testing.T) {
+	root := t.TempDir()
+	write := func(rel, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(root, rel)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, rel), []byte(content), 0644))
+	}
+	write(".openkraft.yaml", "exclude_paths: [tools/legacy]\n")
+	write("go.mod", "module example.com/root\n\ngo 1.24\n")
+	write("main.go", "package main\n\nfunc main() {}\n")
+	write("tools/legacy/go.mod", "module example.com/legacy\n\ngo 1.24\n")
+	write("tools/legacy/legacy.go", "package legacy\n\nfunc Run() {}\n")
+
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+	result, err := svc.ScoreProjects(root)
+	require.NoError(t, err)
+
+	require.Len(t, result.Projects, 1, "excluded nested module is not scored")
+	assert.Equal(t, ".", result.Projects[0].Path)
+}
+
+func TestScoreService_ScoreProjectsUsesRootGradeBands(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".openkraft.yaml"), []byte("grades:\n  - {grade: PASS, min: 0}\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/root\n\ngo 1.24\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0644))
+
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+	result, err := svc.ScoreProjects(root)
+	require.NoError(t, err)
+
+	assert.Equal(t, "PASS", result.Grade())
+}
+
+func TestScoreService_ScoreProjectsWithoutModules(t *testing.T) {
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+	_, err := svc.ScoreProjects(t.TempDir())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no Go modules")
 }
 
 func TestScoreService_CategoriesHaveCorrectWeights(t *testing.T) {
@@ -70,7 +373,26 @@ func TestScoreService_Deterministic(t *testing.T) {
 	score2, err := svc.ScoreProject(fixtureDir)
 	require.NoError(t, err)
 
-	assert.Equal(t, score1.Overall, score2.Overall, "scoring should be deterministic")
+	score1.Timestamp, score2.Timestamp = time.Time{}, time.Time{}
+	score1.Metadata.Timestamp, score2.Metadata.Timestamp = time.Time{}, time.Time{}
+	assert.Equal(t, score1, score2, "scoring should be deterministic")
+	for _, cat := range score1.Categories {
+		assert.True(t, slices.IsSortedFunc(cat.Issues, func(a, b domain.Issue) int {
+			return cmp.Or(cmp.Compare(a.File, b.File), cmp.Compare(a.Line, b.Line))
+		}), "issues of %s should be sorted by file and line", cat.Name)
+	}
+}
+
+func TestScoreService_VerifyDeterminism(t *testing.T) {
+	svc := application.NewScoreService(
+		scanner.New(),
+		detector.New(),
+		parser.New(),
+		config.New(),
+	)
+
+	require.NoError(t, svc.VerifyDeterminism(fixtureDir))
+	assert.Error(t, svc.VerifyDeterminism("/nonexistent/path"))
 }
 
 func TestScoreService_InvalidPath(t *testing.T) {
@@ -163,7 +485,7 @@ func TestScoreService_SkippedCategoryExcluded(t *testing.T) {
 	score, err := svc.ScoreProject(fixtureDir)
 	require.NoError(t, err)
 
-	assert.Len(t, score.Categories, 5, "should have 5 categories when context_quality is skipped")
+	assert.Len(t, score.Categories, 6, "should have 6 categories when context_quality is skipped")
 	for _, cat := range score.Categories {
 		assert.NotEqual(t, "context_quality", cat.Name, "context_quality should be excluded")
 	}
@@ -182,3 +504,130 @@ func TestScoreService_DefaultConfig_NoAppliedConfig(t *testing.T) {
 
 	assert.Nil(t, score.AppliedConfig, "should not include AppliedConfig for default config")
 }
+
+func TestScoreService_Simulate(t *testing.T) {
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+
+	plan := domain.SimulationPlan{Edits: []domain.SimulatedEdit{
+		{DeleteFile: "internal/tax/domain/tax_rule_test.go"},
+		{SplitFunction: &domain.SplitFunctionEdit{File: "internal/tax/domain/tax_rule.go", Function: "NewTaxRule", Parts: 2}},
+	}}
+	result, err := svc.Simulate(fixtureDir, plan)
+	require.NoError(t, err)
+
+	baseline, err := svc.ScoreProject(fixtureDir)
+	require.NoError(t, err)
+	assert.Equal(t, baseline.Overall, result.Baseline)
+	require.Len(t, result.Edits, 2)
+	assert.Equal(t, result.Edits[1].Cumulative, result.Projected)
+	assert.Equal(t, result.Projected-result.Baseline, result.Delta)
+	for _, e := range result.Edits {
+		assert.Equal(t, e.Alone-result.Baseline, e.Gain)
+	}
+
+	_, err = svc.Simulate(fixtureDir, domain.SimulationPlan{Edits: []domain.SimulatedEdit{{DeleteFile: "nope.go"}}})
+	assert.ErrorContains(t, err, "edit 1")
+}
+
+func TestScoreService_Metadata(t *testing.T) {
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+	run := &domain.Provenance{GoVersion: "go1.24", Platform: "linux/amd64"}
+
+	score, err := svc.ScoreProject(fixtureDir, application.WithProvenance("v1.2.3", "abc123", run))
+	require.NoError(t, err)
+
+	meta := score.Metadata
+	require.NotNil(t, meta)
+	assert.Equal(t, domain.ToolName, meta.Tool)
+	assert.Equal(t, "v1.2.3", meta.ToolVersion)
+	assert.Equal(t, "abc123", meta.ToolCommit)
+	assert.Same(t, run, meta.Provenance)
+	assert.NotEmpty(t, meta.ModulePath)
+	assert.True(t, strings.HasPrefix(meta.ProfileHash, "sha256:"))
+	assert.Equal(t, score.Timestamp, meta.Timestamp)
+	assert.Greater(t, meta.Files.Analyzed, 0)
+	assert.GreaterOrEqual(t, meta.Files.Total, meta.Files.Go)
+
+	strict, err := svc.ScoreProject(fixtureDir, application.WithCalibration(domain.CalibrationStrict))
+	require.NoError(t, err)
+	assert.NotEqual(t, meta.ProfileHash, strict.Metadata.ProfileHash, "calibration changes the profile hash")
+}
+
+// memoryResultCache is an in-memory domain.ResultCache.
+type memoryResultCache struct {
+	scores map[string]*domain.Score
+}
+
+func (c *memoryResultCache) Get(key string) (*domain.Score, error) {
+	return c.scores[key], nil
+}
+
+func (c *memoryResultCache) Put(key string, score *domain.Score) error {
+	c.scores[key] = score
+	return nil
+}
+
+func TestScoreService_ScoreProjectCachedReusesResultPerCommitAndProfile(t *testing.T) {
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+	cache := &memoryResultCache{scores: map[string]*domain.Score{}}
+
+	first, hit, err := svc.ScoreProjectCached(fixtureDir, "c0ffee", cache)
+	require.NoError(t, err)
+	assert.False(t, hit)
+	require.Len(t, cache.scores, 1)
+
+	second, hit, err := svc.ScoreProjectCached(fixtureDir, "c0ffee", cache)
+	require.NoError(t, err)
+	assert.True(t, hit)
+	assert.Same(t, first, second)
+
+	_, hit, err = svc.ScoreProjectCached(fixtureDir, "c0ffee", cache, application.WithCalibration(domain.CalibrationStrict))
+	require.NoError(t, err)
+	assert.False(t, hit, "another calibration is another profile")
+	_, hit, err = svc.ScoreProjectCached(fixtureDir, "decaf0", cache)
+	require.NoError(t, err)
+	assert.False(t, hit, "another commit is another result")
+	assert.Len(t, cache.scores, 3)
+}
+
+func TestScoreService_ParseFailures(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/svc\n\ngo 1.24\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "broken.go"), []byte("package main\n\nfunc broken( {\n"), 0644))
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+
+	score, err := svc.ScoreProject(root)
+	require.NoError(t, err, "unparsable files do not abort the run")
+	require.Len(t, score.ParseFailures, 1)
+	assert.Equal(t, "broken.go", score.ParseFailures[0].File)
+	assert.Equal(t, 3, score.ParseFailures[0].Line)
+	assert.Equal(t, 1, score.Metadata.Files.Analyzed)
+	assert.Equal(t, 1, score.Metadata.Files.Unparsed)
+
+	_, err = svc.ScoreProject(root, application.WithStrictParse())
+	var failures *domain.ParseFailuresError
+	require.ErrorAs(t, err, &failures)
+	assert.Equal(t, score.ParseFailures, failures.Failures)
+}
+
+// phaseRecorder is a domain.Tracer keeping the names of recorded spans.
+type phaseRecorder struct{ names []string }
+
+func (r *phaseRecorder) RecordSpan(name string, start, end time.Time, attrs ...any) {
+	if !end.Before(start) {
+		r.names = append(r.names, name)
+	}
+}
+
+func TestScoreService_TracesPhases(t *testing.T) {
+	rec := &phaseRecorder{}
+	application.SetTracer(rec)
+	defer application.SetTracer(nil)
+
+	svc := application.NewScoreService(scanner.New(), detector.New(), parser.New(), config.New())
+	_, err := svc.ScoreProject(fixtureDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"scan", "parse", "score"}, rec.names)
+}
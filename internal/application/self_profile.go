@@ -0,0 +1,50 @@
+package application
+
+import (
+	"runtime"
+	"time"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// phaseTimer measures the phases of one scoring run. A nil timer records
+// nothing, so the pipeline can call it unconditionally.
+type phaseTimer struct {
+	last    time.Time
+	profile domain.SelfProfile
+	mem     runtime.MemStats
+}
+
+func newPhaseTimer() *phaseTimer {
+	t := &phaseTimer{}
+	runtime.ReadMemStats(&t.mem)
+	t.last = time.Now()
+	return t
+}
+
+// mark ends the current phase under name and starts the next one.
+func (t *phaseTimer) mark(name string) {
+	if t == nil {
+		return
+	}
+	now := time.Now()
+	t.profile.AddPhase(name, now.Sub(t.last))
+	t.last = now
+}
+
+// finish returns the recorded profile with allocations since the timer
+// started and the file counts of the scan.
+func (t *phaseTimer) finish(scan *domain.ScanResult, analyzed int) *domain.SelfProfile {
+	if t == nil {
+		return nil
+	}
+	var after runtime.MemStats
+	runtime.ReadMemStats(&after)
+	p := t.profile
+	p.Allocs = after.Mallocs - t.mem.Mallocs
+	p.AllocBytes = after.TotalAlloc - t.mem.TotalAlloc
+	p.Files = len(scan.AllFiles)
+	p.GoFiles = len(scan.GoFiles)
+	p.AnalyzedFiles = analyzed
+	return &p
+}
@@ -0,0 +1,52 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// Simulate analyzes the project once and scores the plan's edits without
+// touching any file: each edit alone, to rank refactors by projected gain,
+// and all edits cumulatively in plan order.
+func (s *ScoreService) Simulate(projectPath string, plan domain.SimulationPlan) (*domain.SimulationResult, error) {
+	if err := plan.Validate(); err != nil {
+		return nil, err
+	}
+	data, err := s.AnalyzeProject(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	base := domain.ProjectSnapshot{Scan: data.Scan, Modules: data.Modules, Analyzed: data.Analyzed}
+	score := func(snap domain.ProjectSnapshot) *domain.Score {
+		return s.ScoreWithData(data.Config, data.Profile, snap.Scan, snap.Modules, snap.Analyzed)
+	}
+	baseline := score(base)
+
+	result := &domain.SimulationResult{Baseline: baseline.Overall}
+	cumulative := base.Clone()
+	var projected *domain.Score
+	for i, edit := range plan.Edits {
+		alone := base.Clone()
+		if err := alone.Apply(edit); err != nil {
+			return nil, fmt.Errorf("edit %d: %w", i+1, err)
+		}
+		if err := cumulative.Apply(edit); err != nil {
+			return nil, fmt.Errorf("edit %d: %w", i+1, err)
+		}
+		aloneScore := score(alone).Overall
+		projected = score(cumulative)
+		result.Edits = append(result.Edits, domain.EditImpact{
+			Edit:       edit.String(),
+			Alone:      aloneScore,
+			Gain:       aloneScore - baseline.Overall,
+			Cumulative: projected.Overall,
+		})
+	}
+
+	result.Projected = projected.Overall
+	result.Delta = projected.Overall - baseline.Overall
+	result.Categories = domain.CompareScores(baseline, projected).Categories
+	return result, nil
+}
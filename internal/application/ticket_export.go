@@ -0,0 +1,35 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// ExportTickets files the issues of score selected by opts in tracker,
+// skipping those whose fingerprint a ticket already carries, open or
+// closed. With dryRun nothing is created and new tickets are reported as
+// planned.
+func ExportTickets(score *domain.Score, tracker domain.IssueTracker, opts domain.TicketOptions, dryRun bool) ([]domain.ExportedTicket, error) {
+	filed, err := tracker.Filed()
+	if err != nil {
+		return nil, fmt.Errorf("listing filed tickets: %w", err)
+	}
+	var out []domain.ExportedTicket
+	for _, t := range domain.SelectTickets(score, opts) {
+		if url, ok := filed[t.Fingerprint]; ok {
+			out = append(out, domain.ExportedTicket{Ticket: t, URL: url, Status: "exists"})
+			continue
+		}
+		if dryRun {
+			out = append(out, domain.ExportedTicket{Ticket: t, Status: "planned"})
+			continue
+		}
+		url, err := tracker.Create(t)
+		if err != nil {
+			return out, fmt.Errorf("filing %q: %w", t.Title, err)
+		}
+		out = append(out, domain.ExportedTicket{Ticket: t, URL: url, Status: "created"})
+	}
+	return out, nil
+}
@@ -0,0 +1,55 @@
+package application
+
+import (
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// tracer receives every phase as a span. Like the default slog logger it is
+// process-wide, set once by the CLI before any work starts.
+var tracer atomic.Pointer[domain.Tracer]
+
+// SetTracer makes t receive the phases of every run from now on; nil stops
+// tracing.
+func SetTracer(t domain.Tracer) {
+	if t == nil {
+		tracer.Store(nil)
+		return
+	}
+	tracer.Store(&t)
+}
+
+// TraceSpan records a phase that started at start and ends now, such as a
+// render step outside the scoring pipeline, with the tracer set by
+// SetTracer.
+func TraceSpan(name string, start time.Time, attrs ...any) {
+	if t := tracer.Load(); t != nil {
+		(*t).RecordSpan(name, start, time.Now(), attrs...)
+	}
+}
+
+// phaseTrace logs the end of each pipeline phase at debug level with its
+// duration, so --debug shows where a run spends its time, and records it
+// as a span when a tracer is set. Unlike phaseTimer it costs nothing to
+// keep on every run.
+type phaseTrace struct {
+	last time.Time
+}
+
+func newPhaseTrace() *phaseTrace {
+	return &phaseTrace{last: time.Now()}
+}
+
+// done ends the current phase under name, logging attrs with it, and
+// starts the next one.
+func (t *phaseTrace) done(phase string, attrs ...any) {
+	now := time.Now()
+	slog.Debug("phase done", append([]any{"phase", phase, "duration", now.Sub(t.last)}, attrs...)...)
+	if tr := tracer.Load(); tr != nil {
+		(*tr).RecordSpan(phase, t.last, now, attrs...)
+	}
+	t.last = now
+}
@@ -2,9 +2,13 @@ package application
 
 import (
 	"crypto/sha256"
+	"errors"
 	"fmt"
+	"log/slog"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 
 	"github.com/abdidvp/openkraft/internal/domain"
 )
@@ -38,23 +42,10 @@ func NewValidateService(
 // Validate checks changed/added/deleted files against the cached baseline.
 // It returns drift issues, score impact, and a pass/warn/fail status.
 func (s *ValidateService) Validate(projectPath string, changed, added, deleted []string, strict bool) (*domain.ValidationResult, error) {
-	// 1. Load config
-	cfg, err := s.configLoader.Load(projectPath)
+	// 1-3. Load config and cache
+	cfg, cached, err := s.loadCache(projectPath)
 	if err != nil {
-		return nil, fmt.Errorf("loading config: %w", err)
-	}
-
-	// 2. Compute hashes
-	goModHash := fileHash(filepath.Join(projectPath, "go.mod"))
-	configHash := fileHash(filepath.Join(projectPath, ".openkraft.yaml"))
-
-	// 3. Load cache
-	cached, err := s.cache.Load(projectPath)
-	if err != nil || cached == nil || cached.IsInvalidated(goModHash, configHash) {
-		cached, err = s.createCache(projectPath, cfg, goModHash, configHash)
-		if err != nil {
-			return nil, fmt.Errorf("creating cache: %w", err)
-		}
+		return nil, err
 	}
 
 	// 4. Apply file changes
@@ -65,12 +56,10 @@ func (s *ValidateService) Validate(projectPath string, changed, added, deleted [
 
 	for _, f := range added {
 		cached.ScanResult.AddFile(f)
-		absPath := filepath.Join(projectPath, f)
-		af, err := s.analyzer.AnalyzeFile(absPath)
+		af, err := s.analyzeFile(projectPath, f, nil)
 		if err != nil {
 			continue
 		}
-		af.Path = f
 		if cached.AnalyzedFiles == nil {
 			cached.AnalyzedFiles = make(map[string]*domain.AnalyzedFile)
 		}
@@ -78,12 +67,10 @@ func (s *ValidateService) Validate(projectPath string, changed, added, deleted [
 	}
 
 	for _, f := range changed {
-		absPath := filepath.Join(projectPath, f)
-		af, err := s.analyzer.AnalyzeFile(absPath)
+		af, err := s.analyzeFile(projectPath, f, nil)
 		if err != nil {
 			continue
 		}
-		af.Path = f
 		cached.AnalyzedFiles[f] = af
 	}
 
@@ -153,6 +140,112 @@ func (s *ValidateService) Validate(projectPath string, changed, added, deleted [
 	}, nil
 }
 
+// ScoreFile scores one file in the context of the cached project state:
+// the project is scored once with the file and once without it, and the
+// differences are its impact. When src is nil the file is read from disk;
+// otherwise src is scored as the content of relPath, which need not exist,
+// so editors and hooks can score unsaved buffers and snippets. The cache
+// is left untouched.
+func (s *ValidateService) ScoreFile(projectPath, relPath string, src []byte) (*domain.FileScore, error) {
+	cfg, cached, err := s.loadCache(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	af, err := s.analyzeFile(projectPath, relPath, src)
+	if err != nil {
+		return nil, err
+	}
+
+	with := maps.Clone(cached.AnalyzedFiles)
+	if with == nil {
+		with = make(map[string]*domain.AnalyzedFile)
+	}
+	with[relPath] = af
+	without := maps.Clone(with)
+	delete(without, relPath)
+
+	withScan, withoutScan := cloneScan(cached.ScanResult), cloneScan(cached.ScanResult)
+	withScan.AddFile(relPath)
+	withoutScan.RemoveFile(relPath)
+
+	profile := BuildProfile(cfg)
+	score := func(scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) (*domain.Score, error) {
+		modules, err := s.detector.Detect(scan)
+		if err != nil {
+			return nil, fmt.Errorf("detecting modules: %w", err)
+		}
+		return s.scoreService.ScoreWithData(cfg, profile, scan, modules, analyzed), nil
+	}
+	withScore, err := score(withScan, with)
+	if err != nil {
+		return nil, err
+	}
+	withoutScore, err := score(withoutScan, without)
+	if err != nil {
+		return nil, err
+	}
+	fs := domain.BuildFileScore(relPath, withScore, withoutScore)
+	fs.Partial = af.Partial
+	return fs, nil
+}
+
+// analyzeFile analyzes relPath, from src when it is non-nil and from disk
+// otherwise. A file with syntax errors, as files are mid-edit, falls back
+// to the analyzer's partial analysis instead of failing.
+func (s *ValidateService) analyzeFile(projectPath, relPath string, src []byte) (*domain.AnalyzedFile, error) {
+	absPath := filepath.Join(projectPath, relPath)
+	if src == nil {
+		data, err := os.ReadFile(absPath)
+		if err != nil {
+			return nil, fmt.Errorf("analyzing %s: %w", relPath, err)
+		}
+		src = data
+	}
+	af, err := s.analyzer.AnalyzeSource(absPath, src)
+	var perr *domain.ParseError
+	switch {
+	case errors.As(err, &perr):
+		slog.Debug("analyzing partially", "file", relPath, "err", perr)
+		af = s.analyzer.AnalyzePartial(absPath, src)
+	case err != nil:
+		return nil, fmt.Errorf("analyzing %s: %w", relPath, err)
+	}
+	af.Path = relPath
+	return af, nil
+}
+
+// cloneScan copies scan with its own file lists, so adding and removing
+// files leaves scan untouched.
+func cloneScan(scan *domain.ScanResult) *domain.ScanResult {
+	c := *scan
+	c.GoFiles = slices.Clone(scan.GoFiles)
+	c.TestFiles = slices.Clone(scan.TestFiles)
+	c.AllFiles = slices.Clone(scan.AllFiles)
+	return &c
+}
+
+// loadCache loads the project config and the cached baseline, rebuilding the
+// cache when it is missing or invalidated by go.mod or config changes.
+func (s *ValidateService) loadCache(projectPath string) (domain.ProjectConfig, *domain.ProjectCache, error) {
+	cfg, err := s.configLoader.Load(projectPath)
+	if err != nil {
+		return cfg, nil, fmt.Errorf("loading config: %w", err)
+	}
+
+	goModHash := fileHash(filepath.Join(projectPath, "go.mod"))
+	configHash := fileHash(filepath.Join(projectPath, ".openkraft.yaml"))
+
+	cached, err := s.cache.Load(projectPath)
+	if err != nil || cached == nil || cached.IsInvalidated(goModHash, configHash) {
+		cached, err = s.createCache(projectPath, cfg, goModHash, configHash)
+		if err != nil {
+			return cfg, nil, fmt.Errorf("creating cache: %w", err)
+		}
+	}
+	return cfg, cached, nil
+}
+
 func (s *ValidateService) createCache(projectPath string, cfg domain.ProjectConfig, goModHash, configHash string) (*domain.ProjectCache, error) {
 	scan, err := s.scanner.Scan(projectPath, cfg.ExcludePaths...)
 	if err != nil {
@@ -208,20 +301,19 @@ func classifyDrift(baseline, current *domain.Score, norms domain.ProjectNorms) [
 		return nil
 	}
 
-	// Build set of baseline issues for deduplication
+	// Build set of baseline issues for deduplication; fingerprints survive
+	// line shifts so moved code is not reported as drift.
 	baselineIssues := make(map[string]bool)
 	for _, cat := range baseline.Categories {
 		for _, issue := range cat.Issues {
-			key := fmt.Sprintf("%s:%s:%s:%d", issue.Category, issue.File, issue.Message, issue.Line)
-			baselineIssues[key] = true
+			baselineIssues[domain.IssueFingerprint(issue)] = true
 		}
 	}
 
 	var drifts []domain.DriftIssue
 	for _, cat := range current.Categories {
 		for _, issue := range cat.Issues {
-			key := fmt.Sprintf("%s:%s:%s:%d", issue.Category, issue.File, issue.Message, issue.Line)
-			if baselineIssues[key] {
+			if baselineIssues[domain.IssueFingerprint(issue)] {
 				continue // not new drift
 			}
 
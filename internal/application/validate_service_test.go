@@ -1,7 +1,9 @@
 package application
 
 import (
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -210,3 +212,138 @@ func TestScoreWithData_NilModules(t *testing.T) {
 	score := svc.ScoreWithData(cfg, profile, scan, nil, nil)
 	assert.NotNil(t, score)
 }
+
+func TestEditSession_UnsavedBufferReportsIssues(t *testing.T) {
+	svc := newValidateService()
+	fixturePath := "../../testdata/go-hexagonal/perfect"
+
+	_ = cache.New().Invalidate(fixturePath)
+	defer func() { _ = cache.New().Invalidate(fixturePath) }()
+
+	var body strings.Builder
+	body.WriteString("package domain\n\nfunc Huge() int {\n\tx := 0\n")
+	for i := 0; i < 400; i++ {
+		body.WriteString("\tx++\n")
+	}
+	body.WriteString("\treturn x\n}\n")
+
+	session, err := svc.OpenSession(fixturePath)
+	require.NoError(t, err)
+
+	rel := "internal/tax/domain/tax_rule.go"
+	issues, err := session.FileIssues(rel, []byte(body.String()))
+	require.NoError(t, err)
+
+	found := false
+	for _, issue := range issues {
+		assert.Equal(t, rel, issue.File)
+		if issue.SubMetric == "function_size" {
+			found = true
+		}
+	}
+	assert.True(t, found, "expected a function_size issue for the unsaved buffer")
+
+	// The unsaved buffer must not leak into the session.
+	issues, err = session.FileIssues(rel, nil)
+	require.NoError(t, err)
+	for _, issue := range issues {
+		assert.NotEqual(t, "function_size", issue.SubMetric)
+	}
+}
+
+func TestEditSession_MatchesProjectScore(t *testing.T) {
+	svc := newValidateService()
+	fixturePath := "../../testdata/go-hexagonal/inconsistent"
+
+	_ = cache.New().Invalidate(fixturePath)
+	defer func() { _ = cache.New().Invalidate(fixturePath) }()
+
+	session, err := svc.OpenSession(fixturePath)
+	require.NoError(t, err)
+
+	project := make(map[string][]string)
+	for _, cat := range session.project.Categories {
+		for _, issue := range cat.Issues {
+			project[issue.File] = append(project[issue.File], issue.SubMetric+": "+issue.Message)
+		}
+	}
+	for rel := range session.cached.AnalyzedFiles {
+		issues, err := session.FileIssues(rel, nil)
+		require.NoError(t, err)
+		var got []string
+		for _, issue := range issues {
+			got = append(got, issue.SubMetric+": "+issue.Message)
+		}
+		assert.ElementsMatch(t, project[rel], got, rel)
+	}
+}
+
+func TestScoreFile_ComparesProjectWithAndWithoutFile(t *testing.T) {
+	svc := newValidateService()
+	fixturePath := "../../testdata/go-hexagonal/perfect"
+
+	_ = cache.New().Invalidate(fixturePath)
+	defer func() { _ = cache.New().Invalidate(fixturePath) }()
+
+	rel := "internal/tax/domain/wide.go"
+	src := []byte("package domain\n\nfunc Wide(a, b, c, d, e, f, g, h int) int { return a }\n")
+	fs, err := svc.ScoreFile(fixturePath, rel, src)
+	require.NoError(t, err)
+
+	assert.Equal(t, rel, fs.Path)
+	require.NotEmpty(t, fs.Issues)
+	for _, issue := range fs.Issues {
+		assert.Equal(t, rel, issue.File)
+	}
+
+	// Scoring an unsaved file must not leak it into the cached project.
+	base, err := svc.ScoreFile(fixturePath, "internal/tax/domain/tax_rule.go", nil)
+	require.NoError(t, err)
+	assert.Equal(t, fs.Overall-fs.OverallImpact, base.Overall-base.OverallImpact)
+}
+
+func TestScoreFile_FallsBackToPartialAnalysisOnSyntaxErrors(t *testing.T) {
+	svc := newValidateService()
+	fixturePath := "../../testdata/go-hexagonal/perfect"
+
+	_ = cache.New().Invalidate(fixturePath)
+	defer func() { _ = cache.New().Invalidate(fixturePath) }()
+
+	rel := "internal/tax/domain/long.go"
+	src := "package domain\n\nfunc Long(x int) int {\n\tif x > 0 {\n\t\tx = max(x, 1\n\t}\n" +
+		strings.Repeat("\tx++\n", 120) + "\treturn x\n}\n"
+	fs, err := svc.ScoreFile(fixturePath, rel, []byte(src))
+	require.NoError(t, err, "a file mid-edit still gets a score")
+
+	assert.True(t, fs.Partial)
+	var subMetrics []string
+	for _, issue := range fs.Issues {
+		subMetrics = append(subMetrics, issue.SubMetric)
+	}
+	assert.Contains(t, subMetrics, "function_size", "the token-level pass still measures function spans")
+}
+
+func TestValidate_CacheKeepsSecretKindButNotValue(t *testing.T) {
+	svc := newValidateService()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/svc\n\ngo 1.22\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "internal", "config"), 0o755))
+	src := "package config\n\nconst defaultPassword = \"hunter2!\"\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "internal", "config", "config.go"), []byte(src), 0o644))
+
+	_, err := svc.Validate(dir, []string{"internal/config/config.go"}, nil, nil, false)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, ".openkraft", "cache", "baseline.json"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "hunter2!")
+
+	cached, err := cache.New().Load(dir)
+	require.NoError(t, err)
+	require.NotNil(t, cached)
+	af := cached.AnalyzedFiles["internal/config/config.go"]
+	require.NotNil(t, af)
+	assert.Equal(t, []domain.SecretCandidate{
+		{Name: "defaultPassword", Kind: "credential", Line: 3},
+	}, af.SecretCandidates)
+}
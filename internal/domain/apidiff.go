@@ -0,0 +1,297 @@
+package domain
+
+import (
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Kinds of exported API symbols.
+const (
+	SymbolFunc      = "func"
+	SymbolMethod    = "method"
+	SymbolStruct    = "struct"
+	SymbolInterface = "interface"
+	SymbolType      = "type"
+	SymbolConst     = "const"
+	SymbolVar       = "var"
+)
+
+// Kinds of API changes.
+const (
+	APIRemoved = "removed"
+	APIChanged = "changed"
+	APIAdded   = "added"
+)
+
+// APISymbol is one exported declaration of an importable package.
+type APISymbol struct {
+	Package   string `json:"package"` // module-relative directory, "." for the root
+	Name      string `json:"name"`    // Type.Method for methods
+	Kind      string `json:"kind"`
+	Signature string `json:"signature"`
+	File      string `json:"file"`
+	Line      int    `json:"line,omitempty"`
+
+	// fields are the exported fields of a struct as "Name Type", so that
+	// adding one is not mistaken for a breaking change.
+	fields []string
+}
+
+// Key identifies the symbol across versions, e.g. "pkg/store.Store.Get"
+// or "Parse" in the root package.
+func (s APISymbol) Key() string {
+	if s.Package == "." {
+		return s.Name
+	}
+	return s.Package + "." + s.Name
+}
+
+// APIChange is one difference between two versions of the exported API.
+type APIChange struct {
+	Kind     string     `json:"kind"`
+	Breaking bool       `json:"breaking"`
+	Before   *APISymbol `json:"before,omitempty"`
+	After    *APISymbol `json:"after,omitempty"`
+}
+
+// Symbol returns the side of the change that exists, preferring the target.
+func (c APIChange) Symbol() APISymbol {
+	if c.After != nil {
+		return *c.After
+	}
+	return *c.Before
+}
+
+// APIDiff is the exported API change between a baseline and a target.
+type APIDiff struct {
+	Baseline string      `json:"baseline"`
+	Target   string      `json:"target"`
+	Changes  []APIChange `json:"changes"`
+}
+
+// Breaking returns the changes that break callers of the baseline API.
+func (d *APIDiff) Breaking() []APIChange {
+	var out []APIChange
+	for _, c := range d.Changes {
+		if c.Breaking {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// ExtractAPI collects the exported functions, methods, structs,
+// interfaces, other types, consts and vars of importable packages: test
+// files, main packages and packages below an internal/ directory are not
+// part of the API. Methods count only when their receiver type is
+// exported. Struct signatures list the exported fields with their types,
+// interface signatures the methods with theirs.
+func ExtractAPI(analyzed map[string]*AnalyzedFile) map[string]APISymbol {
+	paths := make([]string, 0, len(analyzed))
+	for p := range analyzed {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	api := make(map[string]APISymbol)
+	add := func(s APISymbol) {
+		if _, dup := api[s.Key()]; !dup { // platform variants: keep the first
+			api[s.Key()] = s
+		}
+	}
+	for _, p := range paths {
+		af := analyzed[p]
+		file := strings.ReplaceAll(p, "\\", "/")
+		pkg := path.Dir(file)
+		if !isPublicPackage(af, file, pkg) {
+			continue
+		}
+		for _, fn := range af.Functions {
+			if s, ok := functionSymbol(fn); ok {
+				s.Package, s.File = pkg, file
+				add(s)
+			}
+		}
+		defs := make(map[string]StructDef, len(af.StructDefs))
+		for _, sdef := range af.StructDefs {
+			defs[sdef.Name] = sdef
+		}
+		for _, name := range af.Structs {
+			if isExported(name) {
+				sdef, ok := defs[name]
+				if !ok { // partial analysis records names only
+					sdef = StructDef{Name: name}
+				}
+				s := structSymbol(sdef)
+				s.Package, s.File = pkg, file
+				add(s)
+			}
+		}
+		for _, iface := range af.InterfaceDefs {
+			if isExported(iface.Name) {
+				add(APISymbol{Package: pkg, Name: iface.Name, Kind: SymbolInterface, Signature: interfaceSignature(iface), File: file})
+			}
+		}
+		for _, d := range af.Declarations {
+			if isExported(d.Name) {
+				add(APISymbol{Package: pkg, Name: d.Name, Kind: d.Kind, Signature: declarationSignature(d), File: file, Line: d.Line})
+			}
+		}
+	}
+	return api
+}
+
+// DiffAPI compares two extracted APIs. Removed symbols and changed
+// signatures are breaking; additions are not, except methods added to an
+// exported interface, which break its implementations (reported as a
+// changed interface). A struct that only gained exported fields is a
+// change that breaks nothing.
+func DiffAPI(baseline, target map[string]APISymbol) []APIChange {
+	var changes []APIChange
+	for key, before := range baseline {
+		after, ok := target[key]
+		switch {
+		case !ok:
+			changes = append(changes, APIChange{Kind: APIRemoved, Breaking: true, Before: &before})
+		case before.Kind != after.Kind || before.Signature != after.Signature:
+			breaking := before.Kind != SymbolStruct || after.Kind != SymbolStruct || !keepsFields(before.fields, after.fields)
+			changes = append(changes, APIChange{Kind: APIChanged, Breaking: breaking, Before: &before, After: &after})
+		}
+	}
+	for key, after := range target {
+		if _, ok := baseline[key]; !ok {
+			changes = append(changes, APIChange{Kind: APIAdded, After: &after})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.Breaking != b.Breaking {
+			return a.Breaking
+		}
+		return a.Symbol().Key() < b.Symbol().Key()
+	})
+	return changes
+}
+
+func isPublicPackage(af *AnalyzedFile, file, pkg string) bool {
+	if af == nil || af.Package == "main" || strings.HasSuffix(file, "_test.go") {
+		return false
+	}
+	for _, seg := range strings.Split(pkg, "/") {
+		if seg == "internal" || seg == "testdata" {
+			return false
+		}
+	}
+	return true
+}
+
+func functionSymbol(fn Function) (APISymbol, bool) {
+	if !fn.Exported || !isExported(fn.Name) {
+		return APISymbol{}, false
+	}
+	if fn.Receiver == "" {
+		return APISymbol{Name: fn.Name, Kind: SymbolFunc, Signature: FunctionSignature(fn), Line: fn.LineStart}, true
+	}
+	recv := strings.TrimPrefix(fn.Receiver, "*")
+	if !isExported(recv) {
+		return APISymbol{}, false
+	}
+	return APISymbol{
+		Name:      recv + "." + fn.Name,
+		Kind:      SymbolMethod,
+		Signature: FunctionSignature(fn),
+		Line:      fn.LineStart,
+	}, true
+}
+
+// FunctionSignature renders fn's declaration without parameter names, e.g.
+// "func (*Store) Get(string) (string, error)".
+func FunctionSignature(fn Function) string {
+	types := make([]string, len(fn.Params))
+	for i, p := range fn.Params {
+		types[i] = p.Type
+	}
+	sig := "func "
+	if fn.Receiver != "" {
+		sig += "(" + fn.Receiver + ") "
+	}
+	sig += fn.Name + "(" + strings.Join(types, ", ") + ")"
+	switch len(fn.Returns) {
+	case 0:
+	case 1:
+		sig += " " + fn.Returns[0]
+	default:
+		sig += " (" + strings.Join(fn.Returns, ", ") + ")"
+	}
+	return sig
+}
+
+// structSymbol renders a struct with its exported fields, in declaration
+// order, e.g. "type Store struct{Path string; Cache *Cache}".
+func structSymbol(sdef StructDef) APISymbol {
+	var fields []string
+	for _, f := range sdef.Fields {
+		if isExported(f.Name) {
+			fields = append(fields, f.Name+" "+f.Type)
+		}
+	}
+	return APISymbol{
+		Name:      sdef.Name,
+		Kind:      SymbolStruct,
+		Signature: "type " + sdef.Name + " struct{" + strings.Join(fields, "; ") + "}",
+		fields:    fields,
+	}
+}
+
+// keepsFields reports whether every field of before is in after.
+func keepsFields(before, after []string) bool {
+	for _, f := range before {
+		if !slices.Contains(after, f) {
+			return false
+		}
+	}
+	return true
+}
+
+// interfaceSignature renders an interface with its methods sorted by name
+// and its embedded interfaces, e.g.
+// "type Store interface{io.Closer; Get(string) (string, error)}".
+func interfaceSignature(iface InterfaceDef) string {
+	var elems []string
+	for _, et := range iface.Embedded {
+		name := et.Name
+		if et.Package != "" {
+			name = path.Base(et.Package) + "." + name
+		}
+		elems = append(elems, name)
+	}
+	sort.Strings(elems)
+	methods := make([]string, len(iface.Methods))
+	for i, m := range iface.Methods {
+		methods[i] = m
+		if len(iface.Signatures) == len(iface.Methods) {
+			methods[i] += iface.Signatures[i]
+		}
+	}
+	sort.Strings(methods)
+	return "type " + iface.Name + " interface{" + strings.Join(append(elems, methods...), "; ") + "}"
+}
+
+// declarationSignature renders a const, var or type declaration, e.g.
+// "const MaxSize int", "var ErrNotFound" or "type Mode int".
+func declarationSignature(d Declaration) string {
+	sig := d.Kind + " " + d.Name + d.TypeParams
+	if d.Type != "" {
+		sig += " " + d.Type
+	}
+	return sig
+}
+
+func isExported(name string) bool {
+	r, _ := utf8.DecodeRuneInString(name)
+	return unicode.IsUpper(r)
+}
@@ -0,0 +1,203 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func apiFixture(fns []domain.Function, iface domain.InterfaceDef) map[string]*domain.AnalyzedFile {
+	return map[string]*domain.AnalyzedFile{
+		"pkg/store/store.go": {
+			Path:          "pkg/store/store.go",
+			Package:       "store",
+			Structs:       []string{"Store", "cache"},
+			Functions:     fns,
+			InterfaceDefs: []domain.InterfaceDef{iface},
+		},
+		"internal/impl/impl.go": {
+			Path:      "internal/impl/impl.go",
+			Package:   "impl",
+			Functions: []domain.Function{{Name: "Hidden", Exported: true}},
+		},
+		"main.go": {
+			Path:      "main.go",
+			Package:   "main",
+			Functions: []domain.Function{{Name: "Run", Exported: true}},
+		},
+		"pkg/store/store_test.go": {
+			Path:      "pkg/store/store_test.go",
+			Package:   "store_test",
+			Functions: []domain.Function{{Name: "TestStore_Get", Exported: true}},
+		},
+	}
+}
+
+func TestExtractAPI_PublicPackagesOnly(t *testing.T) {
+	api := domain.ExtractAPI(apiFixture([]domain.Function{
+		{Name: "New", Exported: true, Returns: []string{"*Store"}},
+		{Name: "Get", Receiver: "*Store", Exported: true, Params: []domain.Param{{Name: "key", Type: "string"}}, Returns: []string{"string", "error"}},
+		{Name: "Flush", Receiver: "*cache", Exported: true},
+		{Name: "helper"},
+	}, domain.InterfaceDef{Name: "Getter", Methods: []string{"Get"}}))
+
+	keys := make([]string, 0, len(api))
+	for k := range api {
+		keys = append(keys, k)
+	}
+	assert.ElementsMatch(t, []string{
+		"pkg/store.New", "pkg/store.Store.Get", "pkg/store.Store", "pkg/store.Getter",
+	}, keys)
+	assert.Equal(t, "func (*Store) Get(string) (string, error)", api["pkg/store.Store.Get"].Signature)
+	assert.Equal(t, domain.SymbolMethod, api["pkg/store.Store.Get"].Kind)
+}
+
+func TestDiffAPI_ReportsBreakingChanges(t *testing.T) {
+	base := domain.ExtractAPI(apiFixture([]domain.Function{
+		{Name: "New", Exported: true, Returns: []string{"*Store"}},
+		{Name: "Get", Receiver: "*Store", Exported: true, Params: []domain.Param{{Type: "string"}}},
+		{Name: "Delete", Receiver: "*Store", Exported: true},
+	}, domain.InterfaceDef{Name: "Getter", Methods: []string{"Get"}}))
+	target := domain.ExtractAPI(apiFixture([]domain.Function{
+		{Name: "New", Exported: true, Returns: []string{"*Store"}},
+		{Name: "Get", Receiver: "*Store", Exported: true, Params: []domain.Param{{Type: "string"}, {Type: "bool"}}},
+		{Name: "Put", Receiver: "*Store", Exported: true},
+	}, domain.InterfaceDef{Name: "Getter", Methods: []string{"Get", "Len"}}))
+
+	changes := domain.DiffAPI(base, target)
+	diff := domain.APIDiff{Changes: changes}
+
+	breaking := diff.Breaking()
+	require.Len(t, breaking, 3)
+	assert.Equal(t, "pkg/store.Getter", breaking[0].Symbol().Key())
+	assert.Equal(t, domain.APIChanged, breaking[0].Kind)
+	assert.Equal(t, "pkg/store.Store.Delete", breaking[1].Symbol().Key())
+	assert.Equal(t, domain.APIRemoved, breaking[1].Kind)
+	assert.Equal(t, "pkg/store.Store.Get", breaking[2].Symbol().Key())
+	assert.Equal(t, "func (*Store) Get(string, bool)", breaking[2].After.Signature)
+
+	require.Len(t, changes, 4)
+	assert.Equal(t, domain.APIAdded, changes[3].Kind)
+	assert.Equal(t, "pkg/store.Store.Put", changes[3].Symbol().Key())
+	assert.False(t, changes[3].Breaking)
+}
+
+func TestDiffAPI_IdenticalAPIHasNoChanges(t *testing.T) {
+	api := domain.ExtractAPI(apiFixture([]domain.Function{
+		{Name: "New", Exported: true, Returns: []string{"*Store"}},
+	}, domain.InterfaceDef{Name: "Getter", Methods: []string{"Get"}}))
+
+	assert.Empty(t, domain.DiffAPI(api, api))
+}
+
+func structAPI(fields ...domain.Param) map[string]domain.APISymbol {
+	return domain.ExtractAPI(map[string]*domain.AnalyzedFile{
+		"pkg/store/store.go": {
+			Path:       "pkg/store/store.go",
+			Package:    "store",
+			Structs:    []string{"Options"},
+			StructDefs: []domain.StructDef{{Name: "Options", Fields: fields}},
+		},
+	})
+}
+
+func TestExtractAPI_StructSignatureListsExportedFields(t *testing.T) {
+	api := structAPI(domain.Param{Name: "Path", Type: "string"}, domain.Param{Name: "mu", Type: "sync.Mutex"}, domain.Param{Name: "Retries", Type: "int"})
+	assert.Equal(t, "type Options struct{Path string; Retries int}", api["pkg/store.Options"].Signature)
+}
+
+func TestDiffAPI_StructFields(t *testing.T) {
+	base := structAPI(domain.Param{Name: "Path", Type: "string"}, domain.Param{Name: "Retries", Type: "int"})
+
+	t.Run("added field", func(t *testing.T) {
+		changes := domain.DiffAPI(base, structAPI(domain.Param{Name: "Path", Type: "string"}, domain.Param{Name: "Retries", Type: "int"}, domain.Param{Name: "Timeout", Type: "time.Duration"}))
+		require.Len(t, changes, 1)
+		assert.Equal(t, domain.APIChanged, changes[0].Kind)
+		assert.False(t, changes[0].Breaking)
+	})
+	t.Run("changed field type", func(t *testing.T) {
+		changes := domain.DiffAPI(base, structAPI(domain.Param{Name: "Path", Type: "string"}, domain.Param{Name: "Retries", Type: "uint"}))
+		require.Len(t, changes, 1)
+		assert.True(t, changes[0].Breaking)
+		assert.Equal(t, "type Options struct{Path string; Retries uint}", changes[0].After.Signature)
+	})
+	t.Run("removed field", func(t *testing.T) {
+		changes := domain.DiffAPI(base, structAPI(domain.Param{Name: "Path", Type: "string"}))
+		require.Len(t, changes, 1)
+		assert.True(t, changes[0].Breaking)
+	})
+	t.Run("unexported field", func(t *testing.T) {
+		assert.Empty(t, domain.DiffAPI(base, structAPI(domain.Param{Name: "Path", Type: "string"}, domain.Param{Name: "Retries", Type: "int"}, domain.Param{Name: "cache", Type: "map[string]string"})))
+	})
+}
+
+func TestDiffAPI_InterfaceMethodSignatureChange(t *testing.T) {
+	iface := func(sig string) map[string]domain.APISymbol {
+		return domain.ExtractAPI(apiFixture(nil, domain.InterfaceDef{Name: "Getter", Methods: []string{"Get"}, Signatures: []string{sig}}))
+	}
+	base := iface("(string) (string, error)")
+	assert.Equal(t, "type Getter interface{Get(string) (string, error)}", base["pkg/store.Getter"].Signature)
+
+	changes := domain.DiffAPI(base, iface("(context.Context, string) (string, error)"))
+	require.Len(t, changes, 1)
+	assert.Equal(t, "pkg/store.Getter", changes[0].Symbol().Key())
+	assert.True(t, changes[0].Breaking)
+}
+
+func declAPI(decls ...domain.Declaration) map[string]domain.APISymbol {
+	return domain.ExtractAPI(map[string]*domain.AnalyzedFile{
+		"pkg/store/mode.go": {Path: "pkg/store/mode.go", Package: "store", Declarations: decls},
+	})
+}
+
+func TestExtractAPI_ConstsVarsAndTypes(t *testing.T) {
+	api := declAPI(
+		domain.Declaration{Kind: "type", Name: "Mode", Type: "int", Line: 3},
+		domain.Declaration{Kind: "type", Name: "Set", TypeParams: "[T comparable]", Type: "map[T]struct{}"},
+		domain.Declaration{Kind: "type", Name: "Alias", Type: "= Mode"},
+		domain.Declaration{Kind: "const", Name: "ReadOnly", Type: "Mode"},
+		domain.Declaration{Kind: "const", Name: "maxRetries"},
+		domain.Declaration{Kind: "var", Name: "ErrNotFound"},
+	)
+
+	assert.Len(t, api, 5)
+	assert.Equal(t, domain.SymbolType, api["pkg/store.Mode"].Kind)
+	assert.Equal(t, "type Mode int", api["pkg/store.Mode"].Signature)
+	assert.Equal(t, 3, api["pkg/store.Mode"].Line)
+	assert.Equal(t, "type Set[T comparable] map[T]struct{}", api["pkg/store.Set"].Signature)
+	assert.Equal(t, "type Alias = Mode", api["pkg/store.Alias"].Signature)
+	assert.Equal(t, domain.SymbolConst, api["pkg/store.ReadOnly"].Kind)
+	assert.Equal(t, "const ReadOnly Mode", api["pkg/store.ReadOnly"].Signature)
+	assert.Equal(t, "var ErrNotFound", api["pkg/store.ErrNotFound"].Signature)
+}
+
+func TestDiffAPI_ConstsVarsAndTypes(t *testing.T) {
+	base := declAPI(
+		domain.Declaration{Kind: "type", Name: "Mode", Type: "int"},
+		domain.Declaration{Kind: "const", Name: "ReadOnly", Type: "Mode"},
+		domain.Declaration{Kind: "var", Name: "ErrNotFound"},
+	)
+	target := declAPI(
+		domain.Declaration{Kind: "type", Name: "Mode", Type: "string"},
+		domain.Declaration{Kind: "var", Name: "ReadOnly", Type: "Mode"},
+		domain.Declaration{Kind: "const", Name: "ReadWrite", Type: "Mode"},
+	)
+
+	changes := domain.DiffAPI(base, target)
+	require.Len(t, changes, 4)
+	assert.Equal(t, "pkg/store.ErrNotFound", changes[0].Symbol().Key())
+	assert.Equal(t, domain.APIRemoved, changes[0].Kind)
+	assert.Equal(t, "pkg/store.Mode", changes[1].Symbol().Key())
+	assert.Equal(t, domain.APIChanged, changes[1].Kind)
+	assert.True(t, changes[1].Breaking)
+	assert.Equal(t, "pkg/store.ReadOnly", changes[2].Symbol().Key())
+	assert.Equal(t, "const ReadOnly Mode", changes[2].Before.Signature)
+	assert.Equal(t, "var ReadOnly Mode", changes[2].After.Signature)
+	assert.True(t, changes[2].Breaking)
+	assert.Equal(t, "pkg/store.ReadWrite", changes[3].Symbol().Key())
+	assert.Equal(t, domain.APIAdded, changes[3].Kind)
+	assert.False(t, changes[3].Breaking)
+}
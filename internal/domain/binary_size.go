@@ -0,0 +1,16 @@
+package domain
+
+// BinarySize is the size of one main package built with -ldflags="-s -w",
+// with the packages contributing the most symbol bytes to it. It is
+// informational and does not affect the score.
+type BinarySize struct {
+	Dir         string        `json:"dir"` // main package directory, relative to the project root
+	Bytes       int64         `json:"bytes"`
+	TopPackages []PackageSize `json:"top_packages,omitempty"`
+}
+
+// PackageSize is the total size of the symbols of one package in a binary.
+type PackageSize struct {
+	Path  string `json:"path"`
+	Bytes int64  `json:"bytes"`
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "sort"
+
+// BuildTagGroup lists the files compiled only when a build tag is set.
+type BuildTagGroup struct {
+	Tag   string   `json:"tag"`
+	Files []string `json:"files"`
+}
+
+// GroupByBuildTag groups the analyzed files by the non-negated tags of their
+// build constraints, sorted by tag. Unconstrained files are left out.
+func GroupByBuildTag(analyzed map[string]*AnalyzedFile) []BuildTagGroup {
+	byTag := make(map[string][]string)
+	for _, af := range analyzed {
+		for _, tag := range af.BuildTags {
+			byTag[tag] = append(byTag[tag], af.Path)
+		}
+	}
+
+	groups := make([]BuildTagGroup, 0, len(byTag))
+	for tag, files := range byTag {
+		sort.Strings(files)
+		groups = append(groups, BuildTagGroup{Tag: tag, Files: files})
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Tag < groups[j].Tag })
+	return groups
+}
@@ -0,0 +1,28 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupByBuildTag(t *testing.T) {
+	analyzed := map[string]*domain.AnalyzedFile{
+		"poll.go":         {Path: "poll.go"},
+		"poll_linux.go":   {Path: "poll_linux.go", BuildTags: []string{"linux"}},
+		"epoll_linux.go":  {Path: "epoll_linux.go", BuildTags: []string{"cgo", "linux"}},
+		"poll_windows.go": {Path: "poll_windows.go", BuildTags: []string{"windows"}},
+	}
+
+	assert.Equal(t, []domain.BuildTagGroup{
+		{Tag: "cgo", Files: []string{"epoll_linux.go"}},
+		{Tag: "linux", Files: []string{"epoll_linux.go", "poll_linux.go"}},
+		{Tag: "windows", Files: []string{"poll_windows.go"}},
+	}, domain.GroupByBuildTag(analyzed))
+}
+
+func TestGroupByBuildTag_Unconstrained(t *testing.T) {
+	groups := domain.GroupByBuildTag(map[string]*domain.AnalyzedFile{"a.go": {Path: "a.go"}})
+	assert.Empty(t, groups)
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+)
+
+// Calibration presets shift thresholds and grade bands together so a team
+// can pick how demanding the score is without tuning each limit.
+const (
+	CalibrationStrict         = "strict"
+	CalibrationDefault        = "default"
+	CalibrationLegacyFriendly = "legacy-friendly"
+)
+
+// ValidCalibrations enumerates the built-in calibration presets.
+var ValidCalibrations = []string{CalibrationStrict, CalibrationDefault, CalibrationLegacyFriendly}
+
+// GradeBand maps scores at or above Min to a letter grade.
+type GradeBand struct {
+	Grade string `yaml:"grade" json:"grade"`
+	Min   int    `yaml:"min"   json:"min"`
+}
+
+// DefaultGradeBands are the grade bands used when nothing is configured.
+var DefaultGradeBands = []GradeBand{
+	{Grade: "A+", Min: 90},
+	{Grade: "A", Min: 80},
+	{Grade: "B", Min: 70},
+	{Grade: "C", Min: 60},
+	{Grade: "D", Min: 50},
+	{Grade: "F", Min: 0},
+}
+
+var calibrationBands = map[string][]GradeBand{
+	CalibrationStrict: {
+		{Grade: "A+", Min: 95},
+		{Grade: "A", Min: 88},
+		{Grade: "B", Min: 78},
+		{Grade: "C", Min: 68},
+		{Grade: "D", Min: 55},
+		{Grade: "F", Min: 0},
+	},
+	CalibrationDefault: DefaultGradeBands,
+	CalibrationLegacyFriendly: {
+		{Grade: "A+", Min: 85},
+		{Grade: "A", Min: 75},
+		{Grade: "B", Min: 62},
+		{Grade: "C", Min: 50},
+		{Grade: "D", Min: 35},
+		{Grade: "F", Min: 0},
+	},
+}
+
+// calibrationScale multiplies size and complexity limits: strict tightens
+// them by a quarter, legacy-friendly allows half again as much.
+var calibrationScale = map[string]float64{
+	CalibrationStrict:         0.75,
+	CalibrationDefault:        1.0,
+	CalibrationLegacyFriendly: 1.5,
+}
+
+// GradeWithBands returns the grade of the first band whose Min the score
+// reaches. Bands are ordered from best to worst; a score below every band
+// gets the last band's grade.
+func GradeWithBands(score int, bands []GradeBand) string {
+	if len(bands) == 0 {
+		bands = DefaultGradeBands
+	}
+	for _, b := range bands {
+		if score >= b.Min {
+			return b.Grade
+		}
+	}
+	return bands[len(bands)-1].Grade
+}
+
+// CalibrationGradeBands returns the grade bands of a preset, or the default
+// bands for an empty or unknown name.
+func CalibrationGradeBands(preset string) []GradeBand {
+	if bands, ok := calibrationBands[preset]; ok {
+		return bands
+	}
+	return DefaultGradeBands
+}
+
+// CalibrateProfile scales the size and complexity limits of p for preset.
+// The minimum test ratio moves the other way: stricter presets demand more
+// tests. Unknown or empty presets leave p unchanged.
+func CalibrateProfile(p *ScoringProfile, preset string) {
+	f, ok := calibrationScale[preset]
+	if !ok || f == 1.0 {
+		return
+	}
+	scale := func(v int) int { return max(1, int(math.Round(float64(v)*f))) }
+
+	p.MaxFunctionLines = scale(p.MaxFunctionLines)
+	p.MaxFileLines = scale(p.MaxFileLines)
+	foreign := make(map[string]int, len(p.MaxForeignFileLines))
+	for lang, lines := range p.MaxForeignFileLines {
+		foreign[lang] = scale(lines)
+	}
+	p.MaxForeignFileLines = foreign
+	p.MaxNestingDepth = scale(p.MaxNestingDepth)
+	p.MaxParameters = scale(p.MaxParameters)
+	p.MaxConditionalOps = scale(p.MaxConditionalOps)
+	p.MaxCognitiveComplexity = scale(p.MaxCognitiveComplexity)
+	p.MaxDuplicationPercent = scale(p.MaxDuplicationPercent)
+	p.MaxFunctionFanOut = scale(p.MaxFunctionFanOut)
+	p.MaxDirectDependencies = scale(p.MaxDirectDependencies)
+	p.MinTestRatio = math.Min(1, p.MinTestRatio/f)
+}
+
+// ValidateCalibration reports an error for names that are not a preset.
+func ValidateCalibration(name string) error {
+	for _, c := range ValidCalibrations {
+		if name == c {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown calibration %q (valid: strict, default, legacy-friendly)", name)
+}
+
+func validateGradeBands(bands []GradeBand) error {
+	for i, b := range bands {
+		if b.Grade == "" {
+			return fmt.Errorf("grades[%d]: grade must not be empty", i)
+		}
+		if b.Min < 0 || b.Min > 100 {
+			return fmt.Errorf("grades[%d]: min = %d (must be between 0 and 100)", i, b.Min)
+		}
+		if i > 0 && b.Min >= bands[i-1].Min {
+			return fmt.Errorf("grades[%d]: min %d must be lower than the previous band's %d", i, b.Min, bands[i-1].Min)
+		}
+	}
+	return nil
+}
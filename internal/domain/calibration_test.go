@@ -0,0 +1,62 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestGradeWithBands(t *testing.T) {
+	bands := []domain.GradeBand{{Grade: "good", Min: 70}, {Grade: "fair", Min: 40}}
+
+	tests := []struct {
+		score int
+		want  string
+	}{
+		{100, "good"},
+		{70, "good"},
+		{69, "fair"},
+		{40, "fair"},
+		{10, "fair"}, // below every band: worst grade
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, domain.GradeWithBands(tt.score, bands), "score %d", tt.score)
+	}
+}
+
+func TestGradeWithBands_NilUsesDefaults(t *testing.T) {
+	assert.Equal(t, domain.GradeFor(85), domain.GradeWithBands(85, nil))
+	assert.Equal(t, "A", domain.GradeWithBands(85, nil))
+}
+
+func TestScoreGrade_UsesScoreBands(t *testing.T) {
+	s := domain.Score{Overall: 85, GradeBands: domain.CalibrationGradeBands(domain.CalibrationStrict)}
+	assert.Equal(t, "B", s.Grade())
+	s.GradeBands = domain.CalibrationGradeBands(domain.CalibrationLegacyFriendly)
+	assert.Equal(t, "A+", s.Grade())
+}
+
+func TestCalibrateProfile(t *testing.T) {
+	strict := domain.DefaultProfile()
+	domain.CalibrateProfile(&strict, domain.CalibrationStrict)
+	legacy := domain.DefaultProfile()
+	domain.CalibrateProfile(&legacy, domain.CalibrationLegacyFriendly)
+	def := domain.DefaultProfile()
+	domain.CalibrateProfile(&def, domain.CalibrationDefault)
+
+	base := domain.DefaultProfile()
+	assert.Equal(t, base, def, "default preset changes nothing")
+	assert.Less(t, strict.MaxFunctionLines, base.MaxFunctionLines)
+	assert.Greater(t, legacy.MaxFunctionLines, base.MaxFunctionLines)
+	assert.Greater(t, strict.MinTestRatio, base.MinTestRatio)
+	assert.Less(t, legacy.MinTestRatio, base.MinTestRatio)
+	assert.GreaterOrEqual(t, strict.MaxNestingDepth, 1)
+}
+
+func TestCalibrateProfile_UnknownPresetIsNoop(t *testing.T) {
+	p := domain.DefaultProfile()
+	domain.CalibrateProfile(&p, "")
+	assert.Equal(t, domain.DefaultProfile(), p)
+}
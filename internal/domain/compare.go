@@ -0,0 +1,128 @@
+package domain
+
+import "sort"
+
+// Comparison describes how a target score differs from a baseline score.
+type Comparison struct {
+	Baseline       string          `json:"baseline"`
+	Target         string          `json:"target"`
+	OverallBefore  int             `json:"overall_before"`
+	OverallAfter   int             `json:"overall_after"`
+	OverallDelta   int             `json:"overall_delta"`
+	Categories     []CategoryDelta `json:"categories"`
+	NewIssues      []Issue         `json:"new_issues"`
+	ResolvedIssues []Issue         `json:"resolved_issues"`
+}
+
+// CategoryDelta is the change of one category between baseline and target.
+// SubMetrics lists only the sub-metrics whose score or detail changed.
+type CategoryDelta struct {
+	Name       string           `json:"name"`
+	Before     int              `json:"before"`
+	After      int              `json:"after"`
+	Delta      int              `json:"delta"`
+	SubMetrics []SubMetricDelta `json:"sub_metrics,omitempty"`
+}
+
+// SubMetricDelta is the change of one sub-metric between baseline and target.
+type SubMetricDelta struct {
+	Name         string `json:"name"`
+	Before       int    `json:"before"`
+	After        int    `json:"after"`
+	Points       int    `json:"points"`
+	DetailBefore string `json:"detail_before,omitempty"`
+	DetailAfter  string `json:"detail_after,omitempty"`
+}
+
+// CompareScores diffs two scores. Issues are matched by IssueFingerprint,
+// which ignores line numbers and measured values so that code moving within
+// a file does not surface as a new issue.
+func CompareScores(baseline, target *Score) Comparison {
+	c := Comparison{
+		OverallBefore: baseline.Overall,
+		OverallAfter:  target.Overall,
+		OverallDelta:  target.Overall - baseline.Overall,
+	}
+
+	before := make(map[string]CategoryScore, len(baseline.Categories))
+	for _, cat := range baseline.Categories {
+		before[cat.Name] = cat
+	}
+	seen := make(map[string]bool)
+	for _, after := range target.Categories {
+		seen[after.Name] = true
+		c.Categories = append(c.Categories, categoryDelta(before[after.Name], after))
+	}
+	// Categories dropped from the target (e.g. newly skipped) still appear.
+	for _, cat := range baseline.Categories {
+		if !seen[cat.Name] {
+			c.Categories = append(c.Categories, categoryDelta(cat, CategoryScore{Name: cat.Name}))
+		}
+	}
+
+	c.NewIssues = issueDifference(allIssues(target), allIssues(baseline))
+	c.ResolvedIssues = issueDifference(allIssues(baseline), allIssues(target))
+	return c
+}
+
+func categoryDelta(before, after CategoryScore) CategoryDelta {
+	d := CategoryDelta{
+		Name:   after.Name,
+		Before: before.Score,
+		After:  after.Score,
+		Delta:  after.Score - before.Score,
+	}
+
+	prev := make(map[string]SubMetric, len(before.SubMetrics))
+	for _, sm := range before.SubMetrics {
+		prev[sm.Name] = sm
+	}
+	for _, sm := range after.SubMetrics {
+		old := prev[sm.Name]
+		if old.Score == sm.Score && old.Detail == sm.Detail {
+			continue
+		}
+		d.SubMetrics = append(d.SubMetrics, SubMetricDelta{
+			Name:         sm.Name,
+			Before:       old.Score,
+			After:        sm.Score,
+			Points:       sm.Points,
+			DetailBefore: old.Detail,
+			DetailAfter:  sm.Detail,
+		})
+	}
+	return d
+}
+
+func allIssues(s *Score) []Issue {
+	var issues []Issue
+	for _, cat := range s.Categories {
+		issues = append(issues, cat.Issues...)
+	}
+	return issues
+}
+
+// issueDifference returns the issues in a that have no counterpart in b,
+// treating both as multisets keyed by IssueFingerprint.
+func issueDifference(a, b []Issue) []Issue {
+	remaining := make(map[string]int, len(b))
+	for _, iss := range b {
+		remaining[IssueFingerprint(iss)]++
+	}
+	var diff []Issue
+	for _, iss := range a {
+		k := IssueFingerprint(iss)
+		if remaining[k] > 0 {
+			remaining[k]--
+			continue
+		}
+		diff = append(diff, iss)
+	}
+	sort.SliceStable(diff, func(i, j int) bool {
+		if diff[i].File != diff[j].File {
+			return diff[i].File < diff[j].File
+		}
+		return diff[i].Line < diff[j].Line
+	})
+	return diff
+}
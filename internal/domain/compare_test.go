@@ -0,0 +1,74 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestCompareScores_Deltas(t *testing.T) {
+	baseline := &domain.Score{
+		Overall: 70,
+		Categories: []domain.CategoryScore{{
+			Name: "code_health", Score: 70,
+			SubMetrics: []domain.SubMetric{
+				{Name: "function_size", Score: 15, Points: 20, Detail: "3 long functions"},
+				{Name: "file_size", Score: 20, Points: 20, Detail: "ok"},
+			},
+		}},
+	}
+	target := &domain.Score{
+		Overall: 75,
+		Categories: []domain.CategoryScore{{
+			Name: "code_health", Score: 80,
+			SubMetrics: []domain.SubMetric{
+				{Name: "function_size", Score: 18, Points: 20, Detail: "1 long function"},
+				{Name: "file_size", Score: 20, Points: 20, Detail: "ok"},
+			},
+		}},
+	}
+
+	c := domain.CompareScores(baseline, target)
+	assert.Equal(t, 5, c.OverallDelta)
+	require.Len(t, c.Categories, 1)
+	assert.Equal(t, 10, c.Categories[0].Delta)
+	require.Len(t, c.Categories[0].SubMetrics, 1, "unchanged sub-metrics are omitted")
+	assert.Equal(t, "function_size", c.Categories[0].SubMetrics[0].Name)
+	assert.Equal(t, "1 long function", c.Categories[0].SubMetrics[0].DetailAfter)
+}
+
+func TestCompareScores_NewAndResolvedIssues(t *testing.T) {
+	moved := domain.Issue{Category: "code_health", SubMetric: "function_size", File: "a.go", Line: 10, Message: "function Foo is 60 lines"}
+	movedLater := moved
+	movedLater.Line = 42
+	movedLater.Message = "function Foo is 61 lines"
+	fixed := domain.Issue{Category: "code_health", File: "b.go", Message: "Bar is long"}
+	added := domain.Issue{Category: "code_health", File: "c.go", Message: "Baz is long"}
+
+	baseline := &domain.Score{Categories: []domain.CategoryScore{{Name: "code_health", Issues: []domain.Issue{moved, fixed}}}}
+	target := &domain.Score{Categories: []domain.CategoryScore{{Name: "code_health", Issues: []domain.Issue{movedLater, added}}}}
+
+	c := domain.CompareScores(baseline, target)
+	assert.Equal(t, []domain.Issue{added}, c.NewIssues, "a moved issue is neither new nor resolved")
+	assert.Equal(t, []domain.Issue{fixed}, c.ResolvedIssues)
+}
+
+func TestCompareScores_DuplicateIssuesCountedSeparately(t *testing.T) {
+	iss := domain.Issue{Category: "structure", Message: "missing layer"}
+	baseline := &domain.Score{Categories: []domain.CategoryScore{{Name: "structure", Issues: []domain.Issue{iss}}}}
+	target := &domain.Score{Categories: []domain.CategoryScore{{Name: "structure", Issues: []domain.Issue{iss, iss}}}}
+
+	c := domain.CompareScores(baseline, target)
+	assert.Len(t, c.NewIssues, 1)
+	assert.Empty(t, c.ResolvedIssues)
+}
+
+func TestCompareScores_DroppedCategory(t *testing.T) {
+	baseline := &domain.Score{Categories: []domain.CategoryScore{{Name: "verifiability", Score: 60}}}
+	c := domain.CompareScores(baseline, &domain.Score{})
+	require.Len(t, c.Categories, 1)
+	assert.Equal(t, -60, c.Categories[0].Delta)
+}
@@ -1,6 +1,10 @@
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
 
 // ProjectType identifies the kind of project for default scoring tuning.
 type ProjectType string
@@ -24,6 +28,7 @@ var ValidProjectTypes = []ProjectType{
 var ValidCategories = []string{
 	"code_health", "discoverability", "structure",
 	"verifiability", "context_quality", "predictability",
+	"security_hints",
 }
 
 // ValidSubMetrics enumerates all scoring sub-metric names.
@@ -33,29 +38,42 @@ var ValidSubMetrics = []string{
 	"parameter_count", "code_duplication",
 	// discoverability
 	"naming_uniqueness", "file_naming_conventions",
-	"predictable_structure", "dependency_direction",
+	"predictable_structure", "dependency_direction", "function_coupling",
+	"package_cohesion",
 	// structure
 	"expected_layers", "expected_files",
-	"interface_contracts", "module_completeness",
+	"interface_contracts", "module_completeness", "interface_granularity",
 	// verifiability
 	"test_presence", "test_naming",
-	"build_reproducibility", "type_safety_signals",
+	"build_reproducibility", "type_safety_signals", "dependency_hygiene",
 	// context_quality
 	"ai_context_files", "package_documentation",
 	"architecture_docs", "canonical_examples",
 	// predictability
 	"self_describing_names", "explicit_dependencies",
-	"error_message_quality", "consistent_patterns",
+	"error_message_quality", "consistent_patterns", "logging_consistency",
+	// security_hints
+	"hardcoded_secrets", "sql_injection", "file_access",
 }
 
 // ProjectConfig holds project-level configuration loaded from .openkraft.yaml.
 type ProjectConfig struct {
+	Extends       string             `yaml:"extends,omitempty" json:"extends,omitempty"`
+	ExtendsChecksum string           `yaml:"extends_checksum,omitempty" json:"extends_checksum,omitempty"`
 	ProjectType   ProjectType        `yaml:"project_type"    json:"project_type,omitempty"`
 	Weights       map[string]float64 `yaml:"weights"         json:"weights,omitempty"`
 	Skip          SkipConfig         `yaml:"skip"            json:"skip,omitempty"`
 	ExcludePaths  []string           `yaml:"exclude_paths"   json:"exclude_paths,omitempty"`
 	MinThresholds map[string]int     `yaml:"min_thresholds"  json:"min_thresholds,omitempty"`
 	Profile       *ProfileOverrides  `yaml:"profile,omitempty" json:"profile,omitempty"`
+	Gates         *GatesConfig       `yaml:"gates,omitempty"   json:"gates,omitempty"`
+	Calibration   string             `yaml:"calibration,omitempty" json:"calibration,omitempty"`
+	Grades        []GradeBand        `yaml:"grades,omitempty"      json:"grades,omitempty"`
+	Severity      []SeverityRule     `yaml:"severity,omitempty"    json:"severity,omitempty"`
+	PenaltyModel  string             `yaml:"penalty_model,omitempty" json:"penalty_model,omitempty"`
+	Naming        []NamingRule       `yaml:"naming,omitempty"      json:"naming,omitempty"`
+	Headers       *HeaderConfig      `yaml:"headers,omitempty"     json:"headers,omitempty"`
+	Display       *DisplayConfig     `yaml:"display,omitempty"     json:"display,omitempty"`
 }
 
 // ProfileOverrides allows users to override specific scoring profile parameters.
@@ -65,7 +83,12 @@ type ProfileOverrides struct {
 	ExpectedDirs         []string          `yaml:"expected_dirs,omitempty"          json:"expected_dirs,omitempty"`
 	LayerAliases         map[string]string `yaml:"layer_aliases,omitempty"          json:"layer_aliases,omitempty"`
 	ExpectedFileSuffixes []string          `yaml:"expected_file_suffixes,omitempty" json:"expected_file_suffixes,omitempty"`
+	LearnFileSuffixes    *bool             `yaml:"learn_file_suffixes,omitempty"    json:"learn_file_suffixes,omitempty"`
 	NamingConvention     string            `yaml:"naming_convention,omitempty"      json:"naming_convention,omitempty"`
+	PreferredLogger      string            `yaml:"preferred_logger,omitempty"       json:"preferred_logger,omitempty"`
+	InterfaceNaming      *bool             `yaml:"interface_naming,omitempty"       json:"interface_naming,omitempty"`
+	DebtNotesSubMetric   *bool             `yaml:"debt_notes_sub_metric,omitempty"  json:"debt_notes_sub_metric,omitempty"`
+	ScanTestSecrets      *bool             `yaml:"scan_test_secrets,omitempty"      json:"scan_test_secrets,omitempty"`
 	MaxFunctionLines     *int              `yaml:"max_function_lines,omitempty"     json:"max_function_lines,omitempty"`
 	MaxFileLines         *int              `yaml:"max_file_lines,omitempty"         json:"max_file_lines,omitempty"`
 	MaxNestingDepth      *int              `yaml:"max_nesting_depth,omitempty"      json:"max_nesting_depth,omitempty"`
@@ -74,11 +97,76 @@ type ProfileOverrides struct {
 	MaxCognitiveComplexity *int              `yaml:"max_cognitive_complexity,omitempty" json:"max_cognitive_complexity,omitempty"`
 	MaxDuplicationPercent  *int              `yaml:"max_duplication_percent,omitempty"  json:"max_duplication_percent,omitempty"`
 	MinCloneTokens         *int              `yaml:"min_clone_tokens,omitempty"         json:"min_clone_tokens,omitempty"`
+	DupKeepIdentifiers     *bool             `yaml:"dup_keep_identifiers,omitempty"     json:"dup_keep_identifiers,omitempty"`
+	DupKeepLiterals        *bool             `yaml:"dup_keep_literals,omitempty"        json:"dup_keep_literals,omitempty"`
+	DupIgnoreImports       *bool             `yaml:"dup_ignore_imports,omitempty"       json:"dup_ignore_imports,omitempty"`
+	DupIgnoreTestTables    *bool             `yaml:"dup_ignore_test_tables,omitempty"   json:"dup_ignore_test_tables,omitempty"`
+	MinStringLiteralRepeats *int             `yaml:"min_string_literal_repeats,omitempty" json:"min_string_literal_repeats,omitempty"`
 	ExemptParamPatterns    []string          `yaml:"exempt_param_patterns,omitempty"    json:"exempt_param_patterns,omitempty"`
+	MaxFunctionFanOut      *int              `yaml:"max_function_fan_out,omitempty"     json:"max_function_fan_out,omitempty"`
+	HubFanIn               *int              `yaml:"hub_fan_in,omitempty"               json:"hub_fan_in,omitempty"`
+	MaxInterfaceMethods  *int              `yaml:"max_interface_methods,omitempty"  json:"max_interface_methods,omitempty"`
 	ContextFiles         []ContextFileSpec `yaml:"context_files,omitempty"          json:"context_files,omitempty"`
 	MinTestRatio         *float64          `yaml:"min_test_ratio,omitempty"         json:"min_test_ratio,omitempty"`
+	MaxDirectDependencies *int             `yaml:"max_direct_dependencies,omitempty" json:"max_direct_dependencies,omitempty"`
+	MinPackageCohesion   *float64          `yaml:"min_package_cohesion,omitempty"   json:"min_package_cohesion,omitempty"`
+	MaxPackageDepth      *int              `yaml:"max_package_depth,omitempty"      json:"max_package_depth,omitempty"`
+	MaxEmbeddingDepth    *int              `yaml:"max_embedding_depth,omitempty"    json:"max_embedding_depth,omitempty"`
+	TinyPackageLines     *int              `yaml:"tiny_package_lines,omitempty"     json:"tiny_package_lines,omitempty"`
+	MaxTinyPackageRatio  *float64          `yaml:"max_tiny_package_ratio,omitempty" json:"max_tiny_package_ratio,omitempty"`
 	MaxGlobalVarPenalty  *int              `yaml:"max_global_var_penalty,omitempty" json:"max_global_var_penalty,omitempty"`
+	SmoothSmallSamples   *bool             `yaml:"smooth_small_samples,omitempty"   json:"smooth_small_samples,omitempty"`
+	SmoothingWeight      *int              `yaml:"smoothing_weight,omitempty"       json:"smoothing_weight,omitempty"`
+	SmoothingPrior       *float64          `yaml:"smoothing_prior,omitempty"        json:"smoothing_prior,omitempty"`
+	PenaltyScale         *float64          `yaml:"penalty_scale,omitempty"          json:"penalty_scale,omitempty"`
+	DecayK               *int              `yaml:"decay_k,omitempty"                json:"decay_k,omitempty"`
+	ErrorWeight          *float64          `yaml:"error_weight,omitempty"           json:"error_weight,omitempty"`
+	WarningWeight        *float64          `yaml:"warning_weight,omitempty"         json:"warning_weight,omitempty"`
+	InfoWeight           *float64          `yaml:"info_weight,omitempty"            json:"info_weight,omitempty"`
 	CompositionRoots    []string          `yaml:"composition_roots,omitempty"     json:"composition_roots,omitempty"`
+	VaguePackageNames   *WordListOverride `yaml:"vague_package_names,omitempty"   json:"vague_package_names,omitempty"`
+	StutterExemptPackages *WordListOverride `yaml:"stutter_exempt_packages,omitempty" json:"stutter_exempt_packages,omitempty"`
+	GenericWords        *WordListOverride `yaml:"generic_words,omitempty"         json:"generic_words,omitempty"`
+	ActionWords         *WordListOverride `yaml:"action_words,omitempty"          json:"action_words,omitempty"`
+	DomainWords         *WordListOverride `yaml:"domain_words,omitempty"          json:"domain_words,omitempty"`
+	Acronyms            *WordListOverride `yaml:"acronyms,omitempty"              json:"acronyms,omitempty"`
+	MagicNumberAllowlist *WordListOverride `yaml:"magic_number_allowlist,omitempty" json:"magic_number_allowlist,omitempty"`
+	SafeSQLBuilders      *WordListOverride `yaml:"safe_sql_builders,omitempty"      json:"safe_sql_builders,omitempty"`
+}
+
+// WordListOverride edits a built-in word list: Remove drops entries
+// (case-insensitively), then Add appends new ones.
+type WordListOverride struct {
+	Add    []string `yaml:"add,omitempty"    json:"add,omitempty"`
+	Remove []string `yaml:"remove,omitempty" json:"remove,omitempty"`
+}
+
+// Apply returns list edited by o. A nil override returns list unchanged.
+func (o *WordListOverride) Apply(list []string) []string {
+	if o == nil {
+		return list
+	}
+	drop := make(map[string]bool, len(o.Remove))
+	for _, w := range o.Remove {
+		drop[strings.ToLower(w)] = true
+	}
+	seen := make(map[string]bool)
+	var out []string
+	keep := func(w string) {
+		if key := strings.ToLower(w); !seen[key] {
+			seen[key] = true
+			out = append(out, w)
+		}
+	}
+	for _, w := range list {
+		if !drop[strings.ToLower(w)] {
+			keep(w)
+		}
+	}
+	for _, w := range o.Add {
+		keep(w)
+	}
+	return out
 }
 
 // SkipConfig specifies categories and sub-metrics to exclude from scoring.
@@ -87,6 +175,13 @@ type SkipConfig struct {
 	SubMetrics []string `yaml:"sub_metrics" json:"sub_metrics,omitempty"`
 }
 
+// GatesConfig defines CI quality gates checked by `openkraft score --gate`.
+type GatesConfig struct {
+	MinOverall  *int           `yaml:"min_overall,omitempty"  json:"min_overall,omitempty"`
+	MinCategory map[string]int `yaml:"min_category,omitempty" json:"min_category,omitempty"`
+	MaxIssues   map[string]int `yaml:"max_issues,omitempty"   json:"max_issues,omitempty"`
+}
+
 // DefaultConfig returns a zero-value config that changes nothing.
 func DefaultConfig() ProjectConfig {
 	return ProjectConfig{}
@@ -170,8 +265,13 @@ func (c ProjectConfig) Validate() error {
 		}
 	}
 
-	// 3. if all 6 categories specified, weights must sum to ~1.0
-	if len(c.Weights) == len(ValidCategories) {
+	// 3. if all categories specified, weights must sum to ~1.0;
+	// security_hints carries no weight by default and may be left out
+	weighted := len(c.Weights)
+	if _, ok := c.Weights["security_hints"]; !ok {
+		weighted++
+	}
+	if weighted == len(ValidCategories) {
 		sum := 0.0
 		for _, w := range c.Weights {
 			sum += w
@@ -217,12 +317,87 @@ func (c ProjectConfig) Validate() error {
 		}
 	}
 
+	// 9. gates validation
+	if c.Gates != nil {
+		if err := c.Gates.validate(); err != nil {
+			return err
+		}
+	}
+
+	// 10. calibration must be a preset; grade bands must descend
+	if c.Calibration != "" {
+		if err := ValidateCalibration(c.Calibration); err != nil {
+			return err
+		}
+	}
+	if err := validateGradeBands(c.Grades); err != nil {
+		return err
+	}
+
+	// 11. severity rules must name valid severities and selectors
+	if err := validateSeverityRules(c.Severity); err != nil {
+		return err
+	}
+
+	// 12. penalty_model must be a preset
+	if c.PenaltyModel != "" {
+		if err := ValidatePenaltyModel(c.PenaltyModel); err != nil {
+			return err
+		}
+	}
+
+	// 13. naming rules must name a known kind and compile
+	if err := validateNamingRules(c.Naming); err != nil {
+		return err
+	}
+
+	// 14. header patterns must compile
+	if err := validateHeaders(c.Headers); err != nil {
+		return err
+	}
+
+	// 15. extends must name a preset or a remote config
+	if err := validateExtends(c.Extends, c.ExtendsChecksum); err != nil {
+		return err
+	}
+
+	// 16. display aliases need a path and a name
+	if err := c.Display.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g GatesConfig) validate() error {
+	if g.MinOverall != nil && (*g.MinOverall < 0 || *g.MinOverall > 100) {
+		return fmt.Errorf("gates.min_overall = %d (must be between 0 and 100)", *g.MinOverall)
+	}
+	for k, v := range g.MinCategory {
+		if !isValidCategory(k) {
+			return fmt.Errorf("unknown category %q in gates.min_category", k)
+		}
+		if v < 0 || v > 100 {
+			return fmt.Errorf("gates.min_category[%q] = %d (must be between 0 and 100)", k, v)
+		}
+	}
+	for k, v := range g.MaxIssues {
+		if k != SeverityError && k != SeverityWarning && k != SeverityInfo {
+			return fmt.Errorf("unknown severity %q in gates.max_issues (valid: error, warning, info)", k)
+		}
+		if v < 0 {
+			return fmt.Errorf("gates.max_issues[%q] = %d (must be >= 0)", k, v)
+		}
+	}
 	return nil
 }
 
 // validNamingConventions lists allowed values for NamingConvention.
 var validNamingConventions = []string{"", "auto", "bare", "suffixed"}
 
+// validLoggers lists allowed values for PreferredLogger.
+var validLoggers = []string{"", "log", "slog", "logrus", "zap", "zerolog"}
+
 func (p ProfileOverrides) validate() error {
 	// naming_convention must be known
 	if p.NamingConvention != "" {
@@ -237,6 +412,9 @@ func (p ProfileOverrides) validate() error {
 			return fmt.Errorf("unknown naming_convention %q in profile (valid: auto, bare, suffixed)", p.NamingConvention)
 		}
 	}
+	if !slices.Contains(validLoggers, p.PreferredLogger) {
+		return fmt.Errorf("unknown preferred_logger %q in profile (valid: log, slog, logrus, zap, zerolog)", p.PreferredLogger)
+	}
 
 	// int pointer fields must be > 0 if set
 	intFields := map[string]*int{
@@ -248,7 +426,17 @@ func (p ProfileOverrides) validate() error {
 		"max_cognitive_complexity": p.MaxCognitiveComplexity,
 		"max_duplication_percent":  p.MaxDuplicationPercent,
 		"min_clone_tokens":         p.MinCloneTokens,
+		"min_string_literal_repeats": p.MinStringLiteralRepeats,
 		"max_global_var_penalty":   p.MaxGlobalVarPenalty,
+		"max_direct_dependencies":  p.MaxDirectDependencies,
+		"max_function_fan_out":     p.MaxFunctionFanOut,
+		"hub_fan_in":               p.HubFanIn,
+		"max_interface_methods":    p.MaxInterfaceMethods,
+		"max_package_depth":        p.MaxPackageDepth,
+		"max_embedding_depth":      p.MaxEmbeddingDepth,
+		"tiny_package_lines":       p.TinyPackageLines,
+		"smoothing_weight":         p.SmoothingWeight,
+		"decay_k":                  p.DecayK,
 	}
 	for name, ptr := range intFields {
 		if ptr != nil && *ptr <= 0 {
@@ -256,6 +444,38 @@ func (p ProfileOverrides) validate() error {
 		}
 	}
 
+	if p.SmoothingPrior != nil {
+		if *p.SmoothingPrior < 0.0 || *p.SmoothingPrior > 1.0 {
+			return fmt.Errorf("profile.smoothing_prior must be between 0.0 and 1.0 (got %.2f)", *p.SmoothingPrior)
+		}
+	}
+
+	// penalty scale must be positive; severity weights must not be negative
+	if p.PenaltyScale != nil && *p.PenaltyScale <= 0 {
+		return fmt.Errorf("profile.penalty_scale must be > 0 (got %.2f)", *p.PenaltyScale)
+	}
+	weights := map[string]*float64{
+		"error_weight":   p.ErrorWeight,
+		"warning_weight": p.WarningWeight,
+		"info_weight":    p.InfoWeight,
+	}
+	for name, ptr := range weights {
+		if ptr != nil && *ptr < 0 {
+			return fmt.Errorf("profile.%s must be >= 0 (got %.2f)", name, *ptr)
+		}
+	}
+
+	if p.MinPackageCohesion != nil {
+		if *p.MinPackageCohesion < 0.0 || *p.MinPackageCohesion > 1.0 {
+			return fmt.Errorf("profile.min_package_cohesion must be between 0.0 and 1.0 (got %.2f)", *p.MinPackageCohesion)
+		}
+	}
+	if p.MaxTinyPackageRatio != nil {
+		if *p.MaxTinyPackageRatio < 0.0 || *p.MaxTinyPackageRatio > 1.0 {
+			return fmt.Errorf("profile.max_tiny_package_ratio must be between 0.0 and 1.0 (got %.2f)", *p.MaxTinyPackageRatio)
+		}
+	}
+
 	// min_test_ratio must be in [0.0, 1.0]
 	if p.MinTestRatio != nil {
 		if *p.MinTestRatio < 0.0 || *p.MinTestRatio > 1.0 {
@@ -263,6 +483,28 @@ func (p ProfileOverrides) validate() error {
 		}
 	}
 
+	// word list entries must be non-empty
+	wordLists := map[string]*WordListOverride{
+		"vague_package_names":     p.VaguePackageNames,
+		"stutter_exempt_packages": p.StutterExemptPackages,
+		"generic_words":           p.GenericWords,
+		"action_words":            p.ActionWords,
+		"domain_words":            p.DomainWords,
+		"acronyms":                p.Acronyms,
+		"magic_number_allowlist":  p.MagicNumberAllowlist,
+		"safe_sql_builders":       p.SafeSQLBuilders,
+	}
+	for name, o := range wordLists {
+		if o == nil {
+			continue
+		}
+		for _, w := range append(append([]string(nil), o.Add...), o.Remove...) {
+			if strings.TrimSpace(w) == "" {
+				return fmt.Errorf("profile.%s entries must not be empty", name)
+			}
+		}
+	}
+
 	// context_files validation
 	for i, cf := range p.ContextFiles {
 		if cf.Name == "" {
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+)
+
+// ConfigProblem is one finding of `openkraft config validate`: a key the
+// config schema does not know, a value of the wrong type, an invalid value
+// or settings that contradict each other.
+type ConfigProblem struct {
+	Severity string `json:"severity"`       // error, warning or info
+	Line     int    `json:"line,omitempty"` // line in .openkraft.yaml, when known
+	Message  string `json:"message"`
+}
+
+// ResolvedWeights returns the weight of every category: the configured
+// weight, or the built-in one, which the default project type's weights
+// match. security_hints carries no weight unless configured.
+func (c ProjectConfig) ResolvedWeights() map[string]float64 {
+	weights := DefaultConfigForType("").Weights
+	weights["security_hints"] = 0
+	maps.Copy(weights, c.Weights)
+	return weights
+}
+
+// Conflicts reports settings of a valid, resolved config that contradict
+// each other: weights that do not sum to 1, no weight on any scored
+// category, gates and thresholds on skipped categories, and custom grade
+// bands hiding a calibration's bands.
+func (c ProjectConfig) Conflicts() []ConfigProblem {
+	var problems []ConfigProblem
+	add := func(severity, format string, args ...any) {
+		problems = append(problems, ConfigProblem{Severity: severity, Message: fmt.Sprintf(format, args...)})
+	}
+
+	weights := c.ResolvedWeights()
+	var sum, scored float64
+	for _, name := range slices.Sorted(maps.Keys(weights)) {
+		sum += weights[name]
+		if !c.IsSkippedCategory(name) {
+			scored += weights[name]
+		}
+	}
+	if scored == 0 {
+		add(SeverityError, "no scored category carries weight, so the overall score is always 0")
+	} else if math.Abs(sum-1) > 0.05 {
+		add(SeverityWarning, "weights sum to %.2f, not 1; each category counts as its share of the sum", sum)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(c.MinThresholds)) {
+		if c.IsSkippedCategory(name) {
+			add(SeverityWarning, "min_thresholds sets %q, which skip.categories excludes from scoring", name)
+		}
+	}
+	if c.Gates != nil {
+		for _, name := range slices.Sorted(maps.Keys(c.Gates.MinCategory)) {
+			if c.IsSkippedCategory(name) {
+				add(SeverityWarning, "gates.min_category.%s can never fail: skip.categories excludes %q from scoring", name, name)
+			}
+		}
+	}
+
+	if c.Calibration != "" && len(c.Grades) > 0 {
+		add(SeverityInfo, "grades replace the grade bands of calibration %q; only its limits apply", c.Calibration)
+	}
+	return problems
+}
@@ -0,0 +1,57 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvedWeights_FillsBuiltInWeights(t *testing.T) {
+	w := domain.ProjectConfig{Weights: map[string]float64{"verifiability": 0.3}}.ResolvedWeights()
+	assert.Len(t, w, len(domain.ValidCategories))
+	assert.InDelta(t, 0.3, w["verifiability"], 0.001)
+	assert.InDelta(t, 0.25, w["code_health"], 0.001)
+	assert.Zero(t, w["security_hints"])
+}
+
+func TestConflicts_DefaultConfigHasNone(t *testing.T) {
+	assert.Empty(t, domain.DefaultConfig().Conflicts())
+	for _, pt := range domain.ValidProjectTypes {
+		assert.Empty(t, domain.DefaultConfigForType(pt).Conflicts(), pt)
+	}
+}
+
+func TestConflicts_WeightsNotSummingToOne(t *testing.T) {
+	problems := domain.ProjectConfig{Weights: map[string]float64{"verifiability": 0.3}}.Conflicts()
+	require.Len(t, problems, 1)
+	assert.Equal(t, domain.SeverityWarning, problems[0].Severity)
+	assert.Contains(t, problems[0].Message, "weights sum to 1.15")
+}
+
+func TestConflicts_NoScoredWeight(t *testing.T) {
+	cfg := domain.ProjectConfig{
+		Weights: map[string]float64{"code_health": 1, "discoverability": 0, "structure": 0,
+			"verifiability": 0, "context_quality": 0, "predictability": 0},
+		Skip: domain.SkipConfig{Categories: []string{"code_health"}},
+	}
+	problems := cfg.Conflicts()
+	require.Len(t, problems, 1)
+	assert.Equal(t, domain.SeverityError, problems[0].Severity)
+}
+
+func TestConflicts_SkippedCategoryGates(t *testing.T) {
+	cfg := domain.ProjectConfig{
+		Skip:          domain.SkipConfig{Categories: []string{"context_quality"}},
+		MinThresholds: map[string]int{"context_quality": 60},
+		Gates:         &domain.GatesConfig{MinCategory: map[string]int{"context_quality": 70, "structure": 70}},
+		Calibration:   domain.CalibrationStrict,
+		Grades:        []domain.GradeBand{{Grade: "pass", Min: 50}, {Grade: "fail", Min: 0}},
+	}
+	problems := cfg.Conflicts()
+	require.Len(t, problems, 3)
+	assert.Contains(t, problems[0].Message, `min_thresholds sets "context_quality"`)
+	assert.Contains(t, problems[1].Message, "gates.min_category.context_quality can never fail")
+	assert.Equal(t, domain.SeverityInfo, problems[2].Severity)
+}
@@ -127,6 +127,22 @@ func TestValidate_WeightsSumTooLow(t *testing.T) {
 	assert.Contains(t, err.Error(), "weights sum")
 }
 
+func TestValidate_WeightsWithSecurityHints(t *testing.T) {
+	cfg := domain.ProjectConfig{
+		Weights: map[string]float64{
+			"code_health": 0.25, "discoverability": 0.20, "structure": 0.15,
+			"verifiability": 0.15, "context_quality": 0.10, "predictability": 0.10,
+			"security_hints": 0.05,
+		},
+	}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Weights["security_hints"] = 0.50
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "weights sum")
+}
+
 func TestValidate_InvalidCategoryInWeights(t *testing.T) {
 	cfg := domain.ProjectConfig{
 		Weights: map[string]float64{"nonexistent": 0.25},
@@ -204,6 +220,16 @@ func TestValidate_ProfileNamingConventionInvalid(t *testing.T) {
 	assert.Contains(t, err.Error(), "unknown naming_convention")
 }
 
+func TestValidate_ProfilePreferredLogger(t *testing.T) {
+	cfg := domain.ProjectConfig{Profile: &domain.ProfileOverrides{PreferredLogger: "slog"}}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Profile.PreferredLogger = "log4j"
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown preferred_logger")
+}
+
 func TestValidate_ProfileNegativeThreshold(t *testing.T) {
 	neg := -1
 	cfg := domain.ProjectConfig{Profile: &domain.ProfileOverrides{MaxFunctionLines: &neg}}
@@ -229,6 +255,22 @@ func TestValidate_ProfileTestRatioOutOfRange(t *testing.T) {
 	assert.Contains(t, err.Error(), "min_test_ratio")
 }
 
+func TestValidate_ProfilePackageCohesionOutOfRange(t *testing.T) {
+	cohesion := -0.1
+	cfg := domain.ProjectConfig{Profile: &domain.ProfileOverrides{MinPackageCohesion: &cohesion}}
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "min_package_cohesion")
+}
+
+func TestValidate_ProfileTinyPackageRatioOutOfRange(t *testing.T) {
+	ratio := 1.2
+	cfg := domain.ProjectConfig{Profile: &domain.ProfileOverrides{MaxTinyPackageRatio: &ratio}}
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "max_tiny_package_ratio")
+}
+
 func TestValidate_ProfileContextFileEmptyName(t *testing.T) {
 	cfg := domain.ProjectConfig{
 		Profile: &domain.ProfileOverrides{
@@ -285,3 +327,107 @@ func TestDefaultConfigForType_WeightsSum(t *testing.T) {
 		assert.InDelta(t, 1.0, sum, 0.05, "weights for %s should sum to ~1.0", pt)
 	}
 }
+
+// --- Gates validation tests ---
+
+func TestValidate_GatesValid(t *testing.T) {
+	minOverall := 80
+	cfg := domain.ProjectConfig{Gates: &domain.GatesConfig{
+		MinOverall:  &minOverall,
+		MinCategory: map[string]int{"code_health": 75},
+		MaxIssues:   map[string]int{"error": 0},
+	}}
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestValidate_GatesMinOverallOutOfRange(t *testing.T) {
+	minOverall := 120
+	cfg := domain.ProjectConfig{Gates: &domain.GatesConfig{MinOverall: &minOverall}}
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "gates.min_overall")
+}
+
+func TestValidate_GatesUnknownCategory(t *testing.T) {
+	cfg := domain.ProjectConfig{Gates: &domain.GatesConfig{MinCategory: map[string]int{"tests": 50}}}
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown category")
+}
+
+func TestValidate_GatesUnknownSeverity(t *testing.T) {
+	cfg := domain.ProjectConfig{Gates: &domain.GatesConfig{MaxIssues: map[string]int{"critical": 0}}}
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown severity")
+}
+
+// --- Calibration and grade band validation tests ---
+
+func TestValidate_CalibrationPreset(t *testing.T) {
+	assert.NoError(t, domain.ProjectConfig{Calibration: "legacy-friendly"}.Validate())
+
+	err := domain.ProjectConfig{Calibration: "lenient"}.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown calibration")
+}
+
+func TestValidate_PenaltyModel(t *testing.T) {
+	assert.NoError(t, domain.ProjectConfig{PenaltyModel: "strict"}.Validate())
+
+	err := domain.ProjectConfig{PenaltyModel: "harsh"}.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown penalty model")
+
+	scale := 0.0
+	err = domain.ProjectConfig{Profile: &domain.ProfileOverrides{PenaltyScale: &scale}}.Validate()
+	assert.ErrorContains(t, err, "penalty_scale")
+
+	weight := -1.0
+	err = domain.ProjectConfig{Profile: &domain.ProfileOverrides{WarningWeight: &weight}}.Validate()
+	assert.ErrorContains(t, err, "warning_weight")
+}
+
+func TestValidate_GradeBandsMustDescend(t *testing.T) {
+	cfg := domain.ProjectConfig{Grades: []domain.GradeBand{{Grade: "A", Min: 80}, {Grade: "B", Min: 85}}}
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "must be lower")
+}
+
+func TestValidate_GradeBandsRange(t *testing.T) {
+	cfg := domain.ProjectConfig{Grades: []domain.GradeBand{{Grade: "A", Min: 101}}}
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "between 0 and 100")
+}
+
+func TestWordListOverride_Apply(t *testing.T) {
+	tests := []struct {
+		name     string
+		override *domain.WordListOverride
+		want     []string
+	}{
+		{"nil keeps list", nil, []string{"util", "common"}},
+		{"add appends", &domain.WordListOverride{Add: []string{"misc"}}, []string{"util", "common", "misc"}},
+		{"remove ignores case", &domain.WordListOverride{Remove: []string{"UTIL"}}, []string{"common"}},
+		{"add skips duplicates", &domain.WordListOverride{Add: []string{"Common", "misc", "misc"}}, []string{"util", "common", "misc"}},
+		{"remove then re-add", &domain.WordListOverride{Add: []string{"Util"}, Remove: []string{"util"}}, []string{"common", "Util"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.override.Apply([]string{"util", "common"}))
+		})
+	}
+}
+
+func TestValidate_ProfileWordListEmptyEntry(t *testing.T) {
+	cfg := domain.ProjectConfig{
+		Profile: &domain.ProfileOverrides{
+			GenericWords: &domain.WordListOverride{Add: []string{" "}},
+		},
+	}
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "generic_words")
+}
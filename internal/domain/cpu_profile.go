@@ -0,0 +1,19 @@
+package domain
+
+// CPUProfile is a pprof CPU profile reduced to what scoring needs: the
+// cumulative sample value of every source line that appeared on a sampled
+// stack. A line's value counts each sample once, however many frames of
+// the stack it appears in.
+type CPUProfile struct {
+	Total int64         // sum of all sample values
+	Lines []ProfileLine // in no particular order
+}
+
+// ProfileLine is the cumulative sample value of one source line. File is
+// the path recorded in the profile: absolute on the build machine, or
+// module-qualified when built with -trimpath.
+type ProfileLine struct {
+	File  string
+	Line  int
+	Value int64
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"cmp"
+	"path/filepath"
+	"slices"
+)
+
+// maxOldestDebtNotes bounds the dated notes listed in a DebtInventory.
+const maxOldestDebtNotes = 10
+
+// DebtNote is a TODO, FIXME or HACK comment. Author and Date come from an
+// attribution such as TODO(alice, 2024-03-01) when one is present.
+type DebtNote struct {
+	Kind   string `json:"kind"` // TODO, FIXME or HACK
+	File   string `json:"file,omitempty"`
+	Line   int    `json:"line"`
+	Author string `json:"author,omitempty"`
+	Date   string `json:"date,omitempty"` // YYYY-MM-DD
+	Text   string `json:"text,omitempty"`
+}
+
+// DebtInventory summarizes the debt notes of a project: totals by kind,
+// counts per package and the oldest dated notes. It is informational.
+type DebtInventory struct {
+	Total    int            `json:"total"`
+	ByKind   map[string]int `json:"by_kind"`
+	Packages []PackageDebt  `json:"packages"`
+	Oldest   []DebtNote     `json:"oldest,omitempty"`
+}
+
+// PackageDebt is the number of debt notes in one package directory.
+type PackageDebt struct {
+	Package string `json:"package"` // directory, relative to the project root
+	Notes   int    `json:"notes"`
+}
+
+// BuildDebtInventory collects the debt notes of the non-generated files in
+// analyzed. Packages are ordered by note count, then by directory; the
+// oldest notes are the dated ones with the earliest dates. It returns nil
+// when there are no notes.
+func BuildDebtInventory(analyzed map[string]*AnalyzedFile) *DebtInventory {
+	inv := &DebtInventory{ByKind: make(map[string]int)}
+	perPackage := make(map[string]int)
+	var dated []DebtNote
+	for _, af := range analyzed {
+		if af.IsGenerated {
+			continue
+		}
+		for _, n := range af.DebtNotes {
+			n.File = af.Path
+			inv.Total++
+			inv.ByKind[n.Kind]++
+			perPackage[filepath.ToSlash(filepath.Dir(af.Path))]++
+			if n.Date != "" {
+				dated = append(dated, n)
+			}
+		}
+	}
+	if inv.Total == 0 {
+		return nil
+	}
+
+	for pkg, notes := range perPackage {
+		inv.Packages = append(inv.Packages, PackageDebt{Package: pkg, Notes: notes})
+	}
+	slices.SortFunc(inv.Packages, func(a, b PackageDebt) int {
+		return cmp.Or(cmp.Compare(b.Notes, a.Notes), cmp.Compare(a.Package, b.Package))
+	})
+
+	slices.SortFunc(dated, func(a, b DebtNote) int {
+		return cmp.Or(cmp.Compare(a.Date, b.Date), cmp.Compare(a.File, b.File), cmp.Compare(a.Line, b.Line))
+	})
+	inv.Oldest = dated[:min(len(dated), maxOldestDebtNotes)]
+	return inv
+}
@@ -0,0 +1,36 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDebtInventory(t *testing.T) {
+	analyzed := map[string]*domain.AnalyzedFile{
+		"internal/store/db.go": {Path: "internal/store/db.go", DebtNotes: []domain.DebtNote{
+			{Kind: "TODO", Line: 3, Date: "2023-05-02"},
+			{Kind: "FIXME", Line: 9, Author: "alice", Date: "2021-11-30"},
+		}},
+		"internal/store/cache.go": {Path: "internal/store/cache.go", DebtNotes: []domain.DebtNote{{Kind: "HACK", Line: 1}}},
+		"internal/api/api.go":     {Path: "internal/api/api.go", DebtNotes: []domain.DebtNote{{Kind: "TODO", Line: 5, Date: "2022-01-10"}}},
+		"internal/api/api.pb.go":  {Path: "internal/api/api.pb.go", IsGenerated: true, DebtNotes: []domain.DebtNote{{Kind: "TODO", Line: 1}}},
+	}
+
+	inv := domain.BuildDebtInventory(analyzed)
+	require.NotNil(t, inv)
+
+	assert.Equal(t, 4, inv.Total)
+	assert.Equal(t, map[string]int{"TODO": 2, "FIXME": 1, "HACK": 1}, inv.ByKind)
+	assert.Equal(t, []domain.PackageDebt{{Package: "internal/store", Notes: 3}, {Package: "internal/api", Notes: 1}}, inv.Packages)
+	require.Len(t, inv.Oldest, 3, "undated notes are not listed")
+	assert.Equal(t, "2021-11-30", inv.Oldest[0].Date)
+	assert.Equal(t, "internal/store/db.go", inv.Oldest[0].File)
+	assert.Equal(t, "2023-05-02", inv.Oldest[2].Date)
+}
+
+func TestBuildDebtInventory_NoNotes(t *testing.T) {
+	assert.Nil(t, domain.BuildDebtInventory(map[string]*domain.AnalyzedFile{"a.go": {Path: "a.go"}}))
+}
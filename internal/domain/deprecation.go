@@ -0,0 +1,16 @@
+package domain
+
+import "strings"
+
+// DeprecationNote returns the "Deprecated:" paragraph of a doc comment,
+// joined onto one line, or "" when the comment does not deprecate its
+// symbol. As in go doc, the paragraph must start with "Deprecated: ".
+func DeprecationNote(doc string) string {
+	for _, para := range strings.Split(doc, "\n\n") {
+		para = strings.TrimSpace(para)
+		if strings.HasPrefix(para, "Deprecated: ") {
+			return strings.Join(strings.Fields(para), " ")
+		}
+	}
+	return ""
+}
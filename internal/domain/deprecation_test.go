@@ -0,0 +1,23 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecationNote(t *testing.T) {
+	tests := []struct {
+		doc, want string
+	}{
+		{"Open opens a store.\n\nDeprecated: use New.\n", "Deprecated: use New."},
+		{"Deprecated: use Lookup, which\nreports whether the key exists.\n\nMore text.\n", "Deprecated: use Lookup, which reports whether the key exists."},
+		{"Open opens a store. Deprecated: not a paragraph of its own.\n", ""},
+		{"Deprecated:use New.\n", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, domain.DeprecationNote(tt.doc), tt.doc)
+	}
+}
@@ -0,0 +1,207 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DisplayConfig shortens the import paths human-readable reports show. It
+// never changes JSON output or the paths scoring works with.
+type DisplayConfig struct {
+	// StripModulePrefix shows the module's own packages relative to the
+	// module path: internal/billing instead of example.com/org/svc/internal/billing.
+	StripModulePrefix bool `yaml:"strip_module_prefix,omitempty" json:"strip_module_prefix,omitempty"`
+	// Aliases maps import path prefixes to short names, so
+	// github.com/org/platform/billing: billing shows
+	// github.com/org/platform/billing/api as billing/api.
+	Aliases map[string]string `yaml:"aliases,omitempty" json:"aliases,omitempty"`
+}
+
+// Validate rejects aliases with an empty prefix or name.
+func (c *DisplayConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for prefix, alias := range c.Aliases {
+		if strings.Trim(prefix, "/") == "" || alias == "" {
+			return fmt.Errorf("display.aliases: %q: %q needs a non-empty import path and name", prefix, alias)
+		}
+	}
+	return nil
+}
+
+// PathDisplay renders the import paths of one module for human-readable
+// output. A nil *PathDisplay shows every path unchanged.
+type PathDisplay struct {
+	modulePath string
+	strip      bool
+	aliases    []pathAlias // longest prefix first
+}
+
+type pathAlias struct {
+	prefix, name string
+}
+
+// NewPathDisplay returns the display of the packages of modulePath under
+// cfg, which may be nil.
+func NewPathDisplay(modulePath string, cfg *DisplayConfig) *PathDisplay {
+	d := &PathDisplay{modulePath: modulePath}
+	if cfg == nil {
+		return d
+	}
+	d.strip = cfg.StripModulePrefix
+	for prefix, name := range cfg.Aliases {
+		d.aliases = append(d.aliases, pathAlias{prefix: strings.TrimSuffix(prefix, "/"), name: name})
+	}
+	sort.Slice(d.aliases, func(i, j int) bool {
+		if len(d.aliases[i].prefix) != len(d.aliases[j].prefix) {
+			return len(d.aliases[i].prefix) > len(d.aliases[j].prefix)
+		}
+		return d.aliases[i].prefix < d.aliases[j].prefix
+	})
+	return d
+}
+
+// Stripped returns a copy of d that also strips the module prefix, for
+// outputs such as the import graph that always show module-relative paths.
+func (d *PathDisplay) Stripped() *PathDisplay {
+	c := *d
+	c.strip = true
+	return &c
+}
+
+// ModulePath returns the module path d displays packages of.
+func (d *PathDisplay) ModulePath() string {
+	if d == nil {
+		return ""
+	}
+	return d.modulePath
+}
+
+// Alias returns path with its longest matching alias applied, or path
+// unchanged. Unlike Package it never strips the module prefix, so it suits
+// module paths themselves.
+func (d *PathDisplay) Alias(path string) string {
+	if d == nil {
+		return path
+	}
+	for _, a := range d.aliases {
+		if rest, ok := cutPathPrefix(path, a.prefix); ok {
+			return a.name + rest
+		}
+	}
+	return path
+}
+
+// Package returns the display form of an import path: the longest matching
+// alias wins, then the module prefix is stripped if configured, with "."
+// for the module's root package.
+func (d *PathDisplay) Package(path string) string {
+	if d == nil {
+		return path
+	}
+	if alias := d.Alias(path); alias != path {
+		return alias
+	}
+	if d.strip && d.modulePath != "" {
+		if rest, ok := cutPathPrefix(path, d.modulePath); ok {
+			if rest == "" {
+				return "."
+			}
+			return rest[1:]
+		}
+	}
+	return path
+}
+
+// Text rewrites the import paths inside free text, such as issue messages,
+// to their display form. Only whole path segments match, so an alias for
+// example.com/app leaves example.com/application alone.
+func (d *PathDisplay) Text(s string) string {
+	if d == nil {
+		return s
+	}
+	for _, a := range d.aliases {
+		s = replacePathPrefix(s, a.prefix, a.name)
+	}
+	if d.strip && d.modulePath != "" {
+		s = replacePathPrefix(s, d.modulePath, "")
+	}
+	return s
+}
+
+// Apply returns a copy of score whose issue messages show display paths,
+// leaving score untouched. It returns score itself when nothing would change.
+func (d *PathDisplay) Apply(score *Score) *Score {
+	if d == nil || score == nil || (!d.strip && len(d.aliases) == 0) {
+		return score
+	}
+	out := *score
+	out.Categories = make([]CategoryScore, len(score.Categories))
+	for i, cat := range score.Categories {
+		cat.Issues = d.issues(cat.Issues)
+		out.Categories[i] = cat
+	}
+	if score.Findings != nil {
+		out.Findings = make([]Finding, len(score.Findings))
+		for i, f := range score.Findings {
+			f.Issues = d.issues(f.Issues)
+			out.Findings[i] = f
+		}
+	}
+	return &out
+}
+
+func (d *PathDisplay) issues(issues []Issue) []Issue {
+	if issues == nil {
+		return nil
+	}
+	out := make([]Issue, len(issues))
+	for i, iss := range issues {
+		iss.Message = d.Text(iss.Message)
+		out[i] = iss
+	}
+	return out
+}
+
+// cutPathPrefix reports whether path is prefix or lies under it, and
+// returns the remainder, which is empty or starts with a slash.
+func cutPathPrefix(path, prefix string) (string, bool) {
+	rest, ok := strings.CutPrefix(path, prefix)
+	if !ok || (rest != "" && rest[0] != '/') {
+		return "", false
+	}
+	return rest, true
+}
+
+// replacePathPrefix replaces every occurrence of the import path prefix in
+// s that starts and ends at a path boundary with name. An empty name strips
+// the prefix and its slash from longer paths and leaves the bare prefix.
+func replacePathPrefix(s, prefix, name string) string {
+	var b strings.Builder
+	for {
+		i := strings.Index(s, prefix)
+		if i < 0 {
+			b.WriteString(s)
+			return b.String()
+		}
+		end := i + len(prefix)
+		b.WriteString(s[:i])
+		startOK := i == 0 || !isPathChar(s[i-1])
+		switch {
+		case startOK && end < len(s) && s[end] == '/' && name == "":
+			end++
+		case startOK && (end == len(s) || !isPathChar(s[end]) || s[end] == '/') && name != "":
+			b.WriteString(name)
+		default:
+			b.WriteString(prefix)
+		}
+		s = s[end:]
+	}
+}
+
+func isPathChar(c byte) bool {
+	return c == '/' || c == '.' || c == '-' || c == '_' || c == '~' ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathDisplay_Package(t *testing.T) {
+	d := NewPathDisplay("github.com/acme/platform/svc", &DisplayConfig{
+		StripModulePrefix: true,
+		Aliases: map[string]string{
+			"github.com/acme/platform":         "platform",
+			"github.com/acme/platform/billing": "billing",
+		},
+	})
+
+	tests := map[string]string{
+		"github.com/acme/platform/billing/api": "billing/api",
+		"github.com/acme/platform/auth":        "platform/auth",
+		"github.com/acme/platformer":           "github.com/acme/platformer",
+		"golang.org/x/tools":                   "golang.org/x/tools",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, d.Package(in), in)
+	}
+
+	strip := NewPathDisplay("example.com/app", &DisplayConfig{StripModulePrefix: true})
+	assert.Equal(t, "internal/store", strip.Package("example.com/app/internal/store"))
+	assert.Equal(t, ".", strip.Package("example.com/app"))
+	assert.Equal(t, "example.com/application", strip.Package("example.com/application"))
+
+	var none *PathDisplay
+	assert.Equal(t, "example.com/app/x", none.Package("example.com/app/x"))
+	assert.Equal(t, "example.com/app/x", NewPathDisplay("example.com/app", nil).Package("example.com/app/x"))
+}
+
+func TestPathDisplay_Text(t *testing.T) {
+	d := NewPathDisplay("example.com/app", &DisplayConfig{
+		StripModulePrefix: true,
+		Aliases:           map[string]string{"github.com/acme/kit": "kit"},
+	})
+
+	assert.Equal(t,
+		`package "internal/cli" imports kit/log and example.com/application/x`,
+		d.Text(`package "example.com/app/internal/cli" imports github.com/acme/kit/log and example.com/application/x`))
+	assert.Equal(t, "module example.com/app", d.Text("module example.com/app"))
+	assert.Equal(t, "see notgithub.com/acme/kit", d.Text("see notgithub.com/acme/kit"))
+}
+
+func TestPathDisplay_ApplyLeavesScoreUntouched(t *testing.T) {
+	score := &Score{
+		Categories: []CategoryScore{{Name: "structure", Issues: []Issue{{Message: "example.com/app/internal/a imports example.com/app/internal/b"}}}},
+		Findings:   []Finding{{Issues: []Issue{{Message: "example.com/app/internal/a"}}}},
+	}
+	d := NewPathDisplay("example.com/app", &DisplayConfig{StripModulePrefix: true})
+
+	out := d.Apply(score)
+	assert.Equal(t, "internal/a imports internal/b", out.Categories[0].Issues[0].Message)
+	assert.Equal(t, "internal/a", out.Findings[0].Issues[0].Message)
+	assert.Equal(t, "example.com/app/internal/a imports example.com/app/internal/b", score.Categories[0].Issues[0].Message)
+
+	assert.Same(t, score, NewPathDisplay("example.com/app", nil).Apply(score), "nothing to rewrite")
+}
+
+func TestDisplayConfig_Validate(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Display = &DisplayConfig{Aliases: map[string]string{"github.com/acme": ""}}
+	require.Error(t, cfg.Validate())
+
+	cfg.Display = &DisplayConfig{Aliases: map[string]string{"github.com/acme": "acme"}}
+	require.NoError(t, cfg.Validate())
+}
+
+func TestOverlayConfig_MergesDisplay(t *testing.T) {
+	base := ProjectConfig{Display: &DisplayConfig{StripModulePrefix: true, Aliases: map[string]string{"a.com/x": "x", "a.com/y": "y"}}}
+	local := ProjectConfig{Display: &DisplayConfig{Aliases: map[string]string{"a.com/y": "why"}}}
+
+	got := OverlayConfig(base, local).Display
+	require.NotNil(t, got)
+	assert.True(t, got.StripModulePrefix)
+	assert.Equal(t, map[string]string{"a.com/x": "x", "a.com/y": "why"}, got.Aliases)
+}
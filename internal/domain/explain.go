@@ -0,0 +1,52 @@
+package domain
+
+// Explanation breaks a category score down into the inputs of its formula so
+// users can audit how the number was produced.
+type Explanation struct {
+	Category   string                 `json:"category"`
+	Score      int                    `json:"score"`
+	Weight     float64                `json:"weight"`
+	BaseScore  int                    `json:"base_score"`
+	SubMetrics []SubMetricExplanation `json:"sub_metrics"`
+	Penalty    *PenaltyBreakdown      `json:"penalty,omitempty"`
+	Note       string                 `json:"note,omitempty"`
+}
+
+// SubMetricExplanation details how one sub-metric's points were earned.
+// For decay-scored sub-metrics, Items lists every unit that lost credit.
+type SubMetricExplanation struct {
+	Name      string       `json:"name"`
+	Score     int          `json:"score"`
+	Points    int          `json:"points"`
+	Detail    string       `json:"detail,omitempty"`
+	Skipped   bool         `json:"skipped,omitempty"`
+	Formula   string       `json:"formula,omitempty"`
+	Evaluated int          `json:"evaluated,omitempty"`
+	Earned    float64      `json:"earned,omitempty"`
+	Items     []CreditItem `json:"items,omitempty"`
+	Issues    int          `json:"issues"`
+}
+
+// CreditItem is a single function or file whose value exceeded its limit,
+// together with the decay credit it earned in [0,1].
+type CreditItem struct {
+	File   string  `json:"file"`
+	Line   int     `json:"line,omitempty"`
+	Symbol string  `json:"symbol,omitempty"`
+	Value  int     `json:"value"`
+	Limit  int     `json:"limit"`
+	Credit float64 `json:"credit"`
+}
+
+// PenaltyBreakdown shows the severity-weighted penalty computation:
+// penalty = round(weighted / normalizer * scale), floored at 1 with errors.
+type PenaltyBreakdown struct {
+	Errors     int     `json:"errors"`
+	Warnings   int     `json:"warnings"`
+	Infos      int     `json:"infos"`
+	Weighted   float64 `json:"weighted"`
+	Normalizer int     `json:"normalizer"`
+	DebtRatio  float64 `json:"debt_ratio"`
+	Scale      float64 `json:"scale"`
+	Penalty    int     `json:"penalty"`
+}
@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// PackageExposure is the over-exposed API of an internal package imported
+// by a single other package: the exported symbols that importer, and every
+// test outside the package, never reference. They could be unexported.
+type PackageExposure struct {
+	Package  string   `json:"package"`  // directory, relative to the project root
+	Importer string   `json:"importer"` // directory of the only importing package
+	Exported int      `json:"exported"` // exported functions, types and variables
+	Unused   []string `json:"unused"`
+}
+
+// typeNameRef matches an unqualified exported name in a type expression;
+// pkg.Name is left out because it names another package's type.
+var typeNameRef = regexp.MustCompile(`(?:^|[^.\w])([A-Z]\w*)`)
+
+// BuildExposureReport lists, for every package under an internal/
+// directory that exactly one other non-test package imports, the exported
+// package-level functions, structs, interfaces and variables that no file
+// outside the package selects. Types reachable from a used symbol, through
+// a function signature, a method of a used type or an exported field, count
+// as used. Methods and fields themselves are not reported: without type
+// information a call through a value cannot be attributed to its type.
+// Packages with nothing to unexport are left out; the result is ordered by
+// directory and is nil without a module path.
+func BuildExposureReport(modulePath string, analyzed map[string]*AnalyzedFile) []PackageExposure {
+	if modulePath == "" {
+		return nil
+	}
+	importPath := func(dir string) string {
+		if dir == "." {
+			return modulePath
+		}
+		return modulePath + "/" + dir
+	}
+
+	type pkgInfo struct {
+		dir     string
+		files   []*AnalyzedFile
+		imports map[string]bool // importing package directories
+		refs    map[string]bool
+	}
+	pkgs := make(map[string]*pkgInfo) // by import path
+	paths := make([]string, 0, len(analyzed))
+	for p := range analyzed {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		af := analyzed[p]
+		dir := filepath.ToSlash(filepath.Dir(af.Path))
+		if af.IsGenerated || strings.HasSuffix(af.Path, "_test.go") || af.Package == "main" || !isInternalDir(dir) {
+			continue
+		}
+		ip := importPath(dir)
+		if pkgs[ip] == nil {
+			pkgs[ip] = &pkgInfo{dir: dir, imports: make(map[string]bool), refs: make(map[string]bool)}
+		}
+		pkgs[ip].files = append(pkgs[ip].files, af)
+	}
+
+	for _, p := range paths {
+		af := analyzed[p]
+		dir := filepath.ToSlash(filepath.Dir(af.Path))
+		test := strings.HasSuffix(af.Path, "_test.go")
+		for ip, names := range af.QualifiedRefs {
+			pkg := pkgs[ip]
+			if pkg == nil || (pkg.dir == dir && !strings.HasSuffix(af.Package, "_test")) {
+				continue
+			}
+			for _, n := range names {
+				pkg.refs[n] = true
+			}
+		}
+		if test {
+			continue
+		}
+		for _, ip := range af.Imports {
+			if pkg := pkgs[ip]; pkg != nil && pkg.dir != dir {
+				pkg.imports[dir] = true
+			}
+		}
+	}
+
+	var report []PackageExposure
+	for _, pkg := range pkgs {
+		if len(pkg.imports) != 1 {
+			continue
+		}
+		exported, used := exposedSymbols(pkg.files, pkg.refs)
+		var unused []string
+		for _, name := range exported {
+			if !used[name] {
+				unused = append(unused, name)
+			}
+		}
+		if len(unused) == 0 {
+			continue
+		}
+		var importer string
+		for dir := range pkg.imports {
+			importer = dir
+		}
+		report = append(report, PackageExposure{Package: pkg.dir, Importer: importer, Exported: len(exported), Unused: unused})
+	}
+	sort.Slice(report, func(i, j int) bool { return report[i].Package < report[j].Package })
+	return report
+}
+
+// exposedSymbols returns the sorted exported package-level symbols of a
+// package's files and the subset in use: referenced from outside, or a
+// type reachable from a used symbol.
+func exposedSymbols(files []*AnalyzedFile, refs map[string]bool) ([]string, map[string]bool) {
+	exported := make(map[string]bool)
+	funcs := make(map[string]Function)
+	methods := make(map[string][]Function) // by receiver type
+	fields := make(map[string][]Param)     // exported fields, by struct
+	for _, af := range files {
+		for _, fn := range af.Functions {
+			switch {
+			case !fn.Exported:
+			case fn.Receiver == "":
+				exported[fn.Name] = true
+				funcs[fn.Name] = fn
+			default:
+				recv := strings.TrimPrefix(fn.Receiver, "*")
+				if i := strings.IndexByte(recv, '['); i >= 0 {
+					recv = recv[:i]
+				}
+				methods[recv] = append(methods[recv], fn)
+			}
+		}
+		for _, sd := range af.StructDefs {
+			for _, f := range sd.Fields {
+				if f.Name != "" && unicode.IsUpper(rune(f.Name[0])) {
+					fields[sd.Name] = append(fields[sd.Name], f)
+				}
+			}
+		}
+		for _, names := range [][]string{af.Structs, af.Interfaces, af.GlobalVars} {
+			for _, n := range names {
+				if n != "" && unicode.IsUpper(rune(n[0])) {
+					exported[n] = true
+				}
+			}
+		}
+	}
+
+	used := make(map[string]bool)
+	var queue []string
+	mark := func(name string) {
+		if exported[name] && !used[name] {
+			used[name] = true
+			queue = append(queue, name)
+		}
+	}
+	markTypes := func(types ...string) {
+		for _, t := range types {
+			for _, m := range typeNameRef.FindAllStringSubmatch(t, -1) {
+				mark(m[1])
+			}
+		}
+	}
+	markSignature := func(fn Function) {
+		for _, p := range fn.Params {
+			markTypes(p.Type)
+		}
+		markTypes(fn.Returns...)
+	}
+	for name := range refs {
+		mark(name)
+	}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if fn, ok := funcs[name]; ok {
+			markSignature(fn)
+		}
+		for _, m := range methods[name] {
+			markSignature(m)
+		}
+		for _, f := range fields[name] {
+			markTypes(f.Type)
+		}
+	}
+
+	names := make([]string, 0, len(exported))
+	for n := range exported {
+		names = append(names, n)
+	}
+	slices.Sort(names)
+	return names, used
+}
+
+// isInternalDir reports whether dir is an internal directory or lies
+// below one, so only its parent tree may import it.
+func isInternalDir(dir string) bool {
+	return slices.Contains(strings.Split(dir, "/"), "internal")
+}
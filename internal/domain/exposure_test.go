@@ -0,0 +1,49 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildExposureReport(t *testing.T) {
+	analyzed := map[string]*domain.AnalyzedFile{
+		"internal/store/store.go": {Path: "internal/store/store.go", Package: "store",
+			Structs:    []string{"Store", "Options", "Record", "row"},
+			StructDefs: []domain.StructDef{{Name: "Store"}, {Name: "Options"}, {Name: "Record", Fields: []domain.Param{{Name: "Meta", Type: "*Meta"}}}},
+			Interfaces: []string{"Backend"},
+			GlobalVars: []string{"ErrNotFound", "defaultTTL"},
+			Functions: []domain.Function{
+				{Name: "New", Exported: true, Params: []domain.Param{{Name: "opts", Type: "Options"}}, Returns: []string{"*Store", "error"}},
+				{Name: "Get", Receiver: "*Store", Exported: true, Returns: []string{"Record", "error"}},
+				{Name: "Compact", Exported: true},
+				{Name: "helper"},
+			},
+		},
+		"internal/store/meta.go": {Path: "internal/store/meta.go", Package: "store", Structs: []string{"Meta"}},
+		"internal/store/export_test.go": {Path: "internal/store/export_test.go", Package: "store_test",
+			QualifiedRefs: map[string][]string{"example.com/app/internal/store": {"ErrNotFound"}},
+		},
+		"internal/app/service.go": {Path: "internal/app/service.go", Package: "app",
+			Imports:       []string{"example.com/app/internal/store"},
+			QualifiedRefs: map[string][]string{"example.com/app/internal/store": {"New"}},
+		},
+		"internal/shared/shared.go": {Path: "internal/shared/shared.go", Package: "shared", Functions: []domain.Function{{Name: "Unused", Exported: true}}},
+		"internal/app/a.go":         {Path: "internal/app/a.go", Package: "app", Imports: []string{"example.com/app/internal/shared"}},
+		"cmd/tool/main.go":          {Path: "cmd/tool/main.go", Package: "main", Imports: []string{"example.com/app/internal/shared"}},
+	}
+
+	report := domain.BuildExposureReport("example.com/app", analyzed)
+
+	require.Len(t, report, 1, "shared has two importers")
+	assert.Equal(t, domain.PackageExposure{
+		Package:  "internal/store",
+		Importer: "internal/app",
+		Exported: 8,
+		Unused:   []string{"Backend", "Compact"},
+	}, report[0], "Options, Store, Record and Meta are reachable from New; ErrNotFound is used by a test")
+
+	assert.Nil(t, domain.BuildExposureReport("", analyzed))
+}
@@ -0,0 +1,83 @@
+package domain
+
+import "sort"
+
+// FileScore is the result of scoring one file in its project: how far the
+// overall score and each sub-metric move with the file present instead of
+// absent, and the issues located in the file.
+type FileScore struct {
+	Path          string            `json:"path"`
+	Overall       int               `json:"overall"`        // project score with the file
+	OverallImpact int               `json:"overall_impact"` // Overall minus the score without the file
+	SubMetrics    []SubMetricImpact `json:"sub_metrics"`
+	Issues        []Issue           `json:"issues"`
+	// Partial is set when the file has syntax errors and was measured by
+	// the analyzer's token-level fallback, so only some sub-metrics see it.
+	Partial bool `json:"partial,omitempty"`
+}
+
+// SubMetricImpact is one sub-metric the file moves or has issues in.
+type SubMetricImpact struct {
+	Category  string `json:"category"`
+	SubMetric string `json:"sub_metric"`
+	Score     int    `json:"score"` // with the file
+	Points    int    `json:"points"`
+	Impact    int    `json:"impact"` // Score minus the score without the file
+	Issues    int    `json:"issues"` // issues located in the file
+}
+
+// BuildFileScore compares a project scored with the file at path against
+// the same project scored without it. Sub-metrics the file neither moves
+// nor has issues in are left out; the rest are ordered by impact, the most
+// costly first.
+func BuildFileScore(path string, with, without *Score) *FileScore {
+	before := make(map[string]int)
+	for _, cat := range without.Categories {
+		for _, sm := range cat.SubMetrics {
+			before[cat.Name+"/"+sm.Name] = sm.Score
+		}
+	}
+
+	fs := &FileScore{Path: path, Overall: with.Overall, OverallImpact: with.Overall - without.Overall, Issues: []Issue{}}
+	for _, cat := range with.Categories {
+		issues := make(map[string]int)
+		for _, iss := range cat.Issues {
+			if iss.File == path {
+				issues[iss.SubMetric]++
+				fs.Issues = append(fs.Issues, iss)
+			}
+		}
+		for _, sm := range cat.SubMetrics {
+			if sm.Skipped {
+				continue
+			}
+			impact := 0
+			if prev, ok := before[cat.Name+"/"+sm.Name]; ok {
+				impact = sm.Score - prev
+			}
+			if impact == 0 && issues[sm.Name] == 0 {
+				continue
+			}
+			fs.SubMetrics = append(fs.SubMetrics, SubMetricImpact{
+				Category: cat.Name, SubMetric: sm.Name, Score: sm.Score, Points: sm.Points,
+				Impact: impact, Issues: issues[sm.Name],
+			})
+		}
+	}
+	sort.SliceStable(fs.SubMetrics, func(i, j int) bool {
+		return fs.SubMetrics[i].Impact < fs.SubMetrics[j].Impact
+	})
+	SortIssues(fs.Issues)
+	return fs
+}
+
+// IssuesAtLeast counts the file's issues at or above severity.
+func (fs *FileScore) IssuesAtLeast(severity string) int {
+	n := 0
+	for _, iss := range fs.Issues {
+		if severityRank[iss.Severity] <= severityRank[severity] {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildFileScore(t *testing.T) {
+	without := &Score{Overall: 90, Categories: []CategoryScore{{
+		Name: "code_health",
+		SubMetrics: []SubMetric{
+			{Name: "function_size", Score: 20, Points: 20},
+			{Name: "parameter_count", Score: 15, Points: 15},
+			{Name: "file_size", Score: 10, Points: 10},
+		},
+	}}}
+	with := &Score{Overall: 87, Categories: []CategoryScore{{
+		Name: "code_health",
+		SubMetrics: []SubMetric{
+			{Name: "function_size", Score: 17, Points: 20},
+			{Name: "parameter_count", Score: 14, Points: 15},
+			{Name: "file_size", Score: 10, Points: 10},
+			{Name: "nesting_depth", Score: 5, Points: 5, Skipped: true},
+		},
+		Issues: []Issue{
+			{Severity: SeverityWarning, SubMetric: "function_size", File: "a.go", Line: 9},
+			{Severity: SeverityInfo, SubMetric: "file_size", File: "a.go", Line: 1},
+			{Severity: SeverityError, SubMetric: "function_size", File: "b.go", Line: 3},
+		},
+	}}}
+
+	fs := BuildFileScore("a.go", with, without)
+
+	assert.Equal(t, 87, fs.Overall)
+	assert.Equal(t, -3, fs.OverallImpact)
+	require.Len(t, fs.SubMetrics, 3, "unmoved sub-metrics without issues and skipped ones are left out")
+	assert.Equal(t, "function_size", fs.SubMetrics[0].SubMetric)
+	assert.Equal(t, -3, fs.SubMetrics[0].Impact)
+	assert.Equal(t, 1, fs.SubMetrics[0].Issues)
+	assert.Equal(t, "parameter_count", fs.SubMetrics[1].SubMetric)
+	assert.Equal(t, "file_size", fs.SubMetrics[2].SubMetric)
+	assert.Zero(t, fs.SubMetrics[2].Impact)
+	require.Len(t, fs.Issues, 2, "issues in other files are left out")
+
+	assert.Equal(t, 2, fs.IssuesAtLeast(SeverityInfo))
+	assert.Equal(t, 1, fs.IssuesAtLeast(SeverityWarning))
+	assert.Zero(t, fs.IssuesAtLeast(SeverityError))
+}
@@ -0,0 +1,22 @@
+package domain
+
+// DecayCredit is one file's share of a decay sub-metric: the credit its
+// functions or lines earned and how many were checked.
+type DecayCredit struct {
+	Earned float64
+	Total  int
+}
+
+// FileTally is one file's contribution to the file-local sub-metrics of
+// code_health and security_hints: the decay credit of function_size,
+// file_size, cognitive_complexity and parameter_count, and the issues of
+// those sub-metrics and of hardcoded_secrets, sql_injection and
+// file_access. Summing the tallies of every file scores those sub-metrics,
+// so a file can be tallied as soon as it is analyzed.
+type FileTally struct {
+	FunctionSize        DecayCredit
+	FileSize            DecayCredit
+	CognitiveComplexity DecayCredit
+	ParameterCount      DecayCredit
+	Issues              []Issue
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Finding groups related issues so one problem is reported once: a huge
+// file that trips file_size, function_size and cognitive_complexity is a
+// single finding with three child issues. Issues are related when they
+// point at the same file; issues without a file stand alone.
+type Finding struct {
+	File       string   `json:"file,omitempty"`
+	Severity   string   `json:"severity"`              // most severe child
+	Categories []string `json:"categories"`            // distinct, in child order
+	SubMetrics []string `json:"sub_metrics,omitempty"` // distinct, in child order
+	Issues     []Issue  `json:"issues"`
+}
+
+// GroupFindings groups the issues of categories into findings, dropping
+// exact duplicates (same category, sub-metric, line and message). Findings
+// are ordered most severe first, then by issue count and file.
+func GroupFindings(categories []CategoryScore) []Finding {
+	var findings []Finding
+	byFile := make(map[string]int)
+	type issueKey struct {
+		category, subMetric, file, message string
+		line                               int
+	}
+	seen := make(map[issueKey]bool)
+	for _, cat := range categories {
+		for _, iss := range cat.Issues {
+			key := issueKey{iss.Category, iss.SubMetric, iss.File, iss.Message, iss.Line}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			idx, ok := byFile[iss.File]
+			if !ok || iss.File == "" {
+				idx = len(findings)
+				findings = append(findings, Finding{File: iss.File, Severity: iss.Severity})
+				if iss.File != "" {
+					byFile[iss.File] = idx
+				}
+			}
+			findings[idx].add(iss)
+		}
+	}
+
+	slices.SortStableFunc(findings, func(a, b Finding) int {
+		return cmp.Or(
+			cmp.Compare(severityRank[a.Severity], severityRank[b.Severity]),
+			cmp.Compare(len(b.Issues), len(a.Issues)),
+			cmp.Compare(a.File, b.File),
+		)
+	})
+	return findings
+}
+
+func (f *Finding) add(iss Issue) {
+	f.Issues = append(f.Issues, iss)
+	if severityRank[iss.Severity] < severityRank[f.Severity] {
+		f.Severity = iss.Severity
+	}
+	if !slices.Contains(f.Categories, iss.Category) {
+		f.Categories = append(f.Categories, iss.Category)
+	}
+	if iss.SubMetric != "" && !slices.Contains(f.SubMetrics, iss.SubMetric) {
+		f.SubMetrics = append(f.SubMetrics, iss.SubMetric)
+	}
+}
@@ -0,0 +1,60 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestGroupFindings_GroupsByFile(t *testing.T) {
+	categories := []domain.CategoryScore{
+		{Name: "code_health", Issues: []domain.Issue{
+			{Severity: domain.SeverityWarning, Category: "code_health", SubMetric: "function_size", File: "big.go", Line: 10, Message: "function Run is 200 lines"},
+			{Severity: domain.SeverityError, Category: "code_health", SubMetric: "file_size", File: "big.go", Message: "file has 2000 lines"},
+			{Severity: domain.SeverityInfo, Category: "code_health", SubMetric: "function_size", File: "small.go", Line: 3, Message: "function Do is 60 lines"},
+		}},
+		{Name: "discoverability", Issues: []domain.Issue{
+			{Severity: domain.SeverityInfo, Category: "discoverability", SubMetric: "function_coupling", File: "big.go", Line: 10, Message: "function Run calls 30 distinct functions"},
+			{Severity: domain.SeverityWarning, Category: "discoverability", SubMetric: "dependency_direction", Message: `package "a" imports 12 internal packages`},
+		}},
+	}
+
+	findings := domain.GroupFindings(categories)
+
+	require.Len(t, findings, 3)
+	big := findings[0]
+	assert.Equal(t, "big.go", big.File)
+	assert.Equal(t, domain.SeverityError, big.Severity)
+	assert.Len(t, big.Issues, 3)
+	assert.Equal(t, []string{"code_health", "discoverability"}, big.Categories)
+	assert.Equal(t, []string{"function_size", "file_size", "function_coupling"}, big.SubMetrics)
+
+	assert.Empty(t, findings[1].File, "issues without a file stand alone")
+	assert.Equal(t, domain.SeverityWarning, findings[1].Severity)
+	assert.Equal(t, "small.go", findings[2].File)
+}
+
+func TestGroupFindings_DropsExactDuplicates(t *testing.T) {
+	iss := domain.Issue{Severity: domain.SeverityWarning, Category: "code_health", SubMetric: "function_size", File: "a.go", Line: 4, Message: "too long"}
+	findings := domain.GroupFindings([]domain.CategoryScore{{Issues: []domain.Issue{iss, iss}}})
+
+	require.Len(t, findings, 1)
+	assert.Len(t, findings[0].Issues, 1)
+}
+
+func TestFilterIssues_RegroupsFindings(t *testing.T) {
+	score := &domain.Score{Categories: []domain.CategoryScore{{Issues: []domain.Issue{
+		{Severity: domain.SeverityError, SubMetric: "file_size", File: "a.go", Message: "e"},
+		{Severity: domain.SeverityInfo, SubMetric: "function_size", File: "a.go", Message: "i"},
+	}}}}
+	score.Findings = domain.GroupFindings(score.Categories)
+
+	out := domain.FilterIssues(score, domain.IssueFilter{MinSeverity: domain.SeverityWarning})
+
+	require.Len(t, out.Findings, 1)
+	assert.Len(t, out.Findings[0].Issues, 1)
+	assert.Len(t, score.Findings[0].Issues, 2, "the input score is untouched")
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// digits matches the measured values and positions inside issue messages.
+var digits = regexp.MustCompile(`[0-9]+`)
+
+// IssueFingerprint returns a stable identifier for an issue: a hash of its
+// normalized file path, category, sub-metric and message with every number
+// masked. The message keeps the symbol name ("function Run ...") while the
+// masking drops line numbers and measured values, so an issue keeps its
+// fingerprint when code moves within a file or a function grows by a line.
+func IssueFingerprint(iss Issue) string {
+	if iss.Fingerprint != "" {
+		return iss.Fingerprint
+	}
+	file := ""
+	if iss.File != "" {
+		file = path.Clean(strings.ReplaceAll(iss.File, "\\", "/"))
+	}
+	h := sha256.New()
+	for _, part := range []string{file, iss.Category, iss.SubMetric, digits.ReplaceAllString(iss.Message, "#")} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// AssignFingerprints sets the Fingerprint of every issue in categories.
+func AssignFingerprints(categories []CategoryScore) {
+	for _, cat := range categories {
+		for i := range cat.Issues {
+			cat.Issues[i].Fingerprint = IssueFingerprint(cat.Issues[i])
+		}
+	}
+}
+
+// IssueFingerprints returns the sorted fingerprints of every issue in score,
+// one per issue, for recording in score history.
+func IssueFingerprints(score *Score) []string {
+	var fps []string
+	for _, cat := range score.Categories {
+		for _, iss := range cat.Issues {
+			fps = append(fps, IssueFingerprint(iss))
+		}
+	}
+	sort.Strings(fps)
+	return fps
+}
+
+// diffFingerprints counts the fingerprints of cur missing from prev (new)
+// and of prev missing from cur (resolved), treating both as multisets.
+func diffFingerprints(prev, cur []string) (added, resolved int) {
+	remaining := make(map[string]int, len(prev))
+	for _, fp := range prev {
+		remaining[fp]++
+	}
+	for _, fp := range cur {
+		if remaining[fp] > 0 {
+			remaining[fp]--
+			continue
+		}
+		added++
+	}
+	for _, n := range remaining {
+		resolved += n
+	}
+	return added, resolved
+}
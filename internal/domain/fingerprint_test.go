@@ -0,0 +1,76 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestIssueFingerprint(t *testing.T) {
+	base := domain.Issue{
+		Category: "code_health", SubMetric: "function_size", File: "internal/app/run.go",
+		Line: 12, Message: "function Run is 80 lines (>50)",
+	}
+	fp := domain.IssueFingerprint(base)
+	assert.Len(t, fp, 16)
+
+	tests := []struct {
+		name   string
+		mutate func(*domain.Issue)
+		same   bool
+	}{
+		{"line shift", func(i *domain.Issue) { i.Line = 90 }, true},
+		{"measured value change", func(i *domain.Issue) { i.Message = "function Run is 95 lines (>50)" }, true},
+		{"unnormalized path", func(i *domain.Issue) { i.File = "internal/app/../app/run.go" }, true},
+		{"owner and severity", func(i *domain.Issue) { i.Owner, i.Severity = "@team", domain.SeverityError }, true},
+		{"other symbol", func(i *domain.Issue) { i.Message = "function Stop is 80 lines (>50)" }, false},
+		{"other file", func(i *domain.Issue) { i.File = "internal/app/stop.go" }, false},
+		{"other sub-metric", func(i *domain.Issue) { i.SubMetric = "parameter_count" }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iss := base
+			tt.mutate(&iss)
+			if tt.same {
+				assert.Equal(t, fp, domain.IssueFingerprint(iss))
+			} else {
+				assert.NotEqual(t, fp, domain.IssueFingerprint(iss))
+			}
+		})
+	}
+}
+
+func TestAssignFingerprints(t *testing.T) {
+	cats := []domain.CategoryScore{{Name: "code_health", Issues: []domain.Issue{{Category: "code_health", Message: "x"}}}}
+	domain.AssignFingerprints(cats)
+	assert.Equal(t, domain.IssueFingerprint(domain.Issue{Category: "code_health", Message: "x"}), cats[0].Issues[0].Fingerprint)
+}
+
+func TestScoreEntry_TrackIssues(t *testing.T) {
+	moved := domain.Issue{Category: "code_health", File: "a.go", Line: 3, Message: "function A is 60 lines"}
+	fixed := domain.Issue{Category: "code_health", File: "b.go", Message: "function B is 70 lines"}
+	added := domain.Issue{Category: "code_health", File: "c.go", Message: "function C is 90 lines"}
+	movedLater := moved
+	movedLater.Line = 30
+
+	before := &domain.Score{Categories: []domain.CategoryScore{{Issues: []domain.Issue{moved, fixed}}}}
+	after := &domain.Score{Categories: []domain.CategoryScore{{Issues: []domain.Issue{movedLater, added}}}}
+
+	previous := []domain.ScoreEntry{
+		{Timestamp: "t1", Fingerprints: domain.IssueFingerprints(before)},
+		{Timestamp: "t2"}, // written before fingerprints were recorded
+	}
+	entry := domain.ScoreEntry{Fingerprints: domain.IssueFingerprints(after)}
+	entry.TrackIssues(previous)
+
+	assert.Equal(t, 1, entry.NewIssues)
+	assert.Equal(t, 1, entry.ResolvedIssues)
+
+	first := domain.ScoreEntry{Fingerprints: domain.IssueFingerprints(after)}
+	first.TrackIssues(nil)
+	require.Zero(t, first.NewIssues)
+	require.Zero(t, first.ResolvedIssues)
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+)
+
+// GateFailure describes a single quality gate that the score did not pass.
+type GateFailure struct {
+	Gate    string `json:"gate"`
+	Actual  int    `json:"actual"`
+	Limit   int    `json:"limit"`
+	Message string `json:"message"`
+}
+
+// EvaluateGates checks a score against the configured quality gates and
+// returns every gate that failed, in a stable order. Categories that were
+// skipped by config are not part of the score and cannot fail a gate.
+func EvaluateGates(score *Score, gates *GatesConfig) []GateFailure {
+	if score == nil || gates == nil {
+		return nil
+	}
+
+	var failures []GateFailure
+
+	if gates.MinOverall != nil && score.Overall < *gates.MinOverall {
+		failures = append(failures, GateFailure{
+			Gate:    "min_overall",
+			Actual:  score.Overall,
+			Limit:   *gates.MinOverall,
+			Message: fmt.Sprintf("overall score %d is below %d", score.Overall, *gates.MinOverall),
+		})
+	}
+
+	catScores := make(map[string]int, len(score.Categories))
+	issueCounts := make(map[string]int)
+	for _, cat := range score.Categories {
+		catScores[cat.Name] = cat.Score
+		for _, issue := range cat.Issues {
+			issueCounts[issue.Severity]++
+		}
+	}
+
+	for _, name := range sortedKeys(gates.MinCategory) {
+		minimum := gates.MinCategory[name]
+		actual, ok := catScores[name]
+		if !ok || actual >= minimum {
+			continue
+		}
+		failures = append(failures, GateFailure{
+			Gate:    "min_category." + name,
+			Actual:  actual,
+			Limit:   minimum,
+			Message: fmt.Sprintf("%s score %d is below %d", name, actual, minimum),
+		})
+	}
+
+	for _, sev := range sortedKeys(gates.MaxIssues) {
+		maximum := gates.MaxIssues[sev]
+		if issueCounts[sev] <= maximum {
+			continue
+		}
+		failures = append(failures, GateFailure{
+			Gate:    "max_issues." + sev,
+			Actual:  issueCounts[sev],
+			Limit:   maximum,
+			Message: fmt.Sprintf("%d %s issue(s) found, at most %d allowed", issueCounts[sev], sev, maximum),
+		})
+	}
+
+	return failures
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,59 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func gateScore() *domain.Score {
+	return &domain.Score{
+		Overall: 72,
+		Categories: []domain.CategoryScore{
+			{Name: "code_health", Score: 60, Issues: []domain.Issue{
+				{Severity: domain.SeverityError}, {Severity: domain.SeverityWarning},
+			}},
+			{Name: "structure", Score: 90},
+		},
+	}
+}
+
+func TestEvaluateGates_NilGates(t *testing.T) {
+	assert.Empty(t, domain.EvaluateGates(gateScore(), nil))
+}
+
+func TestEvaluateGates_AllPass(t *testing.T) {
+	minOverall := 70
+	gates := &domain.GatesConfig{
+		MinOverall:  &minOverall,
+		MinCategory: map[string]int{"structure": 80},
+		MaxIssues:   map[string]int{"error": 1},
+	}
+	assert.Empty(t, domain.EvaluateGates(gateScore(), gates))
+}
+
+func TestEvaluateGates_ReportsEachFailure(t *testing.T) {
+	minOverall := 80
+	gates := &domain.GatesConfig{
+		MinOverall:  &minOverall,
+		MinCategory: map[string]int{"code_health": 75, "structure": 80},
+		MaxIssues:   map[string]int{"error": 0, "warning": 5},
+	}
+
+	failures := domain.EvaluateGates(gateScore(), gates)
+	require.Len(t, failures, 3)
+	assert.Equal(t, "min_overall", failures[0].Gate)
+	assert.Equal(t, 72, failures[0].Actual)
+	assert.Equal(t, "min_category.code_health", failures[1].Gate)
+	assert.Equal(t, "max_issues.error", failures[2].Gate)
+	assert.Equal(t, 1, failures[2].Actual)
+	assert.Equal(t, 0, failures[2].Limit)
+}
+
+func TestEvaluateGates_SkippedCategoryIgnored(t *testing.T) {
+	gates := &domain.GatesConfig{MinCategory: map[string]int{"verifiability": 90}}
+	assert.Empty(t, domain.EvaluateGates(gateScore(), gates))
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// PackageGenerics is the generics adoption of one package directory:
+// how many of its functions declare type parameters, how many generic
+// types it declares and the most constraint terms on a single function.
+type PackageGenerics struct {
+	Package            string `json:"package"` // directory, relative to the project root
+	Functions          int    `json:"functions"`
+	GenericFunctions   int    `json:"generic_functions"`
+	GenericTypes       int    `json:"generic_types"`
+	MaxConstraintTerms int    `json:"max_constraint_terms,omitempty"`
+}
+
+// BuildGenericsAdoption reports generics adoption for every package with
+// at least one generic function or type, ordered by directory. Test and
+// generated files are left out.
+func BuildGenericsAdoption(analyzed map[string]*AnalyzedFile) []PackageGenerics {
+	byDir := make(map[string]*PackageGenerics)
+	for _, af := range analyzed {
+		if af.IsGenerated || strings.HasSuffix(af.Path, "_test.go") {
+			continue
+		}
+		dir := filepath.ToSlash(filepath.Dir(af.Path))
+		pg := byDir[dir]
+		if pg == nil {
+			pg = &PackageGenerics{Package: dir}
+			byDir[dir] = pg
+		}
+		pg.GenericTypes += len(af.GenericTypes)
+		for _, fn := range af.Functions {
+			pg.Functions++
+			if len(fn.TypeParams) > 0 {
+				pg.GenericFunctions++
+				pg.MaxConstraintTerms = max(pg.MaxConstraintTerms, fn.ConstraintTerms())
+			}
+		}
+	}
+
+	var adoption []PackageGenerics
+	for _, pg := range byDir {
+		if pg.GenericFunctions > 0 || pg.GenericTypes > 0 {
+			adoption = append(adoption, *pg)
+		}
+	}
+	sort.Slice(adoption, func(i, j int) bool { return adoption[i].Package < adoption[j].Package })
+	return adoption
+}
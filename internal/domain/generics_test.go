@@ -0,0 +1,27 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildGenericsAdoption(t *testing.T) {
+	ordered := []domain.TypeParam{{Name: "T", Constraint: "~int | ~string", Terms: 2}}
+	analyzed := map[string]*domain.AnalyzedFile{
+		"internal/set/set.go": {Path: "internal/set/set.go", GenericTypes: []string{"Set"}, Functions: []domain.Function{
+			{Name: "New", TypeParams: []domain.TypeParam{{Name: "T", Constraint: "comparable", Terms: 1}}},
+			{Name: "Max", TypeParams: ordered},
+			{Name: "Len", Receiver: "*Set"},
+		}},
+		"internal/set/set_test.go": {Path: "internal/set/set_test.go", Functions: []domain.Function{
+			{Name: "helper", TypeParams: ordered},
+		}},
+		"internal/app/app.go": {Path: "internal/app/app.go", Functions: []domain.Function{{Name: "Run"}}},
+	}
+
+	assert.Equal(t, []domain.PackageGenerics{
+		{Package: "internal/set", Functions: 3, GenericFunctions: 2, GenericTypes: 1, MaxConstraintTerms: 2},
+	}, domain.BuildGenericsAdoption(analyzed))
+}
@@ -0,0 +1,216 @@
+package golden
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// scaffoldRole is one file of a scaffolded module. File name patterns use
+// the {entity} and {module} placeholders of blueprint paths.
+type scaffoldRole struct {
+	fileType  string // blueprint file type
+	layer     string // canonical layer: domain, application or adapters
+	adapter   string // adapter subdirectory in per-feature layouts
+	direction string // adapter direction in cross-cutting layouts
+	bare      string
+	suffixed  string
+	tmpl      string
+	optional  bool // generated only when the golden module has one
+}
+
+var scaffoldRoles = []scaffoldRole{
+	{fileType: "domain_entity", layer: "domain", bare: "{entity}.go", suffixed: "{entity}.go", tmpl: entityTemplate},
+	{fileType: "domain_errors", layer: "domain", bare: "errors.go", suffixed: "{module}_errors.go", tmpl: errorsTemplate},
+	{fileType: "domain_test", layer: "domain", bare: "{entity}_test.go", suffixed: "{entity}_test.go", tmpl: entityTestTemplate},
+	{fileType: "ports", layer: "application", bare: "ports.go", suffixed: "{module}_ports.go", tmpl: portsTemplate},
+	{fileType: "service", layer: "application", bare: "service.go", suffixed: "{module}_service.go", tmpl: serviceTemplate},
+	{fileType: "repository", layer: "adapters", adapter: "repository", direction: "outbound",
+		bare: "repository.go", suffixed: "{entity}_repository.go", tmpl: repositoryTemplate},
+	{fileType: "handler", layer: "adapters", adapter: "http", direction: "inbound",
+		bare: "handler.go", suffixed: "{module}_handler.go", tmpl: handlerTemplate, optional: true},
+	{fileType: "routes", layer: "adapters", adapter: "http", direction: "inbound",
+		bare: "routes.go", suffixed: "{module}_routes.go", tmpl: routesTemplate, optional: true},
+}
+
+var (
+	moduleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+	entityNamePattern = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+)
+
+// scaffoldData fills the file templates.
+type scaffoldData struct {
+	Package, Module, Entity, Words string
+	DomainImport, DomainPkg        string
+	AppImport, AppPkg              string
+	// Type names. In per-feature layouts, where every module has the
+	// same package names, they take the stem of their file name:
+	// BillingService in billing_service.go.
+	Prefix, Port, Service, Adapter, Handler string
+}
+
+// ScaffoldModule generates the skeleton of a new module named name around the
+// entity type: domain entity, errors and test, application ports and
+// service, and an in-memory repository adapter, plus an HTTP handler and
+// routes when the golden module has them. Files go where the project's
+// layout puts them and are named by its naming convention, or after the
+// golden module's files when its blueprint has one of the same type.
+// entity defaults to the module name in PascalCase.
+func ScaffoldModule(name, entity string, conv domain.ScaffoldConventions) (*domain.ModuleScaffold, error) {
+	if !moduleNamePattern.MatchString(name) {
+		return nil, fmt.Errorf("module name %q must be a lowercase Go package name", name)
+	}
+	if entity == "" {
+		entity = toPascalCase(name)
+	}
+	if !entityNamePattern.MatchString(entity) {
+		return nil, fmt.Errorf("entity %q must be an exported Go type name", entity)
+	}
+
+	patterns := blueprintPatterns(conv.Blueprint)
+	sc := &domain.ModuleScaffold{Module: name, Layout: conv.Layout, Naming: conv.Naming}
+	if len(patterns) > 0 {
+		sc.Golden = conv.Blueprint.ExtractedFrom
+	}
+
+	subst := strings.NewReplacer("{entity}", toSnakeCase(entity), "{module}", name)
+	paths := map[string]string{}
+	var roles []scaffoldRole
+	for _, r := range scaffoldRoles {
+		pattern, ok := patterns[r.fileType]
+		switch {
+		case ok:
+			paths[r.fileType] = path.Join("internal", name, subst.Replace(pattern))
+		case r.optional:
+			continue
+		default:
+			paths[r.fileType] = conventionPath(name, r, subst, conv)
+		}
+		roles = append(roles, r)
+	}
+
+	data := newScaffoldData(name, entity, conv, paths)
+	for _, r := range roles {
+		content, err := renderScaffold(r, data, paths[r.fileType])
+		if err != nil {
+			return nil, fmt.Errorf("rendering %s: %w", paths[r.fileType], err)
+		}
+		sc.Files = append(sc.Files, domain.ScaffoldFile{Path: paths[r.fileType], Role: r.fileType, Content: content})
+	}
+	return sc, nil
+}
+
+// blueprintPatterns maps file types to the first path pattern of that type
+// in the blueprint. Patterns that are not relative to the module, as in
+// cross-cutting layouts, are left out.
+func blueprintPatterns(bp *domain.Blueprint) map[string]string {
+	patterns := map[string]string{}
+	if bp == nil {
+		return patterns
+	}
+	for _, f := range bp.Files {
+		if _, seen := patterns[f.Type]; seen || strings.Contains("/"+f.PathPattern, "/internal/") {
+			continue
+		}
+		patterns[f.Type] = f.PathPattern
+	}
+	return patterns
+}
+
+// conventionPath returns the project-relative path of a file of the new
+// module by the project's conventions: internal/{module}/{layer}/... in
+// per-feature layouts, internal/{layer}/{module}/... in cross-cutting ones.
+func conventionPath(name string, r scaffoldRole, subst *strings.Replacer, conv domain.ScaffoldConventions) string {
+	file := r.bare
+	if conv.Naming == "suffixed" {
+		file = r.suffixed
+	}
+	layer := conv.Layers[r.layer]
+	if layer == "" {
+		layer = r.layer
+	}
+	if conv.Layout == domain.LayoutCrossCutting {
+		dir := path.Join("internal", layer)
+		if r.layer == "adapters" && conv.SplitAdapters {
+			dir = path.Join(dir, r.direction)
+		}
+		return path.Join(dir, name, subst.Replace(file))
+	}
+	return path.Join("internal", name, layer, r.adapter, subst.Replace(file))
+}
+
+func newScaffoldData(name, entity string, conv domain.ScaffoldConventions, paths map[string]string) scaffoldData {
+	domainDir, appDir := path.Dir(paths["domain_entity"]), path.Dir(paths["service"])
+	d := scaffoldData{
+		Module:       name,
+		Entity:       entity,
+		Words:        strings.ReplaceAll(toSnakeCase(entity), "_", " "),
+		DomainImport: path.Join(conv.ModulePath, domainDir),
+		DomainPkg:    packageName(domainDir),
+		AppImport:    path.Join(conv.ModulePath, appDir),
+		AppPkg:       packageName(appDir),
+	}
+	prefix := func(fileType, word string) string {
+		if conv.Layout == domain.LayoutCrossCutting {
+			return ""
+		}
+		stem, _ := strings.CutSuffix(strings.TrimSuffix(path.Base(paths[fileType]), ".go"), "_"+word)
+		return snakeToPascal(stem)
+	}
+	d.Port = prefix("ports", "ports") + "Repository"
+	d.Service = prefix("service", "service") + "Service"
+	d.Adapter = "Memory" + prefix("repository", "repository") + "Repository"
+	d.Prefix = prefix("handler", "handler")
+	d.Handler = d.Prefix + "Handler"
+	return d
+}
+
+// snakeToPascal converts a snake_case file stem to PascalCase; a stem
+// that is a bare role word, such as service, gives "".
+func snakeToPascal(stem string) string {
+	if !strings.Contains(stem, "_") && slices.ContainsFunc(scaffoldRoles, func(r scaffoldRole) bool { return r.bare == stem+".go" }) {
+		return ""
+	}
+	var b strings.Builder
+	for _, word := range strings.Split(stem, "_") {
+		b.WriteString(toPascalCase(word))
+	}
+	return b.String()
+}
+
+// renderScaffold executes the role's template for the file at p and
+// formats the result.
+func renderScaffold(r scaffoldRole, data scaffoldData, p string) (string, error) {
+	data.Package = packageName(path.Dir(p))
+	tmpl, err := template.New(r.fileType).Parse(r.tmpl)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", err
+	}
+	return string(src), nil
+}
+
+// packageName returns the package name of a directory: its base name
+// without the characters a Go identifier cannot hold.
+func packageName(dir string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return -1
+	}, strings.ToLower(path.Base(dir)))
+}
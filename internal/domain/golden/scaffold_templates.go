@@ -0,0 +1,204 @@
+package golden
+
+// Templates of the files a scaffolded module starts with. They compile as
+// generated and are run through gofmt.
+
+const entityTemplate = `package {{.Package}}
+
+// {{.Entity}} is the core type of the {{.Module}} module.
+type {{.Entity}} struct {
+	ID   string
+	Name string
+}
+
+// New{{.Entity}} returns a valid {{.Entity}}.
+func New{{.Entity}}(id, name string) (*{{.Entity}}, error) {
+	e := &{{.Entity}}{ID: id, Name: name}
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Validate checks the invariants of the {{.Entity}}.
+func (e *{{.Entity}}) Validate() error {
+	if e.ID == "" || e.Name == "" {
+		return Err{{.Entity}}Invalid
+	}
+	return nil
+}
+`
+
+const errorsTemplate = `package {{.Package}}
+
+import "errors"
+
+var (
+	Err{{.Entity}}NotFound = errors.New("{{.Words}} not found")
+	Err{{.Entity}}Invalid = errors.New("{{.Words}} is invalid")
+)
+`
+
+const entityTestTemplate = `package {{.Package}}_test
+
+import (
+	"testing"
+
+	"{{.DomainImport}}"
+)
+
+func TestNew{{.Entity}}(t *testing.T) {
+	if _, err := {{.DomainPkg}}.New{{.Entity}}("1", "example"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := {{.DomainPkg}}.New{{.Entity}}("", ""); err == nil {
+		t.Fatal("expected an error for an empty {{.Words}}")
+	}
+}
+`
+
+const portsTemplate = `package {{.Package}}
+
+import "{{.DomainImport}}"
+
+// {{.Port}} stores {{.Entity}} values.
+type {{.Port}} interface {
+	Save(e *{{.DomainPkg}}.{{.Entity}}) error
+	GetByID(id string) (*{{.DomainPkg}}.{{.Entity}}, error)
+	List() ([]*{{.DomainPkg}}.{{.Entity}}, error)
+}
+`
+
+const serviceTemplate = `package {{.Package}}
+
+import "{{.DomainImport}}"
+
+// {{.Service}} runs the {{.Module}} use cases.
+type {{.Service}} struct {
+	repo {{.Port}}
+}
+
+// New{{.Service}} returns a service that stores through repo.
+func New{{.Service}}(repo {{.Port}}) *{{.Service}} {
+	return &{{.Service}}{repo: repo}
+}
+
+// Create validates and stores a new {{.Entity}}.
+func (s *{{.Service}}) Create(id, name string) (*{{.DomainPkg}}.{{.Entity}}, error) {
+	e, err := {{.DomainPkg}}.New{{.Entity}}(id, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Get returns the {{.Entity}} with the given ID.
+func (s *{{.Service}}) Get(id string) (*{{.DomainPkg}}.{{.Entity}}, error) {
+	return s.repo.GetByID(id)
+}
+
+// List returns every {{.Entity}}.
+func (s *{{.Service}}) List() ([]*{{.DomainPkg}}.{{.Entity}}, error) {
+	return s.repo.List()
+}
+`
+
+const repositoryTemplate = `package {{.Package}}
+
+import (
+	"sort"
+	"sync"
+
+	"{{.DomainImport}}"
+)
+
+// {{.Adapter}} keeps {{.Entity}} values in memory. Replace it with a
+// database-backed adapter implementing the same port.
+type {{.Adapter}} struct {
+	mu   sync.RWMutex
+	byID map[string]*{{.DomainPkg}}.{{.Entity}}
+}
+
+// New{{.Adapter}} returns an empty {{.Adapter}}.
+func New{{.Adapter}}() *{{.Adapter}} {
+	return &{{.Adapter}}{byID: map[string]*{{.DomainPkg}}.{{.Entity}}{}}
+}
+
+// Save stores e, replacing the {{.Entity}} with the same ID.
+func (r *{{.Adapter}}) Save(e *{{.DomainPkg}}.{{.Entity}}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.byID[e.ID] = e
+	return nil
+}
+
+// GetByID returns the {{.Entity}} with the given ID.
+func (r *{{.Adapter}}) GetByID(id string) (*{{.DomainPkg}}.{{.Entity}}, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.byID[id]
+	if !ok {
+		return nil, {{.DomainPkg}}.Err{{.Entity}}NotFound
+	}
+	return e, nil
+}
+
+// List returns every stored {{.Entity}}, sorted by ID.
+func (r *{{.Adapter}}) List() ([]*{{.DomainPkg}}.{{.Entity}}, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]*{{.DomainPkg}}.{{.Entity}}, 0, len(r.byID))
+	for _, e := range r.byID {
+		out = append(out, e)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, nil
+}
+`
+
+const handlerTemplate = `package {{.Package}}
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"{{.AppImport}}"
+)
+
+// {{.Handler}} serves the {{.Module}} use cases over HTTP.
+type {{.Handler}} struct {
+	service *{{.AppPkg}}.{{.Service}}
+}
+
+// New{{.Handler}} returns a handler backed by service.
+func New{{.Handler}}(service *{{.AppPkg}}.{{.Service}}) *{{.Handler}} {
+	if service == nil {
+		panic("{{.Module}}: nil service")
+	}
+	return &{{.Handler}}{service: service}
+}
+
+// List writes every {{.Entity}} as JSON.
+func (h *{{.Handler}}) List(w http.ResponseWriter, r *http.Request) {
+	items, err := h.service.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(items)
+}
+`
+
+const routesTemplate = `package {{.Package}}
+
+import "net/http"
+
+// Register{{.Prefix}}Routes registers the {{.Module}} routes on mux.
+func Register{{.Prefix}}Routes(mux *http.ServeMux, h *{{.Handler}}) {
+	mux.HandleFunc("GET /{{.Module}}", h.List)
+}
+`
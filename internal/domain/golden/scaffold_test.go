@@ -0,0 +1,92 @@
+package golden_test
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/golden"
+)
+
+func scaffoldPaths(sc *domain.ModuleScaffold) []string {
+	var paths []string
+	for _, f := range sc.Files {
+		paths = append(paths, f.Path)
+	}
+	return paths
+}
+
+func TestScaffold_FollowsGoldenModuleFileNames(t *testing.T) {
+	modules, analyzed := loadFixture(t)
+	gm, err := golden.SelectGolden(modules, analyzed)
+	require.NoError(t, err)
+	bp, err := golden.ExtractBlueprint(gm.Module, analyzed)
+	require.NoError(t, err)
+
+	sc, err := golden.ScaffoldModule("billing", "Invoice", domain.ScaffoldConventions{
+		ModulePath: "example.com/perfect",
+		Layout:     domain.LayoutPerFeature,
+		Naming:     "suffixed",
+		Blueprint:  bp,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "internal/tax", sc.Golden)
+	assert.ElementsMatch(t, []string{
+		"internal/billing/domain/invoice.go",
+		"internal/billing/domain/billing_errors.go",
+		"internal/billing/domain/invoice_test.go",
+		"internal/billing/application/billing_ports.go",
+		"internal/billing/application/billing_service.go",
+		"internal/billing/adapters/repository/billing_repository.go",
+		"internal/billing/adapters/http/billing_handler.go",
+		"internal/billing/adapters/http/billing_routes.go",
+	}, scaffoldPaths(sc))
+
+	for _, f := range sc.Files {
+		_, err := parser.ParseFile(token.NewFileSet(), f.Path, f.Content, 0)
+		require.NoError(t, err, f.Path)
+		switch f.Role {
+		case "service":
+			assert.Contains(t, f.Content, "package application\n")
+			assert.Contains(t, f.Content, `import "example.com/perfect/internal/billing/domain"`)
+			assert.Contains(t, f.Content, "func NewBillingService(repo BillingRepository) *BillingService")
+		case "handler":
+			assert.Contains(t, f.Content, "service *application.BillingService")
+		}
+	}
+}
+
+func TestScaffold_CrossCuttingBareLayout(t *testing.T) {
+	sc, err := golden.ScaffoldModule("billing", "", domain.ScaffoldConventions{
+		ModulePath:    "example.com/shop",
+		Layout:        domain.LayoutCrossCutting,
+		Naming:        "bare",
+		Layers:        map[string]string{"domain": "domain", "application": "app", "adapters": "adapters"},
+		SplitAdapters: true,
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, sc.Golden)
+	assert.Equal(t, []string{
+		"internal/domain/billing/billing.go",
+		"internal/domain/billing/errors.go",
+		"internal/domain/billing/billing_test.go",
+		"internal/app/billing/ports.go",
+		"internal/app/billing/service.go",
+		"internal/adapters/outbound/billing/repository.go",
+	}, scaffoldPaths(sc))
+	assert.Contains(t, sc.Files[4].Content, "func NewService(repo Repository) *Service")
+	assert.Contains(t, sc.Files[5].Content, "type MemoryRepository struct")
+}
+
+func TestScaffold_RejectsInvalidNames(t *testing.T) {
+	_, err := golden.ScaffoldModule("Billing", "", domain.ScaffoldConventions{})
+	assert.ErrorContains(t, err, "lowercase Go package name")
+	_, err = golden.ScaffoldModule("billing", "invoice", domain.ScaffoldConventions{})
+	assert.ErrorContains(t, err, "exported Go type name")
+}
@@ -0,0 +1,39 @@
+package domain
+
+// GoModInfo is the dependency information read from go.mod and go.sum.
+type GoModInfo struct {
+	GoVersion string          `json:"go_version,omitempty"`
+	Requires  []ModuleRequire `json:"requires,omitempty"`
+	Replaces  []ModuleReplace `json:"replaces,omitempty"`
+	HasGoSum  bool            `json:"has_go_sum"`
+	// Sums holds the "path@version" keys that go.sum has a go.mod checksum for.
+	Sums map[string]bool `json:"-"`
+}
+
+// ModuleRequire is one require directive.
+type ModuleRequire struct {
+	Path     string `json:"path"`
+	Version  string `json:"version"`
+	Indirect bool   `json:"indirect,omitempty"`
+	Line     int    `json:"line"`
+}
+
+// ModuleReplace is one replace directive. Local is true when the
+// replacement is a filesystem path rather than a module.
+type ModuleReplace struct {
+	Old   string `json:"old"`
+	New   string `json:"new"`
+	Local bool   `json:"local,omitempty"`
+	Line  int    `json:"line"`
+}
+
+// DirectRequires returns the requirements not marked // indirect.
+func (m *GoModInfo) DirectRequires() []ModuleRequire {
+	var out []ModuleRequire
+	for _, r := range m.Requires {
+		if !r.Indirect {
+			out = append(out, r)
+		}
+	}
+	return out
+}
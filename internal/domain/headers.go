@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// HeaderConfig requires Go files to start with a header, typically a
+// license block, matching Pattern: a regex matched against the text above
+// the package clause. Overrides replace the pattern for files under a path;
+// the last matching override wins, and an override with an empty pattern
+// exempts its files.
+type HeaderConfig struct {
+	Pattern   string           `yaml:"pattern,omitempty"   json:"pattern,omitempty"`
+	Overrides []HeaderOverride `yaml:"overrides,omitempty" json:"overrides,omitempty"`
+}
+
+// HeaderOverride sets the header pattern for files matching Path, a glob
+// over the file path where "dir/**" matches a subtree.
+type HeaderOverride struct {
+	Path    string `yaml:"path"              json:"path"`
+	Pattern string `yaml:"pattern,omitempty" json:"pattern,omitempty"`
+}
+
+// PatternFor returns the header pattern required of file, or "" when the
+// file needs no header.
+func (h *HeaderConfig) PatternFor(file string) string {
+	if h == nil {
+		return ""
+	}
+	pattern := h.Pattern
+	for _, o := range h.Overrides {
+		if MatchPathGlob(o.Path, file) {
+			pattern = o.Pattern
+		}
+	}
+	return pattern
+}
+
+// CompileHeaderPatterns compiles the patterns of h by source, skipping any
+// that do not compile. Config validation rejects such patterns, so in
+// practice none are skipped.
+func CompileHeaderPatterns(h *HeaderConfig) map[string]*regexp.Regexp {
+	compiled := make(map[string]*regexp.Regexp)
+	if h == nil {
+		return compiled
+	}
+	for _, p := range append([]string{h.Pattern}, overridePatterns(h)...) {
+		if re, err := regexp.Compile(p); p != "" && err == nil {
+			compiled[p] = re
+		}
+	}
+	return compiled
+}
+
+func overridePatterns(h *HeaderConfig) []string {
+	patterns := make([]string, len(h.Overrides))
+	for i, o := range h.Overrides {
+		patterns[i] = o.Pattern
+	}
+	return patterns
+}
+
+func validateHeaders(h *HeaderConfig) error {
+	if h == nil {
+		return nil
+	}
+	if _, err := regexp.Compile(h.Pattern); err != nil {
+		return fmt.Errorf("headers.pattern %q: %w", h.Pattern, err)
+	}
+	for i, o := range h.Overrides {
+		if o.Path == "" {
+			return fmt.Errorf("headers.overrides[%d].path is required", i)
+		}
+		if _, err := path.Match(strings.TrimSuffix(o.Path, "/**"), ""); err != nil {
+			return fmt.Errorf("headers.overrides[%d].path %q: %w", i, o.Path, err)
+		}
+		if _, err := regexp.Compile(o.Pattern); err != nil {
+			return fmt.Errorf("headers.overrides[%d].pattern %q: %w", i, o.Pattern, err)
+		}
+	}
+	return nil
+}
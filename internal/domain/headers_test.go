@@ -0,0 +1,61 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderConfig_PatternFor(t *testing.T) {
+	h := &domain.HeaderConfig{
+		Pattern: `Apache License`,
+		Overrides: []domain.HeaderOverride{
+			{Path: "third_party/**"},
+			{Path: "third_party/mit/**", Pattern: `MIT License`},
+		},
+	}
+
+	assert.Equal(t, "Apache License", h.PatternFor("internal/app/app.go"))
+	assert.Equal(t, "", h.PatternFor("third_party/lib/lib.go"))
+	assert.Equal(t, "MIT License", h.PatternFor("third_party/mit/x/x.go"), "the last matching override wins")
+
+	var none *domain.HeaderConfig
+	assert.Equal(t, "", none.PatternFor("main.go"))
+}
+
+func TestCompileHeaderPatterns(t *testing.T) {
+	compiled := domain.CompileHeaderPatterns(&domain.HeaderConfig{
+		Pattern:   `Copyright \d{4}`,
+		Overrides: []domain.HeaderOverride{{Path: "vendor/**"}, {Path: "x/**", Pattern: "("}},
+	})
+	require.Len(t, compiled, 1)
+	assert.True(t, compiled[`Copyright \d{4}`].MatchString("// Copyright 2024 Acme\n"))
+}
+
+func TestValidate_Headers(t *testing.T) {
+	valid := domain.ProjectConfig{Headers: &domain.HeaderConfig{
+		Pattern:   `^// Copyright`,
+		Overrides: []domain.HeaderOverride{{Path: "gen/**"}},
+	}}
+	assert.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name    string
+		headers domain.HeaderConfig
+		want    string
+	}{
+		{"bad pattern", domain.HeaderConfig{Pattern: "("}, `headers.pattern "("`},
+		{"missing path", domain.HeaderConfig{Overrides: []domain.HeaderOverride{{Pattern: "x"}}}, "headers.overrides[0].path is required"},
+		{"bad path", domain.HeaderConfig{Overrides: []domain.HeaderOverride{{Path: "["}}}, `headers.overrides[0].path "["`},
+		{"bad override pattern", domain.HeaderConfig{Overrides: []domain.HeaderOverride{{Path: "x/**", Pattern: "["}}}, `headers.overrides[0].pattern "["`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := domain.ProjectConfig{Headers: &tt.headers}.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package domain
+
+// Hotspot is a file or function ranked by its negative contribution to the
+// score. Cost is the lost decay credit plus the severity weight of its issues;
+// Priority additionally scales Cost by relative git churn when available.
+type Hotspot struct {
+	File        string  `json:"file"`
+	Line        int     `json:"line,omitempty"`
+	Symbol      string  `json:"symbol,omitempty"`
+	LostCredit  float64 `json:"lost_credit"`
+	IssueWeight float64 `json:"issue_weight"`
+	Issues      int     `json:"issues"`
+	Churn       int     `json:"churn,omitempty"`
+	Cost        float64 `json:"cost"`
+	Priority    float64 `json:"priority"`
+}
+
+// HotspotReport holds ranked file and function hotspots, highest priority first.
+type HotspotReport struct {
+	Files     []Hotspot `json:"files"`
+	Functions []Hotspot `json:"functions"`
+	ChurnUsed bool      `json:"churn_used"`
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"path"
+	"sort"
+	"strings"
+)
+
+// InterfaceImplementations maps one interface to the concrete types whose
+// method sets satisfy it.
+type InterfaceImplementations struct {
+	Interface       string           `json:"interface"` // package-qualified, e.g. "internal/domain.Store"
+	Package         string           `json:"package"`   // module-relative directory, "." for the root
+	File            string           `json:"file"`
+	Methods         []string         `json:"methods"`
+	Implementations []Implementation `json:"implementations,omitempty"`
+}
+
+// Implementation is a concrete type satisfying an interface.
+type Implementation struct {
+	Type    string `json:"type"` // package-qualified, e.g. "internal/adapters/store.Memory"
+	File    string `json:"file"`
+	Pointer bool   `json:"pointer,omitempty"` // only *T satisfies the interface
+	Test    bool   `json:"test,omitempty"`    // declared in a _test.go file
+}
+
+// Qualify joins a module-relative package directory and a name.
+func Qualify(pkg, name string) string {
+	if pkg == "." {
+		return name
+	}
+	return pkg + "." + name
+}
+
+type methodSet struct {
+	pkg, name, file string
+	test            bool
+	methods         map[string]bool // method name → pointer receiver
+}
+
+// BuildInterfaceMap matches every non-empty interface against the method
+// sets of the concrete types across all packages. Matching is by method
+// name, since parameter types are not resolved; a type implements an
+// interface when it declares all of its methods. Types are keyed by
+// package, so same-named types in different packages stay distinct.
+// Interfaces in type-checked files keep the implementations the
+// TypeResolver found instead.
+func BuildInterfaceMap(analyzed map[string]*AnalyzedFile) []InterfaceImplementations {
+	paths := make([]string, 0, len(analyzed))
+	for p := range analyzed {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	types := map[string]*methodSet{}
+	var order []string
+	var ifaces []InterfaceImplementations
+	typed := map[int]bool{}
+	for _, p := range paths {
+		af := analyzed[p]
+		if af == nil {
+			continue
+		}
+		file := strings.ReplaceAll(p, "\\", "/")
+		pkg := path.Dir(file)
+		test := strings.HasSuffix(file, "_test.go")
+		for _, fn := range af.Functions {
+			if fn.Receiver == "" {
+				continue
+			}
+			recv := strings.TrimPrefix(fn.Receiver, "*")
+			key := Qualify(pkg, recv)
+			ms := types[key]
+			if ms == nil {
+				ms = &methodSet{pkg: pkg, name: recv, file: file, test: test, methods: map[string]bool{}}
+				types[key] = ms
+				order = append(order, key)
+			}
+			ms.methods[fn.Name] = strings.HasPrefix(fn.Receiver, "*")
+		}
+		if test {
+			continue
+		}
+		for _, iface := range af.InterfaceDefs {
+			if len(iface.Methods) == 0 {
+				continue
+			}
+			ifaces = append(ifaces, InterfaceImplementations{
+				Interface:       Qualify(pkg, iface.Name),
+				Package:         pkg,
+				File:            file,
+				Methods:         iface.Methods,
+				Implementations: iface.Implementations,
+			})
+			if af.Typed {
+				typed[len(ifaces)-1] = true
+			}
+		}
+	}
+	sort.Strings(order)
+
+	for i := range ifaces {
+		if typed[i] {
+			continue
+		}
+		for _, key := range order {
+			if impl, ok := types[key].implements(ifaces[i].Methods); ok {
+				ifaces[i].Implementations = append(ifaces[i].Implementations, impl)
+			}
+		}
+	}
+	sort.SliceStable(ifaces, func(i, j int) bool { return ifaces[i].Interface < ifaces[j].Interface })
+	return ifaces
+}
+
+func (ms *methodSet) implements(required []string) (Implementation, bool) {
+	impl := Implementation{Type: Qualify(ms.pkg, ms.name), File: ms.file, Test: ms.test}
+	for _, m := range required {
+		pointer, ok := ms.methods[m]
+		if !ok {
+			return Implementation{}, false
+		}
+		impl.Pointer = impl.Pointer || pointer
+	}
+	return impl, true
+}
@@ -0,0 +1,87 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildInterfaceMap(t *testing.T) {
+	analyzed := map[string]*domain.AnalyzedFile{
+		"internal/domain/ports.go": {
+			Package: "domain",
+			InterfaceDefs: []domain.InterfaceDef{
+				{Name: "Store", Methods: []string{"Get", "Put"}},
+				{Name: "Any"},
+				{Name: "Clock", Methods: []string{"Now"}},
+			},
+		},
+		"internal/adapters/memory/store.go": {
+			Package: "memory",
+			Functions: []domain.Function{
+				{Name: "Get", Receiver: "Store"},
+				{Name: "Put", Receiver: "*Store"},
+			},
+		},
+		"internal/adapters/disk/store.go": {
+			Package: "disk",
+			Functions: []domain.Function{
+				{Name: "Get", Receiver: "Store"}, // same type name, no Put
+			},
+		},
+		"internal/adapters/disk/store_extra.go": {
+			Package:   "disk",
+			Functions: []domain.Function{{Name: "Flush", Receiver: "*Store"}},
+		},
+		"internal/app/clock_test.go": {
+			Package:   "app",
+			Functions: []domain.Function{{Name: "Now", Receiver: "fakeClock"}},
+		},
+	}
+
+	ifaces := domain.BuildInterfaceMap(analyzed)
+	require.Len(t, ifaces, 2, "empty interfaces are omitted")
+
+	assert.Equal(t, "internal/domain.Clock", ifaces[0].Interface)
+	require.Len(t, ifaces[0].Implementations, 1)
+	assert.Equal(t, "internal/app.fakeClock", ifaces[0].Implementations[0].Type)
+	assert.True(t, ifaces[0].Implementations[0].Test)
+
+	store := ifaces[1]
+	assert.Equal(t, "internal/domain.Store", store.Interface)
+	assert.Equal(t, "internal/domain", store.Package)
+	assert.Equal(t, []domain.Implementation{
+		{Type: "internal/adapters/memory.Store", File: "internal/adapters/memory/store.go", Pointer: true},
+	}, store.Implementations)
+}
+
+func TestBuildInterfaceMap_RootPackage(t *testing.T) {
+	ifaces := domain.BuildInterfaceMap(map[string]*domain.AnalyzedFile{
+		"lib.go": {
+			Package:       "lib",
+			InterfaceDefs: []domain.InterfaceDef{{Name: "Runner", Methods: []string{"Run"}}},
+			Functions:     []domain.Function{{Name: "Run", Receiver: "task"}},
+		},
+	})
+	require.Len(t, ifaces, 1)
+	assert.Equal(t, "Runner", ifaces[0].Interface)
+	assert.Equal(t, []domain.Implementation{{Type: "task", File: "lib.go"}}, ifaces[0].Implementations)
+}
+
+func TestBuildInterfaceMap_TypedFilesKeepResolvedImplementations(t *testing.T) {
+	resolved := []domain.Implementation{{Type: "store.Disk", File: "store/disk.go", Pointer: true}}
+	ifaces := domain.BuildInterfaceMap(map[string]*domain.AnalyzedFile{
+		"store/store.go": {
+			Typed:         true,
+			InterfaceDefs: []domain.InterfaceDef{{Name: "Repository", Methods: []string{"Get"}, Implementations: resolved}},
+		},
+		"store/wrong.go": {
+			Typed:     true,
+			Functions: []domain.Function{{Name: "Get", Receiver: "*Wrong"}},
+		},
+	})
+	require.Len(t, ifaces, 1)
+	assert.Equal(t, resolved, ifaces[0].Implementations, "name matching would add Wrong")
+}
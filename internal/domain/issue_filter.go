@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+)
+
+// IssueFilter limits the issues a score reports. Zero values disable each
+// limit. Filtering never changes scores; suppressed issues are only counted.
+type IssueFilter struct {
+	MinSeverity     string // drop issues less severe than this
+	MaxPerSubMetric int    // keep at most this many issues per sub-metric
+	MaxTotal        int    // keep at most this many issues overall
+}
+
+// SuppressedIssues counts the issues an IssueFilter removed from the output.
+type SuppressedIssues struct {
+	Total         int            `json:"total"`
+	BelowSeverity int            `json:"below_severity"`
+	OverCap       int            `json:"over_cap"`
+	BySeverity    map[string]int `json:"by_severity"`
+}
+
+// severityRank orders severities from most to least severe.
+var severityRank = map[string]int{SeverityError: 0, SeverityWarning: 1, SeverityInfo: 2}
+
+// ValidateSeverity returns an error if sev is not error, warning or info.
+func ValidateSeverity(sev string) error {
+	if _, ok := severityRank[sev]; !ok {
+		return fmt.Errorf("unknown severity %q (supported: error, warning, info)", sev)
+	}
+	return nil
+}
+
+// Validate returns an error for an unknown MinSeverity or a negative cap.
+// FilterIssues assumes a valid filter: an unknown MinSeverity would drop
+// everything but errors.
+func (f IssueFilter) Validate() error {
+	if f.MinSeverity != "" {
+		if err := ValidateSeverity(f.MinSeverity); err != nil {
+			return err
+		}
+	}
+	if f.MaxPerSubMetric < 0 || f.MaxTotal < 0 {
+		return fmt.Errorf("issue caps must not be negative")
+	}
+	return nil
+}
+
+// Active reports whether the filter removes anything at all.
+func (f IssueFilter) Active() bool {
+	return f.MinSeverity != "" || f.MaxPerSubMetric > 0 || f.MaxTotal > 0
+}
+
+// FilterIssues returns a copy of score whose issues pass f, leaving score
+// untouched so gates still see every issue. Caps keep the most severe
+// issues first and otherwise preserve the existing order. Suppressed counts
+// are recorded per category and in Score.Suppressed, and findings are
+// regrouped from the kept issues.
+func FilterIssues(score *Score, f IssueFilter) *Score {
+	if score == nil || !f.Active() {
+		return score
+	}
+	out := *score
+	out.Categories = make([]CategoryScore, len(score.Categories))
+	suppressed := &SuppressedIssues{BySeverity: map[string]int{}}
+
+	// Issues are visited most severe first so caps keep the worst ones.
+	type ref struct{ cat, idx int }
+	var kept []ref
+	for ci, cat := range score.Categories {
+		perSubMetric := make(map[string]int)
+		for _, i := range severityOrder(cat.Issues) {
+			iss := cat.Issues[i]
+			switch {
+			case f.MinSeverity != "" && severityRank[iss.Severity] > severityRank[f.MinSeverity]:
+				suppressed.BelowSeverity++
+			case f.MaxPerSubMetric > 0 && perSubMetric[iss.SubMetric] >= f.MaxPerSubMetric:
+				suppressed.OverCap++
+			default:
+				perSubMetric[iss.SubMetric]++
+				kept = append(kept, ref{ci, i})
+				continue
+			}
+			suppressed.BySeverity[iss.Severity]++
+			cat.Suppressed++
+		}
+		out.Categories[ci] = cat
+	}
+
+	if f.MaxTotal > 0 && len(kept) > f.MaxTotal {
+		severity := func(r ref) int { return severityRank[score.Categories[r.cat].Issues[r.idx].Severity] }
+		slices.SortStableFunc(kept, func(a, b ref) int { return severity(a) - severity(b) })
+		for _, r := range kept[f.MaxTotal:] {
+			suppressed.OverCap++
+			suppressed.BySeverity[score.Categories[r.cat].Issues[r.idx].Severity]++
+			out.Categories[r.cat].Suppressed++
+		}
+		kept = kept[:f.MaxTotal]
+	}
+
+	keep := make(map[ref]bool, len(kept))
+	for _, r := range kept {
+		keep[r] = true
+	}
+	for ci, cat := range score.Categories {
+		var issues []Issue
+		for i, iss := range cat.Issues {
+			if keep[ref{ci, i}] {
+				issues = append(issues, iss)
+			}
+		}
+		out.Categories[ci].Issues = issues
+	}
+
+	out.Findings = GroupFindings(out.Categories)
+
+	suppressed.Total = suppressed.BelowSeverity + suppressed.OverCap
+	if suppressed.Total > 0 {
+		out.Suppressed = suppressed
+	}
+	return &out
+}
+
+// severityOrder returns the indices of issues, most severe first, keeping
+// the existing order within a severity.
+func severityOrder(issues []Issue) []int {
+	order := make([]int, len(issues))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return severityRank[issues[a].Severity] - severityRank[issues[b].Severity]
+	})
+	return order
+}
@@ -0,0 +1,108 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func filterFixture() *domain.Score {
+	return &domain.Score{
+		Overall: 70,
+		Categories: []domain.CategoryScore{
+			{Name: "code_health", Score: 60, Issues: []domain.Issue{
+				{Severity: domain.SeverityInfo, SubMetric: "function_size", Message: "i1"},
+				{Severity: domain.SeverityWarning, SubMetric: "function_size", Message: "w1"},
+				{Severity: domain.SeverityInfo, SubMetric: "function_size", Message: "i2"},
+				{Severity: domain.SeverityError, SubMetric: "file_size", Message: "e1"},
+			}},
+			{Name: "discoverability", Score: 80, Issues: []domain.Issue{
+				{Severity: domain.SeverityInfo, SubMetric: "naming_uniqueness", Message: "i3"},
+				{Severity: domain.SeverityWarning, SubMetric: "naming_uniqueness", Message: "w2"},
+			}},
+		},
+	}
+}
+
+func messages(score *domain.Score) []string {
+	var out []string
+	for _, cat := range score.Categories {
+		for _, iss := range cat.Issues {
+			out = append(out, iss.Message)
+		}
+	}
+	return out
+}
+
+func TestFilterIssues(t *testing.T) {
+	tests := []struct {
+		name          string
+		filter        domain.IssueFilter
+		want          []string
+		belowSeverity int
+		overCap       int
+	}{
+		{
+			name:          "min severity warning drops info",
+			filter:        domain.IssueFilter{MinSeverity: domain.SeverityWarning},
+			want:          []string{"w1", "e1", "w2"},
+			belowSeverity: 3,
+		},
+		{
+			name:    "per sub-metric cap keeps the most severe",
+			filter:  domain.IssueFilter{MaxPerSubMetric: 1},
+			want:    []string{"w1", "e1", "w2"},
+			overCap: 3,
+		},
+		{
+			name:    "global cap keeps the most severe across categories",
+			filter:  domain.IssueFilter{MaxTotal: 2},
+			want:    []string{"w1", "e1"},
+			overCap: 4,
+		},
+		{
+			name:          "filters combine",
+			filter:        domain.IssueFilter{MinSeverity: domain.SeverityWarning, MaxTotal: 1},
+			want:          []string{"e1"},
+			belowSeverity: 3,
+			overCap:       2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := filterFixture()
+			got := domain.FilterIssues(score, tt.filter)
+
+			assert.Equal(t, tt.want, messages(got))
+			require.NotNil(t, got.Suppressed)
+			assert.Equal(t, tt.belowSeverity, got.Suppressed.BelowSeverity)
+			assert.Equal(t, tt.overCap, got.Suppressed.OverCap)
+			assert.Equal(t, tt.belowSeverity+tt.overCap, got.Suppressed.Total)
+			assert.Equal(t, got.Suppressed.Total, got.Categories[0].Suppressed+got.Categories[1].Suppressed)
+			assert.Equal(t, 70, got.Overall, "scores are unaffected")
+			assert.Len(t, messages(score), 6, "the original score keeps every issue")
+		})
+	}
+}
+
+func TestFilterIssues_InactiveReturnsScore(t *testing.T) {
+	score := filterFixture()
+	assert.Same(t, score, domain.FilterIssues(score, domain.IssueFilter{}))
+	assert.Nil(t, domain.FilterIssues(filterFixture(), domain.IssueFilter{MaxTotal: 10}).Suppressed)
+}
+
+func TestValidateSeverity(t *testing.T) {
+	assert.NoError(t, domain.ValidateSeverity("warning"))
+	assert.Error(t, domain.ValidateSeverity("critical"))
+}
+
+func TestIssueFilter_Validate(t *testing.T) {
+	assert.NoError(t, domain.IssueFilter{}.Validate())
+	assert.NoError(t, domain.IssueFilter{MinSeverity: domain.SeverityInfo, MaxTotal: 5}.Validate())
+	assert.ErrorContains(t, domain.IssueFilter{MinSeverity: "critical"}.Validate(), `unknown severity "critical"`)
+	assert.ErrorContains(t, domain.IssueFilter{MinSeverity: "Warning"}.Validate(), "unknown severity")
+	assert.ErrorContains(t, domain.IssueFilter{MaxPerSubMetric: -1}.Validate(), "negative")
+}
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// messageFormats is the catalog of issue messages: a stable ID for tooling
+// and the English format the message is rendered from. Translations key on
+// the same IDs, so renaming an ID breaks every catalog.
+var messageFormats = map[string]string{
+	"function_size.lines":           "function %s is %d lines (>%d)",
+	"cognitive_complexity.exceeded": "function %s has cognitive complexity %d (>%d)",
+	"parameter_count.exceeded":      "function %s has %d parameters (>%d)",
+	"file_size.lines":               "file has %d lines (>%d)",
+	"file_size.foreign_lines":       "%s file has %d lines (>%d)",
+	"code_duplication.string":       "string %s appears %d times in %d files (%s); move it to a constant",
+	"code_duplication.percent":      "file has %d%% duplicated lines (%d lines, >%d%%)",
+
+	"naming_uniqueness.single_word":            "exported function %q has a single-word name; consider a verb+noun pattern",
+	"naming_uniqueness.too_many_words":         "exported function %q has %d words; consider a shorter verb+noun pattern",
+	"naming_uniqueness.duplicate_name":         "exported function %q appears in %d packages",
+	"naming_uniqueness.vague_package":          "package %q is a vague name; consider a more descriptive name",
+	"naming_uniqueness.single_letter_params":   "exported function %q has %d single-letter parameters",
+	"naming_uniqueness.over_exported":          "internal package %s is only imported by %s, which never uses %d of its %d exported symbols: %s",
+	"naming_uniqueness.stutter":                "%s.%s repeats the package name; consider %s.%s",
+	"naming_uniqueness.rule":                   "%s %q does not match naming rule %q (%s)",
+	"naming_uniqueness.interface_er":           "single-method interface %s should be named for its method %s with an -er suffix (e.g. %s)",
+	"naming_uniqueness.interface_prefix":       "interface %s uses an I prefix; name it for its behavior (e.g. %s)",
+	"naming_uniqueness.interface_suffix":       "interface %s repeats Interface in its name; drop the suffix (e.g. %s)",
+	"file_naming_conventions.bare":             "file %q uses bare naming but project uses suffixed pattern",
+	"file_naming_conventions.suffixed":         "file %q uses suffixed naming but project uses bare pattern",
+	"file_naming_conventions.foreign":          "%s file %q does not follow %s naming",
+	"file_naming_conventions.embed_var":        "embed variable %q does not name the embedded %s",
+	"predictable_structure.missing_layer":      "module %q is missing %q layer that %d/%d peers have",
+	"predictable_structure.file_type_missing":  "file %q does not declare %s; it declares %s",
+	"predictable_structure.type_file_mismatch": "type %s has %d lines of methods but is declared in %q; move it to %s",
+	"predictable_structure.package_depth":      "package %q is nested %d directories deep (max %d); flatten the tree",
+	"predictable_structure.package_sprawl":     "%d of %d packages hold a single file of at most %d lines",
+	"predictable_structure.embed_location":     "embedded assets %q live in %q; use a conventional directory such as templates/, static/ or migrations/",
+	"predictable_structure.docs_missing":       "no README.md, ARCHITECTURE.md or docs/ describes the project's %d modules",
+	"predictable_structure.docs_unmentioned":   "module %q is not mentioned in README.md, ARCHITECTURE.md or docs/",
+	"predictable_structure.docs_stale":         "documentation refers to %s, which does not exist",
+	"dependency_direction.violation":           "%s layer imports %s (dependency direction violation)",
+	"dependency_direction.import_cycle":        "import cycle: %s",
+	"dependency_direction.coupling_outlier":    "package %q imports %d internal packages (median is %.0f)",
+	"function_coupling.fan_out":                "function %s calls %d distinct functions (>%d)",
+	"function_coupling.hub":                    "function %s is a hub: called by %d functions and calls %d",
+	"predictable_structure.embedding_depth":    "%s embeds types %d levels deep (%s), more than %d",
+	"predictable_structure.embedding_diamond":  "%s embeds %s through both %s and %s, so readers cannot tell which path its members come from",
+	"package_cohesion.split":                   "package %q splits into %d unrelated file groups across %d files (cohesion %.2f < %.2f)",
+
+	"structure.no_modules":                "no modules detected; cannot evaluate structure",
+	"interface_contracts.no_ports":        "module %q has domain/application layer but no port interfaces",
+	"interface_granularity.fat_interface": "interface %s has %d methods (>%d); split it into smaller role-specific interfaces",
+	"verifiability.missing":               "missing %s: %s",
+	"context_quality.no_claude_md":        "CLAUDE.md not found; add it to provide AI agents with project context",
+	"context_quality.no_cursorrules":      ".cursorrules not found; add it for Cursor IDE integration",
+	"context_quality.no_agents_md":        "AGENTS.md not found; add it to describe agent workflows",
+	"context_quality.package_doc_missing": "package %s has no package comment (imported by %d packages); add one in a doc.go",
+	"context_quality.package_doc_empty":   "package %s has a doc.go without a package comment (imported by %d packages)",
+
+	"predictability.no_error_handling":            "no error handling found across all source files",
+	"predictability.global_vars":                  "file has %d package-level variables (prefer explicit injection)",
+	"predictability.init_functions":               "file has %d init() function(s) (prefer explicit initialization)",
+	"consistent_patterns.mock_in_production":      "mock %s is defined in production code; move it to a mocks/ package or a *_mock.go file",
+	"consistent_patterns.getter_prefix":           "getter %s.%s should drop the Get prefix (%s)",
+	"consistent_patterns.getter_field":            "getter %s.%s returns field %s; name the getter after the field it returns",
+	"consistent_patterns.setter_error":            "setter %s.%s can fail (calls %s) but returns no error",
+	"consistent_patterns.missing_constructor":     "exported struct %s has unexported fields but no constructor (e.g. New%s)",
+	"consistent_patterns.constructor_error":       "constructor %s checks %s but returns no error; return (%s, error)",
+	"consistent_patterns.constructor_nil_check":   "constructor %s does not check required argument %s for nil",
+	"consistent_patterns.imports_unsorted":        "imports are not sorted within their group (%s before %s); run goimports",
+	"consistent_patterns.imports_mixed":           "import group mixes %s and %s imports (%s, %s); separate them with a blank line",
+	"consistent_patterns.imports_stdlib_last":     "standard library import %s comes after %s; put the standard library group first",
+	"consistent_patterns.alias_inconsistent":      "import %q is aliased %s here but %s in %d other files",
+	"consistent_patterns.receiver_inconsistent":   "method of %s uses receiver %s; other methods use %s (%d times)",
+	"consistent_patterns.receiver_self":           "method of %s uses receiver %s; name it after the type, e.g. %s",
+	"consistent_patterns.naked_return":            "function %s has %d naked returns in %d lines; return the values explicitly",
+	"hardcoded_secrets.secret":                    "possible %s hard-coded in %s",
+	"sql_injection.concat":                        "%s call splices %s into its SQL; pass values as query arguments",
+	"file_access.permissive_mode":                 "%s with mode %s makes the %s writable by every user on the host",
+	"file_access.path_traversal":                  "path joined from request input %s can reach parent directories through ..",
+	"logging_consistency.mixed_loggers":           "file logs with %s while the project logs with %s",
+	"logging_consistency.fmt_print":               "function %s prints with fmt.%s outside package main, where output belongs to the caller or the logger",
+	"logging_consistency.error_log_without_error": "error-level log call does not pass the error, so the cause is lost",
+	"consistent_patterns.process_exit":            "function %s calls %s outside package main, ending the process where callers and tests cannot recover",
+	"consistent_patterns.tag_casing":              "struct %s has %s tags %s that break the %s case the project uses",
+	"consistent_patterns.tag_missing":             "struct %s has json tags but exported fields %s have none and encode under their Go names",
+	"consistent_patterns.tag_duplicate":           "struct %s gives fields %s and %s the same %s tag %s, so one of them is dropped when encoding",
+	"consistent_patterns.same_type_params":        "function %s takes %d consecutive %s parameters (%s) that callers can transpose unnoticed",
+	"consistent_patterns.magic_number":            "number %s appears %d times in package %s (%s); name it with a constant",
+	"consistent_patterns.alias_shadows":           "import alias %s for %q shadows a predeclared identifier",
+	"consistent_patterns.header_missing":          "file header does not match the required pattern %q",
+	"consistent_patterns.deprecated_call":         "%s calls %s. %s",
+	"consistent_patterns.mock_import":             "production code imports mock package %q",
+	"consistent_patterns.test_helper_exported":    "test helper %s is exported from production code; move it to a testutil package",
+	"consistent_patterns.test_helper_duplicated":  "test helper %s is defined in %d packages; share one copy from a testutil package",
+	"consistent_patterns.test_helper_import":      "production code imports test helper package %q",
+}
+
+// formatVerb matches a single fmt verb, including flags, width and precision.
+var formatVerb = regexp.MustCompile(`%[-+# 0]*[0-9]*(?:\.[0-9]+)?[a-zA-Z%]`)
+
+// MessageFormat returns the English format of a catalog message.
+func MessageFormat(id string) (string, bool) {
+	f, ok := messageFormats[id]
+	return f, ok
+}
+
+// MessageIDs returns every catalog message ID.
+func MessageIDs() []string {
+	ids := make([]string, 0, len(messageFormats))
+	for id := range messageFormats {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// WithMessage returns i with its message rendered from the catalog entry
+// id. MessageArgs keeps each argument formatted by its own verb, so a
+// translation can place them in any order without reformatting.
+func (i Issue) WithMessage(id string, args ...any) Issue {
+	format, ok := messageFormats[id]
+	if !ok {
+		i.Message = id
+		return i
+	}
+	i.MessageID = id
+	i.Message = fmt.Sprintf(format, args...)
+	i.MessageArgs = nil
+	n := 0
+	for _, verb := range formatVerb.FindAllString(format, -1) {
+		if verb == "%%" || n >= len(args) {
+			continue
+		}
+		i.MessageArgs = append(i.MessageArgs, fmt.Sprintf(verb, args[n]))
+		n++
+	}
+	return i
+}
@@ -0,0 +1,30 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestIssueWithMessage_RendersCatalogFormat(t *testing.T) {
+	iss := domain.Issue{Severity: domain.SeverityError}.WithMessage("code_duplication.percent", 40, 23, 15)
+
+	assert.Equal(t, "code_duplication.percent", iss.MessageID)
+	assert.Equal(t, "file has 40% duplicated lines (23 lines, >15%)", iss.Message)
+	assert.Equal(t, []string{"40", "23", "15"}, iss.MessageArgs)
+	assert.Equal(t, domain.SeverityError, iss.Severity)
+}
+
+func TestIssueWithMessage_FormatsEachArgumentByItsVerb(t *testing.T) {
+	iss := domain.Issue{}.WithMessage("package_cohesion.split", "internal/x", 2, 5, 0.4, 0.5)
+
+	assert.Equal(t, []string{`"internal/x"`, "2", "5", "0.40", "0.50"}, iss.MessageArgs)
+}
+
+func TestIssueWithMessage_UnknownIDIsVisible(t *testing.T) {
+	iss := domain.Issue{}.WithMessage("no.such.message")
+	assert.Equal(t, "no.such.message", iss.Message)
+	assert.Empty(t, iss.MessageID)
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// ToolName identifies openkraft in report metadata.
+const ToolName = "openkraft"
+
+// ReportMetadata records what produced a score, from which inputs, so that
+// reports stored as build artifacts can be audited and reproduced.
+type ReportMetadata struct {
+	Tool        string      `json:"tool"`
+	ToolVersion string      `json:"tool_version,omitempty"`
+	ToolCommit  string      `json:"tool_commit,omitempty"`
+	ModulePath  string      `json:"module_path,omitempty"`
+	CommitSHA   string      `json:"commit_sha,omitempty"`
+	ProfileHash string      `json:"profile_hash,omitempty"` // see ProfileHash; empty for monorepo aggregates
+	Timestamp   time.Time   `json:"timestamp"`
+	Files       FileCounts  `json:"files"`
+	Provenance  *Provenance `json:"provenance,omitempty"`
+	// ScanDecisions explains the file counts: nested modules, submodules
+	// and symlinks the scanner skipped or followed.
+	ScanDecisions []ScanDecision `json:"scan_decisions,omitempty"`
+}
+
+// FileCounts are the file totals of the scanned project.
+type FileCounts struct {
+	Total    int `json:"total"`
+	Go       int `json:"go"`
+	Test     int `json:"test"`
+	Foreign  int `json:"foreign"`            // non-Go files measured by language analyzers
+	Analyzed int `json:"analyzed"`           // Go files parsed and scored
+	Unparsed int `json:"unparsed,omitempty"` // Go files left out because they do not parse
+}
+
+// Provenance describes the run that produced a report.
+type Provenance struct {
+	GoVersion  string   `json:"go_version"` // toolchain openkraft was built with
+	Platform   string   `json:"platform"`   // GOOS/GOARCH
+	Invocation []string `json:"invocation,omitempty"`
+	Source     string   `json:"source,omitempty"` // remote repository or archive, when not a local path
+	CI         *CIRun   `json:"ci,omitempty"`
+}
+
+// CIRun identifies the CI job a report was produced in.
+type CIRun struct {
+	Provider string `json:"provider"`
+	RunID    string `json:"run_id,omitempty"`
+	RunURL   string `json:"run_url,omitempty"`
+}
+
+// NewReportMetadata describes a score computed from scan with the given
+// profile and config. Tool version and provenance are left to the caller.
+func NewReportMetadata(scan *ScanResult, analyzed int, profile ScoringProfile, cfg ProjectConfig, ts time.Time) *ReportMetadata {
+	return &ReportMetadata{
+		Tool:        ToolName,
+		ModulePath:  scan.ModulePath,
+		ProfileHash: ProfileHash(profile, cfg),
+		Timestamp:   ts,
+		Files: FileCounts{
+			Total:    len(scan.AllFiles),
+			Go:       len(scan.GoFiles),
+			Test:     len(scan.TestFiles),
+			Foreign:  len(scan.ForeignFiles),
+			Analyzed: analyzed,
+			Unparsed: len(scan.ParseFailures),
+		},
+		ScanDecisions: scan.Decisions,
+	}
+}
+
+// ProfileHash returns the SHA-256 of the effective scoring profile and
+// project config. Two reports with the same hash were scored with the same
+// thresholds, weights and skips.
+func ProfileHash(profile ScoringProfile, cfg ProjectConfig) string {
+	data, _ := json.Marshal(struct {
+		Profile ScoringProfile `json:"profile"`
+		Config  ProjectConfig  `json:"config"`
+	}{profile, cfg})
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+)
+
+// FileMetrics are the raw size, complexity and duplication figures of one
+// Go file, for tabular exports. They are informational: scoring reads the
+// same data through its sub-metrics.
+type FileMetrics struct {
+	Path                   string `json:"path"`
+	Lines                  int    `json:"lines"`
+	Functions              int    `json:"functions"`
+	MaxCognitiveComplexity int    `json:"max_cognitive_complexity"`
+	DuplicatedLines        int    `json:"duplicated_lines"`
+	DuplicationPercent     int    `json:"duplication_percent"`
+	Issues                 int    `json:"issues"`
+	Generated              bool   `json:"generated,omitempty"`
+}
+
+// BuildFileMetrics returns the metrics of every analyzed file, sorted by
+// path. dupLines holds the duplicated lines per file as the
+// code_duplication sub-metric estimates them; issues are counted per file
+// across all categories.
+func BuildFileMetrics(analyzed map[string]*AnalyzedFile, dupLines map[string]int, categories []CategoryScore) []FileMetrics {
+	issues := map[string]int{}
+	for _, cat := range categories {
+		for _, iss := range cat.Issues {
+			if iss.File != "" {
+				issues[iss.File]++
+			}
+		}
+	}
+
+	out := make([]FileMetrics, 0, len(analyzed))
+	for path, af := range analyzed {
+		m := FileMetrics{
+			Path:            path,
+			Lines:           af.TotalLines,
+			Functions:       len(af.Functions),
+			DuplicatedLines: dupLines[path],
+			Issues:          issues[path],
+			Generated:       af.IsGenerated,
+		}
+		for _, fn := range af.Functions {
+			m.MaxCognitiveComplexity = max(m.MaxCognitiveComplexity, fn.CognitiveComplexity)
+		}
+		if af.TotalLines > 0 {
+			m.DuplicationPercent = m.DuplicatedLines * 100 / af.TotalLines
+		}
+		out = append(out, m)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Path < out[j].Path })
+	return out
+}
+
+// FunctionMetrics are the raw figures the parser measures for one function
+// or method, as the code_health sub-metrics read them.
+type FunctionMetrics struct {
+	File                string  `json:"file"`
+	Package             string  `json:"package"`
+	Name                string  `json:"name"`
+	Receiver            string  `json:"receiver,omitempty"`
+	Exported            bool    `json:"exported"`
+	Line                int     `json:"line"`
+	Lines               int     `json:"lines"`
+	Params              int     `json:"params"`
+	Returns             int     `json:"returns"`
+	MaxNesting          int     `json:"max_nesting"`
+	MaxCondOps          int     `json:"max_cond_ops"`
+	CognitiveComplexity int     `json:"cognitive_complexity"`
+	StringLiteralRatio  float64 `json:"string_literal_ratio"`
+	MaxCaseArms         int     `json:"max_case_arms"`
+	AvgCaseLines        float64 `json:"avg_case_lines"`
+	Generated           bool    `json:"generated,omitempty"`
+}
+
+// BuildFunctionMetrics returns the metrics of every function of the
+// analyzed files, ordered by file and line.
+func BuildFunctionMetrics(analyzed map[string]*AnalyzedFile) []FunctionMetrics {
+	var out []FunctionMetrics
+	for path, af := range analyzed {
+		for _, fn := range af.Functions {
+			out = append(out, FunctionMetrics{
+				File:                path,
+				Package:             af.Package,
+				Name:                fn.Name,
+				Receiver:            fn.Receiver,
+				Exported:            fn.Exported,
+				Line:                fn.LineStart,
+				Lines:               fn.LineEnd - fn.LineStart + 1,
+				Params:              len(fn.Params),
+				Returns:             len(fn.Returns),
+				MaxNesting:          fn.MaxNesting,
+				MaxCondOps:          fn.MaxCondOps,
+				CognitiveComplexity: fn.CognitiveComplexity,
+				StringLiteralRatio:  fn.StringLiteralRatio,
+				MaxCaseArms:         fn.MaxCaseArms,
+				AvgCaseLines:        fn.AvgCaseLines,
+				Generated:           af.IsGenerated,
+			})
+		}
+	}
+	slices.SortFunc(out, func(a, b FunctionMetrics) int {
+		return cmp.Or(cmp.Compare(a.File, b.File), cmp.Compare(a.Line, b.Line), cmp.Compare(a.Name, b.Name))
+	})
+	return out
+}
@@ -0,0 +1,62 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestBuildFileMetrics(t *testing.T) {
+	analyzed := map[string]*domain.AnalyzedFile{
+		"b.go": {Path: "b.go", TotalLines: 200, Functions: []domain.Function{
+			{Name: "Small", CognitiveComplexity: 2},
+			{Name: "Tangled", CognitiveComplexity: 31},
+		}},
+		"a.go":     {Path: "a.go", TotalLines: 40},
+		"gen.go":   {Path: "gen.go", TotalLines: 10, IsGenerated: true},
+		"empty.go": {Path: "empty.go"},
+	}
+	categories := []domain.CategoryScore{
+		{Name: "code_health", Issues: []domain.Issue{{File: "b.go"}, {File: "b.go"}, {Message: "project-wide"}}},
+		{Name: "structure", Issues: []domain.Issue{{File: "a.go"}}},
+	}
+
+	got := domain.BuildFileMetrics(analyzed, map[string]int{"b.go": 50, "empty.go": 3}, categories)
+
+	require.Len(t, got, 4)
+	assert.Equal(t, []string{"a.go", "b.go", "empty.go", "gen.go"},
+		[]string{got[0].Path, got[1].Path, got[2].Path, got[3].Path})
+	assert.Equal(t, domain.FileMetrics{
+		Path: "b.go", Lines: 200, Functions: 2, MaxCognitiveComplexity: 31,
+		DuplicatedLines: 50, DuplicationPercent: 25, Issues: 2,
+	}, got[1])
+	assert.Equal(t, 1, got[0].Issues)
+	assert.Zero(t, got[2].DuplicationPercent, "no lines, no percentage")
+	assert.True(t, got[3].Generated)
+}
+
+func TestBuildFunctionMetrics(t *testing.T) {
+	analyzed := map[string]*domain.AnalyzedFile{
+		"svc.go": {Path: "svc.go", Package: "svc", Functions: []domain.Function{
+			{Name: "Run", Receiver: "*Service", Exported: true, LineStart: 40, LineEnd: 69,
+				Params: []domain.Param{{Name: "ctx"}, {Name: "req"}}, Returns: []string{"error"},
+				MaxNesting: 3, MaxCondOps: 2, CognitiveComplexity: 12, StringLiteralRatio: 0.25, MaxCaseArms: 6, AvgCaseLines: 2.5},
+			{Name: "helper", LineStart: 10, LineEnd: 12},
+		}},
+		"a.go": {Path: "a.go", Package: "svc", Functions: []domain.Function{{Name: "New", LineStart: 5, LineEnd: 5}}},
+	}
+
+	got := domain.BuildFunctionMetrics(analyzed)
+
+	require.Len(t, got, 3)
+	assert.Equal(t, []string{"New", "helper", "Run"}, []string{got[0].Name, got[1].Name, got[2].Name})
+	assert.Equal(t, 1, got[0].Lines)
+	assert.Equal(t, domain.FunctionMetrics{
+		File: "svc.go", Package: "svc", Name: "Run", Receiver: "*Service", Exported: true,
+		Line: 40, Lines: 30, Params: 2, Returns: 1, MaxNesting: 3, MaxCondOps: 2,
+		CognitiveComplexity: 12, StringLiteralRatio: 0.25, MaxCaseArms: 6, AvgCaseLines: 2.5,
+	}, got[2])
+}
@@ -1,39 +1,55 @@
 package domain
 
 import (
+	"cmp"
 	"math"
+	"slices"
 	"time"
 )
 
+// ScoreSchemaVersion is the version of the JSON output contract for Score.
+// Minor bumps only add fields; a major bump signals a breaking change.
+const ScoreSchemaVersion = "1.1"
+
 // Score represents the overall AI-readiness score of a project.
 type Score struct {
-	Overall       int             `json:"overall"`
-	Categories    []CategoryScore `json:"categories"`
-	Timestamp     time.Time       `json:"timestamp"`
-	CommitHash    string          `json:"commit_hash,omitempty"`
-	ModuleScores  []ModuleScore   `json:"module_scores,omitempty"`
-	AppliedConfig *ProjectConfig  `json:"applied_config,omitempty"`
+	SchemaVersion     string                     `json:"schema_version"`
+	Overall           int                        `json:"overall"`
+	Categories        []CategoryScore            `json:"categories"`
+	Timestamp         time.Time                  `json:"timestamp"`
+	CommitHash        string                     `json:"commit_hash,omitempty"`
+	ModuleScores      []ModuleScore              `json:"module_scores,omitempty"`
+	AppliedConfig     *ProjectConfig             `json:"applied_config,omitempty"`
+	Churn             *ChurnSummary              `json:"churn,omitempty"`
+	BuildTags         []BuildTagGroup            `json:"build_tags,omitempty"`
+	ParseFailures     []ParseFailure             `json:"parse_failures,omitempty"` // Go files left out of the analysis
+	SelfProfile       *SelfProfile               `json:"self_profile,omitempty"`
+	Suppressed        *SuppressedIssues          `json:"suppressed_issues,omitempty"`
+	Metadata          *ReportMetadata            `json:"metadata,omitempty"`
+	Interfaces        []InterfaceImplementations `json:"interfaces,omitempty"`
+	Findings          []Finding                  `json:"findings,omitempty"` // issues grouped by GroupFindings
+	Binaries          []BinarySize               `json:"binaries,omitempty"` // from --binary-size; informational
+	DebtNotes         *DebtInventory             `json:"debt_notes,omitempty"`
+	Generics          []PackageGenerics          `json:"generics,omitempty"`
+	Exposure          []PackageExposure          `json:"exposure,omitempty"`
+	FileMetrics       []FileMetrics              `json:"file_metrics,omitempty"`
+	SuffixSuggestions []SuffixSuggestion         `json:"suffix_suggestions,omitempty"` // with learn_file_suffixes
+	GradeBands        []GradeBand                `json:"-"`                            // nil means DefaultGradeBands
+	Display           *PathDisplay               `json:"-"`                            // import paths in human-readable output; nil shows them unchanged
 }
 
-func (s Score) Grade() string { return GradeFor(s.Overall) }
-
-func GradeFor(score int) string {
-	switch {
-	case score >= 90:
-		return "A+"
-	case score >= 80:
-		return "A"
-	case score >= 70:
-		return "B"
-	case score >= 60:
-		return "C"
-	case score >= 50:
-		return "D"
-	default:
-		return "F"
-	}
+// ChurnSummary describes the git churn used to weight code_health penalties.
+type ChurnSummary struct {
+	Window       string `json:"window"`
+	FilesChanged int    `json:"files_changed"`
+	MaxCommits   int    `json:"max_commits"`
 }
 
+func (s Score) Grade() string { return GradeWithBands(s.Overall, s.GradeBands) }
+
+// GradeFor maps a score to a letter grade using DefaultGradeBands.
+func GradeFor(score int) string { return GradeWithBands(score, DefaultGradeBands) }
+
 func BadgeColor(score int) string {
 	switch {
 	case score >= 90:
@@ -58,6 +74,7 @@ type CategoryScore struct {
 	Weight     float64     `json:"weight"`
 	SubMetrics []SubMetric `json:"sub_metrics,omitempty"`
 	Issues     []Issue     `json:"issues,omitempty"`
+	Suppressed int         `json:"suppressed_issues,omitempty"` // issues hidden by an IssueFilter
 }
 
 type SubMetric struct {
@@ -92,14 +109,34 @@ func ComputeOverallScore(categories []CategoryScore) int {
 
 // Issue represents a problem found during analysis.
 type Issue struct {
-	Severity     string `json:"severity"`
-	Category     string `json:"category"`
-	SubMetric    string `json:"sub_metric,omitempty"`
-	File         string `json:"file,omitempty"`
-	Line         int    `json:"line,omitempty"`
-	Message      string `json:"message"`
-	Pattern      string `json:"pattern,omitempty"`
-	FixAvailable bool   `json:"fix_available"`
+	Severity     string   `json:"severity"`
+	Category     string   `json:"category"`
+	SubMetric    string   `json:"sub_metric,omitempty"`
+	File         string   `json:"file,omitempty"`
+	Line         int      `json:"line,omitempty"`
+	Message      string   `json:"message"`
+	MessageID    string   `json:"message_id,omitempty"`   // stable catalog ID, see Issue.WithMessage
+	MessageArgs  []string `json:"message_args,omitempty"` // formatted arguments of the catalog message
+	Pattern      string   `json:"pattern,omitempty"`
+	FixAvailable bool     `json:"fix_available"`
+	Owner        string   `json:"owner,omitempty"`       // first CODEOWNERS owner of File
+	Fingerprint  string   `json:"fingerprint,omitempty"` // see IssueFingerprint
+	Remediation  string   `json:"remediation,omitempty"` // concrete guidance for fixing the issue
+	CPUShare     float64  `json:"cpu_share,omitempty"`   // cumulative CPU share of the enclosing function, when a profile was given
+}
+
+// SortIssues orders issues by file, line, sub-metric, severity and message so
+// that output does not depend on the order in which scorers visited files.
+func SortIssues(issues []Issue) {
+	slices.SortStableFunc(issues, func(a, b Issue) int {
+		return cmp.Or(
+			cmp.Compare(a.File, b.File),
+			cmp.Compare(a.Line, b.Line),
+			cmp.Compare(a.SubMetric, b.SubMetric),
+			cmp.Compare(a.Severity, b.Severity),
+			cmp.Compare(a.Message, b.Message),
+		)
+	})
 }
 
 const (
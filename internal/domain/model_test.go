@@ -47,3 +47,20 @@ func TestBadgeColor(t *testing.T) {
 	assert.Equal(t, "brightgreen", domain.BadgeColor(95))
 	assert.Equal(t, "critical", domain.BadgeColor(30))
 }
+
+func TestSortIssues(t *testing.T) {
+	issues := []domain.Issue{
+		{File: "b.go", Line: 3, SubMetric: "function_size", Message: "x"},
+		{File: "a.go", Line: 10, SubMetric: "function_size", Message: "y"},
+		{Message: "project-wide"},
+		{File: "a.go", Line: 2, SubMetric: "parameter_count", Message: "z"},
+		{File: "a.go", Line: 2, SubMetric: "function_size", Message: "w"},
+	}
+	domain.SortIssues(issues)
+
+	var got []string
+	for _, iss := range issues {
+		got = append(got, iss.Message)
+	}
+	assert.Equal(t, []string{"project-wide", "w", "z", "y", "x"}, got)
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// ProjectScore is the scorecard of one Go module discovered under a
+// monorepo root.
+type ProjectScore struct {
+	Path       string `json:"path"` // relative to the root, "." for the root module
+	ModulePath string `json:"module_path,omitempty"`
+	Files      int    `json:"files"` // analyzed Go files, the aggregate weight
+	Score      *Score `json:"score"`
+}
+
+// MonorepoScore aggregates the scorecards of every project under a root.
+type MonorepoScore struct {
+	SchemaVersion string          `json:"schema_version"`
+	Root          string          `json:"root"`
+	Overall       int             `json:"overall"`
+	Categories    map[string]int  `json:"categories"`
+	Projects      []ProjectScore  `json:"projects"`
+	Timestamp     time.Time       `json:"timestamp"`
+	Metadata      *ReportMetadata `json:"metadata,omitempty"`
+	GradeBands    []GradeBand     `json:"-"` // nil means DefaultGradeBands
+}
+
+// Grade returns the letter grade of the aggregate score.
+func (m MonorepoScore) Grade() string { return GradeWithBands(m.Overall, m.GradeBands) }
+
+// AggregateProjects computes the overall and per-category aggregate scores
+// as the mean of the project scores weighted by analyzed file count, so a
+// large module moves the aggregate more than a small one. Projects without
+// analyzed files count with weight 1.
+func AggregateProjects(projects []ProjectScore) (overall int, categories map[string]int) {
+	var totalWeight, weightedOverall float64
+	catWeighted := make(map[string]float64)
+	catWeight := make(map[string]float64)
+
+	for _, p := range projects {
+		w := float64(max(p.Files, 1))
+		totalWeight += w
+		weightedOverall += float64(p.Score.Overall) * w
+		for _, c := range p.Score.Categories {
+			catWeighted[c.Name] += float64(c.Score) * w
+			catWeight[c.Name] += w
+		}
+	}
+
+	categories = make(map[string]int, len(catWeight))
+	for name, w := range catWeight {
+		categories[name] = int(math.Round(catWeighted[name] / w))
+	}
+	if totalWeight == 0 {
+		return 0, categories
+	}
+	return int(math.Round(weightedOverall / totalWeight)), categories
+}
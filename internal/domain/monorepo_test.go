@@ -0,0 +1,44 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func projectScore(path string, files, overall, health int) domain.ProjectScore {
+	return domain.ProjectScore{
+		Path:  path,
+		Files: files,
+		Score: &domain.Score{
+			Overall:    overall,
+			Categories: []domain.CategoryScore{{Name: "code_health", Score: health}},
+		},
+	}
+}
+
+func TestAggregateProjects_WeightsByFileCount(t *testing.T) {
+	overall, cats := domain.AggregateProjects([]domain.ProjectScore{
+		projectScore("big", 30, 90, 80),
+		projectScore("small", 10, 50, 40),
+	})
+	// (90*30 + 50*10) / 40 = 80; (80*30 + 40*10) / 40 = 70
+	assert.Equal(t, 80, overall)
+	assert.Equal(t, map[string]int{"code_health": 70}, cats)
+}
+
+func TestAggregateProjects_EmptyProjectCountsOnce(t *testing.T) {
+	overall, _ := domain.AggregateProjects([]domain.ProjectScore{
+		projectScore("a", 0, 60, 60),
+		projectScore("b", 1, 80, 80),
+	})
+	assert.Equal(t, 70, overall)
+}
+
+func TestAggregateProjects_NoProjects(t *testing.T) {
+	overall, cats := domain.AggregateProjects(nil)
+	assert.Equal(t, 0, overall)
+	assert.Empty(t, cats)
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+)
+
+// MovePackageEdit moves the package in the module-relative directory From
+// to the directory To. The package keeps its name.
+type MovePackageEdit struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// MovePackage moves the files of a package and rewrites the imports of it
+// in every file of the module. Files in subdirectories of the package
+// stay. Modules are left as they were; re-detect them from the moved scan.
+func (s ProjectSnapshot) MovePackage(modulePath string, e MovePackageEdit) error {
+	from, to := cleanPath(e.From), cleanPath(e.To)
+	move := func(f string) string {
+		if path.Dir(f) != from {
+			return f
+		}
+		return path.Join(to, path.Base(f))
+	}
+	moved := 0
+	for p, af := range s.Analyzed {
+		if np := move(p); np != p {
+			edited := *af
+			edited.Path = np
+			delete(s.Analyzed, p)
+			s.Analyzed[np] = &edited
+			moved++
+		}
+	}
+	if moved == 0 {
+		return fmt.Errorf("move_package: no Go file in %s", e.From)
+	}
+
+	oldImport, newImport := path.Join(modulePath, from), path.Join(modulePath, to)
+	for p, af := range s.Analyzed {
+		if slices.Contains(af.Imports, oldImport) {
+			s.Analyzed[p] = renameImport(af, oldImport, newImport)
+		}
+	}
+	for _, files := range [][]string{s.Scan.GoFiles, s.Scan.TestFiles, s.Scan.AllFiles} {
+		for i, f := range files {
+			files[i] = move(f)
+		}
+	}
+	for i := range s.Scan.ForeignFiles {
+		s.Scan.ForeignFiles[i].Path = move(s.Scan.ForeignFiles[i].Path)
+	}
+	return nil
+}
+
+// renameImport returns a copy of af that imports newImport where it
+// imported oldImport.
+func renameImport(af *AnalyzedFile, oldImport, newImport string) *AnalyzedFile {
+	rename := func(imp string) string {
+		if imp == oldImport {
+			return newImport
+		}
+		return imp
+	}
+	edited := *af
+	edited.Imports = make([]string, len(af.Imports))
+	for i, imp := range af.Imports {
+		edited.Imports[i] = rename(imp)
+	}
+	edited.ImportGroups = make([][]string, len(af.ImportGroups))
+	for i, group := range af.ImportGroups {
+		edited.ImportGroups[i] = make([]string, len(group))
+		for j, imp := range group {
+			edited.ImportGroups[i][j] = rename(imp)
+		}
+	}
+	if alias, ok := af.ImportAliases[oldImport]; ok {
+		edited.ImportAliases = maps.Clone(af.ImportAliases)
+		delete(edited.ImportAliases, oldImport)
+		edited.ImportAliases[newImport] = alias
+	}
+	if refs, ok := af.QualifiedRefs[oldImport]; ok {
+		edited.QualifiedRefs = maps.Clone(af.QualifiedRefs)
+		delete(edited.QualifiedRefs, oldImport)
+		edited.QualifiedRefs[newImport] = refs
+	}
+	return &edited
+}
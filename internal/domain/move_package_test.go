@@ -0,0 +1,31 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestProjectSnapshot_MovePackage(t *testing.T) {
+	base := simulationSnapshot()
+	base.Analyzed["internal/app/run.go"].QualifiedRefs = map[string][]string{"example.com/svc/internal/adapters/db": {"Open"}}
+	db := &domain.AnalyzedFile{Path: "internal/adapters/db/db.go"}
+	base.Analyzed[db.Path] = db
+	base.Scan.GoFiles = append(base.Scan.GoFiles, db.Path)
+	snap := base.Clone()
+	require.NoError(t, snap.MovePackage("example.com/svc", domain.MovePackageEdit{From: "internal/adapters/db", To: "internal/adapters/outbound/db"}))
+
+	assert.NotContains(t, snap.Analyzed, db.Path)
+	assert.Equal(t, "internal/adapters/outbound/db/db.go", snap.Analyzed["internal/adapters/outbound/db/db.go"].Path)
+	run := snap.Analyzed["internal/app/run.go"]
+	assert.Equal(t, []string{"fmt", "example.com/svc/internal/adapters/outbound/db"}, run.Imports)
+	assert.Equal(t, []string{"Open"}, run.QualifiedRefs["example.com/svc/internal/adapters/outbound/db"])
+	assert.Contains(t, snap.Scan.GoFiles, "internal/adapters/outbound/db/db.go")
+	assert.Contains(t, base.Analyzed, db.Path)
+	assert.Contains(t, base.Analyzed["internal/app/run.go"].Imports, "example.com/svc/internal/adapters/db")
+
+	assert.ErrorContains(t, snap.MovePackage("example.com/svc", domain.MovePackageEdit{From: "internal/missing", To: "internal/x"}), "no Go file in internal/missing")
+}
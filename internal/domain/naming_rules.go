@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Naming rule kinds: what a rule's pattern is matched against.
+const (
+	NamingKindFile      = "file"      // file base name, e.g. "user_service.go"
+	NamingKindStruct    = "struct"    // struct type name
+	NamingKindInterface = "interface" // interface type name
+)
+
+// ValidNamingKinds lists the accepted NamingRule kinds.
+var ValidNamingKinds = []string{NamingKindFile, NamingKindStruct, NamingKindInterface}
+
+// NamingRule is a team naming convention checked on top of the built-in
+// file naming analysis, e.g. "interfaces end in -er or Port". Every name of
+// Kind in a file matching Path must match Pattern; Select narrows the rule
+// to names that match it, so "ports must be XPort" is a rule selecting
+// interfaces in ports.go.
+type NamingRule struct {
+	Name    string `yaml:"name,omitempty"   json:"name,omitempty"`
+	Kind    string `yaml:"kind"             json:"kind"`
+	Pattern string `yaml:"pattern"          json:"pattern"`
+	Select  string `yaml:"select,omitempty" json:"select,omitempty"` // regex; only matching names are checked
+	Path    string `yaml:"path,omitempty"   json:"path,omitempty"`   // glob over the file path; "dir/**" matches a subtree
+}
+
+// Label returns the rule's name, or its kind and pattern when unnamed.
+func (r NamingRule) Label() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return r.Kind + " " + r.Pattern
+}
+
+// AppliesTo reports whether the rule checks files at file.
+func (r NamingRule) AppliesTo(file string) bool {
+	return r.Path == "" || MatchPathGlob(r.Path, file)
+}
+
+// CompiledNamingRule is a NamingRule with its regexes compiled.
+type CompiledNamingRule struct {
+	NamingRule
+	pattern *regexp.Regexp
+	sel     *regexp.Regexp
+}
+
+// Selects reports whether name is subject to the rule.
+func (r CompiledNamingRule) Selects(name string) bool {
+	return r.sel == nil || r.sel.MatchString(name)
+}
+
+// Allows reports whether name satisfies the rule's pattern.
+func (r CompiledNamingRule) Allows(name string) bool {
+	return r.pattern.MatchString(name)
+}
+
+// CompileNamingRules compiles rules, skipping any whose regexes do not
+// compile. Config validation rejects such rules, so in practice none are
+// skipped.
+func CompileNamingRules(rules []NamingRule) []CompiledNamingRule {
+	var compiled []CompiledNamingRule
+	for _, r := range rules {
+		c, err := compileNamingRule(r)
+		if err != nil {
+			continue
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled
+}
+
+func compileNamingRule(r NamingRule) (CompiledNamingRule, error) {
+	c := CompiledNamingRule{NamingRule: r}
+	var err error
+	if c.pattern, err = regexp.Compile(r.Pattern); err != nil {
+		return c, fmt.Errorf("pattern %q: %w", r.Pattern, err)
+	}
+	if r.Select != "" {
+		if c.sel, err = regexp.Compile(r.Select); err != nil {
+			return c, fmt.Errorf("select %q: %w", r.Select, err)
+		}
+	}
+	return c, nil
+}
+
+func validateNamingRules(rules []NamingRule) error {
+	for i, r := range rules {
+		valid := false
+		for _, k := range ValidNamingKinds {
+			if r.Kind == k {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("naming[%d].kind %q is invalid (valid: %s)", i, r.Kind, strings.Join(ValidNamingKinds, ", "))
+		}
+		if r.Pattern == "" {
+			return fmt.Errorf("naming[%d].pattern is required", i)
+		}
+		if _, err := compileNamingRule(r); err != nil {
+			return fmt.Errorf("naming[%d].%w", i, err)
+		}
+		if r.Path != "" {
+			if _, err := path.Match(strings.TrimSuffix(r.Path, "/**"), ""); err != nil {
+				return fmt.Errorf("naming[%d].path %q: %w", i, r.Path, err)
+			}
+		}
+	}
+	return nil
+}
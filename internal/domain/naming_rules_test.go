@@ -0,0 +1,61 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileNamingRules(t *testing.T) {
+	rules := domain.CompileNamingRules([]domain.NamingRule{
+		{Kind: "interface", Pattern: `(er|Port)$`},
+		{Kind: "interface", Pattern: `Port$`, Select: `^[A-Z]`, Path: "internal/application/**"},
+		{Kind: "struct", Pattern: `(`}, // does not compile; skipped
+	})
+	require.Len(t, rules, 2)
+
+	assert.True(t, rules[0].Allows("Scanner"))
+	assert.True(t, rules[0].Allows("ScorePort"))
+	assert.False(t, rules[0].Allows("Scoring"))
+	assert.True(t, rules[0].Selects("anything"))
+	assert.True(t, rules[0].AppliesTo("cmd/main.go"))
+
+	assert.True(t, rules[1].Selects("Repository"))
+	assert.False(t, rules[1].Selects("repository"))
+	assert.True(t, rules[1].AppliesTo("internal/application/ports.go"))
+	assert.False(t, rules[1].AppliesTo("internal/domain/ports.go"))
+}
+
+func TestNamingRule_Label(t *testing.T) {
+	assert.Equal(t, "ports", domain.NamingRule{Name: "ports", Kind: "interface", Pattern: "Port$"}.Label())
+	assert.Equal(t, "interface Port$", domain.NamingRule{Kind: "interface", Pattern: "Port$"}.Label())
+}
+
+func TestValidate_NamingRules(t *testing.T) {
+	valid := domain.ProjectConfig{Naming: []domain.NamingRule{
+		{Name: "ports", Kind: "interface", Pattern: `Port$`, Path: "internal/application/**"},
+		{Kind: "file", Pattern: `^[a-z0-9_]+\.go$`},
+	}}
+	assert.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name string
+		rule domain.NamingRule
+		want string
+	}{
+		{"unknown kind", domain.NamingRule{Kind: "enum", Pattern: "x"}, `naming[0].kind "enum" is invalid`},
+		{"missing pattern", domain.NamingRule{Kind: "struct"}, "naming[0].pattern is required"},
+		{"bad pattern", domain.NamingRule{Kind: "struct", Pattern: "("}, `naming[0].pattern "("`},
+		{"bad select", domain.NamingRule{Kind: "struct", Pattern: "x", Select: "["}, `naming[0].select "["`},
+		{"bad path", domain.NamingRule{Kind: "struct", Pattern: "x", Path: "["}, `naming[0].path "["`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := domain.ProjectConfig{Naming: []domain.NamingRule{tt.rule}}.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxNotifiedErrors caps the new errors a notification lists by name.
+const maxNotifiedErrors = 5
+
+// RunSummary is what a notification reports about one scoring run.
+type RunSummary struct {
+	Project     string `json:"project"` // remote source or module path
+	Commit      string `json:"commit,omitempty"`
+	Overall     int    `json:"overall"`
+	Grade       string `json:"grade"`
+	HasBaseline bool   `json:"has_baseline"`
+	Baseline    int    `json:"baseline,omitempty"`
+	Delta       int    `json:"delta,omitempty"`
+	// Errors counts the error-severity issues of the run; NewErrors lists
+	// those absent from the baseline, or all of them without one.
+	Errors    int     `json:"errors"`
+	NewErrors []Issue `json:"new_errors"`
+}
+
+// SummarizeRun summarizes score for a notification, against baseline when
+// it is not nil. New errors are matched by IssueFingerprint, as compare
+// does.
+func SummarizeRun(score, baseline *Score) RunSummary {
+	s := RunSummary{Overall: score.Overall, Grade: score.Grade(), Commit: score.CommitHash, NewErrors: []Issue{}}
+	if md := score.Metadata; md != nil {
+		s.Project = md.ModulePath
+		if md.Provenance != nil && md.Provenance.Source != "" {
+			s.Project = md.Provenance.Source
+		}
+	}
+	all := allIssues(score)
+	fresh := all
+	if baseline != nil {
+		s.HasBaseline = true
+		s.Baseline = baseline.Overall
+		s.Delta = score.Overall - baseline.Overall
+		fresh = issueDifference(all, allIssues(baseline))
+	}
+	for _, iss := range all {
+		if iss.Severity == SeverityError {
+			s.Errors++
+		}
+	}
+	for _, iss := range fresh {
+		if iss.Severity == SeverityError {
+			s.NewErrors = append(s.NewErrors, iss)
+		}
+	}
+	return s
+}
+
+// Headline is the one-line summary notifications lead with, e.g.
+// "github.com/org/repo@1a2b3c4d scored 78 (B), down 3 from 81, 2 new errors".
+// Without a baseline it counts every error.
+func (s RunSummary) Headline() string {
+	project := s.Project
+	if project == "" {
+		project = "project"
+	}
+	if s.Commit != "" {
+		project += fmt.Sprintf("@%.8s", s.Commit)
+	}
+	line := fmt.Sprintf("%s scored %d (%s)", project, s.Overall, s.Grade)
+	switch {
+	case !s.HasBaseline:
+	case s.Delta > 0:
+		line += fmt.Sprintf(", up %d from %d", s.Delta, s.Baseline)
+	case s.Delta < 0:
+		line += fmt.Sprintf(", down %d from %d", -s.Delta, s.Baseline)
+	default:
+		line += fmt.Sprintf(", unchanged from %d", s.Baseline)
+	}
+	noun := "error"
+	if s.HasBaseline {
+		noun = "new error"
+	}
+	switch n := len(s.NewErrors); {
+	case n == 1:
+		line += ", 1 " + noun
+	case n > 1:
+		line += fmt.Sprintf(", %d %ss", n, noun)
+	default:
+		line += ", no " + noun + "s"
+	}
+	return line
+}
+
+// ErrorLines lists the first new errors as "file:line: message", with a
+// final line counting the rest.
+func (s RunSummary) ErrorLines() []string {
+	var lines []string
+	for i, iss := range s.NewErrors {
+		if i == maxNotifiedErrors {
+			lines = append(lines, fmt.Sprintf("and %d more", len(s.NewErrors)-i))
+			break
+		}
+		loc := iss.File
+		if loc != "" && iss.Line > 0 {
+			loc = fmt.Sprintf("%s:%d", iss.File, iss.Line)
+		}
+		lines = append(lines, strings.TrimPrefix(loc+": "+iss.Message, ": "))
+	}
+	return lines
+}
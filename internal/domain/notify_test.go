@@ -0,0 +1,48 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func TestSummarizeRun_AgainstBaseline(t *testing.T) {
+	old := domain.Issue{Severity: domain.SeverityError, Category: "security", File: "a.go", Line: 3, Message: "hardcoded secret"}
+	moved := old
+	moved.Line = 9
+	fresh := domain.Issue{Severity: domain.SeverityError, Category: "security", File: "b.go", Line: 1, Message: "sql built by concatenation"}
+	warning := domain.Issue{Severity: domain.SeverityWarning, Category: "code_health", File: "c.go", Message: "long function"}
+	baseline := &domain.Score{Overall: 81, Categories: []domain.CategoryScore{{Name: "security", Issues: []domain.Issue{old}}}}
+	score := &domain.Score{
+		Overall:    78,
+		CommitHash: "1a2b3c4d5e6f",
+		Metadata:   &domain.ReportMetadata{ModulePath: "example.com/shop"},
+		Categories: []domain.CategoryScore{{Name: "security", Issues: []domain.Issue{moved, fresh, warning}}},
+	}
+
+	s := domain.SummarizeRun(score, baseline)
+
+	assert.Equal(t, 2, s.Errors)
+	require.Len(t, s.NewErrors, 1)
+	assert.Equal(t, "b.go", s.NewErrors[0].File)
+	assert.Equal(t, "example.com/shop@1a2b3c4d scored 78 (B), down 3 from 81, 1 new error", s.Headline())
+	assert.Equal(t, []string{"b.go:1: sql built by concatenation"}, s.ErrorLines())
+}
+
+func TestSummarizeRun_WithoutBaseline(t *testing.T) {
+	issues := make([]domain.Issue, 7)
+	for i := range issues {
+		issues[i] = domain.Issue{Severity: domain.SeverityError, File: "a.go", Line: i + 1, Message: "error"}
+	}
+	score := &domain.Score{Overall: 95, Categories: []domain.CategoryScore{{Issues: issues}}}
+
+	s := domain.SummarizeRun(score, nil)
+
+	assert.Equal(t, "project scored 95 (A+), 7 errors", s.Headline())
+	lines := s.ErrorLines()
+	require.Len(t, lines, 6)
+	assert.Equal(t, "and 2 more", lines[5])
+}
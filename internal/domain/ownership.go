@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+// UnownedLabel groups issues whose file matches no CODEOWNERS rule.
+const UnownedLabel = "(unowned)"
+
+// CodeOwners holds the parsed rules of a CODEOWNERS file. As on GitHub, the
+// last rule matching a path wins.
+type CodeOwners struct {
+	rules []ownerRule
+	dir   string // the project's directory within the repository
+}
+
+type ownerRule struct {
+	pattern string
+	re      *regexp.Regexp
+	owners  []string
+}
+
+// OwnerDebt summarizes the issues attributed to one owner.
+type OwnerDebt struct {
+	Owner    string  `json:"owner"`
+	Issues   int     `json:"issues"`
+	Errors   int     `json:"errors"`
+	Warnings int     `json:"warnings"`
+	Infos    int     `json:"infos"`
+	Debt     float64 `json:"debt"` // severity-weighted issue count
+}
+
+// ParseCodeOwners parses CODEOWNERS content. Comments, blank lines and
+// patterns that cannot be compiled are ignored.
+func ParseCodeOwners(content string) *CodeOwners {
+	co := &CodeOwners{}
+	for _, line := range strings.Split(content, "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		re, err := regexp.Compile(ownerPatternRegexp(fields[0]))
+		if err != nil {
+			continue
+		}
+		co.rules = append(co.rules, ownerRule{pattern: fields[0], re: re, owners: fields[1:]})
+	}
+	return co
+}
+
+// Under returns the rules for a project that sits in dir, a slash-separated
+// directory relative to the repository root: the paths given to Owners are
+// then relative to dir.
+func (c *CodeOwners) Under(dir string) *CodeOwners {
+	under := *c
+	under.dir = strings.Trim(dir, "/")
+	if under.dir == "." {
+		under.dir = ""
+	}
+	return &under
+}
+
+// Owners returns the owners of a slash-separated path relative to the
+// repository root, or to the project's directory for rules returned by
+// Under, or nil when no rule matches.
+func (c *CodeOwners) Owners(path string) []string {
+	if c == nil {
+		return nil
+	}
+	path = strings.TrimPrefix(path, "/")
+	if c.dir != "" {
+		path = c.dir + "/" + path
+	}
+	for i := len(c.rules) - 1; i >= 0; i-- {
+		if c.rules[i].re.MatchString(path) {
+			return c.rules[i].owners
+		}
+	}
+	return nil
+}
+
+// Owner returns the first owner listed for path, or "" when it has none.
+func (c *CodeOwners) Owner(path string) string {
+	owners := c.Owners(path)
+	if len(owners) == 0 {
+		return ""
+	}
+	return owners[0]
+}
+
+// ownerPatternRegexp translates a gitignore-style CODEOWNERS pattern into
+// an anchored regular expression. A pattern also matches everything below
+// a matching directory, except "dir/*" which only covers direct children.
+func ownerPatternRegexp(pattern string) string {
+	anchored := strings.HasPrefix(pattern, "/") || strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	shallow := strings.HasSuffix(pattern, "/*")
+	p := strings.Trim(pattern, "/")
+
+	var b strings.Builder
+	if anchored {
+		b.WriteString("^")
+	} else {
+		b.WriteString("^(?:.*/)?")
+	}
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case p[i] == '*':
+			b.WriteString("[^/]*")
+		case p[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(p[i])))
+		}
+	}
+	if shallow {
+		b.WriteString("$")
+	} else {
+		b.WriteString("(?:/.*)?$")
+	}
+	return b.String()
+}
@@ -0,0 +1,60 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+const sampleCodeOwners = `# Default owners
+*                       @org/platform
+
+*.md                    @org/docs
+/internal/payments/     @org/payments @alice
+cmd/*                   @org/cli
+**/testdata             @org/qa
+/internal/payments/legacy/
+`
+
+func TestCodeOwners_Owner(t *testing.T) {
+	co := domain.ParseCodeOwners(sampleCodeOwners)
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"main.go", "@org/platform"},
+		{"README.md", "@org/docs"},
+		{"internal/payments/domain/payment.go", "@org/payments"},
+		{"internal/payments/README.md", "@org/payments"},
+		{"cmd/main.go", "@org/cli"},
+		{"cmd/tool/main.go", "@org/platform"},
+		{"internal/tax/testdata/input.go", "@org/qa"},
+		{"internal/payments/legacy/old.go", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, co.Owner(tt.path))
+		})
+	}
+}
+
+func TestCodeOwners_OwnersListsAll(t *testing.T) {
+	co := domain.ParseCodeOwners(sampleCodeOwners)
+	assert.Equal(t, []string{"@org/payments", "@alice"}, co.Owners("internal/payments/x.go"))
+}
+
+func TestCodeOwners_UnanchoredPatternMatchesAnyDepth(t *testing.T) {
+	co := domain.ParseCodeOwners("docs/ @org/docs\nvendor @org/deps\nbuild/logs @org/ops\n")
+	assert.Equal(t, "@org/deps", co.Owner("third_party/vendor/lib.go"))
+	assert.Equal(t, "@org/docs", co.Owner("internal/docs/guide.go"), "a trailing slash alone does not anchor")
+	assert.Equal(t, "@org/ops", co.Owner("build/logs/out.txt"))
+	assert.Empty(t, co.Owner("tools/build/logs/out.txt"), "an inner slash anchors to the root")
+}
+
+func TestCodeOwners_NilHasNoOwners(t *testing.T) {
+	var co *domain.CodeOwners
+	assert.Empty(t, co.Owner("main.go"))
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ParseError is a syntax error in a source file, as a CodeAnalyzer reports
+// it: the position and message of the first error and how many there are.
+type ParseError struct {
+	Line    int
+	Column  int
+	Message string
+	Count   int
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Message)
+}
+
+// ParseFailure is a Go file left out of the analysis because it could not
+// be parsed. Line and Column locate the first syntax error; they are zero
+// when the file could not be read at all.
+type ParseFailure struct {
+	File   string `json:"file"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+	Error  string `json:"error"`
+}
+
+// NewParseFailure describes the failure of the analyzer to parse file,
+// given relative to the project root, with err.
+func NewParseFailure(file string, err error) ParseFailure {
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		return ParseFailure{File: file, Error: err.Error()}
+	}
+	msg := perr.Message
+	if perr.Count > 1 {
+		msg += fmt.Sprintf(" (and %d more errors)", perr.Count-1)
+	}
+	return ParseFailure{File: file, Line: perr.Line, Column: perr.Column, Error: msg}
+}
+
+// ParseFailuresError is returned instead of a score when strict parsing
+// is requested and files fail to parse.
+type ParseFailuresError struct {
+	Failures []ParseFailure
+}
+
+func (e *ParseFailuresError) Error() string {
+	lines := make([]string, len(e.Failures))
+	for i, f := range e.Failures {
+		lines[i] = "  " + f.String()
+	}
+	noun := "files"
+	if len(e.Failures) == 1 {
+		noun = "file"
+	}
+	return fmt.Sprintf("%d Go %s could not be parsed:\n%s", len(e.Failures), noun, strings.Join(lines, "\n"))
+}
+
+// String formats the failure as file:line:column: error.
+func (f ParseFailure) String() string {
+	if f.Line == 0 {
+		return f.File + ": " + f.Error
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", f.File, f.Line, f.Column, f.Error)
+}
@@ -0,0 +1,47 @@
+package domain_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewParseFailure(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want domain.ParseFailure
+	}{
+		{
+			name: "syntax error",
+			err:  fmt.Errorf("parsing /abs/a.go: %w", &domain.ParseError{Line: 3, Column: 9, Message: "expected ')'", Count: 1}),
+			want: domain.ParseFailure{File: "a.go", Line: 3, Column: 9, Error: "expected ')'"},
+		},
+		{
+			name: "several syntax errors",
+			err:  &domain.ParseError{Line: 1, Column: 1, Message: "expected 'package'", Count: 3},
+			want: domain.ParseFailure{File: "a.go", Line: 1, Column: 1, Error: "expected 'package' (and 2 more errors)"},
+		},
+		{
+			name: "unreadable file",
+			err:  errors.New("permission denied"),
+			want: domain.ParseFailure{File: "a.go", Error: "permission denied"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, domain.NewParseFailure("a.go", tt.err))
+		})
+	}
+}
+
+func TestParseFailuresError(t *testing.T) {
+	err := &domain.ParseFailuresError{Failures: []domain.ParseFailure{
+		{File: "a.go", Line: 3, Column: 9, Error: "expected ')'"},
+		{File: "b.go", Error: "permission denied"},
+	}}
+	assert.Equal(t, "2 Go files could not be parsed:\n  a.go:3:9: expected ')'\n  b.go: permission denied", err.Error())
+}
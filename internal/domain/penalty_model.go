@@ -0,0 +1,63 @@
+package domain
+
+import "fmt"
+
+// Penalty model presets select how harshly issues and over-limit values are
+// punished, so organizations can calibrate scoring without forking.
+const (
+	PenaltyModelSonar   = "sonar"
+	PenaltyModelLenient = "lenient"
+	PenaltyModelStrict  = "strict"
+)
+
+// ValidPenaltyModels enumerates the built-in penalty model presets.
+var ValidPenaltyModels = []string{PenaltyModelSonar, PenaltyModelLenient, PenaltyModelStrict}
+
+// PenaltyModel holds the constants behind decay credit and severity
+// penalties. Penalty = round(Σ weight(severity) / functions * Scale); decay
+// credit reaches zero at limit * (DecayK + 1).
+type PenaltyModel struct {
+	Scale         float64 // points per unit of debt ratio
+	DecayK        int     // decay span past the limit, in multiples of the limit
+	ErrorWeight   float64
+	WarningWeight float64
+	InfoWeight    float64
+}
+
+// penaltyModels are the presets. sonar is the default and aligns with
+// SonarQube's SQALE model: well-maintained OSS projects land around 88-98.
+var penaltyModels = map[string]PenaltyModel{
+	PenaltyModelSonar:   {Scale: 120, DecayK: 4, ErrorWeight: 3, WarningWeight: 1, InfoWeight: 0.2},
+	PenaltyModelLenient: {Scale: 80, DecayK: 6, ErrorWeight: 2, WarningWeight: 0.5, InfoWeight: 0.1},
+	PenaltyModelStrict:  {Scale: 160, DecayK: 3, ErrorWeight: 4, WarningWeight: 1.5, InfoWeight: 0.3},
+}
+
+// PenaltyModelPreset returns the named preset, or the sonar model for an
+// empty or unknown name.
+func PenaltyModelPreset(name string) PenaltyModel {
+	if m, ok := penaltyModels[name]; ok {
+		return m
+	}
+	return penaltyModels[PenaltyModelSonar]
+}
+
+// Weight returns the debt weight of one issue of the given severity.
+func (m PenaltyModel) Weight(severity string) float64 {
+	switch severity {
+	case SeverityError:
+		return m.ErrorWeight
+	case SeverityWarning:
+		return m.WarningWeight
+	case SeverityInfo:
+		return m.InfoWeight
+	}
+	return 0
+}
+
+// ValidatePenaltyModel reports an error for names that are not a preset.
+func ValidatePenaltyModel(name string) error {
+	if _, ok := penaltyModels[name]; ok {
+		return nil
+	}
+	return fmt.Errorf("unknown penalty model %q (valid: sonar, lenient, strict)", name)
+}
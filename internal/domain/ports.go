@@ -1,10 +1,43 @@
 package domain
 
-import "strings"
+import (
+	"io"
+	"strings"
+	"time"
+)
+
+// BinarySizer builds main packages and measures the resulting binaries.
+type BinarySizer interface {
+	// Measure builds the main package in each of mainDirs, relative to
+	// projectPath, and reports its stripped size and largest packages.
+	Measure(projectPath string, mainDirs []string) ([]BinarySize, error)
+}
+
+// DeprecationFinder finds the deprecated functions and methods of packages
+// outside the project.
+type DeprecationFinder interface {
+	// Deprecated loads importPaths as resolved from projectPath and maps
+	// each deprecated function ("path.Func") or method ("path.Type.Method")
+	// to its "Deprecated:" paragraph.
+	Deprecated(projectPath string, importPaths []string) (map[string]string, error)
+}
+
+// TypeResolver type-checks a project to replace the parser's name-based
+// guesses with resolved facts.
+type TypeResolver interface {
+	// Resolve type-checks the packages under rootPath and updates the
+	// files in analyzed, keyed by path relative to rootPath, in place:
+	// calls get their resolved package and receiver type, interfaces get
+	// their implementations, and each type-checked file is marked Typed.
+	Resolve(rootPath string, analyzed map[string]*AnalyzedFile) error
+}
 
 // ProjectScanner scans a project directory and returns file metadata.
 type ProjectScanner interface {
 	Scan(projectPath string, excludePaths ...string) (*ScanResult, error)
+	// DiscoverProjects returns the directories under root, relative to it,
+	// that hold their own go.mod.
+	DiscoverProjects(root string, excludePaths ...string) ([]string, error)
 }
 
 // ArchLayout describes the project's architectural layout.
@@ -26,6 +59,7 @@ type ScanResult struct {
 	AllFiles        []string `json:"all_files"`
 	HasGoMod        bool     `json:"has_go_mod"`
 	ModulePath      string   `json:"module_path,omitempty"`
+	GoMod           *GoModInfo `json:"go_mod,omitempty"`
 	HasClaudeMD     bool     `json:"has_claude_md"`
 	HasCursorRules  bool     `json:"has_cursor_rules"`
 	HasAgentsMD     bool     `json:"has_agents_md"`
@@ -38,6 +72,47 @@ type ScanResult struct {
 	CursorRulesSize        int    `json:"cursor_rules_size"`
 	ReadmeSize             int        `json:"readme_size"`
 	Layout                 ArchLayout `json:"layout"`
+	// FileChurn holds commits per file over the churn window; nil unless
+	// churn-weighted scoring was requested.
+	FileChurn              map[string]int `json:"file_churn,omitempty"`
+	// CPUProfile holds the pprof CPU samples used to raise code_health
+	// issues on hot paths; nil unless a profile was given.
+	CPUProfile             *CPUProfile `json:"-"`
+	// Deprecations maps the deprecated functions of direct dependencies to
+	// their "Deprecated:" paragraphs; nil unless they were looked up.
+	Deprecations           map[string]string `json:"-"`
+	// ForeignFiles holds the non-Go source files measured by language analyzers.
+	ForeignFiles           []ForeignFile `json:"foreign_files,omitempty"`
+	// DuplicatedLines holds estimated duplicated lines per clone-eligible Go
+	// file when low-memory mode indexed duplication on disk; nil otherwise.
+	DuplicatedLines        map[string]int `json:"-"`
+	// FileTallies holds the file-local sub-metric tally of every parsed Go
+	// file when low-memory mode tallied them during parsing; nil otherwise.
+	FileTallies            map[string]FileTally `json:"-"`
+	// Decisions records the directories the scanner skipped or followed
+	// specially: nested modules, git submodules and symlinks.
+	Decisions              []ScanDecision `json:"scan_decisions,omitempty"`
+	// ParseFailures lists the Go files left out of the analysis because
+	// they could not be parsed.
+	ParseFailures          []ParseFailure `json:"parse_failures,omitempty"`
+	// Docs holds the project's README.md, ARCHITECTURE.md and the Markdown
+	// files under docs/, keyed by slash-separated path.
+	Docs                   map[string]string `json:"-"`
+}
+
+// Kinds of directories the scanner makes a recorded decision about.
+const (
+	ScanNestedModule = "nested-module"
+	ScanSubmodule    = "submodule"
+	ScanSymlink      = "symlink"
+)
+
+// ScanDecision explains why a directory's files are or are not in a scan.
+type ScanDecision struct {
+	Path     string `json:"path"` // relative to the project root, slash-separated
+	Kind     string `json:"kind"`
+	Included bool   `json:"included"`
+	Reason   string `json:"reason"`
 }
 
 // AddFile adds a file path to the appropriate file lists.
@@ -103,6 +178,33 @@ type DetectedModule struct {
 // CodeAnalyzer parses source files and extracts structural information.
 type CodeAnalyzer interface {
 	AnalyzeFile(filePath string) (*AnalyzedFile, error)
+	AnalyzeSource(filePath string, src []byte) (*AnalyzedFile, error)
+	// AnalyzePartial measures source that does not parse with a tolerant
+	// token-level pass, so incremental analysis keeps some signal while a
+	// file is mid-edit. The result has Partial set.
+	AnalyzePartial(filePath string, src []byte) *AnalyzedFile
+}
+
+// LanguageAnalyzer measures a non-Go source file so mixed-language projects
+// are not scored on their Go files alone.
+type LanguageAnalyzer interface {
+	Language() string
+	// Extensions lists the file extensions handled, including the dot.
+	Extensions() []string
+	AnalyzeFile(filePath string) (*ForeignFile, error)
+}
+
+// ForeignFile holds the language-agnostic metrics of a non-Go source file.
+type ForeignFile struct {
+	Path        string `json:"path"`
+	Language    string `json:"language"`
+	TotalLines  int    `json:"total_lines"`
+	CodeLines   int    `json:"code_lines"`
+	IsGenerated bool   `json:"is_generated,omitempty"`
+	// NamingConvention is the file naming style expected for the language
+	// (e.g. "snake_case"); NamingOK reports whether the file name follows it.
+	NamingConvention string `json:"naming_convention,omitempty"`
+	NamingOK         bool   `json:"naming_ok"`
 }
 
 // AnalyzedFile holds the structural analysis of a single source file.
@@ -110,25 +212,98 @@ type AnalyzedFile struct {
 	Path           string       `json:"path"`
 	Package        string       `json:"package"`
 	Structs        []string     `json:"structs,omitempty"`
+	StructDefs     []StructDef  `json:"struct_defs,omitempty"`
 	Functions      []Function   `json:"functions,omitempty"`
 	Interfaces     []string       `json:"interfaces,omitempty"`
 	InterfaceDefs  []InterfaceDef `json:"interface_defs,omitempty"`
 	Imports        []string     `json:"imports,omitempty"`
+	// ImportGroups are the import paths as written, split into the groups
+	// separated by blank lines or by separate import declarations.
+	ImportGroups [][]string `json:"import_groups,omitempty"`
+	// ImportAliases maps import path to the explicit name it is imported
+	// under; blank and dot imports are left out.
+	ImportAliases map[string]string `json:"import_aliases,omitempty"`
+	// QualifiedRefs maps import path to the names the file selects from
+	// that package (pkg.Name), in order of first use.
+	QualifiedRefs map[string][]string `json:"qualified_refs,omitempty"`
 	PackageDoc     bool         `json:"package_doc,omitempty"`
+	// Header is the source above the package clause: build constraints,
+	// license block and package doc, capped at 4 KiB.
+	Header         string       `json:"header,omitempty"`
+	DebtNotes      []DebtNote   `json:"debt_notes,omitempty"`
 	InitFunctions  int          `json:"init_functions,omitempty"`
 	GlobalVars     []string     `json:"global_vars,omitempty"`
+	// Declarations are the package-level consts, vars and types other
+	// than structs and interfaces, for the API diff.
+	Declarations []Declaration `json:"declarations,omitempty"`
 	ErrorCalls     []ErrorCall  `json:"error_calls,omitempty"`
 	TypeAssertions []TypeAssert `json:"type_assertions,omitempty"`
+	// NumericLiterals are the number literals in function bodies outside
+	// const declarations, except 0, 1 and -1.
+	NumericLiterals []NumericLiteral `json:"numeric_literals,omitempty"`
+	// StringLiterals are the long string literals outside const
+	// declarations, imports and struct tags, unquoted.
+	StringLiterals []StringLiteral `json:"string_literals,omitempty"`
+	// SecretCandidates are the string literals that look like a
+	// credential, for the secret scan.
+	SecretCandidates []SecretCandidate `json:"secret_candidates,omitempty"`
+	// SQLCalls are the query calls whose SQL is concatenated or formatted
+	// from run-time values.
+	SQLCalls []SQLCall `json:"sql_calls,omitempty"`
+	// FileModes are the calls that create or chmod files with a constant
+	// permission mode; PathJoins the path joins fed by request input.
+	FileModes []FileMode `json:"file_modes,omitempty"`
+	PathJoins []PathJoin `json:"path_joins,omitempty"`
+	// ErrorLogs are the error-level calls on loggers, e.g. log.Error(...)
+	// or logger.Error().Msg(...).
+	ErrorLogs []ErrorLog `json:"error_logs,omitempty"`
 	TotalLines       int          `json:"total_lines,omitempty"`
 	NormalizedTokens []int        `json:"-"`
+	// TokenLines holds the source line of each normalized token;
+	// TokenValues a hash in [0, 1<<30) of the text of each identifier and
+	// literal token, 0 for other tokens.
+	TokenLines       []int        `json:"-"`
+	TokenValues      []int        `json:"-"`
+	// ImportTokens counts the leading tokens of the package clause and
+	// imports; TestTableTokens are the [start, end) token ranges of the
+	// case lists of table-driven tests.
+	ImportTokens     int          `json:"-"`
+	TestTableTokens  [][2]int     `json:"-"`
 	IsGenerated      bool         `json:"is_generated,omitempty"`
+	// GenericTypes lists the types declared with type parameters.
+	GenericTypes     []string     `json:"generic_types,omitempty"`
+	// Typed is set when a TypeResolver resolved the file's calls and
+	// interface implementations.
+	Typed            bool         `json:"typed,omitempty"`
+	// Partial is set when the file does not parse and only a token-level
+	// pass measured it: package, imports, type and function names, function
+	// line spans and line counts. Everything else is empty.
+	Partial          bool         `json:"partial,omitempty"`
 	HasCGoImport   bool         `json:"has_cgo_import,omitempty"`
+	// CGoPreambleLines is the length of the C preamble above import "C";
+	// CGoExports lists the functions exported to C with //export.
+	CGoPreambleLines int      `json:"cgo_preamble_lines,omitempty"`
+	CGoExports       []string `json:"cgo_exports,omitempty"`
+	Embeds         []EmbedDirective `json:"embeds,omitempty"`
+	// BuildConstraint is the file's effective build constraint, from its
+	// //go:build line and GOOS/GOARCH name suffix; "" when unconstrained.
+	BuildConstraint string   `json:"build_constraint,omitempty"`
+	BuildTags       []string `json:"build_tags,omitempty"` // non-negated tags in BuildConstraint
+}
+
+// EmbedDirective is a //go:embed directive and the variable it initializes.
+// Patterns are relative to the directory of the file declaring Var.
+type EmbedDirective struct {
+	Var      string   `json:"var"`
+	Patterns []string `json:"patterns"`
+	Line     int      `json:"line"`
 }
 
 // Function represents a function or method extracted from source.
 type Function struct {
 	Name               string   `json:"name"`
 	Receiver           string   `json:"receiver,omitempty"`
+	ReceiverName       string   `json:"receiver_name,omitempty"` // "" when the receiver is unnamed
 	Exported           bool     `json:"exported"`
 	LineStart          int      `json:"line_start"`
 	LineEnd            int      `json:"line_end"`
@@ -140,12 +315,62 @@ type Function struct {
 	StringLiteralRatio  float64  `json:"string_literal_ratio,omitempty"`
 	MaxCaseArms        int      `json:"max_case_arms,omitempty"`
 	AvgCaseLines       float64  `json:"avg_case_lines,omitempty"`
+	CallsC             bool     `json:"calls_c,omitempty"` // body calls into C via cgo
+	// ReturnsField is the receiver field a method body consists of
+	// returning, e.g. "name" for return u.name; "" otherwise.
+	ReturnsField string `json:"returns_field,omitempty"`
+	// ZeroChecks lists the parameters the body compares against a zero
+	// value (nil, "", 0 or an empty len), directly or through a field.
+	ZeroChecks []string `json:"zero_checks,omitempty"`
+	// NamedResults is set when the results are named; NakedReturns counts
+	// the bare return statements of such a function.
+	NamedResults bool `json:"named_results,omitempty"`
+	NakedReturns int  `json:"naked_returns,omitempty"`
+	Calls              []Call   `json:"calls,omitempty"`
+	// Deprecated is the "Deprecated:" paragraph of the doc comment, or ""
+	// when the function is not deprecated.
+	Deprecated string `json:"deprecated,omitempty"`
+	TypeParams []TypeParam `json:"type_params,omitempty"`
+}
+
+// TypeParam is a type parameter of a generic function. Terms counts the
+// union terms, methods and named constraints in its constraint; any has
+// none.
+type TypeParam struct {
+	Name       string `json:"name"`
+	Constraint string `json:"constraint"`
+	Terms      int    `json:"terms,omitempty"`
+}
+
+// ConstraintTerms sums the constraint terms of f's type parameters.
+func (f Function) ConstraintTerms() int {
+	n := 0
+	for _, tp := range f.TypeParams {
+		n += tp.Terms
+	}
+	return n
 }
 
 // Param represents a function parameter.
 type Param struct {
 	Name string `json:"name"`
 	Type string `json:"type"`
+	Tag  string `json:"tag,omitempty"` // struct field tag, unquoted; empty for parameters
+}
+
+// Call is a distinct call expression in a function body. Package is set
+// for calls through an imported package and Receiver for calls on the
+// function's own receiver; Method marks other selector calls, whose
+// receiver type is unknown without type checking. In type-checked files,
+// Receiver is set for every method call on a named type, with Package set
+// when the type is declared in another package, and Method marks only
+// calls on interfaces and function values.
+type Call struct {
+	Name     string `json:"name"`
+	Package  string `json:"package,omitempty"`
+	Receiver string `json:"receiver,omitempty"`
+	Method   bool   `json:"method,omitempty"`
+	Line     int    `json:"line"`
 }
 
 // ErrorCall represents an error creation call found in source.
@@ -156,10 +381,102 @@ type ErrorCall struct {
 	Format     string `json:"format"`      // the format string literal
 }
 
+// StructDef represents a struct with its fields. Embedded fields are named
+// after their type and also listed in Embedded.
+type StructDef struct {
+	Name     string         `json:"name"`
+	Fields   []Param        `json:"fields,omitempty"`
+	Embedded []EmbeddedType `json:"embedded,omitempty"`
+}
+
 // InterfaceDef represents an interface with its method signatures.
 type InterfaceDef struct {
 	Name    string   `json:"name"`
 	Methods []string `json:"methods"` // method names
+	// Signatures are the parameter and result types of each method, in
+	// Methods order and without names, e.g. "(string) (int, error)".
+	Signatures []string `json:"signatures,omitempty"`
+	// Embedded lists the interfaces embedded in this one.
+	Embedded []EmbeddedType `json:"embedded,omitempty"`
+	// Implementations lists the types whose method sets satisfy the
+	// interface, signatures included; set only in type-checked files.
+	Implementations []Implementation `json:"implementations,omitempty"`
+}
+
+// Declaration is a package-level const, var or type declaration other than
+// a struct or interface. Type is written as in source: the declared type
+// of a const or var, "" when it is left to the value, and the underlying
+// type of a type declaration, "= T" for an alias. TypeParams are the type
+// parameters of a generic type, e.g. "[T any]".
+type Declaration struct {
+	Kind       string `json:"kind"` // const, var or type
+	Name       string `json:"name"`
+	TypeParams string `json:"type_params,omitempty"`
+	Type       string `json:"type,omitempty"`
+	Line       int    `json:"line"`
+}
+
+// EmbeddedType is a type embedded in a struct or interface. Package is the
+// import path of a type from another package and empty for a local type.
+type EmbeddedType struct {
+	Package string `json:"package,omitempty"`
+	Name    string `json:"name"`
+	Pointer bool   `json:"pointer,omitempty"`
+}
+
+// NumericLiteral is a number literal as written in source, with a leading
+// "-" when negated.
+type NumericLiteral struct {
+	Value string `json:"value"`
+	Line  int    `json:"line"`
+}
+
+// SecretCandidate is a string literal that looks like a credential. Name
+// is the constant, variable, field or map key the literal is assigned to,
+// or ""; Kind is what SecretKind took it for. The value itself is never
+// kept, so analysis results and the cache written from them cannot leak
+// it.
+type SecretCandidate struct {
+	Name string `json:"name,omitempty"`
+	Kind string `json:"kind"`
+	Line int    `json:"line"`
+}
+
+// SQLCall is a call such as db.Query or tx.ExecContext whose query string
+// is built at run time. Dynamic lists the source of the operands spliced
+// into the SQL, e.g. "name" or "strconv.Itoa(id)".
+type SQLCall struct {
+	Method  string   `json:"method"`
+	Dynamic []string `json:"dynamic"`
+	Line    int      `json:"line"`
+}
+
+// FileMode is a call such as os.WriteFile or os.MkdirAll and the constant
+// permission mode it passes.
+type FileMode struct {
+	Call string `json:"call"` // e.g. "os.OpenFile"
+	Mode uint32 `json:"mode"`
+	Line int    `json:"line"`
+}
+
+// PathJoin is a filepath.Join or path.Join call with arguments derived
+// from request input, listed in Inputs as written.
+type PathJoin struct {
+	Inputs []string `json:"inputs"`
+	Line   int      `json:"line"`
+}
+
+// ErrorLog is an error-level logging call. HasError is set when one of its
+// arguments, or of a call chained to it, refers to an error value.
+type ErrorLog struct {
+	Line     int  `json:"line"`
+	HasError bool `json:"has_error,omitempty"`
+}
+
+// StringLiteral is the unquoted value of a string literal and its line.
+type StringLiteral struct {
+	Value string `json:"value"`
+	Line  int    `json:"line"`
 }
 
 // TypeAssert represents a type assertion found in source.
@@ -171,6 +488,32 @@ type TypeAssert struct {
 type GitInfo interface {
 	CommitHash(projectPath string) (string, error)
 	IsGitRepo(projectPath string) bool
+	// FileChurn counts commits since the given time that touched each file,
+	// keyed by path relative to projectPath.
+	FileChurn(projectPath string, since time.Time) (map[string]int, error)
+	// Worktree checks ref out into a temporary worktree and returns the
+	// directory matching projectPath inside it, plus a cleanup function.
+	Worktree(projectPath, ref string) (dir string, cleanup func() error, err error)
+	// Clone fetches ref of a remote repository into a temporary directory
+	// and returns it, plus a cleanup function.
+	Clone(url, ref string) (dir string, cleanup func() error, err error)
+}
+
+// CodeOwnersLoader reads the project's CODEOWNERS file, returning nil when
+// the project has none.
+type CodeOwnersLoader interface {
+	Load(projectPath string) (*CodeOwners, error)
+}
+
+// CloneIndex collects token-window hashes outside the heap so duplication
+// can be detected without holding every file's tokens in memory.
+type CloneIndex interface {
+	// Add records the window hashes of a file; hashes[i] starts at token i.
+	Add(file string, hashes []uint64) error
+	// DuplicatePositions returns, per file, the start positions of windows
+	// shared with another file for which canPair reports true.
+	DuplicatePositions(canPair func(a, b string) bool) (map[string][]int, error)
+	Close() error
 }
 
 // ScoreHistory persists and retrieves historical scores.
@@ -191,10 +534,65 @@ type CacheStore interface {
 	Invalidate(projectPath string) error
 }
 
+// ResultCache stores full analysis results under a ResultCacheKey so later
+// CI stages can reuse them instead of analyzing the same commit again.
+// Get returns (nil, nil) when nothing is stored under key.
+type ResultCache interface {
+	Get(key string) (*Score, error)
+	Put(key string, score *Score) error
+}
+
+// IssueTracker files tickets in an issue tracker such as GitHub Issues or
+// Jira. Filed reports the tickets already labeled TicketLabel, open or
+// closed, as URLs by fingerprint; Create files one and returns its URL.
+type IssueTracker interface {
+	Filed() (map[string]string, error)
+	Create(t Ticket) (string, error)
+}
+
+// Notifier sends the summary of a scoring run to people watching it, e.g.
+// a chat channel.
+type Notifier interface {
+	Notify(s RunSummary) error
+}
+
+// Tracer exports the phases of a run (scan, parse, score, render) as spans
+// to an observability backend. Phases are recorded once they end, with
+// attributes as alternating keys and values, as log/slog takes them.
+type Tracer interface {
+	RecordSpan(name string, start, end time.Time, attrs ...any)
+}
+
+// ScoreRenderer writes a score in one output format.
+type ScoreRenderer interface {
+	RenderScore(w io.Writer, score *Score) error
+}
+
+// MonorepoRenderer writes the scorecard of a recursive run in one output
+// format.
+type MonorepoRenderer interface {
+	RenderMonorepo(w io.Writer, m *MonorepoScore) error
+}
+
 // ScoreEntry represents a single historical score record.
 type ScoreEntry struct {
-	Timestamp  string `json:"timestamp"`
-	CommitHash string `json:"commit_hash,omitempty"`
-	Overall    int    `json:"overall"`
-	Grade      string `json:"grade"`
+	Timestamp      string   `json:"timestamp"`
+	CommitHash     string   `json:"commit_hash,omitempty"`
+	Overall        int      `json:"overall"`
+	Grade          string   `json:"grade"`
+	NewIssues      int      `json:"new_issues,omitempty"`
+	ResolvedIssues int      `json:"resolved_issues,omitempty"`
+	Fingerprints   []string `json:"fingerprints,omitempty"` // kept on the latest entry only
+}
+
+// TrackIssues sets NewIssues and ResolvedIssues by comparing the entry's
+// fingerprints with those of the most recent previous entry that has them.
+// Moved code keeps its fingerprint, so it counts as neither.
+func (e *ScoreEntry) TrackIssues(previous []ScoreEntry) {
+	for i := len(previous) - 1; i >= 0; i-- {
+		if previous[i].Fingerprints != nil {
+			e.NewIssues, e.ResolvedIssues = diffFingerprints(previous[i].Fingerprints, e.Fingerprints)
+			return
+		}
+	}
 }
@@ -0,0 +1,289 @@
+package domain
+
+import (
+	"cmp"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+)
+
+// Config presets are complete starting points a project config can extend
+// with `extends: <name>`. Each bundles weights, thresholds, a calibration and
+// a penalty model; the project config then only states its deltas.
+const (
+	PresetStrict  = "strict"
+	PresetDefault = "default"
+	PresetLegacy  = "legacy"
+	PresetLibrary = "library"
+	PresetCLIApp  = "cli-app"
+)
+
+// ValidPresets enumerates the built-in config presets.
+var ValidPresets = []string{PresetStrict, PresetDefault, PresetLegacy, PresetLibrary, PresetCLIApp}
+
+// presets build each preset afresh, so callers may modify the result.
+var presets = map[string]func() ProjectConfig{
+	// default is the built-in behavior, named so configs can say so.
+	PresetDefault: func() ProjectConfig { return ProjectConfig{} },
+
+	// strict tightens limits and grade bands, punishes issues harder and
+	// gives security hints a share of the overall score.
+	PresetStrict: func() ProjectConfig {
+		return ProjectConfig{
+			Weights: map[string]float64{
+				"code_health": 0.25, "discoverability": 0.15, "structure": 0.15,
+				"verifiability": 0.20, "context_quality": 0.10, "predictability": 0.10,
+				"security_hints": 0.05,
+			},
+			Calibration:  CalibrationStrict,
+			PenaltyModel: PenaltyModelStrict,
+			Profile: &ProfileOverrides{
+				MaxInterfaceMethods: ptrTo(4),
+				MaxEmbeddingDepth:   ptrTo(2),
+				MinPackageCohesion:  ptrTo(0.6),
+				MaxGlobalVarPenalty: ptrTo(5),
+			},
+		}
+	},
+
+	// legacy relaxes limits for established code bases, so the score
+	// tracks improvement instead of condemning history.
+	PresetLegacy: func() ProjectConfig {
+		return ProjectConfig{
+			Weights: map[string]float64{
+				"code_health": 0.30, "discoverability": 0.20, "structure": 0.10,
+				"verifiability": 0.20, "context_quality": 0.05, "predictability": 0.15,
+			},
+			Calibration:  CalibrationLegacyFriendly,
+			PenaltyModel: PenaltyModelLenient,
+			Profile: &ProfileOverrides{
+				MaxInterfaceMethods: ptrTo(8),
+				MaxEmbeddingDepth:   ptrTo(4),
+				MaxPackageDepth:     ptrTo(9),
+				SmoothSmallSamples:  ptrTo(true),
+			},
+		}
+	},
+
+	// library favors tests and small, stable APIs over application layers.
+	PresetLibrary: func() ProjectConfig {
+		return ProjectConfig{
+			ProjectType: ProjectTypeLibrary,
+			Weights: map[string]float64{
+				"code_health": 0.20, "discoverability": 0.20, "structure": 0.10,
+				"verifiability": 0.25, "context_quality": 0.15, "predictability": 0.10,
+			},
+			Skip: SkipConfig{SubMetrics: []string{"interface_contracts", "module_completeness"}},
+			Profile: &ProfileOverrides{
+				MaxInterfaceMethods:   ptrTo(3),
+				MaxDirectDependencies: ptrTo(10),
+			},
+		}
+	},
+
+	// cli-app expects a command tree wired in cmd/ rather than ports and
+	// adapters, and allows the longer functions flag setup produces.
+	PresetCLIApp: func() ProjectConfig {
+		return ProjectConfig{
+			ProjectType: ProjectTypeCLI,
+			Weights: map[string]float64{
+				"code_health": 0.25, "discoverability": 0.20, "structure": 0.10,
+				"verifiability": 0.20, "context_quality": 0.10, "predictability": 0.15,
+			},
+			Skip: SkipConfig{SubMetrics: []string{"interface_contracts", "module_completeness"}},
+			Profile: &ProfileOverrides{
+				MaxFunctionLines: ptrTo(60),
+				CompositionRoots: []string{"cmd"},
+			},
+		}
+	},
+}
+
+// ConfigPreset returns the named preset and whether it exists.
+func ConfigPreset(name string) (ProjectConfig, bool) {
+	build, ok := presets[name]
+	if !ok {
+		return ProjectConfig{}, false
+	}
+	cfg := build()
+	cfg.Extends = name
+	return cfg, true
+}
+
+// ValidatePreset reports an error for names that are not a preset.
+func ValidatePreset(name string) error {
+	if _, ok := presets[name]; ok {
+		return nil
+	}
+	return fmt.Errorf("unknown preset %q in extends (valid: strict, default, legacy, library, cli-app)", name)
+}
+
+// ApplyPreset returns cfg layered over the preset it extends, as
+// OverlayConfig layers it. A config that extends nothing, a remote config
+// or an unknown preset is returned unchanged.
+func ApplyPreset(cfg ProjectConfig) ProjectConfig {
+	base, ok := ConfigPreset(cfg.Extends)
+	if !ok {
+		return cfg
+	}
+	return OverlayConfig(base, cfg)
+}
+
+// OverlayConfig returns cfg layered over base, the preset or remote config
+// it extends. Weights, min_thresholds and gate limits merge key by key and
+// profile overrides field by field. Exclude paths and naming rules of both
+// apply, and cfg's severity rules come first so they win. Any other value
+// cfg sets replaces base's.
+func OverlayConfig(base, cfg ProjectConfig) ProjectConfig {
+	result := cfg
+	result.Weights = mergeMaps(base.Weights, cfg.Weights)
+	result.MinThresholds = mergeMaps(base.MinThresholds, cfg.MinThresholds)
+	result.Profile = mergeProfileOverrides(base.Profile, cfg.Profile)
+	result.Gates = mergeGates(base.Gates, cfg.Gates)
+	result.ExcludePaths = concat(base.ExcludePaths, cfg.ExcludePaths)
+	result.Naming = concat(base.Naming, cfg.Naming)
+	result.Severity = concat(cfg.Severity, base.Severity)
+	if cfg.ProjectType == "" {
+		result.ProjectType = base.ProjectType
+	}
+	if len(cfg.Skip.Categories) == 0 {
+		result.Skip.Categories = base.Skip.Categories
+	}
+	if len(cfg.Skip.SubMetrics) == 0 {
+		result.Skip.SubMetrics = base.Skip.SubMetrics
+	}
+	if cfg.Calibration == "" {
+		result.Calibration = base.Calibration
+	}
+	if len(cfg.Grades) == 0 {
+		result.Grades = base.Grades
+	}
+	if cfg.PenaltyModel == "" {
+		result.PenaltyModel = base.PenaltyModel
+	}
+	if cfg.Headers == nil {
+		result.Headers = base.Headers
+	}
+	result.Display = mergeDisplay(base.Display, cfg.Display)
+	return result
+}
+
+// mergeDisplay overlays cfg's display settings on base's: aliases merge by
+// prefix and stripping is on if either turns it on.
+func mergeDisplay(base, cfg *DisplayConfig) *DisplayConfig {
+	if base == nil || cfg == nil {
+		return cmp.Or(cfg, base)
+	}
+	return &DisplayConfig{
+		StripModulePrefix: base.StripModulePrefix || cfg.StripModulePrefix,
+		Aliases:           mergeMaps(base.Aliases, cfg.Aliases),
+	}
+}
+
+// IsRemoteExtends reports whether extends names a config to fetch, an
+// http(s) URL or a git+ URL, rather than a built-in preset.
+func IsRemoteExtends(extends string) bool {
+	for _, prefix := range []string{"https://", "http://", "git+"} {
+		if strings.HasPrefix(extends, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateExtends checks extends and its checksum pin: a preset name, or a
+// remote config pinned by extends_checksum as sha256:<hex>. Plain http,
+// also under git+, and the unauthenticated git:// protocol must be pinned,
+// since nothing else vouches for the content.
+func validateExtends(extends, checksum string) error {
+	if !IsRemoteExtends(extends) {
+		if checksum != "" {
+			return fmt.Errorf("extends_checksum pins a remote config, but extends names %q", extends)
+		}
+		if extends == "" {
+			return nil
+		}
+		return ValidatePreset(extends)
+	}
+	if checksum == "" {
+		for _, prefix := range []string{"http://", "git+http://", "git+git://"} {
+			if strings.HasPrefix(extends, prefix) {
+				return fmt.Errorf("extends %q is fetched without transport security and must be pinned with extends_checksum", extends)
+			}
+		}
+		return nil
+	}
+	digest, ok := strings.CutPrefix(checksum, "sha256:")
+	if _, err := hex.DecodeString(digest); !ok || err != nil || len(digest) != 64 {
+		return fmt.Errorf("extends_checksum %q must be sha256: followed by 64 hex digits", checksum)
+	}
+	return nil
+}
+
+// mergeGates returns base's gates with the limits override sets replacing
+// base's.
+func mergeGates(base, override *GatesConfig) *GatesConfig {
+	if base == nil || override == nil {
+		if override != nil {
+			return override
+		}
+		return base
+	}
+	out := GatesConfig{
+		MinOverall:  base.MinOverall,
+		MinCategory: mergeMaps(base.MinCategory, override.MinCategory),
+		MaxIssues:   mergeMaps(base.MaxIssues, override.MaxIssues),
+	}
+	if override.MinOverall != nil {
+		out.MinOverall = override.MinOverall
+	}
+	return &out
+}
+
+// concat returns the elements of a followed by those of b, or nil when
+// both are empty.
+func concat[T any](a, b []T) []T {
+	if len(a) == 0 && len(b) == 0 {
+		return nil
+	}
+	return append(append(make([]T, 0, len(a)+len(b)), a...), b...)
+}
+
+// mergeMaps returns base with the entries of override added or replaced,
+// or nil when both are empty.
+func mergeMaps[V any](base, override map[string]V) map[string]V {
+	if len(base) == 0 && len(override) == 0 {
+		return nil
+	}
+	out := make(map[string]V, len(base)+len(override))
+	maps.Copy(out, base)
+	maps.Copy(out, override)
+	return out
+}
+
+// mergeProfileOverrides returns base with every field override sets
+// replacing base's.
+func mergeProfileOverrides(base, override *ProfileOverrides) *ProfileOverrides {
+	if base == nil || override == nil {
+		if override != nil {
+			return override
+		}
+		return base
+	}
+	out := *base
+	dst := reflect.ValueOf(&out).Elem()
+	src := reflect.ValueOf(override).Elem()
+	for i := range src.NumField() {
+		if f := src.Field(i); !f.IsZero() {
+			dst.Field(i).Set(f)
+		}
+	}
+	return &out
+}
+
+// ptrTo returns a pointer to v.
+func ptrTo[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,150 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresets_AreValid(t *testing.T) {
+	for _, name := range domain.ValidPresets {
+		cfg, ok := domain.ConfigPreset(name)
+		require.True(t, ok, name)
+		assert.Equal(t, name, cfg.Extends)
+		require.NoError(t, cfg.Validate(), name)
+		if len(cfg.Weights) == 0 {
+			continue
+		}
+		sum := 0.0
+		for _, w := range cfg.Weights {
+			sum += w
+		}
+		assert.InDelta(t, 1.0, sum, 0.001, name)
+	}
+}
+
+func TestPreset_ReturnsFreshCopies(t *testing.T) {
+	a, _ := domain.ConfigPreset(domain.PresetStrict)
+	a.Weights["code_health"] = 0.9
+	*a.Profile.MaxInterfaceMethods = 40
+
+	b, _ := domain.ConfigPreset(domain.PresetStrict)
+	assert.InDelta(t, 0.25, b.Weights["code_health"], 0.001)
+	assert.Equal(t, 4, *b.Profile.MaxInterfaceMethods)
+}
+
+func TestValidate_UnknownPreset(t *testing.T) {
+	err := domain.ProjectConfig{Extends: "paranoid"}.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown preset "paranoid"`)
+	assert.NoError(t, domain.ProjectConfig{Extends: "cli-app"}.Validate())
+}
+
+func TestApplyPreset_OverlaysDeltas(t *testing.T) {
+	maxParams := 6
+	cfg := domain.ApplyPreset(domain.ProjectConfig{
+		Extends:     domain.PresetStrict,
+		Weights:     map[string]float64{"structure": 0.2},
+		Calibration: domain.CalibrationDefault,
+		Profile:     &domain.ProfileOverrides{MaxParameters: &maxParams},
+	})
+
+	assert.Equal(t, domain.PresetStrict, cfg.Extends)
+	assert.InDelta(t, 0.2, cfg.Weights["structure"], 0.001, "user weight wins")
+	assert.InDelta(t, 0.05, cfg.Weights["security_hints"], 0.001, "preset weight kept")
+	assert.Equal(t, domain.CalibrationDefault, cfg.Calibration)
+	assert.Equal(t, domain.PenaltyModelStrict, cfg.PenaltyModel)
+	require.NotNil(t, cfg.Profile)
+	assert.Equal(t, 6, *cfg.Profile.MaxParameters)
+	assert.Equal(t, 4, *cfg.Profile.MaxInterfaceMethods)
+}
+
+func TestApplyPreset_ProjectTypeAndSkips(t *testing.T) {
+	cfg := domain.ApplyPreset(domain.ProjectConfig{Extends: domain.PresetLibrary})
+	assert.Equal(t, domain.ProjectTypeLibrary, cfg.ProjectType)
+	assert.Contains(t, cfg.Skip.SubMetrics, "module_completeness")
+
+	cfg = domain.ApplyPreset(domain.ProjectConfig{
+		Extends:     domain.PresetLibrary,
+		ProjectType: domain.ProjectTypeAPI,
+		Skip:        domain.SkipConfig{SubMetrics: []string{"test_naming"}},
+	})
+	assert.Equal(t, domain.ProjectTypeAPI, cfg.ProjectType)
+	assert.Equal(t, []string{"test_naming"}, cfg.Skip.SubMetrics)
+}
+
+func TestApplyPreset_NoExtendsUnchanged(t *testing.T) {
+	cfg := domain.ProjectConfig{Weights: map[string]float64{"structure": 0.2}}
+	assert.Equal(t, cfg, domain.ApplyPreset(cfg))
+}
+
+func TestProfileSettings_CoversEveryOverride(t *testing.T) {
+	p := domain.DefaultProfile()
+	p.Penalty = domain.PenaltyModelPreset(domain.PenaltyModelStrict)
+
+	settings := domain.ProfileSettings(p)
+
+	byKey := make(map[string]any, len(settings))
+	for _, s := range settings {
+		byKey[s.Key] = s.Value
+	}
+	assert.Len(t, byKey, len(settings))
+	assert.Equal(t, 50, byKey["max_function_lines"])
+	assert.Equal(t, 160.0, byKey["penalty_scale"])
+	assert.Equal(t, []string{"2", "10", "100"}, byKey["magic_number_allowlist"])
+	assert.Equal(t, "expected_layers", settings[0].Key)
+	assert.Equal(t, "safe_sql_builders", settings[len(settings)-1].Key)
+}
+
+func TestValidate_RemoteExtends(t *testing.T) {
+	pin := "sha256:" + strings.Repeat("ab", 32)
+	for _, tc := range []struct {
+		extends, checksum, err string
+	}{
+		{extends: "https://example.com/org.yaml"},
+		{extends: "https://example.com/org.yaml", checksum: pin},
+		{extends: "git+https://github.com/acme/policy.git//openkraft.yaml?ref=v1"},
+		{extends: "http://example.com/org.yaml", checksum: pin},
+		{extends: "http://example.com/org.yaml", err: "must be pinned"},
+		{extends: "git+http://example.com/policy.git//openkraft.yaml", err: "must be pinned"},
+		{extends: "git+http://example.com/policy.git//openkraft.yaml", checksum: pin},
+		{extends: "git+git://example.com/policy.git//openkraft.yaml", err: "must be pinned"},
+		{extends: "git+git://example.com/policy.git//openkraft.yaml", checksum: pin},
+		{extends: "https://example.com/org.yaml", checksum: "md5:abc", err: "64 hex digits"},
+		{extends: "strict", checksum: pin, err: "pins a remote config"},
+	} {
+		err := domain.ProjectConfig{Extends: tc.extends, ExtendsChecksum: tc.checksum}.Validate()
+		if tc.err == "" {
+			assert.NoError(t, err, tc.extends)
+			continue
+		}
+		require.Error(t, err, tc.extends)
+		assert.Contains(t, err.Error(), tc.err)
+	}
+}
+
+func TestOverlayConfig_LocalPrecedence(t *testing.T) {
+	minOverall := 80
+	base := domain.ProjectConfig{
+		ExcludePaths: []string{"vendor"},
+		Gates:        &domain.GatesConfig{MinOverall: &minOverall, MaxIssues: map[string]int{"error": 0}},
+		Severity:     []domain.SeverityRule{{Pattern: "fmt-print", Severity: "error"}},
+		Grades:       []domain.GradeBand{{Grade: "pass", Min: 60}, {Grade: "fail", Min: 0}},
+		Headers:      &domain.HeaderConfig{},
+	}
+	cfg := domain.OverlayConfig(base, domain.ProjectConfig{
+		ExcludePaths: []string{"testdata"},
+		Gates:        &domain.GatesConfig{MaxIssues: map[string]int{"warning": 10}},
+		Severity:     []domain.SeverityRule{{Pattern: "fmt-print", Severity: "info"}},
+	})
+
+	assert.Equal(t, []string{"vendor", "testdata"}, cfg.ExcludePaths)
+	assert.Equal(t, 80, *cfg.Gates.MinOverall)
+	assert.Equal(t, map[string]int{"error": 0, "warning": 10}, cfg.Gates.MaxIssues)
+	assert.Equal(t, "info", cfg.Severity[0].Severity)
+	assert.Len(t, cfg.Grades, 2)
+	assert.NotNil(t, cfg.Headers)
+}
@@ -8,18 +8,33 @@ type ScoringProfile struct {
 	ExpectedDirs         []string
 	LayerAliases         map[string]string
 	ExpectedFileSuffixes []string
+	LearnFileSuffixes    bool   // also accept suffixes the project's file names use often (see scoring.LearnFileSuffixes)
 	NamingConvention     string // "auto", "bare", "suffixed"
+	InterfaceNaming      bool   // check the -er rule and flag IFoo/FooInterface names
+	DebtNotesSubMetric   bool   // add the informational technical_debt_notes sub-metric to code_health
 
 	// Code Health
 	MaxFunctionLines       int
 	MaxFileLines           int
+	// MaxForeignFileLines holds the file_size limit of non-Go files by
+	// language; languages without one are left out of file_size.
+	MaxForeignFileLines    map[string]int
 	MaxNestingDepth        int
 	MaxParameters          int
 	MaxConditionalOps      int
 	MaxCognitiveComplexity int
 	MaxDuplicationPercent  int
 	MinCloneTokens         int
+	// Duplication normalization: by default identifiers and literals are
+	// placeholders and every token counts.
+	DupKeepIdentifiers     bool // compare identifier names instead of treating them all alike
+	DupKeepLiterals        bool // compare literal values instead of treating them all alike
+	DupIgnoreImports       bool // skip the package clause and imports
+	DupIgnoreTestTables    bool // skip the case lists of table-driven tests
+	MinStringLiteralRepeats int // occurrences before a long string literal is reported as duplicated (default 3)
 	ExemptParamPatterns    []string
+	MaxFunctionFanOut      int // distinct callees before function_coupling credit decays (default 20)
+	HubFanIn               int // callers that make a wide fan-out function a hub (default 10)
 
 	// Template function detection: functions whose body is dominated by
 	// string literals (e.g., shell completion scripts) receive relaxed
@@ -31,11 +46,15 @@ type ScoringProfile struct {
 	// since wrapper functions must match C API signatures.
 	CGoParamThreshold int // max params for CGo wrapper functions (default 12)
 
+	// Structure
+	MaxInterfaceMethods int // methods before an interface is flagged as fat by interface_granularity (default 5)
+
 	// Context Quality
 	ContextFiles []ContextFileSpec
 
 	// Verifiability
-	MinTestRatio float64
+	MinTestRatio          float64
+	MaxDirectDependencies int // direct go.mod requirements before dependency_hygiene flags the count (default 40)
 
 	// Discoverability
 	MinNamingWordScore         float64    // WCS threshold for "descriptive" (default: 0.7)
@@ -43,6 +62,22 @@ type ScoringProfile struct {
 	NamingCompositeWeights     [3]float64 // WCS, specificity, entropy weights (default: {0.30, 0.30, 0.25})
 	CollisionWeight            float64    // weight for collision rate signal (default: 0.15)
 	StructureCompositeWeights  [3]float64 // layers, suffix, filecount weights (default: {0.5, 0.3, 0.2})
+	MinPackageCohesion         float64    // package_cohesion below which a package is flagged (default: 0.5)
+	MaxPackageDepth            int        // directories below the root before a package is flagged (default: 7)
+	MaxEmbeddingDepth          int        // levels of type embedding before a type is flagged (default: 3)
+	TinyPackageLines           int        // lines at or below which a single-file package counts as tiny (default: 30)
+	MaxTinyPackageRatio        float64    // share of tiny packages above which the tree is flagged as sprawl (default: 0.5)
+
+	// Naming vocabulary. Config can add or remove entries, e.g. to teach
+	// team or locale terms, so domain words are not scored as generic.
+	VaguePackageNames     []string // package names flagged as vague (util, common, ...)
+	StutterExemptPackages []string // package name globs exempt from stutter checks (default: *pb)
+	GenericWords          []string // identifier words that carry no meaning (Get, Data, Manager, ...)
+	ActionWords           []string // verbs with clear but general semantics (Parse, Validate, ...)
+	DomainWords           []string // words always treated as domain vocabulary, overriding the lists above
+	Acronyms              []string // kept as single words when splitting identifiers (HTTP, ID, OAuth, IPv4, ...)
+	MagicNumberAllowlist  []string // number literals never reported as magic, besides 0, 1 and -1 (default: 2, 10, 100)
+	SafeSQLBuilders       []string // functions and packages whose results may be spliced into SQL (default: strconv, pq.QuoteIdentifier, ...)
 
 	// Import graph
 	CyclePenaltyWeight        float64 // weight of cycle penalty within graph score (default: 0.40)
@@ -52,6 +87,32 @@ type ScoringProfile struct {
 
 	// Predictability
 	MaxGlobalVarPenalty int
+	ScanTestSecrets     bool   // also scan test files and testdata for hard-coded secrets
+	PreferredLogger     string // logging library every file should use: log, slog, logrus, zap or zerolog; "" for the one most files import
+
+	// Small-sample smoothing: when enabled, ratio-based sub-metrics count
+	// SmoothingWeight extra units scoring SmoothingPrior, and severity
+	// penalties are normalized by at least SmoothingWeight functions, so a
+	// single finding cannot swing a tiny project's score.
+	SmoothSmallSamples bool
+	SmoothingWeight    int     // pseudo-units (default 10)
+	SmoothingPrior     float64 // ratio assumed for the pseudo-units (default 0.8)
+
+	// Penalty holds the decay and severity-penalty constants, from the
+	// config's penalty_model preset and profile overrides.
+	Penalty PenaltyModel
+
+	// SeverityRules remap issue severities before penalties are computed,
+	// from the config's severity section.
+	SeverityRules []SeverityRule
+
+	// NamingRules are the config's custom naming conventions, checked as
+	// part of file_naming_conventions.
+	NamingRules []NamingRule
+
+	// Headers are the config's required file headers, checked as part of
+	// consistent_patterns.
+	Headers *HeaderConfig
 }
 
 // ContextFileSpec describes an AI context file to check during scoring.
@@ -78,18 +139,26 @@ func DefaultProfile() ScoringProfile {
 			"_ports", "_errors", "_routes", "_rule",
 		},
 		NamingConvention:           "auto",
+		InterfaceNaming:            true,
 		MaxFunctionLines:           50,
 		MaxFileLines:               300,
+		// Schemas and migrations are flat declarations, so they run longer
+		// than Go files before they are hard to navigate.
+		MaxForeignFileLines:        map[string]int{"protobuf": 1000, "sql": 1000, "typescript": 400},
 		MaxNestingDepth:            3,
 		MaxParameters:              4,
 		MaxConditionalOps:          2,
 		MaxCognitiveComplexity:     25,
 		MaxDuplicationPercent:      15,
 		MinCloneTokens:             75,
+		MinStringLiteralRepeats:    3,
 		ExemptParamPatterns:        []string{"Reconstruct"},
 		StringLiteralThreshold:     0.8,
 		TemplateFuncSizeMultiplier: 5,
 		CGoParamThreshold:          12,
+		MaxFunctionFanOut:          20,
+		HubFanIn:                   10,
+		MaxInterfaceMethods:        5,
 		ContextFiles: []ContextFileSpec{
 			{Name: "CLAUDE.md", Points: 10, MinSize: 500},
 			{Name: "AGENTS.md", Points: 8},
@@ -97,15 +166,49 @@ func DefaultProfile() ScoringProfile {
 			{Name: ".github/copilot-instructions.md", Points: 5},
 		},
 		MinTestRatio:               0.5,
+		MaxDirectDependencies:      40,
 		MinNamingWordScore:         0.7,
 		NamingConsistencyThreshold: 0.60,
 		NamingCompositeWeights:     [3]float64{0.30, 0.30, 0.25},
 		CollisionWeight:            0.15,
 		StructureCompositeWeights:  [3]float64{0.5, 0.3, 0.2},
+		MinPackageCohesion:         0.5,
+		MaxPackageDepth:            7,
+		MaxEmbeddingDepth:          3,
+		TinyPackageLines:           30,
+		MaxTinyPackageRatio:        0.5,
+		VaguePackageNames: []string{
+			"util", "utils", "common", "helpers", "misc",
+			"base", "lib", "shared", "tools", "types",
+		},
+		StutterExemptPackages: []string{"*pb"},
+		GenericWords: []string{
+			"Get", "Set", "Do", "Run", "Handle", "Process", "Execute", "Make",
+			"Data", "Info", "Item", "Object", "Thing", "Stuff", "Temp",
+			"Manager", "Handler", "Helper", "Util",
+		},
+		ActionWords: []string{
+			"Validate", "Parse", "Format", "Convert", "Transform",
+			"Compute", "Calculate", "Build", "Render",
+		},
+		Acronyms: []string{
+			"ACL", "API", "ASCII", "AST", "AWS", "CLI", "CPU", "CSS", "CSV",
+			"DB", "DNS", "EOF", "FS", "GCP", "GRPC", "GUID", "HTML", "HTTP",
+			"HTTPS", "ID", "IO", "IP", "JSON", "JWT", "LHS", "MCP", "OS", "PDF",
+			"QPS", "RAM", "RHS", "RPC", "SDK", "SHA", "SLA", "SMTP", "SQL",
+			"SSH", "TCP", "TLS", "TOML", "TTL", "UDP", "UI", "UID", "URI",
+			"URL", "UTF", "UUID", "VM", "XML", "XMPP", "XSRF", "XSS", "YAML",
+			"OAuth", "IPv4", "IPv6", "gRPC",
+		},
+		MagicNumberAllowlist:      []string{"2", "10", "100"},
+		SafeSQLBuilders:           []string{"strconv", "pq.QuoteIdentifier", "pq.QuoteLiteral", "pgx.Identifier"},
 		CyclePenaltyWeight:        0.40,
 		MaxDistanceFromMain:       0.40,
 		CouplingOutlierMultiplier: 2.0,
 		MaxGlobalVarPenalty:       3,
+		SmoothingWeight:           10,
+		SmoothingPrior:            0.8,
+		Penalty:                   PenaltyModelPreset(PenaltyModelSonar),
 	}
 }
 
@@ -132,6 +235,7 @@ func DefaultProfileForType(pt ProjectType) ScoringProfile {
 		p.MaxParameters = 3
 		p.MaxCognitiveComplexity = 20
 		p.MinTestRatio = 0.8
+		p.MaxDirectDependencies = 15
 		p.ContextFiles = []ContextFileSpec{
 			{Name: "CLAUDE.md", Points: 12, MinSize: 500},
 			{Name: "AGENTS.md", Points: 8},
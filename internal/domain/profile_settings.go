@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"reflect"
+	"strings"
+)
+
+// ProfileSetting is one configurable parameter of a resolved profile, keyed
+// by its name under profile: in .openkraft.yaml.
+type ProfileSetting struct {
+	Key   string
+	Value any
+}
+
+// ProfileSettings lists the parameters of p that the profile section of
+// .openkraft.yaml can override, in the order ProfileOverrides declares them.
+// Word lists hold their edited contents and the penalty constants come from
+// p.Penalty, so the result shows what scorers actually use.
+func ProfileSettings(p ScoringProfile) []ProfileSetting {
+	prof := reflect.ValueOf(p)
+	penalty := reflect.ValueOf(p.Penalty)
+	overrides := reflect.TypeFor[ProfileOverrides]()
+	settings := make([]ProfileSetting, 0, overrides.NumField())
+	for i := range overrides.NumField() {
+		f := overrides.Field(i)
+		v := prof.FieldByName(f.Name)
+		if !v.IsValid() {
+			v = penalty.FieldByName(strings.TrimPrefix(f.Name, "Penalty"))
+		}
+		if !v.IsValid() {
+			continue
+		}
+		key, _, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+		settings = append(settings, ProfileSetting{Key: key, Value: v.Interface()})
+	}
+	return settings
+}
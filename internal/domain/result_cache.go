@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// ResultCacheKey names a stored analysis result: the analyzed commit and a
+// digest of the profile hash (see ProfileHash) and the run settings that
+// shape the score, such as the tool version or type-checking. Stages of one
+// CI pipeline that score the same commit the same way share a key.
+func ResultCacheKey(commit, profileHash string, settings ...string) string {
+	sum := sha256.Sum256([]byte(profileHash + "\n" + strings.Join(settings, "\n")))
+	return commit + "-" + hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultCacheKey(t *testing.T) {
+	key := ResultCacheKey("abc123", "sha256:01", "typed=false")
+
+	assert.True(t, strings.HasPrefix(key, "abc123-"))
+	assert.Equal(t, key, ResultCacheKey("abc123", "sha256:01", "typed=false"))
+	assert.NotEqual(t, key, ResultCacheKey("abc124", "sha256:01", "typed=false"))
+	assert.NotEqual(t, key, ResultCacheKey("abc123", "sha256:02", "typed=false"))
+	assert.NotEqual(t, key, ResultCacheKey("abc123", "sha256:01", "typed=true"))
+}
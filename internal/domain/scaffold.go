@@ -0,0 +1,34 @@
+package domain
+
+// ScaffoldConventions are the conventions a generated module follows, as
+// detected in the project it is added to.
+type ScaffoldConventions struct {
+	ModulePath string // go.mod module path
+	Layout     ArchLayout
+	Naming     string // "bare" or "suffixed" file names
+	// Layers maps the canonical layers (domain, application, adapters) to
+	// the directory names the project uses for them, e.g. app for
+	// application.
+	Layers map[string]string
+	// SplitAdapters is set when adapters live under inbound/ and outbound/.
+	SplitAdapters bool
+	// Blueprint is the golden module's; its file paths take precedence
+	// over the naming convention. Nil when there is none.
+	Blueprint *Blueprint
+}
+
+// ModuleScaffold is a generated module skeleton.
+type ModuleScaffold struct {
+	Module string         `json:"module"`
+	Layout ArchLayout     `json:"layout"`
+	Naming string         `json:"naming"`
+	Golden string         `json:"golden,omitempty"` // path of the module whose file names it follows
+	Files  []ScaffoldFile `json:"files"`
+}
+
+// ScaffoldFile is one generated file, by project-relative path.
+type ScaffoldFile struct {
+	Path    string `json:"path"`
+	Role    string `json:"role"` // blueprint file type, e.g. service
+	Content string `json:"content"`
+}
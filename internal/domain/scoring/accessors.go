@@ -0,0 +1,113 @@
+package scoring
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// failingCalls are calls that make a function fallible: constructing an
+// error or aborting the program.
+var failingCalls = map[domain.Call]bool{
+	{Name: "panic"}:                   true,
+	{Name: "New", Package: "errors"}:  true,
+	{Name: "Errorf", Package: "fmt"}:  true,
+	{Name: "Exit", Package: "os"}:     true,
+	{Name: "Fatal", Package: "log"}:   true,
+	{Name: "Fatalf", Package: "log"}:  true,
+	{Name: "Fatalln", Package: "log"}: true,
+	{Name: "Panic", Package: "log"}:   true,
+	{Name: "Panicf", Package: "log"}:  true,
+	{Name: "Panicln", Package: "log"}: true,
+}
+
+// checkAccessors flags exported getters and setters in non-test files that
+// read unidiomatically: getters named GetX instead of X, getters named
+// after a different field than the one they return, and setters that can
+// fail but return no error.
+func checkAccessors(analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		for _, fn := range af.Functions {
+			if fn.Receiver == "" || !fn.Exported {
+				continue
+			}
+			var iss domain.Issue
+			var ok bool
+			switch {
+			case isGetter(fn):
+				iss, ok = getterIssue(fn)
+			case isSetter(fn):
+				iss, ok = setterIssue(fn)
+			default:
+				continue
+			}
+			check.checked++
+			if ok {
+				iss.Category = "predictability"
+				iss.SubMetric = "consistent_patterns"
+				iss.File = af.Path
+				iss.Line = fn.LineStart
+				check.issues = append(check.issues, iss)
+			}
+		}
+	}
+	return check
+}
+
+// isGetter reports whether fn takes no arguments and returns one non-error
+// value.
+func isGetter(fn domain.Function) bool {
+	return len(fn.Params) == 0 && len(fn.Returns) == 1 && fn.Returns[0] != "error"
+}
+
+// isSetter reports whether fn is named SetX and takes arguments.
+func isSetter(fn domain.Function) bool {
+	return hasWordPrefix(fn.Name, "Set") && len(fn.Params) > 0
+}
+
+func getterIssue(fn domain.Function) (domain.Issue, bool) {
+	recv := strings.TrimPrefix(fn.Receiver, "*")
+	if hasWordPrefix(fn.Name, "Get") {
+		return domain.Issue{Severity: domain.SeverityInfo, Pattern: "getter-prefix"}.
+			WithMessage("consistent_patterns.getter_prefix", recv, fn.Name, fn.Name[len("Get"):]), true
+	}
+	field := fn.ReturnsField
+	if field == "" || unicode.IsUpper(rune(field[0])) || strings.EqualFold(field, fn.Name) {
+		return domain.Issue{}, false
+	}
+	return domain.Issue{Severity: domain.SeverityInfo, Pattern: "getter-field"}.
+		WithMessage("consistent_patterns.getter_field", recv, fn.Name, field), true
+}
+
+func setterIssue(fn domain.Function) (domain.Issue, bool) {
+	for _, r := range fn.Returns {
+		if r == "error" {
+			return domain.Issue{}, false
+		}
+	}
+	for _, c := range fn.Calls {
+		key := domain.Call{Name: c.Name, Package: c.Package}
+		if !failingCalls[key] {
+			continue
+		}
+		call := c.Name
+		if c.Package != "" {
+			call = c.Package + "." + c.Name
+		}
+		return domain.Issue{Severity: domain.SeverityWarning, Pattern: "setter-error"}.
+			WithMessage("consistent_patterns.setter_error", strings.TrimPrefix(fn.Receiver, "*"), fn.Name, call), true
+	}
+	return domain.Issue{}, false
+}
+
+// hasWordPrefix reports whether name starts with the CamelCase word prefix
+// followed by another word: GetName but not Get or Getter.
+func hasWordPrefix(name, prefix string) bool {
+	rest, ok := strings.CutPrefix(name, prefix)
+	return ok && rest != "" && unicode.IsUpper(rune(rest[0]))
+}
@@ -0,0 +1,89 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAccessors(t *testing.T) {
+	method := func(name string, params int, returns []string, field string, calls ...domain.Call) domain.Function {
+		fn := domain.Function{Name: name, Receiver: "*User", Exported: true, LineStart: 10, Returns: returns, ReturnsField: field, Calls: calls}
+		for range params {
+			fn.Params = append(fn.Params, domain.Param{Name: "v", Type: "string"})
+		}
+		return fn
+	}
+
+	tests := []struct {
+		name    string
+		fn      domain.Function
+		checked bool
+		pattern string // "" means no issue
+		message string
+	}{
+		{"bare getter", method("Name", 0, []string{"string"}, "name"), true, "", ""},
+		{"initialism getter", method("ID", 0, []string{"string"}, "id"), true, "", ""},
+		{
+			"Get prefix", method("GetName", 0, []string{"string"}, "name"), true,
+			"getter-prefix", "getter User.GetName should drop the Get prefix (Name)",
+		},
+		{
+			"field mismatch", method("Name", 0, []string{"string"}, "title"), true,
+			"getter-field", "getter User.Name returns field title; name the getter after the field it returns",
+		},
+		{"Getter is a noun", method("Getter", 0, []string{"string"}, ""), true, "", ""},
+		{"lookup with key", method("GetByID", 1, []string{"*User", "error"}, ""), false, "", ""},
+		{"infallible setter", method("SetName", 1, nil, ""), true, "", ""},
+		{"setter returning error", method("SetAge", 1, []string{"error"}, "", domain.Call{Name: "Errorf", Package: "fmt"}), true, "", ""},
+		{
+			"panicking setter", method("SetAge", 1, nil, "", domain.Call{Name: "panic"}), true,
+			"setter-error", "setter User.SetAge can fail (calls panic) but returns no error",
+		},
+		{
+			"setter building an error", method("SetEmail", 1, nil, "", domain.Call{Name: "New", Package: "errors"}), true,
+			"setter-error", "setter User.SetEmail can fail (calls errors.New) but returns no error",
+		},
+		{"Setup is not a setter", method("Setup", 1, nil, "", domain.Call{Name: "panic"}), false, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := checkAccessors(map[string]*domain.AnalyzedFile{
+				"user.go": {Path: "user.go", Functions: []domain.Function{tt.fn}},
+			})
+			assert.Equal(t, tt.checked, check.checked == 1)
+			if tt.pattern == "" {
+				assert.Empty(t, check.issues)
+				return
+			}
+			require.Len(t, check.issues, 1)
+			iss := check.issues[0]
+			assert.Equal(t, tt.pattern, iss.Pattern)
+			assert.Equal(t, tt.message, iss.Message)
+			assert.Equal(t, "consistent_patterns", iss.SubMetric)
+			assert.Equal(t, "user.go", iss.File)
+			assert.Equal(t, 10, iss.Line)
+		})
+	}
+}
+
+func TestCheckAccessors_SkipsTestFiles(t *testing.T) {
+	getter := domain.Function{Name: "GetName", Receiver: "*User", Exported: true, Returns: []string{"string"}}
+	check := checkAccessors(map[string]*domain.AnalyzedFile{
+		"user_test.go": {Path: "user_test.go", Functions: []domain.Function{getter}},
+	})
+	assert.Zero(t, check.checked)
+}
+
+func TestComplianceCheck_Blend(t *testing.T) {
+	sm := domain.SubMetric{Name: "consistent_patterns", Points: 25, Score: 25, Detail: "2/2 role groups have consistent patterns"}
+	complianceCheck{checked: 4, issues: make([]domain.Issue, 2)}.blend(&sm, "APIs and import blocks follow Go conventions")
+	assert.Equal(t, 18, sm.Score)
+	assert.Equal(t, "2/2 role groups have consistent patterns; 2/4 APIs and import blocks follow Go conventions", sm.Detail)
+
+	untouched := domain.SubMetric{Points: 25, Score: 20}
+	complianceCheck{}.blend(&untouched, "APIs and import blocks follow Go conventions")
+	assert.Equal(t, 20, untouched.Score)
+}
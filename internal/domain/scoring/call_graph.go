@@ -0,0 +1,137 @@
+package scoring
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// CallGraph represents the call relationships between the functions and
+// methods declared in a module.
+type CallGraph struct {
+	Functions map[string]*FunctionNode
+	// Unresolved counts calls on values other than the caller's receiver
+	// (s.repo.Save()), whose target type is unknown without type checking.
+	Unresolved int
+}
+
+// FunctionNode is a single function or method in the call graph, keyed by
+// its package import path and name, e.g. "example.com/app/store.Store.Get".
+type FunctionNode struct {
+	ID       string
+	Package  string
+	Name     string
+	Receiver string // type name without "*"; empty for functions
+	File     string
+	Line     int
+	Exported bool
+	Calls    []string // outgoing edges (fan-out)
+	CalledBy []string // incoming edges (fan-in)
+}
+
+// FunctionID joins a package import path, receiver type and name into a
+// call graph node ID.
+func FunctionID(pkgPath, receiver, name string) string {
+	if receiver == "" {
+		return pkgPath + "." + name
+	}
+	return pkgPath + "." + receiver + "." + name
+}
+
+// BuildCallGraph links every call recorded by the parser to the module
+// function it targets. Calls to other modules, builtins and conversions
+// drop out because no module function matches them. Test files and
+// generated files are excluded, as in BuildImportGraph.
+func BuildCallGraph(modulePath string, analyzed map[string]*domain.AnalyzedFile) *CallGraph {
+	if modulePath == "" {
+		return nil
+	}
+
+	g := &CallGraph{Functions: make(map[string]*FunctionNode)}
+	type caller struct {
+		node  *FunctionNode
+		calls []domain.Call
+	}
+	var callers []caller
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || strings.HasSuffix(af.Path, "_test.go") {
+			continue
+		}
+		pkgPath := packageImportPath(modulePath, af.Path)
+		for _, fn := range af.Functions {
+			recv := strings.TrimPrefix(fn.Receiver, "*")
+			id := FunctionID(pkgPath, recv, fn.Name)
+			node, ok := g.Functions[id]
+			if !ok { // build-tag variants share one node
+				node = &FunctionNode{
+					ID:       id,
+					Package:  pkgPath,
+					Name:     fn.Name,
+					Receiver: recv,
+					File:     af.Path,
+					Line:     fn.LineStart,
+					Exported: fn.Exported,
+				}
+				g.Functions[id] = node
+			}
+			callers = append(callers, caller{node: node, calls: fn.Calls})
+		}
+	}
+
+	for _, c := range callers {
+		for _, call := range c.calls {
+			if call.Method {
+				g.Unresolved++
+				continue
+			}
+			pkg := c.node.Package
+			if call.Package != "" {
+				pkg = call.Package
+			}
+			target := FunctionID(pkg, call.Receiver, call.Name)
+			callee, ok := g.Functions[target]
+			if !ok || containsString(c.node.Calls, target) {
+				continue
+			}
+			c.node.Calls = append(c.node.Calls, target)
+			callee.CalledBy = append(callee.CalledBy, c.node.ID)
+		}
+	}
+
+	for _, node := range g.Functions {
+		sort.Strings(node.Calls)
+		sort.Strings(node.CalledBy)
+	}
+	return g
+}
+
+// EdgeCount returns the number of distinct caller → callee edges.
+func (g *CallGraph) EdgeCount() int {
+	n := 0
+	for _, node := range g.Functions {
+		n += len(node.Calls)
+	}
+	return n
+}
+
+// SortedFunctions returns the graph's nodes ordered by ID.
+func (g *CallGraph) SortedFunctions() []*FunctionNode {
+	nodes := make([]*FunctionNode, 0, len(g.Functions))
+	for _, node := range g.Functions {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+	return nodes
+}
+
+// packageImportPath returns the import path of the package containing the
+// module-relative file path.
+func packageImportPath(modulePath, file string) string {
+	dir := filepath.Dir(file)
+	if dir == "." {
+		return modulePath
+	}
+	return modulePath + "/" + filepath.ToSlash(dir)
+}
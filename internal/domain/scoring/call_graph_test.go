@@ -0,0 +1,71 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCallGraph(t *testing.T) {
+	mod := "example.com/app"
+	analyzed := map[string]*domain.AnalyzedFile{
+		"main.go": {Path: "main.go", Functions: []domain.Function{
+			{Name: "main", Calls: []domain.Call{
+				{Name: "NewStore", Package: mod + "/store"},
+				{Name: "Println", Package: "fmt"},
+				{Name: "run"},
+				{Name: "run"},
+			}},
+			{Name: "run", Calls: []domain.Call{{Name: "Save", Method: true}, {Name: "len"}}},
+		}},
+		"store/store.go": {Path: "store/store.go", Functions: []domain.Function{
+			{Name: "NewStore", Exported: true},
+			{Name: "Save", Receiver: "*Store", Exported: true, Calls: []domain.Call{{Name: "flush", Receiver: "Store"}}},
+			{Name: "flush", Receiver: "*Store"},
+		}},
+		"store/store_test.go": {Path: "store/store_test.go", Functions: []domain.Function{
+			{Name: "TestSave", Calls: []domain.Call{{Name: "NewStore"}}},
+		}},
+	}
+
+	g := BuildCallGraph(mod, analyzed)
+	require.NotNil(t, g)
+	assert.Len(t, g.Functions, 5, "test functions are excluded")
+	assert.Equal(t, 3, g.EdgeCount())
+	assert.Equal(t, 1, g.Unresolved)
+
+	main := g.Functions[mod+".main"]
+	require.NotNil(t, main)
+	assert.Equal(t, []string{mod + ".run", mod + "/store.NewStore"}, main.Calls)
+
+	newStore := g.Functions[mod+"/store.NewStore"]
+	assert.Equal(t, []string{mod + ".main"}, newStore.CalledBy, "calls from tests do not count")
+
+	flush := g.Functions[mod+"/store.Store.flush"]
+	require.NotNil(t, flush)
+	assert.Equal(t, "Store", flush.Receiver)
+	assert.Equal(t, []string{mod + "/store.Store.Save"}, flush.CalledBy)
+}
+
+func TestBuildCallGraph_NoModule(t *testing.T) {
+	assert.Nil(t, BuildCallGraph("", map[string]*domain.AnalyzedFile{}))
+}
+
+func TestBuildCallGraph_TypedMethodCalls(t *testing.T) {
+	mod := "example.com/app"
+	analyzed := map[string]*domain.AnalyzedFile{
+		"api/api.go": {Path: "api/api.go", Typed: true, Functions: []domain.Function{
+			{Name: "Handle", Calls: []domain.Call{{Name: "Save", Package: mod + "/store", Receiver: "Store"}}},
+		}},
+		"store/store.go": {Path: "store/store.go", Typed: true, Functions: []domain.Function{
+			{Name: "Save", Receiver: "*Store", Exported: true},
+		}},
+	}
+
+	g := BuildCallGraph(mod, analyzed)
+	require.NotNil(t, g)
+	assert.Equal(t, []string{mod + "/store.Store.Save"}, g.Functions[mod+"/api.Handle"].Calls)
+	assert.Zero(t, g.Unresolved)
+}
@@ -0,0 +1,275 @@
+package scoring
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// cloneGapTokens is the longest run of differing tokens two clone halves
+// may be separated by and still be reported as one clone.
+const cloneGapTokens = 10
+
+// maxCloneCopies skips window hashes shared by more places than this:
+// they are boilerplate, and pairing every copy is quadratic.
+const maxCloneCopies = 32
+
+// CloneFragment is one copy of a clone, as a line range of a file.
+type CloneFragment struct {
+	File      string `json:"file"`
+	StartLine int    `json:"start_line"`
+	EndLine   int    `json:"end_line"`
+}
+
+// Clone is a cluster of code fragments with the same token structure: a
+// pair, or more copies when fragments of several pairs overlap. Tokens is
+// the length of the longest pair; Similarity the lowest share of matching
+// tokens of its pairs, below 1 when the copies differ in a few tokens.
+type Clone struct {
+	Fragments  []CloneFragment `json:"fragments"`
+	Tokens     int             `json:"tokens"`
+	Similarity float64         `json:"similarity"`
+}
+
+// CloneQuery selects the clones FindClones reports. MinTokens defaults to
+// the profile's clone window; Paths are globs as in severity rules, and a
+// clone is kept when any of its fragments matches one.
+type CloneQuery struct {
+	MinTokens int
+	Paths     []string
+}
+
+// cloneFile is a file taking part in clone detection, with the tokens
+// the profile compares and their lines.
+type cloneFile struct {
+	af            *domain.AnalyzedFile
+	tokens, lines []int
+}
+
+// clonePair is a matched token range of two files: a's tokens
+// [start, end) equal b's tokens shifted by offset, except for gaps.
+type clonePair struct {
+	a, b       int // file indexes, a < b
+	offset     int
+	start, end int
+	matched    int
+}
+
+// FindClones lists the cross-file clones of at least q.MinTokens
+// normalized tokens, longest first. Like code_duplication it compares the
+// tokens DuplicationTokens returns and ignores
+// generated files, clones within one file and clones between build
+// variants. Windows matching on the same alignment are joined into one
+// clone across gaps of up to cloneGapTokens differing tokens.
+func FindClones(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile, q CloneQuery) []Clone {
+	window := q.MinTokens
+	if window <= 0 {
+		window = cloneWindow(profile)
+	}
+
+	var files []cloneFile
+	for _, af := range sortedFiles(analyzed) {
+		tokens, lines := DuplicationTokens(profile, af)
+		if CloneEligible(af, tokens, window) && len(lines) == len(tokens) {
+			files = append(files, cloneFile{af, tokens, lines})
+		}
+	}
+
+	type loc struct{ file, pos int }
+	hashMap := make(map[uint64][]loc)
+	for fi, f := range files {
+		for pos, h := range WindowHashes(f.tokens, window) {
+			hashMap[h] = append(hashMap[h], loc{fi, pos})
+		}
+	}
+
+	// Matching windows are grouped by file pair and alignment, so runs of
+	// consecutive windows form the clones.
+	type diagonal struct{ a, b, offset int }
+	starts := make(map[diagonal][]int)
+	for _, locs := range hashMap {
+		if len(locs) < 2 || len(locs) > maxCloneCopies {
+			continue
+		}
+		for i, x := range locs {
+			for _, y := range locs[i+1:] {
+				if x.file == y.file || BuildVariants(files[x.file].af, files[y.file].af) {
+					continue
+				}
+				if x.file > y.file {
+					x, y = y, x
+				}
+				d := diagonal{x.file, y.file, y.pos - x.pos}
+				starts[d] = append(starts[d], x.pos)
+			}
+		}
+	}
+
+	var pairs []clonePair
+	for d, positions := range starts {
+		slices.Sort(positions)
+		var cur *clonePair
+		for _, pos := range slices.Compact(positions) {
+			end := pos + window
+			switch {
+			case cur != nil && pos < cur.end:
+				cur.matched += end - cur.end
+				cur.end = end
+			case cur != nil && pos-cur.end <= cloneGapTokens:
+				cur.matched += window
+				cur.end = end
+			default:
+				pairs = append(pairs, clonePair{a: d.a, b: d.b, offset: d.offset, start: pos, end: end, matched: window})
+				cur = &pairs[len(pairs)-1]
+			}
+		}
+	}
+	pairs = dropNestedPairs(pairs)
+
+	clones := clusterPairs(files, pairs)
+	if len(q.Paths) > 0 {
+		clones = slices.DeleteFunc(clones, func(c Clone) bool { return !cloneMatchesPaths(c, q.Paths) })
+	}
+	slices.SortFunc(clones, func(x, y Clone) int {
+		return cmp.Or(
+			cmp.Compare(y.Tokens, x.Tokens),
+			cmp.Compare(len(y.Fragments), len(x.Fragments)),
+			cmp.Compare(x.Fragments[0].File, y.Fragments[0].File),
+			cmp.Compare(x.Fragments[0].StartLine, y.Fragments[0].StartLine),
+		)
+	})
+	return clones
+}
+
+// dropNestedPairs removes pairs lying inside a longer pair of the same
+// files, which repetitive code produces on neighbouring alignments.
+func dropNestedPairs(pairs []clonePair) []clonePair {
+	slices.SortFunc(pairs, func(x, y clonePair) int {
+		return cmp.Or(cmp.Compare(y.end-y.start, x.end-x.start), cmp.Compare(x.a, y.a), cmp.Compare(x.b, y.b),
+			cmp.Compare(x.offset, y.offset), cmp.Compare(x.start, y.start))
+	})
+	var kept []clonePair
+	for _, p := range pairs {
+		nested := slices.ContainsFunc(kept, func(k clonePair) bool {
+			return k.a == p.a && k.b == p.b &&
+				p.start >= k.start && p.end <= k.end &&
+				p.start+p.offset >= k.start+k.offset && p.end+p.offset <= k.end+k.offset
+		})
+		if !nested {
+			kept = append(kept, p)
+		}
+	}
+	return kept
+}
+
+// clusterPairs joins pairs whose fragments mostly overlap into clones,
+// merging the overlapping fragments of each file.
+func clusterPairs(files []cloneFile, pairs []clonePair) []Clone {
+	// A fragment whose first line holds only its first token starts on the
+	// next line: that token ends the line before, like a semicolon or brace.
+	fragment := func(file, start, end int) CloneFragment {
+		lines := files[file].lines
+		first := lines[start]
+		if lines[start+1] > first {
+			first = lines[start+1]
+		}
+		return CloneFragment{File: files[file].af.Path, StartLine: first, EndLine: lines[end-1]}
+	}
+	frags := make([][2]CloneFragment, len(pairs))
+	for i, p := range pairs {
+		frags[i] = [2]CloneFragment{fragment(p.a, p.start, p.end), fragment(p.b, p.start+p.offset, p.end+p.offset)}
+	}
+
+	parent := make([]int, len(pairs))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i := range pairs {
+		for j := range i {
+			if fragmentsOverlap(frags[i], frags[j]) {
+				parent[find(i)] = find(j)
+			}
+		}
+	}
+
+	byRoot := make(map[int]*Clone)
+	var roots []int
+	for i, p := range pairs {
+		r := find(i)
+		c, ok := byRoot[r]
+		if !ok {
+			c = &Clone{Similarity: 1}
+			byRoot[r] = c
+			roots = append(roots, r)
+		}
+		c.Fragments = append(c.Fragments, frags[i][0], frags[i][1])
+		c.Tokens = max(c.Tokens, p.end-p.start)
+		sim := math.Round(float64(p.matched)/float64(p.end-p.start)*100) / 100
+		c.Similarity = min(c.Similarity, sim)
+	}
+
+	clones := make([]Clone, 0, len(roots))
+	for _, r := range roots {
+		c := byRoot[r]
+		c.Fragments = mergeFragments(c.Fragments)
+		clones = append(clones, *c)
+	}
+	return clones
+}
+
+// fragmentsOverlap reports whether a fragment of x and one of y are
+// mostly the same code: they share at least half of the shorter one's
+// lines. Slighter overlaps would chain unrelated clones together.
+func fragmentsOverlap(x, y [2]CloneFragment) bool {
+	for _, f := range x {
+		for _, g := range y {
+			if f.File != g.File {
+				continue
+			}
+			shared := min(f.EndLine, g.EndLine) - max(f.StartLine, g.StartLine) + 1
+			shorter := min(f.EndLine-f.StartLine, g.EndLine-g.StartLine) + 1
+			if shared*2 >= shorter {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mergeFragments sorts fragments by file and line and merges the ones
+// that overlap.
+func mergeFragments(frags []CloneFragment) []CloneFragment {
+	slices.SortFunc(frags, func(x, y CloneFragment) int {
+		return cmp.Or(cmp.Compare(x.File, y.File), cmp.Compare(x.StartLine, y.StartLine))
+	})
+	out := frags[:1]
+	for _, f := range frags[1:] {
+		last := &out[len(out)-1]
+		if f.File == last.File && f.StartLine <= last.EndLine {
+			last.EndLine = max(last.EndLine, f.EndLine)
+			continue
+		}
+		out = append(out, f)
+	}
+	return out
+}
+
+func cloneMatchesPaths(c Clone, globs []string) bool {
+	for _, f := range c.Fragments {
+		for _, g := range globs {
+			if domain.MatchPathGlob(g, f.File) {
+				return true
+			}
+		}
+	}
+	return false
+}
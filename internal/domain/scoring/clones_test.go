@@ -0,0 +1,162 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+// cloneFile builds a file whose tokens are prefix, then body, then
+// suffix, two tokens per line.
+func cloneFile(path string, prefix, body, suffix []int) *domain.AnalyzedFile {
+	tokens := append(append(append([]int{}, prefix...), body...), suffix...)
+	lines := make([]int, len(tokens))
+	for i := range lines {
+		lines[i] = i/2 + 1
+	}
+	af := makeFileWithTokens(path, len(tokens), tokens)
+	af.TokenLines = lines
+	return af
+}
+
+func seqTokens(from, n int) []int {
+	out := make([]int, n)
+	for i := range out {
+		out[i] = from + i
+	}
+	return out
+}
+
+func TestFindClones_Pair(t *testing.T) {
+	body := seqTokens(100, 80)
+	clones := scoring.FindClones(defaultProfile(), analyzed(
+		cloneFile("a.go", seqTokens(1, 6), body, seqTokens(500, 10)),
+		cloneFile("b.go", seqTokens(300, 20), body, nil),
+	), scoring.CloneQuery{})
+
+	require.Len(t, clones, 1)
+	assert.Equal(t, 80, clones[0].Tokens)
+	assert.Equal(t, 1.0, clones[0].Similarity)
+	assert.Equal(t, []scoring.CloneFragment{
+		{File: "a.go", StartLine: 4, EndLine: 43},
+		{File: "b.go", StartLine: 11, EndLine: 50},
+	}, clones[0].Fragments)
+}
+
+func TestFindClones_JoinsAcrossSmallGap(t *testing.T) {
+	left, right := seqTokens(100, 80), seqTokens(200, 80)
+	a := append(append(append([]int{}, left...), 900, 901, 902), right...)
+	b := append(append(append([]int{}, left...), 800, 801, 802), right...)
+	clones := scoring.FindClones(defaultProfile(), analyzed(
+		cloneFile("a.go", nil, a, nil),
+		cloneFile("b.go", nil, b, nil),
+	), scoring.CloneQuery{})
+
+	require.Len(t, clones, 1)
+	assert.Equal(t, 163, clones[0].Tokens)
+	assert.InDelta(t, 0.98, clones[0].Similarity, 0.001)
+}
+
+func TestFindClones_ClustersCopies(t *testing.T) {
+	body := seqTokens(100, 80)
+	clones := scoring.FindClones(defaultProfile(), analyzed(
+		cloneFile("a.go", nil, body, nil),
+		cloneFile("b.go", seqTokens(1, 3), body, nil),
+		cloneFile("c.go", seqTokens(300, 7), body, nil),
+	), scoring.CloneQuery{})
+
+	require.Len(t, clones, 1)
+	assert.Len(t, clones[0].Fragments, 3)
+}
+
+func TestFindClones_MinTokensAndPaths(t *testing.T) {
+	body := seqTokens(100, 40)
+	files := analyzed(
+		cloneFile("internal/a.go", nil, body, nil),
+		cloneFile("internal/b.go", nil, body, nil),
+		cloneFile("cmd/c.go", nil, seqTokens(300, 40), nil),
+		cloneFile("cmd/d.go", nil, seqTokens(300, 40), nil),
+	)
+
+	assert.Empty(t, scoring.FindClones(defaultProfile(), files, scoring.CloneQuery{}), "shorter than the default window")
+
+	clones := scoring.FindClones(defaultProfile(), files, scoring.CloneQuery{MinTokens: 30})
+	assert.Len(t, clones, 2)
+
+	clones = scoring.FindClones(defaultProfile(), files, scoring.CloneQuery{MinTokens: 30, Paths: []string{"cmd/**"}})
+	require.Len(t, clones, 1)
+	assert.Equal(t, "cmd/c.go", clones[0].Fragments[0].File)
+}
+
+func TestFindClones_IgnoresGeneratedAndSameFile(t *testing.T) {
+	body := seqTokens(100, 80)
+	gen := cloneFile("gen.go", nil, body, nil)
+	gen.IsGenerated = true
+	clones := scoring.FindClones(defaultProfile(), analyzed(
+		cloneFile("a.go", nil, append(append([]int{}, body...), body...), nil),
+		gen,
+	), scoring.CloneQuery{})
+
+	assert.Empty(t, clones)
+}
+
+func TestDuplicationTokens_DefaultsReturnParserTokens(t *testing.T) {
+	af := cloneFile("a.go", nil, []int{-1, 10, -2}, nil)
+	af.TokenValues = []int{7, 0, 9}
+
+	tokens, lines := scoring.DuplicationTokens(defaultProfile(), af)
+
+	assert.Equal(t, af.NormalizedTokens, tokens)
+	assert.Equal(t, af.TokenLines, lines)
+}
+
+func TestDuplicationTokens_KeepsValues(t *testing.T) {
+	af := cloneFile("a.go", nil, []int{-1, 10, -2}, nil)
+	af.TokenValues = []int{7, 0, 9}
+
+	p := defaultProfile()
+	p.DupKeepLiterals = true
+	tokens, _ := scoring.DuplicationTokens(p, af)
+	assert.Equal(t, []int{-1, 10, 1009}, tokens, "identifiers stay placeholders")
+
+	p.DupKeepIdentifiers = true
+	tokens, _ = scoring.DuplicationTokens(p, af)
+	assert.Equal(t, []int{1007, 10, 1009}, tokens)
+}
+
+func TestDuplicationTokens_SkipsImportsAndTestTables(t *testing.T) {
+	af := cloneFile("a_test.go", nil, seqTokens(1, 10), nil)
+	af.ImportTokens = 3
+	af.TestTableTokens = [][2]int{{5, 8}}
+
+	p := defaultProfile()
+	p.DupIgnoreImports = true
+	tokens, _ := scoring.DuplicationTokens(p, af)
+	assert.Equal(t, seqTokens(4, 7), tokens)
+
+	p.DupIgnoreTestTables = true
+	tokens, lines := scoring.DuplicationTokens(p, af)
+	assert.Equal(t, []int{4, 5, 9, 10}, tokens)
+	assert.Equal(t, []int{2, 3, 5, 5}, lines)
+}
+
+func TestFindClones_KeepLiteralsSeparatesValues(t *testing.T) {
+	body := seqTokens(100, 80)
+	a, b := cloneFile("a.go", nil, body, nil), cloneFile("b.go", nil, body, nil)
+	a.TokenValues, b.TokenValues = make([]int, 80), make([]int, 80)
+	for i := 0; i < 80; i += 10 {
+		a.NormalizedTokens[i], b.NormalizedTokens[i] = -2, -2
+		a.TokenValues[i], b.TokenValues[i] = i, i+1
+	}
+	files := analyzed(a, b)
+
+	assert.Len(t, scoring.FindClones(defaultProfile(), files, scoring.CloneQuery{}), 1)
+
+	p := defaultProfile()
+	p.DupKeepLiterals = true
+	assert.Empty(t, scoring.FindClones(p, files, scoring.CloneQuery{}), "string values differ")
+}
@@ -32,34 +32,60 @@ func ScoreCodeHealth(profile *domain.ScoringProfile, scan *domain.ScanResult, an
 		Weight: 0.25,
 	}
 
-	sm1 := scoreFunctionSize(profile, analyzed)
-	sm2 := scoreFileSize(profile, analyzed)
-	sm3 := scoreCognitiveComplexity(profile, analyzed)
-	sm4 := scoreParameterCount(profile, analyzed)
-	sm5, dupData := scoreCodeDuplication(profile, analyzed)
+	tallies := fileTallies(scan, analyzed, func(af *domain.AnalyzedFile) domain.FileTally {
+		return codeHealthTally(profile, af)
+	})
+	sm1 := scoreFunctionSize(profile, tallies)
+	sm2 := scoreFileSize(profile, tallies, foreignFiles(scan))
+	sm3 := scoreCognitiveComplexity(profile, tallies)
+	sm4 := scoreParameterCount(profile, tallies)
+	sm5, dupData := scoreCodeDuplication(profile, scan, analyzed)
 
 	cat.SubMetrics = []domain.SubMetric{sm1, sm2, sm3, sm4, sm5}
+	if profile.DebtNotesSubMetric {
+		cat.SubMetrics = append(cat.SubMetrics, scoreTechnicalDebtNotes(analyzed))
+	}
 
 	base := 0
 	for _, sm := range cat.SubMetrics {
 		base += sm.Score
 	}
 
-	cat.Issues = collectCodeHealthIssues(profile, analyzed, dupData)
+	cat.Issues = collectCodeHealthIssues(profile, analyzed, tallies, dupData)
+	cat.Issues = append(cat.Issues, foreignFileSizeIssues(profile, foreignFiles(scan))...)
+	cat.Issues = append(cat.Issues, stringDuplicationIssues(profile, analyzed)...)
+	if scan != nil && scan.CPUProfile != nil {
+		boostHotPaths(cat.Issues, scan.CPUProfile, analyzed)
+	}
+	domain.ApplySeverityRules(profile.SeverityRules, cat.Issues)
+
+	penalty := codeHealthPenalty(profile, cat.Issues, scan, analyzed).Penalty
+	cat.Score = max(0, base-penalty)
 
-	// Count non-generated functions for normalization.
+	return cat
+}
+
+// codeHealthPenalty computes the code_health severity penalty, weighting
+// issues by git churn when the scan carries churn data.
+func codeHealthPenalty(profile *domain.ScoringProfile, issues []domain.Issue, scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) domain.PenaltyBreakdown {
+	var churn map[string]int
+	if scan != nil {
+		churn = scan.FileChurn
+	}
+	return penaltyBreakdown(profile, issues, smoothCount(profile, countFunctions(analyzed)), churn)
+}
+
+// countFunctions counts non-generated functions, the normalizer for the
+// code_health severity penalty.
+func countFunctions(analyzed map[string]*domain.AnalyzedFile) int {
 	funcCount := 0
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if af.IsGenerated {
 			continue
 		}
 		funcCount += len(af.Functions)
 	}
-
-	penalty := severityPenalty(cat.Issues, funcCount)
-	cat.Score = max(0, base-penalty)
-
-	return cat
+	return funcCount
 }
 
 // isTemplateFunc reports whether a function is dominated by string literals,
@@ -104,67 +130,130 @@ func isSwitchDispatch(fn domain.Function) bool {
 	return fn.MaxCaseArms >= 10 && fn.AvgCaseLines <= 3.0
 }
 
-// scoreFunctionSize (20 pts): continuous decay from profile.MaxFunctionLines.
-func scoreFunctionSize(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
-	sm := domain.SubMetric{Name: "function_size", Points: 20}
+// functionSizeLimit returns the line threshold applied to fn: doubled in test
+// files and multiplied for template, data-heavy test and switch-dispatch functions.
+func functionSizeLimit(profile *domain.ScoringProfile, af *domain.AnalyzedFile, fn domain.Function) int {
 	maxLines := profile.MaxFunctionLines
+	isTest := isTestFile(af.Path)
+	effectiveMax := maxLines
+	if isTest {
+		effectiveMax = maxLines * 2
+	}
+	switch {
+	case isTemplateFunc(fn, profile):
+		return effectiveMax * templateMultiplier(profile)
+	case isDataHeavyTest(fn, isTest), isSwitchDispatch(fn):
+		return maxLines * templateMultiplier(profile)
+	}
+	return effectiveMax
+}
 
-	total, earned := 0, 0.0
-	for _, af := range analyzed {
-		if af.IsGenerated {
-			continue
+// fileSizeLimit returns the line threshold applied to a file (doubled for
+// tests). A cgo preamble is C code and does not count against the limit.
+func fileSizeLimit(profile *domain.ScoringProfile, af *domain.AnalyzedFile) int {
+	if isTestFile(af.Path) {
+		return profile.MaxFileLines*2 + af.CGoPreambleLines
+	}
+	return profile.MaxFileLines + af.CGoPreambleLines
+}
+
+// complexityLimit returns the cognitive complexity threshold applied to fn and
+// whether fn is exempt (switch-dispatch functions earn full credit).
+func complexityLimit(profile *domain.ScoringProfile, af *domain.AnalyzedFile, fn domain.Function) (int, bool) {
+	if isSwitchDispatch(fn) {
+		return 0, true
+	}
+	if isTestFile(af.Path) {
+		return profile.MaxCognitiveComplexity + 5, false
+	}
+	return profile.MaxCognitiveComplexity, false
+}
+
+// paramLimit returns the parameter threshold applied to fn and whether fn is
+// exempt through profile.ExemptParamPatterns or as an options API.
+func paramLimit(profile *domain.ScoringProfile, af *domain.AnalyzedFile, fn domain.Function) (int, bool) {
+	if isExemptFromParams(fn.Name, profile.ExemptParamPatterns) || isOptionsAPI(fn) {
+		return 0, true
+	}
+	limit := profile.MaxParameters
+	if isTestFile(af.Path) {
+		limit += 2
+	}
+	if af.HasCGoImport {
+		limit = max(limit, profile.CGoParamThreshold)
+	}
+	return limit, false
+}
+
+// codeHealthTally tallies the decay credit af earns in function_size,
+// file_size, cognitive_complexity and parameter_count, and its issues in
+// them. Generated files are not scored.
+func codeHealthTally(profile *domain.ScoringProfile, af *domain.AnalyzedFile) domain.FileTally {
+	var t domain.FileTally
+	if af.IsGenerated {
+		return t
+	}
+	for _, fn := range af.Functions {
+		if lines := fn.LineEnd - fn.LineStart + 1; lines > 0 {
+			t.FunctionSize.Total++
+			t.FunctionSize.Earned += decayCredit(profile, lines, functionSizeLimit(profile, af, fn))
 		}
-		effectiveMax := maxLines
-		if isTestFile(af.Path) {
-			effectiveMax = maxLines * 2
+
+		// Switch-dispatch and exempt functions earn full credit.
+		t.CognitiveComplexity.Total++
+		if limit, exempt := complexityLimit(profile, af, fn); exempt {
+			t.CognitiveComplexity.Earned += 1.0
+		} else {
+			t.CognitiveComplexity.Earned += decayCredit(profile, fn.CognitiveComplexity, limit)
 		}
-		isTest := isTestFile(af.Path)
-		for _, fn := range af.Functions {
-			lines := fn.LineEnd - fn.LineStart + 1
-			if lines <= 0 {
-				continue
-			}
-			total++
-			fnMax := effectiveMax
-			if isTemplateFunc(fn, profile) {
-				fnMax = effectiveMax * templateMultiplier(profile)
-			} else if isDataHeavyTest(fn, isTest) {
-				fnMax = maxLines * templateMultiplier(profile)
-			} else if isSwitchDispatch(fn) {
-				fnMax = maxLines * templateMultiplier(profile)
-			}
-			earned += decayCredit(lines, fnMax)
+		t.ParameterCount.Total++
+		if limit, exempt := paramLimit(profile, af, fn); exempt {
+			t.ParameterCount.Earned += 1.0
+		} else {
+			t.ParameterCount.Earned += decayCredit(profile, len(fn.Params), limit)
 		}
 	}
+	if af.TotalLines > 0 {
+		t.FileSize = domain.DecayCredit{Earned: decayCredit(profile, af.TotalLines, fileSizeLimit(profile, af)), Total: 1}
+	}
+	t.Issues = codeHealthFileIssues(profile, af)
+	return t
+}
+
+// scoreFunctionSize (20 pts): continuous decay from profile.MaxFunctionLines.
+func scoreFunctionSize(profile *domain.ScoringProfile, tallies map[string]domain.FileTally) domain.SubMetric {
+	sm := domain.SubMetric{Name: "function_size", Points: 20}
+	maxLines := profile.MaxFunctionLines
+
+	earned, total := sumCredit(tallies, func(t domain.FileTally) domain.DecayCredit { return t.FunctionSize })
 	if total == 0 {
 		sm.Score = sm.Points
 		sm.Detail = "no functions to evaluate"
 		return sm
 	}
 
-	ratio := earned / float64(total)
+	ratio := smoothRatio(profile, earned, total)
 	sm.Score = int(math.Round(ratio * float64(sm.Points)))
 	sm.Score = min(sm.Score, sm.Points)
-	sm.Detail = fmt.Sprintf("%.0f%% of %d functions within size limits (max %d lines)", ratio*100, total, maxLines)
+	sm.Detail = fmt.Sprintf("%.0f%% of %d functions within size limits (max %d lines)", earned/float64(total)*100, total, maxLines) +
+		smoothingNote(profile, earned, total)
 	return sm
 }
 
 // scoreFileSize (20 pts): continuous decay from profile.MaxFileLines.
-func scoreFileSize(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
+// Non-Go files measured by language analyzers count like Go files.
+func scoreFileSize(profile *domain.ScoringProfile, tallies map[string]domain.FileTally, foreign []domain.ForeignFile) domain.SubMetric {
 	sm := domain.SubMetric{Name: "file_size", Points: 20}
 	maxLines := profile.MaxFileLines
 
-	total, earned := 0, 0.0
-	for _, af := range analyzed {
-		if af.IsGenerated || af.TotalLines <= 0 {
+	earned, total := sumCredit(tallies, func(t domain.FileTally) domain.DecayCredit { return t.FileSize })
+	for _, ff := range foreign {
+		limit, ok := foreignFileLimit(profile, ff)
+		if !ok || ff.IsGenerated || ff.TotalLines <= 0 {
 			continue
 		}
-		effectiveMax := maxLines
-		if isTestFile(af.Path) {
-			effectiveMax = maxLines * 2
-		}
 		total++
-		earned += decayCredit(af.TotalLines, effectiveMax)
+		earned += decayCredit(profile, ff.TotalLines, limit)
 	}
 	if total == 0 {
 		sm.Score = sm.Points
@@ -172,87 +261,87 @@ func scoreFileSize(profile *domain.ScoringProfile, analyzed map[string]*domain.A
 		return sm
 	}
 
-	ratio := earned / float64(total)
+	ratio := smoothRatio(profile, earned, total)
 	sm.Score = int(math.Round(ratio * float64(sm.Points)))
 	sm.Score = min(sm.Score, sm.Points)
-	sm.Detail = fmt.Sprintf("%.0f%% of %d files within size limits (max %d lines)", ratio*100, total, maxLines)
+	sm.Detail = fmt.Sprintf("%.0f%% of %d files within size limits (max %d lines)", earned/float64(total)*100, total, maxLines) +
+		smoothingNote(profile, earned, total)
 	return sm
 }
 
+// foreignFiles returns the non-Go files measured during the scan, if any.
+func foreignFiles(scan *domain.ScanResult) []domain.ForeignFile {
+	if scan == nil {
+		return nil
+	}
+	return scan.ForeignFiles
+}
+
+// foreignFileLimit returns the line threshold of a non-Go file's language,
+// and false when the language has none. Go's limit does not apply: schema
+// and query files are longer by nature.
+func foreignFileLimit(profile *domain.ScoringProfile, ff domain.ForeignFile) (int, bool) {
+	limit, ok := profile.MaxForeignFileLines[ff.Language]
+	return limit, ok && limit > 0
+}
+
+// foreignFileSizeIssues flags non-Go files longer than their language's limit.
+func foreignFileSizeIssues(profile *domain.ScoringProfile, foreign []domain.ForeignFile) []domain.Issue {
+	var issues []domain.Issue
+	for _, ff := range foreign {
+		limit, ok := foreignFileLimit(profile, ff)
+		if !ok || ff.IsGenerated || ff.TotalLines <= limit {
+			continue
+		}
+		issues = append(issues, domain.Issue{
+			Severity:  issueSeverity(ff.TotalLines, limit),
+			Category:  "code_health",
+			SubMetric: "file_size",
+			File:      ff.Path,
+		}.WithMessage("file_size.foreign_lines", ff.Language, ff.TotalLines, limit))
+	}
+	return issues
+}
+
 // scoreCognitiveComplexity (20 pts): continuous decay from profile.MaxCognitiveComplexity.
 // Test files: threshold + 5 (additive, not 2x — CC is already additive).
 // Switch-dispatch functions: exempt (earn full credit).
-func scoreCognitiveComplexity(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
+func scoreCognitiveComplexity(profile *domain.ScoringProfile, tallies map[string]domain.FileTally) domain.SubMetric {
 	sm := domain.SubMetric{Name: "cognitive_complexity", Points: 20}
 	maxCC := profile.MaxCognitiveComplexity
 
-	total, earned := 0, 0.0
-	for _, af := range analyzed {
-		if af.IsGenerated {
-			continue
-		}
-		effectiveMax := maxCC
-		if isTestFile(af.Path) {
-			effectiveMax = maxCC + 5
-		}
-		for _, fn := range af.Functions {
-			total++
-			if isSwitchDispatch(fn) {
-				earned += 1.0
-				continue
-			}
-			earned += decayCredit(fn.CognitiveComplexity, effectiveMax)
-		}
-	}
+	earned, total := sumCredit(tallies, func(t domain.FileTally) domain.DecayCredit { return t.CognitiveComplexity })
 	if total == 0 {
 		sm.Score = sm.Points
 		sm.Detail = "no functions to evaluate"
 		return sm
 	}
 
-	ratio := earned / float64(total)
+	ratio := smoothRatio(profile, earned, total)
 	sm.Score = int(math.Round(ratio * float64(sm.Points)))
 	sm.Score = min(sm.Score, sm.Points)
-	sm.Detail = fmt.Sprintf("%.0f%% of %d functions within cognitive complexity limits (max %d)", ratio*100, total, maxCC)
+	sm.Detail = fmt.Sprintf("%.0f%% of %d functions within cognitive complexity limits (max %d)", earned/float64(total)*100, total, maxCC) +
+		smoothingNote(profile, earned, total)
 	return sm
 }
 
 // scoreParameterCount (20 pts): continuous decay from profile.MaxParameters.
-func scoreParameterCount(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
+func scoreParameterCount(profile *domain.ScoringProfile, tallies map[string]domain.FileTally) domain.SubMetric {
 	sm := domain.SubMetric{Name: "parameter_count", Points: 20}
 	maxParams := profile.MaxParameters
 
-	total, earned := 0, 0.0
-	for _, af := range analyzed {
-		if af.IsGenerated {
-			continue
-		}
-		effectiveMax := maxParams
-		if isTestFile(af.Path) {
-			effectiveMax = maxParams + 2
-		}
-		if af.HasCGoImport {
-			effectiveMax = max(effectiveMax, profile.CGoParamThreshold)
-		}
-		for _, fn := range af.Functions {
-			total++
-			if isExemptFromParams(fn.Name, profile.ExemptParamPatterns) {
-				earned += 1.0
-				continue
-			}
-			earned += decayCredit(len(fn.Params), effectiveMax)
-		}
-	}
+	earned, total := sumCredit(tallies, func(t domain.FileTally) domain.DecayCredit { return t.ParameterCount })
 	if total == 0 {
 		sm.Score = sm.Points
 		sm.Detail = "no functions to evaluate"
 		return sm
 	}
 
-	ratio := earned / float64(total)
+	ratio := smoothRatio(profile, earned, total)
 	sm.Score = int(math.Round(ratio * float64(sm.Points)))
 	sm.Score = min(sm.Score, sm.Points)
-	sm.Detail = fmt.Sprintf("%.0f%% of %d functions within parameter limits (max %d)", ratio*100, total, maxParams)
+	sm.Detail = fmt.Sprintf("%.0f%% of %d functions within parameter limits (max %d)", earned/float64(total)*100, total, maxParams) +
+		smoothingNote(profile, earned, total)
 	return sm
 }
 
@@ -267,78 +356,91 @@ type dupInfo struct {
 	percent int // duplication percentage
 }
 
-func scoreCodeDuplication(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) (domain.SubMetric, map[string]dupInfo) {
+func scoreCodeDuplication(profile *domain.ScoringProfile, scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) (domain.SubMetric, map[string]dupInfo) {
 	sm := domain.SubMetric{Name: "code_duplication", Points: 20}
-	windowSize := profile.MinCloneTokens
-	if windowSize <= 0 {
-		windowSize = 50
-	}
 	maxDupPercent := profile.MaxDuplicationPercent
 	if maxDupPercent <= 0 {
 		maxDupPercent = 5
 	}
 
-	// Collect files with enough tokens.
-	type fileEntry struct {
-		path   string
-		af     *domain.AnalyzedFile
-		tokens []int
-	}
-	var files []fileEntry
-	for _, af := range analyzed {
-		if af.IsGenerated || len(af.NormalizedTokens) < windowSize {
-			continue
-		}
-		files = append(files, fileEntry{path: af.Path, af: af, tokens: af.NormalizedTokens})
-	}
+	dupLines := DuplicatedLinesPerFile(profile, scan, analyzed)
 
 	dupMap := make(map[string]dupInfo)
 
-	if len(files) < 2 {
+	if len(dupLines) < 2 {
 		// Need at least 2 files for cross-file duplication.
 		sm.Score = sm.Points
 		sm.Detail = "no duplication detected"
 		return sm, dupMap
 	}
 
+	total, earned := 0, 0.0
+	for path, lines := range dupLines {
+		total++
+		if lines == 0 {
+			earned += 1.0
+			continue
+		}
+		af, ok := analyzed[path]
+		if !ok {
+			continue
+		}
+		dupPercent := lines * 100 / max(1, af.TotalLines)
+		dupMap[path] = dupInfo{lines: lines, percent: dupPercent}
+		earned += decayCredit(profile, dupPercent, duplicationLimit(profile, path))
+	}
+
+	ratio := smoothRatio(profile, earned, total)
+	sm.Score = int(math.Round(ratio * float64(sm.Points)))
+	sm.Score = min(sm.Score, sm.Points)
+	sm.Detail = fmt.Sprintf("%.0f%% of %d files within duplication limits (max %d%%)", earned/float64(total)*100, total, maxDupPercent) +
+		smoothingNote(profile, earned, total)
+	return sm, dupMap
+}
+
+// DuplicatedLinesPerFile returns the estimated duplicated lines of every
+// clone-eligible file, as code_duplication counts them. Low-memory runs
+// precompute them from an on-disk index and drop the tokens; otherwise
+// they are computed here.
+func DuplicatedLinesPerFile(profile *domain.ScoringProfile, scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) map[string]int {
+	if scan != nil && scan.DuplicatedLines != nil {
+		return scan.DuplicatedLines
+	}
+	return duplicatedLinesInMemory(profile, analyzed)
+}
+
+// duplicatedLinesInMemory indexes the window hashes of every eligible file
+// and returns the estimated duplicated lines per file. Every file with
+// enough tokens gets an entry, 0 when it shares no window with another file.
+func duplicatedLinesInMemory(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) map[string]int {
+	windowSize := cloneWindow(profile)
+	var files []*domain.AnalyzedFile
+	var fileTokens [][]int
+	for _, af := range sortedFiles(analyzed) {
+		if tokens, _ := DuplicationTokens(profile, af); CloneEligible(af, tokens, windowSize) {
+			files = append(files, af)
+			fileTokens = append(fileTokens, tokens)
+		}
+	}
+
 	// Build hash → set of file indices.
 	type loc struct {
 		fileIdx int
 		pos     int
 	}
 	hashMap := make(map[uint64][]loc)
-
-	const base uint64 = 131
-	for fi, fe := range files {
-		tokens := fe.tokens
-		if len(tokens) < windowSize {
-			continue
-		}
-
-		// Compute initial hash and basePow.
-		var h uint64
-		var basePow uint64 = 1
-		for i := 0; i < windowSize; i++ {
-			h = h*base + uint64(tokens[i]+10) // +10 to avoid negative token issues
-			if i < windowSize-1 {
-				basePow *= base
-			}
-		}
-		hashMap[h] = append(hashMap[h], loc{fi, 0})
-
-		// Roll the hash.
-		for i := 1; i <= len(tokens)-windowSize; i++ {
-			removed := uint64(tokens[i-1] + 10)
-			added := uint64(tokens[i+windowSize-1] + 10)
-			h = h*base - removed*basePow*base + added
-			hashMap[h] = append(hashMap[h], loc{fi, i})
+	for fi := range files {
+		for pos, h := range WindowHashes(fileTokens[fi], windowSize) {
+			hashMap[h] = append(hashMap[h], loc{fi, pos})
 		}
 	}
 
 	// Find hashes that appear in ≥2 distinct files.
 	// Track the starting positions of duplicate windows per file so we can
 	// compute covered token ranges without overcounting overlaps.
-	dupPositions := make(map[int][]int) // fileIdx → sorted start positions
+	// Build variants of the same code (foo_linux.go vs foo_windows.go) never
+	// compile together, so clones between them are not duplication.
+	dupPositions := make(map[int][]int) // fileIdx → start positions
 	for _, locs := range hashMap {
 		fileSet := make(map[int]bool)
 		for _, l := range locs {
@@ -348,70 +450,33 @@ func scoreCodeDuplication(profile *domain.ScoringProfile, analyzed map[string]*d
 			continue // intra-file only — skip
 		}
 		for _, l := range locs {
-			dupPositions[l.fileIdx] = append(dupPositions[l.fileIdx], l.pos)
-		}
-	}
-
-	// Estimate duplicated lines and score each file.
-	total, earned := 0, 0.0
-	for fi, fe := range files {
-		total++
-		positions := dupPositions[fi]
-		if len(positions) == 0 {
-			earned += 1.0
-			continue
-		}
-
-		// Count unique token positions covered by duplicate windows.
-		// Each window starting at pos covers tokens [pos, pos+windowSize).
-		// Merge overlapping ranges to avoid overcounting.
-		covered := 0
-		maxEnd := 0
-		// Sort positions (they may arrive out of order from hash map iteration).
-		sortInts(positions)
-		for _, pos := range positions {
-			end := pos + windowSize
-			if pos >= maxEnd {
-				// Non-overlapping new range.
-				covered += windowSize
-			} else if end > maxEnd {
-				// Partially overlapping — only count the extension.
-				covered += end - maxEnd
-			}
-			if end > maxEnd {
-				maxEnd = end
+			for other := range fileSet {
+				if other != l.fileIdx && !BuildVariants(files[l.fileIdx], files[other]) {
+					dupPositions[l.fileIdx] = append(dupPositions[l.fileIdx], l.pos)
+					break
+				}
 			}
 		}
-
-		// Convert covered tokens to lines (conservative: at least 1 token per line).
-		tokensPerLine := float64(len(fe.tokens)) / float64(max(1, fe.af.TotalLines))
-		if tokensPerLine < 1 {
-			tokensPerLine = 1
-		}
-		dupLines := int(float64(covered) / tokensPerLine)
-		if dupLines > fe.af.TotalLines {
-			dupLines = fe.af.TotalLines
-		}
-		dupPercent := dupLines * 100 / max(1, fe.af.TotalLines)
-		dupMap[fe.path] = dupInfo{lines: dupLines, percent: dupPercent}
-		thresh := maxDupPercent
-		if isTestFile(fe.path) {
-			thresh = maxDupPercent * 2 // test files get relaxed threshold
-		}
-		earned += decayCredit(dupPercent, thresh)
 	}
 
-	if total == 0 {
-		sm.Score = sm.Points
-		sm.Detail = "no files to evaluate"
-		return sm, dupMap
+	result := make(map[string]int, len(files))
+	for fi, af := range files {
+		result[af.Path] = DuplicatedLines(dupPositions[fi], len(fileTokens[fi]), af.TotalLines, windowSize)
 	}
+	return result
+}
 
-	ratio := earned / float64(total)
-	sm.Score = int(math.Round(ratio * float64(sm.Points)))
-	sm.Score = min(sm.Score, sm.Points)
-	sm.Detail = fmt.Sprintf("%.0f%% of %d files within duplication limits (max %d%%)", ratio*100, total, maxDupPercent)
-	return sm, dupMap
+// duplicationLimit returns the duplication percentage threshold for a file;
+// test files get a relaxed (doubled) threshold.
+func duplicationLimit(profile *domain.ScoringProfile, path string) int {
+	limit := profile.MaxDuplicationPercent
+	if limit <= 0 {
+		limit = 5
+	}
+	if isTestFile(path) {
+		limit *= 2
+	}
+	return limit
 }
 
 // isExemptFromParams reports whether the function name matches any of the
@@ -447,111 +512,112 @@ func filePattern(path string) string {
 	return ""
 }
 
-func collectCodeHealthIssues(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile, dupData map[string]dupInfo) []domain.Issue {
+func collectCodeHealthIssues(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile, tallies map[string]domain.FileTally, dupData map[string]dupInfo) []domain.Issue {
 	var issues []domain.Issue
 
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if af.IsGenerated {
 			continue
 		}
-		testFile := isTestFile(af.Path)
-
-		// Compute per-file thresholds aligned with scoring boundaries.
-		// Issues start where score penalties start — no silent zone.
-		funcThresh := profile.MaxFunctionLines
-		paramThresh := profile.MaxParameters
-		ccThresh := profile.MaxCognitiveComplexity
-		fileThresh := profile.MaxFileLines
-		dupThresh := profile.MaxDuplicationPercent
-		if dupThresh <= 0 {
-			dupThresh = 5
-		}
-		if testFile {
-			funcThresh = profile.MaxFunctionLines * 2
-			paramThresh = profile.MaxParameters + 2
-			ccThresh = profile.MaxCognitiveComplexity + 5
-			fileThresh = profile.MaxFileLines * 2
-		}
-		if af.HasCGoImport {
-			paramThresh = max(paramThresh, profile.CGoParamThreshold)
-		}
-
-		for _, fn := range af.Functions {
-			pat := funcPattern(fn.Name)
-			lines := fn.LineEnd - fn.LineStart + 1
-
-			// Template functions (dominated by string literals) get a relaxed size threshold.
-			// Data-heavy tests (low complexity table-driven tests) get the same relaxation.
-			// Switch-dispatch functions (many simple case arms) get the same relaxation.
-			fnFuncThresh := funcThresh
-			if isTemplateFunc(fn, profile) {
-				fnFuncThresh = funcThresh * templateMultiplier(profile)
-			} else if isDataHeavyTest(fn, testFile) {
-				fnFuncThresh = profile.MaxFunctionLines * templateMultiplier(profile)
-			} else if isSwitchDispatch(fn) {
-				fnFuncThresh = profile.MaxFunctionLines * templateMultiplier(profile)
-			}
-			if lines > fnFuncThresh {
-				issues = append(issues, domain.Issue{
-					Severity:  issueSeverity(lines, fnFuncThresh),
-					Category:  "code_health",
-					SubMetric: "function_size",
-					File:      af.Path,
-					Line:      fn.LineStart,
-					Message:   fmt.Sprintf("function %s is %d lines (>%d)", fn.Name, lines, fnFuncThresh),
-					Pattern:   pat,
-				})
-			}
-			if !isSwitchDispatch(fn) && fn.CognitiveComplexity > ccThresh {
-				issues = append(issues, domain.Issue{
-					Severity:  issueSeverity(fn.CognitiveComplexity, ccThresh),
-					Category:  "code_health",
-					SubMetric: "cognitive_complexity",
-					File:      af.Path,
-					Line:      fn.LineStart,
-					Message:   fmt.Sprintf("function %s has cognitive complexity %d (>%d)", fn.Name, fn.CognitiveComplexity, ccThresh),
-					Pattern:   pat,
-				})
-			}
-			if len(fn.Params) > paramThresh && !isExemptFromParams(fn.Name, profile.ExemptParamPatterns) {
-				issues = append(issues, domain.Issue{
-					Severity:  issueSeverity(len(fn.Params), paramThresh),
-					Category:  "code_health",
-					SubMetric: "parameter_count",
-					File:      af.Path,
-					Line:      fn.LineStart,
-					Message:   fmt.Sprintf("function %s has %d parameters (>%d)", fn.Name, len(fn.Params), paramThresh),
-					Pattern:   pat,
-				})
+		for _, issue := range tallies[af.Path].Issues {
+			if issue.Category == "code_health" {
+				issues = append(issues, issue)
 			}
 		}
-		if af.TotalLines > fileThresh {
-			issues = append(issues, domain.Issue{
-				Severity:  issueSeverity(af.TotalLines, fileThresh),
-				Category:  "code_health",
-				SubMetric: "file_size",
-				File:      af.Path,
-				Message:   fmt.Sprintf("file has %d lines (>%d)", af.TotalLines, fileThresh),
-				Pattern:   filePattern(af.Path),
-			})
-		}
-		// Code duplication issues (file-level, after function loop).
+		// Code duplication issues (file-level, after the tallied ones).
 		if di, ok := dupData[af.Path]; ok && di.lines > 0 {
-			fileDupThresh := dupThresh
-			if isTestFile(af.Path) {
-				fileDupThresh = dupThresh * 2 // test files get relaxed threshold
-			}
+			fileDupThresh := duplicationLimit(profile, af.Path)
 			if di.percent > fileDupThresh {
 				issues = append(issues, domain.Issue{
 					Severity:  issueSeverity(di.percent, fileDupThresh),
 					Category:  "code_health",
 					SubMetric: "code_duplication",
 					File:      af.Path,
-					Message:   fmt.Sprintf("file has %d%% duplicated lines (%d lines, >%d%%)", di.percent, di.lines, fileDupThresh),
 					Pattern:   filePattern(af.Path),
-				})
+				}.WithMessage("code_duplication.percent", di.percent, di.lines, fileDupThresh))
 			}
 		}
 	}
 	return issues
 }
+
+// codeHealthFileIssues returns the issues of af in function_size,
+// file_size, cognitive_complexity and parameter_count.
+func codeHealthFileIssues(profile *domain.ScoringProfile, af *domain.AnalyzedFile) []domain.Issue {
+	var issues []domain.Issue
+	testFile := isTestFile(af.Path)
+
+	// Compute per-file thresholds aligned with scoring boundaries.
+	// Issues start where score penalties start — no silent zone.
+	funcThresh := profile.MaxFunctionLines
+	paramThresh := profile.MaxParameters
+	ccThresh := profile.MaxCognitiveComplexity
+	fileThresh := profile.MaxFileLines
+	if testFile {
+		funcThresh = profile.MaxFunctionLines * 2
+		paramThresh = profile.MaxParameters + 2
+		ccThresh = profile.MaxCognitiveComplexity + 5
+		fileThresh = profile.MaxFileLines * 2
+	}
+	if af.HasCGoImport {
+		paramThresh = max(paramThresh, profile.CGoParamThreshold)
+		fileThresh += af.CGoPreambleLines
+	}
+
+	for _, fn := range af.Functions {
+		pat := funcPattern(fn.Name)
+		lines := fn.LineEnd - fn.LineStart + 1
+
+		// Template functions (dominated by string literals) get a relaxed size threshold.
+		// Data-heavy tests (low complexity table-driven tests) get the same relaxation.
+		// Switch-dispatch functions (many simple case arms) get the same relaxation.
+		fnFuncThresh := funcThresh
+		if isTemplateFunc(fn, profile) {
+			fnFuncThresh = funcThresh * templateMultiplier(profile)
+		} else if isDataHeavyTest(fn, testFile) {
+			fnFuncThresh = profile.MaxFunctionLines * templateMultiplier(profile)
+		} else if isSwitchDispatch(fn) {
+			fnFuncThresh = profile.MaxFunctionLines * templateMultiplier(profile)
+		}
+		if lines > fnFuncThresh {
+			issues = append(issues, domain.Issue{
+				Severity:  issueSeverity(lines, fnFuncThresh),
+				Category:  "code_health",
+				SubMetric: "function_size",
+				File:      af.Path,
+				Line:      fn.LineStart,
+				Pattern:   pat,
+			}.WithMessage("function_size.lines", fn.Name, lines, fnFuncThresh))
+		}
+		if !isSwitchDispatch(fn) && fn.CognitiveComplexity > ccThresh {
+			issues = append(issues, domain.Issue{
+				Severity:  issueSeverity(fn.CognitiveComplexity, ccThresh),
+				Category:  "code_health",
+				SubMetric: "cognitive_complexity",
+				File:      af.Path,
+				Line:      fn.LineStart,
+				Pattern:   pat,
+			}.WithMessage("cognitive_complexity.exceeded", fn.Name, fn.CognitiveComplexity, ccThresh))
+		}
+		if len(fn.Params) > paramThresh && !isExemptFromParams(fn.Name, profile.ExemptParamPatterns) && !isOptionsAPI(fn) {
+			issues = append(issues, domain.Issue{
+				Severity:  issueSeverity(len(fn.Params), paramThresh),
+				Category:  "code_health",
+				SubMetric: "parameter_count",
+				File:      af.Path,
+				Line:      fn.LineStart,
+				Pattern:   pat,
+			}.WithMessage("parameter_count.exceeded", fn.Name, len(fn.Params), paramThresh))
+		}
+	}
+	if af.TotalLines > fileThresh {
+		issues = append(issues, domain.Issue{
+			Severity:  issueSeverity(af.TotalLines, fileThresh),
+			Category:  "code_health",
+			SubMetric: "file_size",
+			File:      af.Path,
+			Pattern:   filePattern(af.Path),
+		}.WithMessage("file_size.lines", af.TotalLines, fileThresh))
+	}
+	return issues
+}
@@ -115,6 +115,24 @@ func TestScoreCodeHealth_CategoryStructure(t *testing.T) {
 	}
 }
 
+func TestScoreCodeHealth_DebtNotesSubMetric(t *testing.T) {
+	file := makeFile("service.go", 100, makeFunction("CreateUser", 20, 2, 1, 0))
+	file.DebtNotes = []domain.DebtNote{{Kind: "TODO", Line: 3}, {Kind: "HACK", Line: 9}}
+	files := analyzed(file)
+
+	without := scoring.ScoreCodeHealth(defaultProfile(), nil, files)
+	profile := defaultProfile()
+	profile.DebtNotesSubMetric = true
+	with := scoring.ScoreCodeHealth(profile, nil, files)
+
+	require.Len(t, with.SubMetrics, 6)
+	sm := with.SubMetrics[5]
+	assert.Equal(t, "technical_debt_notes", sm.Name)
+	assert.Zero(t, sm.Points, "the sub-metric is informational")
+	assert.Equal(t, "1 TODO, 0 FIXME, 1 HACK in 1 packages", sm.Detail)
+	assert.Equal(t, without.Score, with.Score)
+}
+
 // ---------------------------------------------------------------------------
 // P0 Bug Fix: Zero-function edge case — full credit when nothing to evaluate
 // ---------------------------------------------------------------------------
@@ -443,6 +461,24 @@ func TestScoreCodeHealth_ReconstructNoParameterCountIssue(t *testing.T) {
 	assert.Empty(t, paramIssues, "Reconstruct functions should never produce parameter_count issues")
 }
 
+func TestScoreCodeHealth_OptionsAPIsExemptFromParameterCount(t *testing.T) {
+	withOptions := makeFunction("NewServer", 30, 6, 1, 0)
+	withOptions.Params[5].Type = "...ServerOption"
+	withConfig := makeFunction("Dial", 30, 6, 1, 0)
+	withConfig.Params[0].Type = "*DialConfig"
+	optionCtor := makeFunction("WithTLS", 10, 6, 1, 0)
+	optionCtor.Returns = []string{"ServerOption"}
+	plain := makeFunction("ProcessOrder", 30, 6, 1, 0)
+
+	result := scoring.ScoreCodeHealth(defaultProfile(), nil, analyzed(
+		makeFile("server.go", 100, withOptions, withConfig, optionCtor, plain),
+	))
+
+	paramIssues := issuesBySubMetric(result.Issues, "parameter_count")
+	require.Len(t, paramIssues, 1)
+	assert.Contains(t, paramIssues[0].Message, "ProcessOrder")
+}
+
 func TestScoreCodeHealth_ReconstructStillCountedForOtherSubMetrics(t *testing.T) {
 	// Reconstruct is only exempt from parameter_count.
 	// If it has 300 lines, it should still get zero on function_size.
@@ -1082,6 +1118,33 @@ func TestScoreCodeHealth_SeverityPenaltyApplied(t *testing.T) {
 	assert.Equal(t, 81, result.Score, "score after rate-based severity penalty")
 }
 
+func TestScoreCodeHealth_SeverityRulesApplyBeforePenalty(t *testing.T) {
+	// Same codebase as above, but policy demotes function_size errors to info,
+	// so the penalty is computed from the remapped severities.
+	fns := make([]domain.Function, 0, 100)
+	for i := range 95 {
+		fns = append(fns, makeFunction("Good"+string(rune('A'+i%26)), 30, 2, 1, 0))
+	}
+	for i := range 5 {
+		fns = append(fns, makeFunction("Bad"+string(rune('A'+i)), 200, 2, 1, 0))
+	}
+
+	profile := defaultProfile()
+	profile.SeverityRules = []domain.SeverityRule{
+		{SubMetric: "function_size", From: domain.SeverityError, Severity: domain.SeverityInfo},
+	}
+	result := scoring.ScoreCodeHealth(profile, nil, analyzed(
+		makeFile("service.go", 100, fns...),
+	))
+
+	sizeIssues := issuesBySubMetric(result.Issues, "function_size")
+	require.Len(t, sizeIssues, 5)
+	for _, issue := range sizeIssues {
+		assert.Equal(t, domain.SeverityInfo, issue.Severity)
+	}
+	assert.Greater(t, result.Score, 81, "demoted issues should cost less than errors")
+}
+
 func TestScoreCodeHealth_NoPenaltyWhenNoIssues(t *testing.T) {
 	// A perfectly clean codebase should have zero penalty.
 	result := scoring.ScoreCodeHealth(defaultProfile(), nil, analyzed(
@@ -1140,6 +1203,28 @@ func TestScoreCodeHealth_PenaltyScalesWithSeverity(t *testing.T) {
 		"more severe violations should produce lower score")
 }
 
+func TestScoreCodeHealth_ChurnWeightsPenalty(t *testing.T) {
+	// Same violations in both files; only the file the churn data marks as
+	// hot should cost more than the unweighted baseline.
+	fns := make([]domain.Function, 0, 20)
+	for i := range 19 {
+		fns = append(fns, makeFunction("Good"+string(rune('A'+i)), 30, 2, 1, 0))
+	}
+	fns = append(fns, makeFunction("Bad", 200, 2, 1, 0))
+	files := analyzed(makeFile("svc.go", 100, fns...), makeFile("other.go", 50))
+
+	baseline := scoring.ScoreCodeHealth(defaultProfile(), &domain.ScanResult{}, files)
+	hot := scoring.ScoreCodeHealth(defaultProfile(), &domain.ScanResult{
+		FileChurn: map[string]int{"svc.go": 12, "other.go": 1},
+	}, files)
+	cold := scoring.ScoreCodeHealth(defaultProfile(), &domain.ScanResult{
+		FileChurn: map[string]int{"other.go": 12},
+	}, files)
+
+	assert.Less(t, hot.Score, baseline.Score, "issues in hot files should cost more")
+	assert.Greater(t, cold.Score, baseline.Score, "issues in frozen files should cost less")
+}
+
 func TestScoreCodeHealth_PenaltyScaleCalibration(t *testing.T) {
 	// Verify that a 6% debt ratio produces ~7 points of penalty.
 	// 50 functions: 49 clean + 1 error (200 lines, 200/50=4x ≥ 3x → error).
@@ -1457,6 +1542,19 @@ func TestScoreCodeHealth_CGoFileNoParameterIssue(t *testing.T) {
 	assert.Empty(t, paramIssues, "CGo file with params within CGo threshold should produce no issues")
 }
 
+func TestScoreCodeHealth_CGoPreambleNotCountedAsFileSize(t *testing.T) {
+	// Default MaxFileLines=300: 400 lines of which 150 are the C preamble
+	// leaves 250 lines of Go, within the limit.
+	f := makeCGoFile("gpu.go", 400, makeFunction("GpuInit", 30, 2, 1, 0))
+	f.CGoPreambleLines = 150
+	result := scoring.ScoreCodeHealth(defaultProfile(), nil, analyzed(f))
+
+	sm := subMetricByName(result, "file_size")
+	require.NotNil(t, sm)
+	assert.Equal(t, 20, sm.Score)
+	assert.Empty(t, issuesBySubMetric(result.Issues, "file_size"))
+}
+
 func TestScoreCodeHealth_CGoFileStillPenalizedBeyondThreshold(t *testing.T) {
 	// Default: CGoParamThreshold=12. A function with 15 params → penalized.
 	// 15 > 12 → issue generated at info severity (15/12=1.25 < 1.5x).
@@ -1743,6 +1841,30 @@ func TestScoreCodeHealth_CodeDuplicationGeneratedExcluded(t *testing.T) {
 	assert.Equal(t, 20, sm.Score, "generated file duplication should not affect score")
 }
 
+func TestScoreCodeHealth_CodeDuplicationBuildVariantsExcluded(t *testing.T) {
+	// Platform variants never compile together, so clones between them are
+	// not duplication; a third unconstrained copy still is.
+	tokens := make([]int, 100)
+	for i := range tokens {
+		tokens[i] = i % 10
+	}
+	linux := makeFileWithTokens("sys/poll_linux.go", 100, tokens, makeFunction("A", 20, 2, 1, 0))
+	linux.BuildConstraint = "linux"
+	windows := makeFileWithTokens("sys/poll_windows.go", 100, tokens, makeFunction("A", 20, 2, 1, 0))
+	windows.BuildConstraint = "windows"
+
+	result := scoring.ScoreCodeHealth(defaultProfile(), nil, analyzed(linux, windows))
+	sm := subMetricByName(result, "code_duplication")
+	require.NotNil(t, sm)
+	assert.Equal(t, 20, sm.Score, "linux/windows variants should not count as duplication")
+
+	shared := makeFileWithTokens("sys/poll.go", 100, tokens, makeFunction("B", 20, 2, 1, 0))
+	result = scoring.ScoreCodeHealth(defaultProfile(), nil, analyzed(linux, windows, shared))
+	sm = subMetricByName(result, "code_duplication")
+	require.NotNil(t, sm)
+	assert.Less(t, sm.Score, 20, "an unconstrained copy still duplicates each variant")
+}
+
 func TestScoreCodeHealth_CodeDuplicationIssueGeneration(t *testing.T) {
 	// Two files with identical tokens → duplication issue should be generated.
 	tokens := make([]int, 100)
@@ -1819,3 +1941,44 @@ func TestScoreCodeHealth_CodeDuplicationTestFileRelaxed(t *testing.T) {
 	// so it should still generate an issue, but at a lower severity.
 	assert.Equal(t, 1, testIssues, "test file should also have duplication issue (100% > 30%)")
 }
+
+func TestScoreCodeHealth_ForeignFilesCountTowardFileSize(t *testing.T) {
+	files := analyzed(makeFile("svc.go", 100, makeFunction("Serve", 30, 2, 1, 0)))
+	goOnly := scoring.ScoreCodeHealth(defaultProfile(), &domain.ScanResult{}, files)
+	mixed := scoring.ScoreCodeHealth(defaultProfile(), &domain.ScanResult{
+		ForeignFiles: []domain.ForeignFile{
+			{Path: "api/service.proto", Language: "protobuf", TotalLines: 2000},
+			{Path: "db/schema.sql", Language: "sql", TotalLines: 600},
+			{Path: "gen/client.ts", Language: "typescript", TotalLines: 5000, IsGenerated: true},
+			{Path: "web/app.elm", Language: "elm", TotalLines: 5000},
+		},
+	}, files)
+
+	goSize, mixedSize := goOnly.SubMetrics[1], mixed.SubMetrics[1]
+	assert.Less(t, mixedSize.Score, goSize.Score, "an oversized .proto file lowers file_size")
+	assert.Contains(t, mixedSize.Detail, "of 3 files", "generated files and languages without a limit are skipped")
+
+	fileIssues := issuesBySubMetric(mixed.Issues, "file_size")
+	require.Len(t, fileIssues, 1, "a .sql file under its own limit is not flagged by Go's")
+	assert.Equal(t, "api/service.proto", fileIssues[0].File)
+	assert.Contains(t, fileIssues[0].Message, "(>1000)")
+}
+
+func TestScoreCodeHealth_CodeDuplicationUsesPrecomputedLines(t *testing.T) {
+	// Low-memory runs drop tokens and hand the scorer duplicated lines
+	// computed from an on-disk index.
+	files := analyzed(
+		makeFile("a.go", 100, makeFunction("A", 20, 2, 1, 0)),
+		makeFile("b.go", 100, makeFunction("B", 20, 2, 1, 0)),
+	)
+	scan := &domain.ScanResult{DuplicatedLines: map[string]int{"a.go": 40, "b.go": 0}}
+
+	result := scoring.ScoreCodeHealth(defaultProfile(), scan, files)
+
+	sm := subMetricByName(result, "code_duplication")
+	require.NotNil(t, sm)
+	assert.Less(t, sm.Score, 20)
+	dupIssues := issuesBySubMetric(result.Issues, "code_duplication")
+	require.Len(t, dupIssues, 1)
+	assert.Equal(t, "a.go", dupIssues[0].File)
+}
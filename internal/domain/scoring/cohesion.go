@@ -0,0 +1,223 @@
+package scoring
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// PackageCohesion describes how tightly the files of a package are bound
+// together, in the spirit of LCOM4: files are linked when one calls a
+// function or method declared in the other or uses a type declared there
+// (as a receiver, parameter or result). Groups are the connected
+// components of that graph.
+type PackageCohesion struct {
+	Package  string     // module-relative directory
+	Groups   [][]string // connected file groups, largest first
+	Files    int
+	Cohesion float64 // 1 - (groups-1)/(files-1): 1 when all files are linked, 0 when none are
+}
+
+// PackageCohesions computes the cohesion of every package with at least two
+// files declaring functions or types. Test and generated files are skipped.
+func PackageCohesions(analyzed map[string]*domain.AnalyzedFile) []PackageCohesion {
+	byDir := map[string][]*domain.AnalyzedFile{}
+	var dirs []string
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		if len(af.Functions) == 0 && len(af.Structs) == 0 && len(af.Interfaces) == 0 {
+			continue // doc.go, constant tables
+		}
+		dir := filepath.ToSlash(filepath.Dir(af.Path))
+		if _, ok := byDir[dir]; !ok {
+			dirs = append(dirs, dir)
+		}
+		byDir[dir] = append(byDir[dir], af)
+	}
+
+	var out []PackageCohesion
+	for _, dir := range dirs {
+		if files := byDir[dir]; len(files) >= 2 {
+			out = append(out, packageCohesion(dir, files))
+		}
+	}
+	return out
+}
+
+func packageCohesion(dir string, files []*domain.AnalyzedFile) PackageCohesion {
+	decls := indexPackageDecls(files)
+	links := newFileUnion(len(files))
+	for i, af := range files {
+		for _, fn := range af.Functions {
+			for _, key := range fileReferences(fn) {
+				if j, ok := decls.lookup(key); ok {
+					links.union(i, j)
+				}
+			}
+		}
+	}
+
+	groups := map[int][]string{}
+	for i, af := range files {
+		root := links.find(i)
+		groups[root] = append(groups[root], af.Path)
+	}
+	pc := PackageCohesion{Package: dir, Files: len(files)}
+	for _, g := range groups {
+		pc.Groups = append(pc.Groups, g)
+	}
+	slices.SortFunc(pc.Groups, func(a, b []string) int {
+		if len(a) != len(b) {
+			return len(b) - len(a)
+		}
+		return cmp.Compare(a[0], b[0])
+	})
+	pc.Cohesion = 1 - float64(len(pc.Groups)-1)/float64(len(files)-1)
+	return pc
+}
+
+// packageDecls records the file index declaring each function ("Name"),
+// method ("Type.Name") and type of a package. Method calls on values of
+// unknown type ("method:Name") resolve only when one type declares the
+// method.
+type packageDecls map[string]int
+
+func indexPackageDecls(files []*domain.AnalyzedFile) packageDecls {
+	decls := packageDecls{}
+	for i, af := range files {
+		for _, name := range slices.Concat(af.Structs, af.Interfaces) {
+			decls[name] = i
+		}
+		for _, fn := range af.Functions {
+			recv := strings.TrimPrefix(fn.Receiver, "*")
+			if recv == "" {
+				decls[fn.Name] = i
+				continue
+			}
+			decls[recv+"."+fn.Name] = i
+			if j, seen := decls["method:"+fn.Name]; !seen {
+				decls["method:"+fn.Name] = i
+			} else if j != i {
+				decls["method:"+fn.Name] = -1
+			}
+		}
+	}
+	return decls
+}
+
+func (d packageDecls) lookup(key string) (int, bool) {
+	i, ok := d[key]
+	return i, ok && i >= 0
+}
+
+// fileReferences lists the package-local declarations fn depends on, as
+// packageDecls keys: its receiver type, parameter and result types, and
+// callees.
+func fileReferences(fn domain.Function) []string {
+	refs := []string{strings.TrimPrefix(fn.Receiver, "*")}
+	for _, p := range fn.Params {
+		refs = append(refs, localTypeName(p.Type))
+	}
+	for _, r := range fn.Returns {
+		refs = append(refs, localTypeName(r))
+	}
+	for _, c := range fn.Calls {
+		switch {
+		case c.Package != "":
+		case c.Receiver != "":
+			refs = append(refs, c.Receiver+"."+c.Name)
+		case c.Method:
+			refs = append(refs, "method:"+c.Name)
+		default:
+			refs = append(refs, c.Name)
+		}
+	}
+	return refs
+}
+
+// fileUnion is a union-find over file indexes.
+type fileUnion []int
+
+func newFileUnion(n int) fileUnion {
+	u := make(fileUnion, n)
+	for i := range u {
+		u[i] = i
+	}
+	return u
+}
+
+func (u fileUnion) find(i int) int {
+	for u[i] != i {
+		u[i] = u[u[i]]
+		i = u[i]
+	}
+	return i
+}
+
+func (u fileUnion) union(i, j int) { u[u.find(i)] = u.find(j) }
+
+// localTypeName strips pointer, slice and variadic markers from a type
+// expression; types qualified by another package yield "".
+func localTypeName(t string) string {
+	t = strings.TrimLeft(t, "*[].")
+	if strings.ContainsAny(t, ".[") {
+		return ""
+	}
+	return t
+}
+
+// minPackageCohesion returns the cohesion below which a package is flagged.
+func minPackageCohesion(profile *domain.ScoringProfile) float64 {
+	if profile.MinPackageCohesion > 0 {
+		return profile.MinPackageCohesion
+	}
+	return 0.5
+}
+
+// scorePackageCohesion (15 pts): average cohesion of multi-file packages.
+func scorePackageCohesion(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
+	sm := domain.SubMetric{Name: "package_cohesion", Points: 15}
+
+	packages := PackageCohesions(analyzed)
+	if len(packages) == 0 {
+		sm.Score = sm.Points
+		sm.Detail = "no multi-file packages to evaluate"
+		return sm
+	}
+
+	total := 0.0
+	for _, pc := range packages {
+		total += pc.Cohesion
+	}
+	avg := smoothRatio(profile, total, len(packages))
+	sm.Score = min(int(math.Round(avg*float64(sm.Points))), sm.Points)
+	sm.Detail = fmt.Sprintf("%.0f%% average cohesion across %d multi-file packages", total/float64(len(packages))*100, len(packages)) +
+		smoothingNote(profile, total, len(packages))
+	return sm
+}
+
+// packageCohesionIssues flags packages whose files fall into unrelated
+// groups: candidates for splitting, or for moving files where they are used.
+func packageCohesionIssues(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	threshold := minPackageCohesion(profile)
+	var issues []domain.Issue
+	for _, pc := range PackageCohesions(analyzed) {
+		if pc.Cohesion >= threshold {
+			continue
+		}
+		issues = append(issues, domain.Issue{
+			Severity:  domain.SeverityWarning,
+			Category:  "discoverability",
+			SubMetric: "package_cohesion",
+			Pattern:   "low-cohesion",
+		}.WithMessage("package_cohesion.split", pc.Package, len(pc.Groups), pc.Files, pc.Cohesion, threshold))
+	}
+	return issues
+}
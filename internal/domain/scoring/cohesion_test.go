@@ -0,0 +1,85 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageCohesions(t *testing.T) {
+	files := couplingFiles(
+		// store: linked by receiver type and calls.
+		&domain.AnalyzedFile{Path: "store/store.go", Structs: []string{"Store"}},
+		couplingFile("store/get.go", domain.Function{Name: "Get", Receiver: "*Store",
+			Calls: []domain.Call{{Name: "decode"}}}),
+		couplingFile("store/codec.go", domain.Function{Name: "decode"}),
+		// util: three unrelated files, one linked pair through a parameter type.
+		couplingFile("util/strings.go", domain.Function{Name: "Reverse"}),
+		&domain.AnalyzedFile{Path: "util/clock.go", Structs: []string{"Clock"}},
+		couplingFile("util/sleep.go", domain.Function{Name: "Sleep", Params: []domain.Param{{Type: "*Clock"}}}),
+		couplingFile("util/retry.go", domain.Function{Name: "Retry", Calls: []domain.Call{{Name: "Reverse", Package: "other"}}}),
+		// Single-file packages, tests and doc-only files are not evaluated.
+		couplingFile("single/one.go", domain.Function{Name: "One"}),
+		couplingFile("store/store_test.go", domain.Function{Name: "TestGet"}),
+		&domain.AnalyzedFile{Path: "store/doc.go"},
+	)
+
+	packages := PackageCohesions(files)
+	require.Len(t, packages, 2)
+
+	store := packages[0]
+	assert.Equal(t, "store", store.Package)
+	assert.Equal(t, 3, store.Files)
+	assert.Len(t, store.Groups, 1)
+	assert.InDelta(t, 1.0, store.Cohesion, 1e-9)
+
+	util := packages[1]
+	assert.Equal(t, "util", util.Package)
+	assert.Equal(t, [][]string{
+		{"util/clock.go", "util/sleep.go"},
+		{"util/retry.go"},
+		{"util/strings.go"},
+	}, util.Groups)
+	assert.InDelta(t, 1.0/3, util.Cohesion, 1e-9)
+}
+
+func TestPackageCohesions_MethodCallsResolveWhenUnambiguous(t *testing.T) {
+	call := []domain.Call{{Name: "Flush", Method: true}}
+	unique := PackageCohesions(couplingFiles(
+		couplingFile("a/writer.go", domain.Function{Name: "Flush", Receiver: "*writer"}),
+		couplingFile("a/run.go", domain.Function{Name: "run", Calls: call}),
+	))
+	require.Len(t, unique, 1)
+	assert.Len(t, unique[0].Groups, 1)
+
+	ambiguous := PackageCohesions(couplingFiles(
+		couplingFile("a/writer.go", domain.Function{Name: "Flush", Receiver: "*writer"}),
+		couplingFile("a/buffer.go", domain.Function{Name: "Flush", Receiver: "*buffer"}),
+		couplingFile("a/run.go", domain.Function{Name: "run", Calls: call}),
+	))
+	require.Len(t, ambiguous, 1)
+	assert.Len(t, ambiguous[0].Groups, 3)
+}
+
+func TestPackageCohesionIssues(t *testing.T) {
+	profile := domain.DefaultProfile()
+	files := couplingFiles(
+		couplingFile("report/html.go", domain.Function{Name: "HTML"}),
+		couplingFile("report/csv.go", domain.Function{Name: "CSV"}),
+	)
+
+	issues := packageCohesionIssues(&profile, files)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "package_cohesion", issues[0].SubMetric)
+	assert.Equal(t, domain.SeverityWarning, issues[0].Severity)
+	assert.Contains(t, issues[0].Message, `package "report" splits into 2 unrelated file groups`)
+
+	sm := scorePackageCohesion(&profile, files)
+	assert.Equal(t, 0, sm.Score)
+
+	profile.MinPackageCohesion = 0.0001
+	files["report/csv.go"].Functions[0].Calls = []domain.Call{{Name: "HTML"}}
+	assert.Empty(t, packageCohesionIssues(&profile, files))
+}
@@ -0,0 +1,41 @@
+package scoring
+
+import (
+	"fmt"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// complianceCheck is the outcome of checking items against a convention:
+// how many items were checked and the issues raised for those that broke
+// it.
+type complianceCheck struct {
+	checked int
+	issues  []domain.Issue
+}
+
+func (c *complianceCheck) add(other complianceCheck) {
+	c.checked += other.checked
+	c.issues = append(c.issues, other.issues...)
+}
+
+// compliance returns the ratio of checked items that raised no issue, or 1
+// when nothing was checked.
+func (c complianceCheck) compliance() float64 {
+	if c.checked == 0 {
+		return 1.0
+	}
+	return float64(c.checked-len(c.issues)) / float64(c.checked)
+}
+
+// blend averages the compliance ratio into sm's score and appends
+// "; passed/checked what" to its detail. It does nothing when no item was
+// checked.
+func (c complianceCheck) blend(sm *domain.SubMetric, what string) {
+	if c.checked == 0 {
+		return
+	}
+	ratio := (float64(sm.Score)/float64(sm.Points) + c.compliance()) / 2.0
+	sm.Score = min(int(ratio*float64(sm.Points)), sm.Points)
+	sm.Detail += fmt.Sprintf("; %d/%d %s", c.checked-len(c.issues), c.checked, what)
+}
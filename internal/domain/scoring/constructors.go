@@ -0,0 +1,122 @@
+package scoring
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// checkConstructors checks constructors and the types that need them in
+// non-test, non-generated files: exported structs with unexported fields
+// should have a constructor, constructors that validate their arguments
+// should return an error, and pointer arguments should be checked for nil.
+// A constructor is an exported New or NewX function returning a type of
+// its own package.
+func checkConstructors(analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	files := sortedFiles(analyzed)
+
+	constructed := make(map[string]bool) // dir + "." + type
+	for _, af := range files {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		for _, fn := range af.Functions {
+			if t := constructedType(fn); t != "" {
+				constructed[filepath.Dir(af.Path)+"."+t] = true
+			}
+		}
+	}
+
+	for _, af := range files {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		for _, sd := range af.StructDefs {
+			if !needsConstructor(sd) {
+				continue
+			}
+			check.checked++
+			if constructed[filepath.Dir(af.Path)+"."+sd.Name] {
+				continue
+			}
+			check.issues = append(check.issues, domain.Issue{
+				Severity:  domain.SeverityInfo,
+				Category:  "predictability",
+				SubMetric: "consistent_patterns",
+				File:      af.Path,
+				Pattern:   "missing-constructor",
+			}.WithMessage("consistent_patterns.missing_constructor", sd.Name, sd.Name))
+		}
+		for _, fn := range af.Functions {
+			if constructedType(fn) == "" {
+				continue
+			}
+			check.checked++
+			if iss, ok := constructorIssue(fn); ok {
+				iss.Category = "predictability"
+				iss.SubMetric = "consistent_patterns"
+				iss.File = af.Path
+				iss.Line = fn.LineStart
+				check.issues = append(check.issues, iss)
+			}
+		}
+	}
+	return check
+}
+
+// constructedType returns the local type fn constructs, or "" when fn is
+// not a constructor.
+func constructedType(fn domain.Function) string {
+	if fn.Receiver != "" || !fn.Exported || len(fn.Returns) == 0 {
+		return ""
+	}
+	if fn.Name != "New" && !hasWordPrefix(fn.Name, "New") {
+		return ""
+	}
+	t := strings.TrimPrefix(fn.Returns[0], "*")
+	if t == "" || strings.ContainsAny(t, ".[]() ") || !unicode.IsUpper(rune(t[0])) {
+		return ""
+	}
+	return t
+}
+
+// needsConstructor reports whether sd is exported and has unexported
+// fields whose zero value is not ready to use. Synchronization fields are
+// usable as zero values and do not count.
+func needsConstructor(sd domain.StructDef) bool {
+	if sd.Name == "" || !unicode.IsUpper(rune(sd.Name[0])) {
+		return false
+	}
+	for _, f := range sd.Fields {
+		if f.Name == "" || f.Name == "_" || unicode.IsUpper(rune(f.Name[0])) || strings.HasPrefix(strings.TrimPrefix(f.Type, "*"), "sync.") {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// constructorIssue reports the most actionable problem with constructor
+// fn: validation without an error return first, then an unchecked pointer
+// argument.
+func constructorIssue(fn domain.Function) (domain.Issue, bool) {
+	returnsError := fn.Returns[len(fn.Returns)-1] == "error"
+	if len(fn.ZeroChecks) > 0 && !returnsError {
+		return domain.Issue{Severity: domain.SeverityWarning, Pattern: "constructor-error"}.
+			WithMessage("consistent_patterns.constructor_error", fn.Name, strings.Join(fn.ZeroChecks, ", "), fn.Returns[0]), true
+	}
+	for _, p := range fn.Params {
+		if !strings.HasPrefix(p.Type, "*") || p.Name == "" || p.Name == "_" {
+			continue
+		}
+		if !slices.Contains(fn.ZeroChecks, p.Name) {
+			return domain.Issue{Severity: domain.SeverityInfo, Pattern: "constructor-nil-check"}.
+				WithMessage("consistent_patterns.constructor_nil_check", fn.Name, p.Name), true
+		}
+	}
+	return domain.Issue{}, false
+}
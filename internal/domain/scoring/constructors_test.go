@@ -0,0 +1,91 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckConstructors_MissingConstructor(t *testing.T) {
+	files := map[string]*domain.AnalyzedFile{
+		"store/store.go": {Path: "store/store.go", StructDefs: []domain.StructDef{
+			{Name: "Store", Fields: []domain.Param{{Name: "db", Type: "*DB"}}},
+			{Name: "Options", Fields: []domain.Param{{Name: "Path", Type: "string"}}},
+			{Name: "Cache", Fields: []domain.Param{{Name: "mu", Type: "sync.RWMutex"}, {Name: "Items", Type: "map[string]any"}}},
+			{Name: "row", Fields: []domain.Param{{Name: "id", Type: "int"}}},
+		}},
+		"store/queue.go": {Path: "store/queue.go", StructDefs: []domain.StructDef{
+			{Name: "Queue", Fields: []domain.Param{{Name: "items", Type: "[]string"}}},
+		}, Functions: []domain.Function{
+			{Name: "NewQueue", Exported: true, Returns: []string{"*Queue"}},
+		}},
+		"other/store.go": {Path: "other/store.go", Functions: []domain.Function{
+			{Name: "NewStore", Exported: true, Returns: []string{"*Store"}},
+		}},
+	}
+
+	check := checkConstructors(files)
+
+	assert.Equal(t, 4, check.checked, "Store and Queue need constructors; NewQueue and NewStore are constructors")
+	require.Len(t, check.issues, 1, "a constructor in another package does not count")
+	assert.Equal(t, "missing-constructor", check.issues[0].Pattern)
+	assert.Equal(t, "store/store.go", check.issues[0].File)
+	assert.Equal(t, "exported struct Store has unexported fields but no constructor (e.g. NewStore)", check.issues[0].Message)
+}
+
+func TestConstructorIssue(t *testing.T) {
+	tests := []struct {
+		name    string
+		fn      domain.Function
+		pattern string // "" means no issue
+		message string
+	}{
+		{
+			"validates and returns error",
+			domain.Function{Name: "NewStore", Params: []domain.Param{{Name: "db", Type: "*DB"}}, Returns: []string{"*Store", "error"}, ZeroChecks: []string{"db"}},
+			"", "",
+		},
+		{
+			"value arguments only",
+			domain.Function{Name: "NewPoint", Params: []domain.Param{{Name: "x", Type: "int"}}, Returns: []string{"Point"}},
+			"", "",
+		},
+		{
+			"validates without error",
+			domain.Function{Name: "NewStore", Params: []domain.Param{{Name: "db", Type: "*DB"}}, Returns: []string{"*Store"}, ZeroChecks: []string{"db"}},
+			"constructor-error", "constructor NewStore checks db but returns no error; return (*Store, error)",
+		},
+		{
+			"unchecked pointer",
+			domain.Function{Name: "NewService", Params: []domain.Param{{Name: "cfg", Type: "Config"}, {Name: "repo", Type: "*Repo"}}, Returns: []string{"*Service", "error"}},
+			"constructor-nil-check", "constructor NewService does not check required argument repo for nil",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iss, ok := constructorIssue(tt.fn)
+			assert.Equal(t, tt.pattern != "", ok)
+			assert.Equal(t, tt.pattern, iss.Pattern)
+			assert.Equal(t, tt.message, iss.Message)
+		})
+	}
+}
+
+func TestConstructedType(t *testing.T) {
+	tests := []struct {
+		fn   domain.Function
+		want string
+	}{
+		{domain.Function{Name: "New", Exported: true, Returns: []string{"*GoParser"}}, "GoParser"},
+		{domain.Function{Name: "NewStore", Exported: true, Returns: []string{"Store", "error"}}, "Store"},
+		{domain.Function{Name: "NewReader", Exported: true, Returns: []string{"io.Reader"}}, ""},
+		{domain.Function{Name: "Newest", Exported: true, Returns: []string{"*Item"}}, ""},
+		{domain.Function{Name: "New", Receiver: "*Factory", Exported: true, Returns: []string{"*Item"}}, ""},
+		{domain.Function{Name: "NewIDs", Exported: true, Returns: []string{"[]ID"}}, ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, constructedType(tt.fn), tt.fn.Name)
+	}
+}
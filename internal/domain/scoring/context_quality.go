@@ -1,7 +1,10 @@
 package scoring
 
 import (
+	"cmp"
 	"fmt"
+	"path"
+	"slices"
 	"strings"
 
 	"github.com/abdidvp/openkraft/internal/domain"
@@ -16,7 +19,7 @@ func ScoreContextQuality(profile *domain.ScoringProfile, scan *domain.ScanResult
 	}
 
 	sm1 := scoreAIContextFiles(profile, scan)
-	sm2 := scorePackageDocumentation(analyzed)
+	sm2 := scorePackageDocumentation(profile, analyzed)
 	sm3 := scoreArchitectureDocs(scan)
 	sm4 := scoreCanonicalExamples(scan, analyzed)
 
@@ -29,6 +32,8 @@ func ScoreContextQuality(profile *domain.ScoringProfile, scan *domain.ScanResult
 	cat.Score = total
 
 	cat.Issues = collectContextQualityIssues(scan)
+	cat.Issues = append(cat.Issues, undocumentedPackageIssues(scan, analyzed)...)
+	domain.ApplySeverityRules(profile.SeverityRules, cat.Issues)
 	return cat
 }
 
@@ -108,36 +113,103 @@ func contextFileStatus(name string, scan *domain.ScanResult) (exists bool, size
 }
 
 // scorePackageDocumentation (25 pts): ratio of packages with // Package ... doc comment.
-func scorePackageDocumentation(analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
+func scorePackageDocumentation(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
 	sm := domain.SubMetric{Name: "package_documentation", Points: 25}
 
-	// Deduplicate packages — one file with doc is enough per package.
-	packages := make(map[string]bool)   // package name → seen
-	documented := make(map[string]bool) // package name → has doc
+	docs := packageDocs(analyzed)
+	if len(docs) == 0 {
+		sm.Detail = "no packages found"
+		return sm
+	}
+	documented := 0
+	for _, pd := range docs {
+		if pd.documented {
+			documented++
+		}
+	}
 
-	for _, af := range analyzed {
-		if strings.HasSuffix(af.Path, "_test.go") {
+	ratio := smoothRatio(profile, float64(documented), len(docs))
+	sm.Score = int(ratio * float64(sm.Points))
+	if sm.Score > sm.Points {
+		sm.Score = sm.Points
+	}
+	sm.Detail = fmt.Sprintf("%d/%d packages have documentation comments", documented, len(docs)) +
+		smoothingNote(profile, float64(documented), len(docs))
+	return sm
+}
+
+// packageDoc is the documentation state of one package directory: whether
+// any of its files carries the package comment, and the file a missing
+// comment belongs in, doc.go when the package has one.
+type packageDoc struct {
+	dir        string
+	documented bool
+	docFile    string
+	hasDocGo   bool
+}
+
+// packageDocs returns the documentation state of every package with
+// hand-written non-test files, keyed by directory.
+func packageDocs(analyzed map[string]*domain.AnalyzedFile) map[string]*packageDoc {
+	docs := make(map[string]*packageDoc)
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || strings.HasSuffix(af.Path, "_test.go") {
 			continue
 		}
-		pkg := af.Package
-		packages[pkg] = true
+		dir := path.Dir(af.Path)
+		pd, ok := docs[dir]
+		if !ok {
+			pd = &packageDoc{dir: dir, docFile: af.Path}
+			docs[dir] = pd
+		}
 		if af.PackageDoc {
-			documented[pkg] = true
+			pd.documented = true
+		}
+		if path.Base(af.Path) == "doc.go" {
+			pd.docFile, pd.hasDocGo = af.Path, true
 		}
 	}
+	return docs
+}
 
-	if len(packages) == 0 {
-		sm.Detail = "no packages found"
-		return sm
+// undocumentedPackageIssues lists the packages without a package comment,
+// most imported first: their documentation is what the readers of every
+// importer look for first.
+func undocumentedPackageIssues(scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	fanIn := make(map[string]int)
+	if scan != nil {
+		if g := BuildImportGraph(scan.ModulePath, analyzed); g != nil {
+			for pkg, node := range g.Packages {
+				fanIn[packageDir(scan.ModulePath, pkg)] = len(node.ImportedBy)
+			}
+		}
 	}
 
-	ratio := float64(len(documented)) / float64(len(packages))
-	sm.Score = int(ratio * float64(sm.Points))
-	if sm.Score > sm.Points {
-		sm.Score = sm.Points
+	var missing []*packageDoc
+	for _, pd := range packageDocs(analyzed) {
+		if !pd.documented {
+			missing = append(missing, pd)
+		}
 	}
-	sm.Detail = fmt.Sprintf("%d/%d packages have documentation comments", len(documented), len(packages))
-	return sm
+	slices.SortFunc(missing, func(a, b *packageDoc) int {
+		return cmp.Or(cmp.Compare(fanIn[b.dir], fanIn[a.dir]), cmp.Compare(a.dir, b.dir))
+	})
+
+	issues := make([]domain.Issue, 0, len(missing))
+	for _, pd := range missing {
+		id := "context_quality.package_doc_missing"
+		if pd.hasDocGo {
+			id = "context_quality.package_doc_empty"
+		}
+		issues = append(issues, domain.Issue{
+			Severity:  domain.SeverityInfo,
+			Category:  "context_quality",
+			SubMetric: "package_documentation",
+			File:      pd.docFile,
+			Pattern:   "undocumented-package",
+		}.WithMessage(id, pd.dir, fanIn[pd.dir]))
+	}
+	return issues
 }
 
 // scoreArchitectureDocs (20 pts): README.md >500 bytes (8), docs/ dir (7), ADR files (5).
@@ -222,7 +294,7 @@ func scoreCanonicalExamples(scan *domain.ScanResult, analyzed map[string]*domain
 
 	// Example* test functions in any _test.go file (5 pts)
 	exampleFuncCount := 0
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if !strings.HasSuffix(af.Path, "_test.go") {
 			continue
 		}
@@ -274,27 +346,24 @@ func collectContextQualityIssues(scan *domain.ScanResult) []domain.Issue {
 		issues = append(issues, domain.Issue{
 			Severity:     domain.SeverityWarning,
 			Category:     "context_quality",
-			Message:      "CLAUDE.md not found; add it to provide AI agents with project context",
 			FixAvailable: true,
-		})
+		}.WithMessage("context_quality.no_claude_md"))
 	}
 
 	if !scan.HasCursorRules {
 		issues = append(issues, domain.Issue{
 			Severity:     domain.SeverityInfo,
 			Category:     "context_quality",
-			Message:      ".cursorrules not found; add it for Cursor IDE integration",
 			FixAvailable: true,
-		})
+		}.WithMessage("context_quality.no_cursorrules"))
 	}
 
 	if !scan.HasAgentsMD {
 		issues = append(issues, domain.Issue{
 			Severity:     domain.SeverityInfo,
 			Category:     "context_quality",
-			Message:      "AGENTS.md not found; add it to describe agent workflows",
 			FixAvailable: true,
-		})
+		}.WithMessage("context_quality.no_agents_md"))
 	}
 
 	return issues
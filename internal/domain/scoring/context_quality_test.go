@@ -160,3 +160,33 @@ func TestScoreContextQuality_CustomContextFiles(t *testing.T) {
 	// 20 pts, min_size=100, size=500 → 10 (half) + 10 (size met) = 20
 	assert.Equal(t, 20, aiContext.Score)
 }
+
+func TestScoreContextQuality_UndocumentedPackagesRankedByFanIn(t *testing.T) {
+	scan := &domain.ScanResult{ModulePath: "example.com/app"}
+	analyzed := map[string]*domain.AnalyzedFile{
+		"cmd/app/main.go": {Path: "cmd/app/main.go", Package: "main", PackageDoc: true,
+			Imports: []string{"example.com/app/store", "example.com/app/billing"}},
+		"billing/invoice.go":  {Path: "billing/invoice.go", Package: "billing", Imports: []string{"example.com/app/store"}},
+		"billing/doc.go":      {Path: "billing/doc.go", Package: "billing"},
+		"store/store.go":      {Path: "store/store.go", Package: "store"},
+		"store/store_test.go": {Path: "store/store_test.go", Package: "store", PackageDoc: true},
+		"api/api.pb.go":       {Path: "api/api.pb.go", Package: "api", IsGenerated: true},
+	}
+
+	result := scoring.ScoreContextQuality(defaultProfile(), scan, analyzed)
+
+	assert.Equal(t, "1/3 packages have documentation comments", result.SubMetrics[1].Detail)
+	var docIssues []domain.Issue
+	for _, iss := range result.Issues {
+		if iss.SubMetric == "package_documentation" {
+			docIssues = append(docIssues, iss)
+		}
+	}
+	if assert.Len(t, docIssues, 2) {
+		assert.Equal(t, "store/store.go", docIssues[0].File, "imported by two packages, listed first")
+		assert.Equal(t, "package store has no package comment (imported by 2 packages); add one in a doc.go", docIssues[0].Message)
+		assert.Equal(t, "billing/doc.go", docIssues[1].File)
+		assert.Equal(t, "package billing has a doc.go without a package comment (imported by 1 packages)", docIssues[1].Message)
+		assert.Equal(t, domain.SeverityInfo, docIssues[1].Severity)
+	}
+}
@@ -0,0 +1,22 @@
+package scoring
+
+import (
+	"fmt"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// scoreTechnicalDebtNotes (0 pts, informational): counts the TODO, FIXME
+// and HACK notes in non-generated files. It carries no points, so it shows
+// the notes next to the code_health sub-metrics without moving the score.
+func scoreTechnicalDebtNotes(analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
+	sm := domain.SubMetric{Name: "technical_debt_notes"}
+	inv := domain.BuildDebtInventory(analyzed)
+	if inv == nil {
+		sm.Detail = "no TODO, FIXME or HACK notes"
+		return sm
+	}
+	sm.Detail = fmt.Sprintf("%d TODO, %d FIXME, %d HACK in %d packages",
+		inv.ByKind["TODO"], inv.ByKind["FIXME"], inv.ByKind["HACK"], len(inv.Packages))
+	return sm
+}
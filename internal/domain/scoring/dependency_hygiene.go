@@ -0,0 +1,131 @@
+package scoring
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// pseudoVersion matches module versions that pin a commit rather than a
+// tag, e.g. v0.0.0-20240101120000-abcdef123456.
+var pseudoVersion = regexp.MustCompile(`[-.]\d{14}-[0-9a-f]{12}(\+incompatible)?$`)
+
+// deprecatedModules maps deprecated or archived modules to their successor.
+var deprecatedModules = map[string]string{
+	"github.com/golang/protobuf":        "google.golang.org/protobuf",
+	"github.com/dgrijalva/jwt-go":       "github.com/golang-jwt/jwt",
+	"github.com/golang/mock":            "go.uber.org/mock",
+	"github.com/satori/go.uuid":         "github.com/google/uuid",
+	"github.com/mitchellh/mapstructure": "github.com/go-viper/mapstructure/v2",
+	"github.com/ghodss/yaml":            "sigs.k8s.io/yaml",
+	"gopkg.in/square/go-jose.v2":        "github.com/go-jose/go-jose/v3",
+}
+
+// stdlibOverlaps maps modules whose functionality the standard library now
+// provides to the replacing packages.
+var stdlibOverlaps = map[string]string{
+	"github.com/pkg/errors":              "errors and fmt.Errorf with %w",
+	"github.com/hashicorp/go-multierror": "errors.Join",
+	"go.uber.org/multierr":               "errors.Join",
+	"golang.org/x/exp":                   "slices, maps, cmp and log/slog",
+	"github.com/mitchellh/go-homedir":    "os.UserHomeDir",
+	"github.com/kardianos/osext":         "os.Executable",
+}
+
+// Deductions per dependency_hygiene finding.
+const (
+	localReplacePenalty  = 6
+	replacePenalty       = 3
+	deprecatedPenalty    = 3
+	missingSumPenalty    = 2
+	pseudoVersionPenalty = 2
+	stdlibOverlapPenalty = 1
+	tooManyDepsPenalty   = 4
+)
+
+// scoreDependencyHygiene (20 pts): deductions for replace directives,
+// deprecated modules, requirements missing from go.sum, direct pseudo-version
+// pins, stdlib-overlapping libraries and an excessive direct dependency count.
+// Skipped when the project has no go.mod.
+func scoreDependencyHygiene(profile *domain.ScoringProfile, scan *domain.ScanResult) (domain.SubMetric, []domain.Issue) {
+	sm := domain.SubMetric{Name: "dependency_hygiene", Points: 20}
+	if scan == nil || scan.GoMod == nil {
+		sm.Skipped = true
+		sm.Detail = "no go.mod found"
+		return sm, nil
+	}
+	mod := scan.GoMod
+	direct := mod.DirectRequires()
+
+	var issues []domain.Issue
+	penalty := 0
+	add := func(severity string, line, points int, format string, args ...any) {
+		penalty += points
+		issues = append(issues, domain.Issue{
+			Severity:  severity,
+			Category:  "verifiability",
+			SubMetric: "dependency_hygiene",
+			File:      "go.mod",
+			Line:      line,
+			Message:   fmt.Sprintf(format, args...),
+		})
+	}
+
+	for _, r := range mod.Replaces {
+		if r.Local {
+			add(domain.SeverityError, r.Line, localReplacePenalty,
+				"replace directive points %s at local path %s; builds outside this checkout will fail", r.Old, r.New)
+		} else {
+			add(domain.SeverityWarning, r.Line, replacePenalty,
+				"replace directive redirects %s to %s", r.Old, r.New)
+		}
+	}
+
+	pseudo := 0
+	for _, r := range mod.Requires {
+		if successor, ok := lookupModule(deprecatedModules, r.Path); ok {
+			add(domain.SeverityWarning, r.Line, deprecatedPenalty,
+				"dependency %s is deprecated; use %s", r.Path, successor)
+		}
+		if mod.HasGoSum && !mod.Sums[r.Path+"@"+r.Version] {
+			add(domain.SeverityWarning, r.Line, missingSumPenalty,
+				"go.sum has no checksum for %s %s; run go mod tidy", r.Path, r.Version)
+		}
+		if r.Indirect {
+			continue
+		}
+		if pseudoVersion.MatchString(r.Version) {
+			pseudo++
+			add(domain.SeverityWarning, r.Line, pseudoVersionPenalty,
+				"dependency %s is pinned to pseudo-version %s instead of a release", r.Path, r.Version)
+		}
+		if replacement, ok := lookupModule(stdlibOverlaps, r.Path); ok {
+			add(domain.SeverityInfo, r.Line, stdlibOverlapPenalty,
+				"dependency %s overlaps the standard library (%s)", r.Path, replacement)
+		}
+	}
+
+	limit := profile.MaxDirectDependencies
+	if limit > 0 && len(direct) > limit {
+		add(domain.SeverityInfo, 0, tooManyDepsPenalty,
+			"%d direct dependencies exceed the limit of %d", len(direct), limit)
+	}
+
+	sm.Score = max(0, sm.Points-penalty)
+	sm.Detail = fmt.Sprintf("%d direct dependencies, %d replace directives, %d pseudo-versions, %d findings",
+		len(direct), len(mod.Replaces), pseudo, len(issues))
+	return sm, issues
+}
+
+// lookupModule finds path in table, also matching major-version suffixes
+// and submodules (e.g. golang.org/x/exp/slog for golang.org/x/exp).
+func lookupModule(table map[string]string, path string) (string, bool) {
+	for prefix, v := range table {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return v, true
+		}
+	}
+	return "", false
+}
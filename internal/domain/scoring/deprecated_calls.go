@@ -0,0 +1,87 @@
+package scoring
+
+import (
+	"path"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// collectDeprecatedCallIssues warns about every call to a deprecated
+// function: one in the module whose doc comment has a "Deprecated:"
+// paragraph, or one of a direct dependency listed in scan.Deprecations.
+// Calls are resolved as in BuildCallGraph, so method calls on values other
+// than the caller's receiver are not checked. Calls from test files,
+// generated files and functions that are deprecated themselves are
+// skipped.
+func collectDeprecatedCallIssues(scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	modulePath := ""
+	deprecated := make(map[string]string)
+	if scan != nil {
+		modulePath = scan.ModulePath
+		for id, note := range scan.Deprecations {
+			deprecated[id] = note
+		}
+	}
+	files := sortedFiles(analyzed)
+	for _, af := range files {
+		if af.IsGenerated {
+			continue
+		}
+		pkgPath := packageImportPath(modulePath, af.Path)
+		for _, fn := range af.Functions {
+			if fn.Deprecated != "" {
+				deprecated[FunctionID(pkgPath, strings.TrimPrefix(fn.Receiver, "*"), fn.Name)] = fn.Deprecated
+			}
+		}
+	}
+	if len(deprecated) == 0 {
+		return nil
+	}
+
+	var issues []domain.Issue
+	for _, af := range files {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		pkgPath := packageImportPath(modulePath, af.Path)
+		for _, fn := range af.Functions {
+			if fn.Deprecated != "" {
+				continue
+			}
+			for _, call := range fn.Calls {
+				target, callee := callTarget(pkgPath, call)
+				note, ok := deprecated[target]
+				if !ok {
+					continue
+				}
+				issues = append(issues, domain.Issue{
+					Severity:  domain.SeverityWarning,
+					Category:  "predictability",
+					SubMetric: "consistent_patterns",
+					File:      af.Path,
+					Line:      call.Line,
+					Pattern:   "deprecated-call",
+				}.WithMessage("consistent_patterns.deprecated_call", fn.Name, callee, note))
+			}
+		}
+	}
+	return issues
+}
+
+// callTarget returns the call graph ID of the function call targets and
+// the name a reader knows it by: pkg.Func, Type.Method, pkg.Type.Method or
+// Func. Method calls on other values have no target.
+func callTarget(pkgPath string, call domain.Call) (string, string) {
+	if call.Method {
+		return "", ""
+	}
+	name := call.Name
+	if call.Receiver != "" {
+		name = call.Receiver + "." + name
+	}
+	if call.Package == "" {
+		return FunctionID(pkgPath, call.Receiver, call.Name), name
+	}
+	return FunctionID(call.Package, call.Receiver, call.Name), path.Base(call.Package) + "." + name
+}
@@ -0,0 +1,52 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectDeprecatedCallIssues(t *testing.T) {
+	scan := &domain.ScanResult{
+		ModulePath:   "example.com/app",
+		Deprecations: map[string]string{"github.com/acme/kv.Dial": "Deprecated: use DialContext."},
+	}
+	analyzed := map[string]*domain.AnalyzedFile{
+		"store/store.go": {Path: "store/store.go", Functions: []domain.Function{
+			{Name: "Get", Receiver: "*Store", Deprecated: "Deprecated: use Lookup."},
+			{Name: "Lookup", Receiver: "*Store"},
+			{Name: "Open", Deprecated: "Deprecated: use New.", Calls: []domain.Call{{Name: "Get", Receiver: "Store", Line: 20}}},
+			{Name: "Refresh", Receiver: "*Store", Calls: []domain.Call{{Name: "Get", Receiver: "Store", Line: 31}}},
+		}},
+		"api/api.go": {Path: "api/api.go", Functions: []domain.Function{
+			{Name: "Serve", Calls: []domain.Call{
+				{Name: "Open", Package: "example.com/app/store", Line: 8},
+				{Name: "Get", Method: true, Line: 9},
+				{Name: "Dial", Package: "github.com/acme/kv", Line: 10},
+			}},
+		}},
+		"api/api_test.go": {Path: "api/api_test.go", Functions: []domain.Function{
+			{Name: "TestServe", Calls: []domain.Call{{Name: "Open", Package: "example.com/app/store", Line: 5}}},
+		}},
+	}
+
+	issues := collectDeprecatedCallIssues(scan, analyzed)
+
+	require.Len(t, issues, 3, "calls from deprecated functions, tests and unresolved methods are skipped")
+	assert.Equal(t, "api/api.go", issues[0].File)
+	assert.Equal(t, 8, issues[0].Line)
+	assert.Equal(t, "Serve calls store.Open. Deprecated: use New.", issues[0].Message)
+	assert.Equal(t, "Serve calls kv.Dial. Deprecated: use DialContext.", issues[1].Message)
+	assert.Equal(t, "Refresh calls Store.Get. Deprecated: use Lookup.", issues[2].Message)
+	assert.Equal(t, domain.SeverityWarning, issues[2].Severity)
+	assert.Equal(t, "deprecated-call", issues[2].Pattern)
+}
+
+func TestCollectDeprecatedCallIssues_NothingDeprecated(t *testing.T) {
+	analyzed := map[string]*domain.AnalyzedFile{
+		"a.go": {Path: "a.go", Functions: []domain.Function{{Name: "A", Calls: []domain.Call{{Name: "B"}}}}},
+	}
+	assert.Empty(t, collectDeprecatedCallIssues(nil, analyzed))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/abdidvp/openkraft/internal/domain"
@@ -17,6 +18,8 @@ func ScoreDiscoverability(profile *domain.ScoringProfile, modules []domain.Detec
 		profile = &p
 	}
 
+	profile = withLearnedSuffixes(profile, scan, analyzed)
+
 	cat := domain.CategoryScore{
 		Name:   "discoverability",
 		Weight: 0.20,
@@ -28,12 +31,20 @@ func ScoreDiscoverability(profile *domain.ScoringProfile, modules []domain.Detec
 		fc = classifyFileNaming(profile, scan.GoFiles, analyzed)
 	}
 
-	sm1 := scoreNamingUniqueness(profile, analyzed)
+	rules := checkNamingRules(profile, analyzed)
+	fileTypes := checkFileTypes(profile, analyzed)
+	layout := checkPackageLayout(profile, analyzed)
+
+	sm1 := scoreNamingUniqueness(profile, analyzed, &rules)
 	sm2 := scoreFileNamingConventions(profile, scan, &fc)
 	sm3 := scorePredictableStructure(profile, modules, &fc)
+	fileTypes.blend(&sm3, "file names match the types they declare")
+	layout.blend(&sm3, "package depth and size checks pass")
 	sm4 := scoreDiscoverabilityDependencyDirection(profile, modules, scan, analyzed)
+	sm5 := scoreFunctionCoupling(profile, analyzed)
+	sm6 := scorePackageCohesion(profile, analyzed)
 
-	cat.SubMetrics = []domain.SubMetric{sm1, sm2, sm3, sm4}
+	cat.SubMetrics = []domain.SubMetric{sm1, sm2, sm3, sm4, sm5, sm6}
 
 	base := 0
 	for _, sm := range cat.SubMetrics {
@@ -41,10 +52,22 @@ func ScoreDiscoverability(profile *domain.ScoringProfile, modules []domain.Detec
 	}
 
 	cat.Issues = collectDiscoverabilityIssues(profile, modules, scan, analyzed, &fc)
+	cat.Issues = append(cat.Issues, foreignNamingIssues(foreignFiles(scan))...)
+	cat.Issues = append(cat.Issues, rules.issues...)
+	cat.Issues = append(cat.Issues, stutterIssues(profile, analyzed)...)
+	cat.Issues = append(cat.Issues, overExposureIssues(scan, analyzed)...)
+	cat.Issues = append(cat.Issues, fileTypes.issues...)
+	cat.Issues = append(cat.Issues, layout.issues...)
+	cat.Issues = append(cat.Issues, collectEmbedIssues(scan, analyzed)...)
+	cat.Issues = append(cat.Issues, collectEmbeddingIssues(profile, scan, analyzed)...)
+	cat.Issues = append(cat.Issues, functionCouplingIssues(profile, scan, analyzed)...)
+	cat.Issues = append(cat.Issues, packageCohesionIssues(profile, analyzed)...)
+	cat.Issues = append(cat.Issues, docLinkageIssues(modules, scan)...)
+	domain.ApplySeverityRules(profile.SeverityRules, cat.Issues)
 
 	funcCount := countExportedFunctions(analyzed)
 	if funcCount > 0 {
-		cat.Score = max(0, base-severityPenalty(cat.Issues, funcCount))
+		cat.Score = max(0, base-severityPenalty(profile, cat.Issues, smoothCount(profile, funcCount)))
 	} else {
 		cat.Score = base
 	}
@@ -52,9 +75,40 @@ func ScoreDiscoverability(profile *domain.ScoringProfile, modules []domain.Detec
 	return cat
 }
 
-// scoreNamingUniqueness (25 pts): composite — WCS, specificity, entropy, collision rate.
-func scoreNamingUniqueness(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
-	sm := domain.SubMetric{Name: "naming_uniqueness", Points: 25}
+// isCGoShim reports whether fn is a cgo shim whose name mirrors C rather
+// than Go conventions: a function exported to C with //export, a C-style
+// name with underscores, or a wrapper calling into C.
+func isCGoShim(af *domain.AnalyzedFile, fn domain.Function) bool {
+	if !af.HasCGoImport {
+		return false
+	}
+	return fn.CallsC || strings.Contains(fn.Name, "_") || slices.Contains(af.CGoExports, fn.Name)
+}
+
+// foreignNamingIssues flags non-Go files whose names break the naming
+// convention of their language (e.g. snake_case for .proto and .sql).
+func foreignNamingIssues(foreign []domain.ForeignFile) []domain.Issue {
+	var issues []domain.Issue
+	for _, ff := range foreign {
+		if ff.NamingOK || ff.IsGenerated || ff.NamingConvention == "" {
+			continue
+		}
+		issues = append(issues, domain.Issue{
+			Severity:  domain.SeverityInfo,
+			Category:  "discoverability",
+			SubMetric: "file_naming_conventions",
+			File:      ff.Path,
+		}.WithMessage("file_naming_conventions.foreign", ff.Language, filepath.Base(ff.Path), ff.NamingConvention))
+	}
+	return issues
+}
+
+// scoreNamingUniqueness (20 pts): composite — WCS, specificity, entropy, collision rate.
+// Generic helpers are left out, as in the naming_uniqueness issues.
+// The compliance ratio of interface naming and config naming rules is
+// averaged in when any name was checked.
+func scoreNamingUniqueness(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile, rules *complianceCheck) domain.SubMetric {
+	sm := domain.SubMetric{Name: "naming_uniqueness", Points: 20}
 
 	var names []string
 	var totalWCS, totalVS float64
@@ -72,20 +126,20 @@ func scoreNamingUniqueness(profile *domain.ScoringProfile, analyzed map[string]*
 		cw = 0.15
 	}
 
-	domainVocab := ExtractDomainVocabulary(analyzed)
+	vocab := NewVocabulary(profile, analyzed)
 
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if af.IsGenerated {
 			continue
 		}
 		for _, fn := range af.Functions {
-			if !fn.Exported {
+			if !fn.Exported || isCGoShim(af, fn) || isGenericHelper(fn) {
 				continue
 			}
 			names = append(names, fn.Name)
-			wcs := WordCountScore(fn.Name)
+			wcs := wordCountScore(len(vocab.Words.Split(fn.Name)))
 			totalWCS += wcs
-			totalVS += IdentifierSpecificity(fn.Name, domainVocab)
+			totalVS += IdentifierSpecificity(fn.Name, vocab)
 			count++
 			if wcs >= minWCS {
 				descriptive++
@@ -104,17 +158,22 @@ func scoreNamingUniqueness(profile *domain.ScoringProfile, analyzed map[string]*
 	collisionRate := SymbolCollisionRate(analyzed)
 
 	composite := avgWCS*w[0] + avgVS*w[1] + entropy*w[2] + (1-collisionRate)*cw
-	sm.Score = min(int(math.Round(composite*float64(sm.Points))), sm.Points)
 	sm.Detail = fmt.Sprintf("%d of %d exported functions have descriptive names (2+ words)",
 		descriptive, count)
+	if rules != nil && rules.checked > 0 {
+		composite = (composite + rules.compliance()) / 2.0
+		sm.Detail += fmt.Sprintf("; %d/%d type and file names follow naming rules",
+			rules.checked-len(rules.issues), rules.checked)
+	}
+	sm.Score = min(int(math.Round(composite*float64(sm.Points))), sm.Points)
 	return sm
 }
 
-// scoreFileNamingConventions (25 pts): measures internal naming consistency.
+// scoreFileNamingConventions (15 pts): measures internal naming consistency.
 // Respects profile.NamingConvention: "bare" or "suffixed" enforces that pattern;
 // "auto" (default) detects the dominant pattern and scores consistency.
 func scoreFileNamingConventions(profile *domain.ScoringProfile, scan *domain.ScanResult, fc *fileClassification) domain.SubMetric {
-	sm := domain.SubMetric{Name: "file_naming_conventions", Points: 25}
+	sm := domain.SubMetric{Name: "file_naming_conventions", Points: 15}
 
 	if fc == nil || fc.total == 0 {
 		sm.Detail = "no scorable files"
@@ -170,13 +229,13 @@ func suffixReuse(goFiles []string, expectedSuffixes []string) float64 {
 	return float64(reused) / float64(total)
 }
 
-// scorePredictableStructure (25 pts): 3-signal composite measuring structural consistency.
+// scorePredictableStructure (15 pts): 3-signal composite measuring structural consistency.
 //   - Layer consistency (50%): Jaccard of normalized layer sets across modules.
 //   - Suffix Jaccard (30%): Jaccard of role-indicating file suffixes across modules.
 //     When naming convention is "bare", suffix Jaccard is replaced with full credit.
 //   - File count similarity (20%): min(a,b)/max(a,b) averaged across pairs.
 func scorePredictableStructure(profile *domain.ScoringProfile, modules []domain.DetectedModule, fc *fileClassification) domain.SubMetric {
-	sm := domain.SubMetric{Name: "predictable_structure", Points: 25}
+	sm := domain.SubMetric{Name: "predictable_structure", Points: 15}
 
 	if len(modules) <= 1 {
 		sm.Score = sm.Points
@@ -280,12 +339,12 @@ func scorePredictableStructure(profile *domain.ScoringProfile, modules []domain.
 	return sm
 }
 
-// scoreDiscoverabilityDependencyDirection (25 pts): composite of layer violations and import graph signals.
+// scoreDiscoverabilityDependencyDirection (20 pts): composite of layer violations and import graph signals.
 // Layer violations (50%): adapter→adapter, domain→application import direction checks.
 // Import graph (50%): cycles, distance from main sequence, coupling outliers.
 // When either signal has no data, the other gets 100% weight.
 func scoreDiscoverabilityDependencyDirection(profile *domain.ScoringProfile, modules []domain.DetectedModule, scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
-	sm := domain.SubMetric{Name: "dependency_direction", Points: 25}
+	sm := domain.SubMetric{Name: "dependency_direction", Points: 20}
 
 	// Layer violations
 	layerScore, violations, totalChecked := scoreLayerViolations(profile, modules, analyzed)
@@ -445,7 +504,7 @@ func jaccard(a, b map[string]bool) float64 {
 // countExportedFunctions counts exported functions in non-generated files.
 func countExportedFunctions(analyzed map[string]*domain.AnalyzedFile) int {
 	count := 0
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if af.IsGenerated {
 			continue
 		}
@@ -462,36 +521,39 @@ func collectDiscoverabilityIssues(profile *domain.ScoringProfile, modules []doma
 	var issues []domain.Issue
 
 	// 1. naming_uniqueness: flag exported single-word functions (WCS < threshold).
-	//    Skip: generated files, test files, Go interface methods, methods with receiver + single word.
+	//    Skip: generated files, test files, Go interface methods, methods with receiver + single word,
+	//    generic helpers (isGenericHelper).
 	minWCS := profile.MinNamingWordScore
 	if minWCS <= 0 {
 		minWCS = 0.7
 	}
-	for _, af := range analyzed {
+	words := splitterFor(profile)
+	for _, af := range sortedFiles(analyzed) {
 		if af.IsGenerated || strings.HasSuffix(af.Path, "_test.go") {
 			continue
 		}
 		for _, fn := range af.Functions {
-			if !fn.Exported {
+			if !fn.Exported || isCGoShim(af, fn) || isGenericHelper(fn) {
 				continue
 			}
-			if fn.Receiver != "" && WordCount(fn.Name) == 1 {
+			wc := len(words.Split(fn.Name))
+			if fn.Receiver != "" && wc == 1 {
 				continue
 			}
-			if WordCountScore(fn.Name) < minWCS {
-				wc := WordCount(fn.Name)
-				msg := fmt.Sprintf("exported function %q has a single-word name; consider a verb+noun pattern", fn.Name)
-				if wc > 1 {
-					msg = fmt.Sprintf("exported function %q has %d words; consider a shorter verb+noun pattern", fn.Name, wc)
-				}
-				issues = append(issues, domain.Issue{
+			if wordCountScore(wc) < minWCS {
+				iss := domain.Issue{
 					Severity:  domain.SeverityInfo,
 					Category:  "discoverability",
 					SubMetric: "naming_uniqueness",
 					File:      af.Path,
 					Line:      fn.LineStart,
-					Message:   msg,
-				})
+				}
+				if wc > 1 {
+					iss = iss.WithMessage("naming_uniqueness.too_many_words", fn.Name, wc)
+				} else {
+					iss = iss.WithMessage("naming_uniqueness.single_word", fn.Name)
+				}
+				issues = append(issues, iss)
 			}
 		}
 	}
@@ -530,16 +592,14 @@ func collectDiscoverabilityIssues(profile *domain.ScoringProfile, modules []doma
 						Category:  "discoverability",
 						SubMetric: "file_naming_conventions",
 						File:      f,
-						Message:   fmt.Sprintf("file %q uses bare naming but project uses suffixed pattern", base),
-					})
+					}.WithMessage("file_naming_conventions.bare", base))
 				} else if !c.dominantIsSuffixed && isSuffixed {
 					issues = append(issues, domain.Issue{
 						Severity:  fileSev,
 						Category:  "discoverability",
 						SubMetric: "file_naming_conventions",
 						File:      f,
-						Message:   fmt.Sprintf("file %q uses suffixed naming but project uses bare pattern", base),
-					})
+					}.WithMessage("file_naming_conventions.suffixed", base))
 				}
 			}
 		}
@@ -581,8 +641,7 @@ func collectDiscoverabilityIssues(profile *domain.ScoringProfile, modules []doma
 						Category:  "discoverability",
 						SubMetric: "predictable_structure",
 						File:      m.Path,
-						Message:   fmt.Sprintf("module %q is missing %q layer that %d/%d peers have", m.Name, layer, count, len(modules)),
-					})
+					}.WithMessage("predictable_structure.missing_layer", m.Name, layer, count, len(modules)))
 				}
 			}
 		}
@@ -608,9 +667,8 @@ func collectDiscoverabilityIssues(profile *domain.ScoringProfile, modules []doma
 						Category:  "discoverability",
 						SubMetric: "dependency_direction",
 						File:      f,
-						Message:   fmt.Sprintf("%s layer imports %s (dependency direction violation)", layer, imp),
 						Pattern:   pat,
-					})
+					}.WithMessage("dependency_direction.violation", layer, imp))
 				}
 			}
 		}
@@ -625,9 +683,8 @@ func collectDiscoverabilityIssues(profile *domain.ScoringProfile, modules []doma
 					Severity:  domain.SeverityError,
 					Category:  "discoverability",
 					SubMetric: "dependency_direction",
-					Message:   fmt.Sprintf("import cycle: %s", strings.Join(cycle, " → ")),
 					Pattern:   "import-cycle",
-				})
+				}.WithMessage("dependency_direction.import_cycle", strings.Join(cycle, " → ")))
 			}
 			multiplier := profile.CouplingOutlierMultiplier
 			if multiplier <= 0 {
@@ -638,9 +695,8 @@ func collectDiscoverabilityIssues(profile *domain.ScoringProfile, modules []doma
 					Severity:  domain.SeverityWarning,
 					Category:  "discoverability",
 					SubMetric: "dependency_direction",
-					Message:   fmt.Sprintf("package %q imports %d internal packages (median is %.0f)", outlier.Package, outlier.Ce, outlier.MedianCe),
 					Pattern:   "coupling-outlier",
-				})
+				}.WithMessage("dependency_direction.coupling_outlier", outlier.Package, outlier.Ce, outlier.MedianCe))
 			}
 		}
 	}
@@ -650,12 +706,12 @@ func collectDiscoverabilityIssues(profile *domain.ScoringProfile, modules []doma
 		packages map[string]bool
 	}
 	collisionMap := make(map[string]*collisionInfo)
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if af.IsGenerated || strings.HasSuffix(af.Path, "_test.go") {
 			continue
 		}
 		for _, fn := range af.Functions {
-			if !fn.Exported || fn.Receiver != "" {
+			if !fn.Exported || fn.Receiver != "" || isGenericHelper(fn) {
 				continue
 			}
 			ci, ok := collisionMap[fn.Name]
@@ -672,19 +728,17 @@ func collectDiscoverabilityIssues(profile *domain.ScoringProfile, modules []doma
 				Severity:  domain.SeverityInfo,
 				Category:  "discoverability",
 				SubMetric: "naming_uniqueness",
-				Message:   fmt.Sprintf("exported function %q appears in %d packages", name, len(ci.packages)),
-			})
+			}.WithMessage("naming_uniqueness.duplicate_name", name, len(ci.packages)))
 		}
 	}
 
 	// 6. Package name quality: flag vague package names.
-	vaguePackages := map[string]bool{
-		"util": true, "utils": true, "common": true, "helpers": true,
-		"misc": true, "base": true, "lib": true, "shared": true,
-		"tools": true, "types": true,
+	vaguePackages := make(map[string]bool, len(profile.VaguePackageNames))
+	for _, name := range profile.VaguePackageNames {
+		vaguePackages[strings.ToLower(name)] = true
 	}
 	seenPackages := make(map[string]bool)
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if af.IsGenerated || af.Package == "" || seenPackages[af.Package] {
 			continue
 		}
@@ -695,14 +749,13 @@ func collectDiscoverabilityIssues(profile *domain.ScoringProfile, modules []doma
 				Category:  "discoverability",
 				SubMetric: "naming_uniqueness",
 				File:      af.Path,
-				Message:   fmt.Sprintf("package %q is a vague name; consider a more descriptive name", af.Package),
-			})
+			}.WithMessage("naming_uniqueness.vague_package", af.Package))
 		}
 	}
 
 	// 7. Param name quality: flag exported functions where all params are single-letter
 	//    and param count >= 2. Skip idiomatic Go param patterns.
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if af.IsGenerated || strings.HasSuffix(af.Path, "_test.go") {
 			continue
 		}
@@ -710,7 +763,7 @@ func collectDiscoverabilityIssues(profile *domain.ScoringProfile, modules []doma
 			if !fn.Exported || fn.Receiver != "" || len(fn.Params) < 2 {
 				continue
 			}
-			if isIdiomaticParamSignature(fn.Params) {
+			if isIdiomaticParamSignature(fn.Params) || isGenericHelper(fn) {
 				continue
 			}
 			allSingleLetter := true
@@ -727,8 +780,7 @@ func collectDiscoverabilityIssues(profile *domain.ScoringProfile, modules []doma
 					SubMetric: "naming_uniqueness",
 					File:      af.Path,
 					Line:      fn.LineStart,
-					Message:   fmt.Sprintf("exported function %q has %d single-letter parameters", fn.Name, len(fn.Params)),
-				})
+				}.WithMessage("naming_uniqueness.single_letter_params", fn.Name, len(fn.Params)))
 			}
 		}
 	}
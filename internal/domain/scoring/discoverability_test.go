@@ -16,7 +16,7 @@ func TestScoreDiscoverability_NilInputs(t *testing.T) {
 
 	assert.Equal(t, "discoverability", result.Name)
 	assert.Equal(t, 0.20, result.Weight)
-	assert.Len(t, result.SubMetrics, 4)
+	assert.Len(t, result.SubMetrics, 6)
 	assert.GreaterOrEqual(t, result.Score, 0)
 	assert.LessOrEqual(t, result.Score, 100)
 }
@@ -30,11 +30,11 @@ func TestScoreDiscoverability_EmptyInputs(t *testing.T) {
 
 	assert.Equal(t, "discoverability", result.Name)
 	assert.Equal(t, 0.20, result.Weight)
-	assert.Len(t, result.SubMetrics, 4)
+	assert.Len(t, result.SubMetrics, 6)
 	// Empty inputs: no functions, no files, no modules.
 	// predictable_structure and dependency_direction give full credit (nothing to penalize).
 	// naming_uniqueness and file_naming_conventions give 0 (no data).
-	assert.Equal(t, 50, result.Score, "empty project: 0+0+25+25 = 50")
+	assert.Equal(t, 65, result.Score, "empty project: 0+0+15+20+15+15 = 65")
 }
 
 func TestScoreDiscoverability_WellStructuredProject(t *testing.T) {
@@ -93,7 +93,7 @@ func TestScoreDiscoverability_WellStructuredProject(t *testing.T) {
 
 	assert.Equal(t, "discoverability", result.Name)
 	assert.Equal(t, 0.20, result.Weight)
-	assert.Len(t, result.SubMetrics, 4)
+	assert.Len(t, result.SubMetrics, 6)
 	assert.Greater(t, result.Score, 0)
 	assert.LessOrEqual(t, result.Score, 100)
 
@@ -144,7 +144,7 @@ func TestScoreDiscoverability_SharedLayersDifferentFiles(t *testing.T) {
 
 	predictable := result.SubMetrics[2]
 	assert.Equal(t, "predictable_structure", predictable.Name)
-	assert.GreaterOrEqual(t, predictable.Score, 20, "modules with identical layers should score high")
+	assert.GreaterOrEqual(t, predictable.Score, 12, "modules with identical layers should score high")
 }
 
 func TestScoreDiscoverability_PredictableStructureWithoutSuffixes(t *testing.T) {
@@ -173,7 +173,7 @@ func TestScoreDiscoverability_PredictableStructureWithoutSuffixes(t *testing.T)
 
 	predictable := result.SubMetrics[2]
 	assert.Equal(t, "predictable_structure", predictable.Name)
-	assert.GreaterOrEqual(t, predictable.Score, 20,
+	assert.GreaterOrEqual(t, predictable.Score, 12,
 		"modules with matching filenames should score high even without suffixes")
 }
 
@@ -222,7 +222,7 @@ func TestScoreDiscoverability_BareNamingConsistency(t *testing.T) {
 	result := scoring.ScoreDiscoverability(defaultProfile(), nil, scan, nil)
 	naming := result.SubMetrics[1]
 	assert.Equal(t, "file_naming_conventions", naming.Name)
-	assert.GreaterOrEqual(t, naming.Score, 22, "all-bare naming = 100%% consistent")
+	assert.GreaterOrEqual(t, naming.Score, 13, "all-bare naming = 100%% consistent")
 }
 
 func TestScoreDiscoverability_MixedNamingReducesScore(t *testing.T) {
@@ -231,8 +231,8 @@ func TestScoreDiscoverability_MixedNamingReducesScore(t *testing.T) {
 	}
 	result := scoring.ScoreDiscoverability(defaultProfile(), nil, scan, nil)
 	naming := result.SubMetrics[1]
-	assert.Less(t, naming.Score, 22, "mixed naming lowers score")
-	assert.Greater(t, naming.Score, 10, "majority still consistent")
+	assert.Less(t, naming.Score, 13, "mixed naming lowers score")
+	assert.Greater(t, naming.Score, 6, "majority still consistent")
 }
 
 func TestScoreDiscoverability_IncomparableModulesGetFullCredit(t *testing.T) {
@@ -243,7 +243,7 @@ func TestScoreDiscoverability_IncomparableModulesGetFullCredit(t *testing.T) {
 	result := scoring.ScoreDiscoverability(defaultProfile(), modules, &domain.ScanResult{}, nil)
 	predictable := result.SubMetrics[2]
 	assert.Equal(t, "predictable_structure", predictable.Name)
-	assert.Equal(t, 25, predictable.Score, "no comparable pairs = full credit")
+	assert.Equal(t, 15, predictable.Score, "no comparable pairs = full credit")
 }
 
 func TestScoreDiscoverability_DependencyViolation(t *testing.T) {
@@ -281,7 +281,7 @@ func TestScoreDiscoverability_ForcedBareNaming(t *testing.T) {
 	naming := result.SubMetrics[1]
 	// With forced "bare", scanner.go and order_repo.go match bare (since _repo isn't a known suffix).
 	// user_handler.go and tax_service.go are recognized suffixed files. 2/4 = 50% → ~13 pts.
-	assert.Less(t, naming.Score, 15, "forced bare should penalize suffixed files")
+	assert.Less(t, naming.Score, 9, "forced bare should penalize suffixed files")
 }
 
 // --- Bug fix regression tests ---
@@ -326,7 +326,7 @@ func TestScoreDiscoverability_SuffixJaccardNotContaminatedByBareNames(t *testing
 	result := scoring.ScoreDiscoverability(defaultProfile(), modules, scan, nil)
 	predictable := result.SubMetrics[2]
 	assert.Equal(t, "predictable_structure", predictable.Name)
-	assert.GreaterOrEqual(t, predictable.Score, 22,
+	assert.GreaterOrEqual(t, predictable.Score, 13,
 		"same role suffixes should produce high Jaccard despite different bare filenames")
 }
 
@@ -453,7 +453,7 @@ func TestScoreDiscoverability_RoundingNotTruncating(t *testing.T) {
 	result := scoring.ScoreDiscoverability(defaultProfile(), nil, nil, analyzed)
 	naming := result.SubMetrics[0]
 	assert.Equal(t, "naming_uniqueness", naming.Name)
-	assert.GreaterOrEqual(t, naming.Score, 22, "well-named functions with domain vocab should score high")
+	assert.GreaterOrEqual(t, naming.Score, 18, "well-named functions with domain vocab should score high")
 }
 
 func TestScoreDiscoverability_SkipsGeneratedFiles(t *testing.T) {
@@ -554,9 +554,9 @@ func TestIdentifierSpecificityWithDomainVocab(t *testing.T) {
 			},
 		},
 	}
-	domainVocab := scoring.ExtractDomainVocabulary(analyzed)
-	scoreGood := scoring.IdentifierSpecificity("CreateUser", domainVocab)
-	scoreBad := scoring.IdentifierSpecificity("HandleData", domainVocab)
+	vocab := scoring.NewVocabulary(defaultProfile(), analyzed)
+	scoreGood := scoring.IdentifierSpecificity("CreateUser", vocab)
+	scoreBad := scoring.IdentifierSpecificity("HandleData", vocab)
 	assert.Greater(t, scoreGood, scoreBad,
 		"CreateUser with User struct should score higher than HandleData")
 }
@@ -614,6 +614,25 @@ func TestVaguePackageNameIssues(t *testing.T) {
 	assert.Equal(t, domain.SeverityInfo, pkgIssues[0].Severity)
 }
 
+func TestVaguePackageNameIssues_ProfileList(t *testing.T) {
+	analyzed := map[string]*domain.AnalyzedFile{
+		"utils/helper.go": {Path: "utils/helper.go", Package: "utils"},
+		"kitchen/sink.go": {Path: "kitchen/sink.go", Package: "kitchen"},
+	}
+	p := domain.DefaultProfile()
+	p.VaguePackageNames = []string{"kitchen"}
+
+	result := scoring.ScoreDiscoverability(&p, nil, nil, analyzed)
+
+	var vague []string
+	for _, iss := range result.Issues {
+		if strings.Contains(iss.Message, "vague name") {
+			vague = append(vague, iss.File)
+		}
+	}
+	assert.Equal(t, []string{"kitchen/sink.go"}, vague)
+}
+
 func TestParamNameQualityIssues(t *testing.T) {
 	analyzed := map[string]*domain.AnalyzedFile{
 		"math.go": {
@@ -717,7 +736,7 @@ func TestCollisionIssuesExemptGeneratedFiles(t *testing.T) {
 // --- Audit bug regression tests (2026-02-28) ---
 
 func TestScoreDiscoverability_NonHexagonalProjectGetsFullDependencyCredit(t *testing.T) {
-	// Bug 1: Flat projects with no layered files were getting 0/25 on dependency_direction.
+	// Bug 1: Flat projects with no layered files were getting 0/20 on dependency_direction.
 	// They should get full credit — no layers means no violations.
 	t.Run("modules_with_no_layers", func(t *testing.T) {
 		modules := []domain.DetectedModule{
@@ -751,8 +770,8 @@ func TestScoreDiscoverability_NonHexagonalProjectGetsFullDependencyCredit(t *tes
 
 		depDirection := result.SubMetrics[3]
 		assert.Equal(t, "dependency_direction", depDirection.Name)
-		assert.Equal(t, 25, depDirection.Score,
-			"flat project with no layers should get full 25/25 dependency direction credit")
+		assert.Equal(t, 20, depDirection.Score,
+			"flat project with no layers should get full 20/20 dependency direction credit")
 	})
 
 	t.Run("zero_modules", func(t *testing.T) {
@@ -771,13 +790,13 @@ func TestScoreDiscoverability_NonHexagonalProjectGetsFullDependencyCredit(t *tes
 
 		depDirection := result.SubMetrics[3]
 		assert.Equal(t, "dependency_direction", depDirection.Name)
-		assert.Equal(t, 25, depDirection.Score,
-			"project with zero modules should get full 25/25 dependency direction credit")
+		assert.Equal(t, 20, depDirection.Score,
+			"project with zero modules should get full 20/20 dependency direction credit")
 
 		predictable := result.SubMetrics[2]
 		assert.Equal(t, "predictable_structure", predictable.Name)
-		assert.Equal(t, 25, predictable.Score,
-			"project with zero modules should get full 25/25 predictable structure credit")
+		assert.Equal(t, 15, predictable.Score,
+			"project with zero modules should get full 15/15 predictable structure credit")
 	})
 }
 
@@ -894,7 +913,7 @@ func TestScoreDiscoverability_CompoundNamesNotTreatedAsSuffixed(t *testing.T) {
 	naming := result.SubMetrics[1]
 	assert.Equal(t, "file_naming_conventions", naming.Name)
 	// All files should be classified as bare → 100% consistency.
-	assert.GreaterOrEqual(t, naming.Score, 22,
+	assert.GreaterOrEqual(t, naming.Score, 13,
 		"compound names like content_type.go should be treated as bare")
 }
 
@@ -911,7 +930,7 @@ func TestScoreDiscoverability_BuildTagsNotTreatedAsSuffixed(t *testing.T) {
 	result := scoring.ScoreDiscoverability(defaultProfile(), nil, scan, nil)
 	naming := result.SubMetrics[1]
 	assert.Equal(t, "file_naming_conventions", naming.Name)
-	assert.GreaterOrEqual(t, naming.Score, 22,
+	assert.GreaterOrEqual(t, naming.Score, 13,
 		"platform build tag files should be treated as bare")
 }
 
@@ -1117,8 +1136,8 @@ func TestScoreDiscoverability_NoModulePathGetsFullGraphCredit(t *testing.T) {
 	depDirection := result.SubMetrics[3]
 	assert.Equal(t, "dependency_direction", depDirection.Name)
 	// No module path → graph gets full credit, only layer violations matter.
-	// No violations → full 25 points.
-	assert.Equal(t, 25, depDirection.Score)
+	// No violations → full 20 points.
+	assert.Equal(t, 20, depDirection.Score)
 }
 
 func TestScoreDiscoverability_CycleDetectedInIssues(t *testing.T) {
@@ -1217,5 +1236,134 @@ func TestScoreDiscoverability_SinglePackageProjectFullCredit(t *testing.T) {
 	result := scoring.ScoreDiscoverability(defaultProfile(), nil, scan, analyzed)
 	depDirection := result.SubMetrics[3]
 	assert.Equal(t, "dependency_direction", depDirection.Name)
-	assert.Equal(t, 25, depDirection.Score, "single-package project should get full credit")
+	assert.Equal(t, 20, depDirection.Score, "single-package project should get full credit")
+}
+
+func TestScoreDiscoverability_FlagsForeignFileNaming(t *testing.T) {
+	scan := &domain.ScanResult{
+		ForeignFiles: []domain.ForeignFile{
+			{Path: "api/UserService.proto", Language: "protobuf", NamingConvention: "snake_case"},
+			{Path: "api/order_service.proto", Language: "protobuf", NamingConvention: "snake_case", NamingOK: true},
+		},
+	}
+	result := scoring.ScoreDiscoverability(defaultProfile(), nil, scan, nil)
+
+	var naming []domain.Issue
+	for _, iss := range result.Issues {
+		if iss.SubMetric == "file_naming_conventions" {
+			naming = append(naming, iss)
+		}
+	}
+	require.Len(t, naming, 1)
+	assert.Equal(t, "api/UserService.proto", naming[0].File)
+	assert.Equal(t, domain.SeverityInfo, naming[0].Severity)
+	assert.Contains(t, naming[0].Message, "snake_case")
+}
+
+func TestScoreDiscoverability_CGoShimsExemptFromNaming(t *testing.T) {
+	shim := func(name string, callsC bool) domain.Function {
+		return domain.Function{Name: name, Exported: true, CallsC: callsC}
+	}
+	files := analyzed(&domain.AnalyzedFile{
+		Path:         "gpu/gpu.go",
+		Package:      "gpu",
+		HasCGoImport: true,
+		CGoExports:   []string{"Callback"},
+		Functions: []domain.Function{
+			shim("Free", true),       // thin wrapper around C.free
+			shim("Callback", false),  // exported to C
+			shim("Gpu_Reset", false), // C-style name
+			shim("Version", false),   // plain Go function: still flagged
+		},
+	})
+
+	result := scoring.ScoreDiscoverability(defaultProfile(), nil, nil, files)
+
+	var flagged []string
+	for _, iss := range result.Issues {
+		if iss.SubMetric == "naming_uniqueness" {
+			flagged = append(flagged, iss.Message)
+		}
+	}
+	require.Len(t, flagged, 1)
+	assert.Contains(t, flagged[0], `"Version"`)
+}
+
+func TestScoreDiscoverability_NamingRules(t *testing.T) {
+	scan := &domain.ScanResult{
+		GoFiles: []string{"scanner.go", "detector.go", "parser.go", "ports.go"},
+	}
+	files := map[string]*domain.AnalyzedFile{
+		"scanner.go":    {Path: "scanner.go", Interfaces: []string{"Scanner"}, Functions: []domain.Function{{Name: "ScanFiles", Exported: true}}},
+		"detector.go":   {Path: "detector.go", Interfaces: []string{"Detection"}},
+		"parser.go":     {Path: "parser.go", Structs: []string{"goParser"}, Functions: []domain.Function{{Name: "ParseSource", Exported: true}}},
+		"ports.go":      {Path: "ports.go", Interfaces: []string{"ScorePort", "Renderer"}},
+		"ports_test.go": {Path: "ports_test.go", Interfaces: []string{"fake"}},
+		"generated.go":  {Path: "generated.go", Interfaces: []string{"Gen"}, IsGenerated: true},
+	}
+
+	profile := defaultProfile()
+	baseline := scoring.ScoreDiscoverability(profile, nil, scan, files).SubMetrics[0]
+
+	profile.NamingRules = []domain.NamingRule{
+		{Name: "er-interfaces", Kind: "interface", Pattern: `(er|Port)$`},
+		{Name: "ports", Kind: "interface", Pattern: `Port$`, Path: "ports.go"},
+	}
+	result := scoring.ScoreDiscoverability(profile, nil, scan, files)
+	naming := result.SubMetrics[0]
+
+	var ruleIssues []domain.Issue
+	for _, iss := range result.Issues {
+		if iss.Pattern == "naming-rule" {
+			ruleIssues = append(ruleIssues, iss)
+		}
+	}
+	require.Len(t, ruleIssues, 2, "Detection breaks er-interfaces; Renderer breaks ports")
+	assert.Equal(t, "detector.go", ruleIssues[0].File)
+	assert.Equal(t, `interface "Detection" does not match naming rule "er-interfaces" ((er|Port)$)`, ruleIssues[0].Message)
+	assert.Equal(t, "naming_uniqueness", ruleIssues[0].SubMetric)
+	assert.Equal(t, "ports.go", ruleIssues[1].File)
+	assert.Contains(t, ruleIssues[1].Message, `"Renderer"`)
+
+	assert.Contains(t, naming.Detail, "4/6 type and file names follow naming rules")
+	assert.Less(t, naming.Score, baseline.Score, "rule violations lower naming_uniqueness")
+}
+
+func TestScoreDiscoverability_DocLinkage(t *testing.T) {
+	modules := []domain.DetectedModule{
+		{Name: "billing", Path: "internal/billing", Layers: []string{"domain"}},
+		{Name: "orders", Path: "internal/orders", Layers: []string{"domain"}},
+		{Name: "ledger", Path: "internal/ledger", Layers: []string{"domain"}},
+	}
+	scan := &domain.ScanResult{
+		AllFiles: []string{"README.md", "docs/design.md", "internal/billing/domain/invoice.go",
+			"internal/orders/domain/order.go", "internal/ledger/domain/entry.go"},
+		Docs: map[string]string{
+			"README.md":      "# Shop\n\nOrders live in `internal/orders`.\nPayments moved from internal/payments/domain.\n",
+			"docs/design.md": "The Billing module issues invoices. Old layout: internal/accounts.\n",
+		},
+	}
+
+	var linkage []domain.Issue
+	for _, iss := range scoring.ScoreDiscoverability(defaultProfile(), modules, scan, nil).Issues {
+		if iss.Pattern == "doc-linkage" {
+			linkage = append(linkage, iss)
+		}
+	}
+	require.Len(t, linkage, 2, "docs/ counts for mentions but is not checked for stale paths")
+	assert.Equal(t, `module "ledger" is not mentioned in README.md, ARCHITECTURE.md or docs/`, linkage[0].Message)
+	assert.Equal(t, "documentation refers to internal/payments, which does not exist", linkage[1].Message)
+	assert.Equal(t, "README.md", linkage[1].File)
+	assert.Equal(t, 4, linkage[1].Line)
+	assert.Equal(t, domain.SeverityInfo, linkage[1].Severity)
+
+	scan.Docs = nil
+	var missing []domain.Issue
+	for _, iss := range scoring.ScoreDiscoverability(defaultProfile(), modules, scan, nil).Issues {
+		if iss.Pattern == "doc-linkage" {
+			missing = append(missing, iss)
+		}
+	}
+	require.Len(t, missing, 1)
+	assert.Equal(t, "no README.md, ARCHITECTURE.md or docs/ describes the project's 3 modules", missing[0].Message)
 }
@@ -0,0 +1,106 @@
+package scoring
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// docPathRef matches a slash-separated path in documentation, such as
+// internal/payments/domain.
+var docPathRef = regexp.MustCompile(`[A-Za-z0-9_.\-]+(?:/[A-Za-z0-9_.\-]+)+`)
+
+// docLinkageIssues checks the project's README.md, ARCHITECTURE.md and
+// docs/ against the detected modules. A module whose name or path appears
+// in none of them is unmentioned. A path under a module root, such as
+// internal/billing, naming a directory that no longer exists is a stale
+// reference; only README.md and ARCHITECTURE.md are checked for those,
+// since docs/ often keeps plans and decision records of past layouts.
+// Without any such document, one issue says so.
+func docLinkageIssues(modules []domain.DetectedModule, scan *domain.ScanResult) []domain.Issue {
+	if scan == nil || len(scan.AllFiles) == 0 || len(modules) == 0 {
+		return nil
+	}
+	if len(scan.Docs) == 0 {
+		return []domain.Issue{domain.Issue{
+			Severity:  domain.SeverityInfo,
+			Category:  "discoverability",
+			SubMetric: "predictable_structure",
+			Pattern:   "doc-linkage",
+		}.WithMessage("predictable_structure.docs_missing", len(modules))}
+	}
+
+	docs := make([]string, 0, len(scan.Docs))
+	for f := range scan.Docs {
+		docs = append(docs, f)
+	}
+	slices.Sort(docs)
+
+	var issues []domain.Issue
+	for _, m := range modules {
+		if !docsMentionModule(scan.Docs, m) {
+			issues = append(issues, domain.Issue{
+				Severity:  domain.SeverityInfo,
+				Category:  "discoverability",
+				SubMetric: "predictable_structure",
+				Pattern:   "doc-linkage",
+			}.WithMessage("predictable_structure.docs_unmentioned", m.Name))
+		}
+	}
+
+	roots := make(map[string]bool)
+	for _, m := range modules {
+		if root, _, ok := strings.Cut(m.Path, "/"); ok {
+			roots[root] = true
+		}
+	}
+	// The entries right below each root: what a reference must name.
+	entries := make(map[string]bool)
+	for _, f := range scan.AllFiles {
+		if parts := strings.SplitN(f, "/", 3); len(parts) >= 2 && roots[parts[0]] {
+			entries[parts[0]+"/"+parts[1]] = true
+		}
+	}
+	for _, doc := range docs {
+		if strings.Contains(doc, "/") {
+			continue
+		}
+		reported := make(map[string]bool)
+		for i, line := range strings.Split(scan.Docs[doc], "\n") {
+			for _, ref := range docPathRef.FindAllString(line, -1) {
+				parts := strings.SplitN(ref, "/", 3)
+				if !roots[parts[0]] || strings.HasPrefix(parts[1], ".") {
+					continue
+				}
+				entry := parts[0] + "/" + strings.TrimRight(parts[1], ".")
+				if entries[entry] || reported[entry] {
+					continue
+				}
+				reported[entry] = true
+				issues = append(issues, domain.Issue{
+					Severity:  domain.SeverityInfo,
+					Category:  "discoverability",
+					SubMetric: "predictable_structure",
+					File:      doc,
+					Line:      i + 1,
+					Pattern:   "doc-linkage",
+				}.WithMessage("predictable_structure.docs_stale", entry))
+			}
+		}
+	}
+	return issues
+}
+
+// docsMentionModule reports whether any document names module m, by its
+// path or by its name as a whole word, ignoring case.
+func docsMentionModule(docs map[string]string, m domain.DetectedModule) bool {
+	name := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(m.Name) + `\b`)
+	for _, content := range docs {
+		if strings.Contains(content, m.Path) || name.MatchString(content) {
+			return true
+		}
+	}
+	return false
+}
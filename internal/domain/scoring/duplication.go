@@ -0,0 +1,141 @@
+package scoring
+
+import (
+	"path"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// hashBase is the Rabin-Karp polynomial base used for token windows.
+const hashBase uint64 = 131
+
+// cloneWindow returns the clone window size in tokens (default 50).
+func cloneWindow(profile *domain.ScoringProfile) int {
+	if profile.MinCloneTokens <= 0 {
+		return 50
+	}
+	return profile.MinCloneTokens
+}
+
+// CloneWindow exports cloneWindow for pipelines that index tokens outside
+// the scorer, such as low-memory mode.
+func CloneWindow(profile *domain.ScoringProfile) int { return cloneWindow(profile) }
+
+// CloneEligible reports whether af takes part in duplication detection:
+// hand-written, with tokens at least one clone window long.
+func CloneEligible(af *domain.AnalyzedFile, tokens []int, windowSize int) bool {
+	return !af.IsGenerated && len(tokens) >= windowSize
+}
+
+// valueTokenBase offsets the text hashes of identifiers and literals kept
+// by the profile past every structural token and placeholder.
+const valueTokenBase = 1000
+
+// DuplicationTokens returns the tokens of af that duplication compares,
+// with the line of each, normalized as the profile's dup_* settings ask:
+// identifier names and literal values replace their placeholders when
+// kept, and imports and test case tables are left out when ignored. With
+// the defaults they are the parser's tokens as is.
+func DuplicationTokens(profile *domain.ScoringProfile, af *domain.AnalyzedFile) (tokens, lines []int) {
+	keepValues := (profile.DupKeepIdentifiers || profile.DupKeepLiterals) && len(af.TokenValues) == len(af.NormalizedTokens)
+	skip := profile.DupIgnoreImports && af.ImportTokens > 0 || profile.DupIgnoreTestTables && len(af.TestTableTokens) > 0
+	if !keepValues && !skip {
+		return af.NormalizedTokens, af.TokenLines
+	}
+
+	skipped := func(i int) bool {
+		if profile.DupIgnoreImports && i < af.ImportTokens {
+			return true
+		}
+		if profile.DupIgnoreTestTables {
+			for _, r := range af.TestTableTokens {
+				if i >= r[0] && i < r[1] {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	hasLines := len(af.TokenLines) == len(af.NormalizedTokens)
+	tokens = make([]int, 0, len(af.NormalizedTokens))
+	for i, tok := range af.NormalizedTokens {
+		if skip && skipped(i) {
+			continue
+		}
+		if keepValues && (tok == -1 && profile.DupKeepIdentifiers || tok < -1 && profile.DupKeepLiterals) {
+			tok = valueTokenBase + af.TokenValues[i]
+		}
+		tokens = append(tokens, tok)
+		if hasLines {
+			lines = append(lines, af.TokenLines[i])
+		}
+	}
+	return tokens, lines
+}
+
+// WindowHashes returns the Rabin-Karp rolling hash of every windowSize-long
+// token window; element i hashes tokens[i : i+windowSize].
+func WindowHashes(tokens []int, windowSize int) []uint64 {
+	if windowSize <= 0 || len(tokens) < windowSize {
+		return nil
+	}
+	hashes := make([]uint64, 0, len(tokens)-windowSize+1)
+
+	var h uint64
+	var basePow uint64 = 1
+	for i := 0; i < windowSize; i++ {
+		h = h*hashBase + uint64(tokens[i]+10) // +10 to avoid negative token issues
+		if i < windowSize-1 {
+			basePow *= hashBase
+		}
+	}
+	hashes = append(hashes, h)
+
+	for i := 1; i <= len(tokens)-windowSize; i++ {
+		removed := uint64(tokens[i-1] + 10)
+		added := uint64(tokens[i+windowSize-1] + 10)
+		h = h*hashBase - removed*basePow*hashBase + added
+		hashes = append(hashes, h)
+	}
+	return hashes
+}
+
+// DuplicatedLines estimates how many lines of a file are covered by the
+// duplicate windows starting at positions. Overlapping windows are merged
+// so tokens are not counted twice; positions are sorted in place.
+func DuplicatedLines(positions []int, tokenCount, totalLines, windowSize int) int {
+	if len(positions) == 0 {
+		return 0
+	}
+	sortInts(positions)
+	covered, maxEnd := 0, 0
+	for _, pos := range positions {
+		end := pos + windowSize
+		if pos >= maxEnd {
+			// Non-overlapping new range.
+			covered += windowSize
+		} else if end > maxEnd {
+			// Partially overlapping — only count the extension.
+			covered += end - maxEnd
+		}
+		if end > maxEnd {
+			maxEnd = end
+		}
+	}
+
+	// Convert covered tokens to lines (conservative: at least 1 token per line).
+	tokensPerLine := float64(tokenCount) / float64(max(1, totalLines))
+	if tokensPerLine < 1 {
+		tokensPerLine = 1
+	}
+	return min(int(float64(covered)/tokensPerLine), totalLines)
+}
+
+// BuildVariants reports whether a and b are alternative implementations
+// selected by build constraints: same directory, both constrained, and
+// constrained differently.
+func BuildVariants(a, b *domain.AnalyzedFile) bool {
+	return a.BuildConstraint != "" && b.BuildConstraint != "" &&
+		a.BuildConstraint != b.BuildConstraint &&
+		path.Dir(a.Path) == path.Dir(b.Path)
+}
@@ -0,0 +1,120 @@
+package scoring
+
+import (
+	"path"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// conventionalAssetDirs are the directory names agents and humans look in
+// first for files compiled into the binary with //go:embed.
+var conventionalAssetDirs = map[string]bool{
+	"templates": true, "template": true, "tmpl": true,
+	"static": true, "assets": true, "public": true, "web": true, "ui": true, "dist": true,
+	"migrations": true, "sql": true, "schema": true, "schemas": true, "queries": true,
+	"testdata": true, "fixtures": true, "data": true,
+	"locales": true, "i18n": true, "docs": true, "embed": true,
+}
+
+// collectEmbedIssues flags //go:embed directives whose assets live outside
+// a conventional directory or whose variable name does not reflect the
+// embedded asset, so that embedded files are discoverable from the code.
+func collectEmbedIssues(scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	var issues []domain.Issue
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated {
+			continue
+		}
+		pkgDir := path.Dir(af.Path)
+		for _, e := range af.Embeds {
+			for _, p := range e.Patterns {
+				dir := embedDir(scan, pkgDir, p)
+				if dir == "" || conventionalAssetDirs[strings.ToLower(dir)] {
+					continue
+				}
+				issues = append(issues, domain.Issue{
+					Severity:  domain.SeverityInfo,
+					Category:  "discoverability",
+					SubMetric: "predictable_structure",
+					File:      af.Path,
+					Line:      e.Line,
+				}.WithMessage("predictable_structure.embed_location", p, dir))
+			}
+			if !embedVarMatches(e) {
+				issues = append(issues, domain.Issue{
+					Severity:  domain.SeverityInfo,
+					Category:  "discoverability",
+					SubMetric: "file_naming_conventions",
+					File:      af.Path,
+					Line:      e.Line,
+				}.WithMessage("file_naming_conventions.embed_var", e.Var, strings.Join(e.Patterns, " ")))
+			}
+		}
+	}
+	return issues
+}
+
+// embedDir returns the top-level directory an embed pattern reads from, or
+// "" when the pattern names files next to the declaring Go file.
+func embedDir(scan *domain.ScanResult, pkgDir, pattern string) string {
+	pattern = strings.TrimPrefix(pattern, "all:")
+	if first, _, ok := strings.Cut(pattern, "/"); ok {
+		return first
+	}
+	if scan == nil || strings.ContainsAny(pattern, "*?[") {
+		return ""
+	}
+	prefix := path.Join(pkgDir, pattern) + "/"
+	for _, f := range scan.AllFiles {
+		if strings.HasPrefix(f, prefix) {
+			return pattern
+		}
+	}
+	return ""
+}
+
+// embedVarMatches reports whether the variable name shares a word with the
+// name or extension of any embedded asset, e.g. schemaSQL for schema.sql or
+// templateFS for templates/. Pure glob patterns such as *.tmpl are accepted.
+func embedVarMatches(e domain.EmbedDirective) bool {
+	varWords := make(map[string]bool)
+	for _, w := range SplitWords(e.Var) {
+		varWords[singular(strings.ToLower(w))] = true
+	}
+	for _, p := range e.Patterns {
+		p = strings.TrimPrefix(p, "all:")
+		if !strings.Contains(p, "/") && strings.ContainsAny(p, "*?[") {
+			return true
+		}
+		for _, w := range assetWords(p) {
+			if varWords[singular(w)] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// assetWords splits the non-glob path elements of a pattern into lowercase
+// words: "templates/email_*.html" yields templates, email and html.
+func assetWords(pattern string) []string {
+	var words []string
+	for _, elem := range strings.Split(pattern, "/") {
+		for _, w := range strings.FieldsFunc(strings.ToLower(elem), func(r rune) bool {
+			return r == '.' || r == '_' || r == '-'
+		}) {
+			if !strings.ContainsAny(w, "*?[]") {
+				words = append(words, w)
+			}
+		}
+	}
+	return words
+}
+
+func singular(w string) string {
+	if len(w) > 3 && strings.HasSuffix(w, "s") {
+		return strings.TrimSuffix(w, "s")
+	}
+	return w
+}
@@ -0,0 +1,60 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreDiscoverability_EmbedHygiene(t *testing.T) {
+	tests := []struct {
+		name     string
+		embed    domain.EmbedDirective
+		files    []string
+		expected []string // sub-metrics of the issues raised
+	}{
+		{
+			name:  "conventional directory with matching name",
+			embed: domain.EmbedDirective{Var: "templateFS", Patterns: []string{"templates/*.html"}},
+		},
+		{
+			name:  "file next to the Go file named after it",
+			embed: domain.EmbedDirective{Var: "schemaSQL", Patterns: []string{"schema.sql"}},
+		},
+		{
+			name:  "pure glob is accepted",
+			embed: domain.EmbedDirective{Var: "pages", Patterns: []string{"*.tmpl"}},
+		},
+		{
+			name:     "unconventional directory",
+			embed:    domain.EmbedDirective{Var: "stuffFS", Patterns: []string{"all:stuff"}},
+			files:    []string{"web/stuff/index.html"},
+			expected: []string{"predictable_structure"},
+		},
+		{
+			name:     "variable does not name the asset",
+			embed:    domain.EmbedDirective{Var: "content", Patterns: []string{"static/app.css"}},
+			expected: []string{"file_naming_conventions"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.embed.Line = 5
+			files := analyzed(&domain.AnalyzedFile{Path: "web/assets.go", Package: "web", Embeds: []domain.EmbedDirective{tt.embed}})
+			scan := &domain.ScanResult{AllFiles: append([]string{"web/assets.go"}, tt.files...)}
+
+			result := scoring.ScoreDiscoverability(defaultProfile(), nil, scan, files)
+
+			var got []string
+			for _, iss := range result.Issues {
+				if iss.File == "web/assets.go" && iss.Line == 5 {
+					got = append(got, iss.SubMetric)
+					assert.Equal(t, domain.SeverityInfo, iss.Severity)
+				}
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package scoring
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// embeddingNode is a struct or interface declared in the module, with the
+// keys of the types it embeds. A key is the package import path and the
+// type name joined by a dot.
+type embeddingNode struct {
+	name   string
+	file   string
+	iface  bool
+	embeds []string
+}
+
+// collectEmbeddingIssues flags structs and interfaces of non-test,
+// non-generated files whose embedding is hard to follow: chains deeper than
+// profile.MaxEmbeddingDepth, where a promoted method is declared several
+// types away, and diamonds, where one type is embedded through two
+// different paths. A struct diamond is a warning because Go silently drops
+// the ambiguous promoted fields and methods; an interface diamond is legal
+// and only informational. Types from other modules end a chain, since their
+// own embedding is not parsed.
+func collectEmbeddingIssues(profile *domain.ScoringProfile, scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	modulePath := ""
+	if scan != nil {
+		modulePath = scan.ModulePath
+	}
+	nodes, keys := buildEmbeddingIndex(modulePath, analyzed)
+	maxDepth := profile.MaxEmbeddingDepth
+	if maxDepth <= 0 {
+		maxDepth = 3
+	}
+
+	chains := make(map[string][]string)
+	var issues []domain.Issue
+	for _, key := range keys {
+		n := nodes[key]
+		pkg := key[:strings.LastIndex(key, ".")]
+		if chain := embeddingChain(nodes, key, chains, map[string]bool{}); len(chain)-1 > maxDepth {
+			names := make([]string, len(chain))
+			for i, k := range chain {
+				names[i] = displayTypeKey(pkg, k)
+			}
+			issues = append(issues, domain.Issue{
+				Severity:  domain.SeverityInfo,
+				Category:  "discoverability",
+				SubMetric: "predictable_structure",
+				File:      n.file,
+				Pattern:   "embedding-depth",
+			}.WithMessage("predictable_structure.embedding_depth", n.name, len(chain)-1, strings.Join(names, " → "), maxDepth))
+		}
+		if shared, a, b, ok := embeddingDiamond(nodes, n); ok {
+			severity := domain.SeverityWarning
+			if n.iface {
+				severity = domain.SeverityInfo
+			}
+			issues = append(issues, domain.Issue{
+				Severity:  severity,
+				Category:  "discoverability",
+				SubMetric: "predictable_structure",
+				File:      n.file,
+				Pattern:   "embedding-diamond",
+			}.WithMessage("predictable_structure.embedding_diamond", n.name,
+				displayTypeKey(pkg, shared), displayTypeKey(pkg, a), displayTypeKey(pkg, b)))
+		}
+	}
+	return issues
+}
+
+// buildEmbeddingIndex indexes the structs and interfaces that embed or may
+// be embedded, returning the nodes and the keys of the embedding types in
+// file order. Build-tag variants of a type keep the first declaration.
+func buildEmbeddingIndex(modulePath string, analyzed map[string]*domain.AnalyzedFile) (map[string]*embeddingNode, []string) {
+	nodes := make(map[string]*embeddingNode)
+	var keys []string
+	add := func(pkg, file, name string, iface bool, embedded []domain.EmbeddedType) {
+		key := pkg + "." + name
+		if nodes[key] != nil {
+			return
+		}
+		n := &embeddingNode{name: name, file: file, iface: iface}
+		for _, et := range embedded {
+			p := et.Package
+			if p == "" {
+				p = pkg
+			}
+			n.embeds = append(n.embeds, p+"."+et.Name)
+		}
+		nodes[key] = n
+		if len(n.embeds) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		pkg := packageImportPath(modulePath, af.Path)
+		for _, sd := range af.StructDefs {
+			add(pkg, af.Path, sd.Name, false, sd.Embedded)
+		}
+		for _, id := range af.InterfaceDefs {
+			add(pkg, af.Path, id.Name, true, id.Embedded)
+		}
+	}
+	return nodes, keys
+}
+
+// embeddingChain returns the longest embedding chain starting at key, key
+// included. Chains are memoized; a type embedding itself through pointers
+// ends the chain where it loops.
+func embeddingChain(nodes map[string]*embeddingNode, key string, memo map[string][]string, visiting map[string]bool) []string {
+	if chain, ok := memo[key]; ok {
+		return chain
+	}
+	n := nodes[key]
+	if n == nil || visiting[key] {
+		return []string{key}
+	}
+	visiting[key] = true
+	var longest []string
+	for _, e := range n.embeds {
+		if chain := embeddingChain(nodes, e, memo, visiting); len(chain) > len(longest) {
+			longest = chain
+		}
+	}
+	delete(visiting, key)
+	chain := append([]string{key}, longest...)
+	memo[key] = chain
+	return chain
+}
+
+// embeddingDiamond reports a type n reaches through two of its direct
+// embeds, with those embeds. The first shared type in key order wins.
+func embeddingDiamond(nodes map[string]*embeddingNode, n *embeddingNode) (shared, a, b string, ok bool) {
+	reach := make([]map[string]bool, len(n.embeds))
+	for i, e := range n.embeds {
+		reach[i] = make(map[string]bool)
+		embeddedClosure(nodes, e, reach[i])
+	}
+	for i := range n.embeds {
+		for j := i + 1; j < len(n.embeds); j++ {
+			if n.embeds[i] == n.embeds[j] {
+				continue
+			}
+			var common []string
+			for k := range reach[i] {
+				if reach[j][k] {
+					common = append(common, k)
+				}
+			}
+			if len(common) > 0 {
+				return slices.Min(common), n.embeds[i], n.embeds[j], true
+			}
+		}
+	}
+	return "", "", "", false
+}
+
+// embeddedClosure adds key and every type it embeds, transitively, to seen.
+func embeddedClosure(nodes map[string]*embeddingNode, key string, seen map[string]bool) {
+	if seen[key] {
+		return
+	}
+	seen[key] = true
+	if n := nodes[key]; n != nil {
+		for _, e := range n.embeds {
+			embeddedClosure(nodes, e, seen)
+		}
+	}
+}
+
+// displayTypeKey renders a type key as code in package pkg refers to it:
+// the bare name for a local type, pkgname.Name otherwise.
+func displayTypeKey(pkg, key string) string {
+	i := strings.LastIndex(key, ".")
+	if key[:i] == pkg {
+		return key[i+1:]
+	}
+	return path.Base(key[:i]) + "." + key[i+1:]
+}
@@ -0,0 +1,85 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func embeds(names ...string) []domain.EmbeddedType {
+	var out []domain.EmbeddedType
+	for _, n := range names {
+		out = append(out, domain.EmbeddedType{Name: n})
+	}
+	return out
+}
+
+func TestCollectEmbeddingIssues_DeepChain(t *testing.T) {
+	scan := &domain.ScanResult{ModulePath: "example.com/app"}
+	files := map[string]*domain.AnalyzedFile{
+		"store/store.go": {Path: "store/store.go", Package: "store", StructDefs: []domain.StructDef{
+			{Name: "Server", Embedded: embeds("Handler")},
+			{Name: "Handler", Embedded: embeds("Base")},
+			{Name: "Base", Embedded: []domain.EmbeddedType{{Package: "example.com/app/core", Name: "Core", Pointer: true}}},
+		}},
+		"core/core.go": {Path: "core/core.go", Package: "core", StructDefs: []domain.StructDef{
+			{Name: "Core", Embedded: []domain.EmbeddedType{{Package: "sync", Name: "Mutex"}}},
+		}},
+		"store/store_test.go": {Path: "store/store_test.go", Package: "store", StructDefs: []domain.StructDef{
+			{Name: "fixture", Embedded: embeds("Server")},
+		}},
+	}
+	p := domain.DefaultProfile()
+
+	issues := collectEmbeddingIssues(&p, scan, files)
+
+	require.Len(t, issues, 1)
+	assert.Equal(t, "embedding-depth", issues[0].Pattern)
+	assert.Equal(t, "store/store.go", issues[0].File)
+	assert.Equal(t, "Server embeds types 4 levels deep (Server → Handler → Base → core.Core → sync.Mutex), more than 3", issues[0].Message)
+
+	p.MaxEmbeddingDepth = 2
+	assert.Len(t, collectEmbeddingIssues(&p, scan, files), 2, "Handler is now too deep as well")
+}
+
+func TestCollectEmbeddingIssues_Diamond(t *testing.T) {
+	files := map[string]*domain.AnalyzedFile{
+		"svc/svc.go": {Path: "svc/svc.go", Package: "svc",
+			StructDefs: []domain.StructDef{
+				{Name: "Service", Embedded: embeds("Reader", "Writer")},
+				{Name: "Reader", Embedded: embeds("conn")},
+				{Name: "Writer", Embedded: embeds("conn")},
+				{Name: "conn"},
+			},
+			InterfaceDefs: []domain.InterfaceDef{
+				{Name: "ReadWriter", Embedded: embeds("Source", "Sink")},
+				{Name: "Source", Embedded: embeds("Closer")},
+				{Name: "Sink", Embedded: embeds("Closer")},
+				{Name: "Closer", Methods: []string{"Close"}},
+			},
+		},
+	}
+	p := domain.DefaultProfile()
+
+	issues := collectEmbeddingIssues(&p, &domain.ScanResult{ModulePath: "example.com/app"}, files)
+
+	require.Len(t, issues, 2)
+	assert.Equal(t, "embedding-diamond", issues[0].Pattern)
+	assert.Equal(t, domain.SeverityWarning, issues[0].Severity)
+	assert.Equal(t, "Service embeds conn through both Reader and Writer, so readers cannot tell which path its members come from", issues[0].Message)
+	assert.Equal(t, domain.SeverityInfo, issues[1].Severity, "interface diamonds are legal")
+	assert.Contains(t, issues[1].Message, "ReadWriter embeds Closer")
+}
+
+func TestCollectEmbeddingIssues_PointerCycleEnds(t *testing.T) {
+	files := map[string]*domain.AnalyzedFile{
+		"list/list.go": {Path: "list/list.go", Package: "list", StructDefs: []domain.StructDef{
+			{Name: "Node", Embedded: []domain.EmbeddedType{{Name: "Node", Pointer: true}}},
+		}},
+	}
+	p := domain.DefaultProfile()
+
+	assert.Empty(t, collectEmbeddingIssues(&p, nil, files))
+}
@@ -0,0 +1,182 @@
+package scoring
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// ExplainCategory recomputes a single category and breaks its score down into
+// formula inputs: per-unit decay credits for code_health sub-metrics, issue
+// counts per sub-metric, and the severity penalty computation.
+func ExplainCategory(profile *domain.ScoringProfile, modules []domain.DetectedModule, scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile, category string) (*domain.Explanation, error) {
+	if profile == nil {
+		p := domain.DefaultProfile()
+		profile = &p
+	}
+
+	var cat domain.CategoryScore
+	var normalizer int
+	switch category {
+	case "code_health":
+		cat = ScoreCodeHealth(profile, scan, analyzed)
+	case "discoverability":
+		cat = ScoreDiscoverability(profile, modules, scan, analyzed)
+		normalizer = countExportedFunctions(analyzed)
+	case "structure":
+		cat = ScoreStructure(profile, modules, scan, analyzed)
+	case "verifiability":
+		cat = ScoreVerifiability(profile, scan, analyzed)
+	case "context_quality":
+		cat = ScoreContextQuality(profile, scan, analyzed)
+	case "predictability":
+		cat = ScorePredictability(profile, modules, scan, analyzed)
+	case "security_hints":
+		cat = ScoreSecurityHints(profile, scan, analyzed)
+	default:
+		return nil, fmt.Errorf("unknown category %q", category)
+	}
+
+	exp := &domain.Explanation{Category: cat.Name, Score: cat.Score, Weight: cat.Weight}
+
+	issueCounts := make(map[string]int)
+	for _, issue := range cat.Issues {
+		issueCounts[issue.SubMetric]++
+	}
+
+	for _, sm := range cat.SubMetrics {
+		exp.BaseScore += sm.Score
+		sme := domain.SubMetricExplanation{
+			Name:    sm.Name,
+			Score:   sm.Score,
+			Points:  sm.Points,
+			Detail:  sm.Detail,
+			Skipped: sm.Skipped,
+			Issues:  issueCounts[sm.Name],
+		}
+		if category == "code_health" {
+			explainDecay(&sme, profile, scan, analyzed)
+		}
+		exp.SubMetrics = append(exp.SubMetrics, sme)
+	}
+
+	// Only code_health and discoverability deduct a severity penalty.
+	switch {
+	case category == "code_health":
+		pb := codeHealthPenalty(profile, cat.Issues, scan, analyzed)
+		exp.Penalty = &pb
+	case normalizer > 0:
+		pb := penaltyBreakdown(profile, cat.Issues, smoothCount(profile, normalizer), nil)
+		exp.Penalty = &pb
+	}
+
+	return exp, nil
+}
+
+// explainDecay fills the formula, totals and per-unit credits for a
+// decay-scored code_health sub-metric. Units with full credit are omitted.
+func explainDecay(sme *domain.SubMetricExplanation, profile *domain.ScoringProfile, scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) {
+	sme.Formula = fmt.Sprintf("round(earned / evaluated * %d); credit = 1 - (value - limit) / (limit * %d), clamped to [0,1]", sme.Points, penaltyModel(profile).DecayK)
+
+	add := func(item domain.CreditItem) {
+		sme.Evaluated++
+		sme.Earned += item.Credit
+		if item.Credit < 1.0 {
+			sme.Items = append(sme.Items, item)
+		}
+	}
+
+	switch sme.Name {
+	case "function_size":
+		for _, af := range sortedFiles(analyzed) {
+			if af.IsGenerated {
+				continue
+			}
+			for _, fn := range af.Functions {
+				lines := fn.LineEnd - fn.LineStart + 1
+				if lines <= 0 {
+					continue
+				}
+				limit := functionSizeLimit(profile, af, fn)
+				add(domain.CreditItem{File: af.Path, Line: fn.LineStart, Symbol: funcSymbol(fn),
+					Value: lines, Limit: limit, Credit: decayCredit(profile, lines, limit)})
+			}
+		}
+	case "file_size":
+		for _, af := range sortedFiles(analyzed) {
+			if af.IsGenerated || af.TotalLines <= 0 {
+				continue
+			}
+			limit := fileSizeLimit(profile, af)
+			add(domain.CreditItem{File: af.Path, Value: af.TotalLines, Limit: limit,
+				Credit: decayCredit(profile, af.TotalLines, limit)})
+		}
+		for _, ff := range foreignFiles(scan) {
+			limit, ok := foreignFileLimit(profile, ff)
+			if !ok || ff.IsGenerated || ff.TotalLines <= 0 {
+				continue
+			}
+			add(domain.CreditItem{File: ff.Path, Value: ff.TotalLines, Limit: limit,
+				Credit: decayCredit(profile, ff.TotalLines, limit)})
+		}
+	case "cognitive_complexity":
+		for _, af := range sortedFiles(analyzed) {
+			if af.IsGenerated {
+				continue
+			}
+			for _, fn := range af.Functions {
+				limit, exempt := complexityLimit(profile, af, fn)
+				credit := 1.0
+				if !exempt {
+					credit = decayCredit(profile, fn.CognitiveComplexity, limit)
+				}
+				add(domain.CreditItem{File: af.Path, Line: fn.LineStart, Symbol: funcSymbol(fn),
+					Value: fn.CognitiveComplexity, Limit: limit, Credit: credit})
+			}
+		}
+	case "parameter_count":
+		for _, af := range sortedFiles(analyzed) {
+			if af.IsGenerated {
+				continue
+			}
+			for _, fn := range af.Functions {
+				limit, exempt := paramLimit(profile, af, fn)
+				credit := 1.0
+				if !exempt {
+					credit = decayCredit(profile, len(fn.Params), limit)
+				}
+				add(domain.CreditItem{File: af.Path, Line: fn.LineStart, Symbol: funcSymbol(fn),
+					Value: len(fn.Params), Limit: limit, Credit: credit})
+			}
+		}
+	case "code_duplication":
+		_, dupMap := scoreCodeDuplication(profile, scan, analyzed)
+		for path, info := range dupMap {
+			limit := duplicationLimit(profile, path)
+			credit := decayCredit(profile, info.percent, limit)
+			if credit < 1.0 {
+				sme.Items = append(sme.Items, domain.CreditItem{File: path, Value: info.percent, Limit: limit, Credit: credit})
+			}
+		}
+		sme.Formula = fmt.Sprintf("round(earned / files * %d); per-file credit decays on duplicated-line %%", sme.Points)
+	}
+
+	sort.Slice(sme.Items, func(i, j int) bool {
+		a, b := sme.Items[i], sme.Items[j]
+		if a.Credit != b.Credit {
+			return a.Credit < b.Credit
+		}
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Line < b.Line
+	})
+}
+
+func funcSymbol(fn domain.Function) string {
+	if fn.Receiver != "" {
+		return fn.Receiver + "." + fn.Name
+	}
+	return fn.Name
+}
@@ -0,0 +1,67 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func TestExplainCategory_UnknownCategory(t *testing.T) {
+	_, err := scoring.ExplainCategory(nil, nil, nil, nil, "tests")
+	assert.Error(t, err)
+}
+
+func TestExplainCategory_CodeHealthMatchesScore(t *testing.T) {
+	profile := domain.DefaultProfile()
+	files := analyzed(
+		makeFile("a.go", 100, makeFunction("Short", 10, 1, 1, 0), makeFunction("Long", 150, 6, 1, 0)),
+		makeFile("b.go", 900, makeFunction("Huge", 300, 2, 1, 0)),
+	)
+
+	exp, err := scoring.ExplainCategory(&profile, nil, nil, files, "code_health")
+	require.NoError(t, err)
+
+	cat := scoring.ScoreCodeHealth(&profile, nil, files)
+	assert.Equal(t, cat.Score, exp.Score)
+	require.NotNil(t, exp.Penalty)
+	assert.Equal(t, 3, exp.Penalty.Normalizer)
+	assert.Equal(t, cat.Score, max(0, exp.BaseScore-exp.Penalty.Penalty))
+
+	var fnSize *domain.SubMetricExplanation
+	for i := range exp.SubMetrics {
+		if exp.SubMetrics[i].Name == "function_size" {
+			fnSize = &exp.SubMetrics[i]
+		}
+	}
+	require.NotNil(t, fnSize)
+	assert.Equal(t, 3, fnSize.Evaluated)
+	assert.NotEmpty(t, fnSize.Formula)
+
+	// Only functions that lost credit are listed, worst first.
+	require.Len(t, fnSize.Items, 2)
+	assert.Equal(t, "Huge", fnSize.Items[0].Symbol)
+	assert.Equal(t, 300, fnSize.Items[0].Value)
+	assert.Equal(t, 50, fnSize.Items[0].Limit)
+	assert.InDelta(t, 0.0, fnSize.Items[0].Credit, 0.001)
+	assert.Equal(t, "Long", fnSize.Items[1].Symbol)
+	assert.InDelta(t, 0.5, fnSize.Items[1].Credit, 0.001)
+
+	// Earned credit reproduces the sub-metric score.
+	sm := subMetricByName(cat, "function_size")
+	require.NotNil(t, sm)
+	assert.Equal(t, sm.Score, int(fnSize.Earned/float64(fnSize.Evaluated)*float64(sm.Points)+0.5))
+}
+
+func TestExplainCategory_NoPenaltyForUnpenalizedCategories(t *testing.T) {
+	profile := domain.DefaultProfile()
+	files := analyzed(makeFile("a.go", 10, makeFunction("Run", 5, 0, 0, 0)))
+
+	exp, err := scoring.ExplainCategory(&profile, nil, &domain.ScanResult{}, files, "context_quality")
+	require.NoError(t, err)
+	assert.Nil(t, exp.Penalty)
+	assert.Len(t, exp.SubMetrics, 4)
+}
@@ -0,0 +1,35 @@
+package scoring
+
+import (
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// maxListedUnused bounds the symbols named in an over-exported issue.
+const maxListedUnused = 5
+
+// overExposureIssues flags internal packages whose only importer leaves
+// part of their exported API unused, one info issue per package. Every
+// exported symbol is a completion candidate and a name agents have to
+// rule out; the ones nobody outside the package needs could be unexported.
+func overExposureIssues(scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	if scan == nil {
+		return nil
+	}
+	var issues []domain.Issue
+	for _, pe := range domain.BuildExposureReport(scan.ModulePath, analyzed) {
+		names := strings.Join(pe.Unused[:min(len(pe.Unused), maxListedUnused)], ", ")
+		if len(pe.Unused) > maxListedUnused {
+			names += ", ..."
+		}
+		issues = append(issues, domain.Issue{
+			Severity:  domain.SeverityInfo,
+			Category:  "discoverability",
+			SubMetric: "naming_uniqueness",
+			File:      pe.Package,
+			Pattern:   "over-exported",
+		}.WithMessage("naming_uniqueness.over_exported", pe.Package, pe.Importer, len(pe.Unused), pe.Exported, names))
+	}
+	return issues
+}
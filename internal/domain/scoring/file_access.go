@@ -0,0 +1,54 @@
+package scoring
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// worldWritable is the permission bit that lets every user on the host
+// write to a file or directory.
+const worldWritable = 0o002
+
+// collectFileAccessIssues warns about file I/O in non-test, non-generated
+// files, which in a hexagonal layout lives in the outbound adapters: files
+// and directories created or chmod-ed world-writable, and paths joined
+// from request input that may climb out of the base directory with "..".
+func collectFileAccessIssues(analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	var issues []domain.Issue
+	for _, af := range sortedFiles(analyzed) {
+		issues = append(issues, fileAccessIssues(af)...)
+	}
+	return issues
+}
+
+// fileAccessIssues returns the file_access issues of af.
+func fileAccessIssues(af *domain.AnalyzedFile) []domain.Issue {
+	if af.IsGenerated || isTestFile(af.Path) {
+		return nil
+	}
+	var issues []domain.Issue
+	iss := domain.Issue{
+		Severity:  domain.SeverityWarning,
+		Category:  "security_hints",
+		SubMetric: "file_access",
+		File:      af.Path,
+	}
+	for _, fm := range af.FileModes {
+		if fm.Mode&worldWritable == 0 {
+			continue
+		}
+		iss.Line, iss.Pattern = fm.Line, "permissive-mode"
+		what := "file"
+		if strings.Contains(fm.Call, "Mkdir") {
+			what = "directory"
+		}
+		issues = append(issues, iss.WithMessage("file_access.permissive_mode", fm.Call, fmt.Sprintf("%#o", fm.Mode), what))
+	}
+	for _, pj := range af.PathJoins {
+		iss.Line, iss.Pattern = pj.Line, "path-traversal"
+		issues = append(issues, iss.WithMessage("file_access.path_traversal", strings.Join(pj.Inputs, ", ")))
+	}
+	return issues
+}
@@ -0,0 +1,35 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectFileAccessIssues(t *testing.T) {
+	files := map[string]*domain.AnalyzedFile{
+		"internal/adapters/outbound/store/files.go": {Path: "internal/adapters/outbound/store/files.go",
+			FileModes: []domain.FileMode{
+				{Call: "os.WriteFile", Mode: 0o644, Line: 10},
+				{Call: "os.OpenFile", Mode: 0o666, Line: 14},
+				{Call: "os.MkdirAll", Mode: 0o777, Line: 18},
+			},
+			PathJoins: []domain.PathJoin{{Inputs: []string{`r.URL.Query().Get("file")`}, Line: 25}},
+		},
+		"internal/adapters/outbound/store/files_test.go": {Path: "internal/adapters/outbound/store/files_test.go",
+			FileModes: []domain.FileMode{{Call: "os.WriteFile", Mode: 0o666, Line: 3}},
+		},
+	}
+
+	issues := collectFileAccessIssues(files)
+
+	require.Len(t, issues, 3)
+	assert.Equal(t, "os.OpenFile with mode 0666 makes the file writable by every user on the host", issues[0].Message)
+	assert.Equal(t, "permissive-mode", issues[0].Pattern)
+	assert.Equal(t, "os.MkdirAll with mode 0777 makes the directory writable by every user on the host", issues[1].Message)
+	assert.Equal(t, "path-traversal", issues[2].Pattern)
+	assert.Equal(t, 25, issues[2].Line)
+	assert.Equal(t, "file_access", issues[2].SubMetric)
+}
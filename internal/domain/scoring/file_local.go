@@ -0,0 +1,24 @@
+package scoring
+
+// fileLocalSubMetrics are the sub-metrics whose issues in a file follow
+// from that file's analysis alone, given the project's modules: scoring the
+// file by itself finds the same issues in it as scoring the whole project.
+// Duplication, naming and the other sub-metrics compare files with each
+// other and are left out.
+var fileLocalSubMetrics = map[string]bool{
+	"function_size":        true,
+	"file_size":            true,
+	"cognitive_complexity": true,
+	"parameter_count":      true,
+	"dependency_direction": true, // the layer violations of a file's imports
+	"hardcoded_secrets":    true,
+	"sql_injection":        true,
+	"file_access":          true,
+}
+
+// FileLocal reports whether the issues subMetric reports in a file depend
+// on that file alone, so that an edit to the file can be rescored without
+// the rest of the project.
+func FileLocal(subMetric string) bool {
+	return fileLocalSubMetrics[subMetric]
+}
@@ -0,0 +1,62 @@
+package scoring
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// TallyFile tallies af for the file-local sub-metrics of code_health and
+// security_hints. Low-memory runs tally each file as it is parsed and
+// release the analysis only those sub-metrics read (see ReleaseTallied);
+// the scorers then sum scan.FileTallies instead of reading the files.
+func TallyFile(profile *domain.ScoringProfile, af *domain.AnalyzedFile) domain.FileTally {
+	t := codeHealthTally(profile, af)
+	t.Issues = append(t.Issues, securityHintIssues(profile, af)...)
+	return t
+}
+
+// ReleaseTallied drops the parts of af that only the tallied sub-metrics
+// read: the secret candidates, query calls, file modes and path joins.
+func ReleaseTallied(af *domain.AnalyzedFile) {
+	af.SecretCandidates, af.SQLCalls, af.FileModes, af.PathJoins = nil, nil, nil, nil
+}
+
+// fileTallies returns scan.FileTallies when low-memory mode recorded them,
+// and otherwise tallies every analyzed file with tally.
+func fileTallies(scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile, tally func(*domain.AnalyzedFile) domain.FileTally) map[string]domain.FileTally {
+	if scan != nil && scan.FileTallies != nil {
+		return scan.FileTallies
+	}
+	tallies := make(map[string]domain.FileTally, len(analyzed))
+	for _, af := range analyzed {
+		tallies[af.Path] = tally(af)
+	}
+	return tallies
+}
+
+// sumCredit adds up one decay sub-metric over the tallies in path order,
+// so the floating-point sum is the same on every run.
+func sumCredit(tallies map[string]domain.FileTally, credit func(domain.FileTally) domain.DecayCredit) (float64, int) {
+	earned, total := 0.0, 0
+	for _, path := range slices.Sorted(maps.Keys(tallies)) {
+		c := credit(tallies[path])
+		earned += c.Earned
+		total += c.Total
+	}
+	return earned, total
+}
+
+// tallyIssues returns the issues of subMetric in the tallies, in path order.
+func tallyIssues(tallies map[string]domain.FileTally, subMetric string) []domain.Issue {
+	var issues []domain.Issue
+	for _, path := range slices.Sorted(maps.Keys(tallies)) {
+		for _, issue := range tallies[path].Issues {
+			if issue.SubMetric == subMetric {
+				issues = append(issues, issue)
+			}
+		}
+	}
+	return issues
+}
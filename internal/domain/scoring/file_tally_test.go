@@ -0,0 +1,31 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTallyFile_ScoresLikeTheAnalyzedFiles(t *testing.T) {
+	p := domain.DefaultProfile()
+	long := makeFile("handler.go", 900, makeFunction("Handle", 120, 7, 4, 3), makeFunction("ok", 10, 1, 1, 0))
+	long.SecretCandidates = []domain.SecretCandidate{{Name: "apiToken", Kind: "credential", Line: 3}}
+	long.SQLCalls = []domain.SQLCall{{Method: "Query", Dynamic: []string{"name"}, Line: 9}}
+	files := analyzed(long, makeFile("small.go", 40, makeFunction("Small", 12, 2, 1, 0)))
+	scan := &domain.ScanResult{}
+
+	health := scoring.ScoreCodeHealth(&p, scan, files)
+	security := scoring.ScoreSecurityHints(&p, scan, files)
+
+	scan.FileTallies = make(map[string]domain.FileTally)
+	for path, af := range files {
+		scan.FileTallies[path] = scoring.TallyFile(&p, af)
+		scoring.ReleaseTallied(af)
+	}
+	assert.Nil(t, long.SecretCandidates)
+	assert.Equal(t, health, scoring.ScoreCodeHealth(&p, scan, files))
+	assert.Equal(t, security, scoring.ScoreSecurityHints(&p, scan, files))
+	assert.Len(t, security.Issues, 2)
+}
@@ -0,0 +1,156 @@
+package scoring
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// checkFileTypes checks that file names and the types they declare agree,
+// in both directions. A file with one of the profile's expected suffixes
+// (order_service.go) that declares types or methods should declare the type
+// its name promises: OrderService, Service, or a type mentioning the stem
+// (OrderRepository in order_ports.go). A big type, whose methods in its
+// package span at least half of profile.MaxFileLines, should be declared in
+// a file reflecting its name, or in the file named after its package.
+// Generated and test files are skipped.
+func checkFileTypes(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	files := sortedFiles(analyzed)
+
+	methodLines := make(map[string]int) // dir + "." + type
+	for _, af := range files {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		for _, fn := range af.Functions {
+			if t := receiverType(fn.Receiver); t != "" {
+				methodLines[filepath.Dir(af.Path)+"."+t] += fn.LineEnd - fn.LineStart + 1
+			}
+		}
+	}
+
+	bigType := max(profile.MaxFileLines/2, 1)
+	for _, af := range files {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		base := filepath.Base(af.Path)
+		name := strings.TrimSuffix(base, ".go")
+		types := declaredTypes(af)
+
+		if stem, suffix, ok := expectedSuffix(name, profile.ExpectedFileSuffixes); ok && len(types) > 0 {
+			check.checked++
+			if !slices.ContainsFunc(types, func(t string) bool { return typeMatchesFile(t, stem, suffix) }) {
+				check.issues = append(check.issues, fileTypeIssue(af.Path, "file-type-missing").
+					WithMessage("predictable_structure.file_type_missing", base, pascalCase(name), strings.Join(types, ", ")))
+			}
+		}
+
+		for _, t := range append(append([]string(nil), af.Structs...), af.Interfaces...) {
+			lines := methodLines[filepath.Dir(af.Path)+"."+t]
+			if lines < bigType {
+				continue
+			}
+			check.checked++
+			if !fileReflectsType(name, af.Package, t) {
+				check.issues = append(check.issues, fileTypeIssue(af.Path, "type-file-mismatch").
+					WithMessage("predictable_structure.type_file_mismatch", t, lines, base, snakeCase(t)+".go"))
+			}
+		}
+	}
+	return check
+}
+
+func fileTypeIssue(file, pattern string) domain.Issue {
+	return domain.Issue{
+		Severity:  domain.SeverityInfo,
+		Category:  "discoverability",
+		SubMetric: "predictable_structure",
+		File:      file,
+		Pattern:   pattern,
+	}
+}
+
+// declaredTypes returns the types af declares or defines methods on, in
+// declaration order without duplicates.
+func declaredTypes(af *domain.AnalyzedFile) []string {
+	types := append(append([]string(nil), af.Structs...), af.Interfaces...)
+	for _, fn := range af.Functions {
+		if t := receiverType(fn.Receiver); t != "" && !slices.Contains(types, t) {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+// receiverType strips the pointer and type parameters from a method
+// receiver: *Store[K, V] gives Store.
+func receiverType(recv string) string {
+	recv = strings.TrimPrefix(recv, "*")
+	if i := strings.IndexByte(recv, '['); i >= 0 {
+		recv = recv[:i]
+	}
+	return recv
+}
+
+// expectedSuffix splits a file name such as order_service into its stem
+// and one of the expected suffixes. A bare suffix file (service.go) has no
+// stem and is not split.
+func expectedSuffix(name string, suffixes []string) (stem, suffix string, ok bool) {
+	for _, s := range suffixes {
+		if stem, ok := strings.CutSuffix(name, s); ok && stem != "" {
+			return stem, strings.TrimPrefix(s, "_"), true
+		}
+	}
+	return "", "", false
+}
+
+// typeMatchesFile reports whether type t is one a file named stem_suffix
+// promises: the full name, the suffix alone (the package already says the
+// stem) or any type mentioning the stem.
+func typeMatchesFile(t, stem, suffix string) bool {
+	lower := strings.ToLower(t)
+	stem = strings.ReplaceAll(stem, "_", "")
+	return lower == stem+suffix || lower == suffix || strings.Contains(lower, stem)
+}
+
+// fileReflectsType reports whether a file named name in package pkg is a
+// fitting home for type t: the names contain one another once underscores
+// and case are ignored (store.go for FileStore, order_service.go for
+// OrderService), or the file is named after the package.
+func fileReflectsType(name, pkg, t string) bool {
+	file := strings.ToLower(strings.ReplaceAll(name, "_", ""))
+	lower := strings.ToLower(t)
+	return strings.Contains(lower, file) || strings.Contains(file, lower) || name == pkg
+}
+
+// pascalCase converts a snake_case file name to the type name it suggests:
+// order_service gives OrderService.
+func pascalCase(name string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(name, "_") {
+		if word != "" {
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return b.String()
+}
+
+// snakeCase converts a type name to the file name it suggests, keeping
+// acronyms together: HTTPServer gives http_server.
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
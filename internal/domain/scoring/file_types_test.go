@@ -0,0 +1,75 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeMatchesFile(t *testing.T) {
+	tests := []struct {
+		typ, stem, suffix string
+		want              bool
+	}{
+		{"OrderService", "order", "service", true},
+		{"Service", "order", "service", true},
+		{"OrderRepository", "order", "ports", true},
+		{"LineItemService", "line_item", "service", true},
+		{"Invoice", "order", "service", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, typeMatchesFile(tt.typ, tt.stem, tt.suffix), tt.typ)
+	}
+}
+
+func TestFileReflectsType(t *testing.T) {
+	assert.True(t, fileReflectsType("store", "cache", "FileStore"))
+	assert.True(t, fileReflectsType("order_service", "order", "OrderService"))
+	assert.True(t, fileReflectsType("cache", "cache", "Store"), "the package's own file may hold any type")
+	assert.False(t, fileReflectsType("types", "cache", "Store"))
+}
+
+func TestSnakeAndPascalCase(t *testing.T) {
+	assert.Equal(t, "http_server", snakeCase("HTTPServer"))
+	assert.Equal(t, "order_service", snakeCase("OrderService"))
+	assert.Equal(t, "OrderService", pascalCase("order_service"))
+}
+
+func TestCheckFileTypes(t *testing.T) {
+	files := map[string]*domain.AnalyzedFile{
+		"order/order_service.go":  {Path: "order/order_service.go", Package: "order", Structs: []string{"OrderService"}},
+		"order/refund_service.go": {Path: "order/refund_service.go", Package: "order", Structs: []string{"Invoice"}},
+		"order/order_errors.go":   {Path: "order/order_errors.go", Package: "order"},
+		"order/types.go":          {Path: "order/types.go", Package: "order", Structs: []string{"Ledger"}},
+		"order/ledger_ops.go": {Path: "order/ledger_ops.go", Package: "order", Functions: []domain.Function{
+			{Name: "Post", Receiver: "*Ledger", LineStart: 1, LineEnd: 100},
+			{Name: "Void", Receiver: "*Ledger", LineStart: 101, LineEnd: 160},
+		}},
+		"order/refund_service_test.go": {Path: "order/refund_service_test.go", Package: "order", Structs: []string{"fake"}},
+	}
+	profile := domain.DefaultProfile()
+	profile.MaxFileLines = 300
+
+	check := checkFileTypes(&profile, files)
+	assert.Equal(t, 3, check.checked, "two suffixed files with types and one big type")
+	require.Len(t, check.issues, 2)
+	assert.Equal(t, "file-type-missing", check.issues[0].Pattern)
+	assert.Equal(t, `file "refund_service.go" does not declare RefundService; it declares Invoice`, check.issues[0].Message)
+	assert.Equal(t, "type-file-mismatch", check.issues[1].Pattern)
+	assert.Equal(t, `type Ledger has 160 lines of methods but is declared in "types.go"; move it to ledger.go`, check.issues[1].Message)
+	assert.Equal(t, "predictable_structure", check.issues[1].SubMetric)
+}
+
+func TestScoreDiscoverability_FileTypesBlendIntoPredictableStructure(t *testing.T) {
+	files := map[string]*domain.AnalyzedFile{
+		"order/refund_service.go": {Path: "order/refund_service.go", Package: "order", Structs: []string{"Invoice"}},
+	}
+	result := ScoreDiscoverability(nil, nil, nil, files)
+
+	sm := result.SubMetrics[2]
+	assert.Equal(t, "predictable_structure", sm.Name)
+	assert.Less(t, sm.Score, sm.Points)
+	assert.Contains(t, sm.Detail, "0/1 file names match the types they declare")
+}
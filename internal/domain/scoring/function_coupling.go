@@ -0,0 +1,145 @@
+package scoring
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// predeclaredCallees are Go's builtin functions and predeclared types;
+// calling them (or converting to them) couples a function to nothing.
+var predeclaredCallees = map[string]bool{
+	"append": true, "cap": true, "clear": true, "close": true, "complex": true,
+	"copy": true, "delete": true, "imag": true, "len": true, "make": true,
+	"max": true, "min": true, "new": true, "panic": true, "print": true,
+	"println": true, "real": true, "recover": true,
+	"any": true, "bool": true, "byte": true, "complex64": true, "complex128": true,
+	"error": true, "float32": true, "float64": true, "int": true, "int8": true,
+	"int16": true, "int32": true, "int64": true, "rune": true, "string": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"uintptr": true,
+}
+
+// fanOut counts the distinct functions and methods fn calls, in or outside
+// the module, ignoring builtins and conversions to predeclared types.
+func fanOut(fn domain.Function) int {
+	n := 0
+	for _, c := range fn.Calls {
+		if c.Package == "" && c.Receiver == "" && !c.Method && predeclaredCallees[c.Name] {
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+// fanOutLimit returns the fan-out threshold applied to fn and whether fn is
+// exempt. Tests and func main, the composition root that wires everything
+// together, are exempt.
+func fanOutLimit(profile *domain.ScoringProfile, af *domain.AnalyzedFile, fn domain.Function) (int, bool) {
+	if isTestFile(af.Path) || (af.Package == "main" && fn.Name == "main" && fn.Receiver == "") {
+		return 0, true
+	}
+	if profile.MaxFunctionFanOut > 0 {
+		return profile.MaxFunctionFanOut, false
+	}
+	return 20, false
+}
+
+// hubFanIn returns the caller count at which a function with a wide
+// fan-out is flagged as a hub.
+func hubFanIn(profile *domain.ScoringProfile) int {
+	if profile.HubFanIn > 0 {
+		return profile.HubFanIn
+	}
+	return 10
+}
+
+// scoreFunctionCoupling (15 pts): continuous decay from profile.MaxFunctionFanOut
+// on the distinct callees of each function.
+func scoreFunctionCoupling(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
+	sm := domain.SubMetric{Name: "function_coupling", Points: 15}
+
+	total, earned, limit := 0, 0.0, 0
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated {
+			continue
+		}
+		for _, fn := range af.Functions {
+			fnLimit, exempt := fanOutLimit(profile, af, fn)
+			if exempt {
+				continue
+			}
+			total++
+			limit = fnLimit
+			earned += decayCredit(profile, fanOut(fn), fnLimit)
+		}
+	}
+	if total == 0 {
+		sm.Score = sm.Points
+		sm.Detail = "no functions to evaluate"
+		return sm
+	}
+
+	ratio := smoothRatio(profile, earned, total)
+	sm.Score = int(math.Round(ratio * float64(sm.Points)))
+	sm.Score = min(sm.Score, sm.Points)
+	sm.Detail = fmt.Sprintf("%.0f%% of %d functions within fan-out limits (max %d callees)", earned/float64(total)*100, total, limit) +
+		smoothingNote(profile, earned, total)
+	return sm
+}
+
+// functionCouplingIssues flags functions calling more distinct functions
+// than allowed, and hubs: functions many module functions depend on that
+// themselves fan out widely, so a change to them ripples in both
+// directions. Fan-in comes from the call graph and needs a module path.
+func functionCouplingIssues(profile *domain.ScoringProfile, scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	var graph *CallGraph
+	modulePath := ""
+	if scan != nil {
+		modulePath = scan.ModulePath
+		graph = BuildCallGraph(modulePath, analyzed)
+	}
+	minFanIn := hubFanIn(profile)
+
+	var issues []domain.Issue
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated {
+			continue
+		}
+		for _, fn := range af.Functions {
+			limit, exempt := fanOutLimit(profile, af, fn)
+			if exempt {
+				continue
+			}
+			out := fanOut(fn)
+			if out > limit {
+				issues = append(issues, domain.Issue{
+					Severity:  issueSeverity(out, limit),
+					Category:  "discoverability",
+					SubMetric: "function_coupling",
+					File:      af.Path,
+					Line:      fn.LineStart,
+					Pattern:   funcPattern(fn.Name),
+				}.WithMessage("function_coupling.fan_out", fn.Name, out, limit))
+			}
+			if graph == nil || out <= limit/2 {
+				continue
+			}
+			id := FunctionID(packageImportPath(modulePath, af.Path), strings.TrimPrefix(fn.Receiver, "*"), fn.Name)
+			if node := graph.Functions[id]; node != nil && len(node.CalledBy) >= minFanIn {
+				issues = append(issues, domain.Issue{
+					Severity:  domain.SeverityWarning,
+					Category:  "discoverability",
+					SubMetric: "function_coupling",
+					File:      af.Path,
+					Line:      fn.LineStart,
+					Pattern:   "hub",
+				}.WithMessage("function_coupling.hub", fn.Name, len(node.CalledBy), out))
+			}
+		}
+	}
+	return issues
+}
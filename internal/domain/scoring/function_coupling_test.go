@@ -0,0 +1,108 @@
+package scoring
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func callsTo(pkg string, n int) []domain.Call {
+	calls := make([]domain.Call, n)
+	for i := range calls {
+		calls[i] = domain.Call{Name: fmt.Sprintf("F%d", i), Package: pkg}
+	}
+	return calls
+}
+
+func couplingFiles(files ...*domain.AnalyzedFile) map[string]*domain.AnalyzedFile {
+	m := make(map[string]*domain.AnalyzedFile, len(files))
+	for _, f := range files {
+		m[f.Path] = f
+	}
+	return m
+}
+
+func couplingFile(path string, fns ...domain.Function) *domain.AnalyzedFile {
+	return &domain.AnalyzedFile{Path: path, Functions: fns}
+}
+
+func TestFanOut_IgnoresBuiltins(t *testing.T) {
+	fn := domain.Function{Calls: []domain.Call{
+		{Name: "len"}, {Name: "string"}, {Name: "append"},
+		{Name: "helper"}, {Name: "Errorf", Package: "fmt"}, {Name: "len", Method: true},
+	}}
+	assert.Equal(t, 3, fanOut(fn))
+}
+
+func TestScoreFunctionCoupling(t *testing.T) {
+	profile := domain.DefaultProfile()
+	profile.MaxFunctionFanOut = 10
+
+	tests := []struct {
+		name  string
+		calls int
+		want  int
+	}{
+		{"within limit", 10, 15},
+		{"slightly over", 12, 14},  // credit 1 - 2/40 = 0.95
+		{"far over", 50, 0},        // credit clamps at 0
+		{"moderately over", 30, 8}, // credit 1 - 20/40 = 0.5
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sm := scoreFunctionCoupling(&profile, couplingFiles(
+				couplingFile("svc/service.go", domain.Function{Name: "Run", Calls: callsTo("fmt", tt.calls)}),
+			))
+			assert.Equal(t, "function_coupling", sm.Name)
+			assert.Equal(t, tt.want, sm.Score)
+		})
+	}
+}
+
+func TestScoreFunctionCoupling_ExemptsTestsAndMain(t *testing.T) {
+	profile := domain.DefaultProfile()
+	main := couplingFile("main.go", domain.Function{Name: "main", Calls: callsTo("fmt", 80)})
+	main.Package = "main"
+	files := couplingFiles(
+		main,
+		couplingFile("svc/service_test.go", domain.Function{Name: "TestRun", Calls: callsTo("fmt", 80)}),
+	)
+
+	sm := scoreFunctionCoupling(&profile, files)
+	assert.Equal(t, sm.Points, sm.Score)
+	assert.Empty(t, functionCouplingIssues(&profile, &domain.ScanResult{ModulePath: "example.com/app"}, files))
+}
+
+func TestFunctionCouplingIssues(t *testing.T) {
+	mod := "example.com/app"
+	profile := domain.DefaultProfile()
+	profile.MaxFunctionFanOut = 4
+	profile.HubFanIn = 3
+
+	hub := domain.Function{Name: "Dispatch", LineStart: 7, Calls: callsTo("fmt", 3)}
+	wide := domain.Function{Name: "Wide", LineStart: 20, Calls: callsTo("fmt", 13)}
+	var callers []domain.Function
+	for i := range 3 {
+		callers = append(callers, domain.Function{Name: fmt.Sprintf("caller%d", i), Calls: []domain.Call{{Name: "Dispatch", Package: mod + "/core"}}})
+	}
+	files := couplingFiles(
+		couplingFile("core/core.go", hub, wide),
+		couplingFile("api/api.go", callers...),
+	)
+
+	issues := functionCouplingIssues(&profile, &domain.ScanResult{ModulePath: mod}, files)
+	require.Len(t, issues, 2)
+
+	for _, issue := range issues {
+		assert.Equal(t, "discoverability", issue.Category)
+		assert.Equal(t, "function_coupling", issue.SubMetric)
+		assert.Equal(t, "core/core.go", issue.File)
+	}
+	assert.Equal(t, "function Dispatch is a hub: called by 3 functions and calls 3", issues[0].Message)
+	assert.Equal(t, domain.SeverityWarning, issues[0].Severity)
+	assert.Equal(t, "function Wide calls 13 distinct functions (>4)", issues[1].Message)
+	assert.Equal(t, domain.SeverityError, issues[1].Severity)
+}
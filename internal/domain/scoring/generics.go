@@ -0,0 +1,43 @@
+package scoring
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// isGenericHelper reports whether fn is a generic helper built around its
+// type parameters, such as Map[T, U any](s []T, f func(T) U) []U: a
+// package-level function at least half of whose parameters are typed with
+// its type parameters. Helpers like Map, Filter and Keys are short,
+// single-word and repeated across packages by design, so naming_uniqueness
+// does not penalize them.
+func isGenericHelper(fn domain.Function) bool {
+	if len(fn.TypeParams) == 0 || fn.Receiver != "" || len(fn.Params) == 0 {
+		return false
+	}
+	typed := 0
+	for _, p := range fn.Params {
+		if mentionsTypeParam(p.Type, fn.TypeParams) {
+			typed++
+		}
+	}
+	return typed*2 >= len(fn.Params)
+}
+
+// mentionsTypeParam reports whether type expression t names one of params
+// as a whole identifier.
+func mentionsTypeParam(t string, params []domain.TypeParam) bool {
+	idents := strings.FieldsFunc(t, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	for _, id := range idents {
+		for _, tp := range params {
+			if id == tp.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsGenericHelper(t *testing.T) {
+	anyT := []domain.TypeParam{{Name: "T", Constraint: "any"}}
+	tests := []struct {
+		name string
+		fn   domain.Function
+		want bool
+	}{
+		{"map helper", domain.Function{Name: "Map", TypeParams: []domain.TypeParam{{Name: "T"}, {Name: "U"}},
+			Params: []domain.Param{{Name: "s", Type: "[]T"}, {Name: "f", Type: "func"}}}, true},
+		{"keys helper", domain.Function{Name: "Keys", TypeParams: []domain.TypeParam{{Name: "K"}, {Name: "V"}},
+			Params: []domain.Param{{Name: "m", Type: "map[K]V"}}}, true},
+		{"mostly concrete params", domain.Function{Name: "Load", TypeParams: anyT,
+			Params: []domain.Param{{Name: "ctx", Type: "context.Context"}, {Name: "path", Type: "string"}, {Name: "v", Type: "*T"}}}, false},
+		{"type param only in a longer name", domain.Function{Name: "Parse", TypeParams: anyT,
+			Params: []domain.Param{{Name: "s", Type: "Text"}}}, false},
+		{"not generic", domain.Function{Name: "Map", Params: []domain.Param{{Name: "s", Type: "[]string"}}}, false},
+		{"method", domain.Function{Name: "Map", Receiver: "*List", TypeParams: anyT, Params: []domain.Param{{Name: "s", Type: "[]T"}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isGenericHelper(tt.fn))
+		})
+	}
+}
+
+func TestScoreDiscoverability_GenericHelpersExemptFromNamingUniqueness(t *testing.T) {
+	helper := func(pkg string) *domain.AnalyzedFile {
+		return &domain.AnalyzedFile{
+			Path:    pkg + "/slices.go",
+			Package: pkg,
+			Functions: []domain.Function{{
+				Name: "Filter", Exported: true, LineStart: 3,
+				TypeParams: []domain.TypeParam{{Name: "T", Constraint: "any"}},
+				Params:     []domain.Param{{Name: "s", Type: "[]T"}, {Name: "f", Type: "func"}},
+			}},
+		}
+	}
+	analyzed := map[string]*domain.AnalyzedFile{
+		"a/slices.go": helper("a"),
+		"b/slices.go": helper("b"),
+	}
+
+	profile := domain.DefaultProfile()
+	result := ScoreDiscoverability(&profile, nil, nil, analyzed)
+	for _, iss := range result.Issues {
+		assert.NotEqual(t, "naming_uniqueness", iss.SubMetric, iss.Message)
+	}
+}
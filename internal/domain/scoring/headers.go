@@ -0,0 +1,39 @@
+package scoring
+
+import (
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// checkHeaders matches the header of every non-generated file against the
+// pattern the config's headers section requires of it. Each file with a
+// required pattern counts as one checked item; a file whose header does
+// not match gets one issue.
+func checkHeaders(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	if profile.Headers == nil {
+		return check
+	}
+	patterns := domain.CompileHeaderPatterns(profile.Headers)
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated {
+			continue
+		}
+		re := patterns[profile.Headers.PatternFor(af.Path)]
+		if re == nil {
+			continue
+		}
+		check.checked++
+		if re.MatchString(af.Header) {
+			continue
+		}
+		check.issues = append(check.issues, domain.Issue{
+			Severity:  domain.SeverityWarning,
+			Category:  "predictability",
+			SubMetric: "consistent_patterns",
+			File:      af.Path,
+			Line:      1,
+			Pattern:   "license-header",
+		}.WithMessage("consistent_patterns.header_missing", re.String()))
+	}
+	return check
+}
@@ -0,0 +1,41 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckHeaders(t *testing.T) {
+	const license = "// Copyright 2025 Acme Inc.\n// SPDX-License-Identifier: Apache-2.0\n\n"
+	profile := domain.DefaultProfile()
+	profile.Headers = &domain.HeaderConfig{
+		Pattern:   `^// Copyright \d{4} Acme Inc\.\n// SPDX-License-Identifier: Apache-2\.0\n`,
+		Overrides: []domain.HeaderOverride{{Path: "third_party/**"}},
+	}
+	analyzed := map[string]*domain.AnalyzedFile{
+		"internal/app/app.go":      {Path: "internal/app/app.go", Header: license},
+		"internal/app/util.go":     {Path: "internal/app/util.go", Header: "// Package app does things.\n"},
+		"internal/app/gen.pb.go":   {Path: "internal/app/gen.pb.go", IsGenerated: true},
+		"third_party/lib/lib.go":   {Path: "third_party/lib/lib.go"},
+		"internal/app/app_test.go": {Path: "internal/app/app_test.go", Header: "//go:build unit\n\n" + license},
+	}
+
+	check := checkHeaders(&profile, analyzed)
+
+	assert.Equal(t, 3, check.checked)
+	require.Len(t, check.issues, 2)
+	assert.Equal(t, "internal/app/app_test.go", check.issues[0].File, "the header must come first")
+	assert.Equal(t, "internal/app/util.go", check.issues[1].File)
+	assert.Equal(t, "license-header", check.issues[1].Pattern)
+	assert.Equal(t, domain.SeverityWarning, check.issues[1].Severity)
+}
+
+func TestCheckHeaders_NoConfig(t *testing.T) {
+	profile := domain.DefaultProfile()
+	check := checkHeaders(&profile, map[string]*domain.AnalyzedFile{"a.go": {Path: "a.go"}})
+	assert.Zero(t, check.checked)
+	assert.Empty(t, check.issues)
+}
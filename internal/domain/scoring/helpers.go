@@ -1,11 +1,24 @@
 package scoring
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/abdidvp/openkraft/internal/domain"
 )
 
+// sortedFiles returns the analyzed files ordered by path. Scorers iterate
+// over it instead of the map so that floating-point sums, details and issues
+// come out identically on every run.
+func sortedFiles(analyzed map[string]*domain.AnalyzedFile) []*domain.AnalyzedFile {
+	files := make([]*domain.AnalyzedFile, 0, len(analyzed))
+	for _, af := range analyzed {
+		files = append(files, af)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+	return files
+}
+
 // buildLayerMap constructs a map from directory name to canonical layer name,
 // using both canonical names and profile aliases.
 func buildLayerMap(profile *domain.ScoringProfile) map[string]string {
@@ -0,0 +1,156 @@
+package scoring
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// HexagonalPlan maps the packages of a flat project onto the layers of a
+// hexagonal layout: core types and ports under internal/domain, services
+// under internal/application, adapters under internal/adapters/inbound or
+// internal/adapters/outbound, and main packages under cmd.
+type HexagonalPlan struct {
+	Moves []PackageMove `json:"moves"`
+	// Placed lists the packages already in a layer directory; they stay.
+	Placed []string `json:"placed,omitempty"`
+	// Undecided lists the packages no role signal is confident about; they
+	// stay until someone places them by hand.
+	Undecided []string `json:"undecided,omitempty"`
+
+	// The score of the project as it is and as projected after the moves.
+	Baseline   int                    `json:"baseline"`
+	Projected  int                    `json:"projected"`
+	Delta      int                    `json:"delta"`
+	Categories []domain.CategoryDelta `json:"categories,omitempty"`
+}
+
+// PackageMove moves one package, by module-relative directory, into the
+// layer its classified role belongs to. The package keeps its name.
+type PackageMove struct {
+	From       string   `json:"from"`
+	To         string   `json:"to"`
+	Role       ArchRole `json:"role"`
+	Confidence float64  `json:"confidence"`
+	Files      int      `json:"files"`     // non-test Go files
+	Importers  int      `json:"importers"` // module packages whose imports change
+	// Public is set for packages other modules can import; moving them
+	// under internal/ breaks those imports.
+	Public bool `json:"public,omitempty"`
+}
+
+// layerDirs are the directory names that already place a package in a
+// layer, as the module detector reads them.
+var layerDirs = map[string]bool{
+	"domain": true, "application": true, "app": true, "core": true, "ports": true,
+	"adapters": true, "adapter": true, "infrastructure": true, "infra": true, "cmd": true,
+}
+
+// inboundAdapterWords name the adapters that drive the application rather
+// than being driven by it.
+var inboundAdapterWords = map[string]bool{
+	"handler": true, "handlers": true, "controller": true, "controllers": true,
+	"server": true, "api": true, "grpc": true, "graphql": true, "transport": true,
+	"delivery": true, "middleware": true, "http": true, "web": true, "rest": true, "cli": true,
+}
+
+// inboundParamTypes are the parameter types of functions that serve
+// requests.
+var inboundParamTypes = []string{"http.Request", "http.ResponseWriter", "net.Listener", "cobra.Command"}
+
+// PlanHexagonal proposes where each package of the module moves in a
+// hexagonal layout, by the role ClassifyPackages detects for it. Packages
+// already under a layer directory stay, as do the module root and the
+// packages whose role is unclassified; main packages move under cmd. A
+// package keeps its path below the layer, minus a leading internal/ or
+// pkg/, so billing moves to internal/domain/billing and pkg/store/sql to
+// internal/adapters/outbound/store/sql. The plan carries no score; the
+// caller projects it.
+func PlanHexagonal(modulePath string, profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) *HexagonalPlan {
+	plan := &HexagonalPlan{}
+	graph := BuildImportGraph(modulePath, analyzed)
+	if graph == nil {
+		return plan
+	}
+	for pkg, ap := range graph.ClassifyPackages(modulePath, profile) {
+		dir := packageDir(modulePath, pkg)
+		role, confidence := ap.Role, ap.Confidence
+		if ap.Node.HasMain {
+			// A main package named like an adapter (server, api) is still
+			// a command.
+			sig := classifyByNaming(dir, "main")
+			role, confidence = sig.Role, sig.Confidence
+		}
+		switch {
+		case dir == ".":
+		case inLayerDir(dir):
+			plan.Placed = append(plan.Placed, dir)
+		case role == RoleUnclassified:
+			plan.Undecided = append(plan.Undecided, dir)
+		default:
+			plan.Moves = append(plan.Moves, PackageMove{
+				From:       dir,
+				To:         hexagonalTarget(dir, role, isInboundAdapter(dir, ap.Node.Files, analyzed)),
+				Role:       role,
+				Confidence: confidence,
+				Files:      len(ap.Node.Files),
+				Importers:  len(ap.Node.ImportedBy),
+				Public:     role != RoleEntryPoint && !slices.Contains(strings.Split(dir, "/"), "internal"),
+			})
+		}
+	}
+	sort.Slice(plan.Moves, func(i, j int) bool { return plan.Moves[i].From < plan.Moves[j].From })
+	sort.Strings(plan.Placed)
+	sort.Strings(plan.Undecided)
+	return plan
+}
+
+func inLayerDir(dir string) bool {
+	return slices.ContainsFunc(strings.Split(dir, "/"), func(seg string) bool { return layerDirs[seg] })
+}
+
+// hexagonalTarget returns the directory a package of the given role moves
+// to.
+func hexagonalTarget(dir string, role ArchRole, inbound bool) string {
+	rel := strings.TrimPrefix(strings.TrimPrefix(dir, "internal/"), "pkg/")
+	switch role {
+	case RoleOrchestrator:
+		return "internal/application/" + rel
+	case RoleAdapter:
+		if inbound {
+			return "internal/adapters/inbound/" + rel
+		}
+		return "internal/adapters/outbound/" + rel
+	case RoleEntryPoint:
+		return "cmd/" + rel
+	default: // core and ports
+		return "internal/domain/" + rel
+	}
+}
+
+// isInboundAdapter reports whether an adapter package serves requests: it
+// is named like a handler or server, or its functions take a request,
+// response writer, listener or command.
+func isInboundAdapter(dir string, files []string, analyzed map[string]*domain.AnalyzedFile) bool {
+	for _, seg := range strings.Split(dir, "/") {
+		if inboundAdapterWords[seg] {
+			return true
+		}
+	}
+	for _, f := range files {
+		af := analyzed[f]
+		if af == nil {
+			continue
+		}
+		for _, fn := range af.Functions {
+			for _, p := range fn.Params {
+				if slices.ContainsFunc(inboundParamTypes, func(t string) bool { return strings.Contains(p.Type, t) }) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func flatProject() map[string]*domain.AnalyzedFile {
+	files := []*domain.AnalyzedFile{
+		{Path: "billing/invoice.go", Package: "billing", Structs: []string{"Invoice"},
+			InterfaceDefs: []domain.InterfaceDef{{Name: "Repository", Methods: []string{"Save"}}}, Interfaces: []string{"Repository"}},
+		{Path: "service/billing_service.go", Package: "service", Structs: []string{"Service"},
+			Imports: []string{"example.com/flat/billing"}},
+		{Path: "handlers/http.go", Package: "handlers", Imports: []string{"net/http", "example.com/flat/service"},
+			Functions: []domain.Function{{Name: "ServeHTTP", Receiver: "*Handler",
+				Params: []domain.Param{{Name: "w", Type: "http.ResponseWriter"}, {Name: "r", Type: "*http.Request"}}}}},
+		{Path: "store/sql_store.go", Package: "store", Imports: []string{"database/sql", "example.com/flat/billing"},
+			Functions: []domain.Function{{Name: "Save", Receiver: "*Store", Params: []domain.Param{{Name: "db", Type: "*sql.DB"}}}}},
+		{Path: "server/main.go", Package: "main", Imports: []string{"net/http", "example.com/flat/handlers"},
+			Functions: []domain.Function{{Name: "main"}}},
+		{Path: "util/strings.go", Package: "util", Functions: []domain.Function{{Name: "Trim"}}},
+		{Path: "internal/domain/money/money.go", Package: "money", Structs: []string{"Money"}},
+	}
+	analyzed := map[string]*domain.AnalyzedFile{}
+	for _, af := range files {
+		analyzed[af.Path] = af
+	}
+	return analyzed
+}
+
+func TestPlanHexagonal_MovesPackagesByRole(t *testing.T) {
+	plan := scoring.PlanHexagonal("example.com/flat", defaultProfile(), flatProject())
+
+	targets := map[string]string{}
+	for _, m := range plan.Moves {
+		targets[m.From] = m.To
+	}
+	assert.Equal(t, map[string]string{
+		"billing":  "internal/domain/billing",
+		"service":  "internal/application/service",
+		"handlers": "internal/adapters/inbound/handlers",
+		"store":    "internal/adapters/outbound/store",
+		"server":   "cmd/server",
+	}, targets)
+	assert.Equal(t, []string{"internal/domain/money"}, plan.Placed)
+	assert.Equal(t, []string{"util"}, plan.Undecided)
+
+	for _, m := range plan.Moves {
+		assert.Equal(t, m.From != "server", m.Public, m.From)
+		if m.From == "billing" {
+			assert.Equal(t, 2, m.Importers)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package scoring
+
+import (
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// hotPathShare is the cumulative CPU share at or above which a function is
+// on the hot path.
+const hotPathShare = 0.05
+
+// raisedSeverity is the severity a hot-path issue is raised to.
+var raisedSeverity = map[string]string{
+	domain.SeverityInfo:    domain.SeverityWarning,
+	domain.SeverityWarning: domain.SeverityError,
+	domain.SeverityError:   domain.SeverityError,
+}
+
+// boostHotPaths raises the severity of issues inside functions that take
+// at least hotPathShare of the profile's CPU samples, and records the share
+// on the issue: a long function on the hot path costs more than one on a
+// cold error path. Issues without a line are left alone.
+func boostHotPaths(issues []domain.Issue, profile *domain.CPUProfile, analyzed map[string]*domain.AnalyzedFile) {
+	shares := functionCPUShares(profile, analyzed)
+	if len(shares) == 0 {
+		return
+	}
+	for i := range issues {
+		fn := functionAt(analyzed[issues[i].File], issues[i].Line)
+		if fn == nil {
+			continue
+		}
+		if share := shares[issues[i].File][fn.LineStart]; share >= hotPathShare {
+			issues[i].Severity = raisedSeverity[issues[i].Severity]
+			issues[i].CPUShare = share
+		}
+	}
+}
+
+// functionCPUShares returns the cumulative CPU share of every analyzed
+// function that appears in profile, keyed by file and start line.
+func functionCPUShares(profile *domain.CPUProfile, analyzed map[string]*domain.AnalyzedFile) map[string]map[int]float64 {
+	if profile == nil || profile.Total <= 0 {
+		return nil
+	}
+	shares := make(map[string]map[int]float64)
+	for _, pl := range profile.Lines {
+		path := profileFile(pl.File, analyzed)
+		fn := functionAt(analyzed[path], pl.Line)
+		if fn == nil {
+			continue
+		}
+		if shares[path] == nil {
+			shares[path] = make(map[int]float64)
+		}
+		share := shares[path][fn.LineStart] + float64(pl.Value)/float64(profile.Total)
+		shares[path][fn.LineStart] = min(share, 1.0)
+	}
+	return shares
+}
+
+// profileFile maps a file path recorded in a profile, absolute on the build
+// machine or module-qualified, to the longest analyzed path it ends with.
+func profileFile(file string, analyzed map[string]*domain.AnalyzedFile) string {
+	file = strings.ReplaceAll(file, "\\", "/")
+	for {
+		if _, ok := analyzed[file]; ok {
+			return file
+		}
+		i := strings.IndexByte(file, '/')
+		if i < 0 {
+			return ""
+		}
+		file = file[i+1:]
+	}
+}
@@ -0,0 +1,68 @@
+package scoring
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileFile(t *testing.T) {
+	analyzed := map[string]*domain.AnalyzedFile{
+		"internal/app/score.go": {},
+		"app/score.go":          {},
+		"cmd/openkraft/main.go": {},
+	}
+	assert.Equal(t, "internal/app/score.go", profileFile("/home/ci/src/openkraft/internal/app/score.go", analyzed))
+	assert.Equal(t, "cmd/openkraft/main.go", profileFile("github.com/abdidvp/openkraft/cmd/openkraft/main.go", analyzed))
+	assert.Equal(t, "", profileFile("/usr/local/go/src/runtime/proc.go", analyzed))
+}
+
+func TestBoostHotPaths(t *testing.T) {
+	analyzed := map[string]*domain.AnalyzedFile{
+		"engine/run.go": {Path: "engine/run.go", Functions: []domain.Function{
+			{Name: "Loop", LineStart: 10, LineEnd: 300},
+			{Name: "reportError", LineStart: 310, LineEnd: 620},
+		}},
+	}
+	profile := &domain.CPUProfile{Total: 100, Lines: []domain.ProfileLine{
+		{File: "/src/engine/run.go", Line: 20, Value: 60},
+		{File: "/src/engine/run.go", Line: 40, Value: 30},
+		{File: "/src/engine/run.go", Line: 400, Value: 1},
+	}}
+	issues := []domain.Issue{
+		{Severity: domain.SeverityWarning, File: "engine/run.go", Line: 10},
+		{Severity: domain.SeverityWarning, File: "engine/run.go", Line: 310},
+		{Severity: domain.SeverityInfo, File: "engine/run.go"},
+	}
+
+	boostHotPaths(issues, profile, analyzed)
+	assert.Equal(t, domain.SeverityError, issues[0].Severity)
+	assert.InDelta(t, 0.9, issues[0].CPUShare, 1e-9)
+	assert.Equal(t, domain.SeverityWarning, issues[1].Severity, "1% of samples is a cold path")
+	assert.Zero(t, issues[1].CPUShare)
+	assert.Equal(t, domain.SeverityInfo, issues[2].Severity)
+}
+
+func TestScoreCodeHealth_CPUProfileRaisesHotIssues(t *testing.T) {
+	fns := []domain.Function{{Name: "Hot", Exported: true, LineStart: 1, LineEnd: 70}}
+	for i := range 20 {
+		fns = append(fns, domain.Function{Name: fmt.Sprintf("small%d", i), LineStart: 100 + 10*i, LineEnd: 105 + 10*i})
+	}
+	analyzed := map[string]*domain.AnalyzedFile{
+		"hot.go": {Path: "hot.go", Package: "x", TotalLines: 300, Functions: fns},
+	}
+	scan := &domain.ScanResult{GoFiles: []string{"hot.go"}}
+	cold := ScoreCodeHealth(nil, scan, analyzed)
+
+	scan.CPUProfile = &domain.CPUProfile{Total: 10, Lines: []domain.ProfileLine{{File: "/src/hot.go", Line: 5, Value: 10}}}
+	hot := ScoreCodeHealth(nil, scan, analyzed)
+
+	assert.Less(t, hot.Score, cold.Score)
+	i := slices.IndexFunc(hot.Issues, func(iss domain.Issue) bool { return iss.SubMetric == "function_size" })
+	require.GreaterOrEqual(t, i, 0)
+	assert.Equal(t, 1.0, hot.Issues[i].CPUShare)
+}
@@ -0,0 +1,122 @@
+package scoring
+
+import (
+	"sort"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// RankHotspots ranks files and functions by their negative contribution to
+// the score: lost decay credit across the code_health sub-metrics plus the
+// severity weight of the issues located in them. When churn (commits per
+// file) is provided, priority scales cost by 1 + churn/maxChurn so that
+// frequently changed code surfaces first. Units with zero cost are omitted.
+func RankHotspots(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile, issues []domain.Issue, churn map[string]int) domain.HotspotReport {
+	if profile == nil {
+		p := domain.DefaultProfile()
+		profile = &p
+	}
+
+	_, dupMap := scoreCodeDuplication(profile, nil, analyzed)
+
+	issuesByFile := make(map[string][]domain.Issue)
+	for _, issue := range issues {
+		if issue.File != "" {
+			issuesByFile[issue.File] = append(issuesByFile[issue.File], issue)
+		}
+	}
+
+	maxChurn := 0
+	for _, c := range churn {
+		maxChurn = max(maxChurn, c)
+	}
+
+	report := domain.HotspotReport{ChurnUsed: maxChurn > 0}
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated {
+			continue
+		}
+
+		file := domain.Hotspot{File: af.Path, Churn: churn[af.Path]}
+		if af.TotalLines > 0 {
+			file.LostCredit += 1 - decayCredit(profile, af.TotalLines, fileSizeLimit(profile, af))
+		}
+		if info, ok := dupMap[af.Path]; ok {
+			file.LostCredit += 1 - decayCredit(profile, info.percent, duplicationLimit(profile, af.Path))
+		}
+
+		fileIssues := issuesByFile[af.Path]
+		claimed := make([]bool, len(fileIssues))
+
+		for _, fn := range af.Functions {
+			h := domain.Hotspot{File: af.Path, Line: fn.LineStart, Symbol: funcSymbol(fn), Churn: file.Churn}
+			h.LostCredit = functionLostCredit(profile, af, fn)
+			for i, issue := range fileIssues {
+				if !claimed[i] && issue.Line >= fn.LineStart && issue.Line <= fn.LineEnd {
+					claimed[i] = true
+					h.Issues++
+					h.IssueWeight += severityWeight(profile, issue.Severity)
+				}
+			}
+			file.LostCredit += h.LostCredit
+			file.Issues += h.Issues
+			file.IssueWeight += h.IssueWeight
+			if finishHotspot(&h, maxChurn) {
+				report.Functions = append(report.Functions, h)
+			}
+		}
+
+		for i, issue := range fileIssues {
+			if !claimed[i] {
+				file.Issues++
+				file.IssueWeight += severityWeight(profile, issue.Severity)
+			}
+		}
+		if finishHotspot(&file, maxChurn) {
+			report.Files = append(report.Files, file)
+		}
+	}
+
+	sortHotspots(report.Files)
+	sortHotspots(report.Functions)
+	return report
+}
+
+// functionLostCredit sums the credit a function loses across the
+// function-level decay sub-metrics.
+func functionLostCredit(profile *domain.ScoringProfile, af *domain.AnalyzedFile, fn domain.Function) float64 {
+	lost := 0.0
+	if lines := fn.LineEnd - fn.LineStart + 1; lines > 0 {
+		lost += 1 - decayCredit(profile, lines, functionSizeLimit(profile, af, fn))
+	}
+	if limit, exempt := complexityLimit(profile, af, fn); !exempt {
+		lost += 1 - decayCredit(profile, fn.CognitiveComplexity, limit)
+	}
+	if limit, exempt := paramLimit(profile, af, fn); !exempt {
+		lost += 1 - decayCredit(profile, len(fn.Params), limit)
+	}
+	return lost
+}
+
+// finishHotspot computes cost and priority and reports whether the unit
+// contributes anything negative to the score.
+func finishHotspot(h *domain.Hotspot, maxChurn int) bool {
+	h.Cost = h.LostCredit + h.IssueWeight
+	h.Priority = h.Cost
+	if maxChurn > 0 {
+		h.Priority = h.Cost * (1 + float64(h.Churn)/float64(maxChurn))
+	}
+	return h.Cost > 0
+}
+
+func sortHotspots(hs []domain.Hotspot) {
+	sort.Slice(hs, func(i, j int) bool {
+		if hs[i].Priority != hs[j].Priority {
+			return hs[i].Priority > hs[j].Priority
+		}
+		if hs[i].File != hs[j].File {
+			return hs[i].File < hs[j].File
+		}
+		return hs[i].Line < hs[j].Line
+	})
+}
@@ -0,0 +1,59 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func TestRankHotspots_OrdersByCost(t *testing.T) {
+	profile := domain.DefaultProfile()
+	long := makeFunction("Long", 150, 1, 1, 0)
+	long.LineStart, long.LineEnd = 10, 159
+	files := analyzed(
+		makeFile("a.go", 200, long),
+		makeFile("b.go", 40, makeFunction("Small", 10, 1, 1, 0)),
+	)
+	issues := []domain.Issue{
+		{Severity: domain.SeverityWarning, File: "a.go", Line: 10},
+		{Severity: domain.SeverityInfo, File: "a.go"},
+	}
+
+	report := scoring.RankHotspots(&profile, files, issues, nil)
+
+	require.Len(t, report.Functions, 1, "functions without cost are omitted")
+	fn := report.Functions[0]
+	assert.Equal(t, "Long", fn.Symbol)
+	assert.InDelta(t, 0.5, fn.LostCredit, 0.001)
+	assert.InDelta(t, 1.0, fn.IssueWeight, 0.001)
+	assert.Equal(t, 1, fn.Issues)
+	assert.InDelta(t, fn.Cost, fn.Priority, 0.001)
+
+	require.Len(t, report.Files, 1)
+	file := report.Files[0]
+	assert.Equal(t, "a.go", file.File)
+	assert.Equal(t, 2, file.Issues, "file includes its functions' issues plus unattributed ones")
+	assert.InDelta(t, 1.2, file.IssueWeight, 0.001)
+	assert.False(t, report.ChurnUsed)
+}
+
+func TestRankHotspots_ChurnRaisesPriority(t *testing.T) {
+	profile := domain.DefaultProfile()
+	files := analyzed(
+		makeFile("frozen.go", 10, makeFunction("Frozen", 150, 1, 1, 0)),
+		makeFile("hot.go", 10, makeFunction("Hot", 120, 1, 1, 0)),
+	)
+	churn := map[string]int{"hot.go": 20, "frozen.go": 1}
+
+	report := scoring.RankHotspots(&profile, files, nil, churn)
+
+	require.Len(t, report.Functions, 2)
+	assert.True(t, report.ChurnUsed)
+	assert.Equal(t, "Hot", report.Functions[0].Symbol, "high churn outranks slightly higher cost")
+	assert.Equal(t, 20, report.Functions[0].Churn)
+	assert.Greater(t, report.Functions[1].Cost, report.Functions[0].Cost)
+}
@@ -0,0 +1,83 @@
+package scoring
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// predeclared lists Go's predeclared identifiers. An import alias with one
+// of these names hides the builtin for the whole file.
+var predeclared = map[string]bool{
+	"any": true, "bool": true, "byte": true, "comparable": true,
+	"complex64": true, "complex128": true, "error": true, "float32": true, "float64": true,
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"rune": true, "string": true, "uint": true, "uint8": true, "uint16": true,
+	"uint32": true, "uint64": true, "uintptr": true,
+	"true": true, "false": true, "iota": true, "nil": true,
+	"append": true, "cap": true, "clear": true, "close": true, "complex": true,
+	"copy": true, "delete": true, "imag": true, "len": true, "make": true,
+	"max": true, "min": true, "new": true, "panic": true, "print": true,
+	"println": true, "real": true, "recover": true,
+}
+
+// checkImportAliases checks every explicit import alias in non-generated
+// files. An alias that shadows a predeclared identifier is a warning; an
+// alias that differs from the one most files use for the same path (pb,
+// proto and apipb for one package) is reported with the dominant alias.
+// Each aliased import counts as one checked item.
+func checkImportAliases(analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	files := sortedFiles(analyzed)
+
+	uses := make(map[string]map[string]int) // path -> alias -> files
+	for _, af := range files {
+		if af.IsGenerated {
+			continue
+		}
+		for path, alias := range af.ImportAliases {
+			if uses[path] == nil {
+				uses[path] = make(map[string]int)
+			}
+			uses[path][alias]++
+		}
+	}
+
+	for _, af := range files {
+		if af.IsGenerated {
+			continue
+		}
+		for _, path := range slices.Sorted(maps.Keys(af.ImportAliases)) {
+			alias := af.ImportAliases[path]
+			check.checked++
+			iss := domain.Issue{
+				Severity:  domain.SeverityInfo,
+				Category:  "predictability",
+				SubMetric: "consistent_patterns",
+				File:      af.Path,
+				Pattern:   "import-alias",
+			}
+			if predeclared[alias] {
+				iss.Severity = domain.SeverityWarning
+				check.issues = append(check.issues, iss.WithMessage("consistent_patterns.alias_shadows", alias, path))
+				continue
+			}
+			if dominant := dominantAlias(uses[path]); alias != dominant {
+				check.issues = append(check.issues, iss.WithMessage("consistent_patterns.alias_inconsistent", path, alias, dominant, uses[path][dominant]))
+			}
+		}
+	}
+	return check
+}
+
+// dominantAlias returns the alias used by the most files, breaking ties
+// alphabetically.
+func dominantAlias(counts map[string]int) string {
+	aliases := slices.Collect(maps.Keys(counts))
+	slices.SortFunc(aliases, func(a, b string) int {
+		return cmp.Or(cmp.Compare(counts[b], counts[a]), cmp.Compare(a, b))
+	})
+	return aliases[0]
+}
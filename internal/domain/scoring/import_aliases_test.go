@@ -0,0 +1,36 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckImportAliases(t *testing.T) {
+	const api = "example.com/app/gen/api/v1"
+	check := checkImportAliases(map[string]*domain.AnalyzedFile{
+		"a.go":    {Path: "a.go", ImportAliases: map[string]string{api: "pb"}},
+		"b.go":    {Path: "b.go", ImportAliases: map[string]string{api: "pb"}},
+		"c.go":    {Path: "c.go", ImportAliases: map[string]string{api: "apipb"}},
+		"d.go":    {Path: "d.go", ImportAliases: map[string]string{"example.com/app/internal/str": "string"}},
+		"e.pb.go": {Path: "e.pb.go", ImportAliases: map[string]string{api: "proto"}, IsGenerated: true},
+	})
+
+	assert.Equal(t, 4, check.checked)
+	require.Len(t, check.issues, 2)
+
+	assert.Equal(t, "c.go", check.issues[0].File)
+	assert.Equal(t, domain.SeverityInfo, check.issues[0].Severity)
+	assert.Equal(t, `import "example.com/app/gen/api/v1" is aliased apipb here but pb in 2 other files`, check.issues[0].Message)
+
+	assert.Equal(t, "d.go", check.issues[1].File)
+	assert.Equal(t, domain.SeverityWarning, check.issues[1].Severity)
+	assert.Equal(t, `import alias string for "example.com/app/internal/str" shadows a predeclared identifier`, check.issues[1].Message)
+}
+
+func TestDominantAlias(t *testing.T) {
+	assert.Equal(t, "pb", dominantAlias(map[string]int{"pb": 3, "proto": 1}))
+	assert.Equal(t, "apipb", dominantAlias(map[string]int{"pb": 2, "apipb": 2}), "ties break alphabetically")
+}
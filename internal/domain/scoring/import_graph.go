@@ -47,7 +47,7 @@ func BuildImportGraph(modulePath string, analyzed map[string]*domain.AnalyzedFil
 	g := &ImportGraph{Packages: make(map[string]*PackageNode)}
 
 	// Group files by package directory.
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if af.IsGenerated || strings.HasSuffix(af.Path, "_test.go") {
 			continue
 		}
@@ -0,0 +1,88 @@
+package scoring
+
+import (
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// Import kinds, in the order goimports-style grouping expects them.
+const (
+	importStdlib     = "standard library"
+	importThirdParty = "third-party"
+	importModule     = "module"
+)
+
+// checkImportGrouping checks the import block of every non-generated file
+// with two or more imports the way goimports lays it out: paths are sorted
+// within each group, standard library imports do not share a group with
+// third-party or module imports, and the standard library group comes
+// first. Third-party and module imports may share a group, as goimports
+// leaves them. Each file yields at most one issue, for the first problem
+// found.
+func checkImportGrouping(modulePath string, analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || len(af.Imports) < 2 {
+			continue
+		}
+		check.checked++
+		if iss, ok := importGroupingIssue(modulePath, af.ImportGroups); ok {
+			iss.Severity = domain.SeverityInfo
+			iss.Category = "predictability"
+			iss.SubMetric = "consistent_patterns"
+			iss.File = af.Path
+			check.issues = append(check.issues, iss)
+		}
+	}
+	return check
+}
+
+func importGroupingIssue(modulePath string, groups [][]string) (domain.Issue, bool) {
+	seenOther := ""
+	for _, group := range groups {
+		for i := 1; i < len(group); i++ {
+			if group[i] < group[i-1] {
+				return domain.Issue{Pattern: "import-order"}.
+					WithMessage("consistent_patterns.imports_unsorted", group[i-1], group[i]), true
+			}
+		}
+
+		first := ""
+		for _, path := range group {
+			kind := importKind(modulePath, path)
+			if kind == "" {
+				continue
+			}
+			if first == "" {
+				first = path
+			} else if other := importKind(modulePath, first); (kind == importStdlib) != (other == importStdlib) {
+				return domain.Issue{Pattern: "import-grouping"}.
+					WithMessage("consistent_patterns.imports_mixed", other, kind, first, path), true
+			}
+			if kind == importStdlib && seenOther != "" {
+				return domain.Issue{Pattern: "import-grouping"}.
+					WithMessage("consistent_patterns.imports_stdlib_last", path, seenOther), true
+			}
+		}
+		if first != "" && importKind(modulePath, first) != importStdlib && seenOther == "" {
+			seenOther = first
+		}
+	}
+	return domain.Issue{}, false
+}
+
+// importKind classifies path as a standard library, third-party or module
+// import. The cgo pseudo-package "C" has no kind. Without a module path,
+// every non-standard import is third-party.
+func importKind(modulePath, path string) string {
+	switch {
+	case path == "C":
+		return ""
+	case modulePath != "" && (path == modulePath || strings.HasPrefix(path, modulePath+"/")):
+		return importModule
+	case !strings.Contains(strings.SplitN(path, "/", 2)[0], "."):
+		return importStdlib
+	}
+	return importThirdParty
+}
@@ -0,0 +1,69 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportGroupingIssue(t *testing.T) {
+	const module = "example.com/app"
+	tests := []struct {
+		name    string
+		groups  [][]string
+		pattern string // "" means no issue
+		message string
+	}{
+		{"goimports layout", [][]string{{"fmt", "os"}, {"example.com/app/internal/domain", "github.com/stretchr/testify/assert"}}, "", ""},
+		{"three groups", [][]string{{"fmt"}, {"github.com/spf13/cobra"}, {"example.com/app/internal/domain"}}, "", ""},
+		{"cgo import", [][]string{{"C", "unsafe"}}, "", ""},
+		{
+			"unsorted group", [][]string{{"os", "fmt"}},
+			"import-order", "imports are not sorted within their group (os before fmt); run goimports",
+		},
+		{
+			"stdlib mixed with module", [][]string{{"example.com/app/internal/domain", "fmt"}},
+			"import-grouping", "import group mixes module and standard library imports (example.com/app/internal/domain, fmt); separate them with a blank line",
+		},
+		{
+			"stdlib sorted into a module group", [][]string{{"errors", "example.com/app/internal/domain"}},
+			"import-grouping", "import group mixes standard library and module imports (errors, example.com/app/internal/domain); separate them with a blank line",
+		},
+		{
+			"stdlib after third-party", [][]string{{"github.com/spf13/cobra"}, {"fmt"}},
+			"import-grouping", "standard library import fmt comes after github.com/spf13/cobra; put the standard library group first",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iss, ok := importGroupingIssue(module, tt.groups)
+			assert.Equal(t, tt.pattern != "", ok)
+			assert.Equal(t, tt.pattern, iss.Pattern)
+			assert.Equal(t, tt.message, iss.Message)
+		})
+	}
+}
+
+func TestImportKind(t *testing.T) {
+	assert.Equal(t, importStdlib, importKind("example.com/app", "net/http"))
+	assert.Equal(t, importModule, importKind("example.com/app", "example.com/app/internal/domain"))
+	assert.Equal(t, importThirdParty, importKind("example.com/app", "example.com/application"))
+	assert.Equal(t, importModule, importKind("myapp", "myapp/internal/store"), "dotless module paths are not stdlib")
+	assert.Equal(t, importThirdParty, importKind("", "github.com/spf13/cobra"))
+}
+
+func TestCheckImportGrouping(t *testing.T) {
+	check := checkImportGrouping("example.com/app", map[string]*domain.AnalyzedFile{
+		"a.go":    {Path: "a.go", Imports: []string{"os", "fmt"}, ImportGroups: [][]string{{"os", "fmt"}}},
+		"b.go":    {Path: "b.go", Imports: []string{"fmt", "os"}, ImportGroups: [][]string{{"fmt", "os"}}},
+		"c.go":    {Path: "c.go", Imports: []string{"fmt"}, ImportGroups: [][]string{{"fmt"}}},
+		"d.pb.go": {Path: "d.pb.go", Imports: []string{"os", "fmt"}, ImportGroups: [][]string{{"os", "fmt"}}, IsGenerated: true},
+	})
+	assert.Equal(t, 2, check.checked, "single-import and generated files are skipped")
+	require.Len(t, check.issues, 1)
+	assert.Equal(t, "a.go", check.issues[0].File)
+	assert.Equal(t, domain.SeverityInfo, check.issues[0].Severity)
+	assert.Equal(t, "consistent_patterns", check.issues[0].SubMetric)
+}
@@ -0,0 +1,117 @@
+package scoring
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// scoreInterfaceGranularity (10 pts): share of interfaces whose method set
+// stays within profile.MaxInterfaceMethods. Like interface_contracts, it
+// earns nothing when the project declares no interfaces.
+func scoreInterfaceGranularity(profile *domain.ScoringProfile, check complianceCheck) domain.SubMetric {
+	sm := domain.SubMetric{Name: "interface_granularity", Points: 10}
+	if check.checked == 0 {
+		sm.Detail = "no interfaces found"
+		return sm
+	}
+
+	small := check.checked - len(check.issues)
+	ratio := smoothRatio(profile, float64(small), check.checked)
+	sm.Score = min(int(math.Round(ratio*float64(sm.Points))), sm.Points)
+	sm.Detail = fmt.Sprintf("%d/%d interfaces have at most %d methods", small, check.checked, maxInterfaceMethods(profile)) +
+		smoothingNote(profile, float64(small), check.checked)
+	return sm
+}
+
+// checkInterfaceGranularity checks the method set of every interface in
+// non-test, non-generated files against profile.MaxInterfaceMethods: small,
+// role-specific interfaces make ports easy to implement and fake, while a
+// fat interface forces every adapter and test double to carry methods its
+// callers never use. The method set includes the methods of embedded module
+// interfaces; interfaces from other modules add nothing, since their
+// methods are not parsed. A fat port in a domain or application file is a
+// warning, any other fat interface is info.
+func checkInterfaceGranularity(profile *domain.ScoringProfile, scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	limit := maxInterfaceMethods(profile)
+	modulePath := ""
+	if scan != nil {
+		modulePath = scan.ModulePath
+	}
+
+	ifaces := make(map[string]domain.InterfaceDef)
+	files := sortedFiles(analyzed)
+	for _, af := range files {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		pkg := packageImportPath(modulePath, af.Path)
+		for _, iface := range af.InterfaceDefs {
+			if _, ok := ifaces[pkg+"."+iface.Name]; !ok {
+				ifaces[pkg+"."+iface.Name] = iface
+			}
+		}
+	}
+
+	for _, af := range files {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		pkg := packageImportPath(modulePath, af.Path)
+		for _, iface := range af.InterfaceDefs {
+			methods := make(map[string]bool)
+			interfaceMethodSet(ifaces, pkg, iface, methods, map[string]bool{})
+			if len(methods) == 0 {
+				continue
+			}
+			check.checked++
+			if len(methods) <= limit {
+				continue
+			}
+			severity := domain.SeverityInfo
+			if isDomainOrAppFile(af.Path) {
+				severity = domain.SeverityWarning
+			}
+			check.issues = append(check.issues, domain.Issue{
+				Severity:  severity,
+				Category:  "structure",
+				SubMetric: "interface_granularity",
+				File:      af.Path,
+				Pattern:   "fat-interface",
+			}.WithMessage("interface_granularity.fat_interface", iface.Name, len(methods), limit))
+		}
+	}
+	return check
+}
+
+// interfaceMethodSet adds the methods of iface, declared in package pkg,
+// and of the module interfaces it embeds to methods.
+func interfaceMethodSet(ifaces map[string]domain.InterfaceDef, pkg string, iface domain.InterfaceDef, methods, seen map[string]bool) {
+	for _, m := range iface.Methods {
+		methods[m] = true
+	}
+	for _, et := range iface.Embedded {
+		p := et.Package
+		if p == "" {
+			p = pkg
+		}
+		key := p + "." + et.Name
+		embedded, ok := ifaces[key]
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		interfaceMethodSet(ifaces, p, embedded, methods, seen)
+	}
+}
+
+// maxInterfaceMethods returns the method count above which an interface is
+// fat, defaulting to 5.
+func maxInterfaceMethods(profile *domain.ScoringProfile) int {
+	if profile.MaxInterfaceMethods > 0 {
+		return profile.MaxInterfaceMethods
+	}
+	return 5
+}
@@ -0,0 +1,42 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckInterfaceGranularity(t *testing.T) {
+	files := map[string]*domain.AnalyzedFile{
+		"internal/domain/ports.go": {Path: "internal/domain/ports.go", Package: "domain",
+			InterfaceDefs: []domain.InterfaceDef{
+				{Name: "Reader", Methods: []string{"Get", "List", "Count"}},
+				{Name: "Store", Methods: []string{"Save", "Update", "Delete", "Get"}, Embedded: []domain.EmbeddedType{{Name: "Reader"}}},
+				{Name: "Closer", Methods: []string{"Close"}, Embedded: []domain.EmbeddedType{{Package: "io", Name: "Closer"}}},
+				{Name: "Any"},
+			},
+		},
+		"internal/adapters/cache/cache.go": {Path: "internal/adapters/cache/cache.go", Package: "cache",
+			InterfaceDefs: []domain.InterfaceDef{
+				{Name: "backend", Methods: []string{"A", "B", "C", "D", "E", "F"}},
+			},
+		},
+		"internal/domain/ports_test.go": {Path: "internal/domain/ports_test.go", Package: "domain",
+			InterfaceDefs: []domain.InterfaceDef{
+				{Name: "fixture", Methods: []string{"A", "B", "C", "D", "E", "F"}},
+			},
+		},
+	}
+	p := domain.DefaultProfile()
+
+	check := checkInterfaceGranularity(&p, &domain.ScanResult{ModulePath: "example.com/app"}, files)
+
+	assert.Equal(t, 4, check.checked, "empty interfaces and test files are skipped")
+	require.Len(t, check.issues, 2)
+	assert.Contains(t, check.issues[0].Message, "interface backend has 6 methods")
+	assert.Equal(t, domain.SeverityInfo, check.issues[0].Severity)
+	assert.Equal(t, "interface Store has 6 methods (>5); split it into smaller role-specific interfaces", check.issues[1].Message, "embedded Reader adds List and Count")
+	assert.Equal(t, domain.SeverityWarning, check.issues[1].Severity)
+}
@@ -0,0 +1,79 @@
+package scoring
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// checkInterfaceNaming applies Go's interface naming conventions to every
+// interface in non-test, non-generated files: single-method interfaces are
+// named for their method with an -er suffix (Reader, Closer), and no
+// interface carries an I prefix or an Interface suffix. Each interface is
+// checked once and yields at most one issue.
+func checkInterfaceNaming(analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	for _, path := range slices.Sorted(maps.Keys(analyzed)) {
+		af := analyzed[path]
+		if af.IsGenerated || strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		for _, iface := range af.InterfaceDefs {
+			check.checked++
+			if iss, ok := interfaceNamingIssue(iface); ok {
+				iss.File = path
+				check.issues = append(check.issues, iss)
+			}
+		}
+	}
+	return check
+}
+
+func interfaceNamingIssue(iface domain.InterfaceDef) (domain.Issue, bool) {
+	iss := domain.Issue{
+		Severity:  domain.SeverityWarning,
+		Category:  "discoverability",
+		SubMetric: "naming_uniqueness",
+	}
+	name := iface.Name
+	switch {
+	case hasIPrefix(name):
+		iss.Pattern = "interface-prefix"
+		return iss.WithMessage("naming_uniqueness.interface_prefix", name, name[1:]), true
+	case len(name) > len("Interface") && strings.HasSuffix(name, "Interface"):
+		iss.Pattern = "interface-suffix"
+		return iss.WithMessage("naming_uniqueness.interface_suffix", name, strings.TrimSuffix(name, "Interface")), true
+	case len(iface.Methods) == 1 && !hasAgentSuffix(name):
+		iss.Severity = domain.SeverityInfo
+		iss.Pattern = "interface-er"
+		return iss.WithMessage("naming_uniqueness.interface_er", name, iface.Methods[0], agentNoun(iface.Methods[0])), true
+	}
+	return iss, false
+}
+
+// hasIPrefix reports whether name is a C#-style IFoo: an I followed by a
+// capitalized word, so IDGenerator and IOReader are not flagged.
+func hasIPrefix(name string) bool {
+	r := []rune(name)
+	return len(r) >= 3 && r[0] == 'I' && unicode.IsUpper(r[1]) && unicode.IsLower(r[2])
+}
+
+// hasAgentSuffix reports whether name ends in an agent noun suffix, -er or
+// -or (Reader, Validator).
+func hasAgentSuffix(name string) bool {
+	lower := strings.ToLower(name)
+	return strings.HasSuffix(lower, "er") || strings.HasSuffix(lower, "or")
+}
+
+// agentNoun suggests the -er name for an interface whose only method is
+// method: Read → Reader, Close → Closer.
+func agentNoun(method string) string {
+	name := exportedName(method)
+	if strings.HasSuffix(name, "e") {
+		return name + "r"
+	}
+	return name + "er"
+}
@@ -0,0 +1,72 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterfaceNamingIssue(t *testing.T) {
+	tests := []struct {
+		name    string
+		iface   domain.InterfaceDef
+		pattern string // "" means no issue
+		message string
+	}{
+		{"er suffix", domain.InterfaceDef{Name: "Reader", Methods: []string{"Read"}}, "", ""},
+		{"or suffix", domain.InterfaceDef{Name: "Validator", Methods: []string{"Validate"}}, "", ""},
+		{"unexported er", domain.InterfaceDef{Name: "fileCloser", Methods: []string{"Close"}}, "", ""},
+		{"multi-method noun", domain.InterfaceDef{Name: "Repository", Methods: []string{"Get", "Save"}}, "", ""},
+		{"initialism", domain.InterfaceDef{Name: "IDGenerator", Methods: []string{"Next", "Reset"}}, "", ""},
+		{"io initialism", domain.InterfaceDef{Name: "IOReader", Methods: []string{"Read"}}, "", ""},
+		{
+			"single method without -er", domain.InterfaceDef{Name: "Scoring", Methods: []string{"Score"}},
+			"interface-er", "single-method interface Scoring should be named for its method Score with an -er suffix (e.g. Scorer)",
+		},
+		{
+			"I prefix", domain.InterfaceDef{Name: "IUserStore", Methods: []string{"Get", "Put"}},
+			"interface-prefix", "interface IUserStore uses an I prefix; name it for its behavior (e.g. UserStore)",
+		},
+		{
+			"Interface suffix", domain.InterfaceDef{Name: "CacheInterface", Methods: []string{"Get"}},
+			"interface-suffix", "interface CacheInterface repeats Interface in its name; drop the suffix (e.g. Cache)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iss, ok := interfaceNamingIssue(tt.iface)
+			assert.Equal(t, tt.pattern != "", ok)
+			if ok {
+				assert.Equal(t, tt.pattern, iss.Pattern)
+				assert.Equal(t, tt.message, iss.Message)
+				assert.Equal(t, "naming_uniqueness", iss.SubMetric)
+			}
+		})
+	}
+}
+
+func TestAgentNoun(t *testing.T) {
+	assert.Equal(t, "Reader", agentNoun("Read"))
+	assert.Equal(t, "Closer", agentNoun("Close"))
+	assert.Equal(t, "Scorer", agentNoun("score"))
+}
+
+func TestCheckInterfaceNaming_SkipsTestAndGeneratedFiles(t *testing.T) {
+	bad := []domain.InterfaceDef{{Name: "IStore", Methods: []string{"Get", "Put"}}}
+	files := map[string]*domain.AnalyzedFile{
+		"store.go":      {Path: "store.go", InterfaceDefs: bad},
+		"store_test.go": {Path: "store_test.go", InterfaceDefs: bad},
+		"store.pb.go":   {Path: "store.pb.go", InterfaceDefs: bad, IsGenerated: true},
+	}
+
+	check := checkInterfaceNaming(files)
+	assert.Equal(t, 1, check.checked)
+	if assert.Len(t, check.issues, 1) {
+		assert.Equal(t, "store.go", check.issues[0].File)
+	}
+
+	profile := domain.DefaultProfile()
+	profile.InterfaceNaming = false
+	assert.Empty(t, checkNamingRules(&profile, files).issues, "opted out via profile")
+}
@@ -0,0 +1,139 @@
+package scoring
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// loggingLibraries maps the import paths of logging libraries to the names
+// profile.PreferredLogger uses for them.
+var loggingLibraries = map[string]string{
+	"log":                        "log",
+	"log/slog":                   "slog",
+	"github.com/sirupsen/logrus": "logrus",
+	"go.uber.org/zap":            "zap",
+	"github.com/rs/zerolog":      "zerolog",
+	"github.com/rs/zerolog/log":  "zerolog",
+}
+
+// fmtPrints are the fmt functions that write to standard output.
+var fmtPrints = map[string]bool{"Print": true, "Println": true, "Printf": true}
+
+// scoreLoggingConsistency (10 pts): share of logging checks passed. A
+// project that does not log passes trivially.
+func scoreLoggingConsistency(profile *domain.ScoringProfile, check complianceCheck) domain.SubMetric {
+	sm := domain.SubMetric{Name: "logging_consistency", Points: 10}
+	if check.checked == 0 {
+		sm.Score = sm.Points
+		sm.Detail = "no logging found"
+		return sm
+	}
+
+	passed := check.checked - len(check.issues)
+	ratio := smoothRatio(profile, float64(passed), check.checked)
+	sm.Score = min(int(math.Round(ratio*float64(sm.Points))), sm.Points)
+	sm.Detail = fmt.Sprintf("%d/%d logging checks passed", passed, check.checked) +
+		smoothingNote(profile, float64(passed), check.checked)
+	return sm
+}
+
+// checkLogging checks how non-test, non-generated files log: every file
+// should use the same logging library, profile.PreferredLogger or else the
+// one most files import; packages other than main should not print with
+// fmt.Print, fmt.Println or fmt.Printf; and error-level log calls should
+// carry the error. Each file importing a logging library, each file
+// outside package main and each error-level call is one checked item.
+func checkLogging(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	preferred := preferredLogger(profile, analyzed)
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		for _, lib := range fileLoggers(af) {
+			check.checked++
+			if lib != preferred {
+				check.issues = append(check.issues, loggingIssue(af.Path, 0, "mixed-loggers").
+					WithMessage("logging_consistency.mixed_loggers", lib, preferred))
+			}
+		}
+		if af.Package != "main" {
+			check.checked++
+			if fn, call, ok := firstFmtPrint(af); ok {
+				check.issues = append(check.issues, loggingIssue(af.Path, call.Line, "fmt-print").
+					WithMessage("logging_consistency.fmt_print", fn, call.Name))
+			}
+		}
+		for _, el := range af.ErrorLogs {
+			check.checked++
+			if !el.HasError {
+				check.issues = append(check.issues, loggingIssue(af.Path, el.Line, "error-log-without-error").
+					WithMessage("logging_consistency.error_log_without_error"))
+			}
+		}
+	}
+	return check
+}
+
+// preferredLogger returns profile.PreferredLogger, or the logging library
+// imported by the most non-test files, ties broken alphabetically.
+func preferredLogger(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) string {
+	if profile.PreferredLogger != "" {
+		return profile.PreferredLogger
+	}
+	counts := make(map[string]int)
+	for _, af := range analyzed {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		for _, lib := range fileLoggers(af) {
+			counts[lib]++
+		}
+	}
+	if len(counts) == 0 {
+		return ""
+	}
+	return dominantAlias(counts)
+}
+
+// fileLoggers returns the sorted logging libraries af imports.
+func fileLoggers(af *domain.AnalyzedFile) []string {
+	seen := make(map[string]bool)
+	var libs []string
+	for _, imp := range af.Imports {
+		if lib, ok := loggingLibraries[imp]; ok && !seen[lib] {
+			seen[lib] = true
+			libs = append(libs, lib)
+		}
+	}
+	sort.Strings(libs)
+	return libs
+}
+
+// firstFmtPrint returns the first function in af that prints with fmt and
+// the call.
+func firstFmtPrint(af *domain.AnalyzedFile) (string, domain.Call, bool) {
+	for _, fn := range af.Functions {
+		for _, call := range fn.Calls {
+			if call.Package == "fmt" && fmtPrints[call.Name] {
+				return fn.Name, call, true
+			}
+		}
+	}
+	return "", domain.Call{}, false
+}
+
+// loggingIssue returns an info issue under logging_consistency.
+func loggingIssue(file string, line int, pattern string) domain.Issue {
+	return domain.Issue{
+		Severity:  domain.SeverityInfo,
+		Category:  "predictability",
+		SubMetric: "logging_consistency",
+		File:      file,
+		Line:      line,
+		Pattern:   pattern,
+	}
+}
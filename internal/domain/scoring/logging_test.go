@@ -0,0 +1,61 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loggingFixture() map[string]*domain.AnalyzedFile {
+	return map[string]*domain.AnalyzedFile{
+		"cmd/app/main.go": {Path: "cmd/app/main.go", Package: "main", Imports: []string{"fmt", "log"},
+			Functions: []domain.Function{{Name: "main", Calls: []domain.Call{{Name: "Println", Package: "fmt", Line: 5}}}}},
+		"store/store.go": {Path: "store/store.go", Package: "store", Imports: []string{"log/slog"},
+			ErrorLogs: []domain.ErrorLog{{Line: 12, HasError: true}, {Line: 20}}},
+		"store/cache.go": {Path: "store/cache.go", Package: "store", Imports: []string{"log/slog"}},
+		"store/dump.go": {Path: "store/dump.go", Package: "store", Imports: []string{"fmt"},
+			Functions: []domain.Function{{Name: "Dump", Calls: []domain.Call{
+				{Name: "Sprintf", Package: "fmt", Line: 3}, {Name: "Printf", Package: "fmt", Line: 4},
+			}}}},
+		"store/store_test.go": {Path: "store/store_test.go", Package: "store", Imports: []string{"go.uber.org/zap"}},
+	}
+}
+
+func TestCheckLogging(t *testing.T) {
+	p := domain.DefaultProfile()
+
+	check := checkLogging(&p, loggingFixture())
+
+	assert.Equal(t, 8, check.checked, "3 logger imports, 3 files outside main and 2 error logs; tests are skipped")
+	require.Len(t, check.issues, 3)
+	assert.Equal(t, "mixed-loggers", check.issues[0].Pattern)
+	assert.Equal(t, "file logs with log while the project logs with slog", check.issues[0].Message)
+	assert.Equal(t, "fmt-print", check.issues[1].Pattern)
+	assert.Equal(t, "store/dump.go", check.issues[1].File)
+	assert.Equal(t, 4, check.issues[1].Line)
+	assert.Equal(t, "error-log-without-error", check.issues[2].Pattern)
+	assert.Equal(t, 20, check.issues[2].Line)
+}
+
+func TestCheckLogging_PreferredLogger(t *testing.T) {
+	p := domain.DefaultProfile()
+	p.PreferredLogger = "log"
+
+	check := checkLogging(&p, loggingFixture())
+
+	require.Len(t, check.issues, 4)
+	assert.Equal(t, "file logs with slog while the project logs with log", check.issues[0].Message)
+}
+
+func TestScoreLoggingConsistency(t *testing.T) {
+	p := domain.DefaultProfile()
+
+	sm := scoreLoggingConsistency(&p, complianceCheck{})
+	assert.Equal(t, 10, sm.Score, "a project that does not log is not inconsistent")
+
+	sm = scoreLoggingConsistency(&p, checkLogging(&p, loggingFixture()))
+	assert.Equal(t, 6, sm.Score)
+	assert.Equal(t, "5/8 logging checks passed", sm.Detail)
+}
@@ -0,0 +1,78 @@
+package scoring
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// magicNumberRepeats is how often a number must appear in one package
+// before it should be a named constant.
+const magicNumberRepeats = 3
+
+// checkMagicNumbers checks the number literals of non-test, non-generated
+// files package by package. The parser already leaves out 0, 1, -1 and
+// literals in const declarations; values in profile.MagicNumberAllowlist
+// are skipped too. Each remaining distinct value in a package is one
+// checked item, reported when it appears magicNumberRepeats or more times,
+// at its first occurrence.
+func checkMagicNumbers(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	type use struct {
+		file string
+		line int
+	}
+	var check complianceCheck
+	byPkg := make(map[string]map[string][]use) // dir -> value -> uses
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		dir := filepath.Dir(af.Path)
+		for _, lit := range af.NumericLiterals {
+			if slices.Contains(profile.MagicNumberAllowlist, lit.Value) {
+				continue
+			}
+			if byPkg[dir] == nil {
+				byPkg[dir] = make(map[string][]use)
+			}
+			byPkg[dir][lit.Value] = append(byPkg[dir][lit.Value], use{af.Path, lit.Line})
+		}
+	}
+
+	dirs := make([]string, 0, len(byPkg))
+	for dir := range byPkg {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	for _, dir := range dirs {
+		values := make([]string, 0, len(byPkg[dir]))
+		for v := range byPkg[dir] {
+			values = append(values, v)
+		}
+		sort.Strings(values)
+		for _, v := range values {
+			uses := byPkg[dir][v]
+			check.checked++
+			if len(uses) < magicNumberRepeats {
+				continue
+			}
+			var at []string
+			for _, u := range uses[:magicNumberRepeats] {
+				at = append(at, fmt.Sprintf("%s:%d", filepath.Base(u.file), u.line))
+			}
+			check.issues = append(check.issues, domain.Issue{
+				Severity:  domain.SeverityInfo,
+				Category:  "predictability",
+				SubMetric: "consistent_patterns",
+				File:      uses[0].file,
+				Line:      uses[0].line,
+				Pattern:   "magic-number",
+			}.WithMessage("consistent_patterns.magic_number", v, len(uses), filepath.ToSlash(dir), strings.Join(at, ", ")))
+		}
+	}
+	return check
+}
@@ -0,0 +1,37 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckMagicNumbers(t *testing.T) {
+	lits := func(values ...string) []domain.NumericLiteral {
+		var out []domain.NumericLiteral
+		for i, v := range values {
+			out = append(out, domain.NumericLiteral{Value: v, Line: 10 + i})
+		}
+		return out
+	}
+	files := map[string]*domain.AnalyzedFile{
+		"retry/backoff.go":    {Path: "retry/backoff.go", NumericLiterals: lits("86400", "2", "86400")},
+		"retry/policy.go":     {Path: "retry/policy.go", NumericLiterals: lits("86400", "42", "2", "2")},
+		"retry/retry_test.go": {Path: "retry/retry_test.go", NumericLiterals: lits("42", "42", "42")},
+		"other/other.go":      {Path: "other/other.go", NumericLiterals: lits("86400")},
+	}
+	p := domain.DefaultProfile()
+
+	check := checkMagicNumbers(&p, files)
+
+	assert.Equal(t, 3, check.checked, "2 is allowlisted; values count per package")
+	require.Len(t, check.issues, 1)
+	assert.Equal(t, "retry/backoff.go", check.issues[0].File)
+	assert.Equal(t, 10, check.issues[0].Line)
+	assert.Equal(t, "number 86400 appears 3 times in package retry (backoff.go:10, backoff.go:12, policy.go:10); name it with a constant", check.issues[0].Message)
+
+	p.MagicNumberAllowlist = []string{"2", "86400"}
+	assert.Empty(t, checkMagicNumbers(&p, files).issues)
+}
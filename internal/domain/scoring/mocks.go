@@ -0,0 +1,116 @@
+package scoring
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// mockDirs are directory and package names conventionally holding test doubles.
+var mockDirs = map[string]bool{
+	"mocks": true, "mock": true, "fakes": true, "fake": true,
+	"stubs": true, "stub": true, "testutil": true, "testdata": true, "testing": true,
+}
+
+// mockLibraries are the mocking frameworks whose generated code imports them.
+var mockLibraries = []string{
+	"github.com/golang/mock/gomock",
+	"go.uber.org/mock/gomock",
+	"github.com/stretchr/testify/mock",
+}
+
+// mockPrefixes mark hand-written test doubles by struct name.
+var mockPrefixes = []string{"Mock", "Stub", "Fake"}
+
+// collectMockIssues flags test doubles living outside a conventional
+// location (mocks/, *_mock.go, _test.go files) and production code that
+// imports mock packages or mocking frameworks.
+func collectMockIssues(analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	var issues []domain.Issue
+	for _, af := range sortedFiles(analyzed) {
+		inMockLocation := isMockLocation(af)
+		if !inMockLocation {
+			for _, name := range mockStructs(af) {
+				issues = append(issues, domain.Issue{
+					Severity:  domain.SeverityInfo,
+					Category:  "predictability",
+					SubMetric: "consistent_patterns",
+					File:      af.Path,
+				}.WithMessage("consistent_patterns.mock_in_production", name))
+			}
+		}
+		if inMockLocation || isTestFile(af.Path) {
+			continue
+		}
+		for _, imp := range af.Imports {
+			if !isMockImport(imp) {
+				continue
+			}
+			issues = append(issues, domain.Issue{
+				Severity:  domain.SeverityWarning,
+				Category:  "predictability",
+				SubMetric: "consistent_patterns",
+				File:      af.Path,
+			}.WithMessage("consistent_patterns.mock_import", imp))
+		}
+	}
+	return issues
+}
+
+// mockStructs returns the test doubles declared in af: every struct of a
+// file generated against a mocking framework, otherwise the structs named
+// Mock*, Stub* or Fake*.
+func mockStructs(af *domain.AnalyzedFile) []string {
+	if af.IsGenerated && slices.ContainsFunc(af.Imports, isMockLibrary) {
+		return af.Structs
+	}
+	var mocks []string
+	for _, s := range af.Structs {
+		for _, prefix := range mockPrefixes {
+			rest, ok := strings.CutPrefix(s, prefix)
+			if ok && rest != "" && strings.ToUpper(rest[:1]) == rest[:1] {
+				mocks = append(mocks, s)
+				break
+			}
+		}
+	}
+	return mocks
+}
+
+// isMockLocation reports whether af sits where test doubles are expected.
+func isMockLocation(af *domain.AnalyzedFile) bool {
+	if isTestFile(af.Path) || mockDirs[af.Package] || strings.HasSuffix(af.Package, "test") {
+		return true
+	}
+	base := strings.TrimSuffix(path.Base(af.Path), ".go")
+	for _, suffix := range []string{"_mock", "_mocks", "_fake", "_stub"} {
+		if strings.HasSuffix(base, suffix) {
+			return true
+		}
+	}
+	if strings.HasPrefix(base, "mock_") {
+		return true
+	}
+	for _, dir := range strings.Split(path.Dir(af.Path), "/") {
+		if mockDirs[dir] {
+			return true
+		}
+	}
+	return false
+}
+
+// isMockImport reports whether an import path names a mock package or a
+// mocking framework.
+func isMockImport(imp string) bool {
+	if isMockLibrary(imp) {
+		return true
+	}
+	last := path.Base(imp)
+	return last == "mocks" || last == "mock" || last == "fakes" || strings.Contains(imp, "/mocks/")
+}
+
+func isMockLibrary(imp string) bool {
+	return slices.Contains(mockLibraries, imp)
+}
@@ -0,0 +1,69 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScorePredictability_MockPlacement(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     *domain.AnalyzedFile
+		expected []string // severities of the mock issues raised
+	}{
+		{
+			name: "mock in mocks package",
+			file: &domain.AnalyzedFile{Path: "internal/mocks/repo.go", Package: "mocks", Structs: []string{"MockRepo"}},
+		},
+		{
+			name: "mock in _mock.go file",
+			file: &domain.AnalyzedFile{Path: "internal/store/repo_mock.go", Package: "store", Structs: []string{"MockRepo"}},
+		},
+		{
+			name: "mock in test file",
+			file: &domain.AnalyzedFile{Path: "internal/store/repo_test.go", Package: "store", Structs: []string{"fakeClock", "StubRepo"}},
+		},
+		{
+			name: "word that only starts like a mock",
+			file: &domain.AnalyzedFile{Path: "internal/store/mockery.go", Package: "store", Structs: []string{"Mockingbird"}},
+		},
+		{
+			name:     "hand-written mock in production file",
+			file:     &domain.AnalyzedFile{Path: "internal/store/repo.go", Package: "store", Structs: []string{"Repo", "MockRepo"}},
+			expected: []string{domain.SeverityInfo},
+		},
+		{
+			name: "generated gomock file in production package",
+			file: &domain.AnalyzedFile{Path: "internal/store/repo_gen.go", Package: "store", IsGenerated: true,
+				Imports: []string{"go.uber.org/mock/gomock"}, Structs: []string{"RepoRecorder"}},
+			expected: []string{domain.SeverityInfo, domain.SeverityWarning},
+		},
+		{
+			name: "production code importing mocks",
+			file: &domain.AnalyzedFile{Path: "cmd/api/main.go", Package: "main",
+				Imports: []string{"example.com/app/internal/mocks"}},
+			expected: []string{domain.SeverityWarning},
+		},
+		{
+			name: "test importing mocks",
+			file: &domain.AnalyzedFile{Path: "cmd/api/main_test.go", Package: "main",
+				Imports: []string{"example.com/app/internal/mocks", "github.com/stretchr/testify/mock"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := scoring.ScorePredictability(defaultProfile(), nil, nil, analyzed(tt.file))
+
+			var got []string
+			for _, iss := range result.Issues {
+				if iss.SubMetric == "consistent_patterns" {
+					got = append(got, iss.Severity)
+				}
+			}
+			assert.ElementsMatch(t, tt.expected, got)
+		})
+	}
+}
@@ -4,8 +4,7 @@ import (
 	"math"
 	"strings"
 	"unicode"
-
-	"github.com/fatih/camelcase"
+	"unicode/utf8"
 
 	"github.com/abdidvp/openkraft/internal/domain"
 )
@@ -21,8 +20,10 @@ var vagueWords = map[string]bool{
 // WordCountScore returns a score [0,1] based on the number of CamelCase words
 // in a function name. 2-4 words is optimal.
 func WordCountScore(name string) float64 {
-	words := camelcase.Split(name)
-	n := len(words)
+	return wordCountScore(len(SplitWords(name)))
+}
+
+func wordCountScore(n int) float64 {
 	switch {
 	case n >= 2 && n <= 4:
 		return 1.0
@@ -37,7 +38,7 @@ func WordCountScore(name string) float64 {
 
 // VocabularySpecificity returns the ratio of non-vague words in a name.
 func VocabularySpecificity(name string) float64 {
-	words := camelcase.Split(name)
+	words := SplitWords(name)
 	if len(words) == 0 {
 		return 0
 	}
@@ -90,41 +91,62 @@ func ShannonEntropy(names []string) float64 {
 // CamelCase words (verb + noun). Go naming conventions dictate that exported
 // function names naturally use verb+noun structure (CreateUser, ScoreProject,
 // AnalyzeFile). Single-word names (String, Error, Len) don't qualify.
-func hasVerbNounPattern(name string) bool {
+func hasVerbNounPattern(name string, words *WordSplitter) bool {
 	if len(name) == 0 || !unicode.IsUpper(rune(name[0])) {
 		return false
 	}
-	return len(camelcase.Split(name)) >= 2
+	return len(words.Split(name)) >= 2
 }
 
 // HasVerbNounPattern exports hasVerbNounPattern for testing.
-var HasVerbNounPattern = hasVerbNounPattern
+func HasVerbNounPattern(name string) bool { return hasVerbNounPattern(name, defaultSplitter) }
 
-// WordCount returns the number of CamelCase words in a name.
+// WordCount returns the number of words in a name, keeping acronyms whole.
 func WordCount(name string) int {
-	return len(camelcase.Split(name))
+	return len(SplitWords(name))
 }
 
-// genericWords score 0.0 — fully generic identifiers.
-var genericWords = map[string]bool{
-	"Get": true, "Set": true, "Do": true, "Run": true,
-	"Handle": true, "Process": true, "Execute": true, "Make": true,
-	"Data": true, "Info": true, "Item": true, "Object": true,
-	"Thing": true, "Stuff": true, "Temp": true, "Manager": true,
-	"Handler": true, "Helper": true, "Util": true,
+// Vocabulary classifies the words of identifiers for IdentifierSpecificity.
+// Keys are title-cased words.
+type Vocabulary struct {
+	Generic map[string]bool // score 0.0: fully generic words
+	Action  map[string]bool // score 0.5: verbs with clear semantics
+	Domain  map[string]bool // score 1.0: the project's own vocabulary
+	Words   *WordSplitter   // splits names into words; nil uses the default acronyms
 }
 
-// actionWords score 0.5 — verbs with clear semantics.
-var actionWords = map[string]bool{
-	"Validate": true, "Parse": true, "Format": true, "Convert": true,
-	"Transform": true, "Compute": true, "Calculate": true, "Build": true,
-	"Render": true,
+// NewVocabulary builds the vocabulary from the profile's word lists and the
+// domain words extracted from analyzed. The profile's DomainWords override
+// its generic and action lists; extracted words do not, so a Handler struct
+// does not make "Handle" specific.
+func NewVocabulary(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) Vocabulary {
+	words := splitterFor(profile)
+	v := Vocabulary{
+		Generic: titledSet(profile.GenericWords),
+		Action:  titledSet(profile.ActionWords),
+		Domain:  extractDomainVocabulary(analyzed, words),
+		Words:   words,
+	}
+	for w := range titledSet(profile.DomainWords) {
+		delete(v.Generic, w)
+		delete(v.Action, w)
+		v.Domain[w] = true
+	}
+	return v
+}
+
+func titledSet(words []string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[titleCase(w)] = true
+	}
+	return set
 }
 
 // IdentifierSpecificity scores a function name based on word specificity.
 // Generic words = 0.0, action words = 0.5, domain vocab = 1.0, unknown = 0.75.
-func IdentifierSpecificity(name string, domainVocab map[string]bool) float64 {
-	words := camelcase.Split(name)
+func IdentifierSpecificity(name string, vocab Vocabulary) float64 {
+	words := vocab.Words.Split(name)
 	if len(words) == 0 {
 		return 0
 	}
@@ -132,11 +154,11 @@ func IdentifierSpecificity(name string, domainVocab map[string]bool) float64 {
 	for _, w := range words {
 		titled := titleCase(w)
 		switch {
-		case genericWords[titled]:
+		case vocab.Generic[titled]:
 			total += 0.0
-		case actionWords[titled]:
+		case vocab.Action[titled]:
 			total += 0.5
-		case domainVocab[titled]:
+		case vocab.Domain[titled]:
 			total += 1.0
 		default:
 			total += 0.75
@@ -148,18 +170,22 @@ func IdentifierSpecificity(name string, domainVocab map[string]bool) float64 {
 // ExtractDomainVocabulary builds a set of words found in struct and interface
 // names across the project, split by CamelCase boundaries.
 func ExtractDomainVocabulary(analyzed map[string]*domain.AnalyzedFile) map[string]bool {
+	return extractDomainVocabulary(analyzed, defaultSplitter)
+}
+
+func extractDomainVocabulary(analyzed map[string]*domain.AnalyzedFile, words *WordSplitter) map[string]bool {
 	vocab := make(map[string]bool)
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if af.IsGenerated {
 			continue
 		}
 		for _, s := range af.Structs {
-			for _, w := range camelcase.Split(s) {
+			for _, w := range words.Split(s) {
 				vocab[titleCase(w)] = true
 			}
 		}
 		for _, iface := range af.Interfaces {
-			for _, w := range camelcase.Split(iface) {
+			for _, w := range words.Split(iface) {
 				vocab[titleCase(w)] = true
 			}
 		}
@@ -177,12 +203,12 @@ func SymbolCollisionRate(analyzed map[string]*domain.AnalyzedFile) float64 {
 	names := make(map[string]*nameInfo)
 	totalNames := 0
 
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if af.IsGenerated || strings.HasSuffix(af.Path, "_test.go") {
 			continue
 		}
 		for _, fn := range af.Functions {
-			if !fn.Exported || fn.Receiver != "" {
+			if !fn.Exported || fn.Receiver != "" || isGenericHelper(fn) {
 				continue
 			}
 			totalNames++
@@ -215,5 +241,6 @@ func titleCase(w string) string {
 		return w
 	}
 	low := strings.ToLower(w)
-	return strings.ToUpper(low[:1]) + low[1:]
+	r, size := utf8.DecodeRuneInString(low)
+	return string(unicode.ToUpper(r)) + low[size:]
 }
@@ -0,0 +1,66 @@
+package scoring
+
+import (
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// checkNamingRules matches every file, struct and interface name in
+// non-test, non-generated files against the rules that select it, after
+// the built-in interface checks when profile.InterfaceNaming is set. A
+// name broken by several rules yields one issue per rule.
+func checkNamingRules(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	if profile.InterfaceNaming {
+		check.add(checkInterfaceNaming(analyzed))
+	}
+	rules := domain.CompileNamingRules(profile.NamingRules)
+	if len(rules) == 0 {
+		return check
+	}
+
+	for _, path := range slices.Sorted(maps.Keys(analyzed)) {
+		af := analyzed[path]
+		if af.IsGenerated || strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		for _, r := range rules {
+			if !r.AppliesTo(path) {
+				continue
+			}
+			for _, name := range namesOfKind(af, path, r.Kind) {
+				if !r.Selects(name) {
+					continue
+				}
+				check.checked++
+				if r.Allows(name) {
+					continue
+				}
+				check.issues = append(check.issues, domain.Issue{
+					Severity:  domain.SeverityWarning,
+					Category:  "discoverability",
+					SubMetric: "naming_uniqueness",
+					File:      path,
+					Pattern:   "naming-rule",
+				}.WithMessage("naming_uniqueness.rule", r.Kind, name, r.Label(), r.Pattern))
+			}
+		}
+	}
+	return check
+}
+
+func namesOfKind(af *domain.AnalyzedFile, path, kind string) []string {
+	switch kind {
+	case domain.NamingKindFile:
+		return []string{filepath.Base(path)}
+	case domain.NamingKindStruct:
+		return af.Structs
+	case domain.NamingKindInterface:
+		return af.Interfaces
+	}
+	return nil
+}
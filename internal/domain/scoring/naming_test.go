@@ -3,6 +3,7 @@ package scoring_test
 import (
 	"testing"
 
+	"github.com/abdidvp/openkraft/internal/domain"
 	"github.com/abdidvp/openkraft/internal/domain/scoring"
 	"github.com/stretchr/testify/assert"
 )
@@ -66,3 +67,21 @@ func TestShannonEntropy(t *testing.T) {
 	// Single name → zero.
 	assert.Equal(t, 0.0, scoring.ShannonEntropy([]string{"One"}))
 }
+
+func TestNewVocabulary_ProfileDomainWordsOverrideGeneric(t *testing.T) {
+	p := domain.DefaultProfile()
+	before := scoring.IdentifierSpecificity("ProcessZahlung", scoring.NewVocabulary(&p, nil))
+
+	p.DomainWords = []string{"process", "zahlung"}
+	after := scoring.IdentifierSpecificity("ProcessZahlung", scoring.NewVocabulary(&p, nil))
+
+	assert.Equal(t, 0.375, before) // generic 0.0 + unknown 0.75
+	assert.Equal(t, 1.0, after)
+}
+
+func TestNewVocabulary_CustomGenericWords(t *testing.T) {
+	p := domain.DefaultProfile()
+	p.GenericWords = append(p.GenericWords, "Verwalten")
+
+	assert.Equal(t, 0.0, scoring.IdentifierSpecificity("Verwalten", scoring.NewVocabulary(&p, nil)))
+}
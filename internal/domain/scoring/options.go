@@ -0,0 +1,85 @@
+package scoring
+
+import (
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// configSuffixes name the struct types that carry a function's settings in
+// the config-struct pattern: New(cfg Config), Run(ctx, opts RunOptions).
+var configSuffixes = []string{"Config", "Options", "Opts", "Params", "Settings"}
+
+// isOptionsAPI reports whether fn is built on functional options or a
+// config struct: an option constructor (func WithX(...) Option), a function
+// taking variadic options (opts ...Option) or one taking a config struct.
+// These are the idiomatic answers to long parameter lists, so they are
+// exempt from parameter_count.
+func isOptionsAPI(fn domain.Function) bool {
+	return isOptionConstructor(fn) || takesOptions(fn) || takesConfigStruct(fn)
+}
+
+// isOptionConstructor reports whether fn is a WithX function returning an
+// option type or an option func.
+func isOptionConstructor(fn domain.Function) bool {
+	return hasWordPrefix(fn.Name, "With") && len(fn.Returns) == 1 && isOptionType(fn.Returns[0])
+}
+
+// takesOptions reports whether fn's last parameter is variadic options.
+func takesOptions(fn domain.Function) bool {
+	if len(fn.Params) == 0 {
+		return false
+	}
+	t, ok := strings.CutPrefix(fn.Params[len(fn.Params)-1].Type, "...")
+	return ok && isOptionType(t)
+}
+
+// takesConfigStruct reports whether any parameter of fn is a config struct.
+func takesConfigStruct(fn domain.Function) bool {
+	for _, p := range fn.Params {
+		name := typeBaseName(p.Type)
+		if name == "" || strings.HasPrefix(p.Type, "[]") || strings.HasPrefix(p.Type, "map[") {
+			continue
+		}
+		for _, suffix := range configSuffixes {
+			if strings.HasSuffix(name, suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isOptionType reports whether t names an option: a type ending in Option
+// or Opt (grpc.DialOption, ScoreOption) or a bare func type.
+func isOptionType(t string) bool {
+	if t == "func" {
+		return true
+	}
+	name := typeBaseName(t)
+	return strings.HasSuffix(name, "Option") || strings.HasSuffix(name, "Opt")
+}
+
+// typeBaseName strips the pointer and package qualifier from t.
+func typeBaseName(t string) string {
+	t = strings.TrimPrefix(t, "*")
+	return t[strings.LastIndex(t, ".")+1:]
+}
+
+// checkOptionAPIs counts the functional-option and config-struct APIs in
+// non-test, non-generated files. They are a positive conventions signal:
+// each one counts as a checked item that follows Go conventions.
+func checkOptionAPIs(analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		for _, fn := range af.Functions {
+			if fn.Exported && isOptionsAPI(fn) {
+				check.checked++
+			}
+		}
+	}
+	return check
+}
@@ -0,0 +1,50 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsOptionsAPI(t *testing.T) {
+	params := func(types ...string) []domain.Param {
+		ps := make([]domain.Param, len(types))
+		for i, t := range types {
+			ps[i] = domain.Param{Name: "p", Type: t}
+		}
+		return ps
+	}
+	tests := []struct {
+		name string
+		fn   domain.Function
+		want bool
+	}{
+		{"option constructor", domain.Function{Name: "WithTimeout", Returns: []string{"Option"}}, true},
+		{"qualified option constructor", domain.Function{Name: "WithDialer", Returns: []string{"grpc.DialOption"}}, true},
+		{"option func constructor", domain.Function{Name: "WithRetries", Returns: []string{"func"}}, true},
+		{"builder method", domain.Function{Name: "WithName", Receiver: "*Builder", Returns: []string{"*Builder"}}, false},
+		{"variadic options", domain.Function{Name: "NewClient", Params: params("string", "...ClientOption")}, true},
+		{"variadic option funcs", domain.Function{Name: "NewClient", Params: params("string", "...func")}, true},
+		{"variadic strings", domain.Function{Name: "Join", Params: params("string", "...string")}, false},
+		{"config struct", domain.Function{Name: "NewStore", Params: params("context.Context", "*StoreConfig")}, true},
+		{"params struct", domain.Function{Name: "Render", Params: params("RenderParams")}, true},
+		{"slice of configs", domain.Function{Name: "Merge", Params: params("[]Config")}, false},
+		{"plain", domain.Function{Name: "ProcessOrder", Params: params("string", "int", "bool")}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isOptionsAPI(tt.fn))
+		})
+	}
+}
+
+func TestCheckOptionAPIs(t *testing.T) {
+	option := domain.Function{Name: "WithTimeout", Exported: true, Returns: []string{"Option"}}
+	check := checkOptionAPIs(map[string]*domain.AnalyzedFile{
+		"client.go":      {Path: "client.go", Functions: []domain.Function{option, {Name: "Do", Exported: true}}},
+		"client_test.go": {Path: "client_test.go", Functions: []domain.Function{option}},
+	})
+	assert.Equal(t, 1, check.checked)
+	assert.Empty(t, check.issues)
+}
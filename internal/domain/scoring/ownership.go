@@ -0,0 +1,48 @@
+package scoring
+
+import (
+	"sort"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// SummarizeByOwner groups issues by their Owner field and totals the
+// severity-weighted debt per owner (sonar penalty model weights), highest
+// debt first. Issues without an
+// owner are grouped under domain.UnownedLabel.
+func SummarizeByOwner(issues []domain.Issue) []domain.OwnerDebt {
+	byOwner := make(map[string]*domain.OwnerDebt)
+	for _, issue := range issues {
+		owner := issue.Owner
+		if owner == "" {
+			owner = domain.UnownedLabel
+		}
+		od, ok := byOwner[owner]
+		if !ok {
+			od = &domain.OwnerDebt{Owner: owner}
+			byOwner[owner] = od
+		}
+		od.Issues++
+		od.Debt += severityWeight(nil, issue.Severity)
+		switch issue.Severity {
+		case domain.SeverityError:
+			od.Errors++
+		case domain.SeverityWarning:
+			od.Warnings++
+		default:
+			od.Infos++
+		}
+	}
+
+	result := make([]domain.OwnerDebt, 0, len(byOwner))
+	for _, od := range byOwner {
+		result = append(result, *od)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Debt != result[j].Debt {
+			return result[i].Debt > result[j].Debt
+		}
+		return result[i].Owner < result[j].Owner
+	})
+	return result
+}
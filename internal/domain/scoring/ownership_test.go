@@ -0,0 +1,39 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func TestSummarizeByOwner(t *testing.T) {
+	issues := []domain.Issue{
+		{Severity: domain.SeverityWarning, Owner: "@org/a"},
+		{Severity: domain.SeverityWarning, Owner: "@org/a"},
+		{Severity: domain.SeverityError, Owner: "@org/b"},
+		{Severity: domain.SeverityInfo, Owner: "@org/b"},
+		{Severity: domain.SeverityInfo},
+	}
+
+	owners := scoring.SummarizeByOwner(issues)
+	require.Len(t, owners, 3)
+
+	assert.Equal(t, "@org/b", owners[0].Owner, "highest debt first")
+	assert.InDelta(t, 3.2, owners[0].Debt, 1e-9)
+	assert.Equal(t, 1, owners[0].Errors)
+	assert.Equal(t, 1, owners[0].Infos)
+
+	assert.Equal(t, "@org/a", owners[1].Owner)
+	assert.Equal(t, 2, owners[1].Warnings)
+
+	assert.Equal(t, domain.UnownedLabel, owners[2].Owner)
+	assert.Equal(t, 1, owners[2].Issues)
+}
+
+func TestSummarizeByOwner_NoIssues(t *testing.T) {
+	assert.Empty(t, scoring.SummarizeByOwner(nil))
+}
@@ -0,0 +1,84 @@
+package scoring
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// minSprawlPackages is the number of packages below which package sprawl
+// is not judged; a handful of small packages is just a small project.
+const minSprawlPackages = 10
+
+// packageStats is the size of one package directory: its non-test,
+// non-generated files and their total lines.
+type packageStats struct {
+	files, lines int
+}
+
+// checkPackageLayout checks the shape of the package tree. Each package
+// nested deeper than profile.MaxPackageDepth directories is flagged. The
+// project as a whole is flagged for sprawl when more than
+// profile.MaxTinyPackageRatio of its packages (and at least
+// minSprawlPackages of them) hold a single file of at most
+// profile.TinyPackageLines lines. Every package counts as one checked item
+// and the sprawl judgement as one more.
+func checkPackageLayout(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	packages := make(map[string]*packageStats)
+	var dirs []string
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		dir := filepath.ToSlash(filepath.Dir(af.Path))
+		if packages[dir] == nil {
+			packages[dir] = &packageStats{}
+			dirs = append(dirs, dir)
+		}
+		packages[dir].files++
+		packages[dir].lines += af.TotalLines
+	}
+
+	tiny := 0
+	for _, dir := range dirs {
+		check.checked++
+		if depth := packageDepth(dir); profile.MaxPackageDepth > 0 && depth > profile.MaxPackageDepth {
+			check.issues = append(check.issues, packageLayoutIssue(dir, "package-depth").
+				WithMessage("predictable_structure.package_depth", dir, depth, profile.MaxPackageDepth))
+		}
+		if ps := packages[dir]; ps.files == 1 && ps.lines <= profile.TinyPackageLines {
+			tiny++
+		}
+	}
+
+	if len(dirs) < minSprawlPackages || profile.TinyPackageLines <= 0 {
+		return check
+	}
+	check.checked++
+	if ratio := float64(tiny) / float64(len(dirs)); ratio > profile.MaxTinyPackageRatio {
+		check.issues = append(check.issues, packageLayoutIssue(".", "package-sprawl").
+			WithMessage("predictable_structure.package_sprawl", tiny, len(dirs), profile.TinyPackageLines))
+	}
+	return check
+}
+
+func packageLayoutIssue(dir, pattern string) domain.Issue {
+	return domain.Issue{
+		Severity:  domain.SeverityWarning,
+		Category:  "discoverability",
+		SubMetric: "predictable_structure",
+		File:      dir,
+		Pattern:   pattern,
+	}
+}
+
+// packageDepth counts the directories between the project root and dir:
+// the root package is 0 deep and internal/domain is 2.
+func packageDepth(dir string) int {
+	if dir == "." || dir == "" {
+		return 0
+	}
+	return strings.Count(dir, "/") + 1
+}
@@ -0,0 +1,54 @@
+package scoring
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageDepth(t *testing.T) {
+	assert.Equal(t, 0, packageDepth("."))
+	assert.Equal(t, 1, packageDepth("cmd"))
+	assert.Equal(t, 3, packageDepth("internal/domain/scoring"))
+}
+
+func TestCheckPackageLayout_Depth(t *testing.T) {
+	deep := "a/b/c/d/e/f/g/h"
+	files := map[string]*domain.AnalyzedFile{
+		"main.go":              {Path: "main.go", Package: "main", TotalLines: 10},
+		deep + "/leaf.go":      {Path: deep + "/leaf.go", Package: "h", TotalLines: 200},
+		deep + "/leaf_test.go": {Path: deep + "/leaf_test.go", Package: "h"},
+	}
+	profile := domain.DefaultProfile()
+
+	check := checkPackageLayout(&profile, files)
+	assert.Equal(t, 2, check.checked, "too few packages to judge sprawl")
+	require.Len(t, check.issues, 1)
+	assert.Equal(t, "package-depth", check.issues[0].Pattern)
+	assert.Equal(t, `package "a/b/c/d/e/f/g/h" is nested 8 directories deep (max 7); flatten the tree`, check.issues[0].Message)
+
+	profile.MaxPackageDepth = 8
+	assert.Empty(t, checkPackageLayout(&profile, files).issues)
+}
+
+func TestCheckPackageLayout_Sprawl(t *testing.T) {
+	files := map[string]*domain.AnalyzedFile{}
+	for i := range 12 {
+		path := fmt.Sprintf("pkg/p%d/p.go", i)
+		files[path] = &domain.AnalyzedFile{Path: path, TotalLines: 20}
+	}
+	files["pkg/p0/more.go"] = &domain.AnalyzedFile{Path: "pkg/p0/more.go", TotalLines: 20}
+	profile := domain.DefaultProfile()
+
+	check := checkPackageLayout(&profile, files)
+	assert.Equal(t, 13, check.checked)
+	require.Len(t, check.issues, 1)
+	assert.Equal(t, "package-sprawl", check.issues[0].Pattern)
+	assert.Equal(t, "11 of 12 packages hold a single file of at most 30 lines", check.issues[0].Message)
+
+	profile.TinyPackageLines = 10
+	assert.Empty(t, checkPackageLayout(&profile, files).issues)
+}
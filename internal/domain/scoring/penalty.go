@@ -6,64 +6,90 @@ import (
 	"github.com/abdidvp/openkraft/internal/domain"
 )
 
-// decayK controls how gradually credit decays past the threshold.
-// With k=4, credit reaches zero at threshold*5 (5x threshold).
-// Calibrated alongside severityPenaltyScale=120 to produce industry-aligned
-// scores (88-98 for well-maintained OSS projects).
-const decayK = 4
+// penaltyModel returns the profile's penalty model, falling back to the
+// sonar preset for profiles built without one. The sonar model decays credit
+// to zero at 5x the limit and deducts 120 points per unit of debt ratio,
+// calibrated to produce industry-aligned scores (88-98 for well-maintained
+// OSS projects).
+func penaltyModel(profile *domain.ScoringProfile) domain.PenaltyModel {
+	if profile == nil || profile.Penalty == (domain.PenaltyModel{}) {
+		return domain.PenaltyModelPreset(domain.PenaltyModelSonar)
+	}
+	return profile.Penalty
+}
 
 // decayCredit returns a continuous credit in [0,1] using linear decay.
 // At or below threshold: 1.0. Beyond threshold: linearly decays to 0.0
-// at threshold*(decayK+1).
-func decayCredit(value, threshold int) float64 {
+// at threshold*(DecayK+1).
+func decayCredit(profile *domain.ScoringProfile, value, threshold int) float64 {
 	if value <= threshold {
 		return 1.0
 	}
-	credit := 1.0 - float64(value-threshold)/float64(threshold*decayK)
+	credit := 1.0 - float64(value-threshold)/float64(threshold*penaltyModel(profile).DecayK)
 	return max(0.0, credit)
 }
 
-// severityPenaltyScale converts the debt ratio (severity_weight / funcCount)
-// into a point deduction. Calibrated so that a 6% debt ratio yields ~7
-// points of penalty, aligning with SonarQube's SQALE model where well-
-// maintained OSS projects receive B grades (79-82 range).
-const severityPenaltyScale = 120.0
-
 // severityPenalty computes a point deduction based on the debt ratio
-// (severity_weight / funcCount). This rate-based approach ensures that
+// (severity_weight / funcCount), scaled by the penalty model. This rate-based approach ensures that
 // codebases of different sizes are compared fairly — same violation rate
 // produces the same penalty regardless of codebase size.
 //
 // An error floor guarantees at least 1 point deduction when any error-level
 // issue exists, so critical violations never go unnoticed.
-func severityPenalty(issues []domain.Issue, funcCount int) int {
-	if len(issues) == 0 || funcCount == 0 {
-		return 0
-	}
+func severityPenalty(profile *domain.ScoringProfile, issues []domain.Issue, funcCount int) int {
+	return penaltyBreakdown(profile, issues, funcCount, nil).Penalty
+}
 
-	var weight float64
-	var hasError bool
+// penaltyBreakdown computes severityPenalty and keeps every intermediate
+// value so the computation can be explained to users. When churn is
+// non-empty, each issue's weight is scaled by churnFactor for its file.
+func penaltyBreakdown(profile *domain.ScoringProfile, issues []domain.Issue, funcCount int, churn map[string]int) domain.PenaltyBreakdown {
+	model := penaltyModel(profile)
+	pb := domain.PenaltyBreakdown{Normalizer: funcCount, Scale: model.Scale}
+	maxChurn := 0
+	for _, c := range churn {
+		maxChurn = max(maxChurn, c)
+	}
 	for _, iss := range issues {
+		pb.Weighted += model.Weight(iss.Severity) * churnFactor(iss.File, churn, maxChurn)
 		switch iss.Severity {
 		case domain.SeverityError:
-			weight += 3.0
-			hasError = true
+			pb.Errors++
 		case domain.SeverityWarning:
-			weight += 1.0
+			pb.Warnings++
 		case domain.SeverityInfo:
-			weight += 0.2
+			pb.Infos++
 		}
 	}
+	if len(issues) == 0 || funcCount == 0 {
+		return pb
+	}
 
-	debtRatio := weight / float64(funcCount)
-	penalty := int(math.Round(debtRatio * severityPenaltyScale))
+	pb.DebtRatio = pb.Weighted / float64(funcCount)
+	pb.Penalty = int(math.Round(pb.DebtRatio * model.Scale))
 
 	// Floor: at least 1 point if any error-level issue exists.
-	if hasError && penalty < 1 {
-		penalty = 1
+	if pb.Errors > 0 && pb.Penalty < 1 {
+		pb.Penalty = 1
 	}
 
-	return penalty
+	return pb
+}
+
+// churnFactor scales an issue's weight by how often its file changed:
+// untouched files count half, the most-changed file counts double. Issues
+// without a file, or scoring without churn data, keep a factor of 1.
+func churnFactor(file string, churn map[string]int, maxChurn int) float64 {
+	if maxChurn == 0 || file == "" {
+		return 1.0
+	}
+	return 0.5 + 1.5*float64(churn[file])/float64(maxChurn)
+}
+
+// severityWeight is the debt weight of a single issue under the profile's
+// penalty model (sonar: error 3, warning 1, info 0.2).
+func severityWeight(profile *domain.ScoringProfile, severity string) float64 {
+	return penaltyModel(profile).Weight(severity)
 }
 
 // issueSeverity returns a severity level based on how far the actual value
@@ -8,51 +8,99 @@ import (
 )
 
 func TestDecayCredit_AtThreshold(t *testing.T) {
-	assert.Equal(t, 1.0, decayCredit(50, 50))
+	assert.Equal(t, 1.0, decayCredit(nil, 50, 50))
 }
 
 func TestDecayCredit_BelowThreshold(t *testing.T) {
-	assert.Equal(t, 1.0, decayCredit(30, 50))
+	assert.Equal(t, 1.0, decayCredit(nil, 30, 50))
 }
 
 func TestDecayCredit_AboveThreshold(t *testing.T) {
-	credit := decayCredit(100, 50)
+	credit := decayCredit(nil, 100, 50)
 	assert.Greater(t, credit, 0.0)
 	assert.Less(t, credit, 1.0)
 }
 
 func TestDecayCredit_AtFiveXThreshold(t *testing.T) {
-	// At threshold*(decayK+1) = 50*5 = 250, credit should be 0.
-	assert.Equal(t, 0.0, decayCredit(250, 50))
+	// At threshold*(DecayK+1) = 50*5 = 250, credit should be 0.
+	assert.Equal(t, 0.0, decayCredit(nil, 250, 50))
 }
 
 func TestDecayCredit_BeyondFiveX(t *testing.T) {
-	assert.Equal(t, 0.0, decayCredit(300, 50))
+	assert.Equal(t, 0.0, decayCredit(nil, 300, 50))
 }
 
 func TestSeverityPenalty_NoIssues(t *testing.T) {
-	assert.Equal(t, 0, severityPenalty(nil, 100))
+	assert.Equal(t, 0, severityPenalty(nil, nil, 100))
 }
 
 func TestSeverityPenalty_ZeroFuncCount(t *testing.T) {
 	issues := []domain.Issue{{Severity: domain.SeverityError}}
-	assert.Equal(t, 0, severityPenalty(issues, 0))
+	assert.Equal(t, 0, severityPenalty(nil, issues, 0))
 }
 
 func TestSeverityPenalty_ErrorFloor(t *testing.T) {
 	// Single error in a large codebase: floor guarantees >= 1.
 	issues := []domain.Issue{{Severity: domain.SeverityError}}
-	p := severityPenalty(issues, 1000)
+	p := severityPenalty(nil, issues, 1000)
 	assert.GreaterOrEqual(t, p, 1)
 }
 
 func TestSeverityPenalty_InfoLowWeight(t *testing.T) {
 	issues := []domain.Issue{{Severity: domain.SeverityInfo}}
-	p := severityPenalty(issues, 100)
+	p := severityPenalty(nil, issues, 100)
 	// 0.2/100 * 120 = 0.24 → rounds to 0
 	assert.Equal(t, 0, p)
 }
 
+func TestPenaltyBreakdown_NoChurnMatchesSeverityPenalty(t *testing.T) {
+	issues := []domain.Issue{
+		{File: "a.go", Severity: domain.SeverityError},
+		{File: "b.go", Severity: domain.SeverityWarning},
+	}
+	assert.Equal(t, severityPenalty(nil, issues, 20), penaltyBreakdown(nil, issues, 20, nil).Penalty)
+}
+
+func TestPenaltyBreakdown_ChurnWeightsHotFiles(t *testing.T) {
+	churn := map[string]int{"hot.go": 10, "cold.go": 0}
+	hot := penaltyBreakdown(nil, []domain.Issue{{File: "hot.go", Severity: domain.SeverityWarning}}, 10, churn)
+	cold := penaltyBreakdown(nil, []domain.Issue{{File: "cold.go", Severity: domain.SeverityWarning}}, 10, churn)
+
+	assert.Equal(t, 2.0, hot.Weighted)
+	assert.Equal(t, 0.5, cold.Weighted)
+	assert.Greater(t, hot.Penalty, cold.Penalty)
+}
+
+func TestPenaltyModel_PresetsChangeDecayAndPenalty(t *testing.T) {
+	issues := []domain.Issue{{Severity: domain.SeverityError}, {Severity: domain.SeverityWarning}}
+	profile := func(name string) *domain.ScoringProfile {
+		return &domain.ScoringProfile{Penalty: domain.PenaltyModelPreset(name)}
+	}
+
+	// sonar: (3+1)/100*120 = 4.8; lenient: (2+0.5)/100*80 = 2; strict: (4+1.5)/100*160 = 8.8
+	assert.Equal(t, 5, severityPenalty(profile(domain.PenaltyModelSonar), issues, 100))
+	assert.Equal(t, 2, severityPenalty(profile(domain.PenaltyModelLenient), issues, 100))
+	assert.Equal(t, 9, severityPenalty(profile(domain.PenaltyModelStrict), issues, 100))
+
+	// 100 lines against a 50-line limit: 1 - 50/(50*k)
+	assert.InDelta(t, 0.75, decayCredit(profile(domain.PenaltyModelSonar), 100, 50), 1e-9)
+	assert.InDelta(t, 5.0/6, decayCredit(profile(domain.PenaltyModelLenient), 100, 50), 1e-9)
+	assert.InDelta(t, 2.0/3, decayCredit(profile(domain.PenaltyModelStrict), 100, 50), 1e-9)
+}
+
+func TestPenaltyModel_ZeroProfileFallsBackToSonar(t *testing.T) {
+	assert.Equal(t, domain.PenaltyModelPreset(domain.PenaltyModelSonar), penaltyModel(&domain.ScoringProfile{}))
+	assert.Equal(t, 3.0, severityWeight(nil, domain.SeverityError))
+}
+
+func TestChurnFactor(t *testing.T) {
+	churn := map[string]int{"a.go": 4}
+	assert.Equal(t, 1.0, churnFactor("a.go", churn, 0), "no churn data")
+	assert.Equal(t, 1.0, churnFactor("", churn, 4), "issue without file")
+	assert.Equal(t, 0.5, churnFactor("missing.go", churn, 4))
+	assert.Equal(t, 2.0, churnFactor("a.go", churn, 4))
+}
+
 func TestIssueSeverity_Error(t *testing.T) {
 	assert.Equal(t, domain.SeverityError, issueSeverity(150, 50))
 }
@@ -17,12 +17,18 @@ func ScorePredictability(profile *domain.ScoringProfile, modules []domain.Detect
 		Weight: 0.10,
 	}
 
-	sm1 := scoreSelfDescribingNames(analyzed)
+	sm1 := scoreSelfDescribingNames(profile, analyzed)
 	sm2 := scoreExplicitDependencies(profile, analyzed)
 	sm3 := scoreErrorMessageQuality(analyzed)
 	sm4 := scoreConsistentPatterns(modules, analyzed)
+	conventions := checkGoConventions(profile, scan, analyzed)
+	conventions.blend(&sm4, "APIs and import blocks follow Go conventions")
+	headers := checkHeaders(profile, analyzed)
+	headers.blend(&sm4, "files carry the required header")
+	logging := checkLogging(profile, analyzed)
+	sm5 := scoreLoggingConsistency(profile, logging)
 
-	cat.SubMetrics = []domain.SubMetric{sm1, sm2, sm3, sm4}
+	cat.SubMetrics = []domain.SubMetric{sm1, sm2, sm3, sm4, sm5}
 
 	total := 0
 	for _, sm := range cat.SubMetrics {
@@ -31,23 +37,52 @@ func ScorePredictability(profile *domain.ScoringProfile, modules []domain.Detect
 	cat.Score = total
 
 	cat.Issues = collectPredictabilityIssues(analyzed)
+	cat.Issues = append(cat.Issues, collectMockIssues(analyzed)...)
+	cat.Issues = append(cat.Issues, collectTestHelperIssues(analyzed)...)
+	cat.Issues = append(cat.Issues, collectDeprecatedCallIssues(scan, analyzed)...)
+	cat.Issues = append(cat.Issues, collectProcessExitIssues(analyzed)...)
+	cat.Issues = append(cat.Issues, conventions.issues...)
+	cat.Issues = append(cat.Issues, headers.issues...)
+	cat.Issues = append(cat.Issues, logging.issues...)
+	domain.ApplySeverityRules(profile.SeverityRules, cat.Issues)
 	return cat
 }
 
+// checkGoConventions runs the accessor, constructor, options-API, import
+// grouping, import alias, receiver name, signature, magic number and struct
+// tag checks whose compliance feeds consistent_patterns.
+func checkGoConventions(profile *domain.ScoringProfile, scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	check := checkAccessors(analyzed)
+	check.add(checkConstructors(analyzed))
+	check.add(checkOptionAPIs(analyzed))
+	modulePath := ""
+	if scan != nil {
+		modulePath = scan.ModulePath
+	}
+	check.add(checkImportGrouping(modulePath, analyzed))
+	check.add(checkImportAliases(analyzed))
+	check.add(checkReceiverNames(analyzed))
+	check.add(checkSignatures(analyzed))
+	check.add(checkMagicNumbers(profile, analyzed))
+	check.add(checkStructTags(analyzed))
+	return check
+}
+
 // scoreSelfDescribingNames (25 pts): exported functions with verb+noun via CamelCase split.
-func scoreSelfDescribingNames(analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
+func scoreSelfDescribingNames(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
+	words := splitterFor(profile)
 	sm := domain.SubMetric{Name: "self_describing_names", Points: 25}
 
 	total := 0
 	verbNoun := 0
 
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		for _, fn := range af.Functions {
 			if !fn.Exported {
 				continue
 			}
 			total++
-			if hasVerbNounPattern(fn.Name) {
+			if hasVerbNounPattern(fn.Name, words) {
 				verbNoun++
 			}
 		}
@@ -58,12 +93,13 @@ func scoreSelfDescribingNames(analyzed map[string]*domain.AnalyzedFile) domain.S
 		return sm
 	}
 
-	ratio := float64(verbNoun) / float64(total)
+	ratio := smoothRatio(profile, float64(verbNoun), total)
 	sm.Score = int(ratio * float64(sm.Points))
 	if sm.Score > sm.Points {
 		sm.Score = sm.Points
 	}
-	sm.Detail = fmt.Sprintf("%d/%d exported functions follow verb+noun naming", verbNoun, total)
+	sm.Detail = fmt.Sprintf("%d/%d exported functions follow verb+noun naming", verbNoun, total) +
+		smoothingNote(profile, float64(verbNoun), total)
 	return sm
 }
 
@@ -75,7 +111,7 @@ func scoreExplicitDependencies(profile *domain.ScoringProfile, analyzed map[stri
 	totalFiles := 0
 	mutableState := 0
 
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if strings.HasSuffix(af.Path, "_test.go") {
 			continue
 		}
@@ -117,7 +153,7 @@ func scoreErrorMessageQuality(analyzed map[string]*domain.AnalyzedFile) domain.S
 	var totalErrors, wrapped, withContext int
 	hasSentinels := false
 
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		for _, ec := range af.ErrorCalls {
 			totalErrors++
 			if ec.HasWrap {
@@ -167,10 +203,10 @@ func scoreErrorMessageQuality(analyzed map[string]*domain.AnalyzedFile) domain.S
 	return sm
 }
 
-// scoreConsistentPatterns (25 pts): group functions by role (file suffix), normalize
+// scoreConsistentPatterns (15 pts): group functions by role (file suffix), normalize
 // signatures, measure modal consistency.
 func scoreConsistentPatterns(_ []domain.DetectedModule, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
-	sm := domain.SubMetric{Name: "consistent_patterns", Points: 25}
+	sm := domain.SubMetric{Name: "consistent_patterns", Points: 15}
 
 	type signature struct {
 		paramCount  int
@@ -182,7 +218,7 @@ func scoreConsistentPatterns(_ []domain.DetectedModule, analyzed map[string]*dom
 	// Group functions by file role suffix.
 	roleSignatures := make(map[string][]signature)
 
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if strings.HasSuffix(af.Path, "_test.go") {
 			continue
 		}
@@ -274,7 +310,7 @@ func collectPredictabilityIssues(analyzed map[string]*domain.AnalyzedFile) []dom
 	var issues []domain.Issue
 
 	totalErrors := 0
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if !strings.HasSuffix(af.Path, "_test.go") {
 			totalErrors += len(af.ErrorCalls)
 		}
@@ -283,11 +319,10 @@ func collectPredictabilityIssues(analyzed map[string]*domain.AnalyzedFile) []dom
 		issues = append(issues, domain.Issue{
 			Severity: domain.SeverityInfo,
 			Category: "predictability",
-			Message:  "no error handling found across all source files",
-		})
+		}.WithMessage("predictability.no_error_handling"))
 	}
 
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if strings.HasSuffix(af.Path, "_test.go") {
 			continue
 		}
@@ -296,16 +331,14 @@ func collectPredictabilityIssues(analyzed map[string]*domain.AnalyzedFile) []dom
 				Severity: domain.SeverityWarning,
 				Category: "predictability",
 				File:     af.Path,
-				Message:  fmt.Sprintf("file has %d package-level variables (prefer explicit injection)", len(af.GlobalVars)),
-			})
+			}.WithMessage("predictability.global_vars", len(af.GlobalVars)))
 		}
 		if af.InitFunctions > 0 {
 			issues = append(issues, domain.Issue{
 				Severity: domain.SeverityInfo,
 				Category: "predictability",
 				File:     af.Path,
-				Message:  fmt.Sprintf("file has %d init() function(s) (prefer explicit initialization)", af.InitFunctions),
-			})
+			}.WithMessage("predictability.init_functions", af.InitFunctions))
 		}
 	}
 
@@ -14,7 +14,7 @@ func TestScorePredictability_NilInputs(t *testing.T) {
 
 	assert.Equal(t, "predictability", result.Name)
 	assert.Equal(t, 0.10, result.Weight)
-	assert.Len(t, result.SubMetrics, 4)
+	assert.Len(t, result.SubMetrics, 5)
 	assert.GreaterOrEqual(t, result.Score, 0)
 	assert.LessOrEqual(t, result.Score, 100)
 }
@@ -28,7 +28,7 @@ func TestScorePredictability_EmptyInputs(t *testing.T) {
 
 	assert.Equal(t, "predictability", result.Name)
 	assert.Equal(t, 0.10, result.Weight)
-	assert.Len(t, result.SubMetrics, 4)
+	assert.Len(t, result.SubMetrics, 5)
 }
 
 func TestScorePredictability_CleanCode(t *testing.T) {
@@ -75,13 +75,14 @@ func TestScorePredictability_CleanCode(t *testing.T) {
 
 	assert.Equal(t, "predictability", result.Name)
 	assert.Equal(t, 0.10, result.Weight)
-	assert.Len(t, result.SubMetrics, 4)
+	assert.Len(t, result.SubMetrics, 5)
 	assert.Greater(t, result.Score, 0)
 	assert.LessOrEqual(t, result.Score, 100)
 
 	expectedNames := []string{
 		"self_describing_names", "explicit_dependencies",
 		"error_message_quality", "consistent_patterns",
+		"logging_consistency",
 	}
 	for i, name := range expectedNames {
 		assert.Equal(t, name, result.SubMetrics[i].Name)
@@ -0,0 +1,60 @@
+package scoring
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// processExits maps the packages whose functions end the process to those
+// functions.
+var processExits = map[string][]string{
+	"os":                         {"Exit"},
+	"log":                        {"Fatal", "Fatalf", "Fatalln"},
+	"github.com/sirupsen/logrus": {"Fatal", "Fatalf", "Fatalln"},
+}
+
+// collectProcessExitIssues reports functions that call os.Exit, log.Fatal
+// or logrus.Fatal outside package main and cmd/ directories. Library code
+// that ends the process skips deferred cleanup, cannot be tested, and
+// leaves callers no way to recover. The first such call in each function
+// is a warning, escalated to an error in domain and application files,
+// which should only return errors. Test files are skipped: TestMain exits
+// by design.
+func collectProcessExitIssues(analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	var issues []domain.Issue
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || isTestFile(af.Path) || af.Package == "main" || isCmdPath(af.Path) {
+			continue
+		}
+		severity := domain.SeverityWarning
+		if isDomainOrAppFile(af.Path) {
+			severity = domain.SeverityError
+		}
+		for _, fn := range af.Functions {
+			for _, call := range fn.Calls {
+				if !slices.Contains(processExits[call.Package], call.Name) {
+					continue
+				}
+				issues = append(issues, domain.Issue{
+					Severity:  severity,
+					Category:  "predictability",
+					SubMetric: "consistent_patterns",
+					File:      af.Path,
+					Line:      call.Line,
+					Pattern:   "process-exit",
+				}.WithMessage("consistent_patterns.process_exit", fn.Name, filepath.Base(call.Package)+"."+call.Name))
+				break
+			}
+		}
+	}
+	return issues
+}
+
+// isCmdPath reports whether path lies in a cmd directory, where binaries
+// own the process.
+func isCmdPath(path string) bool {
+	return slices.Contains(strings.Split(filepath.ToSlash(filepath.Dir(path)), "/"), "cmd")
+}
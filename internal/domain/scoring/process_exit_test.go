@@ -0,0 +1,34 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectProcessExitIssues(t *testing.T) {
+	exit := func(pkg, name string, line int) []domain.Call {
+		return []domain.Call{{Name: "Println", Package: "fmt", Line: line - 1}, {Name: name, Package: pkg, Line: line}}
+	}
+	files := map[string]*domain.AnalyzedFile{
+		"main.go":             {Path: "main.go", Package: "main", Functions: []domain.Function{{Name: "main", Calls: exit("os", "Exit", 5)}}},
+		"cmd/tool/run.go":     {Path: "cmd/tool/run.go", Package: "tool", Functions: []domain.Function{{Name: "Run", Calls: exit("log", "Fatal", 9)}}},
+		"internal/cli/cli.go": {Path: "internal/cli/cli.go", Package: "cli", Functions: []domain.Function{{Name: "Execute", Calls: exit("os", "Exit", 12)}}},
+		"internal/billing/domain/invoice.go": {Path: "internal/billing/domain/invoice.go", Package: "domain", Functions: []domain.Function{
+			{Name: "Total", Calls: append(exit("github.com/sirupsen/logrus", "Fatalf", 20), domain.Call{Name: "Exit", Package: "os", Line: 21})},
+		}},
+		"internal/cli/cli_test.go": {Path: "internal/cli/cli_test.go", Package: "cli", Functions: []domain.Function{{Name: "TestMain", Calls: exit("os", "Exit", 3)}}},
+	}
+
+	issues := collectProcessExitIssues(files)
+
+	require.Len(t, issues, 2)
+	assert.Equal(t, "internal/billing/domain/invoice.go", issues[0].File)
+	assert.Equal(t, domain.SeverityError, issues[0].Severity, "escalated in the domain layer")
+	assert.Equal(t, "function Total calls logrus.Fatalf outside package main, ending the process where callers and tests cannot recover", issues[0].Message)
+	assert.Equal(t, "internal/cli/cli.go", issues[1].File)
+	assert.Equal(t, domain.SeverityWarning, issues[1].Severity)
+	assert.Equal(t, 12, issues[1].Line)
+}
@@ -0,0 +1,108 @@
+package scoring
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// receiverUse is one method's receiver name and position.
+type receiverUse struct {
+	file, name string
+	line       int
+}
+
+// checkReceiverNames checks that the methods of each type in non-generated
+// files share one receiver name, as Go convention asks (always s *Server,
+// not a mix of s, srv and server), and that the name is not self or this.
+// Each type with named receivers counts as one checked item and yields at
+// most one issue, at its first method that strays from the dominant name:
+// a warning for self or this, info for an inconsistent name.
+func checkReceiverNames(analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	byType := receiversByType(analyzed)
+	keys := make([]string, 0, len(byType))
+	for k := range byType {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		check.checked++
+		if iss, ok := receiverNameIssue(key[strings.LastIndex(key, ".")+1:], byType[key]); ok {
+			check.issues = append(check.issues, iss)
+		}
+	}
+	return check
+}
+
+// receiversByType groups the named receivers of methods in non-generated
+// files by package directory and type, as dir + "." + type.
+func receiversByType(analyzed map[string]*domain.AnalyzedFile) map[string][]receiverUse {
+	byType := make(map[string][]receiverUse)
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated {
+			continue
+		}
+		for _, fn := range af.Functions {
+			recv := strings.TrimPrefix(fn.Receiver, "*")
+			if recv == "" || fn.ReceiverName == "" || fn.ReceiverName == "_" {
+				continue
+			}
+			key := filepath.Dir(af.Path) + "." + recv
+			byType[key] = append(byType[key], receiverUse{file: af.Path, name: fn.ReceiverName, line: fn.LineStart})
+		}
+	}
+	return byType
+}
+
+// receiverNameIssue reports the first method of typeName whose receiver is
+// self, this or not the name most of its methods use.
+func receiverNameIssue(typeName string, uses []receiverUse) (domain.Issue, bool) {
+	counts := make(map[string]int)
+	for _, u := range uses {
+		if !isSelfReceiver(u.name) {
+			counts[u.name]++
+		}
+	}
+	want := receiverSuggestion(typeName)
+	if len(counts) > 0 {
+		want = dominantAlias(counts)
+	}
+	for _, u := range uses {
+		if u.name == want {
+			continue
+		}
+		iss := domain.Issue{
+			Severity:  domain.SeverityInfo,
+			Category:  "predictability",
+			SubMetric: "consistent_patterns",
+			File:      u.file,
+			Line:      u.line,
+			Pattern:   "receiver-name",
+		}
+		if isSelfReceiver(u.name) {
+			iss.Severity = domain.SeverityWarning
+			return iss.WithMessage("consistent_patterns.receiver_self", typeName, u.name, want), true
+		}
+		return iss.WithMessage("consistent_patterns.receiver_inconsistent", typeName, u.name, want, counts[want]), true
+	}
+	return domain.Issue{}, false
+}
+
+// isSelfReceiver reports whether name is a receiver name borrowed from
+// other languages.
+func isSelfReceiver(name string) bool {
+	return name == "self" || name == "this"
+}
+
+// receiverSuggestion returns the conventional receiver name for a type:
+// its first letter, lower-cased.
+func receiverSuggestion(typeName string) string {
+	for _, r := range typeName {
+		return string(unicode.ToLower(r))
+	}
+	return "r"
+}
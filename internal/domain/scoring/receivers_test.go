@@ -0,0 +1,37 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckReceiverNames(t *testing.T) {
+	files := map[string]*domain.AnalyzedFile{
+		"server/server.go": {Path: "server/server.go", Package: "server", Functions: []domain.Function{
+			{Name: "Start", Receiver: "*Server", ReceiverName: "s", LineStart: 10},
+			{Name: "Stop", Receiver: "*Server", ReceiverName: "s", LineStart: 20},
+			{Name: "Close", Receiver: "Server", ReceiverName: "srv", LineStart: 30},
+			{Name: "Len", Receiver: "Queue", ReceiverName: "q", LineStart: 40},
+			{Name: "Reset", Receiver: "Queue", ReceiverName: "_", LineStart: 50},
+		}},
+		"server/legacy.go": {Path: "server/legacy.go", Package: "server", Functions: []domain.Function{
+			{Name: "Serve", Receiver: "*Handler", ReceiverName: "self", LineStart: 5},
+		}},
+		"client/server.go": {Path: "client/server.go", Package: "client", Functions: []domain.Function{
+			{Name: "Dial", Receiver: "*Server", ReceiverName: "srv", LineStart: 3},
+		}},
+	}
+
+	check := checkReceiverNames(files)
+
+	assert.Equal(t, 4, check.checked, "Server is counted once per package")
+	require.Len(t, check.issues, 2)
+	assert.Equal(t, domain.SeverityWarning, check.issues[0].Severity)
+	assert.Equal(t, "method of Handler uses receiver self; name it after the type, e.g. h", check.issues[0].Message)
+	assert.Equal(t, "server/server.go", check.issues[1].File)
+	assert.Equal(t, 30, check.issues[1].Line)
+	assert.Equal(t, "method of Server uses receiver srv; other methods use s (2 times)", check.issues[1].Message)
+}
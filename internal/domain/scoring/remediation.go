@@ -0,0 +1,213 @@
+package scoring
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// AttachRemediations fills in Remediation on every issue that lacks one,
+// using the analyzed function or file the issue points at to make the
+// guidance concrete.
+func AttachRemediations(categories []domain.CategoryScore, analyzed map[string]*domain.AnalyzedFile) {
+	for _, cat := range categories {
+		for i := range cat.Issues {
+			if cat.Issues[i].Remediation == "" {
+				cat.Issues[i].Remediation = Remediate(cat.Issues[i], analyzed)
+			}
+		}
+	}
+}
+
+// Remediate returns sub-metric-specific guidance for fixing issue, or ""
+// when there is nothing more specific to say than the message itself.
+func Remediate(issue domain.Issue, analyzed map[string]*domain.AnalyzedFile) string {
+	af := analyzed[issue.File]
+	fn := functionAt(af, issue.Line)
+
+	switch issue.SubMetric {
+	case "function_size":
+		if fn != nil {
+			return functionSizeRemedy(fn)
+		}
+		return "split the function into named helpers, one per step"
+	case "cognitive_complexity":
+		if fn != nil {
+			return complexityRemedy(fn)
+		}
+		return "flatten nested branches with early returns and extract the innermost blocks into helpers"
+	case "parameter_count":
+		if fn != nil {
+			return parameterRemedy(fn)
+		}
+		return "group related parameters into a struct"
+	case "file_size":
+		if af != nil {
+			return fileSizeRemedy(af)
+		}
+		return "split the file by responsibility into smaller files"
+	case "function_coupling":
+		return couplingRemedy(issue, fn)
+	case "dependency_direction":
+		return dependencyRemedy(issue)
+	case "consistent_patterns", "predictable_structure", "naming_uniqueness", "code_duplication", "logging_consistency", "file_access":
+		if r, ok := conventionRemedies[issue.Pattern]; ok {
+			return r
+		}
+	}
+	return subMetricRemedies[issue.SubMetric]
+}
+
+// subMetricRemedies is the guidance for sub-metrics whose issues carry no
+// function context worth tailoring the advice to.
+var subMetricRemedies = map[string]string{
+	"code_duplication":        "extract the repeated blocks into a shared helper and call it from each copy",
+	"package_cohesion":        "move each unrelated file group into its own package, or merge the files into the package whose code they call",
+	"naming_uniqueness":       "rename it after what it does in this package so search and completion find one obvious match",
+	"file_naming_conventions": "rename the file to follow the naming convention the rest of the project uses",
+	"predictable_structure":   "mirror the layout of the peer modules so code lives where readers expect it",
+	"sql_injection":           "use placeholders (? or $1) and pass the values as arguments; quote identifiers with a safe builder listed in profile.safe_sql_builders",
+	"hardcoded_secrets":       "move the value to an environment variable or secret store, rotate it, and purge it from the git history",
+	"interface_granularity":   "split the interface by caller role and have each consumer depend only on the methods it calls",
+	"interface_contracts":     "declare port interfaces in the domain or application layer and implement them in adapters",
+	"consistent_patterns":     "keep test doubles in *_test.go files or a dedicated mocks package",
+}
+
+// conventionRemedies is the guidance for the Go conventions checked under
+// consistent_patterns and predictable_structure, and for the exported
+// surface checked under naming_uniqueness, keyed by issue pattern.
+var conventionRemedies = map[string]string{
+	"getter-prefix":           "rename the getter to the bare field name; Go reserves no Get prefix for accessors",
+	"getter-field":            "rename the getter or the field so reading the call tells which value comes back",
+	"setter-error":            "return an error from the setter instead of panicking or discarding the failure",
+	"missing-constructor":     "add a New constructor that sets the unexported fields, so callers cannot build an unusable zero value",
+	"constructor-error":       "return (*T, error) and report the failed check instead of panicking or substituting a default",
+	"import-order":            "run goimports on the file to sort each import group",
+	"import-grouping":         "put standard library imports in the first group and other imports in later groups, separated by blank lines",
+	"import-alias":            "import the package under the alias the rest of the project uses, and never under a predeclared name such as string or len",
+	"constructor-nil-check":   "reject a nil required argument in the constructor with an error, rather than failing later on first use",
+	"file-type-missing":       "rename the file after the type it declares, or move the type into a file named after it",
+	"type-file-mismatch":      "move the type and its methods into a file named after the type",
+	"package-depth":           "move the package up the tree; a few levels of internal/ and feature directories are enough",
+	"package-sprawl":          "merge the tiny single-file packages into the packages that use them",
+	"test-helper":             "keep test helpers in _test.go files, sharing them through a testutil package that only tests import",
+	"license-header":          "add the required license header above the package clause, copied from a compliant file",
+	"embedding-depth":         "embed the inner type directly or hold it in a named field, so its methods are one step from the type that uses them",
+	"embedding-diamond":       "embed the shared type once and hold the other path in a named field, so every promoted member has one source",
+	"over-exported":           "unexport the listed symbols; nothing outside the package uses them, and a smaller API is easier to search and complete",
+	"receiver-name":           "rename the receiver to the short name the type's other methods use, never self or this",
+	"naked-return":            "write the returned values out in each return statement so the function reads without its signature",
+	"same-type-params":        "give the parameters distinct types or group them into a struct with named fields",
+	"mixed-loggers":           "log through the project's logger, or set profile.preferred_logger to the library you are moving to",
+	"fmt-print":               "take an io.Writer or a logger from the caller instead of writing to standard output",
+	"error-log-without-error": "pass the error as an attribute, e.g. slog.Any(\"err\", err), zap.Error(err) or .Err(err)",
+	"process-exit":            "return an error and let main decide the exit code; log.Fatal and os.Exit belong in package main only",
+	"permissive-mode":         "use 0600 or 0644 for files and 0700 or 0755 for directories; nothing should be world-writable",
+	"path-traversal":          "reduce the input with filepath.Base, or reject it unless filepath.IsLocal holds, before joining it to the base directory",
+	"tag-casing":              "rename the tags to the casing the rest of the project uses for that key",
+	"tag-missing":             "add a json tag to every exported field, or tag it json:\"-\" to keep it out of the encoding",
+	"tag-duplicate":           "give each field its own tag name, or drop the field that should not be encoded",
+	"magic-number":            "declare a named constant for the number and use it at every occurrence",
+	"duplicate-string":        "declare the string once as a constant, or behind a function that builds it, and refer to it everywhere",
+	"deprecated-call":         "switch to the replacement the deprecation notice names before the deprecated API is removed",
+}
+
+// functionAt returns the function in af whose body spans line, or nil.
+func functionAt(af *domain.AnalyzedFile, line int) *domain.Function {
+	if af == nil || line <= 0 {
+		return nil
+	}
+	for i := range af.Functions {
+		fn := &af.Functions[i]
+		if fn.LineStart <= line && line <= fn.LineEnd {
+			return fn
+		}
+	}
+	return nil
+}
+
+func functionSizeRemedy(fn *domain.Function) string {
+	if fn.MaxCaseArms >= 5 {
+		return fmt.Sprintf("move the %d-arm switch in %s into a dispatch map of small handler functions", fn.MaxCaseArms, fn.Name)
+	}
+	return fmt.Sprintf("split %s (lines %d-%d) into named helpers, one per step, and keep %s as the sequence of calls",
+		fn.Name, fn.LineStart, fn.LineEnd, fn.Name)
+}
+
+// complexityRemedy targets the construct that most likely drives the
+// cognitive complexity of fn: a long switch, deep nesting or a compound
+// condition.
+func complexityRemedy(fn *domain.Function) string {
+	switch {
+	case fn.MaxCaseArms >= 5:
+		return fmt.Sprintf("replace the %d-arm switch in %s with a dispatch map keyed on the case value", fn.MaxCaseArms, fn.Name)
+	case fn.MaxNesting >= 3:
+		return fmt.Sprintf("flatten %s (nesting depth %d): invert conditions into early returns and extract the innermost block into a helper",
+			fn.Name, fn.MaxNesting)
+	case fn.MaxCondOps >= 3:
+		return fmt.Sprintf("name the %d-operator condition in %s as a predicate function", fn.MaxCondOps, fn.Name)
+	}
+	return fmt.Sprintf("extract the else-if chain in %s starting at line %d into a dispatch map or separate functions", fn.Name, fn.LineStart)
+}
+
+func parameterRemedy(fn *domain.Function) string {
+	names := make([]string, 0, len(fn.Params))
+	for _, p := range fn.Params {
+		if p.Name != "" && p.Name != "_" {
+			names = append(names, p.Name)
+		}
+	}
+	group := fmt.Sprintf("the %d parameters", len(fn.Params))
+	if len(names) > 0 {
+		group = strings.Join(names, ", ")
+	}
+	if fn.Receiver != "" {
+		return fmt.Sprintf("move long-lived values among %s onto %s fields, and group the rest into a %sParams struct",
+			group, fn.Receiver, exportedName(fn.Name))
+	}
+	return fmt.Sprintf("group %s into a %sParams struct or functional options", group, exportedName(fn.Name))
+}
+
+func fileSizeRemedy(af *domain.AnalyzedFile) string {
+	if len(af.Functions) == 0 {
+		return "split the file by responsibility into smaller files"
+	}
+	largest := af.Functions[0]
+	for _, fn := range af.Functions[1:] {
+		if fn.LineEnd-fn.LineStart > largest.LineEnd-largest.LineStart {
+			largest = fn
+		}
+	}
+	return fmt.Sprintf("split the %d functions by responsibility into separate files; start by moving %s (lines %d-%d) and its helpers",
+		len(af.Functions), largest.Name, largest.LineStart, largest.LineEnd)
+}
+
+func couplingRemedy(issue domain.Issue, fn *domain.Function) string {
+	name := "the function"
+	if fn != nil {
+		name = fn.Name
+	}
+	if issue.Pattern == "hub" {
+		return fmt.Sprintf("split %s by caller group, or hide it behind a narrow interface, so changes stop rippling to every caller", name)
+	}
+	return fmt.Sprintf("group the calls in %s into a few intermediate steps or delegate them to a collaborator type", name)
+}
+
+func dependencyRemedy(issue domain.Issue) string {
+	switch issue.Pattern {
+	case "import-cycle":
+		return "break the cycle by moving the shared types into a lower-level package, or by depending on an interface declared by the importer"
+	case "coupling-outlier":
+		return "split the package so each part imports only the internal packages it needs"
+	}
+	return "declare an interface (port) in the inner layer and implement it in the outer layer instead of importing it"
+}
+
+// exportedName upper-cases the first letter of name.
+func exportedName(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
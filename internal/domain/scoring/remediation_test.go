@@ -0,0 +1,67 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemediate_CognitiveComplexityTargetsDominantConstruct(t *testing.T) {
+	sw := makeFunction("route", 80, 1, 1, 0)
+	sw.MaxCaseArms = 9
+	nested := makeFunction("Sync", 80, 1, 5, 0)
+	chain := makeFunction("classify", 80, 1, 1, 0)
+	chain.LineStart, chain.LineEnd = 10, 60
+
+	tests := []struct {
+		name string
+		fn   domain.Function
+		want string
+	}{
+		{"switch", sw, "replace the 9-arm switch in route with a dispatch map keyed on the case value"},
+		{"nesting", nested, "flatten Sync (nesting depth 5): invert conditions into early returns and extract the innermost block into a helper"},
+		{"else-if chain", chain, "extract the else-if chain in classify starting at line 10 into a dispatch map or separate functions"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := analyzed(makeFile("svc.go", 100, tt.fn))
+			issue := domain.Issue{SubMetric: "cognitive_complexity", File: "svc.go", Line: tt.fn.LineStart}
+			assert.Equal(t, tt.want, scoring.Remediate(issue, files))
+		})
+	}
+}
+
+func TestRemediate_ParameterCountNamesParameters(t *testing.T) {
+	fn := makeFunction("newServer", 20, 0, 1, 0)
+	fn.Params = []domain.Param{{Name: "host"}, {Name: "port"}, {Name: "tls"}, {Name: "log"}, {Name: "db"}}
+	files := analyzed(makeFile("server.go", 40, fn))
+
+	got := scoring.Remediate(domain.Issue{SubMetric: "parameter_count", File: "server.go", Line: 1}, files)
+	assert.Equal(t, "group host, port, tls, log, db into a NewServerParams struct or functional options", got)
+}
+
+func TestRemediate_WithoutFunctionContextFallsBack(t *testing.T) {
+	got := scoring.Remediate(domain.Issue{SubMetric: "function_size", File: "gone.go", Line: 3}, nil)
+	assert.Equal(t, "split the function into named helpers, one per step", got)
+
+	got = scoring.Remediate(domain.Issue{SubMetric: "dependency_direction", Pattern: "import-cycle"}, nil)
+	assert.Contains(t, got, "break the cycle")
+
+	assert.Empty(t, scoring.Remediate(domain.Issue{SubMetric: "ai_context_files"}, nil))
+}
+
+func TestAttachRemediations_KeepsExistingGuidance(t *testing.T) {
+	cats := []domain.CategoryScore{{Issues: []domain.Issue{
+		{SubMetric: "code_duplication", File: "a.go"},
+		{SubMetric: "code_duplication", File: "b.go", Remediation: "delete b.go"},
+	}}}
+
+	scoring.AttachRemediations(cats, nil)
+
+	require.Len(t, cats[0].Issues, 2)
+	assert.Contains(t, cats[0].Issues[0].Remediation, "shared helper")
+	assert.Equal(t, "delete b.go", cats[0].Issues[1].Remediation)
+}
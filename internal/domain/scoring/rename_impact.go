@@ -0,0 +1,185 @@
+package scoring
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// Kinds of references to a renamed symbol.
+const (
+	RefCall         = "call"
+	RefPossibleCall = "possible call" // method call on a value whose type is unknown
+	RefSelector     = "reference"     // pkg.Name used as a value, not called
+	RefType         = "type use"
+	RefReceiver     = "receiver"
+	RefString       = "string"
+)
+
+// Blast radius levels.
+const (
+	BlastLow    = "low"
+	BlastMedium = "medium"
+	BlastHigh   = "high"
+)
+
+// maxRenameTextBytes caps the size of a file searched for string references.
+const maxRenameTextBytes = 1 << 20
+
+// RenameReference is one place that names the symbol being renamed.
+type RenameReference struct {
+	Kind     string `json:"kind"`
+	File     string `json:"file"`
+	Line     int    `json:"line,omitempty"`
+	Function string `json:"function,omitempty"` // enclosing function, Type.Method for methods
+	Text     string `json:"text,omitempty"`     // the source line of a string reference
+}
+
+// InterfaceBinding is a type satisfying an interface the renamed symbol
+// takes part in. Breaks is set when renaming the symbol alone stops the
+// type satisfying the interface.
+type InterfaceBinding struct {
+	Interface string `json:"interface"` // package-qualified, as in BuildInterfaceMap
+	Type      string `json:"type"`
+	File      string `json:"file"`
+	Breaks    bool   `json:"breaks,omitempty"`
+}
+
+// BlastRadius sums up how far a rename reaches.
+type BlastRadius struct {
+	Files      int    `json:"files"`
+	Packages   int    `json:"packages"`
+	References int    `json:"references"`
+	PublicAPI  bool   `json:"public_api,omitempty"` // importers outside the module break too
+	Level      string `json:"level"`
+}
+
+// RenameImpact lists everything that names one symbol, to estimate what a
+// rename touches before making it.
+type RenameImpact struct {
+	Target     string             `json:"target"`
+	Symbol     Symbol             `json:"symbol"`
+	CallSites  []RenameReference  `json:"call_sites"`
+	References []RenameReference  `json:"references,omitempty"`
+	Bindings   []InterfaceBinding `json:"interface_bindings,omitempty"`
+	Strings    []RenameReference  `json:"string_references,omitempty"`
+	Blast      BlastRadius        `json:"blast_radius"`
+}
+
+// AnalyzeRenameImpact finds the symbol target names, as pkg.Symbol or
+// pkg.Type.Method with pkg a package name, module-relative directory or
+// import path, and collects its call sites (one per calling function),
+// other code references, the interface bindings it takes part in and the
+// string references the compiler would not catch: Go string literals and
+// the template and config files of the project, which read returns.
+// Method calls on values whose type is unknown without type checking are
+// listed as possible calls.
+func AnalyzeRenameImpact(target string, profile *domain.ScoringProfile, scan *domain.ScanResult,
+	analyzed map[string]*domain.AnalyzedFile, read func(file string) []byte) (*RenameImpact, error) {
+	index := BuildSymbolIndex(scan.ModulePath, profile, analyzed)
+	sym, err := resolveRenameTarget(target, scan.ModulePath, index, analyzed)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRenameScan(scan.ModulePath, sym)
+	for _, af := range sortedFiles(analyzed) {
+		r.scanFile(af)
+	}
+	r.bindings(domain.BuildInterfaceMap(analyzed))
+	files := slices.Clone(scan.AllFiles)
+	sort.Strings(files)
+	for _, f := range files {
+		if path.Ext(f) == ".go" || IsRenameTextFile(f) {
+			r.strings(f, read(f))
+		}
+	}
+
+	for _, refs := range [][]RenameReference{r.impact.CallSites, r.impact.References} {
+		sort.SliceStable(refs, func(i, j int) bool {
+			if refs[i].File != refs[j].File {
+				return refs[i].File < refs[j].File
+			}
+			return refs[i].Line < refs[j].Line
+		})
+	}
+	r.impact.Target = target
+	r.impact.Blast = r.blast(analyzed)
+	return r.impact, nil
+}
+
+// IsRenameTextFile reports whether a non-Go file is searched for string
+// references: templates, config and SQL.
+func IsRenameTextFile(file string) bool {
+	switch strings.ToLower(path.Ext(file)) {
+	case ".tmpl", ".tpl", ".gotmpl", ".gohtml", ".html", ".htm", ".txt",
+		".yaml", ".yml", ".json", ".toml", ".sql":
+		return true
+	}
+	return false
+}
+
+// resolveRenameTarget finds the one declaration target names. Methods of
+// interfaces are not in the symbol index and are looked up in the
+// interface definitions.
+func resolveRenameTarget(target, modulePath string, index []Symbol, analyzed map[string]*domain.AnalyzedFile) (Symbol, error) {
+	slash := strings.LastIndex(target, "/")
+	pkgName, name, ok := strings.Cut(target[slash+1:], ".")
+	if !ok || pkgName == "" || name == "" || strings.Count(name, ".") > 1 {
+		return Symbol{}, fmt.Errorf("target %q is not of the form pkg.Symbol or pkg.Type.Method", target)
+	}
+	pkg := target[:slash+1] + pkgName
+	inPackage := func(s Symbol) bool {
+		return s.Package == pkg || s.Package == modulePath+"/"+pkg || strings.HasSuffix(s.Package, "/"+pkg)
+	}
+
+	var found []Symbol
+	for _, s := range index {
+		if s.Name == name && inPackage(s) && !slices.ContainsFunc(found, func(f Symbol) bool { return f.Package == s.Package }) {
+			found = append(found, s)
+		}
+	}
+	if typ, method, ok := strings.Cut(name, "."); ok && len(found) == 0 {
+		for _, s := range index {
+			if s.Kind == domain.SymbolInterface && s.Name == typ && inPackage(s) {
+				if m, ok := interfaceMethod(s, method, analyzed); ok {
+					found = append(found, m)
+				}
+			}
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return Symbol{}, fmt.Errorf("no function, method, struct or interface %s in the module", target)
+	case 1:
+		return found[0], nil
+	}
+	var pkgs []string
+	for _, s := range found {
+		pkgs = append(pkgs, s.Package)
+	}
+	return Symbol{}, fmt.Errorf("%s is ambiguous, qualify its package: %s", target, strings.Join(pkgs, ", "))
+}
+
+// interfaceMethod returns method of the interface iface as a symbol.
+func interfaceMethod(iface Symbol, method string, analyzed map[string]*domain.AnalyzedFile) (Symbol, bool) {
+	for _, af := range analyzed {
+		if strings.ReplaceAll(af.Path, "\\", "/") != iface.File {
+			continue
+		}
+		for _, def := range af.InterfaceDefs {
+			if def.Name == iface.Name && slices.Contains(def.Methods, method) {
+				m := iface
+				m.Name, m.Kind, m.Exported = iface.Name+"."+method, domain.SymbolMethod, isUpper(method)
+				m.Signature = "method of interface " + iface.Name
+				return m, true
+			}
+		}
+	}
+	return Symbol{}, false
+}
@@ -0,0 +1,159 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+const storeImport = "example.com/app/internal/store"
+
+func renameFixture() (*domain.ScanResult, map[string]*domain.AnalyzedFile, map[string]string) {
+	analyzed := map[string]*domain.AnalyzedFile{
+		"internal/store/store.go": {
+			Path:          "internal/store/store.go",
+			Package:       "store",
+			Structs:       []string{"Memory"},
+			InterfaceDefs: []domain.InterfaceDef{{Name: "Store", Methods: []string{"Get"}}},
+			Functions: []domain.Function{
+				{Name: "NewMemory", Exported: true, LineStart: 10, Returns: []string{"*Memory"}},
+				{Name: "Get", Receiver: "*Memory", Exported: true, LineStart: 20},
+				{Name: "Fetch", Receiver: "*Memory", Exported: true, LineStart: 30,
+					Calls: []domain.Call{{Name: "Get", Receiver: "Memory", Line: 31}}},
+			},
+		},
+		"internal/service/service.go": {
+			Path:          "internal/service/service.go",
+			Package:       "service",
+			Imports:       []string{storeImport},
+			ImportAliases: map[string]string{storeImport: "st"},
+			QualifiedRefs: map[string][]string{storeImport: {"NewMemory", "Memory"}},
+			StructDefs:    []domain.StructDef{{Name: "Service", Fields: []domain.Param{{Name: "mem", Type: "*st.Memory"}}}},
+			Functions: []domain.Function{
+				{Name: "New", Exported: true, LineStart: 5,
+					Calls: []domain.Call{{Name: "NewMemory", Package: storeImport, Line: 6}}},
+				{Name: "Load", Receiver: "*Service", Exported: true, LineStart: 12,
+					Calls: []domain.Call{{Name: "Get", Method: true, Line: 13}}},
+			},
+		},
+		"internal/cache/cache.go": {
+			Path:      "internal/cache/cache.go",
+			Package:   "cache",
+			Structs:   []string{"Cache"},
+			Functions: []domain.Function{{Name: "Get", Receiver: "Cache", Exported: true, LineStart: 3}},
+		},
+	}
+	scan := &domain.ScanResult{
+		ModulePath: "example.com/app",
+		AllFiles: []string{"internal/store/store.go", "internal/service/service.go", "internal/cache/cache.go",
+			"templates/memory.tmpl", "README.md"},
+	}
+	texts := map[string]string{
+		"internal/service/service.go": "package service\n\n// Memory is not a string.\nvar kind = \"st.Memory\"\nvar r = 'M'\n",
+		"templates/memory.tmpl":       "{{ .Memory.Get }}\n{{ .MemoryUsage }}\n",
+		"README.md":                   "Memory docs are not searched.\n",
+	}
+	return scan, analyzed, texts
+}
+
+func analyzeRename(t *testing.T, target string) *scoring.RenameImpact {
+	t.Helper()
+	scan, analyzed, texts := renameFixture()
+	impact, err := scoring.AnalyzeRenameImpact(target, defaultProfile(), scan, analyzed,
+		func(file string) []byte { return []byte(texts[file]) })
+	require.NoError(t, err)
+	return impact
+}
+
+func TestAnalyzeRenameImpact_FunctionCallSites(t *testing.T) {
+	impact := analyzeRename(t, "store.NewMemory")
+
+	assert.Equal(t, domain.SymbolFunc, impact.Symbol.Kind)
+	assert.Equal(t, []scoring.RenameReference{
+		{Kind: scoring.RefCall, File: "internal/service/service.go", Line: 6, Function: "New"},
+	}, impact.CallSites)
+	assert.Empty(t, impact.References, "a called name is not also a bare reference")
+	assert.Equal(t, 2, impact.Blast.Files)
+	assert.Equal(t, 2, impact.Blast.Packages)
+	assert.Equal(t, scoring.BlastMedium, impact.Blast.Level)
+	assert.False(t, impact.Blast.PublicAPI, "internal packages are not public API")
+}
+
+func TestAnalyzeRenameImpact_StructReferencesAndStrings(t *testing.T) {
+	impact := analyzeRename(t, "internal/store.Memory")
+
+	assert.Equal(t, []scoring.RenameReference{
+		{Kind: scoring.RefType, File: "internal/service/service.go", Function: "Service.mem"},
+		{Kind: scoring.RefType, File: "internal/store/store.go", Line: 10, Function: "NewMemory"},
+		{Kind: scoring.RefReceiver, File: "internal/store/store.go", Line: 20, Function: "Memory.Get"},
+		{Kind: scoring.RefReceiver, File: "internal/store/store.go", Line: 30, Function: "Memory.Fetch"},
+	}, impact.References)
+	assert.Equal(t, []scoring.InterfaceBinding{
+		{Interface: "internal/store.Store", Type: "internal/store.Memory", File: "internal/store/store.go"},
+	}, impact.Bindings)
+
+	require.Len(t, impact.Strings, 2, "comments, rune literals, MemoryUsage and markdown do not count")
+	assert.Equal(t, "internal/service/service.go", impact.Strings[0].File)
+	assert.Equal(t, 4, impact.Strings[0].Line)
+	assert.Equal(t, `var kind = "st.Memory"`, impact.Strings[0].Text)
+	assert.Equal(t, "templates/memory.tmpl", impact.Strings[1].File)
+	assert.Equal(t, 1, impact.Strings[1].Line)
+}
+
+func TestAnalyzeRenameImpact_MethodBindingsBreak(t *testing.T) {
+	impact := analyzeRename(t, "store.Memory.Get")
+
+	assert.Equal(t, []scoring.RenameReference{
+		{Kind: scoring.RefPossibleCall, File: "internal/service/service.go", Line: 13, Function: "Service.Load"},
+		{Kind: scoring.RefCall, File: "internal/store/store.go", Line: 31, Function: "Memory.Fetch"},
+	}, impact.CallSites)
+	assert.Contains(t, impact.Bindings, scoring.InterfaceBinding{
+		Interface: "internal/store.Store", Type: "internal/store.Memory", File: "internal/store/store.go", Breaks: true,
+	})
+	assert.Contains(t, impact.Bindings, scoring.InterfaceBinding{
+		Interface: "internal/store.Store", Type: "internal/cache.Cache", File: "internal/cache/cache.go", Breaks: true,
+	}, "other implementations must rename in step")
+	assert.Equal(t, scoring.BlastHigh, impact.Blast.Level)
+}
+
+func TestAnalyzeRenameImpact_InterfaceMethod(t *testing.T) {
+	impact := analyzeRename(t, "store.Store.Get")
+
+	assert.Equal(t, "Store.Get", impact.Symbol.Name)
+	assert.Equal(t, "method of interface Store", impact.Symbol.Signature)
+	require.Len(t, impact.CallSites, 1)
+	assert.Equal(t, scoring.RefPossibleCall, impact.CallSites[0].Kind)
+	assert.Len(t, impact.Bindings, 2)
+	for _, b := range impact.Bindings {
+		assert.True(t, b.Breaks)
+	}
+}
+
+func TestAnalyzeRenameImpact_Errors(t *testing.T) {
+	scan, analyzed, _ := renameFixture()
+	analyzed["internal/other/get.go"] = &domain.AnalyzedFile{
+		Path: "internal/other/get.go", Package: "other", Structs: []string{"Memory"},
+	}
+	analyzed["internal/more/store/x.go"] = &domain.AnalyzedFile{
+		Path: "internal/more/store/x.go", Package: "store", Structs: []string{"Memory"},
+	}
+	read := func(string) []byte { return nil }
+
+	for target, want := range map[string]string{
+		"Memory":        "not of the form",
+		"store.Missing": "no function, method, struct or interface",
+		"store.Memory":  "ambiguous",
+	} {
+		_, err := scoring.AnalyzeRenameImpact(target, defaultProfile(), scan, analyzed, read)
+		require.Error(t, err, target)
+		assert.Contains(t, err.Error(), want, target)
+	}
+
+	impact, err := scoring.AnalyzeRenameImpact("internal/store.Memory", defaultProfile(), scan, analyzed, read)
+	require.NoError(t, err, "a longer package path disambiguates")
+	assert.Equal(t, "example.com/app/internal/store", impact.Symbol.Package)
+}
@@ -0,0 +1,274 @@
+package scoring
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// renameScan collects the references to one symbol. typ and member split
+// its name: a function or type has only one of them, a method both.
+type renameScan struct {
+	modulePath string
+	sym        Symbol
+	dir        string // module-relative directory of sym's package, "." for the root
+	typ        string
+	member     string
+	iface      bool // sym is a method of an interface
+	impact     *RenameImpact
+}
+
+func newRenameScan(modulePath string, sym Symbol) *renameScan {
+	r := &renameScan{modulePath: modulePath, sym: sym, dir: path.Dir(sym.File), impact: &RenameImpact{Symbol: sym, CallSites: []RenameReference{}}}
+	switch sym.Kind {
+	case domain.SymbolFunc:
+		r.member = sym.Name
+	case domain.SymbolMethod:
+		r.typ, r.member, _ = strings.Cut(sym.Name, ".")
+		r.iface = strings.HasPrefix(sym.Signature, "method of interface ")
+	default:
+		r.typ = sym.Name
+	}
+	return r
+}
+
+// word is the identifier the rename replaces.
+func (r *renameScan) word() string {
+	if r.member != "" {
+		return r.member
+	}
+	return r.typ
+}
+
+// scanFile collects the call sites and code references in one file.
+func (r *renameScan) scanFile(af *domain.AnalyzedFile) {
+	file := strings.ReplaceAll(af.Path, "\\", "/")
+	pkg := packageImportPath(r.modulePath, file)
+	qual, named := r.qualifier(af, pkg)
+	if !named && !r.method() {
+		return
+	}
+	found := false
+	for _, fn := range af.Functions {
+		name := fn.Name
+		if fn.Receiver != "" {
+			name = strings.TrimPrefix(fn.Receiver, "*") + "." + fn.Name
+		}
+		ref := RenameReference{File: file, Line: fn.LineStart, Function: name}
+		if r.member == "" && pkg == r.sym.Package && strings.TrimPrefix(fn.Receiver, "*") == r.typ {
+			ref.Kind = RefReceiver
+			r.impact.References = append(r.impact.References, ref)
+		}
+		if r.member == "" && r.usesType(fn, qual) {
+			ref.Kind = RefType
+			r.impact.References = append(r.impact.References, ref)
+			found = true
+		}
+		found = r.callSites(fn.Calls, pkg, ref) || found
+	}
+	found = r.fieldUses(af, file, qual) || found
+	if !found && !r.method() && pkg != r.sym.Package && slices.Contains(af.QualifiedRefs[r.sym.Package], r.word()) {
+		r.impact.References = append(r.impact.References, RenameReference{Kind: RefSelector, File: file})
+	}
+}
+
+// method reports whether sym is a method, which code can call without
+// importing its package.
+func (r *renameScan) method() bool {
+	return r.typ != "" && r.member != ""
+}
+
+// qualifier returns how code in af names sym's package: "" inside it and
+// the import name followed by a dot elsewhere. It reports false when af
+// does not import the package.
+func (r *renameScan) qualifier(af *domain.AnalyzedFile, pkg string) (string, bool) {
+	switch {
+	case pkg == r.sym.Package:
+		return "", true
+	case !slices.Contains(af.Imports, r.sym.Package):
+		return "", false
+	case af.ImportAliases[r.sym.Package] != "":
+		return af.ImportAliases[r.sym.Package] + ".", true
+	}
+	return path.Base(r.sym.Package) + ".", true
+}
+
+// callSites records the calls of one function that target sym and reports
+// whether there were any.
+func (r *renameScan) callSites(calls []domain.Call, pkg string, ref RenameReference) bool {
+	found := false
+	for _, c := range calls {
+		target := pkg
+		if c.Package != "" {
+			target = c.Package
+		}
+		ref.Line = c.Line
+		switch {
+		case c.Method && r.typ != "" && c.Name == r.member:
+			ref.Kind = RefPossibleCall
+		case !c.Method && !r.iface && target == r.sym.Package && c.Receiver == r.typ && c.Name == r.member:
+			ref.Kind = RefCall
+		case !c.Method && r.member == "" && target == r.sym.Package && c.Receiver == "" && c.Name == r.typ:
+			ref.Kind = RefCall // conversion
+		default:
+			continue
+		}
+		r.impact.CallSites = append(r.impact.CallSites, ref)
+		found = true
+	}
+	return found
+}
+
+// usesType reports whether a parameter or result of fn has sym's type.
+func (r *renameScan) usesType(fn domain.Function, qual string) bool {
+	for _, p := range fn.Params {
+		if mentionsType(p.Type, qual+r.typ) {
+			return true
+		}
+	}
+	for _, t := range fn.Returns {
+		if mentionsType(t, qual+r.typ) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldUses records the struct fields of af typed with sym's type.
+func (r *renameScan) fieldUses(af *domain.AnalyzedFile, file, qual string) bool {
+	if r.member != "" {
+		return false
+	}
+	found := false
+	for _, sd := range af.StructDefs {
+		for _, f := range sd.Fields {
+			if mentionsType(f.Type, qual+r.typ) {
+				r.impact.References = append(r.impact.References, RenameReference{Kind: RefType, File: file, Function: sd.Name + "." + f.Name})
+				found = true
+			}
+		}
+	}
+	return found
+}
+
+// mentionsType reports whether the type expression typ names the type
+// name, qualified or not, as a whole identifier: "[]*store.Memory" names
+// "store.Memory". An unqualified name must not follow a dot.
+func mentionsType(typ, name string) bool {
+	for _, i := range identIndexes(typ, name) {
+		if strings.Contains(name, ".") || i == 0 || typ[i-1] != '.' {
+			return true
+		}
+	}
+	return false
+}
+
+// bindings records the interface bindings sym takes part in: the
+// implementations of an interface or interface method, the interfaces a
+// struct satisfies, and for a method the interfaces its type satisfies
+// through it, along with the other types that must rename it in step.
+func (r *renameScan) bindings(ifaces []domain.InterfaceImplementations) {
+	self := domain.Qualify(r.dir, r.typ)
+	for _, ii := range ifaces {
+		switch {
+		case ii.Interface == self && (r.sym.Kind == domain.SymbolInterface || r.iface):
+			r.addBindings(ii, "", r.iface)
+		case r.sym.Kind == domain.SymbolStruct:
+			for _, impl := range ii.Implementations {
+				if impl.Type == self {
+					r.impact.Bindings = append(r.impact.Bindings, InterfaceBinding{Interface: ii.Interface, Type: impl.Type, File: impl.File})
+				}
+			}
+		case r.sym.Kind == domain.SymbolMethod && !r.iface && slices.Contains(ii.Methods, r.member):
+			if slices.ContainsFunc(ii.Implementations, func(impl domain.Implementation) bool { return impl.Type == self }) {
+				r.addBindings(ii, self, true)
+			}
+		}
+	}
+}
+
+// addBindings records the implementations of ii, with the type own, whose
+// method sym is, first.
+func (r *renameScan) addBindings(ii domain.InterfaceImplementations, own string, breaks bool) {
+	if own != "" {
+		r.impact.Bindings = append(r.impact.Bindings, InterfaceBinding{Interface: ii.Interface, Type: own, File: r.sym.File, Breaks: true})
+	}
+	for _, impl := range ii.Implementations {
+		if impl.Type != own {
+			r.impact.Bindings = append(r.impact.Bindings, InterfaceBinding{Interface: ii.Interface, Type: impl.Type, File: impl.File, Breaks: breaks})
+		}
+	}
+}
+
+// strings records the string references in one file: inside string
+// literals for Go files, anywhere for templates and config.
+func (r *renameScan) strings(file string, src []byte) {
+	if len(src) == 0 || len(src) > maxRenameTextBytes || !strings.Contains(string(src), r.word()) {
+		return
+	}
+	text := string(src)
+	spans := [][2]int{{0, len(text)}}
+	if path.Ext(file) == ".go" {
+		spans = goStringSpans(text)
+	}
+	for _, span := range spans {
+		for _, i := range identIndexes(text[span[0]:span[1]], r.word()) {
+			at := span[0] + i
+			start := strings.LastIndexByte(text[:at], '\n') + 1
+			end := strings.IndexByte(text[at:], '\n')
+			if end < 0 {
+				end = len(text) - at
+			}
+			r.impact.Strings = append(r.impact.Strings, RenameReference{
+				Kind: RefString, File: file, Line: strings.Count(text[:at], "\n") + 1,
+				Text: clip(strings.TrimSpace(text[start:at+end]), 100),
+			})
+		}
+	}
+}
+
+// blast sums up the files and packages the rename touches, including the
+// declaration. Strings and possible calls the compiler cannot check, a
+// binding the rename breaks and public API raise the level, as does reach.
+func (r *renameScan) blast(analyzed map[string]*domain.AnalyzedFile) BlastRadius {
+	im := r.impact
+	files := map[string]bool{r.sym.File: true}
+	uncheckable := len(im.Strings) > 0
+	for _, refs := range [][]RenameReference{im.CallSites, im.References, im.Strings} {
+		for _, ref := range refs {
+			files[ref.File] = true
+			uncheckable = uncheckable || ref.Kind == RefPossibleCall
+		}
+	}
+	breaks := false
+	for _, b := range im.Bindings {
+		files[b.File] = true
+		breaks = breaks || b.Breaks
+	}
+	dirs := map[string]bool{}
+	for f := range files {
+		dirs[path.Dir(f)] = true
+	}
+
+	key := r.sym.Name
+	if r.iface {
+		key = r.typ
+	}
+	_, public := domain.ExtractAPI(analyzed)[domain.Qualify(r.dir, key)]
+	b := BlastRadius{
+		Files:      len(files),
+		Packages:   len(dirs),
+		References: len(im.CallSites) + len(im.References) + len(im.Strings) + len(im.Bindings),
+		PublicAPI:  public && r.sym.Exported,
+		Level:      BlastLow,
+	}
+	switch {
+	case b.PublicAPI || breaks || b.Files > 10:
+		b.Level = BlastHigh
+	case uncheckable || b.Packages > 1 || b.Files > 3:
+		b.Level = BlastMedium
+	}
+	return b
+}
@@ -0,0 +1,83 @@
+package scoring
+
+import "strings"
+
+// clip shortens s to at most n runes, marking the cut with an ellipsis.
+func clip(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n-1]) + "…"
+	}
+	return s
+}
+
+// goStringSpans returns the byte ranges of the contents of the string
+// literals in Go source, skipping comments and rune literals.
+func goStringSpans(src string) [][2]int {
+	var spans [][2]int
+	for i := 0; i < len(src); i++ {
+		switch {
+		case strings.HasPrefix(src[i:], "//"):
+			i += lineRest(src[i:])
+		case strings.HasPrefix(src[i:], "/*"):
+			if end := strings.Index(src[i+2:], "*/"); end >= 0 {
+				i += end + 3
+			} else {
+				i = len(src)
+			}
+		case src[i] == '"' || src[i] == '\'' || src[i] == '`':
+			end := literalEnd(src, i)
+			if src[i] != '\'' {
+				spans = append(spans, [2]int{i + 1, end})
+			}
+			i = end
+		}
+	}
+	return spans
+}
+
+// lineRest returns the length of s up to its first newline.
+func lineRest(s string) int {
+	if n := strings.IndexByte(s, '\n'); n >= 0 {
+		return n
+	}
+	return len(s)
+}
+
+// literalEnd returns the index of the quote closing the literal opened at
+// src[start], or len(src) when it is unterminated.
+func literalEnd(src string, start int) int {
+	quote := src[start]
+	for i := start + 1; i < len(src); i++ {
+		switch {
+		case src[i] == quote:
+			return i
+		case src[i] == '\\' && quote != '`':
+			i++
+		case src[i] == '\n' && quote != '`':
+			return i
+		}
+	}
+	return len(src)
+}
+
+// identIndexes returns the offsets of the occurrences of word in s that
+// are whole identifiers.
+func identIndexes(s, word string) []int {
+	var out []int
+	for from := 0; ; {
+		i := strings.Index(s[from:], word)
+		if i < 0 {
+			return out
+		}
+		at := from + i
+		end := at + len(word)
+		if (at == 0 || !isIdentByte(s[at-1])) && (end == len(s) || !isIdentByte(s[end])) {
+			out = append(out, at)
+		}
+		from = end
+	}
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
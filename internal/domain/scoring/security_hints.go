@@ -0,0 +1,110 @@
+package scoring
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// ScoreSecurityHints scans string literals for hard-coded credentials,
+// query calls for SQL built from run-time values, and file I/O for
+// world-writable modes and paths joined from request input. Weight: 0 by
+// default, so its findings gate through max_issues without moving the
+// overall score; set weights.security_hints to count it.
+func ScoreSecurityHints(profile *domain.ScoringProfile, scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) domain.CategoryScore {
+	cat := domain.CategoryScore{
+		Name:   "security_hints",
+		Weight: 0,
+	}
+
+	var secrets, queries, files []domain.Issue
+	if scan != nil && scan.FileTallies != nil {
+		secrets = tallyIssues(scan.FileTallies, "hardcoded_secrets")
+		queries = tallyIssues(scan.FileTallies, "sql_injection")
+		files = tallyIssues(scan.FileTallies, "file_access")
+	} else {
+		secrets = collectSecretIssues(profile, analyzed)
+		queries = collectSQLInjectionIssues(profile, analyzed)
+		files = collectFileAccessIssues(analyzed)
+	}
+	cat.SubMetrics = []domain.SubMetric{
+		scoreHintFindings("hardcoded_secrets", 40, "hard-coded secrets", len(secrets)),
+		scoreHintFindings("sql_injection", 30, "queries built from run-time values", len(queries)),
+		scoreHintFindings("file_access", 30, "unsafe file modes or paths", len(files)),
+	}
+	for _, sm := range cat.SubMetrics {
+		cat.Score += sm.Score
+	}
+	cat.Issues = append(append(secrets, queries...), files...)
+	domain.ApplySeverityRules(profile.SeverityRules, cat.Issues)
+	return cat
+}
+
+// hintPenalty is the score one security finding costs: a single leaked
+// key or injectable query is as serious in a large project as in a small
+// one.
+const hintPenalty = 10
+
+// scoreHintFindings scores a security_hints sub-metric worth points
+// (hardcoded_secrets 40, sql_injection and file_access 30 each): it loses
+// hintPenalty points per finding.
+func scoreHintFindings(name string, points int, what string, found int) domain.SubMetric {
+	sm := domain.SubMetric{Name: name, Points: points}
+	sm.Score = max(sm.Points-found*hintPenalty, 0)
+	sm.Detail = "no " + what + " found"
+	if found > 0 {
+		sm.Detail = fmt.Sprintf("%d possible %s", found, what)
+	}
+	return sm
+}
+
+// securityHintIssues returns the security_hints issues of af, for
+// TallyFile.
+func securityHintIssues(profile *domain.ScoringProfile, af *domain.AnalyzedFile) []domain.Issue {
+	issues := secretIssues(profile, af)
+	issues = append(issues, sqlInjectionIssues(profile, af)...)
+	return append(issues, fileAccessIssues(af)...)
+}
+
+// collectSecretIssues reports the string literals the parser found to
+// look like credentials (see domain.SecretKind). Test files and testdata
+// are skipped unless profile.ScanTestSecrets is set, since fixtures hold
+// fake credentials by design. Messages never include the value.
+func collectSecretIssues(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	var issues []domain.Issue
+	for _, af := range sortedFiles(analyzed) {
+		issues = append(issues, secretIssues(profile, af)...)
+	}
+	return issues
+}
+
+// secretIssues returns the hardcoded_secrets issues of af.
+func secretIssues(profile *domain.ScoringProfile, af *domain.AnalyzedFile) []domain.Issue {
+	if af.IsGenerated || (!profile.ScanTestSecrets && isTestFixture(af.Path)) {
+		return nil
+	}
+	var issues []domain.Issue
+	for _, c := range af.SecretCandidates {
+		where := "a string literal"
+		if c.Name != "" {
+			where = c.Name
+		}
+		issues = append(issues, domain.Issue{
+			Severity:  domain.SeverityError,
+			Category:  "security_hints",
+			SubMetric: "hardcoded_secrets",
+			File:      af.Path,
+			Line:      c.Line,
+			Pattern:   "hardcoded-secret",
+		}.WithMessage("hardcoded_secrets.secret", c.Kind, where))
+	}
+	return issues
+}
+
+// isTestFixture reports whether path is a test file or lies in testdata.
+func isTestFixture(path string) bool {
+	return isTestFile(path) || slices.Contains(strings.Split(filepath.ToSlash(path), "/"), "testdata")
+}
@@ -0,0 +1,57 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectSecretIssues(t *testing.T) {
+	files := map[string]*domain.AnalyzedFile{
+		"config/config.go": {Path: "config/config.go", SecretCandidates: []domain.SecretCandidate{
+			{Name: "defaultPassword", Kind: "credential", Line: 7},
+		}},
+		"config/config_test.go": {Path: "config/config_test.go", SecretCandidates: []domain.SecretCandidate{
+			{Kind: "AWS access key", Line: 3},
+		}},
+		"config/testdata/fixture.go": {Path: "config/testdata/fixture.go", SecretCandidates: []domain.SecretCandidate{
+			{Name: "token", Kind: "credential", Line: 1},
+		}},
+	}
+	p := domain.DefaultProfile()
+
+	issues := collectSecretIssues(&p, files)
+
+	require.Len(t, issues, 1)
+	assert.Equal(t, domain.SeverityError, issues[0].Severity)
+	assert.Equal(t, "security_hints", issues[0].Category)
+	assert.Equal(t, 7, issues[0].Line)
+	assert.Equal(t, "possible credential hard-coded in defaultPassword", issues[0].Message)
+	assert.NotContains(t, issues[0].Message, "hunter2", "reports must not repeat the secret")
+
+	p.ScanTestSecrets = true
+	assert.Len(t, collectSecretIssues(&p, files), 3)
+}
+
+func TestScoreSecurityHints(t *testing.T) {
+	p := domain.DefaultProfile()
+	files := map[string]*domain.AnalyzedFile{
+		"main.go": {Path: "main.go", SecretCandidates: []domain.SecretCandidate{
+			{Kind: "AWS access key", Line: 3},
+			{Name: "apiToken", Kind: "credential", Line: 4},
+		}, SQLCalls: []domain.SQLCall{
+			{Method: "Query", Dynamic: []string{"name"}, Line: 9},
+			{Method: "Exec", Dynamic: []string{"strconv.Itoa(id)"}, Line: 12},
+		}},
+	}
+
+	cat := ScoreSecurityHints(&p, nil, files)
+
+	assert.Equal(t, "security_hints", cat.Name)
+	assert.Zero(t, cat.Weight, "informational unless weighted in config")
+	assert.Equal(t, 70, cat.Score, "20/40 for two secrets, 20/30 for one unsafe query, 30/30 for file access")
+	assert.Len(t, cat.Issues, 3)
+	assert.Equal(t, 100, ScoreSecurityHints(&p, nil, nil).Score)
+}
@@ -0,0 +1,77 @@
+package scoring
+
+import (
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// nakedReturnMaxLines is the function length above which a bare return
+// makes readers scroll back to the signature, as in the nakedret linter.
+const nakedReturnMaxLines = 5
+
+// minSameTypeRun is the number of consecutive parameters of one type from
+// which callers can transpose arguments without the compiler noticing.
+const minSameTypeRun = 3
+
+// checkSignatures complements parameter_count with two signature checks on
+// non-test, non-generated functions: naked returns in functions longer
+// than nakedReturnMaxLines, and runs of minSameTypeRun or more consecutive
+// parameters of the same type. Each function with named results past the
+// length limit is one checked item for the first check, and each function
+// with at least minSameTypeRun parameters one for the second.
+func checkSignatures(analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		for _, fn := range af.Functions {
+			iss := domain.Issue{
+				Severity:  domain.SeverityInfo,
+				Category:  "predictability",
+				SubMetric: "consistent_patterns",
+				File:      af.Path,
+				Line:      fn.LineStart,
+			}
+			if lines := fn.LineEnd - fn.LineStart + 1; fn.NamedResults && lines > nakedReturnMaxLines {
+				check.checked++
+				if fn.NakedReturns > 0 {
+					iss.Pattern = "naked-return"
+					check.issues = append(check.issues, iss.WithMessage("consistent_patterns.naked_return", fn.Name, fn.NakedReturns, lines))
+				}
+			}
+
+			if len(fn.Params) < minSameTypeRun {
+				continue
+			}
+			check.checked++
+			if start, n := longestSameTypeRun(fn.Params); n >= minSameTypeRun {
+				names := make([]string, n)
+				for i, p := range fn.Params[start : start+n] {
+					names[i] = p.Name
+				}
+				iss.Pattern = "same-type-params"
+				check.issues = append(check.issues, iss.WithMessage("consistent_patterns.same_type_params",
+					fn.Name, n, fn.Params[start].Type, strings.Join(names, ", ")))
+			}
+		}
+	}
+	return check
+}
+
+// longestSameTypeRun returns the start and length of the longest run of
+// consecutive parameters sharing a type.
+func longestSameTypeRun(params []domain.Param) (start, n int) {
+	for i := 0; i < len(params); {
+		j := i + 1
+		for j < len(params) && params[j].Type == params[i].Type {
+			j++
+		}
+		if j-i > n {
+			start, n = i, j-i
+		}
+		i = j
+	}
+	return start, n
+}
@@ -0,0 +1,51 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLongestSameTypeRun(t *testing.T) {
+	params := []domain.Param{
+		{Name: "ctx", Type: "context.Context"},
+		{Name: "from", Type: "string"}, {Name: "to", Type: "string"}, {Name: "cc", Type: "string"},
+		{Name: "n", Type: "int"},
+	}
+	start, n := longestSameTypeRun(params)
+	assert.Equal(t, 1, start)
+	assert.Equal(t, 3, n)
+
+	_, n = longestSameTypeRun(nil)
+	assert.Zero(t, n)
+}
+
+func TestCheckSignatures(t *testing.T) {
+	files := map[string]*domain.AnalyzedFile{
+		"mail/mail.go": {Path: "mail/mail.go", Package: "mail", Functions: []domain.Function{
+			{Name: "parse", LineStart: 1, LineEnd: 20, NamedResults: true, NakedReturns: 2},
+			{Name: "split", LineStart: 30, LineEnd: 33, NamedResults: true, NakedReturns: 1},
+			{Name: "load", LineStart: 40, LineEnd: 60, NamedResults: true},
+			{Name: "Send", LineStart: 70, LineEnd: 75, Params: []domain.Param{
+				{Name: "from", Type: "string"}, {Name: "to", Type: "string"}, {Name: "subject", Type: "string"},
+			}},
+			{Name: "Retry", LineStart: 80, LineEnd: 85, Params: []domain.Param{
+				{Name: "n", Type: "int"}, {Name: "d", Type: "time.Duration"}, {Name: "max", Type: "int"},
+			}},
+		}},
+		"mail/mail_test.go": {Path: "mail/mail_test.go", Package: "mail", Functions: []domain.Function{
+			{Name: "check", LineStart: 1, LineEnd: 20, NamedResults: true, NakedReturns: 1},
+		}},
+	}
+
+	check := checkSignatures(files)
+
+	assert.Equal(t, 4, check.checked, "short functions and tests are not checked for naked returns")
+	require.Len(t, check.issues, 2)
+	assert.Equal(t, "function parse has 2 naked returns in 20 lines; return the values explicitly", check.issues[0].Message)
+	assert.Equal(t, "naked-return", check.issues[0].Pattern)
+	assert.Equal(t, "function Send takes 3 consecutive string parameters (from, to, subject) that callers can transpose unnoticed", check.issues[1].Message)
+	assert.Equal(t, 70, check.issues[1].Line)
+}
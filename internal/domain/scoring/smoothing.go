@@ -0,0 +1,46 @@
+package scoring
+
+import (
+	"fmt"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// smoothRatio returns hits/n. With profile.SmoothSmallSamples it blends in
+// SmoothingWeight pseudo-units that score SmoothingPrior, a Bayesian
+// average: a three-function project with one long function lands near the
+// prior instead of at 67%, while large samples are barely moved.
+func smoothRatio(profile *domain.ScoringProfile, hits float64, n int) float64 {
+	if profile == nil || !profile.SmoothSmallSamples {
+		return hits / float64(n)
+	}
+	w := float64(smoothingWeight(profile))
+	return (hits + profile.SmoothingPrior*w) / (float64(n) + w)
+}
+
+// smoothingNote returns the suffix of a sub-metric Detail whose score comes
+// from smoothRatio: the smoothed figure scored, so the plain ratio the
+// Detail reports is not mistaken for it. Without smoothing it is "".
+func smoothingNote(profile *domain.ScoringProfile, hits float64, n int) string {
+	if profile == nil || !profile.SmoothSmallSamples {
+		return ""
+	}
+	return fmt.Sprintf("; scored as %.0f%% after small-sample smoothing", smoothRatio(profile, hits, n)*100)
+}
+
+// smoothCount returns the normalizer for severity penalties: n, raised to
+// SmoothingWeight under small-sample smoothing so a single issue in a tiny
+// project does not read as a high debt ratio.
+func smoothCount(profile *domain.ScoringProfile, n int) int {
+	if profile == nil || !profile.SmoothSmallSamples || n == 0 {
+		return n
+	}
+	return max(n, smoothingWeight(profile))
+}
+
+func smoothingWeight(profile *domain.ScoringProfile) int {
+	if profile.SmoothingWeight > 0 {
+		return profile.SmoothingWeight
+	}
+	return 10
+}
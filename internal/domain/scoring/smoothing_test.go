@@ -0,0 +1,81 @@
+package scoring
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSmoothRatio(t *testing.T) {
+	off := domain.DefaultProfile()
+	on := domain.DefaultProfile()
+	on.SmoothSmallSamples = true
+
+	tests := []struct {
+		name    string
+		profile *domain.ScoringProfile
+		hits    float64
+		n       int
+		want    float64
+	}{
+		{"disabled is the plain ratio", &off, 2, 3, 2.0 / 3},
+		{"nil profile is the plain ratio", nil, 1, 4, 0.25},
+		{"tiny sample pulled toward prior", &on, 2, 3, (2 + 8.0) / 13},
+		{"large sample barely moves", &on, 900, 1000, (900 + 8.0) / 1010},
+		{"perfect tiny sample stays high", &on, 3, 3, (3 + 8.0) / 13},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, smoothRatio(tt.profile, tt.hits, tt.n), 1e-9)
+		})
+	}
+}
+
+func TestSmoothCount(t *testing.T) {
+	p := domain.DefaultProfile()
+	assert.Equal(t, 3, smoothCount(&p, 3))
+
+	p.SmoothSmallSamples = true
+	assert.Equal(t, 10, smoothCount(&p, 3))
+	assert.Equal(t, 250, smoothCount(&p, 250))
+	assert.Equal(t, 0, smoothCount(&p, 0), "no functions means no penalty base")
+}
+
+func TestScoreCodeHealth_SmoothingStabilizesTinyProjects(t *testing.T) {
+	long := domain.Function{Name: "Huge", LineStart: 1, LineEnd: 200}
+	short := domain.Function{Name: "Small", LineStart: 1, LineEnd: 10}
+	files := couplingFiles(couplingFile("main.go", long, short, short))
+
+	raw := domain.DefaultProfile()
+	smoothed := domain.DefaultProfile()
+	smoothed.SmoothSmallSamples = true
+
+	rawScore := ScoreCodeHealth(&raw, nil, files)
+	smoothedScore := ScoreCodeHealth(&smoothed, nil, files)
+	assert.Greater(t, smoothedScore.Score, rawScore.Score,
+		"one oversized function among three should not dominate")
+}
+
+func TestScoreCodeHealth_SmoothedDetailReportsRawRatio(t *testing.T) {
+	long := domain.Function{Name: "Huge", LineStart: 1, LineEnd: 200}
+	short := domain.Function{Name: "Small", LineStart: 1, LineEnd: 10}
+	files := couplingFiles(couplingFile("main.go", long, short, short))
+
+	raw := domain.DefaultProfile()
+	smoothed := domain.DefaultProfile()
+	smoothed.SmoothSmallSamples = true
+	earned := decayCredit(&raw, 200, raw.MaxFunctionLines) + 2
+
+	rawSize := ScoreCodeHealth(&raw, nil, files).SubMetrics[0]
+	smoothedSize := ScoreCodeHealth(&smoothed, nil, files).SubMetrics[0]
+
+	plain := fmt.Sprintf("%.0f%% of 3 functions within size limits (max 50 lines)", earned/3*100)
+	assert.Equal(t, plain, rawSize.Detail)
+	assert.Equal(t, plain+fmt.Sprintf("; scored as %.0f%% after small-sample smoothing", smoothRatio(&smoothed, earned, 3)*100),
+		smoothedSize.Detail, "the detail keeps the plain ratio and names the smoothed one")
+	assert.Equal(t, int(math.Round(smoothRatio(&smoothed, earned, 3)*20)), smoothedSize.Score)
+	assert.Greater(t, smoothedSize.Score, rawSize.Score)
+}
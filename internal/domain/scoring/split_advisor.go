@@ -0,0 +1,228 @@
+package scoring
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// SplitPlan proposes splitting one package whose files mix
+// responsibilities into packages of one responsibility each.
+type SplitPlan struct {
+	Package  string        `json:"package"` // module-relative directory, "." for the root
+	Reasons  []string      `json:"reasons"`
+	Cohesion float64       `json:"cohesion"`
+	FanIn    int           `json:"fan_in"`  // module packages importing it
+	FanOut   int           `json:"fan_out"` // module packages it imports
+	Targets  []SplitTarget `json:"targets"`
+	// Warnings name the files that stay but use declarations of files that
+	// move, which would make the split packages import each other.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// SplitTarget is one package of a split plan and the files it receives.
+// The first target of a plan keeps the original package.
+type SplitTarget struct {
+	Package string   `json:"package"`
+	Role    string   `json:"role,omitempty"`
+	Files   []string `json:"files"`
+}
+
+// Responsibility families a file can belong to. Ports go with the core
+// types they describe.
+const (
+	familyCore    = "core"
+	familyAdapter = "adapter"
+	familyService = "orchestrator"
+)
+
+// adapterImports are the imports that make a single file an adapter. The
+// package-level stdlibIO set also counts logging and JSON, which core
+// files use too.
+var adapterImports = []string{"net", "net/http", "net/rpc", "net/smtp", "database/sql", "html/template", "crypto/tls"}
+
+// adapterFileWords are the file name words that make a file an adapter:
+// user_handler.go, postgres_repo.go. Words such as cache or client also
+// name ports and plain types, so they take an import to tell.
+var adapterFileWords = map[string]bool{
+	"handler": true, "handlers": true, "controller": true, "controllers": true,
+	"repository": true, "repo": true, "middleware": true, "gateway": true,
+	"grpc": true, "graphql": true, "http": true, "transport": true,
+	"postgres": true, "mysql": true, "sqlite": true, "redis": true, "mongo": true, "kafka": true,
+}
+
+// hubMinEdges is the fan-in and fan-out from which a package counts as a
+// hub when both are also twice the module median.
+const hubMinEdges = 3
+
+// AdviseSplits finds the packages that mix responsibilities and proposes
+// which files belong in which new package. A core, ports or application
+// package mixes responsibilities when some of its files are adapters by
+// their name, imports or I/O parameters (an HTTP handler next to the
+// domain types, say); any package does when its cohesion is below the
+// profile minimum and its files form separate groups of two or more. High
+// fan-in together with high fan-out is reported as a further reason.
+// Adapter packages may declare their own types, and main, test and
+// generated files are left out.
+func AdviseSplits(modulePath string, profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) []SplitPlan {
+	byDir := map[string][]*domain.AnalyzedFile{}
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || isTestFile(af.Path) || af.Package == "main" {
+			continue
+		}
+		dir := path.Dir(strings.ReplaceAll(af.Path, "\\", "/"))
+		byDir[dir] = append(byDir[dir], af)
+	}
+	roles := map[string]ArchRole{}
+	if graph := BuildImportGraph(modulePath, analyzed); graph != nil {
+		for pkg, ap := range graph.ClassifyPackages(modulePath, profile) {
+			dir := packageDir(modulePath, pkg)
+			roles[dir] = splitRole(dir, ap.Role)
+		}
+	}
+	hubs := packageHubs(modulePath, analyzed)
+
+	var plans []SplitPlan
+	for _, dir := range sortedKeys(byDir) {
+		if files := byDir[dir]; len(files) >= 2 {
+			if plan, ok := adviseSplit(profile, dir, roles[dir], files, hubs[dir]); ok {
+				plans = append(plans, plan)
+			}
+		}
+	}
+	return plans
+}
+
+// adviseSplit plans the split of the package in dir, if it needs one.
+func adviseSplit(profile *domain.ScoringProfile, dir string, role ArchRole, files []*domain.AnalyzedFile, hub *packageHub) (SplitPlan, bool) {
+	cohesion := packageCohesion(dir, files)
+	plan := SplitPlan{Package: dir, Cohesion: cohesion.Cohesion}
+	if hub != nil {
+		plan.FanIn, plan.FanOut = hub.fanIn, hub.fanOut
+	}
+
+	var adapters []string
+	if role != RoleAdapter && role != RoleEntryPoint {
+		for _, af := range files {
+			if isAdapterFile(af) {
+				adapters = append(adapters, af.Path)
+			}
+		}
+	}
+	switch {
+	case len(adapters) > 0 && len(adapters) < len(files):
+		family := roleFamily(role)
+		plan.Reasons = append(plan.Reasons, fmt.Sprintf("mixes %s (%d %s) with adapters (%d %s)",
+			familyLabel(family), len(files)-len(adapters), plural(len(files)-len(adapters), "file"),
+			len(adapters), plural(len(adapters), "file")))
+		plan.Targets = adapterTargets(dir, family, files, adapters)
+	case cohesion.Cohesion < minPackageCohesion(profile) && countLarger(cohesion.Groups, 1) >= 2:
+		plan.Reasons = append(plan.Reasons, fmt.Sprintf("cohesion %.2f: its files form %d groups that share no declarations",
+			cohesion.Cohesion, len(cohesion.Groups)))
+		plan.Targets = groupTargets(dir, cohesion.Groups)
+	default:
+		return SplitPlan{}, false
+	}
+	if hub != nil && hub.hub {
+		plan.Reasons = append(plan.Reasons, fmt.Sprintf("hub: imported by %d module packages and importing %d",
+			hub.fanIn, hub.fanOut))
+	}
+	plan.Warnings = splitWarnings(files, plan.Targets)
+	return plan, true
+}
+
+// splitRole returns the role a package is meant to play: the one its
+// path hints at, else its classified role unless that is adapter, since
+// adapter files alone make a package classify as one.
+func splitRole(dir string, classified ArchRole) ArchRole {
+	if hint := classifyByNaming(dir, path.Base(dir)); hint.Role != "" {
+		return hint.Role
+	}
+	if classified == RoleAdapter {
+		return RoleUnclassified
+	}
+	return classified
+}
+
+// isAdapterFile reports whether a file wraps I/O: it imports a network,
+// database or template package or a known external I/O library, takes an
+// I/O parameter such as *http.Request, or is named like an adapter and
+// declares no interfaces, which would make it a port.
+func isAdapterFile(af *domain.AnalyzedFile) bool {
+	for _, imp := range af.Imports {
+		if slices.Contains(adapterImports, imp) || isExternalIO(imp) {
+			return true
+		}
+	}
+	for _, fn := range af.Functions {
+		if slices.ContainsFunc(fn.Params, func(p domain.Param) bool { return isIOParamType(p.Type) }) {
+			return true
+		}
+	}
+	return len(af.InterfaceDefs) == 0 && adapterFileWord(af.Path) != ""
+}
+
+// roleFamily maps a package role to the responsibility its non-adapter
+// files keep.
+func roleFamily(role ArchRole) string {
+	if role == RoleOrchestrator {
+		return familyService
+	}
+	return familyCore
+}
+
+// adapterFileWord returns the first adapter word in a file name, or "".
+func adapterFileWord(file string) string {
+	for _, word := range strings.Split(strings.TrimSuffix(path.Base(file), ".go"), "_") {
+		if adapterFileWords[word] {
+			return word
+		}
+	}
+	return ""
+}
+
+// packageDir returns the module-relative directory of an import path in
+// the module, "." for the module root.
+func packageDir(modulePath, pkg string) string {
+	if dir := strings.TrimPrefix(strings.TrimPrefix(pkg, modulePath), "/"); dir != "" {
+		return dir
+	}
+	return "."
+}
+
+func familyLabel(family string) string {
+	if family == familyService {
+		return "orchestration"
+	}
+	return "core types"
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return word
+	}
+	return word + "s"
+}
+
+func countLarger(groups [][]string, size int) int {
+	n := 0
+	for _, g := range groups {
+		if len(g) > size {
+			n++
+		}
+	}
+	return n
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
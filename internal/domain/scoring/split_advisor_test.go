@@ -0,0 +1,76 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func splitFixture() map[string]*domain.AnalyzedFile {
+	files := []*domain.AnalyzedFile{
+		{Path: "internal/billing/invoice.go", Package: "billing", Structs: []string{"Invoice"},
+			Functions: []domain.Function{{Name: "Close", Receiver: "*Invoice", Exported: true,
+				Calls: []domain.Call{{Name: "SaveInvoice"}}}}},
+		{Path: "internal/billing/payment.go", Package: "billing", Structs: []string{"Payment"},
+			Functions: []domain.Function{{Name: "Pay", Exported: true, Params: []domain.Param{{Name: "inv", Type: "*Invoice"}}}}},
+		{Path: "internal/billing/http.go", Package: "billing", Imports: []string{"net/http"},
+			Functions: []domain.Function{{Name: "Serve", Exported: true, Params: []domain.Param{{Name: "w", Type: "http.ResponseWriter"}}}}},
+		{Path: "internal/billing/postgres_repo.go", Package: "billing", Imports: []string{"github.com/jackc/pgx/v5"},
+			Functions: []domain.Function{{Name: "SaveInvoice", Exported: true, Params: []domain.Param{{Name: "inv", Type: "*Invoice"}}}}},
+		{Path: "internal/billing/cache.go", Package: "billing", InterfaceDefs: []domain.InterfaceDef{{Name: "Cache", Methods: []string{"Get"}}}},
+
+		{Path: "internal/util/report.go", Package: "util", Functions: []domain.Function{{Name: "Report", Calls: []domain.Call{{Name: "format"}}}}},
+		{Path: "internal/util/report_format.go", Package: "util", Functions: []domain.Function{{Name: "format"}}},
+		{Path: "internal/util/mail.go", Package: "util", Functions: []domain.Function{{Name: "Mail", Calls: []domain.Call{{Name: "send"}}}}},
+		{Path: "internal/util/mail_send.go", Package: "util", Functions: []domain.Function{{Name: "send"}}},
+		{Path: "internal/util/strings.go", Package: "util", Functions: []domain.Function{{Name: "Trim"}}},
+		{Path: "internal/util/ints.go", Package: "util", Functions: []domain.Function{{Name: "Abs"}}},
+
+		{Path: "internal/adapters/web/server.go", Package: "web", Imports: []string{"net/http"},
+			Functions: []domain.Function{{Name: "Run", Calls: []domain.Call{{Name: "route"}}}}},
+		{Path: "internal/adapters/web/dto.go", Package: "web", Structs: []string{"Response"},
+			Functions: []domain.Function{{Name: "route", Params: []domain.Param{{Name: "r", Type: "Response"}}}}},
+	}
+	analyzed := map[string]*domain.AnalyzedFile{}
+	for _, af := range files {
+		analyzed[af.Path] = af
+	}
+	return analyzed
+}
+
+func TestAdviseSplits_MovesAdaptersOutOfCorePackages(t *testing.T) {
+	plans := scoring.AdviseSplits("example.com/app", defaultProfile(), splitFixture())
+	require.Len(t, plans, 2, "adapter packages may declare their own types")
+
+	billing := plans[0]
+	assert.Equal(t, "internal/billing", billing.Package)
+	assert.Equal(t, []string{"mixes core types (3 files) with adapters (2 files)"}, billing.Reasons)
+	assert.Equal(t, []scoring.SplitTarget{
+		{Package: "internal/billing", Role: "core", Files: []string{
+			"internal/billing/cache.go", "internal/billing/invoice.go", "internal/billing/payment.go"}},
+		{Package: "internal/billing/http", Role: "adapter", Files: []string{"internal/billing/http.go"}},
+		{Package: "internal/billing/pgx", Role: "adapter", Files: []string{"internal/billing/postgres_repo.go"}},
+	}, billing.Targets)
+	require.Len(t, billing.Warnings, 1)
+	assert.Contains(t, billing.Warnings[0], "invoice.go uses SaveInvoice from postgres_repo.go, which moves to internal/billing/pgx")
+}
+
+func TestAdviseSplits_SplitsUnrelatedFileGroups(t *testing.T) {
+	plans := scoring.AdviseSplits("example.com/app", defaultProfile(), splitFixture())
+	require.Len(t, plans, 2)
+
+	util := plans[1]
+	assert.Equal(t, "internal/util", util.Package)
+	assert.InDelta(t, 0.4, util.Cohesion, 0.001)
+	assert.Contains(t, util.Reasons[0], "its files form 4 groups")
+	assert.Equal(t, []scoring.SplitTarget{
+		{Package: "internal/util", Files: []string{
+			"internal/util/ints.go", "internal/util/mail.go", "internal/util/mail_send.go", "internal/util/strings.go"}},
+		{Package: "internal/util/report", Files: []string{"internal/util/report.go", "internal/util/report_format.go"}},
+	}, util.Targets)
+	assert.Empty(t, util.Warnings)
+}
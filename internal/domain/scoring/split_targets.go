@@ -0,0 +1,169 @@
+package scoring
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// adapterTargets keeps the files of family in dir and moves the adapters
+// into one package per technology they wrap, e.g. dir/http or dir/pgx.
+func adapterTargets(dir, family string, files []*domain.AnalyzedFile, adapters []string) []SplitTarget {
+	targets := []SplitTarget{{Package: dir, Role: family}}
+	index := map[string]int{dir: 0}
+	for _, af := range files {
+		pkg := dir
+		if slices.Contains(adapters, af.Path) {
+			pkg = joinPackage(dir, adapterPackageName(af))
+		}
+		i, ok := index[pkg]
+		if !ok {
+			i = len(targets)
+			index[pkg] = i
+			targets = append(targets, SplitTarget{Package: pkg, Role: familyAdapter})
+		}
+		targets[i].Files = append(targets[i].Files, af.Path)
+	}
+	return targets
+}
+
+// adapterPackageName names the package an adapter file moves to: after
+// the technology it imports, else the adapter word in its name, e.g.
+// handler for user_handler.go.
+func adapterPackageName(af *domain.AnalyzedFile) string {
+	for _, imp := range af.Imports {
+		if slices.Contains(adapterImports, imp) || isExternalIO(imp) {
+			return importName(imp)
+		}
+	}
+	if word := adapterFileWord(af.Path); word != "" {
+		return word
+	}
+	return "adapter"
+}
+
+// importName returns the last segment of an import path that is not a
+// major version suffix such as v5.
+func importName(imp string) string {
+	segs := strings.Split(imp, "/")
+	name := segs[len(segs)-1]
+	if len(segs) > 1 && len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = segs[len(segs)-2]
+	}
+	return strings.NewReplacer("-", "", ".", "").Replace(strings.TrimPrefix(name, "go-"))
+}
+
+// groupTargets keeps the largest group of linked files and moves every
+// other group of two or more files into a package named after the word
+// its file names share most; single files stay.
+func groupTargets(dir string, groups [][]string) []SplitTarget {
+	targets := []SplitTarget{{Package: dir, Files: slices.Clone(groups[0])}}
+	used := map[string]bool{dir: true}
+	for _, g := range groups[1:] {
+		if len(g) < 2 {
+			targets[0].Files = append(targets[0].Files, g...)
+			continue
+		}
+		name := groupName(g)
+		pkg := joinPackage(dir, name)
+		for n := 2; used[pkg]; n++ {
+			pkg = joinPackage(dir, fmt.Sprintf("%s%d", name, n))
+		}
+		used[pkg] = true
+		targets = append(targets, SplitTarget{Package: pkg, Files: slices.Clone(g)})
+	}
+	sort.Strings(targets[0].Files)
+	return targets
+}
+
+// groupName returns the first word most of the group's file names start
+// with: invoice for invoice.go and invoice_store.go.
+func groupName(files []string) string {
+	counts := map[string]int{}
+	best := ""
+	for _, f := range files {
+		word, _, _ := strings.Cut(strings.TrimSuffix(path.Base(f), ".go"), "_")
+		word = strings.ToLower(word)
+		counts[word]++
+		if counts[word] > counts[best] || counts[word] == counts[best] && word < best {
+			best = word
+		}
+	}
+	return best
+}
+
+func joinPackage(dir, name string) string {
+	if dir == "." {
+		return name
+	}
+	return dir + "/" + name
+}
+
+// splitWarnings names the files of the kept package that use declarations
+// of moved files. The moved packages import the kept one, so such a use
+// would become an import cycle.
+func splitWarnings(files []*domain.AnalyzedFile, targets []SplitTarget) []string {
+	target := map[string]string{}
+	for _, t := range targets {
+		for _, f := range t.Files {
+			target[f] = t.Package
+		}
+	}
+	decls := indexPackageDecls(files)
+	var warnings []string
+	for _, af := range files {
+		if target[af.Path] != targets[0].Package {
+			continue
+		}
+		seen := map[string]bool{}
+		for _, fn := range af.Functions {
+			for _, key := range fileReferences(fn) {
+				j, ok := decls.lookup(key)
+				if !ok || seen[key] || target[files[j].Path] == targets[0].Package {
+					continue
+				}
+				seen[key] = true
+				warnings = append(warnings, fmt.Sprintf("%s uses %s from %s, which moves to %s: invert the dependency with an interface or move it too",
+					path.Base(af.Path), strings.TrimPrefix(key, "method:"), path.Base(files[j].Path), target[files[j].Path]))
+			}
+		}
+	}
+	return warnings
+}
+
+// packageHub is the import fan-in and fan-out of one package.
+type packageHub struct {
+	fanIn, fanOut int
+	hub           bool // both at least hubMinEdges and twice the module median
+}
+
+// packageHubs returns the fan-in and fan-out of every package, keyed by
+// module-relative directory.
+func packageHubs(modulePath string, analyzed map[string]*domain.AnalyzedFile) map[string]*packageHub {
+	g := BuildImportGraph(modulePath, analyzed)
+	if g == nil {
+		return nil
+	}
+	var ins, outs []int
+	for _, node := range g.Packages {
+		ins = append(ins, len(node.ImportedBy))
+		outs = append(outs, len(node.ImportsInternal))
+	}
+	sort.Ints(ins)
+	sort.Ints(outs)
+	medIn, medOut := medianInt(ins), medianInt(outs)
+
+	hubs := make(map[string]*packageHub, len(g.Packages))
+	for pkg, node := range g.Packages {
+		dir := packageDir(modulePath, pkg)
+		h := &packageHub{fanIn: len(node.ImportedBy), fanOut: len(node.ImportsInternal)}
+		h.hub = h.fanIn >= hubMinEdges && h.fanOut >= hubMinEdges &&
+			float64(h.fanIn) >= 2*medIn && float64(h.fanOut) >= 2*medOut
+		hubs[dir] = h
+	}
+	return hubs
+}
@@ -0,0 +1,61 @@
+package scoring
+
+import (
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// collectSQLInjectionIssues warns about query calls in non-test,
+// non-generated files that splice run-time values into their SQL instead
+// of passing them as query arguments. Operands produced by a safe builder
+// in profile.SafeSQLBuilders, such as strconv.Itoa(id) or
+// pq.QuoteIdentifier(table), are allowed; a call whose operands are all
+// allowed is not reported.
+func collectSQLInjectionIssues(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	var issues []domain.Issue
+	for _, af := range sortedFiles(analyzed) {
+		issues = append(issues, sqlInjectionIssues(profile, af)...)
+	}
+	return issues
+}
+
+// sqlInjectionIssues returns the sql_injection issues of af.
+func sqlInjectionIssues(profile *domain.ScoringProfile, af *domain.AnalyzedFile) []domain.Issue {
+	if af.IsGenerated || isTestFile(af.Path) {
+		return nil
+	}
+	var issues []domain.Issue
+	for _, call := range af.SQLCalls {
+		var unsafe []string
+		for _, operand := range call.Dynamic {
+			if !isSafeSQLOperand(profile.SafeSQLBuilders, operand) {
+				unsafe = append(unsafe, operand)
+			}
+		}
+		if len(unsafe) == 0 {
+			continue
+		}
+		issues = append(issues, domain.Issue{
+			Severity:  domain.SeverityWarning,
+			Category:  "security_hints",
+			SubMetric: "sql_injection",
+			File:      af.Path,
+			Line:      call.Line,
+			Pattern:   "sql-concat",
+		}.WithMessage("sql_injection.concat", call.Method, strings.Join(unsafe, ", ")))
+	}
+	return issues
+}
+
+// isSafeSQLOperand reports whether operand is an entry of safe, or a call,
+// selector or composite literal on one: "strconv" allows strconv.Itoa(n),
+// and "pgx.Identifier" allows pgx.Identifier{table}.Sanitize().
+func isSafeSQLOperand(safe []string, operand string) bool {
+	for _, s := range safe {
+		if operand == s || (strings.HasPrefix(operand, s) && strings.ContainsRune("(.{", rune(operand[len(s)]))) {
+			return true
+		}
+	}
+	return false
+}
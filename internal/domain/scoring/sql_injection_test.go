@@ -0,0 +1,43 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsSafeSQLOperand(t *testing.T) {
+	safe := domain.DefaultProfile().SafeSQLBuilders
+
+	assert.True(t, isSafeSQLOperand(safe, "strconv.Itoa(id)"))
+	assert.True(t, isSafeSQLOperand(safe, "pq.QuoteIdentifier(table)"))
+	assert.True(t, isSafeSQLOperand(safe, "pgx.Identifier{schema, table}.Sanitize()"))
+	assert.False(t, isSafeSQLOperand(safe, "name"))
+	assert.False(t, isSafeSQLOperand(safe, "strconvert(name)"), "prefix must end at a call or selector")
+}
+
+func TestCollectSQLInjectionIssues(t *testing.T) {
+	files := map[string]*domain.AnalyzedFile{
+		"store/user_store.go": {Path: "store/user_store.go", SQLCalls: []domain.SQLCall{
+			{Method: "QueryContext", Dynamic: []string{"pq.QuoteIdentifier(table)", "r.URL.Query().Get(\"name\")"}, Line: 21},
+			{Method: "Exec", Dynamic: []string{"strconv.Itoa(limit)"}, Line: 30},
+			{Method: "Query", Dynamic: []string{"orderBy"}, Line: 40},
+		}},
+		"store/user_store_test.go": {Path: "store/user_store_test.go", SQLCalls: []domain.SQLCall{
+			{Method: "Exec", Dynamic: []string{"fixture"}, Line: 5},
+		}},
+	}
+	p := domain.DefaultProfile()
+
+	issues := collectSQLInjectionIssues(&p, files)
+
+	require.Len(t, issues, 2)
+	assert.Equal(t, "sql_injection", issues[0].SubMetric)
+	assert.Equal(t, domain.SeverityWarning, issues[0].Severity)
+	assert.Equal(t, `QueryContext call splices r.URL.Query().Get("name") into its SQL; pass values as query arguments`, issues[0].Message)
+
+	p.SafeSQLBuilders = append(p.SafeSQLBuilders, "orderBy")
+	assert.Len(t, collectSQLInjectionIssues(&p, files), 1, "allowlisted operands are trusted")
+}
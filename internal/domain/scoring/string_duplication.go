@@ -0,0 +1,78 @@
+package scoring
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// maxQuotedString bounds how much of a duplicated string an issue quotes.
+const maxQuotedString = 40
+
+// stringDuplicationIssues flags long string literals, such as error
+// messages, SQL and URLs, repeated verbatim across files: at least
+// profile.MinStringLiteralRepeats times in two or more non-test,
+// non-generated files. Unlike code_duplication, which compares token
+// streams, this catches the same value copied into unrelated code, where
+// a constant would give it a single source of truth. The issue sits at the
+// first occurrence.
+func stringDuplicationIssues(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	type use struct {
+		file string
+		line int
+	}
+	repeats := profile.MinStringLiteralRepeats
+	if repeats <= 0 {
+		repeats = 3
+	}
+	uses := make(map[string][]use)
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		for _, lit := range af.StringLiterals {
+			uses[lit.Value] = append(uses[lit.Value], use{af.Path, lit.Line})
+		}
+	}
+
+	var issues []domain.Issue
+	for value, us := range uses {
+		if len(us) < repeats {
+			continue
+		}
+		var files []string
+		for _, u := range us {
+			if len(files) == 0 || files[len(files)-1] != u.file {
+				files = append(files, u.file)
+			}
+		}
+		if len(files) < 2 {
+			continue
+		}
+		issues = append(issues, domain.Issue{
+			Severity:  domain.SeverityInfo,
+			Category:  "code_health",
+			SubMetric: "code_duplication",
+			File:      us[0].file,
+			Line:      us[0].line,
+			Pattern:   "duplicate-string",
+		}.WithMessage("code_duplication.string", quoteShort(value), len(us), len(files), strings.Join(files, ", ")))
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].File != issues[j].File {
+			return issues[i].File < issues[j].File
+		}
+		return issues[i].Line < issues[j].Line
+	})
+	return issues
+}
+
+// quoteShort quotes s, cut to maxQuotedString runes.
+func quoteShort(s string) string {
+	if r := []rune(s); len(r) > maxQuotedString {
+		return fmt.Sprintf("%q...", string(r[:maxQuotedString]))
+	}
+	return fmt.Sprintf("%q", s)
+}
@@ -0,0 +1,35 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringDuplicationIssues(t *testing.T) {
+	const query = "SELECT id, name FROM users WHERE id = $1"
+	files := map[string]*domain.AnalyzedFile{
+		"store/users.go":   {Path: "store/users.go", StringLiterals: []domain.StringLiteral{{Value: query, Line: 12}, {Value: query, Line: 40}}},
+		"store/admin.go":   {Path: "store/admin.go", StringLiterals: []domain.StringLiteral{{Value: query, Line: 7}}},
+		"api/handler.go":   {Path: "api/handler.go", StringLiterals: []domain.StringLiteral{{Value: "same message in one file", Line: 1}, {Value: "same message in one file", Line: 2}, {Value: "same message in one file", Line: 3}}},
+		"store/db_test.go": {Path: "store/db_test.go", StringLiterals: []domain.StringLiteral{{Value: query, Line: 3}}},
+	}
+	p := domain.DefaultProfile()
+
+	issues := stringDuplicationIssues(&p, files)
+
+	require.Len(t, issues, 1, "one file repeating itself is not duplication across files")
+	assert.Equal(t, "store/admin.go", issues[0].File)
+	assert.Equal(t, 7, issues[0].Line)
+	assert.Equal(t, `string "SELECT id, name FROM users WHERE id = $1" appears 3 times in 2 files (store/admin.go, store/users.go); move it to a constant`, issues[0].Message)
+
+	p.MinStringLiteralRepeats = 4
+	assert.Empty(t, stringDuplicationIssues(&p, files))
+}
+
+func TestQuoteShort(t *testing.T) {
+	assert.Equal(t, `"short"`, quoteShort("short"))
+	assert.Equal(t, `"0123456789012345678901234567890123456789"...`, quoteShort("0123456789012345678901234567890123456789-tail"))
+}
@@ -0,0 +1,173 @@
+package scoring
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// tagKeys are the struct tag keys whose names checkStructTags compares.
+var tagKeys = []string{"json", "yaml", "db"}
+
+// taggedStruct is a struct declaration and the file declaring it.
+type taggedStruct struct {
+	file string
+	def  domain.StructDef
+}
+
+// checkStructTags checks the json, yaml and db tags of structs in non-test,
+// non-generated files: names in each key follow the casing the project
+// uses most for that key, no two fields of a struct share a name, and a
+// struct with json tags tags all its exported fields, since an untagged one
+// encodes under its Go name. Each struct using a key counts as two checked
+// items, casing and uniqueness, and each struct using json tags as one more
+// for completeness. Casing and missing
+// tags are info, duplicate names a warning: the encoder drops or overwrites
+// one of the fields.
+func checkStructTags(analyzed map[string]*domain.AnalyzedFile) complianceCheck {
+	var check complianceCheck
+	var structs []taggedStruct
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || isTestFile(af.Path) {
+			continue
+		}
+		for _, sd := range af.StructDefs {
+			structs = append(structs, taggedStruct{file: af.Path, def: sd})
+		}
+	}
+
+	styles := tagStyles(structs)
+	for _, ts := range structs {
+		for _, key := range tagKeys {
+			want := ""
+			if len(styles[key]) > 0 {
+				want = dominantAlias(styles[key])
+			}
+			check.add(checkStructKey(ts, key, want))
+		}
+		check.add(checkMissingJSONTags(ts))
+	}
+	return check
+}
+
+// tagStyles counts the tag names of structs by key and casing.
+func tagStyles(structs []taggedStruct) map[string]map[string]int {
+	styles := make(map[string]map[string]int)
+	for _, ts := range structs {
+		for _, f := range ts.def.Fields {
+			for _, key := range tagKeys {
+				name, _ := tagName(f.Tag, key)
+				style := tagCasing(name)
+				if style == "" {
+					continue
+				}
+				if styles[key] == nil {
+					styles[key] = make(map[string]int)
+				}
+				styles[key][style]++
+			}
+		}
+	}
+	return styles
+}
+
+// checkStructKey checks the names ts gives its fields under key for casing
+// other than want and for duplicates, two checked items. A struct without
+// the key is not checked.
+func checkStructKey(ts taggedStruct, key, want string) complianceCheck {
+	var check complianceCheck
+	seen := make(map[string]string) // tag name -> field
+	var offStyle []string
+	var duplicate bool
+	for _, f := range ts.def.Fields {
+		name, ok := tagName(f.Tag, key)
+		if !ok {
+			continue
+		}
+		check.checked = 2 // casing and uniqueness
+		if name == "" || name == "-" {
+			continue
+		}
+		if style := tagCasing(name); style != "" && style != want {
+			offStyle = append(offStyle, name)
+		}
+		if prev, ok := seen[name]; ok && !duplicate {
+			duplicate = true
+			check.issues = append(check.issues, structTagIssue(ts, domain.SeverityWarning, "tag-duplicate").
+				WithMessage("consistent_patterns.tag_duplicate", ts.def.Name, prev, f.Name, key, name))
+		}
+		seen[name] = f.Name
+	}
+	if len(offStyle) > 0 {
+		check.issues = append(check.issues, structTagIssue(ts, domain.SeverityInfo, "tag-casing").
+			WithMessage("consistent_patterns.tag_casing", ts.def.Name, key, strings.Join(offStyle, ", "), want))
+	}
+	return check
+}
+
+// checkMissingJSONTags reports the exported, non-embedded fields of a
+// struct with json tags that have no json tag of their own.
+func checkMissingJSONTags(ts taggedStruct) complianceCheck {
+	var check complianceCheck
+	embedded := make(map[string]bool)
+	for _, et := range ts.def.Embedded {
+		embedded[et.Name] = true
+	}
+	var missing []string
+	for _, f := range ts.def.Fields {
+		if _, ok := tagName(f.Tag, "json"); ok {
+			check.checked = 1
+			continue
+		}
+		if f.Name != "" && unicode.IsUpper(rune(f.Name[0])) && !embedded[f.Name] {
+			missing = append(missing, f.Name)
+		}
+	}
+	if check.checked > 0 && len(missing) > 0 {
+		check.issues = append(check.issues, structTagIssue(ts, domain.SeverityInfo, "tag-missing").
+			WithMessage("consistent_patterns.tag_missing", ts.def.Name, strings.Join(missing, ", ")))
+	}
+	return check
+}
+
+// structTagIssue returns a conventions issue about the struct ts.
+func structTagIssue(ts taggedStruct, severity, pattern string) domain.Issue {
+	return domain.Issue{
+		Severity:  severity,
+		Category:  "predictability",
+		SubMetric: "consistent_patterns",
+		File:      ts.file,
+		Pattern:   pattern,
+	}
+}
+
+// tagName returns the name part of the key entry in a struct tag, and
+// whether the tag has the key at all.
+func tagName(tag, key string) (string, bool) {
+	value, ok := reflect.StructTag(tag).Lookup(key)
+	if !ok {
+		return "", false
+	}
+	name, _, _ := strings.Cut(value, ",")
+	return name, true
+}
+
+// tagCasing classifies a tag name as snake, kebab, camel or pascal case. A
+// single lower-case word fits every style but pascal and yields "".
+func tagCasing(name string) string {
+	switch {
+	case name == "" || name == "-":
+		return ""
+	case strings.Contains(name, "_"):
+		return "snake"
+	case strings.Contains(name, "-"):
+		return "kebab"
+	case unicode.IsUpper(rune(name[0])):
+		return "pascal"
+	case strings.ToLower(name) != name:
+		return "camel"
+	}
+	return ""
+}
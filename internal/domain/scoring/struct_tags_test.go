@@ -0,0 +1,55 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagCasing(t *testing.T) {
+	assert.Equal(t, "snake", tagCasing("user_id"))
+	assert.Equal(t, "kebab", tagCasing("user-id"))
+	assert.Equal(t, "camel", tagCasing("userId"))
+	assert.Equal(t, "pascal", tagCasing("UserID"))
+	assert.Empty(t, tagCasing("name"), "a single word fits snake and camel alike")
+	assert.Empty(t, tagCasing("-"))
+}
+
+func TestCheckStructTags(t *testing.T) {
+	files := map[string]*domain.AnalyzedFile{
+		"api/dto.go": {Path: "api/dto.go", Package: "api", StructDefs: []domain.StructDef{
+			{Name: "User", Fields: []domain.Param{
+				{Name: "ID", Tag: `json:"user_id" db:"id"`},
+				{Name: "Name", Tag: `json:"name,omitempty"`},
+				{Name: "CreatedAt", Tag: `json:"createdAt"`},
+				{Name: "Email"},
+				{Name: "secret"},
+			}},
+			{Name: "Order", Fields: []domain.Param{
+				{Name: "OrderID", Tag: `json:"order_id"`},
+				{Name: "Total", Tag: `json:"total_cents"`},
+				{Name: "Sum", Tag: `json:"total_cents"`},
+				{Name: "Note", Tag: `json:"-"`},
+				{Name: "Base", Type: "Base"},
+			}, Embedded: []domain.EmbeddedType{{Name: "Base"}}},
+			{Name: "Base", Fields: []domain.Param{{Name: "Version"}}},
+		}},
+		"api/dto_test.go": {Path: "api/dto_test.go", Package: "api", StructDefs: []domain.StructDef{
+			{Name: "fixture", Fields: []domain.Param{{Name: "A", Tag: `json:"a"`}, {Name: "B", Tag: `json:"a"`}}},
+		}},
+	}
+
+	check := checkStructTags(files)
+
+	assert.Equal(t, 8, check.checked, "json and db on User, json on Order, each twice, plus two json structs")
+	require.Len(t, check.issues, 3)
+	assert.Equal(t, "tag-casing", check.issues[0].Pattern)
+	assert.Equal(t, "struct User has json tags createdAt that break the snake case the project uses", check.issues[0].Message)
+	assert.Equal(t, "tag-missing", check.issues[1].Pattern)
+	assert.Equal(t, "struct User has json tags but exported fields Email have none and encode under their Go names", check.issues[1].Message)
+	assert.Equal(t, "tag-duplicate", check.issues[2].Pattern)
+	assert.Equal(t, domain.SeverityWarning, check.issues[2].Severity)
+	assert.Equal(t, "struct Order gives fields Total and Sum the same json tag total_cents, so one of them is dropped when encoding", check.issues[2].Message)
+}
@@ -17,10 +17,12 @@ func ScoreStructure(profile *domain.ScoringProfile, modules []domain.DetectedMod
 
 	sm1 := scoreExpectedLayers(profile, modules, scan)
 	sm2 := scoreExpectedFiles(profile, modules)
-	sm3 := scoreInterfaceContracts(modules, analyzed)
+	sm3 := scoreInterfaceContracts(profile, analyzed)
 	sm4 := scoreModuleCompleteness(modules, analyzed)
+	granularity := checkInterfaceGranularity(profile, scan, analyzed)
+	sm5 := scoreInterfaceGranularity(profile, granularity)
 
-	cat.SubMetrics = []domain.SubMetric{sm1, sm2, sm3, sm4}
+	cat.SubMetrics = []domain.SubMetric{sm1, sm2, sm3, sm4, sm5}
 
 	total := 0
 	for _, sm := range cat.SubMetrics {
@@ -29,6 +31,8 @@ func ScoreStructure(profile *domain.ScoringProfile, modules []domain.DetectedMod
 	cat.Score = total
 
 	cat.Issues = collectStructureIssues(modules, analyzed)
+	cat.Issues = append(cat.Issues, granularity.issues...)
+	domain.ApplySeverityRules(profile.SeverityRules, cat.Issues)
 	return cat
 }
 
@@ -143,61 +147,44 @@ func scoreExpectedFiles(profile *domain.ScoringProfile, modules []domain.Detecte
 	return sm
 }
 
-// scoreInterfaceContracts (25 pts): checks whether port interfaces defined in
+// scoreInterfaceContracts (15 pts): checks whether port interfaces defined in
 // domain/application files have concrete implementations (receiver methods match).
-func scoreInterfaceContracts(_ []domain.DetectedModule, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
-	sm := domain.SubMetric{Name: "interface_contracts", Points: 25}
-
-	// Collect port interfaces from domain/application files.
-	var ports []domain.InterfaceDef
-	for _, af := range analyzed {
-		if !isDomainOrAppFile(af.Path) {
+func scoreInterfaceContracts(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
+	sm := domain.SubMetric{Name: "interface_contracts", Points: 15}
+
+	// Port interfaces are those declared in non-test domain/application
+	// files; an empty port is satisfied by every type.
+	ports, satisfied := 0, 0
+	for path, af := range analyzed {
+		if af == nil || !isDomainOrAppFile(path) || strings.HasSuffix(path, "_test.go") {
 			continue
 		}
-		ports = append(ports, af.InterfaceDefs...)
+		for _, iface := range af.InterfaceDefs {
+			ports++
+			if len(iface.Methods) == 0 {
+				satisfied++
+			}
+		}
 	}
 
-	if len(ports) == 0 {
+	if ports == 0 {
 		sm.Detail = "no port interfaces found"
 		return sm
 	}
 
-	// Collect methods-by-receiver from all concrete types.
-	receivers := map[string]map[string]bool{} // receiver → {method names}
-	for _, af := range analyzed {
-		for _, fn := range af.Functions {
-			if fn.Receiver == "" {
-				continue
-			}
-			recv := strings.TrimPrefix(fn.Receiver, "*")
-			if receivers[recv] == nil {
-				receivers[recv] = map[string]bool{}
-			}
-			receivers[recv][fn.Name] = true
-		}
-	}
-
-	// Check each port: is there a concrete type implementing all its methods?
-	satisfied := 0
-	for _, iface := range ports {
-		if len(iface.Methods) == 0 {
+	for _, iface := range domain.BuildInterfaceMap(analyzed) {
+		if isDomainOrAppFile(iface.File) && len(iface.Implementations) > 0 {
 			satisfied++
-			continue
-		}
-		for _, methods := range receivers {
-			if implementsAll(iface.Methods, methods) {
-				satisfied++
-				break
-			}
 		}
 	}
 
-	ratio := float64(satisfied) / float64(len(ports))
+	ratio := smoothRatio(profile, float64(satisfied), ports)
 	sm.Score = int(ratio * float64(sm.Points))
 	if sm.Score > sm.Points {
 		sm.Score = sm.Points
 	}
-	sm.Detail = fmt.Sprintf("%d/%d port interfaces have concrete implementations", satisfied, len(ports))
+	sm.Detail = fmt.Sprintf("%d/%d port interfaces have concrete implementations", satisfied, ports) +
+		smoothingNote(profile, float64(satisfied), ports)
 	return sm
 }
 
@@ -205,15 +192,6 @@ func isDomainOrAppFile(path string) bool {
 	return strings.Contains(path, "/domain/") || strings.Contains(path, "/application/")
 }
 
-func implementsAll(required []string, available map[string]bool) bool {
-	for _, m := range required {
-		if !available[m] {
-			return false
-		}
-	}
-	return true
-}
-
 // scoreModuleCompleteness (25 pts): compares file counts among modules sharing
 // at least one layer. Modules in different layers are architecturally distinct
 // by design and should not be compared.
@@ -283,8 +261,7 @@ func collectStructureIssues(modules []domain.DetectedModule, analyzed map[string
 		issues = append(issues, domain.Issue{
 			Severity: domain.SeverityWarning,
 			Category: "structure",
-			Message:  "no modules detected; cannot evaluate structure",
-		})
+		}.WithMessage("structure.no_modules"))
 		return issues
 	}
 
@@ -306,8 +283,7 @@ func collectStructureIssues(modules []domain.DetectedModule, analyzed map[string
 				Severity:  domain.SeverityWarning,
 				Category:  "structure",
 				SubMetric: "interface_contracts",
-				Message:   fmt.Sprintf("module %q has domain/application layer but no port interfaces", m.Name),
-			})
+			}.WithMessage("interface_contracts.no_ports", m.Name))
 		}
 	}
 
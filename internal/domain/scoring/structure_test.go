@@ -13,7 +13,7 @@ func TestScoreStructure_NilInputs(t *testing.T) {
 
 	assert.Equal(t, "structure", result.Name)
 	assert.Equal(t, 0.15, result.Weight)
-	assert.Len(t, result.SubMetrics, 4)
+	assert.Len(t, result.SubMetrics, 5)
 	assert.GreaterOrEqual(t, result.Score, 0)
 	assert.LessOrEqual(t, result.Score, 100)
 }
@@ -27,7 +27,7 @@ func TestScoreStructure_EmptyInputs(t *testing.T) {
 
 	assert.Equal(t, "structure", result.Name)
 	assert.Equal(t, 0.15, result.Weight)
-	assert.Len(t, result.SubMetrics, 4)
+	assert.Len(t, result.SubMetrics, 5)
 	assert.Equal(t, 0, result.Score)
 }
 
@@ -84,7 +84,7 @@ func TestScoreStructure_WellStructuredProject(t *testing.T) {
 
 	assert.Equal(t, "structure", result.Name)
 	assert.Equal(t, 0.15, result.Weight)
-	assert.Len(t, result.SubMetrics, 4)
+	assert.Len(t, result.SubMetrics, 5)
 	assert.Greater(t, result.Score, 0)
 	assert.LessOrEqual(t, result.Score, 100)
 
@@ -163,7 +163,7 @@ func TestScoreStructure_InterfaceSatisfaction(t *testing.T) {
 	result := scoring.ScoreStructure(defaultProfile(), modules, &domain.ScanResult{}, analyzed)
 	contracts := result.SubMetrics[2]
 	assert.Equal(t, "interface_contracts", contracts.Name)
-	assert.Equal(t, 12, contracts.Score, "1/2 satisfied = 50% = 12/25")
+	assert.Equal(t, 7, contracts.Score, "1/2 satisfied = 50% = 7/15")
 }
 
 func TestScoreStructure_ModuleCompletenessComparesWithinLayer(t *testing.T) {
@@ -0,0 +1,69 @@
+package scoring
+
+import (
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// stutterIssues flags exported types and functions whose name repeats
+// their package name, such as user.UserService, and suggests the shorter
+// name callers would read as user.Service. Generated files, test files,
+// package main and packages matching profile.StutterExemptPackages (globs
+// over the package name, "*pb" by default for protobuf packages) are
+// skipped.
+func stutterIssues(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	var issues []domain.Issue
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || isTestFile(af.Path) || af.Package == "" || af.Package == "main" ||
+			isStutterExempt(af.Package, profile.StutterExemptPackages) {
+			continue
+		}
+		names := append(append([]string(nil), af.Structs...), af.Interfaces...)
+		for _, fn := range af.Functions {
+			if fn.Receiver == "" {
+				names = append(names, fn.Name)
+			}
+		}
+		for _, name := range names {
+			short, ok := stutterSuffix(af.Package, name)
+			if !ok {
+				continue
+			}
+			issues = append(issues, domain.Issue{
+				Severity:  domain.SeverityInfo,
+				Category:  "discoverability",
+				SubMetric: "naming_uniqueness",
+				File:      af.Path,
+				Pattern:   "stutter",
+			}.WithMessage("naming_uniqueness.stutter", af.Package, name, af.Package, short))
+		}
+	}
+	return issues
+}
+
+// stutterSuffix returns name without its leading package name when name is
+// exported and starts with pkg followed by another word: UserService in
+// package user gives Service. A name equal to the package (user.User) does
+// not stutter.
+func stutterSuffix(pkg, name string) (string, bool) {
+	if len(name) <= len(pkg) || !unicode.IsUpper(rune(name[0])) || !strings.EqualFold(name[:len(pkg)], pkg) {
+		return "", false
+	}
+	rest := name[len(pkg):]
+	if !unicode.IsUpper(rune(rest[0])) {
+		return "", false
+	}
+	return rest, true
+}
+
+func isStutterExempt(pkg string, patterns []string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, pkg); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStutterSuffix(t *testing.T) {
+	tests := []struct {
+		pkg, name, want string
+		ok              bool
+	}{
+		{"user", "UserService", "Service", true},
+		{"http", "HTTPClient", "Client", true},
+		{"user", "User", "", false},
+		{"user", "Users", "", false},
+		{"user", "Service", "", false},
+		{"user", "userCache", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := stutterSuffix(tt.pkg, tt.name)
+		assert.Equal(t, tt.ok, ok, tt.name)
+		assert.Equal(t, tt.want, got, tt.name)
+	}
+}
+
+func TestStutterIssues(t *testing.T) {
+	files := map[string]*domain.AnalyzedFile{
+		"user/service.go": {Path: "user/service.go", Package: "user",
+			Structs:    []string{"UserService", "User"},
+			Interfaces: []string{"UserRepository"},
+			Functions: []domain.Function{
+				{Name: "UserFromID", Exported: true},
+				{Name: "UserName", Receiver: "*User", Exported: true},
+			},
+		},
+		"user/service_test.go": {Path: "user/service_test.go", Package: "user", Structs: []string{"UserFixture"}},
+		"api/userpb/user.go":   {Path: "api/userpb/user.go", Package: "userpb", Structs: []string{"UserpbClient"}},
+		"cmd/main.go":          {Path: "cmd/main.go", Package: "main", Structs: []string{"MainConfig"}},
+	}
+	profile := domain.DefaultProfile()
+
+	issues := stutterIssues(&profile, files)
+	require.Len(t, issues, 3, "methods, tests, main and *pb packages are skipped")
+	assert.Equal(t, "user.UserService repeats the package name; consider user.Service", issues[0].Message)
+	assert.Equal(t, "naming_uniqueness", issues[0].SubMetric)
+	assert.Contains(t, issues[1].Message, "user.UserRepository")
+	assert.Contains(t, issues[2].Message, "user.UserFromID")
+
+	profile.StutterExemptPackages = []string{"u*"}
+	assert.Empty(t, stutterIssues(&profile, files))
+}
@@ -0,0 +1,105 @@
+package scoring
+
+import (
+	"cmp"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// A file suffix is learned once this many files in at least
+// minLearnedSuffixPackages directories use it.
+const (
+	minLearnedSuffixFiles    = 3
+	minLearnedSuffixPackages = 2
+)
+
+// LearnFileSuffixes counts the role suffixes of the project's file names,
+// such as _worker in billing_worker.go, and returns the ones used often
+// enough to be a convention but missing from ExpectedFileSuffixes, most
+// used first. Test, main, doc and generated files are not counted, nor
+// platform suffixes like _linux.
+func LearnFileSuffixes(profile *domain.ScoringProfile, goFiles []string, analyzed map[string]*domain.AnalyzedFile) []domain.SuffixSuggestion {
+	type usage struct {
+		files []string
+		dirs  map[string]bool
+	}
+	bySuffix := make(map[string]*usage)
+	for _, f := range goFiles {
+		base := filepath.Base(f)
+		if strings.HasSuffix(base, "_test.go") {
+			continue
+		}
+		name := strings.TrimSuffix(base, ".go")
+		if name == "main" || name == "doc" {
+			continue
+		}
+		if af, ok := analyzed[f]; ok && af.IsGenerated {
+			continue
+		}
+		suffix := roleSuffix(name)
+		if suffix == "" || slices.Contains(profile.ExpectedFileSuffixes, suffix) {
+			continue
+		}
+		u, ok := bySuffix[suffix]
+		if !ok {
+			u = &usage{dirs: make(map[string]bool)}
+			bySuffix[suffix] = u
+		}
+		u.files = append(u.files, f)
+		u.dirs[path.Dir(filepath.ToSlash(f))] = true
+	}
+
+	var suggestions []domain.SuffixSuggestion
+	for suffix, u := range bySuffix {
+		if len(u.files) < minLearnedSuffixFiles || len(u.dirs) < minLearnedSuffixPackages {
+			continue
+		}
+		slices.Sort(u.files)
+		suggestions = append(suggestions, domain.SuffixSuggestion{
+			Suffix:   suffix,
+			Files:    len(u.files),
+			Packages: len(u.dirs),
+			Examples: u.files[:min(3, len(u.files))],
+		})
+	}
+	slices.SortFunc(suggestions, func(a, b domain.SuffixSuggestion) int {
+		return cmp.Or(cmp.Compare(b.Files, a.Files), cmp.Compare(a.Suffix, b.Suffix))
+	})
+	return suggestions
+}
+
+// roleSuffix returns the last _word of a file name without extension,
+// after stripping a platform suffix, or "" when it has none.
+func roleSuffix(name string) string {
+	if idx := strings.LastIndex(name, "_"); idx > 0 && platformBuildTags[name[idx:]] {
+		name = name[:idx]
+	}
+	idx := strings.LastIndex(name, "_")
+	if idx <= 0 || idx == len(name)-1 {
+		return ""
+	}
+	return name[idx:]
+}
+
+// withLearnedSuffixes returns profile with the suffixes LearnFileSuffixes
+// finds added to ExpectedFileSuffixes when learn_file_suffixes is set, and
+// profile itself otherwise.
+func withLearnedSuffixes(profile *domain.ScoringProfile, scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) *domain.ScoringProfile {
+	if !profile.LearnFileSuffixes || scan == nil {
+		return profile
+	}
+	learned := LearnFileSuffixes(profile, scan.GoFiles, analyzed)
+	if len(learned) == 0 {
+		return profile
+	}
+	p := *profile
+	p.ExpectedFileSuffixes = slices.Clone(profile.ExpectedFileSuffixes)
+	for _, s := range learned {
+		p.ExpectedFileSuffixes = append(p.ExpectedFileSuffixes, s.Suffix)
+	}
+	return &p
+}
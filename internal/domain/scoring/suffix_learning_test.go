@@ -0,0 +1,57 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+var workerFiles = []string{
+	"billing/invoice_worker.go", "billing/invoice_service.go", "billing/invoice_worker_test.go",
+	"email/send_worker.go", "email/send_service.go",
+	"sync/pull_worker.go", "sync/pull_consumer.go", "sync/push_consumer.go",
+	"net/conn_linux.go", "net/conn_windows.go", "net/dial_linux.go",
+	"cmd/main.go", "billing/doc.go",
+}
+
+func TestLearnFileSuffixes(t *testing.T) {
+	got := scoring.LearnFileSuffixes(defaultProfile(), workerFiles, nil)
+
+	require.Len(t, got, 1, "_consumer is used in one package only, _service is expected, _linux is a platform")
+	assert.Equal(t, domain.SuffixSuggestion{
+		Suffix: "_worker", Files: 3, Packages: 3,
+		Examples: []string{"billing/invoice_worker.go", "email/send_worker.go", "sync/pull_worker.go"},
+	}, got[0])
+}
+
+func TestLearnFileSuffixes_SkipsGenerated(t *testing.T) {
+	files := analyzed(&domain.AnalyzedFile{Path: "sync/pull_worker.go", IsGenerated: true})
+	assert.Empty(t, scoring.LearnFileSuffixes(defaultProfile(), workerFiles, files))
+}
+
+func TestScoreDiscoverability_LearnFileSuffixes(t *testing.T) {
+	files := []string{
+		"a/job_worker.go", "b/job_worker.go", "c/job_worker.go", "d/job_worker.go",
+		"a/user_service.go", "b/order_service.go", "c/store.go",
+	}
+	scan := &domain.ScanResult{GoFiles: files}
+	count := func(p *domain.ScoringProfile) int {
+		n := 0
+		for _, iss := range scoring.ScoreDiscoverability(p, nil, scan, nil).Issues {
+			if iss.SubMetric == "file_naming_conventions" {
+				n++
+			}
+		}
+		return n
+	}
+
+	p := defaultProfile()
+	assert.Equal(t, 2, count(p), "_worker is unknown, so bare dominates and the _service files are flagged")
+
+	p.LearnFileSuffixes = true
+	assert.Equal(t, 1, count(p), "_worker files count as suffixed, only store.go breaks the pattern")
+}
@@ -0,0 +1,193 @@
+package scoring
+
+import (
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// Symbol is one declaration in the symbol index.
+type Symbol struct {
+	Name      string  `json:"name"` // Type.Method for methods
+	Kind      string  `json:"kind"` // domain.SymbolFunc, SymbolMethod, SymbolStruct or SymbolInterface
+	Exported  bool    `json:"exported"`
+	Package   string  `json:"package"` // import path
+	File      string  `json:"file"`
+	Line      int     `json:"line,omitempty"`
+	Signature string  `json:"signature,omitempty"`
+	Role      string  `json:"role,omitempty"`  // architectural role of the package
+	Layer     string  `json:"layer,omitempty"` // domain, application or adapters
+	Match     float64 `json:"match,omitempty"` // relevance to the query, set by FindSymbols
+}
+
+// SymbolQuery selects symbols from the index. Pattern is matched fuzzily
+// against the symbol name; * and ? make it a glob. Empty filters match all.
+type SymbolQuery struct {
+	Pattern      string
+	Kind         string
+	Role         string
+	Layer        string
+	ExportedOnly bool
+	Limit        int // 0 = unlimited
+}
+
+// BuildSymbolIndex lists the functions, methods, structs and interfaces of
+// the analyzed non-test, non-generated files, annotated with the role and
+// layer of their package.
+func BuildSymbolIndex(modulePath string, profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) []Symbol {
+	roles := make(map[string]string)
+	if graph := BuildImportGraph(modulePath, analyzed); graph != nil {
+		for pkg, ap := range graph.ClassifyPackages(modulePath, profile) {
+			if ap.Role != RoleUnclassified {
+				roles[pkg] = string(ap.Role)
+			}
+		}
+	}
+
+	var index []Symbol
+	for _, af := range sortedFiles(analyzed) {
+		if af.IsGenerated || strings.HasSuffix(af.Path, "_test.go") {
+			continue
+		}
+		file := strings.ReplaceAll(af.Path, "\\", "/")
+		pkg := modulePath
+		if dir := path.Dir(file); dir != "." {
+			pkg = path.Join(modulePath, dir)
+		}
+		base := Symbol{Package: pkg, File: file, Role: roles[pkg], Layer: fileLayer("/"+file, profile)}
+
+		for _, fn := range af.Functions {
+			s := base
+			s.Name, s.Kind, s.Exported = fn.Name, domain.SymbolFunc, fn.Exported
+			if fn.Receiver != "" {
+				s.Name, s.Kind = strings.TrimPrefix(fn.Receiver, "*")+"."+fn.Name, domain.SymbolMethod
+			}
+			s.Line, s.Signature = fn.LineStart, domain.FunctionSignature(fn)
+			index = append(index, s)
+		}
+		for _, name := range af.Structs {
+			s := base
+			s.Name, s.Kind, s.Exported = name, domain.SymbolStruct, isUpper(name)
+			s.Signature = "type " + name + " struct"
+			index = append(index, s)
+		}
+		for _, iface := range af.InterfaceDefs {
+			s := base
+			s.Name, s.Kind, s.Exported = iface.Name, domain.SymbolInterface, isUpper(iface.Name)
+			s.Signature = "type " + iface.Name + " interface{" + strings.Join(iface.Methods, "; ") + "}"
+			index = append(index, s)
+		}
+	}
+	return index
+}
+
+// FindSymbols returns the symbols matching q, most relevant first: exact
+// names, then prefixes, substrings, globs and finally subsequences such as
+// "scoreproj" or "SP" for ScoreProject.
+func FindSymbols(index []Symbol, q SymbolQuery) []Symbol {
+	var out []Symbol
+	for _, s := range index {
+		if q.Kind != "" && s.Kind != q.Kind ||
+			q.Role != "" && !strings.EqualFold(s.Role, q.Role) ||
+			q.Layer != "" && !strings.EqualFold(s.Layer, q.Layer) ||
+			q.ExportedOnly && !s.Exported {
+			continue
+		}
+		if s.Match = matchSymbol(s.Name, q.Pattern); s.Match > 0 {
+			out = append(out, s)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.Match != b.Match {
+			return a.Match > b.Match
+		}
+		if a.Exported != b.Exported {
+			return a.Exported
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.File < b.File
+	})
+	if q.Limit > 0 && len(out) > q.Limit {
+		out = out[:q.Limit]
+	}
+	return out
+}
+
+// matchSymbol scores how well pattern matches a symbol name in (0,1], or 0
+// for no match. A method also matches by its bare name.
+func matchSymbol(name, pattern string) float64 {
+	if pattern == "" {
+		return 1
+	}
+	best := matchName(name, pattern)
+	if _, method, ok := strings.Cut(name, "."); ok {
+		best = max(best, matchName(method, pattern)*0.95)
+	}
+	return best
+}
+
+func matchName(name, pattern string) float64 {
+	lname, lpat := strings.ToLower(name), strings.ToLower(pattern)
+	switch {
+	case name == pattern:
+		return 1
+	case lname == lpat:
+		return 0.95
+	case strings.HasPrefix(lname, lpat):
+		return 0.85
+	case strings.Contains(lname, lpat):
+		return 0.7
+	}
+	if strings.ContainsAny(pattern, "*?[") {
+		if ok, _ := path.Match(lpat, lname); ok {
+			return 0.8
+		}
+		return 0
+	}
+	if initials(name) == lpat {
+		return 0.65
+	}
+	if subsequence(lname, lpat) {
+		return 0.3 + 0.3*float64(len(lpat))/float64(len(lname))
+	}
+	return 0
+}
+
+// initials returns the lower-cased first letters of name's words, e.g.
+// "sp" for ScoreProject.
+func initials(name string) string {
+	var b strings.Builder
+	for _, w := range SplitWords(name) {
+		b.WriteRune(unicode.ToLower([]rune(w)[0]))
+	}
+	return b.String()
+}
+
+// subsequence reports whether the runes of sub appear in s in order.
+func subsequence(s, sub string) bool {
+	rs := []rune(s)
+	i := 0
+	for _, r := range sub {
+		for i < len(rs) && rs[i] != r {
+			i++
+		}
+		if i == len(rs) {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+func isUpper(name string) bool {
+	for _, r := range name {
+		return unicode.IsUpper(r)
+	}
+	return false
+}
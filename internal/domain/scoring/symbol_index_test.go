@@ -0,0 +1,93 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func symbolFixture() map[string]*domain.AnalyzedFile {
+	return map[string]*domain.AnalyzedFile{
+		"internal/domain/user.go": {
+			Path:          "internal/domain/user.go",
+			Package:       "domain",
+			Structs:       []string{"User"},
+			InterfaceDefs: []domain.InterfaceDef{{Name: "UserRepository", Methods: []string{"Save"}}},
+		},
+		"internal/application/user_service.go": {
+			Path:    "internal/application/user_service.go",
+			Package: "application",
+			Structs: []string{"UserService"},
+			Imports: []string{"example.com/app/internal/domain"},
+			Functions: []domain.Function{
+				{Name: "NewUserService", Exported: true, LineStart: 10, Returns: []string{"*UserService"}},
+				{Name: "CreateUser", Receiver: "*UserService", Exported: true, LineStart: 20, Params: []domain.Param{{Name: "name", Type: "string"}}, Returns: []string{"error"}},
+				{Name: "validate", Receiver: "*UserService", LineStart: 30},
+			},
+		},
+		"internal/application/user_service_test.go": {
+			Path:      "internal/application/user_service_test.go",
+			Package:   "application",
+			Functions: []domain.Function{{Name: "TestCreateUser_OK", Exported: true}},
+		},
+	}
+}
+
+func TestBuildSymbolIndex_AnnotatesDeclarations(t *testing.T) {
+	index := scoring.BuildSymbolIndex("example.com/app", defaultProfile(), symbolFixture())
+
+	names := make(map[string]scoring.Symbol)
+	for _, s := range index {
+		names[s.Name] = s
+	}
+	assert.NotContains(t, names, "TestCreateUser_OK", "test files are not indexed")
+
+	create := names["UserService.CreateUser"]
+	assert.Equal(t, domain.SymbolMethod, create.Kind)
+	assert.Equal(t, "example.com/app/internal/application", create.Package)
+	assert.Equal(t, "func (*UserService) CreateUser(string) error", create.Signature)
+	assert.Equal(t, 20, create.Line)
+	assert.Equal(t, "application", create.Layer)
+
+	assert.Equal(t, domain.SymbolInterface, names["UserRepository"].Kind)
+	assert.Equal(t, "domain", names["UserRepository"].Layer)
+	assert.False(t, names["UserService.validate"].Exported)
+}
+
+func TestFindSymbols_Ranking(t *testing.T) {
+	index := scoring.BuildSymbolIndex("example.com/app", defaultProfile(), symbolFixture())
+
+	tests := []struct {
+		name  string
+		query scoring.SymbolQuery
+		want  []string
+	}{
+		{"exact before prefix", scoring.SymbolQuery{Pattern: "User"}, []string{"User", "UserRepository", "UserService"}},
+		{"method by bare name", scoring.SymbolQuery{Pattern: "CreateUser"}, []string{"UserService.CreateUser"}},
+		{"initials", scoring.SymbolQuery{Pattern: "nus"}, []string{"NewUserService"}},
+		{"glob", scoring.SymbolQuery{Pattern: "New*"}, []string{"NewUserService"}},
+		{"kind filter", scoring.SymbolQuery{Pattern: "user", Kind: domain.SymbolStruct}, []string{"User", "UserService"}},
+		{"layer filter", scoring.SymbolQuery{Pattern: "user", Layer: "domain"}, []string{"User", "UserRepository"}},
+		{"exported only", scoring.SymbolQuery{Pattern: "validate", ExportedOnly: true}, nil},
+		{"limit", scoring.SymbolQuery{Pattern: "User", Limit: 1}, []string{"User"}},
+		{"no match", scoring.SymbolQuery{Pattern: "zzz"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, s := range scoring.FindSymbols(index, tt.query) {
+				got = append(got, s.Name)
+			}
+			if tt.want == nil {
+				assert.Empty(t, got)
+				return
+			}
+			require.GreaterOrEqual(t, len(got), len(tt.want))
+			assert.Equal(t, tt.want, got[:len(tt.want)])
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package scoring
+
+import (
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// minHelperCopies is the number of packages whose _test.go files must
+// define the same helper before it is reported as duplicated.
+const minHelperCopies = 3
+
+// testHelperPackages are package names conventionally holding shared test
+// helpers. Standard library packages ending in "test" (httptest, fstest)
+// are test helpers too.
+var testHelperPackages = map[string]bool{
+	"testutil": true, "testutils": true, "testhelper": true, "testhelpers": true,
+}
+
+// testingTypes are the parameter types that make a function a test helper.
+var testingTypes = []string{"*testing.T", "*testing.B", "*testing.F", "testing.TB"}
+
+// collectTestHelperIssues flags test helpers in the wrong place: exported
+// helpers (TestingX functions or functions taking *testing.T) declared in
+// production files, the same helper copied into the _test.go files of
+// several packages, and production code importing a test helper package.
+// Files in a conventional test-double location (testutil/, packages ending
+// in "test") are exempt.
+func collectTestHelperIssues(analyzed map[string]*domain.AnalyzedFile) []domain.Issue {
+	var issues []domain.Issue
+	copies := make(map[string]*helperCopies) // signature -> copies
+	for _, af := range sortedFiles(analyzed) {
+		if isTestFile(af.Path) {
+			for _, fn := range af.Functions {
+				if !isTestHelper(fn) || isTestEntryPoint(fn.Name) {
+					continue
+				}
+				sig := domain.FunctionSignature(fn)
+				if copies[sig] == nil {
+					copies[sig] = &helperCopies{name: fn.Name, file: af.Path}
+				}
+				if dir := path.Dir(af.Path); !slices.Contains(copies[sig].dirs, dir) {
+					copies[sig].dirs = append(copies[sig].dirs, dir)
+				}
+			}
+			continue
+		}
+		if isMockLocation(af) {
+			continue
+		}
+		for _, fn := range af.Functions {
+			if fn.Exported && fn.Receiver == "" && (isTestHelper(fn) || hasWordPrefix(fn.Name, "Testing")) {
+				issues = append(issues, testHelperIssue(af.Path, domain.SeverityInfo).
+					WithMessage("consistent_patterns.test_helper_exported", fn.Name))
+			}
+		}
+		for _, imp := range af.Imports {
+			if isTestHelperImport(imp) {
+				issues = append(issues, testHelperIssue(af.Path, domain.SeverityWarning).
+					WithMessage("consistent_patterns.test_helper_import", imp))
+			}
+		}
+	}
+
+	for _, sig := range slices.Sorted(maps.Keys(copies)) {
+		if c := copies[sig]; len(c.dirs) >= minHelperCopies {
+			issues = append(issues, testHelperIssue(c.file, domain.SeverityInfo).
+				WithMessage("consistent_patterns.test_helper_duplicated", c.name, len(c.dirs)))
+		}
+	}
+	return issues
+}
+
+// helperCopies records where one test helper signature is defined: its
+// name, the first file seen and every package directory.
+type helperCopies struct {
+	name, file string
+	dirs       []string
+}
+
+func testHelperIssue(file string, severity string) domain.Issue {
+	return domain.Issue{
+		Severity:  severity,
+		Category:  "predictability",
+		SubMetric: "consistent_patterns",
+		File:      file,
+		Pattern:   "test-helper",
+	}
+}
+
+// isTestHelper reports whether fn is a plain function taking a testing
+// handle.
+func isTestHelper(fn domain.Function) bool {
+	return fn.Receiver == "" && slices.ContainsFunc(fn.Params, func(p domain.Param) bool {
+		return slices.Contains(testingTypes, p.Type)
+	})
+}
+
+// isTestEntryPoint reports whether name is run by go test itself rather
+// than called as a helper.
+func isTestEntryPoint(name string) bool {
+	if name == "TestMain" {
+		return true
+	}
+	for _, prefix := range []string{"Test", "Benchmark", "Fuzz", "Example"} {
+		if name == prefix || hasWordPrefix(name, prefix) || strings.HasPrefix(name, prefix+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+// isTestHelperImport reports whether imp names a test helper package: a
+// testutil-style package or a standard library *test package.
+func isTestHelperImport(imp string) bool {
+	last := path.Base(imp)
+	return testHelperPackages[last] ||
+		(importKind("", imp) == importStdlib && strings.HasSuffix(last, "test"))
+}
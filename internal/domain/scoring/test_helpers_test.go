@@ -0,0 +1,56 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTestEntryPoint(t *testing.T) {
+	for _, name := range []string{"TestMain", "TestScore", "Test_score", "BenchmarkParse", "FuzzParse", "Example"} {
+		assert.True(t, isTestEntryPoint(name), name)
+	}
+	for _, name := range []string{"Testdata", "newTestServer", "mustParse"} {
+		assert.False(t, isTestEntryPoint(name), name)
+	}
+}
+
+func TestIsTestHelperImport(t *testing.T) {
+	assert.True(t, isTestHelperImport("net/http/httptest"))
+	assert.True(t, isTestHelperImport("example.com/app/internal/testutil"))
+	assert.False(t, isTestHelperImport("github.com/acme/latest"))
+	assert.False(t, isTestHelperImport("testing"))
+}
+
+func TestCollectTestHelperIssues(t *testing.T) {
+	tb := []domain.Param{{Name: "t", Type: "*testing.T"}}
+	helper := domain.Function{Name: "newServer", Params: tb, Returns: []string{"*Server"}}
+	files := map[string]*domain.AnalyzedFile{
+		"a/a_test.go": {Path: "a/a_test.go", Functions: []domain.Function{helper, {Name: "TestA", Params: tb}}},
+		"b/b_test.go": {Path: "b/b_test.go", Functions: []domain.Function{helper, {Name: "TestB", Params: tb}}},
+		"c/c_test.go": {Path: "c/c_test.go", Functions: []domain.Function{helper, {Name: "TestC", Params: tb}}},
+		"c/d_test.go": {Path: "c/d_test.go", Functions: []domain.Function{helper}},
+		"store/fixtures.go": {Path: "store/fixtures.go", Package: "store",
+			Imports: []string{"net/http/httptest", "testing"},
+			Functions: []domain.Function{
+				{Name: "TestingStore", Exported: true},
+				{Name: "Seed", Exported: true, Params: tb},
+				{Name: "seed", Params: tb},
+			},
+		},
+		"internal/testutil/server.go": {Path: "internal/testutil/server.go", Package: "testutil",
+			Functions: []domain.Function{{Name: "NewServer", Exported: true, Params: tb}},
+		},
+	}
+
+	issues := collectTestHelperIssues(files)
+	require.Len(t, issues, 4)
+	assert.Equal(t, "test helper TestingStore is exported from production code; move it to a testutil package", issues[0].Message)
+	assert.Contains(t, issues[1].Message, "test helper Seed")
+	assert.Equal(t, `production code imports test helper package "net/http/httptest"`, issues[2].Message)
+	assert.Equal(t, domain.SeverityWarning, issues[2].Severity)
+	assert.Equal(t, "test helper newServer is defined in 3 packages; share one copy from a testutil package", issues[3].Message)
+	assert.Equal(t, "a/a_test.go", issues[3].File)
+}
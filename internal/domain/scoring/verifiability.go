@@ -2,6 +2,7 @@ package scoring
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/abdidvp/openkraft/internal/domain"
@@ -16,26 +17,36 @@ func ScoreVerifiability(profile *domain.ScoringProfile, scan *domain.ScanResult,
 	}
 
 	sm1 := scoreTestPresence(profile, scan)
-	sm2 := scoreTestNaming(scan, analyzed)
+	sm2 := scoreTestNaming(profile, analyzed)
 	sm3 := scoreBuildReproducibility(scan)
 	sm4 := scoreTypeSafetySignals(scan, analyzed)
+	sm5, depIssues := scoreDependencyHygiene(profile, scan)
 
-	cat.SubMetrics = []domain.SubMetric{sm1, sm2, sm3, sm4}
+	cat.SubMetrics = []domain.SubMetric{sm1, sm2, sm3, sm4, sm5}
 
-	total := 0
+	// Normalize to 100 over the measured sub-metrics: dependency_hygiene
+	// is skipped for projects without a go.mod.
+	earned, points := 0, 0
 	for _, sm := range cat.SubMetrics {
-		total += sm.Score
+		if sm.Skipped {
+			continue
+		}
+		earned += sm.Score
+		points += sm.Points
+	}
+	if points > 0 {
+		cat.Score = int(math.Round(float64(earned) / float64(points) * 100))
 	}
-	cat.Score = total
 
-	cat.Issues = collectVerifiabilityIssues(scan, cat.SubMetrics)
+	cat.Issues = append(collectVerifiabilityIssues(scan, cat.SubMetrics), depIssues...)
+	domain.ApplySeverityRules(profile.SeverityRules, cat.Issues)
 	return cat
 }
 
-// scoreTestPresence (25 pts): ratio of .go files with _test.go.
+// scoreTestPresence (20 pts): ratio of .go files with _test.go.
 // Uses profile.MinTestRatio as the target for full credit.
 func scoreTestPresence(profile *domain.ScoringProfile, scan *domain.ScanResult) domain.SubMetric {
-	sm := domain.SubMetric{Name: "test_presence", Points: 25}
+	sm := domain.SubMetric{Name: "test_presence", Points: 20}
 
 	sourceCount := len(scan.GoFiles) - len(scan.TestFiles)
 	testCount := len(scan.TestFiles)
@@ -59,14 +70,14 @@ func scoreTestPresence(profile *domain.ScoringProfile, scan *domain.ScanResult)
 	return sm
 }
 
-// scoreTestNaming (25 pts): Test<Func>_<Scenario> pattern + t.Run subtests.
-func scoreTestNaming(_ *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
-	sm := domain.SubMetric{Name: "test_naming", Points: 25}
+// scoreTestNaming (20 pts): Test<Func>_<Scenario> pattern + t.Run subtests.
+func scoreTestNaming(profile *domain.ScoringProfile, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
+	sm := domain.SubMetric{Name: "test_naming", Points: 20}
 
 	totalTests := 0
 	wellNamed := 0
 
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		if !strings.HasSuffix(af.Path, "_test.go") {
 			continue
 		}
@@ -88,18 +99,19 @@ func scoreTestNaming(_ *domain.ScanResult, analyzed map[string]*domain.AnalyzedF
 		return sm
 	}
 
-	ratio := float64(wellNamed) / float64(totalTests)
+	ratio := smoothRatio(profile, float64(wellNamed), totalTests)
 	sm.Score = int(ratio * float64(sm.Points))
 	if sm.Score > sm.Points {
 		sm.Score = sm.Points
 	}
-	sm.Detail = fmt.Sprintf("%d/%d test functions follow Test<Func>_<Scenario> naming", wellNamed, totalTests)
+	sm.Detail = fmt.Sprintf("%d/%d test functions follow Test<Func>_<Scenario> naming", wellNamed, totalTests) +
+		smoothingNote(profile, float64(wellNamed), totalTests)
 	return sm
 }
 
-// scoreBuildReproducibility (25 pts): go.sum (10), Makefile/Taskfile (8), CI config (7).
+// scoreBuildReproducibility (20 pts): go.sum (8), Makefile/Taskfile (6), CI config (4), linter config (2).
 func scoreBuildReproducibility(scan *domain.ScanResult) domain.SubMetric {
-	sm := domain.SubMetric{Name: "build_reproducibility", Points: 25}
+	sm := domain.SubMetric{Name: "build_reproducibility", Points: 20}
 
 	if scan == nil {
 		sm.Detail = "no scan data"
@@ -109,35 +121,35 @@ func scoreBuildReproducibility(scan *domain.ScanResult) domain.SubMetric {
 	points := 0
 	found := []string{}
 
-	// go.sum (10 pts)
+	// go.sum (8 pts)
 	for _, f := range scan.AllFiles {
 		if f == "go.sum" {
-			points += 10
+			points += 8
 			found = append(found, "go.sum")
 			break
 		}
 	}
 
-	// Makefile/Taskfile/justfile (7 pts)
+	// Makefile/Taskfile/justfile (6 pts)
 	for _, f := range scan.AllFiles {
 		lower := strings.ToLower(f)
 		if lower == "makefile" || lower == "taskfile.yml" || lower == "taskfile.yaml" || lower == "justfile" {
-			points += 7
+			points += 6
 			found = append(found, f)
 			break
 		}
 	}
 
-	// CI config (5 pts)
+	// CI config (4 pts)
 	if scan.HasCIConfig {
-		points += 5
+		points += 4
 		found = append(found, "CI config")
 	}
 
-	// Linter config (3 pts) — complements type_safety_signals
+	// Linter config (2 pts) — complements type_safety_signals
 	for _, f := range scan.AllFiles {
 		if f == ".golangci.yml" || f == ".golangci.yaml" {
-			points += 3
+			points += 2
 			found = append(found, f)
 			break
 		}
@@ -155,26 +167,26 @@ func scoreBuildReproducibility(scan *domain.ScanResult) domain.SubMetric {
 	return sm
 }
 
-// scoreTypeSafetySignals (25 pts): .golangci.yml (10), low interface{}/any (10), safe type assertions (5).
+// scoreTypeSafetySignals (20 pts): .golangci.yml (8), low interface{}/any (8), safe type assertions (4).
 func scoreTypeSafetySignals(scan *domain.ScanResult, analyzed map[string]*domain.AnalyzedFile) domain.SubMetric {
-	sm := domain.SubMetric{Name: "type_safety_signals", Points: 25}
+	sm := domain.SubMetric{Name: "type_safety_signals", Points: 20}
 
 	points := 0
 
-	// .golangci.yml (10 pts)
+	// .golangci.yml (8 pts)
 	if scan != nil {
 		for _, f := range scan.AllFiles {
 			if f == ".golangci.yml" || f == ".golangci.yaml" {
-				points += 10
+				points += 8
 				break
 			}
 		}
 	}
 
-	// Low interface{}/any usage (10 pts) — check param types across all functions.
+	// Low interface{}/any usage (8 pts) — check param types across all functions.
 	totalParams := 0
 	emptyInterfaceParams := 0
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		for _, fn := range af.Functions {
 			for _, p := range fn.Params {
 				totalParams++
@@ -187,18 +199,18 @@ func scoreTypeSafetySignals(scan *domain.ScanResult, analyzed map[string]*domain
 	if totalParams > 0 {
 		ratio := float64(emptyInterfaceParams) / float64(totalParams)
 		if ratio < 0.05 {
-			points += 10
+			points += 8
 		} else if ratio < 0.15 {
-			points += 5
+			points += 4
 		}
 	} else {
-		points += 10 // No params to check = clean
+		points += 8 // No params to check = clean
 	}
 
-	// Safe type assertions (5 pts)
+	// Safe type assertions (4 pts)
 	totalAssertions := 0
 	safeAssertions := 0
-	for _, af := range analyzed {
+	for _, af := range sortedFiles(analyzed) {
 		for _, ta := range af.TypeAssertions {
 			totalAssertions++
 			if ta.Safe {
@@ -207,11 +219,11 @@ func scoreTypeSafetySignals(scan *domain.ScanResult, analyzed map[string]*domain
 		}
 	}
 	if totalAssertions == 0 {
-		points += 5 // No assertions = clean
+		points += 4 // No assertions = clean
 	} else if float64(safeAssertions)/float64(totalAssertions) >= 0.8 {
-		points += 5
+		points += 4
 	} else if float64(safeAssertions)/float64(totalAssertions) >= 0.5 {
-		points += 3
+		points += 2
 	}
 
 	if points > sm.Points {
@@ -227,7 +239,7 @@ func collectVerifiabilityIssues(_ *domain.ScanResult, metrics []domain.SubMetric
 	var issues []domain.Issue
 
 	for _, m := range metrics {
-		if m.Score == 0 {
+		if m.Score == 0 && !m.Skipped && m.Name != "dependency_hygiene" {
 			severity := domain.SeverityWarning
 			if m.Name == "test_presence" {
 				severity = domain.SeverityError
@@ -235,8 +247,7 @@ func collectVerifiabilityIssues(_ *domain.ScanResult, metrics []domain.SubMetric
 			issues = append(issues, domain.Issue{
 				Severity: severity,
 				Category: "verifiability",
-				Message:  fmt.Sprintf("missing %s: %s", m.Name, m.Detail),
-			})
+			}.WithMessage("verifiability.missing", m.Name, m.Detail))
 		}
 	}
 
@@ -6,6 +6,7 @@ import (
 	"github.com/abdidvp/openkraft/internal/domain"
 	"github.com/abdidvp/openkraft/internal/domain/scoring"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestScoreVerifiability_NilInputs(t *testing.T) {
@@ -13,7 +14,7 @@ func TestScoreVerifiability_NilInputs(t *testing.T) {
 
 	assert.Equal(t, "verifiability", result.Name)
 	assert.Equal(t, 0.15, result.Weight)
-	assert.Len(t, result.SubMetrics, 4)
+	assert.Len(t, result.SubMetrics, 5)
 	assert.GreaterOrEqual(t, result.Score, 0)
 	assert.LessOrEqual(t, result.Score, 100)
 }
@@ -26,7 +27,7 @@ func TestScoreVerifiability_EmptyInputs(t *testing.T) {
 
 	assert.Equal(t, "verifiability", result.Name)
 	assert.Equal(t, 0.15, result.Weight)
-	assert.Len(t, result.SubMetrics, 4)
+	assert.Len(t, result.SubMetrics, 5)
 }
 
 func TestScoreVerifiability_WellTestedProject(t *testing.T) {
@@ -81,13 +82,13 @@ func TestScoreVerifiability_WellTestedProject(t *testing.T) {
 
 	assert.Equal(t, "verifiability", result.Name)
 	assert.Equal(t, 0.15, result.Weight)
-	assert.Len(t, result.SubMetrics, 4)
+	assert.Len(t, result.SubMetrics, 5)
 	assert.Greater(t, result.Score, 0)
 	assert.LessOrEqual(t, result.Score, 100)
 
 	expectedNames := []string{
 		"test_presence", "test_naming",
-		"build_reproducibility", "type_safety_signals",
+		"build_reproducibility", "type_safety_signals", "dependency_hygiene",
 	}
 	for i, name := range expectedNames {
 		assert.Equal(t, name, result.SubMetrics[i].Name)
@@ -131,7 +132,7 @@ func TestScoreVerifiability_BuildReproducibilitySignals(t *testing.T) {
 
 	buildRepro := result.SubMetrics[2]
 	assert.Equal(t, "build_reproducibility", buildRepro.Name)
-	assert.Equal(t, 25, buildRepro.Score) // 10 + 7 + 5 + 3 = 25
+	assert.Equal(t, 20, buildRepro.Score) // 8 + 6 + 4 + 2 = 20
 }
 
 func TestScoreVerifiability_CustomTestRatio(t *testing.T) {
@@ -147,6 +148,146 @@ func TestScoreVerifiability_CustomTestRatio(t *testing.T) {
 
 	testPresence := result.SubMetrics[0]
 	assert.Equal(t, "test_presence", testPresence.Name)
-	// 1 test / 2 source = 0.5 ratio. Target 1.0 → 0.5/1.0 * 25 = 12.
-	assert.Equal(t, 12, testPresence.Score)
+	// 1 test / 2 source = 0.5 ratio. Target 1.0 → 0.5/1.0 * 20 = 10.
+	assert.Equal(t, 10, testPresence.Score)
+}
+
+func TestScoreVerifiability_DependencyHygieneSkippedWithoutGoMod(t *testing.T) {
+	scan := &domain.ScanResult{
+		GoFiles:   []string{"a.go", "a_test.go"},
+		TestFiles: []string{"a_test.go"},
+	}
+
+	result := scoring.ScoreVerifiability(defaultProfile(), scan, nil)
+
+	deps := result.SubMetrics[4]
+	assert.Equal(t, "dependency_hygiene", deps.Name)
+	assert.True(t, deps.Skipped)
+	// test_presence (20) and type_safety_signals (12) earn 32 of the 80
+	// measured points.
+	assert.Equal(t, 40, result.Score)
+}
+
+func TestScoreVerifiability_DependencyHygiene(t *testing.T) {
+	tests := []struct {
+		name      string
+		mod       domain.GoModInfo
+		wantScore int
+		wantIssue string
+		severity  string
+	}{
+		{
+			name: "clean",
+			mod: domain.GoModInfo{
+				Requires: []domain.ModuleRequire{{Path: "github.com/spf13/cobra", Version: "v1.8.0", Line: 5}},
+				HasGoSum: true,
+				Sums:     map[string]bool{"github.com/spf13/cobra@v1.8.0": true},
+			},
+			wantScore: 20,
+		},
+		{
+			name: "local replace",
+			mod: domain.GoModInfo{
+				Replaces: []domain.ModuleReplace{{Old: "example.com/lib", New: "../lib", Local: true, Line: 9}},
+			},
+			wantScore: 14,
+			wantIssue: "local path ../lib",
+			severity:  domain.SeverityError,
+		},
+		{
+			name: "module replace",
+			mod: domain.GoModInfo{
+				Replaces: []domain.ModuleReplace{{Old: "example.com/lib", New: "example.com/fork", Line: 9}},
+			},
+			wantScore: 17,
+			wantIssue: "redirects example.com/lib",
+			severity:  domain.SeverityWarning,
+		},
+		{
+			name: "pseudo-version",
+			mod: domain.GoModInfo{
+				Requires: []domain.ModuleRequire{{Path: "example.com/lib", Version: "v0.0.0-20240101120000-abcdef123456", Line: 4}},
+			},
+			wantScore: 18,
+			wantIssue: "pseudo-version",
+			severity:  domain.SeverityWarning,
+		},
+		{
+			name: "indirect pseudo-version is ignored",
+			mod: domain.GoModInfo{
+				Requires: []domain.ModuleRequire{{Path: "example.com/lib", Version: "v0.0.0-20240101120000-abcdef123456", Indirect: true, Line: 4}},
+			},
+			wantScore: 20,
+		},
+		{
+			name: "deprecated module",
+			mod: domain.GoModInfo{
+				Requires: []domain.ModuleRequire{{Path: "github.com/golang/protobuf", Version: "v1.5.4", Line: 6}},
+			},
+			wantScore: 17,
+			wantIssue: "use google.golang.org/protobuf",
+			severity:  domain.SeverityWarning,
+		},
+		{
+			name: "stdlib overlap",
+			mod: domain.GoModInfo{
+				Requires: []domain.ModuleRequire{{Path: "github.com/pkg/errors", Version: "v0.9.1", Line: 7}},
+			},
+			wantScore: 19,
+			wantIssue: "overlaps the standard library",
+			severity:  domain.SeverityInfo,
+		},
+		{
+			name: "missing checksum",
+			mod: domain.GoModInfo{
+				Requires: []domain.ModuleRequire{{Path: "github.com/spf13/cobra", Version: "v1.8.0", Line: 5}},
+				HasGoSum: true,
+				Sums:     map[string]bool{},
+			},
+			wantScore: 18,
+			wantIssue: "go.sum has no checksum",
+			severity:  domain.SeverityWarning,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mod := tt.mod
+			result := scoring.ScoreVerifiability(defaultProfile(), &domain.ScanResult{GoMod: &mod}, nil)
+
+			deps := result.SubMetrics[4]
+			assert.Equal(t, tt.wantScore, deps.Score)
+
+			var found []domain.Issue
+			for _, iss := range result.Issues {
+				if iss.SubMetric == "dependency_hygiene" {
+					found = append(found, iss)
+				}
+			}
+			if tt.wantIssue == "" {
+				assert.Empty(t, found)
+				return
+			}
+			require.Len(t, found, 1)
+			assert.Contains(t, found[0].Message, tt.wantIssue)
+			assert.Equal(t, tt.severity, found[0].Severity)
+			assert.Equal(t, "go.mod", found[0].File)
+			assert.NotZero(t, found[0].Line)
+		})
+	}
+}
+
+func TestScoreVerifiability_TooManyDirectDependencies(t *testing.T) {
+	p := domain.DefaultProfile()
+	p.MaxDirectDependencies = 1
+	mod := &domain.GoModInfo{Requires: []domain.ModuleRequire{
+		{Path: "example.com/a", Version: "v1.0.0", Line: 4},
+		{Path: "example.com/b", Version: "v1.0.0", Line: 5},
+		{Path: "example.com/c", Version: "v1.0.0", Indirect: true, Line: 6},
+	}}
+
+	result := scoring.ScoreVerifiability(&p, &domain.ScanResult{GoMod: mod}, nil)
+
+	assert.Equal(t, 16, result.SubMetrics[4].Score)
+	assert.Contains(t, result.SubMetrics[4].Detail, "2 direct dependencies")
 }
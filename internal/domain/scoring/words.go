@@ -0,0 +1,170 @@
+package scoring
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+// WordSplitter splits Go identifiers into words. Unlike a plain camel-case
+// split it keeps known acronyms intact and apart (HTTPServerID → HTTP,
+// Server, ID; SQLDBConn → SQL, DB, Conn), keeps plural acronyms whole
+// (userIDs → user, IDs), recognizes mixed-case acronyms (OAuth, IPv4) and
+// attaches digits to the preceding word (Base64Encode → Base64, Encode).
+type WordSplitter struct {
+	acronyms map[string]bool
+	mixed    []string // acronyms with lower-case letters or digits, longest first
+}
+
+// NewWordSplitter returns a splitter that knows the given acronyms.
+func NewWordSplitter(acronyms []string) *WordSplitter {
+	s := &WordSplitter{acronyms: make(map[string]bool, len(acronyms))}
+	for _, a := range acronyms {
+		if a == "" {
+			continue
+		}
+		if strings.ToUpper(a) == a && !strings.ContainsAny(a, "0123456789") {
+			s.acronyms[a] = true
+		} else {
+			s.mixed = append(s.mixed, a)
+		}
+	}
+	sort.Slice(s.mixed, func(i, j int) bool { return len(s.mixed[i]) > len(s.mixed[j]) })
+	return s
+}
+
+var defaultSplitter = NewWordSplitter(domain.DefaultProfile().Acronyms)
+
+// SplitWords splits name with the default acronym dictionary.
+func SplitWords(name string) []string { return defaultSplitter.Split(name) }
+
+// splitterFor returns the splitter for the profile's acronyms.
+func splitterFor(profile *domain.ScoringProfile) *WordSplitter {
+	if profile == nil || len(profile.Acronyms) == 0 {
+		return defaultSplitter
+	}
+	return NewWordSplitter(profile.Acronyms)
+}
+
+// Split returns the words of name. Underscores separate words and are
+// dropped.
+func (s *WordSplitter) Split(name string) []string {
+	if s == nil {
+		s = defaultSplitter
+	}
+	var words []string
+	runes := []rune(name)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == '_' || !(unicode.IsLetter(r) || unicode.IsDigit(r)):
+			i++
+		case unicode.IsDigit(r):
+			j := runEnd(runes, i, unicode.IsDigit)
+			if len(words) > 0 && i > 0 && runes[i-1] != '_' {
+				words[len(words)-1] += string(runes[i:j])
+			} else {
+				words = append(words, string(runes[i:j]))
+			}
+			i = j
+		default:
+			if m := s.matchMixed(runes, i); m != "" {
+				words = append(words, m)
+				i += len([]rune(m))
+				continue
+			}
+			var next []string
+			next, i = s.splitLetters(runes, i)
+			words = append(words, next...)
+		}
+	}
+	return words
+}
+
+// splitLetters consumes one camel-case word starting at i, splitting a
+// leading run of capitals into acronyms.
+func (s *WordSplitter) splitLetters(runes []rune, i int) ([]string, int) {
+	if unicode.IsLower(runes[i]) {
+		j := runEnd(runes, i, unicode.IsLower)
+		return []string{string(runes[i:j])}, j
+	}
+	upper := i
+	for upper < len(runes) && unicode.IsUpper(runes[upper]) {
+		if upper > i && s.matchMixed(runes, upper) != "" {
+			break // GKEK8S: K8S starts a new word
+		}
+		upper++
+	}
+	lower := runEnd(runes, upper, unicode.IsLower)
+	switch {
+	case upper-i == 1:
+		// Ordinary word: Server.
+		return []string{string(runes[i:lower])}, lower
+	case lower == upper:
+		// Capitals up to a digit, underscore or the end: ID, SQLDB.
+		return s.segment(string(runes[i:upper])), upper
+	case lower-upper == 1 && runes[upper] == 's' && wordBoundary(runes, lower):
+		// Plural acronym: IDs, URLs.
+		parts := s.segment(string(runes[i:upper]))
+		parts[len(parts)-1] += "s"
+		return parts, lower
+	default:
+		// The last capital starts the next word: HTTPServer → HTTP, Server.
+		return append(s.segment(string(runes[i:upper-1])), string(runes[upper-1:lower])), lower
+	}
+}
+
+// segment splits a run of capitals into known acronyms, or keeps it whole
+// when it is not made of known acronyms.
+func (s *WordSplitter) segment(run string) []string {
+	if s.acronyms[run] || len(run) == 1 {
+		return []string{run}
+	}
+	// best[i] holds a split of run[:i] into acronyms, preferring fewer parts.
+	best := make([][]string, len(run)+1)
+	best[0] = []string{}
+	for end := 1; end <= len(run); end++ {
+		for start := 0; start < end; start++ {
+			if best[start] == nil || !s.acronyms[run[start:end]] {
+				continue
+			}
+			if cand := append(append([]string{}, best[start]...), run[start:end]); best[end] == nil || len(cand) < len(best[end]) {
+				best[end] = cand
+			}
+		}
+	}
+	if best[len(run)] == nil {
+		return []string{run}
+	}
+	return best[len(run)]
+}
+
+// matchMixed returns the mixed-case acronym (OAuth, IPv4) starting at i and
+// ending at a word boundary, or "".
+func (s *WordSplitter) matchMixed(runes []rune, i int) string {
+	if i > 0 && unicode.IsLower(runes[i]) && unicode.IsLetter(runes[i-1]) {
+		return "" // inside a lower-case word
+	}
+	for _, m := range s.mixed {
+		mr := []rune(m)
+		end := i + len(mr)
+		if end <= len(runes) && string(runes[i:end]) == m && (wordBoundary(runes, end) || unicode.IsDigit(runes[end])) {
+			return m
+		}
+	}
+	return ""
+}
+
+// wordBoundary reports whether a new word may start at i.
+func wordBoundary(runes []rune, i int) bool {
+	return i >= len(runes) || unicode.IsUpper(runes[i]) || runes[i] == '_'
+}
+
+func runEnd(runes []rune, i int, in func(rune) bool) int {
+	for i < len(runes) && in(runes[i]) {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,52 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+func TestSplitWords_Acronyms(t *testing.T) {
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"CreateUser", []string{"Create", "User"}},
+		{"HTTPServerID", []string{"HTTP", "Server", "ID"}},
+		{"SQLDBConn", []string{"SQL", "DB", "Conn"}},
+		{"GetHTTPSURL", []string{"Get", "HTTPS", "URL"}},
+		{"ParseJSONToXML", []string{"Parse", "JSON", "To", "XML"}},
+		{"userIDs", []string{"user", "IDs"}},
+		{"getURLsForIDs", []string{"get", "URLs", "For", "IDs"}},
+		{"ServeHTTP", []string{"Serve", "HTTP"}},
+		{"Base64Encode", []string{"Base64", "Encode"}},
+		{"OAuth2Token", []string{"OAuth2", "Token"}},
+		{"IPv4Addr", []string{"IPv4", "Addr"}},
+		{"newGRPCClient", []string{"new", "GRPC", "Client"}},
+		{"XYZHandler", []string{"XYZ", "Handler"}}, // unknown acronym stays whole
+		{"parse_config_file", []string{"parse", "config", "file"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, scoring.SplitWords(tt.name))
+		})
+	}
+}
+
+func TestWordSplitter_CustomAcronyms(t *testing.T) {
+	s := scoring.NewWordSplitter([]string{"K8S", "GKE", "eBPF"})
+
+	assert.Equal(t, []string{"Deploy", "GKE", "K8S", "Cluster"}, s.Split("DeployGKEK8SCluster"))
+	assert.Equal(t, []string{"eBPF", "Program"}, s.Split("eBPFProgram"))
+	// HTTP is not in this dictionary, so HTTPSQL cannot be segmented.
+	assert.Equal(t, []string{"HTTPSQL", "Conn"}, s.Split("HTTPSQLConn"))
+}
+
+func TestWordCount_AcronymsAreOneWord(t *testing.T) {
+	assert.Equal(t, 3, scoring.WordCount("SQLDBConn"))
+	assert.Equal(t, 2, scoring.WordCount("userIDs"))
+	assert.Equal(t, 1.0, scoring.WordCountScore("NewHTTPServerID"))
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// secretRule recognizes a credential by its well-known format.
+type secretRule struct {
+	kind string
+	re   *regexp.Regexp
+}
+
+var secretRules = []secretRule{
+	{"AWS access key", regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"GitHub token", regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}`)},
+	{"Slack token", regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+	{"Stripe key", regexp.MustCompile(`\b[rs]k_live_[A-Za-z0-9]{20,}`)},
+	{"Google API key", regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}`)},
+	{"private key", regexp.MustCompile(`-----BEGIN [A-Z]+ ?PRIVATE KEY-----`)},
+	{"JSON web token", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`)},
+	{"password in URL", regexp.MustCompile(`[a-z][a-z0-9+.-]*://[^/\s:@]+:[^/\s:@$%{]+@`)},
+}
+
+// credentialWord matches names and values that mention a credential.
+var credentialWord = regexp.MustCompile(`(?i)passw(or)?d|passwd|pwd|secret|token|api_?key|access_?key|private_?key|credential`)
+
+// descriptorWords end names that hold something about a credential, such
+// as tokenHeader or passwordEnv, rather than the credential itself.
+var descriptorWords = []string{
+	"name", "env", "var", "header", "path", "file", "dir", "field", "flag", "type", "prefix",
+	"param", "url", "uri", "label", "kind", "id", "msg", "message", "prompt", "format", "pattern",
+	"endpoint", "length", "len", "size", "ttl", "expiry", "cookie",
+}
+
+// minEntropyLen and minEntropyBits bound the generic high-entropy check:
+// random keys of this length score above the threshold, while identifiers
+// and hex digests, which repeat characters or use only 16 of them, score
+// below.
+const (
+	minEntropyLen  = 24
+	minEntropyBits = 4.2
+)
+
+// SecretKind returns what kind of credential value looks like, given the
+// name it is bound to, or "" when it does not look like one: a known key
+// or token format, a plausible value bound to a credential-named
+// constant, variable or field, or a long random-looking string.
+func SecretKind(name, value string) string {
+	for _, r := range secretRules {
+		if r.re.MatchString(value) {
+			return r.kind
+		}
+	}
+	if isCredentialName(name) && isPlausibleSecret(value) {
+		return "credential"
+	}
+	if len(value) >= minEntropyLen && isKeyAlphabet(value) &&
+		hasMixedClasses(value) && shannonEntropy(value) >= minEntropyBits {
+		return "high-entropy key"
+	}
+	return ""
+}
+
+// isCredentialName reports whether name says it holds a credential.
+func isCredentialName(name string) bool {
+	if !credentialWord.MatchString(name) {
+		return false
+	}
+	lower := strings.ToLower(name)
+	for _, w := range descriptorWords {
+		if strings.HasSuffix(lower, w) {
+			return false
+		}
+	}
+	return true
+}
+
+// isPlausibleSecret reports whether value could be a password or key
+// rather than a label, an environment variable name, a placeholder or a
+// template: a single word mixing letters with digits or symbols that does
+// not itself mention a credential.
+func isPlausibleSecret(value string) bool {
+	switch {
+	case strings.ContainsFunc(value, unicode.IsSpace),
+		credentialWord.MatchString(value),
+		strings.ContainsAny(value, "<>{}$%*"),
+		strings.Contains(value, "://"),
+		strings.HasPrefix(value, "/"),
+		strings.ToUpper(value) == value && !strings.ContainsFunc(value, unicode.IsDigit):
+		return false
+	}
+	return strings.ContainsFunc(value, unicode.IsLetter) && strings.ContainsFunc(value, func(r rune) bool { return !unicode.IsLetter(r) })
+}
+
+// isKeyAlphabet reports whether s uses only the characters of base64 and
+// hex encoded keys.
+func isKeyAlphabet(s string) bool {
+	return !strings.ContainsFunc(s, func(r rune) bool {
+		return r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("+/=_-", r))
+	})
+}
+
+// hasMixedClasses reports whether s has upper-case letters, lower-case
+// letters and digits.
+func hasMixedClasses(s string) bool {
+	return strings.ContainsFunc(s, unicode.IsUpper) && strings.ContainsFunc(s, unicode.IsLower) &&
+		strings.ContainsFunc(s, unicode.IsDigit)
+}
+
+// shannonEntropy returns the entropy of s in bits per character.
+func shannonEntropy(s string) float64 {
+	counts := make(map[rune]int)
+	n := 0
+	for _, r := range s {
+		counts[r]++
+		n++
+	}
+	var h float64
+	for _, c := range counts {
+		p := float64(c) / float64(n)
+		h -= p * math.Log2(p)
+	}
+	return h
+}
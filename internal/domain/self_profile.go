@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// SelfProfile records how long openkraft itself took to score a project and
+// how much it allocated, for performance reports and regression tracking.
+type SelfProfile struct {
+	WallTimeMs    float64       `json:"wall_time_ms"`
+	Phases        []PhaseTiming `json:"phases"`
+	Allocs        uint64        `json:"allocs"`
+	AllocBytes    uint64        `json:"alloc_bytes"`
+	Files         int           `json:"files"`
+	GoFiles       int           `json:"go_files"`
+	AnalyzedFiles int           `json:"analyzed_files"`
+}
+
+// PhaseTiming is the wall time of one pipeline phase (scan, parse, score,
+// render).
+type PhaseTiming struct {
+	Name       string  `json:"name"`
+	DurationMs float64 `json:"duration_ms"`
+}
+
+// AddPhase appends a phase and adds its duration to the wall time.
+func (p *SelfProfile) AddPhase(name string, d time.Duration) {
+	ms := Milliseconds(d)
+	p.Phases = append(p.Phases, PhaseTiming{Name: name, DurationMs: ms})
+	p.WallTimeMs = math.Round((p.WallTimeMs+ms)*1000) / 1000
+}
+
+// Milliseconds converts d to fractional milliseconds.
+func Milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
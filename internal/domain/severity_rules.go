@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// SeverityRule remaps the severity of matching issues so penalties follow
+// team policy, e.g. treating import cycles as warnings during a migration.
+// Empty selectors match any issue; From limits the rule to issues of one
+// severity.
+type SeverityRule struct {
+	Category  string `yaml:"category,omitempty"   json:"category,omitempty"`
+	SubMetric string `yaml:"sub_metric,omitempty" json:"sub_metric,omitempty"`
+	Pattern   string `yaml:"pattern,omitempty"    json:"pattern,omitempty"`
+	Path      string `yaml:"path,omitempty"       json:"path,omitempty"` // glob over the issue's file; "dir/**" matches a subtree
+	From      string `yaml:"from,omitempty"       json:"from,omitempty"`
+	Severity  string `yaml:"severity"             json:"severity"`
+}
+
+// Matches reports whether the rule selects issue.
+func (r SeverityRule) Matches(issue Issue) bool {
+	switch {
+	case r.Category != "" && r.Category != issue.Category,
+		r.SubMetric != "" && r.SubMetric != issue.SubMetric,
+		r.Pattern != "" && r.Pattern != issue.Pattern,
+		r.From != "" && r.From != issue.Severity:
+		return false
+	}
+	return r.Path == "" || MatchPathGlob(r.Path, issue.File)
+}
+
+// ApplySeverityRules sets the severity of each issue from the first rule
+// matching it. Issues no rule matches keep their severity.
+func ApplySeverityRules(rules []SeverityRule, issues []Issue) {
+	if len(rules) == 0 {
+		return
+	}
+	for i := range issues {
+		for _, r := range rules {
+			if r.Matches(issues[i]) {
+				issues[i].Severity = r.Severity
+				break
+			}
+		}
+	}
+}
+
+// MatchPathGlob matches a slash-separated file path against a path.Match
+// pattern, where a trailing "/**" matches everything below a directory.
+func MatchPathGlob(pattern, file string) bool {
+	if file == "" {
+		return false
+	}
+	file = strings.ReplaceAll(file, "\\", "/")
+	if dir, ok := strings.CutSuffix(pattern, "/**"); ok {
+		for d := path.Dir(file); d != "." && d != "/"; d = path.Dir(d) {
+			if ok, _ := path.Match(dir, d); ok {
+				return true
+			}
+		}
+		return false
+	}
+	ok, _ := path.Match(pattern, file)
+	return ok
+}
+
+func validateSeverityRules(rules []SeverityRule) error {
+	for i, r := range rules {
+		if !isValidSeverity(r.Severity) {
+			return fmt.Errorf("severity[%d].severity %q is invalid (valid: error, warning, info)", i, r.Severity)
+		}
+		if r.From != "" && !isValidSeverity(r.From) {
+			return fmt.Errorf("severity[%d].from %q is invalid (valid: error, warning, info)", i, r.From)
+		}
+		if r.Category != "" && !isValidCategory(r.Category) {
+			return fmt.Errorf("unknown category %q in severity[%d]", r.Category, i)
+		}
+		if r.SubMetric != "" && !isValidSubMetric(r.SubMetric) {
+			return fmt.Errorf("unknown sub-metric %q in severity[%d]", r.SubMetric, i)
+		}
+		if r.Path != "" {
+			if _, err := path.Match(strings.TrimSuffix(r.Path, "/**"), ""); err != nil {
+				return fmt.Errorf("severity[%d].path %q: %w", i, r.Path, err)
+			}
+		}
+		if r.Category == "" && r.SubMetric == "" && r.Pattern == "" && r.Path == "" && r.From == "" {
+			return fmt.Errorf("severity[%d] matches every issue; set category, sub_metric, pattern, path or from", i)
+		}
+	}
+	return nil
+}
+
+func isValidSeverity(s string) bool {
+	return s == SeverityError || s == SeverityWarning || s == SeverityInfo
+}
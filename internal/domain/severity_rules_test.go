@@ -0,0 +1,92 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeverityRule_Matches(t *testing.T) {
+	issue := domain.Issue{
+		Severity:  domain.SeverityError,
+		Category:  "code_health",
+		SubMetric: "parameter_count",
+		File:      "internal/cgo/bridge/bridge.go",
+		Pattern:   "parameter-count",
+	}
+
+	tests := []struct {
+		name string
+		rule domain.SeverityRule
+		want bool
+	}{
+		{"sub-metric", domain.SeverityRule{SubMetric: "parameter_count"}, true},
+		{"other sub-metric", domain.SeverityRule{SubMetric: "function_size"}, false},
+		{"category", domain.SeverityRule{Category: "code_health"}, true},
+		{"pattern", domain.SeverityRule{Pattern: "import-cycle"}, false},
+		{"from matches", domain.SeverityRule{From: domain.SeverityError}, true},
+		{"from differs", domain.SeverityRule{From: domain.SeverityWarning}, false},
+		{"subtree glob", domain.SeverityRule{Path: "internal/cgo/**"}, true},
+		{"nested subtree glob", domain.SeverityRule{Path: "internal/*/bridge/**"}, true},
+		{"other subtree", domain.SeverityRule{Path: "internal/api/**"}, false},
+		{"file glob", domain.SeverityRule{Path: "internal/cgo/bridge/*.go"}, true},
+		{"file glob wrong depth", domain.SeverityRule{Path: "internal/*.go"}, false},
+		{"all selectors", domain.SeverityRule{SubMetric: "parameter_count", Path: "internal/cgo/**", From: domain.SeverityError}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rule.Matches(issue))
+		})
+	}
+}
+
+func TestSeverityRule_PathNeverMatchesPackageIssues(t *testing.T) {
+	rule := domain.SeverityRule{Path: "**"}
+	assert.False(t, rule.Matches(domain.Issue{Message: `package "x" has an import cycle`}))
+}
+
+func TestApplySeverityRules_FirstMatchWins(t *testing.T) {
+	issues := []domain.Issue{
+		{Severity: domain.SeverityError, SubMetric: "dependency_direction", Pattern: "import-cycle"},
+		{Severity: domain.SeverityError, SubMetric: "parameter_count", File: "internal/cgo/a.go"},
+		{Severity: domain.SeverityError, SubMetric: "parameter_count", File: "internal/api/a.go"},
+	}
+	rules := []domain.SeverityRule{
+		{Pattern: "import-cycle", Severity: domain.SeverityWarning},
+		{SubMetric: "parameter_count", Path: "internal/cgo/**", Severity: domain.SeverityInfo},
+		{SubMetric: "parameter_count", Severity: domain.SeverityWarning},
+	}
+
+	domain.ApplySeverityRules(rules, issues)
+
+	assert.Equal(t, domain.SeverityWarning, issues[0].Severity)
+	assert.Equal(t, domain.SeverityInfo, issues[1].Severity)
+	assert.Equal(t, domain.SeverityWarning, issues[2].Severity)
+}
+
+func TestValidate_SeverityRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []domain.SeverityRule
+		wantErr string
+	}{
+		{"valid", []domain.SeverityRule{{Pattern: "import-cycle", Severity: "warning"}}, ""},
+		{"bad severity", []domain.SeverityRule{{Pattern: "x", Severity: "fatal"}}, "severity[0].severity"},
+		{"bad from", []domain.SeverityRule{{Pattern: "x", From: "high", Severity: "info"}}, "severity[0].from"},
+		{"unknown category", []domain.SeverityRule{{Category: "speed", Severity: "info"}}, "unknown category"},
+		{"unknown sub-metric", []domain.SeverityRule{{SubMetric: "nope", Severity: "info"}}, "unknown sub-metric"},
+		{"bad glob", []domain.SeverityRule{{Path: "internal/[", Severity: "info"}}, "severity[0].path"},
+		{"no selector", []domain.SeverityRule{{Severity: "info"}}, "matches every issue"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := domain.ProjectConfig{Severity: tt.rules}.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
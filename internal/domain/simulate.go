@@ -0,0 +1,243 @@
+package domain
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// SimulationPlan lists hypothetical refactorings to score before making them.
+type SimulationPlan struct {
+	Edits []SimulatedEdit `yaml:"edits" json:"edits"`
+}
+
+// SimulatedEdit is one hypothetical refactoring. Exactly one field is set.
+type SimulatedEdit struct {
+	SplitFunction    *SplitFunctionEdit    `yaml:"split_function,omitempty" json:"split_function,omitempty"`
+	RemoveDependency *RemoveDependencyEdit `yaml:"remove_dependency,omitempty" json:"remove_dependency,omitempty"`
+	DeleteFile       string                `yaml:"delete_file,omitempty" json:"delete_file,omitempty"`
+}
+
+// SplitFunctionEdit splits a function into Parts functions of equal length
+// and complexity, at most one per line of the function. Function may be
+// qualified with its receiver ("Server.Run").
+type SplitFunctionEdit struct {
+	File     string `yaml:"file" json:"file"`
+	Function string `yaml:"function" json:"function"`
+	Parts    int    `yaml:"parts" json:"parts"`
+}
+
+// RemoveDependencyEdit drops every import of To from the files under the
+// directory From. To matches an import path exactly or by its trailing
+// path elements ("internal/adapters/outbound/scanner").
+type RemoveDependencyEdit struct {
+	From string `yaml:"from" json:"from"`
+	To   string `yaml:"to" json:"to"`
+}
+
+// SimulationResult is the projected score of a plan. Each edit is scored
+// alone (to rank refactors by gain) and cumulatively (in plan order).
+type SimulationResult struct {
+	Baseline   int             `json:"baseline"`
+	Projected  int             `json:"projected"`
+	Delta      int             `json:"delta"`
+	Edits      []EditImpact    `json:"edits"`
+	Categories []CategoryDelta `json:"categories"`
+}
+
+// EditImpact is the projected effect of one edit.
+type EditImpact struct {
+	Edit       string `json:"edit"`
+	Alone      int    `json:"alone"`      // overall score with only this edit
+	Gain       int    `json:"gain"`       // Alone minus the baseline
+	Cumulative int    `json:"cumulative"` // overall score after this and all earlier edits
+}
+
+// Validate checks that the plan has edits and each edit is well-formed.
+func (p SimulationPlan) Validate() error {
+	if len(p.Edits) == 0 {
+		return fmt.Errorf("simulation plan has no edits")
+	}
+	for i, e := range p.Edits {
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("edit %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks that exactly one edit kind is set with its required fields.
+func (e SimulatedEdit) Validate() error {
+	kinds := 0
+	if e.SplitFunction != nil {
+		kinds++
+		if e.SplitFunction.File == "" || e.SplitFunction.Function == "" {
+			return fmt.Errorf("split_function requires file and function")
+		}
+		if e.SplitFunction.Parts < 2 {
+			return fmt.Errorf("split_function parts must be at least 2, got %d", e.SplitFunction.Parts)
+		}
+	}
+	if e.RemoveDependency != nil {
+		kinds++
+		if e.RemoveDependency.From == "" || e.RemoveDependency.To == "" {
+			return fmt.Errorf("remove_dependency requires from and to")
+		}
+	}
+	if e.DeleteFile != "" {
+		kinds++
+	}
+	if kinds != 1 {
+		return fmt.Errorf("set exactly one of split_function, remove_dependency or delete_file")
+	}
+	return nil
+}
+
+// String describes the edit for reports.
+func (e SimulatedEdit) String() string {
+	switch {
+	case e.SplitFunction != nil:
+		return fmt.Sprintf("split %s in %s into %d", e.SplitFunction.Function, e.SplitFunction.File, e.SplitFunction.Parts)
+	case e.RemoveDependency != nil:
+		return fmt.Sprintf("remove dependency %s → %s", e.RemoveDependency.From, e.RemoveDependency.To)
+	default:
+		return "delete " + e.DeleteFile
+	}
+}
+
+// ProjectSnapshot is the analyzed state of a project that edits rewrite.
+type ProjectSnapshot struct {
+	Scan     *ScanResult
+	Modules  []DetectedModule
+	Analyzed map[string]*AnalyzedFile
+}
+
+// Clone returns a snapshot that edits can change without affecting s.
+// Analyzed files are shared until an edit replaces them.
+func (s ProjectSnapshot) Clone() ProjectSnapshot {
+	scan := *s.Scan
+	scan.GoFiles = slices.Clone(s.Scan.GoFiles)
+	scan.TestFiles = slices.Clone(s.Scan.TestFiles)
+	scan.AllFiles = slices.Clone(s.Scan.AllFiles)
+	scan.ForeignFiles = slices.Clone(s.Scan.ForeignFiles)
+
+	modules := make([]DetectedModule, len(s.Modules))
+	for i, m := range s.Modules {
+		m.Files = slices.Clone(m.Files)
+		modules[i] = m
+	}
+
+	analyzed := make(map[string]*AnalyzedFile, len(s.Analyzed))
+	for k, v := range s.Analyzed {
+		analyzed[k] = v
+	}
+	return ProjectSnapshot{Scan: &scan, Modules: modules, Analyzed: analyzed}
+}
+
+// Apply performs the edit on the snapshot.
+func (s ProjectSnapshot) Apply(e SimulatedEdit) error {
+	switch {
+	case e.SplitFunction != nil:
+		return s.splitFunction(*e.SplitFunction)
+	case e.RemoveDependency != nil:
+		return s.removeDependency(*e.RemoveDependency)
+	default:
+		return s.deleteFile(e.DeleteFile)
+	}
+}
+
+func (s ProjectSnapshot) splitFunction(e SplitFunctionEdit) error {
+	file := cleanPath(e.File)
+	af, ok := s.Analyzed[file]
+	if !ok {
+		return fmt.Errorf("split_function: file %s not found", e.File)
+	}
+	recv, name, qualified := strings.Cut(e.Function, ".")
+	if !qualified {
+		recv, name = "", e.Function
+	}
+	idx := slices.IndexFunc(af.Functions, func(fn Function) bool {
+		return fn.Name == name && (!qualified || strings.TrimPrefix(fn.Receiver, "*") == strings.TrimPrefix(recv, "*"))
+	})
+	if idx < 0 {
+		return fmt.Errorf("split_function: function %s not found in %s", e.Function, e.File)
+	}
+
+	fn := af.Functions[idx]
+	lines := max(fn.LineEnd-fn.LineStart+1, 1)
+	// A function cannot be split into more parts than it has lines, and
+	// every part must stay within the function's own span.
+	n := min(e.Parts, lines)
+	parts := make([]Function, n)
+	start := fn.LineStart
+	for i := range parts {
+		part := fn
+		if i > 0 {
+			part.Name = fmt.Sprintf("%sPart%d", fn.Name, i+1)
+		}
+		size := lines / n
+		if i < lines%n {
+			size++
+		}
+		part.LineStart = start
+		part.LineEnd = start + size - 1
+		part.CognitiveComplexity = ceilDiv(fn.CognitiveComplexity, n)
+		start = part.LineEnd + 1
+		parts[i] = part
+	}
+
+	edited := *af
+	edited.Functions = slices.Concat(af.Functions[:idx], parts, af.Functions[idx+1:])
+	s.Analyzed[file] = &edited
+	return nil
+}
+
+func (s ProjectSnapshot) removeDependency(e RemoveDependencyEdit) error {
+	from := cleanPath(e.From)
+	removed := 0
+	for p, af := range s.Analyzed {
+		if path.Dir(p) != from && !strings.HasPrefix(p, from+"/") {
+			continue
+		}
+		imports := slices.DeleteFunc(slices.Clone(af.Imports), func(imp string) bool {
+			return imp == e.To || strings.HasSuffix(imp, "/"+e.To)
+		})
+		if len(imports) == len(af.Imports) {
+			continue
+		}
+		removed += len(af.Imports) - len(imports)
+		edited := *af
+		edited.Imports = imports
+		s.Analyzed[p] = &edited
+	}
+	if removed == 0 {
+		return fmt.Errorf("remove_dependency: no file under %s imports %s", e.From, e.To)
+	}
+	return nil
+}
+
+func (s ProjectSnapshot) deleteFile(file string) error {
+	file = cleanPath(file)
+	if !slices.Contains(s.Scan.AllFiles, file) {
+		return fmt.Errorf("delete_file: file %s not found", file)
+	}
+	isFile := func(f string) bool { return f == file }
+	delete(s.Analyzed, file)
+	s.Scan.GoFiles = slices.DeleteFunc(s.Scan.GoFiles, isFile)
+	s.Scan.TestFiles = slices.DeleteFunc(s.Scan.TestFiles, isFile)
+	s.Scan.AllFiles = slices.DeleteFunc(s.Scan.AllFiles, isFile)
+	s.Scan.ForeignFiles = slices.DeleteFunc(s.Scan.ForeignFiles, func(ff ForeignFile) bool { return ff.Path == file })
+	for i := range s.Modules {
+		s.Modules[i].Files = slices.DeleteFunc(s.Modules[i].Files, isFile)
+	}
+	return nil
+}
+
+func cleanPath(p string) string {
+	return path.Clean(strings.TrimPrefix(strings.ReplaceAll(p, "\\", "/"), "./"))
+}
+
+func ceilDiv(a, b int) int {
+	return (a + b - 1) / b
+}
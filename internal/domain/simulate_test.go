@@ -0,0 +1,132 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func simulationSnapshot() domain.ProjectSnapshot {
+	run := &domain.AnalyzedFile{
+		Path:    "internal/app/run.go",
+		Imports: []string{"fmt", "example.com/svc/internal/adapters/db"},
+		Functions: []domain.Function{
+			{Name: "Run", LineStart: 10, LineEnd: 99, CognitiveComplexity: 30},
+			{Name: "Stop", Receiver: "Server", LineStart: 100, LineEnd: 110},
+			{Name: "Serve", Receiver: "*Server", LineStart: 112, LineEnd: 130},
+		},
+	}
+	old := &domain.AnalyzedFile{Path: "internal/app/old.go"}
+	return domain.ProjectSnapshot{
+		Scan: &domain.ScanResult{
+			GoFiles:  []string{run.Path, old.Path},
+			AllFiles: []string{run.Path, old.Path},
+		},
+		Modules:  []domain.DetectedModule{{Name: "app", Files: []string{run.Path, old.Path}}},
+		Analyzed: map[string]*domain.AnalyzedFile{run.Path: run, old.Path: old},
+	}
+}
+
+func TestSimulatedEdit_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    domain.SimulatedEdit
+		wantErr string
+	}{
+		{"split", domain.SimulatedEdit{SplitFunction: &domain.SplitFunctionEdit{File: "a.go", Function: "Run", Parts: 2}}, ""},
+		{"delete", domain.SimulatedEdit{DeleteFile: "a.go"}, ""},
+		{"none", domain.SimulatedEdit{}, "exactly one"},
+		{"two kinds", domain.SimulatedEdit{DeleteFile: "a.go", RemoveDependency: &domain.RemoveDependencyEdit{From: "a", To: "b"}}, "exactly one"},
+		{"one part", domain.SimulatedEdit{SplitFunction: &domain.SplitFunctionEdit{File: "a.go", Function: "Run", Parts: 1}}, "at least 2"},
+		{"missing to", domain.SimulatedEdit{RemoveDependency: &domain.RemoveDependencyEdit{From: "a"}}, "requires from and to"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.edit.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+	assert.Error(t, domain.SimulationPlan{}.Validate())
+}
+
+func TestProjectSnapshot_SplitFunction(t *testing.T) {
+	base := simulationSnapshot()
+	snap := base.Clone()
+	require.NoError(t, snap.Apply(domain.SimulatedEdit{SplitFunction: &domain.SplitFunctionEdit{
+		File: "./internal/app/run.go", Function: "Run", Parts: 3,
+	}}))
+
+	fns := snap.Analyzed["internal/app/run.go"].Functions
+	require.Len(t, fns, 5)
+	assert.Equal(t, []string{"Run", "RunPart2", "RunPart3", "Stop", "Serve"}, []string{fns[0].Name, fns[1].Name, fns[2].Name, fns[3].Name, fns[4].Name})
+	for _, fn := range fns[:3] {
+		assert.Equal(t, 30, fn.LineEnd-fn.LineStart+1)
+		assert.Equal(t, 10, fn.CognitiveComplexity)
+	}
+	assert.Len(t, base.Analyzed["internal/app/run.go"].Functions, 3, "the base snapshot is untouched")
+
+	err := snap.Apply(domain.SimulatedEdit{SplitFunction: &domain.SplitFunctionEdit{File: "internal/app/run.go", Function: "Client.Stop", Parts: 2}})
+	assert.ErrorContains(t, err, "not found")
+	require.NoError(t, snap.Apply(domain.SimulatedEdit{SplitFunction: &domain.SplitFunctionEdit{File: "internal/app/run.go", Function: "Server.Stop", Parts: 2}}))
+}
+
+func TestProjectSnapshot_SplitFunctionMatchesPointerReceivers(t *testing.T) {
+	for _, name := range []string{"Server.Serve", "*Server.Serve"} {
+		snap := simulationSnapshot()
+		require.NoError(t, snap.Apply(domain.SimulatedEdit{SplitFunction: &domain.SplitFunctionEdit{
+			File: "internal/app/run.go", Function: name, Parts: 2,
+		}}), name)
+		fns := snap.Analyzed["internal/app/run.go"].Functions
+		require.Len(t, fns, 4, name)
+		assert.Equal(t, "ServePart2", fns[3].Name, name)
+	}
+}
+
+func TestProjectSnapshot_SplitFunctionClampsPartsToLines(t *testing.T) {
+	snap := simulationSnapshot()
+	snap.Analyzed["internal/app/run.go"].Functions[1].LineEnd = 102 // Stop spans lines 100-102
+
+	require.NoError(t, snap.Apply(domain.SimulatedEdit{SplitFunction: &domain.SplitFunctionEdit{
+		File: "internal/app/run.go", Function: "Server.Stop", Parts: 5,
+	}}))
+
+	fns := snap.Analyzed["internal/app/run.go"].Functions
+	require.Len(t, fns, 5, "a 3-line function splits into at most 3 parts")
+	for i, fn := range fns[1:4] {
+		assert.Equal(t, 100+i, fn.LineStart)
+		assert.Equal(t, 100+i, fn.LineEnd)
+	}
+}
+
+func TestProjectSnapshot_RemoveDependency(t *testing.T) {
+	base := simulationSnapshot()
+	snap := base.Clone()
+	require.NoError(t, snap.Apply(domain.SimulatedEdit{RemoveDependency: &domain.RemoveDependencyEdit{From: "internal/app", To: "internal/adapters/db"}}))
+
+	assert.Equal(t, []string{"fmt"}, snap.Analyzed["internal/app/run.go"].Imports)
+	assert.Len(t, base.Analyzed["internal/app/run.go"].Imports, 2)
+
+	err := snap.Apply(domain.SimulatedEdit{RemoveDependency: &domain.RemoveDependencyEdit{From: "internal/app", To: "net/http"}})
+	assert.ErrorContains(t, err, "no file under internal/app imports net/http")
+}
+
+func TestProjectSnapshot_DeleteFile(t *testing.T) {
+	base := simulationSnapshot()
+	snap := base.Clone()
+	require.NoError(t, snap.Apply(domain.SimulatedEdit{DeleteFile: "internal/app/old.go"}))
+
+	assert.NotContains(t, snap.Analyzed, "internal/app/old.go")
+	assert.Equal(t, []string{"internal/app/run.go"}, snap.Scan.GoFiles)
+	assert.Equal(t, []string{"internal/app/run.go"}, snap.Modules[0].Files)
+	assert.Len(t, base.Scan.GoFiles, 2)
+	assert.Len(t, base.Modules[0].Files, 2)
+
+	assert.ErrorContains(t, snap.Apply(domain.SimulatedEdit{DeleteFile: "missing.go"}), "not found")
+}
@@ -0,0 +1,12 @@
+package domain
+
+// SuffixSuggestion is a file name suffix the project uses as a convention
+// but ExpectedFileSuffixes does not list, e.g. _worker in 5 files across 3
+// packages. Adding it to the profile makes file_naming_conventions count
+// those files as suffixed without learn_file_suffixes.
+type SuffixSuggestion struct {
+	Suffix   string   `json:"suffix"`
+	Files    int      `json:"files"`
+	Packages int      `json:"packages"`
+	Examples []string `json:"examples"`
+}
@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// TicketLabel marks the tickets openkraft files, so a later export finds
+// them again and does not file the same issue twice.
+const TicketLabel = "openkraft"
+
+// maxTicketTitle keeps titles within what trackers display and accept.
+const maxTicketTitle = 120
+
+// ticketFingerprint matches the fingerprint line of a ticket body.
+var ticketFingerprint = regexp.MustCompile(`openkraft-fingerprint: ([0-9a-f]{16})`)
+
+// TicketOptions selects the issues exported as tickets.
+type TicketOptions struct {
+	Top      int    // export at most this many issues; 0 exports all
+	Category string // export only the issues of this category
+}
+
+// Ticket is one issue as filed in an issue tracker. The body is plain text,
+// which reads the same in GitHub Markdown and Jira, and ends with the
+// issue's fingerprint line.
+type Ticket struct {
+	Fingerprint string   `json:"fingerprint"`
+	Title       string   `json:"title"`
+	Severity    string   `json:"severity"`
+	Body        string   `json:"body"`
+	Labels      []string `json:"labels"`
+	// Estimated points the overall and category scores regain when the
+	// issue is fixed.
+	Impact         float64 `json:"impact"`
+	CategoryImpact float64 `json:"category_impact"`
+}
+
+// ExportedTicket is the outcome of exporting one ticket.
+type ExportedTicket struct {
+	Ticket
+	URL    string `json:"url,omitempty"` // empty for a dry run
+	Status string `json:"status"`        // created, exists or planned
+}
+
+// SelectTickets returns tickets for the issues of score with the largest
+// estimated score impact, most severe first among equals. An issue's
+// impact is its even share of the points its sub-metric lost, weighted as
+// the overall score weighs its category.
+func SelectTickets(score *Score, opts TicketOptions) []Ticket {
+	var totalWeight float64
+	for _, cat := range score.Categories {
+		totalWeight += cat.Weight
+	}
+	var tickets []Ticket
+	seen := map[string]bool{}
+	for _, cat := range score.Categories {
+		if opts.Category != "" && cat.Name != opts.Category {
+			continue
+		}
+		for _, iss := range cat.Issues {
+			fp := IssueFingerprint(iss)
+			if seen[fp] {
+				continue
+			}
+			seen[fp] = true
+			share := issueShare(cat, iss)
+			impact := 0.0
+			if totalWeight > 0 {
+				impact = share * cat.Weight / totalWeight
+			}
+			tickets = append(tickets, newTicket(iss, fp, impact, share))
+		}
+	}
+	slices.SortStableFunc(tickets, func(a, b Ticket) int {
+		return cmp.Or(
+			cmp.Compare(b.Impact, a.Impact),
+			cmp.Compare(severityRank[a.Severity], severityRank[b.Severity]),
+			cmp.Compare(a.Fingerprint, b.Fingerprint),
+		)
+	})
+	if opts.Top > 0 && len(tickets) > opts.Top {
+		tickets = tickets[:opts.Top]
+	}
+	return tickets
+}
+
+// TicketFingerprint returns the fingerprint recorded in a ticket body, or
+// "" when the body has none.
+func TicketFingerprint(body string) string {
+	if m := ticketFingerprint.FindStringSubmatch(body); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// issueShare returns the points of the category the issue costs: the points
+// its sub-metric lost, split evenly between the sub-metric's issues.
+func issueShare(cat CategoryScore, iss Issue) float64 {
+	lost := 0
+	for _, sm := range cat.SubMetrics {
+		if sm.Name == iss.SubMetric && !sm.Skipped {
+			lost = sm.Points - sm.Score
+		}
+	}
+	if lost <= 0 {
+		return 0
+	}
+	n := 0
+	for _, other := range cat.Issues {
+		if other.SubMetric == iss.SubMetric {
+			n++
+		}
+	}
+	return float64(lost) / float64(n)
+}
+
+func newTicket(iss Issue, fp string, impact, share float64) Ticket {
+	location := iss.File
+	if location != "" && iss.Line > 0 {
+		location = fmt.Sprintf("%s:%d", iss.File, iss.Line)
+	}
+	title := iss.Message
+	if location != "" {
+		title = location + ": " + title
+	}
+	if r := []rune(title); len(r) > maxTicketTitle {
+		title = strings.TrimSpace(string(r[:maxTicketTitle-3])) + "..."
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\n", iss.Message)
+	if location != "" {
+		fmt.Fprintf(&b, "Location: %s\n", location)
+	}
+	fmt.Fprintf(&b, "Category: %s", iss.Category)
+	if iss.SubMetric != "" {
+		fmt.Fprintf(&b, " / %s", iss.SubMetric)
+	}
+	fmt.Fprintf(&b, "\nSeverity: %s\n", iss.Severity)
+	if iss.Owner != "" {
+		fmt.Fprintf(&b, "Owner: %s\n", iss.Owner)
+	}
+	if iss.Remediation != "" {
+		fmt.Fprintf(&b, "\nHow to fix: %s\n", iss.Remediation)
+	}
+	if impact > 0 {
+		fmt.Fprintf(&b, "\nEstimated score impact: fixing this adds about %.1f points to the overall score (%.1f to %s).\n",
+			impact, share, iss.Category)
+	}
+	fmt.Fprintf(&b, "\nopenkraft-fingerprint: %s\n", fp)
+
+	labels := []string{TicketLabel}
+	if iss.Category != "" {
+		labels = append(labels, iss.Category)
+	}
+	return Ticket{
+		Fingerprint: fp, Title: title, Severity: iss.Severity, Body: b.String(), Labels: labels,
+		Impact: impact, CategoryImpact: share,
+	}
+}
@@ -0,0 +1,61 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/internal/domain"
+)
+
+func ticketScore() *domain.Score {
+	return &domain.Score{Categories: []domain.CategoryScore{
+		{
+			Name: "code_health", Weight: 0.5,
+			SubMetrics: []domain.SubMetric{{Name: "function_size", Points: 20, Score: 12}, {Name: "file_size", Points: 20, Score: 18}},
+			Issues: []domain.Issue{
+				{Severity: domain.SeverityWarning, Category: "code_health", SubMetric: "function_size", File: "a.go", Line: 3, Message: "function Run is 90 lines", Remediation: "split Run"},
+				{Severity: domain.SeverityWarning, Category: "code_health", SubMetric: "function_size", File: "b.go", Line: 7, Message: "function Do is 80 lines"},
+				{Severity: domain.SeverityError, Category: "code_health", SubMetric: "file_size", File: "c.go", Message: "file has 900 lines"},
+			},
+		},
+		{
+			Name: "discoverability", Weight: 0.5,
+			SubMetrics: []domain.SubMetric{{Name: "naming_uniqueness", Points: 25, Score: 25}},
+			Issues: []domain.Issue{
+				{Severity: domain.SeverityError, Category: "discoverability", SubMetric: "naming_uniqueness", File: "d.go", Message: "exported name Get is ambiguous"},
+			},
+		},
+	}}
+}
+
+func TestSelectTickets_RanksByEstimatedImpact(t *testing.T) {
+	tickets := domain.SelectTickets(ticketScore(), domain.TicketOptions{})
+
+	require.Len(t, tickets, 4)
+	// function_size lost 8 points over two issues: 4 each, 2 overall.
+	assert.Equal(t, "a.go:3: function Run is 90 lines", tickets[0].Title)
+	assert.InDelta(t, 2.0, tickets[0].Impact, 1e-9)
+	assert.InDelta(t, 4.0, tickets[0].CategoryImpact, 1e-9)
+	assert.Equal(t, "b.go:7: function Do is 80 lines", tickets[1].Title)
+	assert.Equal(t, "c.go: file has 900 lines", tickets[2].Title)
+	assert.Zero(t, tickets[3].Impact, "a sub-metric at full points has nothing to regain")
+
+	body := tickets[0].Body
+	assert.Contains(t, body, "How to fix: split Run")
+	assert.Contains(t, body, "about 2.0 points to the overall score (4.0 to code_health)")
+	assert.Equal(t, tickets[0].Fingerprint, domain.TicketFingerprint(body))
+	assert.Equal(t, []string{domain.TicketLabel, "code_health"}, tickets[0].Labels)
+}
+
+func TestSelectTickets_TopAndCategory(t *testing.T) {
+	tickets := domain.SelectTickets(ticketScore(), domain.TicketOptions{Top: 1, Category: "discoverability"})
+
+	require.Len(t, tickets, 1)
+	assert.Equal(t, "d.go: exported name Get is ambiguous", tickets[0].Title)
+}
+
+func TestTicketFingerprint_AbsentFromForeignBody(t *testing.T) {
+	assert.Empty(t, domain.TicketFingerprint("filed by hand"))
+}
@@ -0,0 +1,16 @@
+// Package openkraft is the public Go API for embedding the OpenKraft
+// analyzer in other programs.
+//
+//	res, err := openkraft.Analyze("./myproject", openkraft.Options{})
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Println(res.Overall, res.Grade)
+//
+// Everything else in this module lives under internal/ and may change at
+// any time. This package follows semantic versioning (see APIVersion):
+// within a major version, exported identifiers are neither removed nor
+// changed incompatibly, and result types only gain fields. Scores
+// themselves are not part of the guarantee; scorer tuning can change them
+// in any release.
+package openkraft
@@ -0,0 +1,305 @@
+package openkraft
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/cache"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/config"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/detector"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/parser"
+	"github.com/abdidvp/openkraft/internal/adapters/outbound/scanner"
+	"github.com/abdidvp/openkraft/internal/application"
+	"github.com/abdidvp/openkraft/internal/domain"
+	"github.com/abdidvp/openkraft/internal/domain/scoring"
+)
+
+// APIVersion is the semantic version of this package's API, independent of
+// the openkraft release version.
+const APIVersion = "1.2.0"
+
+// Severities of an Issue, from most to least severe.
+const (
+	SeverityError   = domain.SeverityError
+	SeverityWarning = domain.SeverityWarning
+	SeverityInfo    = domain.SeverityInfo
+)
+
+// Options configures Analyze. The zero value scores the project with its
+// .openkraft.yaml (if any) and reports every issue.
+type Options struct {
+	// Calibration overrides the configured calibration preset: "strict",
+	// "default" or "legacy-friendly".
+	Calibration string
+	// MinSeverity drops issues less severe than this from the result.
+	// Scores are unaffected.
+	MinSeverity string
+	// MaxIssues keeps at most this many issues, most severe first.
+	MaxIssues int
+	// StrictParse makes Analyze fail when any Go file cannot be parsed,
+	// instead of scoring the files that can and listing the rest in
+	// Result.ParseFailures.
+	StrictParse bool
+}
+
+// Result is the outcome of analyzing a project.
+type Result struct {
+	Path       string     `json:"path"`
+	ModulePath string     `json:"module_path"`
+	Overall    int        `json:"overall"`
+	Grade      string     `json:"grade"`
+	Categories []Category `json:"categories"`
+	Graph      Graph      `json:"graph"`
+	// ParseFailures lists the Go files left out because they do not parse.
+	ParseFailures []ParseFailure `json:"parse_failures,omitempty"`
+}
+
+// ParseFailure is a Go file that could not be parsed. Line and Column
+// locate the first syntax error and are zero if the file was unreadable.
+type ParseFailure struct {
+	File   string `json:"file"` // relative to the project root
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+	Error  string `json:"error"`
+}
+
+// Category is the score of one scoring category.
+type Category struct {
+	Name       string      `json:"name"`
+	Score      int         `json:"score"`
+	Weight     float64     `json:"weight"`
+	SubMetrics []SubMetric `json:"sub_metrics"`
+	Issues     []Issue     `json:"issues"`
+}
+
+// SubMetric is one measured aspect of a category.
+type SubMetric struct {
+	Name    string `json:"name"`
+	Score   int    `json:"score"`
+	Points  int    `json:"points"`
+	Detail  string `json:"detail,omitempty"`
+	Skipped bool   `json:"skipped,omitempty"`
+}
+
+// Issue is a single finding. File is relative to the project root.
+type Issue struct {
+	Severity    string `json:"severity"`
+	Category    string `json:"category"`
+	SubMetric   string `json:"sub_metric,omitempty"`
+	File        string `json:"file,omitempty"`
+	Line        int    `json:"line,omitempty"`
+	Message     string `json:"message"`
+	Fingerprint string `json:"fingerprint"` // stable across runs and line shifts
+}
+
+// Graph is the project's internal package import graph.
+type Graph struct {
+	Packages []Package  `json:"packages"`
+	Cycles   [][]string `json:"cycles"`
+}
+
+// Package is a node of the import graph.
+type Package struct {
+	Path       string   `json:"path"`
+	Role       string   `json:"role"`
+	Imports    []string `json:"imports"`     // internal packages this one imports
+	ImportedBy []string `json:"imported_by"` // internal packages importing this one
+	Violations []string `json:"violations,omitempty"`
+}
+
+// FileResult is the impact of one file on its project's score.
+type FileResult struct {
+	Path          string            `json:"path"` // relative to the project root
+	Overall       int               `json:"overall"`
+	OverallImpact int               `json:"overall_impact"` // Overall minus the score without the file
+	SubMetrics    []SubMetricImpact `json:"sub_metrics"`
+	Issues        []Issue           `json:"issues"`
+	Partial       bool              `json:"partial,omitempty"` // the file has syntax errors and was measured token by token
+}
+
+// SubMetricImpact is one sub-metric a file moves or has issues in.
+type SubMetricImpact struct {
+	Category  string `json:"category"`
+	SubMetric string `json:"sub_metric"`
+	Score     int    `json:"score"`
+	Points    int    `json:"points"`
+	Impact    int    `json:"impact"` // Score minus the score without the file
+	Issues    int    `json:"issues"`
+}
+
+// Analyze scores the Go project at path.
+func Analyze(path string, opts Options) (*Result, error) {
+	if opts.Calibration != "" {
+		if err := domain.ValidateCalibration(opts.Calibration); err != nil {
+			return nil, err
+		}
+	}
+	filter := domain.IssueFilter{MinSeverity: opts.MinSeverity, MaxTotal: opts.MaxIssues}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("resolving path: %w", err)
+	}
+
+	svc := application.NewScoreService(
+		scanner.New(),
+		detector.New(),
+		parser.New(),
+		config.New(),
+		parser.LanguageAnalyzers()...,
+	)
+	var scoreOpts []application.ScoreOption
+	if opts.Calibration != "" {
+		scoreOpts = append(scoreOpts, application.WithCalibration(opts.Calibration))
+	}
+	if opts.StrictParse {
+		scoreOpts = append(scoreOpts, application.WithStrictParse())
+	}
+	score, data, err := svc.ScoreProjectData(absPath, scoreOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("scoring %s: %w", absPath, err)
+	}
+	score = domain.FilterIssues(score, filter)
+
+	return &Result{
+		Path:          absPath,
+		ModulePath:    data.Scan.ModulePath,
+		Overall:       score.Overall,
+		Grade:         score.Grade(),
+		Categories:    convertCategories(score.Categories),
+		Graph:         convertGraph(data),
+		ParseFailures: convertParseFailures(score.ParseFailures),
+	}, nil
+}
+
+// ScoreFile scores the file at file, relative to projectPath, by comparing
+// the project scored with and without it. When src is non-nil it is scored
+// as the file's content instead of what is on disk, and the file need not
+// exist, which suits editor save actions and pre-commit hooks. The project
+// analysis is cached under projectPath/.openkraft between calls.
+func ScoreFile(projectPath, file string, src []byte) (*FileResult, error) {
+	absPath, err := filepath.Abs(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("resolving path: %w", err)
+	}
+	sc, det, par, cfg := scanner.New(), detector.New(), parser.New(), config.New()
+	scoreSvc := application.NewScoreService(sc, det, par, cfg, parser.LanguageAnalyzers()...)
+	svc := application.NewValidateService(sc, det, par, scoreSvc, cache.New(), cfg)
+
+	fs, err := svc.ScoreFile(absPath, filepath.ToSlash(filepath.Clean(file)), src)
+	if err != nil {
+		return nil, fmt.Errorf("scoring %s: %w", file, err)
+	}
+	res := &FileResult{
+		Path:          fs.Path,
+		Overall:       fs.Overall,
+		OverallImpact: fs.OverallImpact,
+		SubMetrics:    make([]SubMetricImpact, 0, len(fs.SubMetrics)),
+		Issues:        make([]Issue, 0, len(fs.Issues)),
+		Partial:       fs.Partial,
+	}
+	for _, sm := range fs.SubMetrics {
+		res.SubMetrics = append(res.SubMetrics, SubMetricImpact(sm))
+	}
+	for _, iss := range fs.Issues {
+		res.Issues = append(res.Issues, convertIssue(iss))
+	}
+	return res, nil
+}
+
+// Issues returns the issues of every category in one list.
+func (r *Result) Issues() []Issue {
+	var out []Issue
+	for _, cat := range r.Categories {
+		out = append(out, cat.Issues...)
+	}
+	return out
+}
+
+func convertCategories(categories []domain.CategoryScore) []Category {
+	out := make([]Category, 0, len(categories))
+	for _, cat := range categories {
+		c := Category{
+			Name:       cat.Name,
+			Score:      cat.Score,
+			Weight:     cat.Weight,
+			SubMetrics: make([]SubMetric, 0, len(cat.SubMetrics)),
+			Issues:     make([]Issue, 0, len(cat.Issues)),
+		}
+		for _, sm := range cat.SubMetrics {
+			c.SubMetrics = append(c.SubMetrics, SubMetric(sm))
+		}
+		for _, iss := range cat.Issues {
+			c.Issues = append(c.Issues, convertIssue(iss))
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+func convertParseFailures(failures []domain.ParseFailure) []ParseFailure {
+	if len(failures) == 0 {
+		return nil
+	}
+	out := make([]ParseFailure, len(failures))
+	for i, f := range failures {
+		out[i] = ParseFailure{File: f.File, Line: f.Line, Column: f.Column, Error: f.Error}
+	}
+	return out
+}
+
+func convertIssue(iss domain.Issue) Issue {
+	return Issue{
+		Severity:    iss.Severity,
+		Category:    iss.Category,
+		SubMetric:   iss.SubMetric,
+		File:        iss.File,
+		Line:        iss.Line,
+		Message:     iss.Message,
+		Fingerprint: domain.IssueFingerprint(iss),
+	}
+}
+
+func convertGraph(data *application.ProjectData) Graph {
+	out := Graph{Packages: []Package{}, Cycles: [][]string{}}
+	graph := scoring.BuildImportGraph(data.Scan.ModulePath, data.Analyzed)
+	if graph == nil {
+		return out
+	}
+	if cycles := graph.DetectCycles(); cycles != nil {
+		out.Cycles = cycles
+	}
+
+	annotated := graph.ClassifyPackages(data.Scan.ModulePath, &data.Profile)
+	pkgs := make([]string, 0, len(graph.Packages))
+	for pkg := range graph.Packages {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+
+	for _, pkg := range pkgs {
+		node := graph.Packages[pkg]
+		p := Package{
+			Path:       pkg,
+			Imports:    sortedCopy(node.ImportsInternal),
+			ImportedBy: sortedCopy(node.ImportedBy),
+		}
+		if ap := annotated[pkg]; ap != nil {
+			p.Role = string(ap.Role)
+			for _, v := range ap.Violations {
+				p.Violations = append(p.Violations, v.Message)
+			}
+		}
+		out.Packages = append(out.Packages, p)
+	}
+	return out
+}
+
+func sortedCopy(s []string) []string {
+	out := append([]string{}, s...)
+	sort.Strings(out)
+	return out
+}
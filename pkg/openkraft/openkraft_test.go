@@ -0,0 +1,78 @@
+package openkraft_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abdidvp/openkraft/pkg/openkraft"
+)
+
+const fixtureDir = "../../testdata/go-hexagonal/perfect"
+
+func TestAnalyze(t *testing.T) {
+	res, err := openkraft.Analyze(fixtureDir, openkraft.Options{})
+	require.NoError(t, err)
+
+	assert.Greater(t, res.Overall, 0)
+	assert.NotEmpty(t, res.Grade)
+	assert.NotEmpty(t, res.ModulePath)
+	require.NotEmpty(t, res.Categories)
+	for _, cat := range res.Categories {
+		assert.NotEmpty(t, cat.SubMetrics, cat.Name)
+	}
+	for _, iss := range res.Issues() {
+		assert.NotEmpty(t, iss.Fingerprint)
+	}
+	assert.NotEmpty(t, res.Graph.Packages)
+}
+
+func TestAnalyze_FiltersIssuesWithoutChangingScores(t *testing.T) {
+	all, err := openkraft.Analyze(fixtureDir, openkraft.Options{})
+	require.NoError(t, err)
+	filtered, err := openkraft.Analyze(fixtureDir, openkraft.Options{MinSeverity: openkraft.SeverityError, MaxIssues: 1})
+	require.NoError(t, err)
+
+	assert.Equal(t, all.Overall, filtered.Overall)
+	assert.LessOrEqual(t, len(filtered.Issues()), 1)
+	for _, iss := range filtered.Issues() {
+		assert.Equal(t, openkraft.SeverityError, iss.Severity)
+	}
+}
+
+func TestAnalyze_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts openkraft.Options
+	}{
+		{name: "calibration", opts: openkraft.Options{Calibration: "lenient"}},
+		{name: "severity", opts: openkraft.Options{MinSeverity: "critical"}},
+		{name: "negative max issues", opts: openkraft.Options{MaxIssues: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := openkraft.Analyze(fixtureDir, tt.opts)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestScoreFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/app\n\ngo 1.24\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.go"), []byte("package app\n\n// Name returns the app name.\nfunc Name() string { return \"app\" }\n"), 0o644))
+
+	res, err := openkraft.ScoreFile(dir, "wide.go", []byte("package app\n\nfunc Wide(a, b, c, d, e, f, g int) int { return a }\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "wide.go", res.Path)
+	require.NotEmpty(t, res.Issues)
+	for _, iss := range res.Issues {
+		assert.Equal(t, "wide.go", iss.File)
+		assert.NotEmpty(t, iss.Fingerprint)
+	}
+	assert.NotEmpty(t, res.SubMetrics)
+}
@@ -66,7 +66,7 @@ func TestE2E_ScoreJSON(t *testing.T) {
 	var score domain.Score
 	err := json.Unmarshal([]byte(out), &score)
 	require.NoError(t, err)
-	assert.Len(t, score.Categories, 6, "should have 6 categories")
+	assert.Len(t, score.Categories, 7, "should have 7 categories")
 	assert.True(t, score.Overall > 0, "overall should be positive")
 	assert.True(t, score.Overall <= 100, "overall should not exceed 100")
 
@@ -229,7 +229,7 @@ func TestE2E_ScoreSkippedCategory(t *testing.T) {
 	var score domain.Score
 	require.NoError(t, json.Unmarshal([]byte(out), &score))
 
-	assert.Len(t, score.Categories, 5, "should have 5 categories when context_quality is skipped")
+	assert.Len(t, score.Categories, 6, "should have 6 categories when context_quality is skipped")
 	for _, cat := range score.Categories {
 		assert.NotEqual(t, "context_quality", cat.Name)
 	}